@@ -0,0 +1,16 @@
+package app
+
+import "github.com/cosmos/cosmos-sdk/types/module"
+
+// ReleaseVersion is the name of the upgrade handler of the release
+const ReleaseVersion = releaseVersion
+
+// ModuleManager returns the module manager of the app
+func (app *App) ModuleManager() *module.Manager {
+	return app.mm
+}
+
+// Configurator returns the configurator used to register the module migrations
+func (app *App) Configurator() module.Configurator {
+	return app.configurator
+}
@@ -4,12 +4,20 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 const releaseVersion = "v11.0.0"
 
+// releaseZetaClientTxTypes are the zetaclient tx types added in the release, the observers were granted the tx types of
+// the previous releases in the genesis
+var releaseZetaClientTxTypes = []string{
+	sdk.MsgTypeURL(&crosschaintypes.MsgReportOutTxNonceGap{}),
+}
+
 func SetupHandlers(app *App) {
 	app.UpgradeKeeper.SetUpgradeHandler(releaseVersion, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		app.Logger().Info("Running upgrade handler for " + releaseVersion)
@@ -18,7 +26,16 @@ func SetupHandlers(app *App) {
 			vm[m] = mb.ConsensusVersion()
 		}
 		vm[crosschaintypes.ModuleName] = vm[crosschaintypes.ModuleName] - 1
-		return app.mm.RunMigrations(ctx, app.configurator, vm)
+		// the observer module is at consensus version 3 on the network, its migration from version 3 was registered in
+		// the previous release without bumping the consensus version, the migration keeps the block header verification
+		// flags of the crosschain flags in case it already ran
+		vm[observertypes.ModuleName] = 3
+		vm, err := app.mm.RunMigrations(ctx, app.configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+		grantZetaClientTxTypes(ctx, app, releaseZetaClientTxTypes)
+		return vm, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -36,3 +53,28 @@ func SetupHandlers(app *App) {
 		app.SetStoreLoader(types.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 }
+
+// grantZetaClientTxTypes grants the tx types to the zetaclient grantee of each node account, the tx types already
+// granted are left unchanged
+func grantZetaClientTxTypes(ctx sdk.Context, app *App, txTypes []string) {
+	for _, nodeAccount := range app.ZetaObserverKeeper.GetAllNodeAccount(ctx) {
+		granter, err := sdk.AccAddressFromBech32(nodeAccount.Operator)
+		if err != nil {
+			ctx.Logger().Error("invalid node account operator", "operator", nodeAccount.Operator, "error", err)
+			continue
+		}
+		grantee, err := sdk.AccAddressFromBech32(nodeAccount.GranteeAddress)
+		if err != nil {
+			ctx.Logger().Error("invalid node account grantee", "operator", nodeAccount.Operator, "error", err)
+			continue
+		}
+		for _, txType := range txTypes {
+			if authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, granter, txType); authorization != nil {
+				continue
+			}
+			if err := app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authz.NewGenericAuthorization(txType), nil); err != nil {
+				ctx.Logger().Error("failed to grant zetaclient tx type", "operator", nodeAccount.Operator, "type", txType, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package app_test
+
+import (
+	"os"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/common"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	fixtureTssPubkey         = "zetapub1addwnpepq226m5d3cpr4znr9fpuuf6hm7puzqedcmqaqeyku937vft7xml4zkhfqxf3"
+	fixturePreviousTssPubkey = "zetapub1addwnpepqg0m7yvmdgw2ja8ve4mh8qvyzlwhdajxdhxx9cz7v8ujkn7vhvnjcp5kh6q"
+	fixtureOperator          = "zeta1cmwqu9dd8y8lxmv9tp8qnncflfjr7vdsuphg38"
+	fixtureAdmin             = "zeta18hczngzmmmy2sse24jv846sp4msndj8txk7c7c"
+	fixtureChainID           = 1337
+)
+
+// firstMigratedVersions is the first consensus version of the modules from which migrations are registered
+// Every migration of these modules must be covered by an upgrade test
+var firstMigratedVersions = map[string]uint64{
+	crosschaintypes.ModuleName: 1,
+	observertypes.ModuleName:   1,
+	fungibletypes.ModuleName:   2,
+	emissionstypes.ModuleName:  2,
+}
+
+var upgradeTests = []upgradeTest{
+	{
+		fixture: "crosschain_v1.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			k := zetaApp.ZetaObserverKeeper
+
+			// node accounts, keygen and flags are moved to the observer store
+			require.Len(t, k.GetAllNodeAccount(ctx), 2)
+			nodeAccount, found := k.GetNodeAccount(ctx, fixtureOperator)
+			require.True(t, found)
+			require.Equal(t, observertypes.NodeStatus_Active, nodeAccount.NodeStatus)
+
+			keygen, found := k.GetKeygen(ctx)
+			require.True(t, found)
+			require.Equal(t, observertypes.KeygenStatus_KeyGenSuccess, keygen.Status)
+			require.EqualValues(t, 90, keygen.BlockNumber)
+
+			flags, found := k.GetCrosschainFlags(ctx)
+			require.True(t, found)
+			require.True(t, flags.IsInboundEnabled)
+			require.False(t, flags.IsOutboundEnabled)
+
+			observerCount, found := k.GetLastObserverCount(ctx)
+			require.True(t, found)
+			require.EqualValues(t, 3, observerCount.Count)
+			require.Equal(t, ctx.BlockHeight(), observerCount.LastChangeHeight)
+
+			checkTssMoved(t, ctx, zetaApp, fixtureTssPubkey)
+			checkNoncesMoved(t, ctx, zetaApp)
+			checkZetaAccounting(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "crosschain_v2.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			checkTssMoved(t, ctx, zetaApp, fixtureTssPubkey)
+			checkNoncesMoved(t, ctx, zetaApp)
+			checkZetaAccounting(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "crosschain_v3.json",
+		upgrade: app.ReleaseVersion,
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			checkTssMoved(t, ctx, zetaApp, fixtureTssPubkey, fixturePreviousTssPubkey)
+			checkNoncesMoved(t, ctx, zetaApp)
+			checkZetaAccounting(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "observer_v1.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			flags, found := zetaApp.ZetaObserverKeeper.GetCrosschainFlags(ctx)
+			require.True(t, found)
+			require.True(t, flags.IsInboundEnabled)
+			require.True(t, flags.IsOutboundEnabled)
+
+			checkAdminPolicyGroups(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "observer_v2.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			flags, found := zetaApp.ZetaObserverKeeper.GetCrosschainFlags(ctx)
+			require.True(t, found)
+			require.False(t, flags.IsInboundEnabled)
+			require.True(t, flags.IsOutboundEnabled)
+			require.NotNil(t, flags.GasPriceIncreaseFlags)
+			require.EqualValues(t, 50, flags.GasPriceIncreaseFlags.EpochLength)
+			require.EqualValues(t, 100, flags.GasPriceIncreaseFlags.MaxPendingCctxs)
+
+			checkAdminPolicyGroups(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "observer_v3.json",
+		upgrade: app.ReleaseVersion,
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			flags, found := zetaApp.ZetaObserverKeeper.GetCrosschainFlags(ctx)
+			require.True(t, found)
+			require.True(t, flags.IsInboundEnabled)
+			require.False(t, flags.IsOutboundEnabled)
+			require.NotNil(t, flags.GasPriceIncreaseFlags)
+			require.EqualValues(t, 50, flags.GasPriceIncreaseFlags.EpochLength)
+			require.NotNil(t, flags.BlockHeaderVerificationFlags)
+
+			checkBlockHeadersIndexed(t, ctx, zetaApp, fixtureChainID)
+		},
+	},
+	{
+		fixture: "observer_v3_migrated.json",
+		upgrade: app.ReleaseVersion,
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			flags, found := zetaApp.ZetaObserverKeeper.GetCrosschainFlags(ctx)
+			require.True(t, found)
+			require.True(t, flags.IsInboundEnabled)
+			require.True(t, flags.IsOutboundEnabled)
+			require.EqualValues(t, 100, flags.GasPriceIncreaseFlags.EpochLength)
+
+			// the block header verification flags set on the network are kept
+			require.Equal(t, &observertypes.BlockHeaderVerificationFlags{
+				IsEthTypeChainEnabled: false,
+				IsBtcTypeChainEnabled: true,
+			}, flags.BlockHeaderVerificationFlags)
+
+			checkZetaClientTxTypesGranted(t, ctx, zetaApp)
+		},
+	},
+	{
+		fixture: "observer_v4.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			checkBlockHeadersIndexed(t, ctx, zetaApp, common.BtcRegtestChain().ChainId)
+		},
+	},
+	{
+		fixture: "observer_v5.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			k := zetaApp.ZetaObserverKeeper
+
+			coreParams, found := k.GetCoreParamsByChainID(ctx, common.BtcMainnetChain().ChainId)
+			require.True(t, found)
+			require.Equal(t, observertypes.BitcoinConfirmationTiers(), coreParams.ConfirmationTiers)
+
+			coreParams, found = k.GetCoreParamsByChainID(ctx, common.EthChain().ChainId)
+			require.True(t, found)
+			require.Empty(t, coreParams.ConfirmationTiers)
+		},
+	},
+	{
+		fixture: "observer_v6.json",
+		check: func(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+			k := zetaApp.ZetaObserverKeeper
+			chainID := common.BtcRegtestChain().ChainId
+
+			// the block headers of the fixture have the regtest target, a work of 2 each
+			var hash []byte
+			for height := int64(1); height <= 3; height++ {
+				var found bool
+				hash, found = k.GetBestChainBlockHash(ctx, chainID, height)
+				require.True(t, found)
+				chainWork, found := k.GetBlockChainWork(ctx, hash)
+				require.True(t, found)
+				require.EqualValues(t, 2*height, chainWork.ChainWork.Uint64())
+			}
+
+			bhs, found := k.GetBlockHeaderState(ctx, chainID)
+			require.True(t, found)
+			require.EqualValues(t, 3, bhs.LatestHeight)
+			require.Equal(t, hash, bhs.LatestBlockHash)
+		},
+	},
+}
+
+// TestMain sets the address prefixes of the network, the fixtures exported from a node have its bech32 keys
+func TestMain(m *testing.M) {
+	app.SetConfig()
+	os.Exit(m.Run())
+}
+
+func TestUpgrades(t *testing.T) {
+	for _, test := range upgradeTests {
+		test := test
+		t.Run(test.fixture, func(t *testing.T) {
+			runUpgradeTest(t, test)
+		})
+	}
+}
+
+// TestUpgradeTestsCoverMigrations checks that a fixture is tested from each version of the migrated modules
+// A new migration must be shipped with a fixture of the state before the migration and its upgrade test
+func TestUpgradeTestsCoverMigrations(t *testing.T) {
+	tested := make(map[string]map[uint64]bool)
+	for _, test := range upgradeTests {
+		fixture, err := readUpgradeFixture(test.fixture)
+		require.NoError(t, err)
+		for m, v := range fixture.Versions {
+			if tested[m] == nil {
+				tested[m] = make(map[uint64]bool)
+			}
+			tested[m][v] = true
+		}
+	}
+
+	zetaApp, _ := newUpgradeTestApp(t)
+	latestVersions := zetaApp.ModuleManager().GetVersionMap()
+	for m, first := range firstMigratedVersions {
+		latest, ok := latestVersions[m]
+		require.True(t, ok, "unknown module %s", m)
+		for v := first; v < latest; v++ {
+			require.True(t, tested[m][v], "no upgrade test for module %s from version %d", m, v)
+		}
+	}
+}
+
+// checkTssMoved checks the TSS and its history are in the observer store
+func checkTssMoved(t *testing.T, ctx sdk.Context, zetaApp *app.App, tssPubkey string, previousTssPubkeys ...string) {
+	tss, found := zetaApp.ZetaObserverKeeper.GetTSS(ctx)
+	require.True(t, found)
+	require.Equal(t, tssPubkey, tss.TssPubkey)
+	require.EqualValues(t, 100, tss.FinalizedZetaHeight)
+
+	var history []string
+	for _, previous := range zetaApp.ZetaObserverKeeper.GetAllTSS(ctx) {
+		history = append(history, previous.TssPubkey)
+	}
+	require.ElementsMatch(t, append(previousTssPubkeys, tssPubkey), history)
+}
+
+// checkNoncesMoved checks the chain nonces, pending nonces and nonce to cctx mappings are in the observer store
+func checkNoncesMoved(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+	k := zetaApp.ZetaObserverKeeper
+
+	chainNonces, found := k.GetChainNonces(ctx, "goerli_localnet")
+	require.True(t, found)
+	require.EqualValues(t, 2, chainNonces.Nonce)
+
+	pendingNonces, found := k.GetPendingNonces(ctx, fixtureTssPubkey, fixtureChainID)
+	require.True(t, found)
+	require.EqualValues(t, 1, pendingNonces.NonceLow)
+	require.EqualValues(t, 2, pendingNonces.NonceHigh)
+
+	nonceToCctx, found := k.GetNonceToCctx(ctx, fixtureTssPubkey, fixtureChainID, 1)
+	require.True(t, found)
+	require.Equal(t, "0x03", nonceToCctx.CctxIndex)
+}
+
+// checkZetaAccounting checks the aborted zeta amount is the sum of the aborted zeta cctxs of the fixtures
+func checkZetaAccounting(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+	zetaAccounting, found := zetaApp.ZetaCoreKeeper.GetZetaAccounting(ctx)
+	require.True(t, found)
+	require.True(t, sdkmath.NewUint(3500).Equal(zetaAccounting.AbortedZetaAmount), zetaAccounting.AbortedZetaAmount.String())
+}
+
+// checkAdminPolicyGroups checks the legacy admin policy is replaced by the policy groups of the first admin
+func checkAdminPolicyGroups(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+	params := zetaApp.ZetaObserverKeeper.GetParams(ctx)
+	require.Len(t, params.AdminPolicy, 2)
+	require.Equal(t, fixtureAdmin, params.GetAdminPolicyAccount(observertypes.Policy_Type_group1))
+	require.Equal(t, fixtureAdmin, params.GetAdminPolicyAccount(observertypes.Policy_Type_group2))
+}
+
+// checkBlockHeadersIndexed checks the block headers of the fixtures are indexed by height, the block headers below the
+// pruned height are otherwise reported as pruned
+func checkBlockHeadersIndexed(t *testing.T, ctx sdk.Context, zetaApp *app.App, chainID int64) {
+	k := zetaApp.ZetaObserverKeeper
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	require.True(t, found)
+	bhs.PrunedHeight = bhs.LatestHeight + 1
+	require.Empty(t, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+}
+
+// checkZetaClientTxTypesGranted checks the zetaclient grantee of each node account is granted the tx types of zetaclient
+func checkZetaClientTxTypesGranted(t *testing.T, ctx sdk.Context, zetaApp *app.App) {
+	nodeAccounts := zetaApp.ZetaObserverKeeper.GetAllNodeAccount(ctx)
+	require.NotEmpty(t, nodeAccounts)
+	for _, nodeAccount := range nodeAccounts {
+		granter := sdk.MustAccAddressFromBech32(nodeAccount.Operator)
+		grantee := sdk.MustAccAddressFromBech32(nodeAccount.GranteeAddress)
+		authorization, _ := zetaApp.AuthzKeeper.GetAuthorization(
+			ctx,
+			grantee,
+			granter,
+			sdk.MsgTypeURL(&crosschaintypes.MsgReportOutTxNonceGap{}),
+		)
+		require.NotNil(t, authorization, "node account %s", nodeAccount.Operator)
+	}
+}
@@ -0,0 +1,321 @@
+package app_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// simChainID is the chain ID of the simulated chain, the EVM requires an Ethermint chain ID
+const simChainID = "athens_101-1"
+
+// fungibleModuleFunds is the amount of azeta held by the fungible module at genesis
+var fungibleModuleFunds = sdkmath.NewIntWithDecimal(1, 24)
+
+// Get flags every time the simulator is run
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// setSimPowerReduction sets the power reduction of the SDK for the duration of the test and restores it on cleanup
+// The simulation funds accounts with less than 1e12 tokens, the power reduction of the SDK is used instead of the EVM
+// one to get non-empty validator sets
+func setSimPowerReduction(t *testing.T) {
+	powerReduction := sdk.DefaultPowerReduction
+	sdk.DefaultPowerReduction = sdk.NewIntFromUint64(1_000_000)
+	t.Cleanup(func() {
+		sdk.DefaultPowerReduction = powerReduction
+	})
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// appStateFn returns the initial application state of the simulation
+// The base fee is disabled since the simulated blocks have no gas limit
+func appStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return simapp.AppStateFnWithExtendedCb(
+		cdc,
+		simManager,
+		app.NewDefaultGenesisState(cdc),
+		func(rawState map[string]json.RawMessage) {
+			var feemarketGenesis feemarkettypes.GenesisState
+			cdc.MustUnmarshalJSON(rawState[feemarkettypes.ModuleName], &feemarketGenesis)
+			feemarketGenesis.Params.NoBaseFee = true
+			rawState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(&feemarketGenesis)
+
+			var evmGenesis evmtypes.GenesisState
+			cdc.MustUnmarshalJSON(rawState[evmtypes.ModuleName], &evmGenesis)
+			evmGenesis.Params.EvmDenom = config.BaseDenom
+			rawState[evmtypes.ModuleName] = cdc.MustMarshalJSON(&evmGenesis)
+
+			// fund the fungible module to provide zeta liquidity to the gas coin pools
+			fungibleFunds := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, fungibleModuleFunds))
+			var bankGenesis banktypes.GenesisState
+			cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], &bankGenesis)
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+				Address: authtypes.NewModuleAddress(fungibletypes.ModuleName).String(),
+				Coins:   fungibleFunds,
+			})
+			bankGenesis.Supply = bankGenesis.Supply.Add(fungibleFunds...)
+			rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+		},
+	)
+}
+
+// newSimApp returns an app for the simulation
+func newSimApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) *app.App {
+	return app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		simapp.FlagPeriodValue,
+		app.MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+		baseAppOptions...,
+	)
+}
+
+// runSimulation runs the randomized simulation of the config on the app
+func runSimulation(t *testing.T, zetaApp *app.App, config simtypes.Config) (bool, simulation.Params, error) {
+	return simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		zetaApp.BaseApp,
+		appStateFn(zetaApp.AppCodec(), zetaApp.SimulationManager()),
+		simtypes.RandomAccounts,
+		simapp.SimulationOperations(zetaApp, zetaApp.AppCodec(), config),
+		zetaApp.ModuleAccountAddrs(),
+		config,
+		zetaApp.AppCodec(),
+	)
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	setSimPowerReduction(t)
+	config.ChainID = simChainID
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	zetaApp := newSimApp(logger, db, fauxMerkleModeOpt)
+
+	// run randomized simulation
+	_, simParams, simErr := runSimulation(t, zetaApp, config)
+
+	// export state and simParams before the simulation error is checked
+	err = simapp.CheckExportSimulation(zetaApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	setSimPowerReduction(t)
+	config.ChainID = simChainID
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	zetaApp := newSimApp(logger, db, fauxMerkleModeOpt)
+
+	// run randomized simulation
+	stopEarly, simParams, simErr := runSimulation(t, zetaApp, config)
+
+	// export state and simParams before the simulation error is checked
+	err = simapp.CheckExportSimulation(zetaApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+
+	if stopEarly {
+		fmt.Println("can't export or import a zero-validator genesis, exiting test...")
+		return
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := zetaApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	_, newDB, newDir, _, _, err := simapp.SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := newSimApp(log.NewNopLogger(), newDB, fauxMerkleModeOpt)
+
+	var genesisState app.GenesisState
+	err = json.Unmarshal(exported.AppState, &genesisState)
+	require.NoError(t, err)
+
+	ctxA := zetaApp.NewContext(true, tmproto.Header{Height: zetaApp.LastBlockHeight(), ChainID: simChainID})
+	ctxB := newApp.NewContext(true, tmproto.Header{Height: zetaApp.LastBlockHeight(), ChainID: simChainID})
+	newApp.ModuleManager().InitGenesis(ctxB, zetaApp.AppCodec(), genesisState)
+	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
+
+	fmt.Printf("comparing stores...\n")
+
+	storeKeysPrefixes := []struct {
+		key      string
+		prefixes [][]byte
+	}{
+		{authtypes.StoreKey, [][]byte{}},
+		{
+			stakingtypes.StoreKey,
+			[][]byte{
+				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+				stakingtypes.HistoricalInfoKey,
+			},
+		}, // ordering may change but it doesn't matter
+		{slashingtypes.StoreKey, [][]byte{}},
+		{distrtypes.StoreKey, [][]byte{}},
+		{banktypes.StoreKey, [][]byte{banktypes.BalancesPrefix}},
+		{paramstypes.StoreKey, [][]byte{}},
+		{govtypes.StoreKey, [][]byte{}},
+		{evidencetypes.StoreKey, [][]byte{}},
+		{authzkeeper.StoreKey, [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
+		{crosschaintypes.StoreKey, [][]byte{}},
+		{
+			observertypes.StoreKey,
+			[][]byte{observertypes.KeyPrefix(observertypes.BallotListKey)},
+		}, // ordering of the ballots of a height may change but it doesn't matter
+		{fungibletypes.StoreKey, [][]byte{}},
+		{emissionstypes.StoreKey, [][]byte{}},
+	}
+
+	for _, skp := range storeKeysPrefixes {
+		keyA, keyB := zetaApp.GetKey(skp.key), newApp.GetKey(skp.key)
+		storeA, storeB := ctxA.KVStore(keyA), ctxB.KVStore(keyB)
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.prefixes)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+
+		fmt.Printf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), keyA, keyB)
+		require.Equal(t, 0, len(failedKVAs), simapp.GetSimulationLog(
+			skp.key,
+			zetaApp.SimulationManager().StoreDecoders,
+			failedKVAs,
+			failedKVBs,
+		))
+	}
+}
+
+func TestAppStateDeterminism(t *testing.T) {
+	if !simapp.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+	setSimPowerReduction(t)
+
+	config := simapp.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = simChainID
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 5
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			var logger log.Logger
+			if simapp.FlagVerboseValue {
+				logger = log.TestingLogger()
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			db := dbm.NewMemDB()
+			zetaApp := newSimApp(logger, db)
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := runSimulation(t, zetaApp, config)
+			require.NoError(t, err)
+
+			if config.Commit {
+				simapp.PrintStats(db)
+			}
+
+			appHash := zetaApp.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/testutil/simapp"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
+	fungiblekeeper "github.com/zeta-chain/zetacore/x/fungible/keeper"
+	observerkeeper "github.com/zeta-chain/zetacore/x/observer/keeper"
+)
+
+// upgradeFixturesDir is the directory of the upgrade fixtures
+const upgradeFixturesDir = "testdata/upgrades"
+
+// upgradeFixture is the state of some stores at an older version of the app
+// The stores of the fixture are written in the state of a new app initialized with the default genesis, the fixture
+// therefore only needs to contain the stores modified by the migrations under test
+type upgradeFixture struct {
+	// Description describes the state of the fixture and where it comes from
+	Description string `json:"description"`
+
+	// Versions are the consensus versions of the modules in the fixture, the other modules are at their latest version
+	Versions module.VersionMap `json:"versions"`
+
+	// Stores are the content of the stores of the fixture
+	Stores []fixtureStore `json:"stores"`
+}
+
+// fixtureStore is the content of a store in an upgrade fixture
+type fixtureStore struct {
+	// Name is the name of the store key
+	Name string `json:"name"`
+
+	// Merge writes the entries over the content of the store instead of replacing it
+	// It is used for shared stores such as the params store
+	Merge bool `json:"merge"`
+
+	Entries []fixtureEntry `json:"entries"`
+}
+
+// fixtureEntry is a key-value pair of a store
+// The key is written as is, binary keys can use \u0000 escapes or write their binary suffix after the key in KeyHex
+// If Type is set, the value is the JSON of the protobuf message with this type name and is stored in binary,
+// otherwise the JSON value is stored as is, as for the amino JSON of the params
+type fixtureEntry struct {
+	Key    string          `json:"key"`
+	KeyHex string          `json:"key_hex,omitempty"`
+	Type   string          `json:"type,omitempty"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// upgradeTest tests the migrations of an upgrade fixture
+type upgradeTest struct {
+	// fixture is the file name of the fixture in upgradeFixturesDir
+	fixture string
+
+	// upgrade is the name of the registered upgrade handler to run
+	// If empty, the module migrations are run from the versions of the fixture
+	upgrade string
+
+	// check asserts the state after the migrations, the registered invariants and the invariants of the crosschain,
+	// fungible and observer modules are always checked
+	check func(t *testing.T, ctx sdk.Context, zetaApp *app.App)
+}
+
+// readUpgradeFixture reads an upgrade fixture, unknown fields are rejected to catch typos
+func readUpgradeFixture(name string) (upgradeFixture, error) {
+	var fixture upgradeFixture
+	// #nosec G304 -- fixtures are test data of the repository
+	bz, err := os.ReadFile(filepath.Join(upgradeFixturesDir, name))
+	if err != nil {
+		return fixture, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&fixture); err != nil {
+		return fixture, fmt.Errorf("invalid fixture %s: %w", name, err)
+	}
+	return fixture, nil
+}
+
+// writeStores writes the stores of the fixture in the state of the app
+func (f upgradeFixture) writeStores(ctx sdk.Context, zetaApp *app.App) error {
+	for _, s := range f.Stores {
+		key := zetaApp.GetKey(s.Name)
+		if key == nil {
+			return fmt.Errorf("unknown store %s", s.Name)
+		}
+		store := ctx.KVStore(key)
+
+		if !s.Merge {
+			iterator := store.Iterator(nil, nil)
+			var keys [][]byte
+			for ; iterator.Valid(); iterator.Next() {
+				keys = append(keys, iterator.Key())
+			}
+			if err := iterator.Close(); err != nil {
+				return err
+			}
+			for _, k := range keys {
+				store.Delete(k)
+			}
+		}
+
+		for _, entry := range s.Entries {
+			key, err := entry.key()
+			if err != nil {
+				return fmt.Errorf("store %s, key %q: %w", s.Name, entry.Key, err)
+			}
+			value, err := entry.encode(zetaApp.AppCodec())
+			if err != nil {
+				return fmt.Errorf("store %s, key %q: %w", s.Name, entry.Key, err)
+			}
+			store.Set(key, value)
+		}
+	}
+	return nil
+}
+
+// key returns the key of the entry as stored
+func (e fixtureEntry) key() ([]byte, error) {
+	suffix, err := hex.DecodeString(e.KeyHex)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(e.Key), suffix...), nil
+}
+
+// encode returns the value of the entry as stored
+func (e fixtureEntry) encode(cdc codec.Codec) ([]byte, error) {
+	if e.Type == "" {
+		var value bytes.Buffer
+		if err := json.Compact(&value, e.Value); err != nil {
+			return nil, err
+		}
+		return value.Bytes(), nil
+	}
+
+	msgType := proto.MessageType(e.Type)
+	if msgType == nil {
+		return nil, fmt.Errorf("unknown type %s", e.Type)
+	}
+	msg, ok := reflect.New(msgType.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("type %s is not a protobuf message", e.Type)
+	}
+	if err := cdc.UnmarshalJSON(e.Value, msg); err != nil {
+		return nil, err
+	}
+	return cdc.Marshal(msg)
+}
+
+// newUpgradeTestApp returns an app initialized with the default genesis and a validator, and a context to run the upgrades
+func newUpgradeTestApp(t *testing.T) (*app.App, sdk.Context) {
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	account := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	zetaApp := simapp.SetupWithGenesisValSet(
+		t,
+		tmtypes.NewValidatorSet([]*tmtypes.Validator{validator}),
+		[]authtypes.GenesisAccount{account},
+		sdk.DefaultPowerReduction,
+		emissionstypes.DefaultParams(),
+		nil,
+		nil,
+	)
+	ctx := zetaApp.BaseApp.NewContext(false, tmproto.Header{
+		Height: zetaApp.LastBlockHeight() + 1,
+		Time:   time.Now().UTC(),
+	})
+	return zetaApp, ctx
+}
+
+// runUpgradeTest loads the fixture of the test in a new app, runs the migrations and checks the resulting state
+func runUpgradeTest(t *testing.T, test upgradeTest) {
+	fixture, err := readUpgradeFixture(test.fixture)
+	require.NoError(t, err)
+	zetaApp, ctx := newUpgradeTestApp(t)
+	mm := zetaApp.ModuleManager()
+
+	// the modules that are not in the fixture are at their latest version
+	fromVM := mm.GetVersionMap()
+	for m, v := range fixture.Versions {
+		_, ok := fromVM[m]
+		require.True(t, ok, "unknown module %s", m)
+		fromVM[m] = v
+	}
+	require.NoError(t, fixture.writeStores(ctx, zetaApp))
+	zetaApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+	if test.upgrade != "" {
+		require.True(t, zetaApp.UpgradeKeeper.HasHandler(test.upgrade), "no handler for upgrade %s", test.upgrade)
+		require.NotPanics(t, func() {
+			zetaApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: test.upgrade, Height: ctx.BlockHeight()})
+		})
+	} else {
+		vm, err := mm.RunMigrations(ctx, zetaApp.Configurator(), fromVM)
+		require.NoError(t, err)
+		zetaApp.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+	}
+
+	// all modules must be at their latest version
+	require.Equal(t, mm.GetVersionMap(), zetaApp.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	for _, route := range zetaApp.CrisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		require.False(t, broken, msg)
+	}
+	for _, invariant := range []sdk.Invariant{
+		crosschainkeeper.AllInvariants(zetaApp.ZetaCoreKeeper),
+		fungiblekeeper.AllInvariants(zetaApp.FungibleKeeper),
+		observerkeeper.AllInvariants(*zetaApp.ZetaObserverKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+
+	if test.check != nil {
+		test.check(t, ctx, zetaApp)
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog"
@@ -9,6 +11,8 @@ import (
 	"github.com/zeta-chain/zetacore/zetaclient"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
 )
 
 func CreateAuthzSigner(granter string, grantee sdk.AccAddress) {
@@ -40,6 +44,16 @@ func CreateZetaBridge(cfg *config.Config, telemetry *zetaclient.TelemetryServer)
 		return nil, err
 	}
 
+	if cfg.RemoteSignerAddr != "" {
+		signer, err := remotesigner.NewClient(cfg.RemoteSignerAddr)
+		if err != nil {
+			return nil, err
+		}
+		if err := bridge.EnableRemoteSigner(signer); err != nil {
+			return nil, err
+		}
+	}
+
 	return bridge, nil
 }
 
@@ -88,12 +102,17 @@ func CreateChainClientMap(
 	ts *zetaclient.TelemetryServer,
 ) (map[common.Chain]zetaclient.ChainClient, error) {
 	clientMap := make(map[common.Chain]zetaclient.ChainClient)
+	storeOpts := store.Options{
+		Backend:   cfg.StoreBackend,
+		Dir:       dbpath,
+		Retention: time.Duration(cfg.StoreRetention) * time.Hour,
+	}
 	// EVM clients
 	for _, evmConfig := range cfg.GetAllEVMConfigs() {
 		if evmConfig.Chain.IsZetaChain() {
 			continue
 		}
-		co, err := zetaclient.NewEVMChainClient(bridge, tss, dbpath, metrics, logger, cfg, *evmConfig, ts)
+		co, err := zetaclient.NewEVMChainClient(bridge, tss, storeOpts, metrics, logger, cfg, *evmConfig, ts)
 		if err != nil {
 			logger.Error().Err(err).Msgf("NewEVMChainClient error for chain %s", evmConfig.Chain.String())
 			continue
@@ -103,7 +122,7 @@ func CreateChainClientMap(
 	// BTC client
 	btcChain, btcConfig, enabled := cfg.GetBTCConfig()
 	if enabled {
-		co, err := zetaclient.NewBitcoinClient(btcChain, bridge, tss, dbpath, metrics, logger, btcConfig, ts)
+		co, err := zetaclient.NewBitcoinClient(btcChain, bridge, tss, storeOpts, metrics, logger, btcConfig, ts)
 		if err != nil {
 			logger.Error().Err(err).Msgf("NewBitcoinClient error for chain %s", btcChain.String())
 
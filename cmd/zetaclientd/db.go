@@ -0,0 +1,259 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
+)
+
+var dbArgs = dbArguments{}
+
+type dbArguments struct {
+	dir     string
+	backend string
+	output  string
+}
+
+var DBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Inspect, export and compact the stores of the chain clients",
+	Long: `Inspect, export and compact the stores of the chain clients.
+The commands are run offline: zetaclientd must be stopped. The stores are migrated to the current schema version when opened.`,
+}
+
+var DBInspectCmd = &cobra.Command{
+	Use:   "inspect [store...]",
+	Short: "Show the schema version, the last scanned block and the entries of the stores, all the stores if none given",
+	RunE:  dbInspect,
+}
+
+var DBExportCmd = &cobra.Command{
+	Use:   "export [store]",
+	Short: "Export the entries of a store as JSON lines",
+	Args:  cobra.ExactArgs(1),
+	RunE:  dbExport,
+}
+
+var DBCompactCmd = &cobra.Command{
+	Use:   "compact [store...]",
+	Short: "Reclaim the space of the deleted entries of the stores, all the stores if none given",
+	RunE:  dbCompact,
+}
+
+// dbRecord is an exported entry
+type dbRecord struct {
+	Bucket    string          `json:"bucket"`
+	Key       string          `json:"key"`
+	Timestamp time.Time       `json:"timestamp"`
+	Value     json.RawMessage `json:"value"`
+}
+
+func init() {
+	RootCmd.AddCommand(DBCmd)
+	DBCmd.AddCommand(DBInspectCmd, DBExportCmd, DBCompactCmd)
+	DBCmd.PersistentFlags().StringVar(&dbArgs.dir, "dir", "", "directory of the stores (default ~/.zetaclient/chainobserver)")
+	DBCmd.PersistentFlags().StringVar(&dbArgs.backend, "backend", "", "backend of the stores (sqlite, leveldb), detected if empty")
+	DBExportCmd.Flags().StringVar(&dbArgs.output, "output", "", "file the entries are written to (default stdout)")
+}
+
+// defaultStoreDir returns the directory of the stores of the chain clients
+func defaultStoreDir() (string, error) {
+	userDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userDir, ".zetaclient/chainobserver"), nil
+}
+
+// dbStoreDir returns the directory of the stores given by the flags
+func dbStoreDir() (string, error) {
+	if dbArgs.dir != "" {
+		return dbArgs.dir, nil
+	}
+	return defaultStoreDir()
+}
+
+// dbStoreNames returns the names of the stores given as arguments, all the stores of the directory if none
+func dbStoreNames(dir string, args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+	names, err := store.List(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no store in %s", dir)
+	}
+	return names, nil
+}
+
+// dbOpen opens an existing store with the backend given by the flags or else detected
+func dbOpen(dir, name string) (store.Store, string, error) {
+	backend := dbArgs.backend
+	if backend == "" {
+		var err error
+		backend, err = store.Detect(dir, name)
+		if err != nil {
+			return nil, "", err
+		}
+	} else if _, err := os.Stat(store.Path(backend, dir, name)); err != nil {
+		return nil, "", fmt.Errorf("no %s store %s in %s", backend, name, dir)
+	}
+	s, err := store.Open(backend, dir, name)
+	if err != nil {
+		return nil, "", fmt.Errorf("error opening store %s: %w", name, err)
+	}
+	return s, backend, nil
+}
+
+func dbInspect(cmd *cobra.Command, args []string) error {
+	dir, err := dbStoreDir()
+	if err != nil {
+		return err
+	}
+	names, err := dbStoreNames(dir, args)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	for _, name := range names {
+		if err := inspectStore(out, dir, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func inspectStore(out io.Writer, dir, name string) error {
+	s, backend, err := dbOpen(dir, name)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	version, err := s.Version()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s (%s, schema version %d)\n", name, backend, version)
+	for _, bucket := range store.Buckets {
+		var count int
+		var oldest, newest time.Time
+		err := s.Iterate(bucket, func(entry store.Entry) error {
+			count++
+			if oldest.IsZero() || entry.Timestamp.Before(oldest) {
+				oldest = entry.Timestamp
+			}
+			if entry.Timestamp.After(newest) {
+				newest = entry.Timestamp
+			}
+			if bucket == store.BucketLastBlock && entry.Key == store.KeyLastBlock {
+				fmt.Fprintf(out, "  last scanned block: %s (%s)\n", entry.Value, entry.Timestamp.UTC().Format(time.RFC3339))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if bucket == store.BucketLastBlock {
+			continue
+		}
+		if count == 0 {
+			fmt.Fprintf(out, "  %s: 0 entries\n", bucket)
+			continue
+		}
+		fmt.Fprintf(out, "  %s: %d entries, oldest %s, newest %s\n", bucket, count,
+			oldest.UTC().Format(time.RFC3339), newest.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func dbExport(cmd *cobra.Command, args []string) error {
+	dir, err := dbStoreDir()
+	if err != nil {
+		return err
+	}
+	s, _, err := dbOpen(dir, args[0])
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	out := cmd.OutOrStdout()
+	if dbArgs.output != "" {
+		file, err := os.Create(filepath.Clean(dbArgs.output))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	encoder := json.NewEncoder(out)
+	for _, bucket := range store.Buckets {
+		err := s.Iterate(bucket, func(entry store.Entry) error {
+			value := entry.Value
+			if !json.Valid(value) { // exported as a string
+				if value, err = json.Marshal(string(value)); err != nil {
+					return err
+				}
+			}
+			return encoder.Encode(dbRecord{
+				Bucket:    bucket,
+				Key:       entry.Key,
+				Timestamp: entry.Timestamp.UTC(),
+				Value:     value,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dbCompact(cmd *cobra.Command, args []string) error {
+	dir, err := dbStoreDir()
+	if err != nil {
+		return err
+	}
+	names, err := dbStoreNames(dir, args)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		s, backend, err := dbOpen(dir, name)
+		if err != nil {
+			return err
+		}
+		path := store.Path(backend, dir, name)
+		sizeBefore := storeSize(path)
+		err = s.Compact()
+		if closeErr := s.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("error compacting store %s: %w", name, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s (%s): %d bytes -> %d bytes\n", name, backend, sizeBefore, storeSize(path))
+	}
+	return nil
+}
+
+// storeSize returns the size in bytes of the file or the directory of a store
+func storeSize(path string) int64 {
+	var size int64
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
 )
 
 var InitCmd = &cobra.Command{
@@ -34,6 +35,22 @@ type initArguments struct {
 	KeyringBackend      string
 	HsmMode             bool
 	HsmHotKey           string
+
+	ZRC20SupplyCheck      bool
+	ZRC20SupplyCheckPause bool
+
+	AdminAPIAddr string
+
+	VoteBatchWindow uint64
+
+	RemoteSignerAddr string
+	P2PKeyPath       string
+
+	TracingEndpoint string
+	TracingInsecure bool
+
+	StoreBackend   string
+	StoreRetention uint64
 }
 
 func init() {
@@ -58,6 +75,16 @@ func init() {
 	InitCmd.Flags().StringVar(&initArgs.KeyringBackend, "keyring-backend", string(config.KeyringBackendTest), "keyring backend to use (test, file)")
 	InitCmd.Flags().BoolVar(&initArgs.HsmMode, "hsm-mode", false, "enable hsm signer, default disabled")
 	InitCmd.Flags().StringVar(&initArgs.HsmHotKey, "hsm-hotkey", "hsm-hotkey", "name of hotkey associated with hardware security module")
+	InitCmd.Flags().BoolVar(&initArgs.ZRC20SupplyCheck, "zrc20-supply-check", false, "enable the check of the ZRC20 supplies against the holdings on the connected chains")
+	InitCmd.Flags().BoolVar(&initArgs.ZRC20SupplyCheckPause, "zrc20-supply-check-pause", false, "pause the outbound of a chain locally when its ZRC20 supply is not backed by the chain holdings")
+	InitCmd.Flags().StringVar(&initArgs.AdminAPIAddr, "admin-api-addr", "127.0.0.1:8124", "loopback address of the admin API of the operator actions (empty to disable)")
+	InitCmd.Flags().Uint64Var(&initArgs.VoteBatchWindow, "vote-batch-window", 500, "window in milliseconds within which the votes are broadcast in a single tx (0 to broadcast the votes one by one)")
+	InitCmd.Flags().StringVar(&initArgs.RemoteSignerAddr, "remote-signer-addr", "", "address of the remote signer of the hot key txs, host:port or unix:///path (empty to sign with the keyring)")
+	InitCmd.Flags().StringVar(&initArgs.P2PKeyPath, "p2p-key-path", "", "file of the hex encoded private key of the TSS p2p identity, required with the remote signer")
+	InitCmd.Flags().StringVar(&initArgs.TracingEndpoint, "tracing-endpoint", "", "OTLP gRPC endpoint (host:port) the spans of the cctxs are exported to (empty to disable)")
+	InitCmd.Flags().BoolVar(&initArgs.TracingInsecure, "tracing-insecure", false, "disable the TLS of the connection to the OTLP endpoint")
+	InitCmd.Flags().StringVar(&initArgs.StoreBackend, "store-backend", store.BackendSQLite, "backend of the stores of the chain clients (sqlite, leveldb)")
+	InitCmd.Flags().Uint64Var(&initArgs.StoreRetention, "store-retention", 72, "retention in hours of the data of the finalized outbound txs in the stores (0 to never prune)")
 }
 
 func Initialize(_ *cobra.Command, _ []string) error {
@@ -95,6 +122,16 @@ func Initialize(_ *cobra.Command, _ []string) error {
 	configData.KeyringBackend = config.KeyringBackend(initArgs.KeyringBackend)
 	configData.HsmMode = initArgs.HsmMode
 	configData.HsmHotKey = initArgs.HsmHotKey
+	configData.ZRC20SupplyCheck = initArgs.ZRC20SupplyCheck
+	configData.ZRC20SupplyCheckPause = initArgs.ZRC20SupplyCheckPause
+	configData.AdminAPIAddr = initArgs.AdminAPIAddr
+	configData.VoteBatchWindow = initArgs.VoteBatchWindow
+	configData.RemoteSignerAddr = initArgs.RemoteSignerAddr
+	configData.P2PKeyPath = initArgs.P2PKeyPath
+	configData.TracingEndpoint = initArgs.TracingEndpoint
+	configData.TracingInsecure = initArgs.TracingInsecure
+	configData.StoreBackend = initArgs.StoreBackend
+	configData.StoreRetention = initArgs.StoreRetention
 
 	//Save config file
 	return config.Save(&configData, rootArgs.zetaCoreHome)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/rs/zerolog"
 	"github.com/tendermint/crypto/sha3"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -60,6 +61,13 @@ func GenerateTss(logger zerolog.Logger,
 	// Note : The TSS generation is done through the "hotkey" or "Zeta-clientGrantee" This key needs to be present on the machine for the TSS signing to happen .
 	// "ZetaClientGrantee" key is different from the "operator" key .The "Operator" key gives all zetaclient related permissions such as TSS generation ,reporting and signing, INBOUND and OUTBOUND vote signing, to the "ZetaClientGrantee" key.
 	// The votes to signify a successful TSS generation (Or unsuccessful) is signed by the operator key and broadcast to zetacore by the zetcalientGrantee key on behalf of the operator .
+	// The keygen grantee key is the p2p key of the node, the hot key or the separate p2p key with the remote signer
+	pubkeySet, err := mc.GetPubKeySetFromPrivKey(&cosmossecp256k1.PrivKey{Key: priKey})
+	if err != nil {
+		keygenLogger.Error().Err(err).Msg("GetPubKeySet error")
+		return nil, err
+	}
+	granteePubkey := pubkeySet.Secp256k1.String()
 	ticker := time.NewTicker(time.Second * 1)
 	triedKeygenAtBlock := false
 	lastBlock := int64(0)
@@ -100,6 +108,11 @@ func GenerateTss(logger zerolog.Logger,
 				}
 				// Try keygen only once at a particular block, irrespective of whether it is successful or failure
 				triedKeygenAtBlock = true
+				// Nodes excluded from the keygen, e.g. blamed for a failed keygen being retried, do not take part in the ceremony
+				if !isKeygenGrantee(keyGen, granteePubkey) {
+					keygenLogger.Warn().Msgf("Node is not a grantee of the keygen at block %d, skipping keygen", keyGen.BlockNumber)
+					continue
+				}
 				err = keygenTss(cfg, tss, keygenLogger)
 				if err != nil {
 					keygenLogger.Error().Err(err).Msg("keygenTss error")
@@ -144,6 +157,16 @@ func GenerateTss(logger zerolog.Logger,
 	return nil, errors.New("unexpected state for TSS generation")
 }
 
+// isKeygenGrantee returns true if the grantee pubkey is part of the keygen signers
+func isKeygenGrantee(keyGen observertypes.Keygen, granteePubkey string) bool {
+	for _, pubkey := range keyGen.GranteePubkeys {
+		if pubkey == granteePubkey {
+			return true
+		}
+	}
+	return false
+}
+
 func keygenTss(cfg *config.Config, tss *mc.TSS, keygenLogger zerolog.Logger) error {
 
 	keyGen := cfg.GetKeygen()
@@ -158,7 +181,7 @@ func keygenTss(cfg *config.Config, tss *mc.TSS, keygenLogger zerolog.Logger) err
 		if err != nil {
 			return err
 		}
-		index := fmt.Sprintf("keygen-%s-%d", digest, keyGen.BlockNumber)
+		index := observertypes.GetKeygenBlameIndex(digest, keyGen.BlockNumber)
 		zetaHash, err := tss.CoreBridge.PostBlameData(&res.Blame, tss.CoreBridge.ZetaChain().ChainId, index)
 		if err != nil {
 			keygenLogger.Error().Err(err).Msg("error sending blame data to core")
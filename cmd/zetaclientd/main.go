@@ -5,6 +5,7 @@ import (
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/rs/zerolog"
 	ecdsakeygen "github.com/zeta-chain/tss-lib/ecdsa/keygen"
+	mc "github.com/zeta-chain/zetacore/zetaclient"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 
 	"github.com/zeta-chain/zetacore/cmd"
@@ -51,19 +52,22 @@ func SetupConfigForTest() {
 
 }
 
-func InitLogger(cfg *config.Config) zerolog.Logger {
-	var logger zerolog.Logger
+// InitLogger returns the logger of the client and the writer of the logger filtering the events with the level of their module
+func InitLogger(cfg *config.Config) (zerolog.Logger, *mc.ModuleLevelWriter) {
+	// the levels are checked by the writer so that the level of a module can be changed at runtime
+	var logWriter *mc.ModuleLevelWriter
 	switch cfg.LogFormat {
 	case "json":
-		logger = zerolog.New(os.Stdout).Level(zerolog.Level(cfg.LogLevel)).With().Timestamp().Logger()
+		logWriter = mc.NewModuleLevelWriter(os.Stdout, zerolog.Level(cfg.LogLevel))
 	case "text":
-		logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}).Level(zerolog.Level(cfg.LogLevel)).With().Timestamp().Logger()
+		logWriter = mc.NewModuleLevelWriter(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}, zerolog.Level(cfg.LogLevel))
 	default:
-		logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339})
+		logWriter = mc.NewModuleLevelWriter(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}, zerolog.TraceLevel)
 	}
+	logger := zerolog.New(logWriter).Level(zerolog.TraceLevel).With().Timestamp().Logger()
 
 	if cfg.LogSampler {
 		logger = logger.Sample(&zerolog.BasicSampler{N: 5})
 	}
-	return logger
+	return logger, logWriter
 }
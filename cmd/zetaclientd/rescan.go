@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/common"
+	mc "github.com/zeta-chain/zetacore/zetaclient"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+var rescanArgs = rescanArguments{}
+
+type rescanArguments struct {
+	dryRun bool
+}
+
+var RescanCmd = &cobra.Command{
+	Use:   "rescan [chain-id] [start-block] [end-block]",
+	Short: "Rescan a block range of a chain for missed inbound txs and vote them",
+	Long: `Rescan a block range of a chain for missed inbound txs and vote them.
+The rescan is run by the running zetaclientd through its admin API, the inbound txs with a ballot or a cctx on zetacore are not voted.`,
+	Args: cobra.ExactArgs(3),
+	RunE: rescan,
+}
+
+func init() {
+	RootCmd.AddCommand(RescanCmd)
+	RescanCmd.Flags().BoolVar(&rescanArgs.dryRun, "dry-run", false, "only list the missed inbound txs without voting them")
+}
+
+func rescan(_ *cobra.Command, args []string) error {
+	err := setHomeDir()
+	if err != nil {
+		return err
+	}
+	chainID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chain id: %w", err)
+	}
+	startBlock, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid start block: %w", err)
+	}
+	endBlock, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid end block: %w", err)
+	}
+
+	cfg, err := config.Load(rootArgs.zetaCoreHome)
+	if err != nil {
+		return err
+	}
+	if cfg.AdminAPIAddr == "" {
+		return errors.New("the admin API is disabled, set AdminAPIAddr in the config")
+	}
+	// #nosec G304 -- the token file is in the home folder of the client
+	token, err := os.ReadFile(config.AdminTokenPath(rootArgs.zetaCoreHome))
+	if err != nil {
+		return fmt.Errorf("error reading the admin token, the client must be started once: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"start_block": startBlock,
+		"end_block":   endBlock,
+		"dry_run":     rescanArgs.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("http://%s/chains/%d/rescan/range", cfg.AdminAPIAddr, chainID)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("rescan failed: %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var result mc.RescanResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// rescanInTxs runs the rescans of the config with the chain clients, the errors are logged
+func rescanInTxs(rescans []config.InTxRescan, chainClientMap map[common.Chain]mc.ChainClient, logger zerolog.Logger) {
+	for _, r := range rescans {
+		chain := common.GetChainFromChainID(r.ChainID)
+		if chain == nil {
+			logger.Error().Msgf("rescanInTxs: chain %d not found", r.ChainID)
+			continue
+		}
+		client, found := chainClientMap[*chain]
+		if !found {
+			logger.Error().Msgf("rescanInTxs: chain client not found for chain %d", r.ChainID)
+			continue
+		}
+		result, err := client.RescanInTx(r.StartBlock, r.EndBlock, true)
+		if err != nil {
+			logger.Error().Err(err).Msgf("rescanInTxs: error rescanning blocks %d to %d of chain %d", r.StartBlock, r.EndBlock, r.ChainID)
+			continue
+		}
+		logger.Info().Msgf(
+			"rescanInTxs: rescanned blocks %d to %d of chain %d: found %d inbound txs, %d missed, %d voted",
+			r.StartBlock,
+			r.EndBlock,
+			r.ChainID,
+			result.Found,
+			len(result.Missed),
+			len(result.Voted),
+		)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,14 +12,17 @@ import (
 	"syscall"
 	"time"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/libp2p/go-libp2p/core"
 	maddr "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/zeta-chain/go-tss/p2p"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
 	mc "github.com/zeta-chain/zetacore/zetaclient"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
@@ -50,7 +54,8 @@ func start(_ *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	log.Logger = InitLogger(cfg)
+	var logWriter *mc.ModuleLevelWriter
+	log.Logger, logWriter = InitLogger(cfg)
 	//Wait until zetacore has started
 	if len(cfg.Peer) != 0 {
 		err := validatePeer(cfg.Peer)
@@ -63,6 +68,21 @@ func start(_ *cobra.Command, _ []string) error {
 	masterLogger := log.Logger
 	startLogger := masterLogger.With().Str("module", "startup").Logger()
 
+	// Tracing: the spans of the cctxs are exported to the OTLP collector
+	if cfg.TracingEndpoint != "" {
+		shutdownTracing, err := tracing.Setup(context.Background(), "zetaclientd", cfg.TracingEndpoint, cfg.TracingInsecure)
+		if err != nil {
+			startLogger.Error().Err(err).Msg("tracing setup error")
+			return err
+		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				startLogger.Error().Err(err).Msg("tracing shutdown error")
+			}
+		}()
+		startLogger.Info().Msgf("exporting the spans of the cctxs to %s", cfg.TracingEndpoint)
+	}
+
 	waitForZetaCore(cfg, startLogger)
 	startLogger.Info().Msgf("ZetaCore is ready , Trying to connect to %s", cfg.Peer)
 
@@ -113,6 +133,11 @@ func start(_ *cobra.Command, _ []string) error {
 	}
 	startLogger.Info().Msgf("Config is updated from ZetaCore %s", maskCfg(cfg))
 
+	// VoteAggregator: votes are collected within a window and broadcast in a single tx
+	if cfg.VoteBatchWindow > 0 {
+		zetaBridge.EnableVoteAggregator(time.Duration(cfg.VoteBatchWindow) * time.Millisecond)
+	}
+
 	// ConfigUpdater: A polling goroutine checks and updates core parameters at every height. Zetacore stores core parameters for all clients
 	go zetaBridge.ConfigUpdater(cfg)
 
@@ -120,9 +145,11 @@ func start(_ *cobra.Command, _ []string) error {
 	// The bridgePk is private key for the Hotkey. The Hotkey is used to sign all inbound transactions
 	// Each node processes a portion of the key stored in ~/.tss by default . Custom location can be specified in config file during init.
 	// After generating the key , the address is set on the zetacore
-	bridgePk, err := zetaBridge.GetKeys().GetPrivateKey()
+	// With the remote signer, the hot key is not on the host and the p2p key is read from the p2p key file
+	bridgePk, err := getP2PKey(cfg, zetaBridge.GetKeys())
 	if err != nil {
-		startLogger.Error().Err(err).Msg("zetabridge getPrivateKey error")
+		startLogger.Error().Err(err).Msg("zetabridge getP2PKey error")
+		return err
 	}
 	startLogger.Debug().Msgf("bridgePk %s", bridgePk.String())
 	if len(bridgePk.Bytes()) != 32 {
@@ -218,12 +245,11 @@ func start(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	userDir, err := os.UserHomeDir()
+	dbpath, err := defaultStoreDir()
 	if err != nil {
 		log.Error().Err(err).Msg("os.UserHomeDir")
 		return err
 	}
-	dbpath := filepath.Join(userDir, ".zetaclient/chainobserver")
 
 	// CreateChainClientMap : This creates a map of all chain clients . Each chain client is responsible for listening to events on the chain and processing them
 	chainClientMap, err := CreateChainClientMap(zetaBridge, tss, dbpath, metrics, masterLogger, cfg, telemetryServer)
@@ -239,12 +265,29 @@ func start(_ *cobra.Command, _ []string) error {
 		for _, v := range chainClientMap {
 			v.Start()
 		}
+
+		// rescan the block ranges of the config for missed inbound txs
+		if len(cfg.InTxRescans) > 0 {
+			go rescanInTxs(cfg.InTxRescans, chainClientMap, startLogger)
+		}
 	}
 
 	// CreateCoreObserver : Core observer wraps the zetacore bridge and adds the client and signer maps to it . This is the high level object used for CCTX interactions
 	mo1 := mc.NewCoreObserver(zetaBridge, signerMap, chainClientMap, metrics, masterLogger, cfg, telemetryServer)
 	mo1.MonitorCore()
 
+	// start the admin API of the operator actions
+	if cfg.AdminAPIAddr != "" {
+		adminServer, err := startAdminServer(cfg, mo1, logWriter, masterLogger)
+		if err != nil {
+			startLogger.Error().Err(err).Msg("startAdminServer error")
+			return err
+		}
+		defer func() {
+			_ = adminServer.Stop()
+		}()
+	}
+
 	// start zeta supply checker
 	// TODO: enable
 	// https://github.com/zeta-chain/node/issues/1354
@@ -259,6 +302,17 @@ func start(_ *cobra.Command, _ []string) error {
 	//	defer zetaSupplyChecker.Stop()
 	//}
 
+	// start zrc20 supply checker
+	if cfg.ZRC20SupplyCheck {
+		zrc20SupplyChecker, err := mc.NewZRC20SupplyChecker(cfg, zetaBridge, metrics, masterLogger)
+		if err != nil {
+			startLogger.Err(err).Msg("NewZRC20SupplyChecker")
+		} else {
+			go zrc20SupplyChecker.Start()
+			defer zrc20SupplyChecker.Stop()
+		}
+	}
+
 	startLogger.Info().Msgf("awaiting the os.Interrupt, syscall.SIGTERM signals...")
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
@@ -274,6 +328,41 @@ func start(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
+// startAdminServer starts the admin API, the token file is created on the first start
+func startAdminServer(
+	cfg *config.Config,
+	coreObserver *mc.CoreObserver,
+	logWriter *mc.ModuleLevelWriter,
+	logger zerolog.Logger,
+) (*mc.AdminServer, error) {
+	token, err := mc.LoadOrCreateAdminToken(config.AdminTokenPath(cfg.ZetaCoreHome))
+	if err != nil {
+		return nil, err
+	}
+	adminServer, err := mc.NewAdminServer(cfg.AdminAPIAddr, token, coreObserver, logWriter, logger)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := adminServer.Start(); err != nil {
+			logger.Error().Err(err).Msg("adminServer error")
+		}
+	}()
+	return adminServer, nil
+}
+
+// getP2PKey returns the private key of the TSS p2p identity of the node, the hot key or the p2p key of the p2p key file
+// when the txs of the hot key are signed by the remote signer
+func getP2PKey(cfg *config.Config, keys *mc.Keys) (cryptotypes.PrivKey, error) {
+	if cfg.RemoteSignerAddr == "" {
+		return keys.GetPrivateKey()
+	}
+	if cfg.P2PKeyPath == "" {
+		return nil, errors.New("the p2p key file must be set when the txs of the hot key are signed by the remote signer")
+	}
+	return mc.LoadP2PKey(cfg.P2PKeyPath)
+}
+
 func initPeers(peer string) (p2p.AddrList, error) {
 	var peers p2p.AddrList
 
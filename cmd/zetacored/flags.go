@@ -11,6 +11,12 @@ var KeyAddCommand = []string{"keys", "add"}
 const (
 	HDPathFlag     = "hd-path"
 	HDPathEthereum = "m/44'/60'/0'/0/0"
+
+	// TracingEndpointFlag is the OTLP gRPC endpoint the spans of the cctx votes are exported to, disabled if empty
+	TracingEndpointFlag = "tracing.otlp-endpoint"
+
+	// TracingInsecureFlag disables the TLS of the connection to the OTLP endpoint
+	TracingInsecureFlag = "tracing.otlp-insecure"
 )
 
 // SetEthereumHDPath sets the default HD path to Ethereum's
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	tmcfg "github.com/tendermint/tendermint/config"
 	"github.com/zeta-chain/zetacore/app"
 	zetacoredconfig "github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	zevmserver "github.com/zeta-chain/zetacore/server"
 	servercfg "github.com/zeta-chain/zetacore/server/config"
 
@@ -139,10 +141,20 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appparams.EncodingConfig
 	)
 
 	ac := appCreator{
-		encCfg: encodingConfig,
+		encCfg:          encodingConfig,
+		shutdownTracing: new(func(context.Context) error),
 	}
 	zevmserver.AddCommands(rootCmd, zevmserver.NewDefaultStartOptions(ac.newApp, app.DefaultNodeHome), ac.appExport, addModuleInitFlags)
 
+	// flush the spans still buffered by the tracing exporter once the node has stopped
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "start" {
+			cmd.PostRunE = func(_ *cobra.Command, _ []string) error {
+				return ac.stopTracing()
+			}
+		}
+	}
+
 	// the ethermintserver one supercedes the sdk one
 	//server.AddCommands(rootCmd, app.DefaultNodeHome, ac.newApp, ac.createSimappAndExport, addModuleInitFlags)
 
@@ -165,6 +177,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appparams.EncodingConfig
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().String(TracingEndpointFlag, "", "OTLP gRPC endpoint (host:port) the spans of the cctx votes are exported to, disabled if empty")
+	startCmd.Flags().Bool(TracingInsecureFlag, false, "disable the TLS of the connection to the OTLP endpoint")
 }
 
 func queryCommand() *cobra.Command {
@@ -219,6 +233,20 @@ func txCommand() *cobra.Command {
 
 type appCreator struct {
 	encCfg appparams.EncodingConfig
+
+	// shutdownTracing is set by newApp when the tracing exporter is enabled
+	shutdownTracing *func(context.Context) error
+}
+
+// stopTracing shuts down the tracing exporter if newApp has set it up
+func (ac appCreator) stopTracing() error {
+	if ac.shutdownTracing == nil || *ac.shutdownTracing == nil {
+		return nil
+	}
+	if err := (*ac.shutdownTracing)(context.Background()); err != nil {
+		return fmt.Errorf("tracing shutdown error: %w", err)
+	}
+	return nil
 }
 
 func (ac appCreator) newApp(
@@ -256,6 +284,16 @@ func (ac appCreator) newApp(
 		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
+
+	if endpoint := cast.ToString(appOpts.Get(TracingEndpointFlag)); endpoint != "" {
+		shutdownTracing, err := tracing.Setup(context.Background(), "zetacored", endpoint, cast.ToBool(appOpts.Get(TracingInsecureFlag)))
+		if err != nil {
+			panic(err)
+		}
+		if ac.shutdownTracing != nil {
+			*ac.shutdownTracing = shutdownTracing
+		}
+	}
 	return app.New(logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
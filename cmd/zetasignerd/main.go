@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/cmd"
+	"github.com/zeta-chain/zetacore/common/cosmos"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
+	"google.golang.org/grpc"
+)
+
+// hotkeyPasswordEnvVar is the environment variable of the password of the file keyring
+const hotkeyPasswordEnvVar = "HOTKEY_PASSWORD"
+
+var RootCmd = &cobra.Command{
+	Use:   "zetasignerd",
+	Short: "Reference remote signer of the zetaclient hot key",
+}
+
+var StartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start the remote signer",
+	RunE:  start,
+}
+
+type startArguments struct {
+	listenAddr     string
+	home           string
+	keyringBackend string
+	keyName        string
+	chainID        string
+	rateLimit      int
+	stateFile      string
+}
+
+var startArgs = startArguments{}
+
+func init() {
+	RootCmd.AddCommand(StartCmd)
+	StartCmd.Flags().StringVar(&startArgs.listenAddr, "listen-addr", "127.0.0.1:8125", "address the remote signer listens on, host:port or unix:///path")
+	StartCmd.Flags().StringVar(&startArgs.home, "home", app.DefaultNodeHome, "home directory of the keyring of the hot key")
+	StartCmd.Flags().StringVar(&startArgs.keyringBackend, "keyring-backend", keyring.BackendTest, "keyring backend of the hot key (test, file)")
+	StartCmd.Flags().StringVar(&startArgs.keyName, "key-name", "hotkey", "name of the hot key in the keyring")
+	StartCmd.Flags().StringVar(&startArgs.chainID, "chain-id", "athens_7001-1", "chain id of the signed txs")
+	StartCmd.Flags().IntVar(&startArgs.rateLimit, "rate-limit", 600, "maximum number of txs signed per minute (0 to disable)")
+	StartCmd.Flags().StringVar(&startArgs.stateFile, "state-file", "", "file of the signed votes for the double vote protection (default <home>/config/zetasignerd_votes.jsonl)")
+}
+
+func main() {
+	if err := RootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func start(_ *cobra.Command, _ []string) error {
+	setupConfig()
+	logger := log.Logger.With().Str("module", "zetasignerd").Logger()
+
+	encodingCfg := app.MakeEncodingConfig()
+	privKey, err := loadHotkey(encodingCfg)
+	if err != nil {
+		return err
+	}
+
+	stateFile := startArgs.stateFile
+	if stateFile == "" {
+		stateFile = filepath.Join(startArgs.home, "config", "zetasignerd_votes.jsonl")
+	}
+	policy, err := remotesigner.NewPolicy(remotesigner.DefaultAllowedMsgTypes(), startArgs.rateLimit, stateFile)
+	if err != nil {
+		return err
+	}
+
+	listener, err := remotesigner.Listen(startArgs.listenAddr)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	remotesigner.RegisterRemoteSignerServer(server, remotesigner.NewServer(
+		privKey,
+		startArgs.chainID,
+		policy,
+		encodingCfg.Codec,
+		logger,
+	))
+
+	go func() {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+		sig := <-ch
+		logger.Info().Msgf("stop signal received: %s", sig)
+		server.GracefulStop()
+	}()
+
+	logger.Info().Msgf("remote signer of %s listening on %s", sdk.AccAddress(privKey.PubKey().Address()), startArgs.listenAddr)
+	return server.Serve(listener)
+}
+
+// loadHotkey loads the private key of the hot key from the keyring, the password of the file keyring is read from
+// the HOTKEY_PASSWORD environment variable
+func loadHotkey(encodingCfg params.EncodingConfig) (cryptotypes.PrivKey, error) {
+	backend := startArgs.keyringBackend
+	if backend != keyring.BackendTest && backend != keyring.BackendFile {
+		return nil, fmt.Errorf("invalid keyring backend %s", backend)
+	}
+	password := ""
+	buf := bytes.NewBufferString("")
+	if backend == keyring.BackendFile {
+		password = os.Getenv(hotkeyPasswordEnvVar)
+		if password == "" {
+			return nil, fmt.Errorf("%s environment variable is not defined", hotkeyPasswordEnvVar)
+		}
+		// the library used by keyring is using ReadLine , which expect a new line
+		buf.WriteString(password + "\n" + password + "\n")
+	}
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), backend, startArgs.home, buf, encodingCfg.Codec)
+	if err != nil {
+		return nil, err
+	}
+	armor, err := kb.ExportPrivKeyArmor(startArgs.keyName, password)
+	if err != nil {
+		return nil, err
+	}
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, password)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unarmor private key: %w", err)
+	}
+	return privKey, nil
+}
+
+func setupConfig() {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	config := cosmos.GetConfig()
+	config.SetBech32PrefixForAccount(cmd.Bech32PrefixAccAddr, cmd.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(cmd.Bech32PrefixValAddr, cmd.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(cmd.Bech32PrefixConsAddr, cmd.Bech32PrefixConsPub)
+}
@@ -0,0 +1,133 @@
+package beacon
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// PublicKeyLength is the length of a compressed BLS public key
+	PublicKeyLength = 48
+
+	// SerializedPublicKeyLength is the length of an uncompressed BLS public key
+	SerializedPublicKeyLength = 96
+
+	// SignatureLength is the length of a compressed BLS signature
+	SignatureLength = 96
+
+	// SignatureDST is the domain separation tag of the hash to curve of the BLS signatures of the beacon chain
+	SignatureDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+	secretKeyLength = 32
+)
+
+// The BLS signatures of the beacon chain have the public keys in G1 and the signatures in G2, the points are decoded,
+// checked and verified with blst: https://github.com/supranational/blst
+
+// DecompressPublicKey decodes a compressed BLS public key and checks it is a valid point of G1 that is not the identity
+func DecompressPublicKey(in []byte) (*blst.P1Affine, error) {
+	if len(in) != PublicKeyLength {
+		return nil, fmt.Errorf("invalid public key length %d", len(in))
+	}
+	p := new(blst.P1Affine).Uncompress(in)
+	if p == nil {
+		return nil, errors.New("invalid public key encoding")
+	}
+	if !p.KeyValidate() {
+		return nil, errors.New("public key is the identity or not in the G1 subgroup")
+	}
+	return p, nil
+}
+
+// CompressPublicKey encodes a point of G1 into a compressed BLS public key
+func CompressPublicKey(p *blst.P1Affine) []byte {
+	return p.Compress()
+}
+
+// SerializePublicKey encodes a point of G1 into an uncompressed BLS public key, decoded without the square root of
+// the decompression
+func SerializePublicKey(p *blst.P1Affine) []byte {
+	return p.Serialize()
+}
+
+// DeserializeValidatedPublicKey decodes an uncompressed BLS public key without the subgroup check, the public key
+// must have been validated with DecompressPublicKey
+func DeserializeValidatedPublicKey(in []byte) (*blst.P1Affine, error) {
+	if len(in) != SerializedPublicKeyLength {
+		return nil, fmt.Errorf("invalid serialized public key length %d", len(in))
+	}
+	p := new(blst.P1Affine).Deserialize(in)
+	if p == nil {
+		return nil, errors.New("invalid serialized public key encoding")
+	}
+	return p, nil
+}
+
+// DecompressSignature decodes a compressed BLS signature and checks it is a valid point of G2 that is not the identity
+func DecompressSignature(in []byte) (*blst.P2Affine, error) {
+	if len(in) != SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d", len(in))
+	}
+	p := new(blst.P2Affine).Uncompress(in)
+	if p == nil {
+		return nil, errors.New("invalid signature encoding")
+	}
+	if !p.SigValidate(true) {
+		return nil, errors.New("signature is the identity or not in the G2 subgroup")
+	}
+	return p, nil
+}
+
+// CompressSignature encodes a point of G2 into a compressed BLS signature
+func CompressSignature(p *blst.P2Affine) []byte {
+	return p.Compress()
+}
+
+// FastAggregateVerify verifies the aggregate signature of a message by all the public keys, the public keys must have
+// been validated
+func FastAggregateVerify(pubkeys []*blst.P1Affine, msg []byte, signature []byte) error {
+	if len(pubkeys) == 0 {
+		return errors.New("no public key")
+	}
+	sig, err := DecompressSignature(signature)
+	if err != nil {
+		return err
+	}
+	if !sig.FastAggregateVerify(false, pubkeys, msg, []byte(SignatureDST)) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// Sign returns the compressed BLS signature of a message
+func Sign(secretKey *big.Int, msg []byte) ([]byte, error) {
+	sk, err := decodeSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(blst.P2Affine).Sign(sk, msg, []byte(SignatureDST)).Compress(), nil
+}
+
+// PublicKey returns the compressed BLS public key of a secret key, the secret key must be valid
+func PublicKey(secretKey *big.Int) []byte {
+	sk, err := decodeSecretKey(secretKey)
+	if err != nil {
+		panic(err)
+	}
+	return new(blst.P1Affine).From(sk).Compress()
+}
+
+// decodeSecretKey returns the scalar of a secret key, the secret key must be in [1, r)
+func decodeSecretKey(secretKey *big.Int) (*blst.SecretKey, error) {
+	if secretKey.Sign() <= 0 || secretKey.BitLen() > 8*secretKeyLength {
+		return nil, errors.New("invalid secret key")
+	}
+	sk := new(blst.SecretKey).Deserialize(secretKey.FillBytes(make([]byte, secretKeyLength)))
+	if sk == nil || !sk.Valid() {
+		return nil, errors.New("invalid secret key")
+	}
+	return sk, nil
+}
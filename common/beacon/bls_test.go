@@ -0,0 +1,232 @@
+package beacon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// signature test vector of the consensus specs tests
+const (
+	testSecretKey = "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+	testPublicKey = "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	testSignature = "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	require.NoError(t, err)
+	return b
+}
+
+func TestSign(t *testing.T) {
+	secretKey, ok := new(big.Int).SetString(testSecretKey, 16)
+	require.True(t, ok)
+	msg := make([]byte, 32)
+
+	require.Equal(t, testPublicKey, hex.EncodeToString(PublicKey(secretKey)))
+	signature, err := Sign(secretKey, msg)
+	require.NoError(t, err)
+	require.Equal(t, testSignature, hex.EncodeToString(signature))
+}
+
+func TestCompression(t *testing.T) {
+	pubkey, err := DecompressPublicKey(mustDecodeHex(t, testPublicKey))
+	require.NoError(t, err)
+	require.Equal(t, testPublicKey, hex.EncodeToString(CompressPublicKey(pubkey)))
+
+	signature, err := DecompressSignature(mustDecodeHex(t, testSignature))
+	require.NoError(t, err)
+	require.Equal(t, testSignature, hex.EncodeToString(CompressSignature(signature)))
+
+	t.Run("should fail on invalid lengths", func(t *testing.T) {
+		_, err := DecompressPublicKey(mustDecodeHex(t, testPublicKey)[1:])
+		require.Error(t, err)
+		_, err = DecompressSignature(mustDecodeHex(t, testSignature)[1:])
+		require.Error(t, err)
+	})
+
+	t.Run("should fail on the point at infinity", func(t *testing.T) {
+		infinity := make([]byte, PublicKeyLength)
+		infinity[0] = 0xc0
+		_, err := DecompressPublicKey(infinity)
+		require.Error(t, err)
+	})
+}
+
+func TestSerializePublicKey(t *testing.T) {
+	pubkey, err := DecompressPublicKey(mustDecodeHex(t, testPublicKey))
+	require.NoError(t, err)
+	serialized := SerializePublicKey(pubkey)
+	require.Len(t, serialized, SerializedPublicKeyLength)
+
+	deserialized, err := DeserializeValidatedPublicKey(serialized)
+	require.NoError(t, err)
+	require.Equal(t, testPublicKey, hex.EncodeToString(CompressPublicKey(deserialized)))
+
+	t.Run("should fail on an invalid serialized public key", func(t *testing.T) {
+		_, err := DeserializeValidatedPublicKey(serialized[1:])
+		require.Error(t, err)
+		invalid := append([]byte{}, serialized...)
+		invalid[SerializedPublicKeyLength-1] ^= 0x01
+		_, err = DeserializeValidatedPublicKey(invalid)
+		require.Error(t, err)
+	})
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	msg := []byte("light client")
+	secretKeys := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	pubkeys := make([]*blst.P1Affine, len(secretKeys))
+	aggregateKey := new(big.Int)
+	for i, sk := range secretKeys {
+		p, err := DecompressPublicKey(PublicKey(sk))
+		require.NoError(t, err)
+		pubkeys[i] = p
+		aggregateKey.Add(aggregateKey, sk)
+	}
+	signature, err := Sign(aggregateKey, msg)
+	require.NoError(t, err)
+
+	require.NoError(t, FastAggregateVerify(pubkeys, msg, signature))
+	require.Error(t, FastAggregateVerify(pubkeys[1:], msg, signature))
+	require.Error(t, FastAggregateVerify(pubkeys, []byte("other message"), signature))
+	require.Error(t, FastAggregateVerify(nil, msg, signature))
+}
+
+// blsTestCase is a test vector in the format of the BLS test vectors of the consensus specs
+type blsTestCase struct {
+	Input  json.RawMessage `json:"input"`
+	Output json.RawMessage `json:"output"`
+}
+
+// runBLSTestVectors runs the test vectors of a handler of testdata/bls
+func runBLSTestVectors(t *testing.T, handler string, run func(t *testing.T, input json.RawMessage, output json.RawMessage)) {
+	files, err := filepath.Glob(filepath.Join("testdata", "bls", handler, "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".json"), func(t *testing.T) {
+			b, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var tc blsTestCase
+			require.NoError(t, json.Unmarshal(b, &tc))
+			run(t, tc.Input, tc.Output)
+		})
+	}
+}
+
+// verifyVector returns true if the signature of the message by the public keys is valid, the public keys are
+// validated as the keys of a sync committee
+func verifyVector(t *testing.T, pubkeys []string, message string, signature string) bool {
+	points := make([]*blst.P1Affine, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		p, err := DecompressPublicKey(mustDecodeHex(t, pubkey))
+		if err != nil {
+			return false
+		}
+		points[i] = p
+	}
+	return FastAggregateVerify(points, mustDecodeHex(t, message), mustDecodeHex(t, signature)) == nil
+}
+
+func TestBLSVectors(t *testing.T) {
+	t.Run("sign", func(t *testing.T) {
+		runBLSTestVectors(t, "sign", func(t *testing.T, input json.RawMessage, output json.RawMessage) {
+			var in struct {
+				Privkey string `json:"privkey"`
+				Message string `json:"message"`
+			}
+			require.NoError(t, json.Unmarshal(input, &in))
+			var expected *string
+			require.NoError(t, json.Unmarshal(output, &expected))
+
+			signature, err := Sign(new(big.Int).SetBytes(mustDecodeHex(t, in.Privkey)), mustDecodeHex(t, in.Message))
+			if expected == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, strings.TrimPrefix(*expected, "0x"), hex.EncodeToString(signature))
+		})
+	})
+
+	t.Run("verify", func(t *testing.T) {
+		runBLSTestVectors(t, "verify", func(t *testing.T, input json.RawMessage, output json.RawMessage) {
+			var in struct {
+				Pubkey    string `json:"pubkey"`
+				Message   string `json:"message"`
+				Signature string `json:"signature"`
+			}
+			require.NoError(t, json.Unmarshal(input, &in))
+			var expected bool
+			require.NoError(t, json.Unmarshal(output, &expected))
+			require.Equal(t, expected, verifyVector(t, []string{in.Pubkey}, in.Message, in.Signature))
+		})
+	})
+
+	t.Run("fast_aggregate_verify", func(t *testing.T) {
+		runBLSTestVectors(t, "fast_aggregate_verify", func(t *testing.T, input json.RawMessage, output json.RawMessage) {
+			var in struct {
+				Pubkeys   []string `json:"pubkeys"`
+				Message   string   `json:"message"`
+				Signature string   `json:"signature"`
+			}
+			require.NoError(t, json.Unmarshal(input, &in))
+			var expected bool
+			require.NoError(t, json.Unmarshal(output, &expected))
+			require.Equal(t, expected, verifyVector(t, in.Pubkeys, in.Message, in.Signature))
+		})
+	})
+
+	// the identity is a valid encoding of a point, but neither a valid public key nor a valid sync committee signature
+	t.Run("deserialization_G1", func(t *testing.T) {
+		runBLSTestVectors(t, "deserialization_G1", func(t *testing.T, input json.RawMessage, output json.RawMessage) {
+			var in struct {
+				Pubkey string `json:"pubkey"`
+			}
+			require.NoError(t, json.Unmarshal(input, &in))
+			var expected bool
+			require.NoError(t, json.Unmarshal(output, &expected))
+
+			b := mustDecodeHex(t, in.Pubkey)
+			_, err := DecompressPublicKey(b)
+			require.Equal(t, expected && !isInfinityEncoding(b), err == nil)
+		})
+	})
+
+	t.Run("deserialization_G2", func(t *testing.T) {
+		runBLSTestVectors(t, "deserialization_G2", func(t *testing.T, input json.RawMessage, output json.RawMessage) {
+			var in struct {
+				Signature string `json:"signature"`
+			}
+			require.NoError(t, json.Unmarshal(input, &in))
+			var expected bool
+			require.NoError(t, json.Unmarshal(output, &expected))
+
+			b := mustDecodeHex(t, in.Signature)
+			_, err := DecompressSignature(b)
+			require.Equal(t, expected && !isInfinityEncoding(b), err == nil)
+		})
+	})
+}
+
+// isInfinityEncoding returns true if the bytes are the compressed encoding of the point at infinity
+func isInfinityEncoding(b []byte) bool {
+	if len(b) == 0 || b[0] != 0xc0 {
+		return false
+	}
+	for _, c := range b[1:] {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
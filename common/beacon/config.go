@@ -0,0 +1,188 @@
+package beacon
+
+import (
+	"encoding/hex"
+)
+
+const (
+	// SlotsPerEpoch is the number of slots of an epoch
+	SlotsPerEpoch = 32
+
+	// EpochsPerSyncCommitteePeriod is the number of epochs a sync committee signs the blocks
+	EpochsPerSyncCommitteePeriod = 256
+
+	// SyncCommitteeSize is the number of validators of a sync committee
+	SyncCommitteeSize = 512
+)
+
+// Generalized indices of the light client proofs in the beacon state and the beacon block body
+// The beacon state of Electra has more than 32 fields, its proofs are one level deeper
+const (
+	FinalizedRootGIndex               GeneralizedIndex = 105
+	CurrentSyncCommitteeGIndex        GeneralizedIndex = 54
+	NextSyncCommitteeGIndex           GeneralizedIndex = 55
+	FinalizedRootGIndexElectra        GeneralizedIndex = 169
+	CurrentSyncCommitteeGIndexElectra GeneralizedIndex = 86
+	NextSyncCommitteeGIndexElectra    GeneralizedIndex = 87
+	ExecutionPayloadGIndex            GeneralizedIndex = 25
+)
+
+// domainSyncCommittee is the domain type of the signatures of the sync committees
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// ForkVersion is the version of a fork of the beacon chain
+type ForkVersion [4]byte
+
+// Fork is a fork of the beacon chain activated at an epoch
+type Fork struct {
+	Version ForkVersion
+	Epoch   uint64
+}
+
+// Config is the configuration of a beacon chain network required to verify the light client updates
+// The light client only supports the headers from Capella, the first fork with the execution block in the headers
+type Config struct {
+	GenesisValidatorsRoot Root
+	GenesisForkVersion    ForkVersion
+	AltairFork            Fork
+	BellatrixFork         Fork
+	CapellaFork           Fork
+	DenebFork             Fork
+	ElectraFork           Fork
+}
+
+// farFutureEpoch is the epoch of the forks not scheduled
+const farFutureEpoch = ^uint64(0)
+
+var (
+	// MainnetConfig is the configuration of the Ethereum mainnet beacon chain
+	MainnetConfig = Config{
+		GenesisValidatorsRoot: mustRoot("4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		GenesisForkVersion:    ForkVersion{0x00, 0x00, 0x00, 0x00},
+		AltairFork:            Fork{Version: ForkVersion{0x01, 0x00, 0x00, 0x00}, Epoch: 74240},
+		BellatrixFork:         Fork{Version: ForkVersion{0x02, 0x00, 0x00, 0x00}, Epoch: 144896},
+		CapellaFork:           Fork{Version: ForkVersion{0x03, 0x00, 0x00, 0x00}, Epoch: 194048},
+		DenebFork:             Fork{Version: ForkVersion{0x04, 0x00, 0x00, 0x00}, Epoch: 269568},
+		ElectraFork:           Fork{Version: ForkVersion{0x05, 0x00, 0x00, 0x00}, Epoch: 364032},
+	}
+
+	// SepoliaConfig is the configuration of the Sepolia beacon chain
+	SepoliaConfig = Config{
+		GenesisValidatorsRoot: mustRoot("d8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+		GenesisForkVersion:    ForkVersion{0x90, 0x00, 0x00, 0x69},
+		AltairFork:            Fork{Version: ForkVersion{0x90, 0x00, 0x00, 0x70}, Epoch: 50},
+		BellatrixFork:         Fork{Version: ForkVersion{0x90, 0x00, 0x00, 0x71}, Epoch: 100},
+		CapellaFork:           Fork{Version: ForkVersion{0x90, 0x00, 0x00, 0x72}, Epoch: 56832},
+		DenebFork:             Fork{Version: ForkVersion{0x90, 0x00, 0x00, 0x73}, Epoch: 132608},
+		ElectraFork:           Fork{Version: ForkVersion{0x90, 0x00, 0x00, 0x74}, Epoch: 222464},
+	}
+
+	// GoerliConfig is the configuration of the Goerli beacon chain
+	GoerliConfig = Config{
+		GenesisValidatorsRoot: mustRoot("043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb"),
+		GenesisForkVersion:    ForkVersion{0x00, 0x00, 0x10, 0x20},
+		AltairFork:            Fork{Version: ForkVersion{0x01, 0x00, 0x10, 0x20}, Epoch: 36660},
+		BellatrixFork:         Fork{Version: ForkVersion{0x02, 0x00, 0x10, 0x20}, Epoch: 112260},
+		CapellaFork:           Fork{Version: ForkVersion{0x03, 0x00, 0x10, 0x20}, Epoch: 162304},
+		DenebFork:             Fork{Version: ForkVersion{0x04, 0x00, 0x10, 0x20}, Epoch: 231680},
+		ElectraFork:           Fork{Version: ForkVersion{0x05, 0x00, 0x10, 0x20}, Epoch: farFutureEpoch},
+	}
+)
+
+func mustRoot(s string) Root {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	root, err := RootFromBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
+
+// EpochAtSlot returns the epoch of a slot
+func EpochAtSlot(slot uint64) uint64 {
+	return slot / SlotsPerEpoch
+}
+
+// SyncCommitteePeriodAtSlot returns the sync committee period of a slot
+func SyncCommitteePeriodAtSlot(slot uint64) uint64 {
+	return EpochAtSlot(slot) / EpochsPerSyncCommitteePeriod
+}
+
+// ForkVersionAtEpoch returns the version of the fork active at an epoch
+func (c Config) ForkVersionAtEpoch(epoch uint64) ForkVersion {
+	switch {
+	case epoch >= c.ElectraFork.Epoch:
+		return c.ElectraFork.Version
+	case epoch >= c.DenebFork.Epoch:
+		return c.DenebFork.Version
+	case epoch >= c.CapellaFork.Epoch:
+		return c.CapellaFork.Version
+	case epoch >= c.BellatrixFork.Epoch:
+		return c.BellatrixFork.Version
+	case epoch >= c.AltairFork.Epoch:
+		return c.AltairFork.Version
+	default:
+		return c.GenesisForkVersion
+	}
+}
+
+// IsCapella returns true if the block of the slot is at least a Capella block
+func (c Config) IsCapella(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.CapellaFork.Epoch
+}
+
+// IsDeneb returns true if the block of the slot is at least a Deneb block
+func (c Config) IsDeneb(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.DenebFork.Epoch
+}
+
+// IsElectra returns true if the block of the slot is at least an Electra block
+func (c Config) IsElectra(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.ElectraFork.Epoch
+}
+
+// FinalizedRootGIndexAtSlot returns the generalized index of the finalized checkpoint root in the state of the slot
+func (c Config) FinalizedRootGIndexAtSlot(slot uint64) GeneralizedIndex {
+	if c.IsElectra(slot) {
+		return FinalizedRootGIndexElectra
+	}
+	return FinalizedRootGIndex
+}
+
+// CurrentSyncCommitteeGIndexAtSlot returns the generalized index of the current sync committee in the state of the slot
+func (c Config) CurrentSyncCommitteeGIndexAtSlot(slot uint64) GeneralizedIndex {
+	if c.IsElectra(slot) {
+		return CurrentSyncCommitteeGIndexElectra
+	}
+	return CurrentSyncCommitteeGIndex
+}
+
+// NextSyncCommitteeGIndexAtSlot returns the generalized index of the next sync committee in the state of the slot
+func (c Config) NextSyncCommitteeGIndexAtSlot(slot uint64) GeneralizedIndex {
+	if c.IsElectra(slot) {
+		return NextSyncCommitteeGIndexElectra
+	}
+	return NextSyncCommitteeGIndex
+}
+
+// SyncCommitteeDomain returns the domain of the signatures of the sync committee at a signature slot
+func (c Config) SyncCommitteeDomain(signatureSlot uint64) Root {
+	// the sync committee signs the block of the previous slot
+	if signatureSlot > 0 {
+		signatureSlot--
+	}
+	version := c.ForkVersionAtEpoch(EpochAtSlot(signatureSlot))
+
+	// fork data root: hash_tree_root(ForkData(current_version, genesis_validators_root))
+	var versionRoot Root
+	copy(versionRoot[:], version[:])
+	forkDataRoot := HashPair(versionRoot, c.GenesisValidatorsRoot)
+
+	var domain Root
+	copy(domain[:4], domainSyncCommittee[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain
+}
@@ -0,0 +1,146 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Root is the SSZ hash tree root of an object
+type Root [32]byte
+
+// HashPair returns the hash of the concatenation of two nodes of a Merkle tree
+func HashPair(left, right Root) Root {
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+// Merkleize returns the root of the Merkle tree of the chunks padded with zero chunks to the next power of two
+func Merkleize(chunks []Root) Root {
+	if len(chunks) == 0 {
+		return Root{}
+	}
+	layer := make([]Root, len(chunks))
+	copy(layer, chunks)
+
+	// the padding of a layer is the root of a subtree of zero chunks
+	zero := Root{}
+	for len(layer) > 1 {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zero)
+		}
+		next := make([]Root, len(layer)/2)
+		for i := range next {
+			next[i] = HashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+		zero = HashPair(zero, zero)
+	}
+	return layer[0]
+}
+
+// MixInLength returns the root of a list from the root of its elements and its length
+func MixInLength(root Root, length uint64) Root {
+	return HashPair(root, Uint64Root(length))
+}
+
+// Uint64Root returns the root of an uint64
+func Uint64Root(v uint64) (root Root) {
+	binary.LittleEndian.PutUint64(root[:], v)
+	return root
+}
+
+// Uint256Root returns the root of an uint256
+func Uint256Root(v *big.Int) (root Root, err error) {
+	if v.Sign() < 0 || v.BitLen() > 256 {
+		return root, fmt.Errorf("invalid uint256 %s", v)
+	}
+	b := v.FillBytes(make([]byte, 32))
+	for i := range b {
+		root[i] = b[len(b)-1-i]
+	}
+	return root, nil
+}
+
+// BytesRoot returns the root of a fixed size byte vector
+func BytesRoot(b []byte) Root {
+	return Merkleize(packBytes(b))
+}
+
+// BytesListRoot returns the root of a byte list with the maximum length
+func BytesListRoot(b []byte, maxLength int) (Root, error) {
+	if len(b) > maxLength {
+		return Root{}, fmt.Errorf("byte list length %d above %d", len(b), maxLength)
+	}
+	chunks := packBytes(b)
+	limit := (maxLength + 31) / 32
+	for len(chunks) < limit {
+		chunks = append(chunks, Root{})
+	}
+	return MixInLength(Merkleize(chunks), uint64(len(b))), nil
+}
+
+// RootFromBytes returns the root of a 32 bytes vector
+func RootFromBytes(b []byte) (root Root, err error) {
+	if len(b) != len(root) {
+		return root, fmt.Errorf("invalid root length %d", len(b))
+	}
+	copy(root[:], b)
+	return root, nil
+}
+
+func packBytes(b []byte) []Root {
+	chunks := make([]Root, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[32*i:])
+	}
+	return chunks
+}
+
+// GeneralizedIndex is the index of a node in a Merkle tree, the root is 1 and the children of i are 2i and 2i+1
+type GeneralizedIndex uint64
+
+// Depth returns the depth of the node in the tree
+func (g GeneralizedIndex) Depth() int {
+	depth := 0
+	for i := g; i > 1; i >>= 1 {
+		depth++
+	}
+	return depth
+}
+
+// Index returns the index of the node in its layer of the tree
+func (g GeneralizedIndex) Index() uint64 {
+	return uint64(g) - 1<<g.Depth()
+}
+
+// VerifyMerkleBranch checks the branch proves the leaf at the generalized index of the tree of the root
+func VerifyMerkleBranch(leaf Root, branch [][]byte, gindex GeneralizedIndex, root Root) error {
+	depth := gindex.Depth()
+	if len(branch) != depth {
+		return fmt.Errorf("invalid branch length %d, expected %d", len(branch), depth)
+	}
+	index := gindex.Index()
+	node := leaf
+	for i, b := range branch {
+		sibling, err := RootFromBytes(b)
+		if err != nil {
+			return err
+		}
+		if (index>>i)&1 == 1 {
+			node = HashPair(sibling, node)
+		} else {
+			node = HashPair(node, sibling)
+		}
+	}
+	if node != root {
+		return errors.New("invalid merkle branch")
+	}
+	return nil
+}
+
+// ComputeSigningRoot returns the root signed for an object in a domain
+func ComputeSigningRoot(objectRoot Root, domain Root) Root {
+	return HashPair(objectRoot, domain)
+}
@@ -0,0 +1,42 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleize(t *testing.T) {
+	a, b, c := Uint64Root(1), Uint64Root(2), Uint64Root(3)
+
+	require.Equal(t, a, Merkleize([]Root{a}))
+	require.Equal(t, HashPair(a, b), Merkleize([]Root{a, b}))
+	require.Equal(t, HashPair(HashPair(a, b), HashPair(c, Root{})), Merkleize([]Root{a, b, c}))
+}
+
+func TestGeneralizedIndex(t *testing.T) {
+	require.Equal(t, 4, GeneralizedIndex(25).Depth())
+	require.EqualValues(t, 9, GeneralizedIndex(25).Index())
+	require.Equal(t, 6, FinalizedRootGIndex.Depth())
+	require.Equal(t, 7, FinalizedRootGIndexElectra.Depth())
+}
+
+func TestVerifyMerkleBranch(t *testing.T) {
+	leaves := make([]Root, 8)
+	for i := range leaves {
+		leaves[i] = sha256.Sum256([]byte{byte(i)})
+	}
+	root := Merkleize(leaves)
+
+	// branch of the leaf 5 at the generalized index 13
+	branch := [][]byte{
+		leaves[4][:],
+		func() []byte { r := HashPair(leaves[6], leaves[7]); return r[:] }(),
+		func() []byte { r := Merkleize(leaves[:4]); return r[:] }(),
+	}
+	require.NoError(t, VerifyMerkleBranch(leaves[5], branch, 13, root))
+	require.Error(t, VerifyMerkleBranch(leaves[4], branch, 13, root))
+	require.Error(t, VerifyMerkleBranch(leaves[5], branch, 12, root))
+	require.Error(t, VerifyMerkleBranch(leaves[5], branch[:2], 13, root))
+}
@@ -0,0 +1,21 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/zeta-chain/zetacore/common/beacon"
+)
+
+// BeaconConfigFromChainID returns the beacon chain config of the Ethereum network from the chain id
+func BeaconConfigFromChainID(chainID int64) (*beacon.Config, error) {
+	switch chainID {
+	case EthChain().ChainId:
+		return &beacon.MainnetConfig, nil
+	case SepoliaChain().ChainId:
+		return &beacon.SepoliaConfig, nil
+	case GoerliChain().ChainId:
+		return &beacon.GoerliConfig, nil
+	default:
+		return nil, fmt.Errorf("no beacon chain config for chain ID: %d", chainID)
+	}
+}
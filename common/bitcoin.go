@@ -6,6 +6,20 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 )
 
+const (
+	// BtcDepositorFeeRate is the fee rate in sat/byte of the depositor fee
+	// The fee rate on UTXO deposit is different from the fee rate when the UTXO is spent, a fixed rate is used for simplicity
+	BtcDepositorFeeRate = 5
+
+	// BtcOutTxBytesDepositor is the size in bytes incurred by the depositor in the outtx spending the deposited UTXO,
+	// a SegWit input of 41 bytes and its witness of 108 bytes
+	BtcOutTxBytesDepositor = 149
+
+	// BtcDepositorFeeSat is the fee in satoshis charged to the bitcoin deposits to cover the cost of spending the
+	// deposited UTXO
+	BtcDepositorFeeSat = BtcDepositorFeeRate * BtcOutTxBytesDepositor
+)
+
 var (
 	BitcoinMainnetParams = &chaincfg.MainNetParams
 	BitcoinRegnetParams  = &chaincfg.RegressionNetParams
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BitcoinHeader returns the deserialized Bitcoin header of the header data
+func (h HeaderData) BitcoinHeader() (*wire.BlockHeader, error) {
+	data, ok := h.Data.(*HeaderData_BitcoinHeader)
+	if !ok {
+		return nil, errors.New("not a bitcoin header")
+	}
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(data.BitcoinHeader)); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// IsBitcoinNoRetargeting returns true if the difficulty of the network is never retargeted, like on regtest where all
+// the blocks use the difficulty limit
+func IsBitcoinNoRetargeting(params *chaincfg.Params) bool {
+	return params.Net == chaincfg.RegressionNetParams.Net
+}
+
+// BitcoinBlocksPerRetarget returns the number of blocks between two difficulty retargets of the network
+func BitcoinBlocksPerRetarget(params *chaincfg.Params) int64 {
+	return int64(params.TargetTimespan / params.TargetTimePerBlock)
+}
+
+// BitcoinRetargetBits returns the compact target of a block at a retarget height from the compact target of its parent
+// and the timestamps of the first and the last blocks of the previous retarget interval, as computed by btcd
+func BitcoinRetargetBits(params *chaincfg.Params, parentBits uint32, firstTimestamp, lastTimestamp time.Time) uint32 {
+	targetTimespan := int64(params.TargetTimespan / time.Second)
+	minRetargetTimespan := targetTimespan / params.RetargetAdjustmentFactor
+	maxRetargetTimespan := targetTimespan * params.RetargetAdjustmentFactor
+
+	// limit the amount of adjustment that can occur to the previous difficulty
+	actualTimespan := lastTimestamp.Unix() - firstTimestamp.Unix()
+	adjustedTimespan := actualTimespan
+	if actualTimespan < minRetargetTimespan {
+		adjustedTimespan = minRetargetTimespan
+	} else if actualTimespan > maxRetargetTimespan {
+		adjustedTimespan = maxRetargetTimespan
+	}
+
+	newTarget := new(big.Int).Mul(blockchain.CompactToBig(parentBits), big.NewInt(adjustedTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
+	}
+	return blockchain.BigToCompact(newTarget)
+}
+
+// IsBitcoinRetargetBitsInBounds returns true if the compact target of a block at a retarget height is within the
+// maximum adjustment of the compact target of its parent, used when the first block of the interval is unknown
+func IsBitcoinRetargetBitsInBounds(params *chaincfg.Params, parentBits uint32, bits uint32) bool {
+	adjustmentFactor := big.NewInt(params.RetargetAdjustmentFactor)
+	parentTarget := blockchain.CompactToBig(parentBits)
+	target := blockchain.CompactToBig(bits)
+
+	minTarget := blockchain.CompactToBig(blockchain.BigToCompact(new(big.Int).Div(parentTarget, adjustmentFactor)))
+	maxTarget := new(big.Int).Mul(parentTarget, adjustmentFactor)
+	if maxTarget.Cmp(params.PowLimit) > 0 {
+		maxTarget.Set(params.PowLimit)
+	}
+	return target.Cmp(minTarget) >= 0 && target.Cmp(maxTarget) <= 0
+}
+
+// IsBitcoinMinDifficultyAllowed returns true if a block of a network allowing min difficulty blocks can use the
+// difficulty limit, when its timestamp is more than the min difficulty reduction time after its parent
+func IsBitcoinMinDifficultyAllowed(params *chaincfg.Params, parentTimestamp, timestamp time.Time) bool {
+	if !params.ReduceMinDifficulty {
+		return false
+	}
+	return timestamp.Unix() > parentTimestamp.Unix()+int64(params.MinDiffReductionTime/time.Second)
+}
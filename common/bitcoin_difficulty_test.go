@@ -0,0 +1,90 @@
+package common_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+func TestHeaderData_BitcoinHeader(t *testing.T) {
+	header := wire.BlockHeader{Version: 1, Bits: 0x1d00ffff, Timestamp: time.Unix(1231006505, 0), Nonce: 2083236893}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+
+	res, err := common.NewBitcoinHeader(buf.Bytes()).BitcoinHeader()
+	require.NoError(t, err)
+	require.Equal(t, header.BlockHash(), res.BlockHash())
+
+	_, err = common.NewEthereumHeader([]byte{1}).BitcoinHeader()
+	require.Error(t, err)
+	_, err = common.NewBitcoinHeader([]byte{1}).BitcoinHeader()
+	require.Error(t, err)
+}
+
+func TestBitcoinBlocksPerRetarget(t *testing.T) {
+	require.EqualValues(t, 2016, common.BitcoinBlocksPerRetarget(&chaincfg.MainNetParams))
+	require.True(t, common.IsBitcoinNoRetargeting(&chaincfg.RegressionNetParams))
+	require.False(t, common.IsBitcoinNoRetargeting(&chaincfg.MainNetParams))
+	require.False(t, common.IsBitcoinNoRetargeting(&chaincfg.TestNet3Params))
+}
+
+func TestBitcoinRetargetBits(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	parentBits := uint32(0x17053894)
+	parentTarget := blockchain.CompactToBig(parentBits)
+	first := time.Unix(1700000000, 0)
+
+	t.Run("target unchanged for the target timespan", func(t *testing.T) {
+		bits := common.BitcoinRetargetBits(params, parentBits, first, first.Add(params.TargetTimespan))
+		require.Equal(t, parentBits, bits)
+	})
+	t.Run("target halved for half the target timespan", func(t *testing.T) {
+		bits := common.BitcoinRetargetBits(params, parentBits, first, first.Add(params.TargetTimespan/2))
+		expected := new(big.Int).Div(parentTarget, big.NewInt(2))
+		require.Equal(t, blockchain.BigToCompact(expected), bits)
+	})
+	t.Run("adjustment clamped to the adjustment factor", func(t *testing.T) {
+		bits := common.BitcoinRetargetBits(params, parentBits, first, first.Add(params.TargetTimespan*10))
+		expected := new(big.Int).Mul(parentTarget, big.NewInt(params.RetargetAdjustmentFactor))
+		require.Equal(t, blockchain.BigToCompact(expected), bits)
+
+		bits = common.BitcoinRetargetBits(params, parentBits, first, first.Add(params.TargetTimespan/10))
+		expected = new(big.Int).Div(parentTarget, big.NewInt(params.RetargetAdjustmentFactor))
+		require.Equal(t, blockchain.BigToCompact(expected), bits)
+	})
+	t.Run("target capped to the difficulty limit", func(t *testing.T) {
+		bits := common.BitcoinRetargetBits(params, params.PowLimitBits, first, first.Add(params.TargetTimespan*2))
+		require.Equal(t, params.PowLimitBits, bits)
+	})
+}
+
+func TestIsBitcoinRetargetBitsInBounds(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	parentBits := uint32(0x17053894)
+	parentTarget := blockchain.CompactToBig(parentBits)
+	factor := big.NewInt(params.RetargetAdjustmentFactor)
+
+	require.True(t, common.IsBitcoinRetargetBitsInBounds(params, parentBits, parentBits))
+	require.True(t, common.IsBitcoinRetargetBitsInBounds(params, parentBits, blockchain.BigToCompact(new(big.Int).Mul(parentTarget, factor))))
+	require.True(t, common.IsBitcoinRetargetBitsInBounds(params, parentBits, blockchain.BigToCompact(new(big.Int).Div(parentTarget, factor))))
+	require.False(t, common.IsBitcoinRetargetBitsInBounds(params, parentBits, blockchain.BigToCompact(new(big.Int).Mul(parentTarget, big.NewInt(5)))))
+	require.False(t, common.IsBitcoinRetargetBitsInBounds(params, parentBits, blockchain.BigToCompact(new(big.Int).Div(parentTarget, big.NewInt(5)))))
+	require.False(t, common.IsBitcoinRetargetBitsInBounds(params, params.PowLimitBits, 0x1e00ffff))
+}
+
+func TestIsBitcoinMinDifficultyAllowed(t *testing.T) {
+	parent := time.Unix(1700000000, 0)
+
+	params := &chaincfg.TestNet3Params
+	require.False(t, common.IsBitcoinMinDifficultyAllowed(params, parent, parent.Add(params.MinDiffReductionTime)))
+	require.True(t, common.IsBitcoinMinDifficultyAllowed(params, parent, parent.Add(params.MinDiffReductionTime+time.Second)))
+
+	require.False(t, common.IsBitcoinMinDifficultyAllowed(&chaincfg.MainNetParams, parent, parent.Add(time.Hour)))
+}
@@ -96,3 +96,26 @@ func (p Proof) Verify(headerData HeaderData, txIndex int) ([]byte, error) {
 		return nil, errors.New("unrecognized proof type")
 	}
 }
+
+// VerifyReceipt verifies the proof of a receipt against the header
+// Returns the verified receipt in bytes if the verification is successful, only ethereum proofs are supported
+func (p Proof) VerifyReceipt(headerData HeaderData, txIndex int) ([]byte, error) {
+	proof, ok := p.Proof.(*Proof_EthereumProof)
+	if !ok {
+		return nil, errors.New("receipt proof is only supported for ethereum")
+	}
+	ethHeaderBytes := headerData.GetEthereumHeader()
+	if ethHeaderBytes == nil {
+		return nil, errors.New("can't verify ethereum proof against non-ethereum header")
+	}
+	var ethHeader ethtypes.Header
+	err := rlp.DecodeBytes(ethHeaderBytes, &ethHeader)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proof.EthereumProof.Verify(ethHeader.ReceiptHash, txIndex)
+	if err != nil {
+		return nil, NewErrInvalidProof(err)
+	}
+	return val, nil
+}
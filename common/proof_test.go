@@ -9,10 +9,12 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/common/bitcoin"
+	"github.com/zeta-chain/zetacore/common/ethereum"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 
@@ -20,6 +22,8 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 const numBlocksToTest = 100
@@ -149,3 +153,38 @@ func validateBitcoinBlock(t *testing.T, _ *wire.BlockHeader, headerBytes []byte,
 		require.Nil(t, txBytes)
 	}
 }
+
+func TestProof_VerifyReceipt(t *testing.T) {
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21_000, Logs: []*ethtypes.Log{}},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 42_000, Logs: []*ethtypes.Log{}},
+	}
+	receiptTrie := ethereum.NewTrie(receipts)
+	headerRLP, err := rlp.EncodeToBytes(&ethtypes.Header{
+		Number:      big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		ReceiptHash: receiptTrie.Hash(),
+	})
+	require.NoError(t, err)
+	header := common.NewEthereumHeader(headerRLP)
+
+	for i, expected := range receipts {
+		proof, err := receiptTrie.GenerateProof(i)
+		require.NoError(t, err)
+		receiptBytes, err := common.NewEthereumProof(proof).VerifyReceipt(header, i)
+		require.NoError(t, err)
+
+		var receipt ethtypes.Receipt
+		require.NoError(t, receipt.UnmarshalBinary(receiptBytes))
+		require.Equal(t, expected.Status, receipt.Status)
+		require.Equal(t, expected.CumulativeGasUsed, receipt.CumulativeGasUsed)
+
+		// the proof doesn't verify at another index
+		_, err = common.NewEthereumProof(proof).VerifyReceipt(header, 1-i)
+		require.True(t, common.IsErrorInvalidProof(err))
+	}
+
+	// only ethereum proofs are supported
+	_, err = common.NewBitcoinProof(nil, nil, 0).VerifyReceipt(header, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The spans of a cctx are emitted by the zetaclient of every observer and by the crosschain module of every node. The
+// trace context can't be propagated between them through the votes, so the id of the trace of a cctx is derived from
+// the cctx index: all the spans of a cctx join the same trace without propagation.
+
+const (
+	// AttrCctxIndex is the attribute of the index of the cctx
+	AttrCctxIndex = attribute.Key("cctx.index")
+
+	// AttrInboundHash is the attribute of the hash of the inbound tx of the cctx
+	AttrInboundHash = attribute.Key("cctx.inbound_hash")
+
+	// AttrSenderChainID is the attribute of the id of the sender chain of the cctx
+	AttrSenderChainID = attribute.Key("cctx.sender_chain_id")
+
+	// AttrReceiverChainID is the attribute of the id of the receiver chain of the cctx
+	AttrReceiverChainID = attribute.Key("cctx.receiver_chain_id")
+
+	// AttrOutboundNonce is the attribute of the tss nonce of the outbound tx of the cctx
+	AttrOutboundNonce = attribute.Key("cctx.outbound_nonce")
+
+	// AttrOutboundHash is the attribute of the hash of the outbound tx of the cctx
+	AttrOutboundHash = attribute.Key("cctx.outbound_hash")
+)
+
+// Tracer returns the tracer of a component, the spans are dropped unless a tracer provider is set up with Setup
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// CctxTraceID returns the id of the trace of a cctx
+func CctxTraceID(cctxIndex string) trace.TraceID {
+	var traceID trace.TraceID
+	hash := cctxHash(cctxIndex)
+	copy(traceID[:], hash[:16])
+	return traceID
+}
+
+// ContextWithCctx returns a context whose spans belong to the trace of a cctx, the spans started from the context are
+// children of the root of the trace, a span shared by all the nodes and never emitted
+func ContextWithCctx(ctx context.Context, cctxIndex string) context.Context {
+	var spanID trace.SpanID
+	hash := cctxHash(cctxIndex)
+	copy(spanID[:], hash[16:24])
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    CctxTraceID(cctxIndex),
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
+// StartCctxSpan starts a span in the trace of a cctx, the span is a child of the span of the context if it belongs
+// to the trace of the cctx
+func StartCctxSpan(
+	ctx context.Context,
+	tracer trace.Tracer,
+	name string,
+	cctxIndex string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	if trace.SpanContextFromContext(ctx).TraceID() != CctxTraceID(cctxIndex) {
+		ctx = ContextWithCctx(ctx, cctxIndex)
+	}
+	attrs = append(attrs, AttrCctxIndex.String(cctxIndex))
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends a span, setting its status to error if err is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func cctxHash(cctxIndex string) [32]byte {
+	return sha256.Sum256([]byte(strings.ToLower(cctxIndex)))
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// testCollector is a local OTLP collector recording the exported spans
+type testCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *testCollector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, resourceSpans := range req.ResourceSpans {
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			c.spans = append(c.spans, scopeSpans.Spans...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *testCollector) span(name string) *tracepb.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, span := range c.spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func startTestCollector(t *testing.T) (*testCollector, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	collector := &testCollector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return collector, listener.Addr().String()
+}
+
+func TestCctxTraceID(t *testing.T) {
+	index := "0x7a9bd5d8f6ee3d0b6c3b0a2b1d6d4e1f8f7f1f3bce2d2bd6f9a3c6e6b0a2a1f0"
+	require.Equal(t, CctxTraceID(index), CctxTraceID("0x7A9BD5D8F6EE3D0B6C3B0A2B1D6D4E1F8F7F1F3BCE2D2BD6F9A3C6E6B0A2A1F0"))
+	require.NotEqual(t, CctxTraceID(index), CctxTraceID("0x01"))
+	require.True(t, CctxTraceID(index).IsValid())
+}
+
+func TestSetup(t *testing.T) {
+	collector, endpoint := startTestCollector(t)
+	prevProvider := otel.GetTracerProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+	})
+	shutdown, err := Setup(context.Background(), "test", endpoint, true)
+	require.NoError(t, err)
+
+	// the spans of a cctx started by two components join the same trace
+	index := "0x7a9bd5d8f6ee3d0b6c3b0a2b1d6d4e1f8f7f1f3bce2d2bd6f9a3c6e6b0a2a1f0"
+	ctx, span := StartCctxSpan(context.Background(), Tracer("zetaclient"), "TryProcessOutTx", index, AttrOutboundNonce.Int64(3))
+	_, child := StartCctxSpan(ctx, Tracer("zetaclient"), "Keysign", index)
+	EndSpan(child, errors.New("keysign failed"))
+	span.End()
+	_, vote := StartCctxSpan(context.Background(), Tracer("crosschain"), "VoteOnObservedOutboundTx", index)
+	EndSpan(vote, nil)
+	require.NoError(t, shutdown(context.Background()))
+
+	traceID := CctxTraceID(index)
+	processSpan := collector.span("TryProcessOutTx")
+	require.NotNil(t, processSpan)
+	require.Equal(t, traceID[:], processSpan.TraceId)
+	require.Equal(t, trace.SpanID(processSpan.SpanId), trace.SpanID(collector.span("Keysign").ParentSpanId))
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, collector.span("Keysign").Status.Code)
+
+	voteSpan := collector.span("VoteOnObservedOutboundTx")
+	require.NotNil(t, voteSpan)
+	require.Equal(t, traceID[:], voteSpan.TraceId)
+	require.Equal(t, processSpan.ParentSpanId, voteSpan.ParentSpanId)
+	var indexAttr string
+	for _, attr := range voteSpan.Attributes {
+		if attr.Key == string(AttrCctxIndex) {
+			indexAttr = attr.Value.GetStringValue()
+		}
+	}
+	require.Equal(t, index, indexAttr)
+}
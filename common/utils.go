@@ -8,6 +8,9 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
+// DonationMessage is the message of the inbound txs donating to the TSS, no cctx is created for them
+const DonationMessage = "I am rich!"
+
 // A very special value to mark current nonce in UTXO
 func NonceMarkAmount(nonce uint64) int64 {
 	// #nosec G701 always in range
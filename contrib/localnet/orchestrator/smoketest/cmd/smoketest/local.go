@@ -1,35 +1,33 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
-	"github.com/btcsuite/btcd/rpcclient"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
-	"github.com/zeta-chain/zetacore/app"
 	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/config"
 	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
 	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/smoketests"
-	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/txserver"
 	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
-	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
-	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
-	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
-	"google.golang.org/grpc"
 )
 
 const (
 	flagContractsDeployed = "deployed"
 	flagWaitForHeight     = "wait-for"
 	flagConfigFile        = "config"
+	flagTests             = "tests"
+	flagTags              = "tags"
+	flagSkipTags          = "skip-tags"
+	flagList              = "list"
+	flagParallel          = "parallel"
+	flagTestTimeout       = "test-timeout"
+	flagJUnitReport       = "junit"
+	flagJSONReport        = "json-report"
+
+	// smokeTestSuiteName is the name of the suite in the reports
+	smokeTestSuiteName = "smoketest"
 )
 
 func NewLocalCmd() *cobra.Command {
@@ -53,10 +51,64 @@ func NewLocalCmd() *cobra.Command {
 		"",
 		"config file to use for the smoketest",
 	)
+	cmd.Flags().StringSlice(
+		flagTests,
+		nil,
+		"names of the smoke tests to run, ex. --tests erc20_deposit,erc20_withdraw",
+	)
+	cmd.Flags().StringSlice(
+		flagTags,
+		nil,
+		fmt.Sprintf("run the smoke tests with one of the tags (%s)", strings.Join(smoketests.AllTags, ", ")),
+	)
+	cmd.Flags().StringSlice(
+		flagSkipTags,
+		nil,
+		"skip the smoke tests with one of the tags, ex. --skip-tags bitcoin",
+	)
+	cmd.Flags().Bool(
+		flagList,
+		false,
+		"list the selected smoke tests and exit",
+	)
+	cmd.Flags().Int(
+		flagParallel,
+		1,
+		"maximum number of smoke tests run concurrently, each with its own funded account; admin and bitcoin tests always run sequentially",
+	)
+	cmd.Flags().Duration(
+		flagTestTimeout,
+		runner.DefaultSmokeTestTimeout,
+		"timeout of each smoke test, some tests define a longer timeout",
+	)
+	cmd.Flags().String(
+		flagJUnitReport,
+		"",
+		"file to write the JUnit XML report to",
+	)
+	cmd.Flags().String(
+		flagJSONReport,
+		"",
+		"file to write the JSON report to",
+	)
 	return cmd
 }
 
 func localSmokeTest(cmd *cobra.Command, _ []string) {
+	// select the smoke tests
+	smokeTests, err := getSmokeTests(cmd)
+	if err != nil {
+		panic(err)
+	}
+	list, err := cmd.Flags().GetBool(flagList)
+	if err != nil {
+		panic(err)
+	}
+	if list {
+		printSmokeTests(smokeTests)
+		return
+	}
+
 	testStartTime := time.Now()
 	defer func() {
 		fmt.Println("Smoke test took", time.Since(testStartTime))
@@ -78,134 +130,32 @@ func localSmokeTest(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		panic(err)
 	}
-
-	// initialize smoke tests config
-	conf, err := getConfig(cmd)
+	parallelism, err := cmd.Flags().GetInt(flagParallel)
 	if err != nil {
 		panic(err)
 	}
-
-	// wait for a specific height on ZetaChain
-	if waitForHeight != 0 {
-		utils.WaitForBlockHeight(waitForHeight, conf.RPCs.ZetaCoreRPC)
-	}
-
-	// set account prefix to zeta
-	cosmosConf := sdk.GetConfig()
-	cosmosConf.SetBech32PrefixForAccount(app.Bech32PrefixAccAddr, app.Bech32PrefixAccPub)
-	cosmosConf.Seal()
-
-	// initialize clients
-	// TODO: add connection values to config
-	// https://github.com/zeta-chain/node-private/issues/41
-	connCfg := &rpcclient.ConnConfig{
-		Host:         conf.RPCs.Bitcoin,
-		User:         "smoketest",
-		Pass:         "123",
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       "testnet3",
-	}
-	btcRPCClient, err := rpcclient.New(connCfg, nil)
-	if err != nil {
-		panic(err)
-	}
-
-	goerliClient, err := ethclient.Dial(conf.RPCs.EVM)
-	if err != nil {
-		panic(err)
-	}
-
-	chainid, err := goerliClient.ChainID(context.Background())
-	if err != nil {
-		panic(err)
-	}
-	deployerPrivkey, err := crypto.HexToECDSA(DeployerPrivateKey)
-	if err != nil {
-		panic(err)
-	}
-	goerliAuth, err := bind.NewKeyedTransactorWithChainID(deployerPrivkey, chainid)
+	testTimeout, err := cmd.Flags().GetDuration(flagTestTimeout)
 	if err != nil {
 		panic(err)
 	}
 
-	grpcConn, err := grpc.Dial(conf.RPCs.ZetaCoreGRPC, grpc.WithInsecure())
-	if err != nil {
-		panic(err)
-	}
-
-	cctxClient := crosschaintypes.NewQueryClient(grpcConn)
-	fungibleClient := fungibletypes.NewQueryClient(grpcConn)
-	authClient := authtypes.NewQueryClient(grpcConn)
-	bankClient := banktypes.NewQueryClient(grpcConn)
-	observerClient := observertypes.NewQueryClient(grpcConn)
-
-	// wait for Genesis
-	time.Sleep(30 * time.Second)
-
-	// initialize client to send messages to ZetaChain
-	zetaTxServer, err := txserver.NewZetaTxServer(
-		conf.RPCs.ZetaCoreRPC,
-		[]string{utils.FungibleAdminName},
-		[]string{FungibleAdminMnemonic},
-		conf.ZetaChainID,
-	)
+	// initialize smoke tests config
+	conf, err := getConfig(cmd)
 	if err != nil {
 		panic(err)
 	}
 
-	// wait for keygen to be completed. ~ height 30
-	for {
-		time.Sleep(5 * time.Second)
-		response, err := cctxClient.LastZetaHeight(context.Background(), &crosschaintypes.QueryLastZetaHeightRequest{})
-		if err != nil {
-			fmt.Printf("cctxClient.LastZetaHeight error: %s", err)
-			continue
-		}
-		if response.Height >= 60 {
-			break
-		}
-		fmt.Printf("Last ZetaHeight: %d\n", response.Height)
+	// wait for a specific height on ZetaChain
+	if waitForHeight != 0 {
+		utils.WaitForBlockHeight(waitForHeight, conf.RPCs.ZetaCoreRPC)
 	}
 
-	// setup client and auth for zevm
-	var zevmClient *ethclient.Client
-	for {
-		time.Sleep(5 * time.Second)
-		fmt.Printf("dialing zevm client: %s\n", conf.RPCs.Zevm)
-		zevmClient, err = ethclient.Dial(conf.RPCs.Zevm)
-		if err != nil {
-			continue
-		}
-		break
-	}
-	chainid, err = zevmClient.ChainID(context.Background())
-	if err != nil {
-		panic(err)
-	}
-	zevmAuth, err := bind.NewKeyedTransactorWithChainID(deployerPrivkey, chainid)
+	// initialize smoke test runner
+	sm, err := newSmokeTestRunner(conf)
 	if err != nil {
 		panic(err)
 	}
 
-	// initialize smoke test runner
-	sm := runner.NewSmokeTestRunner(
-		DeployerAddress,
-		DeployerPrivateKey,
-		FungibleAdminMnemonic,
-		goerliClient,
-		zevmClient,
-		cctxClient,
-		zetaTxServer,
-		fungibleClient,
-		authClient,
-		bankClient,
-		observerClient,
-		goerliAuth,
-		zevmAuth,
-		btcRPCClient,
-	)
-
 	// setting up the networks
 	startTime := time.Now()
 
@@ -230,10 +180,98 @@ func localSmokeTest(cmd *cobra.Command, _ []string) {
 
 	fmt.Printf("## Setup takes %s\n", time.Since(startTime))
 
-	// run all smoke tests
-	sm.RunSmokeTests(smoketests.AllSmokeTests)
+	// run the selected smoke tests
+	suiteStartTime := time.Now()
+	results := sm.RunSmokeTestSuite(smokeTests, runner.SuiteOptions{
+		Parallelism: parallelism,
+		Timeout:     testTimeout,
+	})
+	if err := writeReports(cmd, suiteStartTime, results); err != nil {
+		panic(err)
+	}
 
-	sm.WG.Wait()
+	if !printResults(results) {
+		fmt.Println("Smoke test took", time.Since(testStartTime))
+		os.Exit(1)
+	}
+}
+
+// getSmokeTests returns the smoke tests selected by the flags
+func getSmokeTests(cmd *cobra.Command) ([]runner.SmokeTestDefinition, error) {
+	names, err := cmd.Flags().GetStringSlice(flagTests)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := cmd.Flags().GetStringSlice(flagTags)
+	if err != nil {
+		return nil, err
+	}
+	skipTags, err := cmd.Flags().GetStringSlice(flagSkipTags)
+	if err != nil {
+		return nil, err
+	}
+	return smoketests.SelectSmokeTests(names, tags, skipTags)
+}
+
+// printSmokeTests prints the name, tags and description of the smoke tests
+func printSmokeTests(smokeTests []runner.SmokeTestDefinition) {
+	for _, test := range smokeTests {
+		mode := "sequential"
+		if test.Parallel {
+			mode = "parallel"
+		}
+		fmt.Printf("%-32s %-10s %-20s %s\n", test.Name, mode, strings.Join(test.Tags, ","), test.Description)
+	}
+}
+
+// printResults prints the results of the smoke tests and returns true if all of them passed
+func printResults(results []runner.SmokeTestResult) bool {
+	passed := true
+	fmt.Println("Smoke test results:")
+	for _, r := range results {
+		fmt.Printf("  %-32s %-10s %s\n", r.Name, r.Status, r.Duration.Round(time.Second))
+		if r.Status != runner.SmokeTestPassed {
+			passed = false
+			fmt.Printf("    %s\n", r.Failure)
+		}
+	}
+	return passed
+}
+
+// writeReports writes the JUnit and JSON reports of the results if requested by the flags
+func writeReports(cmd *cobra.Command, startTime time.Time, results []runner.SmokeTestResult) error {
+	junitFile, err := cmd.Flags().GetString(flagJUnitReport)
+	if err != nil {
+		return err
+	}
+	jsonFile, err := cmd.Flags().GetString(flagJSONReport)
+	if err != nil {
+		return err
+	}
+
+	writeReport := func(file string, write func(*os.File) error) error {
+		if file == "" {
+			return nil
+		}
+		// #nosec G304 -- the report file is given by the user
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		if err := write(f); err != nil {
+			_ = f.Close()
+			return err
+		}
+		return f.Close()
+	}
+	if err := writeReport(junitFile, func(f *os.File) error {
+		return runner.WriteJUnitReport(f, smokeTestSuiteName, startTime, results)
+	}); err != nil {
+		return err
+	}
+	return writeReport(jsonFile, func(f *os.File) error {
+		return runner.WriteJSONReport(f, smokeTestSuiteName, startTime, results)
+	})
 }
 
 func getConfig(cmd *cobra.Command) (config.Config, error) {
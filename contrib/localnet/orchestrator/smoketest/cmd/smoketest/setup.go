@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/config"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/txserver"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc"
+)
+
+// newSmokeTestRunner initializes the clients from the config and returns a smoke test runner using them
+// It waits for the genesis of ZetaChain and for the keygen to be completed
+func newSmokeTestRunner(conf config.Config) (*runner.SmokeTestRunner, error) {
+	// set account prefix to zeta
+	cosmosConf := sdk.GetConfig()
+	cosmosConf.SetBech32PrefixForAccount(app.Bech32PrefixAccAddr, app.Bech32PrefixAccPub)
+	cosmosConf.Seal()
+
+	// initialize clients
+	// TODO: add connection values to config
+	// https://github.com/zeta-chain/node-private/issues/41
+	connCfg := &rpcclient.ConnConfig{
+		Host:         conf.RPCs.Bitcoin,
+		User:         "smoketest",
+		Pass:         "123",
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Params:       "testnet3",
+	}
+	btcRPCClient, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	goerliClient, err := ethclient.Dial(conf.RPCs.EVM)
+	if err != nil {
+		return nil, err
+	}
+
+	chainid, err := goerliClient.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	deployerPrivkey, err := crypto.HexToECDSA(DeployerPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	goerliAuth, err := bind.NewKeyedTransactorWithChainID(deployerPrivkey, chainid)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcConn, err := grpc.Dial(conf.RPCs.ZetaCoreGRPC, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	cctxClient := crosschaintypes.NewQueryClient(grpcConn)
+	fungibleClient := fungibletypes.NewQueryClient(grpcConn)
+	authClient := authtypes.NewQueryClient(grpcConn)
+	bankClient := banktypes.NewQueryClient(grpcConn)
+	observerClient := observertypes.NewQueryClient(grpcConn)
+
+	// wait for Genesis
+	time.Sleep(30 * time.Second)
+
+	// initialize client to send messages to ZetaChain
+	zetaTxServer, err := txserver.NewZetaTxServer(
+		conf.RPCs.ZetaCoreRPC,
+		[]string{utils.FungibleAdminName},
+		[]string{FungibleAdminMnemonic},
+		conf.ZetaChainID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// wait for keygen to be completed. ~ height 30
+	for {
+		time.Sleep(5 * time.Second)
+		response, err := cctxClient.LastZetaHeight(context.Background(), &crosschaintypes.QueryLastZetaHeightRequest{})
+		if err != nil {
+			fmt.Printf("cctxClient.LastZetaHeight error: %s", err)
+			continue
+		}
+		if response.Height >= 60 {
+			break
+		}
+		fmt.Printf("Last ZetaHeight: %d\n", response.Height)
+	}
+
+	// setup client and auth for zevm
+	var zevmClient *ethclient.Client
+	for {
+		time.Sleep(5 * time.Second)
+		fmt.Printf("dialing zevm client: %s\n", conf.RPCs.Zevm)
+		zevmClient, err = ethclient.Dial(conf.RPCs.Zevm)
+		if err != nil {
+			continue
+		}
+		break
+	}
+	chainid, err = zevmClient.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	zevmAuth, err := bind.NewKeyedTransactorWithChainID(deployerPrivkey, chainid)
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.NewSmokeTestRunner(
+		DeployerAddress,
+		DeployerPrivateKey,
+		FungibleAdminMnemonic,
+		goerliClient,
+		zevmClient,
+		cctxClient,
+		zetaTxServer,
+		fungibleClient,
+		authClient,
+		bankClient,
+		observerClient,
+		goerliAuth,
+		zevmAuth,
+		btcRPCClient,
+	), nil
+}
@@ -0,0 +1,584 @@
+package inprocess
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+var _ zetaclient.BTCRPCClient = (*BitcoinStandIn)(nil)
+
+const (
+	// defaultBitcoinFeeRate is the fee rate in BTC/kB returned by the fee estimation
+	defaultBitcoinFeeRate = 0.0001
+
+	// bitcoinBlockReward is the value of the coinbase output of the generated blocks
+	bitcoinBlockReward = 50 * btcutil.SatoshiPerBitcoin
+)
+
+type bitcoinTx struct {
+	tx        *wire.MsgTx
+	blockHash *chainhash.Hash
+	height    int64
+	index     int
+}
+
+type bitcoinUTXO struct {
+	pkScript []byte
+	value    int64
+	height   int64 // -1 if the output is in the mempool
+	coinbase bool
+}
+
+// BitcoinStandIn is an in-memory bitcoin node implementing the RPC methods used by the zetaclient
+// Blocks are only produced on demand with GenerateToAddress, the transactions sent are kept in the mempool until then
+// The blocks satisfy the proof-of-work of the network so their headers can be verified by ZetaChain
+// The scripts of the inputs are not validated, only the existence of the spent outputs is checked
+// It also has a wallet and serves the bitcoind JSON-RPC API over HTTP so the smoketest runner can use it through an rpcclient
+type BitcoinStandIn struct {
+	mu      sync.Mutex
+	params  *chaincfg.Params
+	blocks  []*wire.MsgBlock
+	txs     map[chainhash.Hash]*bitcoinTx
+	utxos   map[wire.OutPoint]*bitcoinUTXO
+	mempool []*wire.MsgTx
+
+	wallets         map[string]bool
+	walletKeys      map[string]*btcec.PrivateKey
+	walletAddresses map[string]btcutil.Address
+
+	http *httptest.Server
+
+	// FeeRate is the fee rate in BTC/kB returned by EstimateSmartFee
+	FeeRate float64
+}
+
+// NewBitcoinStandIn returns a bitcoin stand-in containing only the genesis block of the network and an empty wallet
+func NewBitcoinStandIn(params *chaincfg.Params) *BitcoinStandIn {
+	b := &BitcoinStandIn{
+		params:          params,
+		blocks:          []*wire.MsgBlock{params.GenesisBlock},
+		txs:             make(map[chainhash.Hash]*bitcoinTx),
+		utxos:           make(map[wire.OutPoint]*bitcoinUTXO),
+		wallets:         make(map[string]bool),
+		walletKeys:      make(map[string]*btcec.PrivateKey),
+		walletAddresses: make(map[string]btcutil.Address),
+		FeeRate:         defaultBitcoinFeeRate,
+	}
+	b.http = httptest.NewServer(b)
+	return b
+}
+
+// GenerateToAddress mines n blocks paying the block reward to the address, the first block includes the mempool
+func (b *BitcoinStandIn) GenerateToAddress(n int, addr btcutil.Address) ([]*chainhash.Hash, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	hashes := make([]*chainhash.Hash, 0, n)
+	for i := 0; i < n; i++ {
+		height := int64(len(b.blocks))
+		coinbase, err := newCoinbaseTx(height, pkScript)
+		if err != nil {
+			return nil, err
+		}
+		txs := append([]*wire.MsgTx{coinbase}, b.mempool...)
+		b.mempool = nil
+
+		prev := b.blocks[len(b.blocks)-1]
+		block := wire.NewMsgBlock(wire.NewBlockHeader(
+			prev.Header.Version,
+			&chainhash.Hash{},
+			&chainhash.Hash{},
+			prev.Header.Bits,
+			0,
+		))
+		block.Header.PrevBlock = prev.BlockHash()
+		block.Header.Timestamp = prev.Header.Timestamp.Add(10 * time.Minute)
+		for _, tx := range txs {
+			if err := block.AddTransaction(tx); err != nil {
+				return nil, err
+			}
+		}
+		block.Header.MerkleRoot = merkleRoot(txs)
+		solveBlock(&block.Header)
+		blockHash := block.BlockHash()
+		b.blocks = append(b.blocks, block)
+
+		for index, tx := range txs {
+			txHash := tx.TxHash()
+			b.txs[txHash] = &bitcoinTx{
+				tx:        tx,
+				blockHash: &blockHash,
+				height:    height,
+				index:     index,
+			}
+			for vout, out := range tx.TxOut {
+				b.utxos[wire.OutPoint{Hash: txHash, Index: uint32(vout)}] = &bitcoinUTXO{
+					pkScript: out.PkScript,
+					value:    out.Value,
+					height:   height,
+					coinbase: index == 0,
+				}
+			}
+		}
+		hashes = append(hashes, &blockHash)
+	}
+	return hashes, nil
+}
+
+// SendToAddressWithMemo spends the outputs of the private key to send the amount to the address
+// The transaction contains a null data output with the memo after the payment output, as expected by the zetaclient for deposits
+// The change is returned to the sender and the transaction stays in the mempool until the next block is generated
+func (b *BitcoinStandIn) SendToAddressWithMemo(privKey *btcec.PrivateKey, to btcutil.Address, amount int64, memo []byte) (*chainhash.Hash, error) {
+	from, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(privKey.PubKey().SerializeCompressed()), b.params)
+	if err != nil {
+		return nil, err
+	}
+	fromScript, err := txscript.PayToAddrScript(from)
+	if err != nil {
+		return nil, err
+	}
+	toScript, err := txscript.PayToAddrScript(to)
+	if err != nil {
+		return nil, err
+	}
+	memoScript, err := txscript.NullDataScript(memo)
+	if err != nil {
+		return nil, err
+	}
+	fee := int64(b.FeeRate * btcutil.SatoshiPerBitcoin)
+
+	// select the confirmed outputs of the sender
+	b.mu.Lock()
+	outpoints := make([]wire.OutPoint, 0)
+	for outpoint, utxo := range b.utxos {
+		if utxo.height >= 0 && bytes.Equal(utxo.pkScript, fromScript) {
+			outpoints = append(outpoints, outpoint)
+		}
+	}
+	sortOutPoints(outpoints)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	values := make([]int64, 0)
+	total := int64(0)
+	for _, outpoint := range outpoints {
+		if total >= amount+fee {
+			break
+		}
+		op := outpoint
+		tx.AddTxIn(wire.NewTxIn(&op, nil, nil))
+		values = append(values, b.utxos[outpoint].value)
+		total += b.utxos[outpoint].value
+	}
+	b.mu.Unlock()
+	if total < amount+fee {
+		return nil, fmt.Errorf("insufficient funds for %s: %d < %d", from.EncodeAddress(), total, amount+fee)
+	}
+
+	tx.AddTxOut(wire.NewTxOut(amount, toScript))
+	tx.AddTxOut(wire.NewTxOut(0, memoScript))
+	if change := total - amount - fee; change > 0 {
+		tx.AddTxOut(wire.NewTxOut(change, fromScript))
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i := range tx.TxIn {
+		witness, err := txscript.WitnessSignature(tx, sigHashes, i, values[i], fromScript, txscript.SigHashAll, privKey, true)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxIn[i].Witness = witness
+	}
+	return b.SendRawTransaction(tx, true)
+}
+
+func (b *BitcoinStandIn) GetNetworkInfo() (*btcjson.GetNetworkInfoResult, error) {
+	return &btcjson.GetNetworkInfoResult{
+		Version:    210000,
+		SubVersion: "/Satoshi:0.21.0/",
+		RelayFee:   b.FeeRate / 10,
+	}, nil
+}
+
+func (b *BitcoinStandIn) SendRawTransaction(tx *wire.MsgTx, _ bool) (*chainhash.Hash, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	txHash := tx.TxHash()
+	if _, found := b.txs[txHash]; found {
+		return nil, fmt.Errorf("transaction %s already exists", txHash)
+	}
+	for _, in := range tx.TxIn {
+		if _, found := b.utxos[in.PreviousOutPoint]; !found {
+			return nil, fmt.Errorf("transaction %s spends missing or spent output %s", txHash, in.PreviousOutPoint)
+		}
+	}
+	for _, in := range tx.TxIn {
+		delete(b.utxos, in.PreviousOutPoint)
+	}
+	for vout, out := range tx.TxOut {
+		b.utxos[wire.OutPoint{Hash: txHash, Index: uint32(vout)}] = &bitcoinUTXO{
+			pkScript: out.PkScript,
+			value:    out.Value,
+			height:   -1,
+		}
+	}
+	b.txs[txHash] = &bitcoinTx{tx: tx, height: -1, index: -1}
+	b.mempool = append(b.mempool, tx)
+	return &txHash, nil
+}
+
+func (b *BitcoinStandIn) ListUnspentMinMaxAddresses(minConf int, maxConf int, addrs []btcutil.Address) ([]btcjson.ListUnspentResult, error) {
+	scripts := make(map[string]btcutil.Address, len(addrs))
+	for _, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		scripts[string(pkScript)] = addr
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	outpoints := make([]wire.OutPoint, 0)
+	for outpoint, utxo := range b.utxos {
+		if _, found := scripts[string(utxo.pkScript)]; found {
+			outpoints = append(outpoints, outpoint)
+		}
+	}
+	sortOutPoints(outpoints)
+
+	results := make([]btcjson.ListUnspentResult, 0, len(outpoints))
+	for _, outpoint := range outpoints {
+		utxo := b.utxos[outpoint]
+		confirmations := b.confirmations(utxo.height)
+		if confirmations < int64(minConf) || confirmations > int64(maxConf) {
+			continue
+		}
+		results = append(results, btcjson.ListUnspentResult{
+			TxID:          outpoint.Hash.String(),
+			Vout:          outpoint.Index,
+			Address:       scripts[string(utxo.pkScript)].EncodeAddress(),
+			ScriptPubKey:  hex.EncodeToString(utxo.pkScript),
+			Amount:        btcutil.Amount(utxo.value).ToBTC(),
+			Confirmations: confirmations,
+			Spendable:     true,
+		})
+	}
+	return results, nil
+}
+
+func (b *BitcoinStandIn) EstimateSmartFee(_ int64, _ *btcjson.EstimateSmartFeeMode) (*btcjson.EstimateSmartFeeResult, error) {
+	feeRate := b.FeeRate
+	return &btcjson.EstimateSmartFeeResult{
+		FeeRate: &feeRate,
+		Blocks:  1,
+	}, nil
+}
+
+func (b *BitcoinStandIn) GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, found := b.txs[*txHash]
+	if !found {
+		return nil, fmt.Errorf("transaction %s not found", txHash)
+	}
+	raw, err := serializeTx(entry.tx)
+	if err != nil {
+		return nil, err
+	}
+	result := &btcjson.GetTransactionResult{
+		TxID:          txHash.String(),
+		Hex:           raw,
+		Confirmations: b.confirmations(entry.height),
+		Time:          time.Now().Unix(),
+		TimeReceived:  time.Now().Unix(),
+	}
+	if entry.blockHash != nil {
+		result.BlockHash = entry.blockHash.String()
+		result.BlockIndex = int64(entry.index)
+		result.BlockTime = b.blocks[entry.height].Header.Timestamp.Unix()
+	}
+	return result, nil
+}
+
+func (b *BitcoinStandIn) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, found := b.txs[*txHash]
+	if !found {
+		return nil, fmt.Errorf("transaction %s not found", txHash)
+	}
+	return b.txRawResult(entry)
+}
+
+func (b *BitcoinStandIn) GetBlockCount() (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return int64(len(b.blocks) - 1), nil
+}
+
+func (b *BitcoinStandIn) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if blockHeight < 0 || blockHeight >= int64(len(b.blocks)) {
+		return nil, fmt.Errorf("block height %d out of range", blockHeight)
+	}
+	hash := b.blocks[blockHeight].BlockHash()
+	return &hash, nil
+}
+
+func (b *BitcoinStandIn) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	block, height, err := b.block(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	result := b.blockVerboseResult(block, height)
+	for _, tx := range block.Transactions {
+		result.Tx = append(result.Tx, tx.TxHash().String())
+	}
+	return &result, nil
+}
+
+func (b *BitcoinStandIn) GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	block, height, err := b.block(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	verbose := b.blockVerboseResult(block, height)
+	result := &btcjson.GetBlockVerboseTxResult{
+		Hash:          verbose.Hash,
+		Confirmations: verbose.Confirmations,
+		StrippedSize:  verbose.StrippedSize,
+		Size:          verbose.Size,
+		Weight:        verbose.Weight,
+		Height:        verbose.Height,
+		Version:       verbose.Version,
+		VersionHex:    verbose.VersionHex,
+		MerkleRoot:    verbose.MerkleRoot,
+		Time:          verbose.Time,
+		Nonce:         verbose.Nonce,
+		Bits:          verbose.Bits,
+		Difficulty:    verbose.Difficulty,
+		PreviousHash:  verbose.PreviousHash,
+		NextHash:      verbose.NextHash,
+	}
+	for index, tx := range block.Transactions {
+		entry, found := b.txs[tx.TxHash()]
+		if !found {
+			// the transactions of the genesis block are not indexed
+			entry = &bitcoinTx{tx: tx, blockHash: blockHash, height: height, index: index}
+		}
+		raw, err := b.txRawResult(entry)
+		if err != nil {
+			return nil, err
+		}
+		result.Tx = append(result.Tx, *raw)
+	}
+	return result, nil
+}
+
+func (b *BitcoinStandIn) GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	block, _, err := b.block(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	header := block.Header
+	return &header, nil
+}
+
+// block returns the block and its height from the hash
+func (b *BitcoinStandIn) block(blockHash *chainhash.Hash) (*wire.MsgBlock, int64, error) {
+	for height, block := range b.blocks {
+		if block.BlockHash() == *blockHash {
+			return block, int64(height), nil
+		}
+	}
+	return nil, 0, fmt.Errorf("block %s not found", blockHash)
+}
+
+// confirmations returns the number of confirmations of a block height, 0 for the mempool
+func (b *BitcoinStandIn) confirmations(height int64) int64 {
+	if height < 0 {
+		return 0
+	}
+	return int64(len(b.blocks)) - height
+}
+
+func (b *BitcoinStandIn) blockVerboseResult(block *wire.MsgBlock, height int64) btcjson.GetBlockVerboseResult {
+	result := btcjson.GetBlockVerboseResult{
+		Hash:          block.BlockHash().String(),
+		Confirmations: b.confirmations(height),
+		StrippedSize:  int32(block.SerializeSizeStripped()),
+		Size:          int32(block.SerializeSize()),
+		Weight:        int32(blockchain.GetBlockWeight(btcutil.NewBlock(block))),
+		Height:        height,
+		Version:       block.Header.Version,
+		VersionHex:    fmt.Sprintf("%08x", block.Header.Version),
+		MerkleRoot:    block.Header.MerkleRoot.String(),
+		Time:          block.Header.Timestamp.Unix(),
+		Nonce:         block.Header.Nonce,
+		Bits:          fmt.Sprintf("%08x", block.Header.Bits),
+		PreviousHash:  block.Header.PrevBlock.String(),
+	}
+	if height+1 < int64(len(b.blocks)) {
+		result.NextHash = b.blocks[height+1].BlockHash().String()
+	}
+	return result
+}
+
+func (b *BitcoinStandIn) txRawResult(entry *bitcoinTx) (*btcjson.TxRawResult, error) {
+	tx := entry.tx
+	raw, err := serializeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	vin := make([]btcjson.Vin, 0, len(tx.TxIn))
+	for _, in := range tx.TxIn {
+		if blockchain.IsCoinBaseTx(tx) {
+			vin = append(vin, btcjson.Vin{
+				Coinbase: hex.EncodeToString(in.SignatureScript),
+				Sequence: in.Sequence,
+			})
+			continue
+		}
+		witness := make([]string, 0, len(in.Witness))
+		for _, item := range in.Witness {
+			witness = append(witness, hex.EncodeToString(item))
+		}
+		vin = append(vin, btcjson.Vin{
+			Txid: in.PreviousOutPoint.Hash.String(),
+			Vout: in.PreviousOutPoint.Index,
+			ScriptSig: &btcjson.ScriptSig{
+				Hex: hex.EncodeToString(in.SignatureScript),
+			},
+			Witness:  witness,
+			Sequence: in.Sequence,
+		})
+	}
+
+	vout := make([]btcjson.Vout, 0, len(tx.TxOut))
+	for n, out := range tx.TxOut {
+		class, addrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(out.PkScript, b.params)
+		addresses := make([]string, 0, len(addrs))
+		for _, addr := range addrs {
+			addresses = append(addresses, addr.EncodeAddress())
+		}
+		vout = append(vout, btcjson.Vout{
+			Value: btcutil.Amount(out.Value).ToBTC(),
+			N:     uint32(n),
+			ScriptPubKey: btcjson.ScriptPubKeyResult{
+				Hex:       hex.EncodeToString(out.PkScript),
+				ReqSigs:   int32(reqSigs),
+				Type:      class.String(),
+				Addresses: addresses,
+			},
+		})
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	result := &btcjson.TxRawResult{
+		Hex:           raw,
+		Txid:          tx.TxHash().String(),
+		Hash:          tx.WitnessHash().String(),
+		Size:          int32(tx.SerializeSize()),
+		Vsize:         int32((weight + blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor),
+		Weight:        int32(weight),
+		Version:       uint32(tx.Version),
+		LockTime:      tx.LockTime,
+		Vin:           vin,
+		Vout:          vout,
+		Confirmations: uint64(b.confirmations(entry.height)),
+	}
+	if entry.blockHash != nil {
+		result.BlockHash = entry.blockHash.String()
+		result.Time = b.blocks[entry.height].Header.Timestamp.Unix()
+		result.Blocktime = result.Time
+	}
+	return result, nil
+}
+
+// newCoinbaseTx returns a coinbase transaction for the height paying the block reward to the script
+func newCoinbaseTx(height int64, pkScript []byte) (*wire.MsgTx, error) {
+	sigScript, err := txscript.NewScriptBuilder().AddInt64(height).AddInt64(0).Script()
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), sigScript, nil))
+	tx.AddTxOut(wire.NewTxOut(bitcoinBlockReward, pkScript))
+	return tx, nil
+}
+
+// solveBlock increments the nonce of the header until its hash satisfies the proof-of-work of the header bits
+// The target of the regtest network is met after two attempts on average
+func solveBlock(header *wire.BlockHeader) {
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return
+		}
+		header.Nonce++
+	}
+}
+
+// merkleRoot returns the merkle root of the transactions of a block
+func merkleRoot(txs []*wire.MsgTx) chainhash.Hash {
+	utilTxs := make([]*btcutil.Tx, 0, len(txs))
+	for _, tx := range txs {
+		utilTxs = append(utilTxs, btcutil.NewTx(tx))
+	}
+	store := blockchain.BuildMerkleTreeStore(utilTxs, false)
+	return *store[len(store)-1]
+}
+
+func serializeTx(tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+func sortOutPoints(outpoints []wire.OutPoint) {
+	sort.Slice(outpoints, func(i, j int) bool {
+		if outpoints[i].Hash != outpoints[j].Hash {
+			return bytes.Compare(outpoints[i].Hash[:], outpoints[j].Hash[:]) < 0
+		}
+		return outpoints[i].Index < outpoints[j].Index
+	})
+}
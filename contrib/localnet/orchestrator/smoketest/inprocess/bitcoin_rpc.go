@@ -0,0 +1,280 @@
+package inprocess
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// Endpoint returns the HTTP endpoint of the JSON-RPC server
+func (b *BitcoinStandIn) Endpoint() string {
+	return b.http.URL
+}
+
+// Client returns an RPC client connected to the JSON-RPC server in HTTP POST mode, as the smoketest runner connects to bitcoind
+func (b *BitcoinStandIn) Client() (*rpcclient.Client, error) {
+	return rpcclient.New(&rpcclient.ConnConfig{
+		Host:         strings.TrimPrefix(b.http.URL, "http://"),
+		User:         "smoketest",
+		Pass:         "123",
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Params:       b.params.Name,
+	}, nil)
+}
+
+// Close stops the JSON-RPC server
+func (b *BitcoinStandIn) Close() {
+	b.http.Close()
+}
+
+// ServeHTTP serves the bitcoind JSON-RPC API, the requests are not authenticated
+// The methods not implemented by the stand-in are reported as not found, getinfo in particular so the RPC client
+// detects a bitcoind backend
+func (b *BitcoinStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req btcjson.Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result interface{}
+	cmd, err := btcjson.UnmarshalCmd(&req)
+	if err == nil {
+		result, err = b.handleRPC(cmd)
+	}
+	bz, err := btcjson.MarshalResponse(btcjson.RpcVersion1, req.ID, result, rpcError(err))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// handleRPC executes a JSON-RPC command and returns its result
+func (b *BitcoinStandIn) handleRPC(cmd interface{}) (interface{}, error) {
+	switch cmd := cmd.(type) {
+	// chain
+	case *btcjson.GetNetworkInfoCmd:
+		return b.GetNetworkInfo()
+	case *btcjson.GetBlockCountCmd:
+		return b.GetBlockCount()
+	case *btcjson.GetBlockHashCmd:
+		hash, err := b.GetBlockHash(cmd.Index)
+		if err != nil {
+			return nil, err
+		}
+		return hash.String(), nil
+	case *btcjson.GetBlockCmd:
+		hash, err := chainhash.NewHashFromStr(cmd.Hash)
+		if err != nil {
+			return nil, err
+		}
+		switch intParam(cmd.Verbosity, 1) {
+		case 0:
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			block, _, err := b.block(hash)
+			if err != nil {
+				return nil, err
+			}
+			return serializeHex(block.Serialize)
+		case 1:
+			return b.GetBlockVerbose(hash)
+		default:
+			return b.GetBlockVerboseTx(hash)
+		}
+	case *btcjson.GetBlockHeaderCmd:
+		hash, err := chainhash.NewHashFromStr(cmd.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if cmd.Verbose == nil || *cmd.Verbose {
+			return nil, errors.New("verbose block headers are not supported")
+		}
+		header, err := b.GetBlockHeader(hash)
+		if err != nil {
+			return nil, err
+		}
+		return serializeHex(header.Serialize)
+	case *btcjson.GetRawTransactionCmd:
+		hash, err := chainhash.NewHashFromStr(cmd.Txid)
+		if err != nil {
+			return nil, err
+		}
+		result, err := b.GetRawTransactionVerbose(hash)
+		if err != nil {
+			return nil, err
+		}
+		if intParam(cmd.Verbose, 0) == 0 {
+			return result.Hex, nil
+		}
+		return result, nil
+	case *btcjson.SendRawTransactionCmd:
+		tx, err := deserializeTx(cmd.HexTx)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := b.SendRawTransaction(tx, true)
+		if err != nil {
+			return nil, err
+		}
+		return hash.String(), nil
+	case *btcjson.CreateRawTransactionCmd:
+		amounts := make(map[btcutil.Address]btcutil.Amount, len(cmd.Amounts))
+		for encoded, value := range cmd.Amounts {
+			addr, err := btcutil.DecodeAddress(encoded, b.params)
+			if err != nil {
+				return nil, err
+			}
+			if amounts[addr], err = btcutil.NewAmount(value); err != nil {
+				return nil, err
+			}
+		}
+		tx, err := b.CreateRawTransaction(cmd.Inputs, amounts, cmd.LockTime)
+		if err != nil {
+			return nil, err
+		}
+		return serializeTx(tx)
+	case *btcjson.EstimateSmartFeeCmd:
+		return b.EstimateSmartFee(cmd.ConfTarget, cmd.EstimateMode)
+	case *btcjson.GenerateToAddressCmd:
+		addr, err := btcutil.DecodeAddress(cmd.Address, b.params)
+		if err != nil {
+			return nil, err
+		}
+		hashes, err := b.GenerateToAddress(int(cmd.NumBlocks), addr)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]string, 0, len(hashes))
+		for _, hash := range hashes {
+			result = append(result, hash.String())
+		}
+		return result, nil
+
+	// wallet
+	case *btcjson.CreateWalletCmd:
+		if err := b.CreateWallet(cmd.WalletName); err != nil {
+			return nil, err
+		}
+		return btcjson.CreateWalletResult{Name: cmd.WalletName}, nil
+	case *btcjson.ImportPrivKeyCmd:
+		wif, err := btcutil.DecodeWIF(cmd.PrivKey)
+		if err != nil {
+			return nil, err
+		}
+		return nil, b.ImportPrivKey(wif)
+	case *btcjson.ImportAddressCmd:
+		addr, err := btcutil.DecodeAddress(cmd.Address, b.params)
+		if err != nil {
+			return nil, err
+		}
+		return nil, b.ImportAddress(addr)
+	case *btcjson.ListUnspentCmd:
+		results, err := b.ListUnspent(intParam(cmd.MinConf, 1), intParam(cmd.MaxConf, 9999999))
+		if err != nil || cmd.Addresses == nil {
+			return results, err
+		}
+		filtered := make([]btcjson.ListUnspentResult, 0, len(results))
+		for _, result := range results {
+			for _, addr := range *cmd.Addresses {
+				if result.Address == addr {
+					filtered = append(filtered, result)
+				}
+			}
+		}
+		return filtered, nil
+	case *btcjson.GetBalanceCmd:
+		balance, err := b.GetBalance(intParam(cmd.MinConf, 1))
+		if err != nil {
+			return nil, err
+		}
+		return balance.ToBTC(), nil
+	case *btcjson.GetBalancesCmd:
+		return b.GetBalances()
+	case *btcjson.GetTransactionCmd:
+		hash, err := chainhash.NewHashFromStr(cmd.Txid)
+		if err != nil {
+			return nil, err
+		}
+		return b.GetTransaction(hash)
+	case *btcjson.SignRawTransactionWithWalletCmd:
+		tx, err := deserializeTx(cmd.RawTx)
+		if err != nil {
+			return nil, err
+		}
+		signed, complete, err := b.SignRawTransactionWithWallet(tx)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := serializeTx(signed)
+		if err != nil {
+			return nil, err
+		}
+		return btcjson.SignRawTransactionWithWalletResult{Hex: raw, Complete: complete}, nil
+	default:
+		return nil, btcjson.ErrRPCMethodNotFound
+	}
+}
+
+// rpcError returns the JSON-RPC error of an error, nil if there is no error
+func rpcError(err error) *btcjson.RPCError {
+	if err == nil {
+		return nil
+	}
+	var rpcErr *btcjson.RPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr
+	}
+	var jsonErr btcjson.Error
+	if errors.As(err, &jsonErr) {
+		if jsonErr.ErrorCode == btcjson.ErrUnregisteredMethod {
+			return btcjson.ErrRPCMethodNotFound
+		}
+		return btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter, err.Error())
+	}
+	return btcjson.NewRPCError(btcjson.ErrRPCMisc, err.Error())
+}
+
+// intParam returns the value of an optional integer parameter or its default value
+func intParam(param *int, defaultValue int) int {
+	if param == nil {
+		return defaultValue
+	}
+	return *param
+}
+
+// serializeHex returns the hex encoding of a serialized block or header
+func serializeHex(serialize func(w io.Writer) error) (string, error) {
+	var buf bytes.Buffer
+	if err := serialize(&buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// deserializeTx returns the transaction of a hex encoding
+func deserializeTx(raw string) (*wire.MsgTx, error) {
+	bz, err := hex.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(bz)); err != nil {
+		return nil, fmt.Errorf("failed to deserialize transaction: %w", err)
+	}
+	return tx, nil
+}
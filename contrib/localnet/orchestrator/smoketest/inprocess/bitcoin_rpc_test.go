@@ -0,0 +1,133 @@
+package inprocess
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBitcoinAddress(t *testing.T) (*btcec.PrivateKey, *btcutil.AddressWitnessPubKeyHash) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(privKey.PubKey().SerializeCompressed()),
+		&chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	return privKey, addr
+}
+
+func TestBitcoinStandIn_RPC(t *testing.T) {
+	b := NewBitcoinStandIn(&chaincfg.RegressionNetParams)
+	defer b.Close()
+	client, err := b.Client()
+	require.NoError(t, err)
+	defer client.Shutdown()
+
+	// the wallet is set up as by the smoketest runner
+	_, err = client.CreateWallet("smoketest", rpcclient.WithCreateWalletBlank())
+	require.NoError(t, err)
+	_, err = client.CreateWallet("smoketest", rpcclient.WithCreateWalletBlank())
+	require.ErrorContains(t, err, "Database already exists")
+
+	deployerKey, deployer := newTestBitcoinAddress(t)
+	wif, err := btcutil.NewWIF(deployerKey, &chaincfg.RegressionNetParams, true)
+	require.NoError(t, err)
+	require.NoError(t, client.ImportPrivKeyRescan(wif, "deployer", true))
+	_, tss := newTestBitcoinAddress(t)
+	require.NoError(t, client.ImportAddress(tss.EncodeAddress()))
+
+	// the first coinbase output is mature after 101 blocks
+	hashes, err := client.GenerateToAddress(101, deployer, nil)
+	require.NoError(t, err)
+	require.Len(t, hashes, 101)
+	balance, err := client.GetBalance("*")
+	require.NoError(t, err)
+	require.EqualValues(t, bitcoinBlockReward, balance)
+	balances, err := client.GetBalances()
+	require.NoError(t, err)
+	require.EqualValues(t, 50, balances.Mine.Trusted)
+	require.EqualValues(t, 100*50, balances.Mine.Immature)
+	require.NotNil(t, balances.WatchOnly)
+
+	// a raw transaction is created, signed by the wallet and sent to the TSS address
+	utxos, err := client.ListUnspent()
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	require.True(t, utxos[0].Spendable)
+	inputs := []btcjson.TransactionInput{{Txid: utxos[0].TxID, Vout: utxos[0].Vout}}
+	tx, err := client.CreateRawTransaction(inputs, map[btcutil.Address]btcutil.Amount{
+		tss:      btcutil.SatoshiPerBitcoin,
+		deployer: 49 * btcutil.SatoshiPerBitcoin,
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, tx.TxOut, 2)
+	signed, complete, err := client.SignRawTransactionWithWallet2(tx, []btcjson.RawTxWitnessInput{{
+		Txid:         utxos[0].TxID,
+		Vout:         utxos[0].Vout,
+		ScriptPubKey: utxos[0].ScriptPubKey,
+		Amount:       &utxos[0].Amount,
+	}})
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	// the witness of the signed transaction is valid
+	deployerScript, err := txscript.PayToAddrScript(deployer)
+	require.NoError(t, err)
+	engine, err := txscript.NewEngine(
+		deployerScript,
+		signed,
+		0,
+		txscript.StandardVerifyFlags,
+		nil,
+		txscript.NewTxSigHashes(signed),
+		bitcoinBlockReward,
+	)
+	require.NoError(t, err)
+	require.NoError(t, engine.Execute())
+
+	txHash, err := client.SendRawTransaction(signed, true)
+	require.NoError(t, err)
+	_, err = client.GenerateToAddress(1, deployer, nil)
+	require.NoError(t, err)
+
+	// the transaction is confirmed and the output of the TSS address is watch-only
+	result, err := client.GetTransaction(txHash)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.Confirmations)
+	require.EqualValues(t, 1, result.BlockIndex)
+	utxos, err = client.ListUnspent()
+	require.NoError(t, err)
+	require.Len(t, utxos, 3)
+	require.True(t, utxos[0].Spendable)
+	require.Equal(t, tss.EncodeAddress(), utxos[2].Address)
+	require.False(t, utxos[2].Spendable)
+	utxos, err = client.ListUnspentMinMaxAddresses(1, 9999999, []btcutil.Address{tss})
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+
+	raw, err := client.GetRawTransactionVerbose(txHash)
+	require.NoError(t, err)
+	require.Len(t, raw.Vin, 1)
+	require.Len(t, raw.Vin[0].Witness, 2)
+	blockHash, err := client.GetBlockHash(102)
+	require.NoError(t, err)
+	require.Equal(t, result.BlockHash, blockHash.String())
+	block, err := client.GetBlockVerboseTx(blockHash)
+	require.NoError(t, err)
+	require.Len(t, block.Tx, 2)
+	require.Equal(t, txHash.String(), block.Tx[1].Txid)
+	header, err := client.GetBlockHeader(blockHash)
+	require.NoError(t, err)
+	require.Equal(t, *blockHash, header.BlockHash())
+
+	// the methods not implemented are not found
+	_, err = client.GetInfo()
+	require.ErrorContains(t, err, "Method not found")
+}
@@ -0,0 +1,227 @@
+package inprocess
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// The wallet of the stand-in implements the bitcoind wallet methods used by the smoketest runner
+// The P2WPKH outputs of the imported private keys are spendable, the outputs of the imported addresses are watch-only
+// A single wallet is shared by all the wallet names, the imported keys and addresses are used without a rescan
+
+// CreateWallet creates a wallet, an error is returned if the wallet already exists as by bitcoind
+func (b *BitcoinStandIn) CreateWallet(name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.wallets[name] {
+		return fmt.Errorf("wallet file verification failed, failed to create database path %s: Database already exists", name)
+	}
+	b.wallets[name] = true
+	return nil
+}
+
+// ImportPrivKey imports the private key in the wallet, its P2WPKH outputs are spendable
+func (b *BitcoinStandIn) ImportPrivKey(wif *btcutil.WIF) error {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(wif.SerializePubKey()), b.params)
+	if err != nil {
+		return err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.walletAddresses[string(pkScript)] = addr
+	b.walletKeys[string(pkScript)] = wif.PrivKey
+	return nil
+}
+
+// ImportAddress imports the address in the wallet, its outputs are watch-only
+func (b *BitcoinStandIn) ImportAddress(addr btcutil.Address) error {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.walletAddresses[string(pkScript)] = addr
+	return nil
+}
+
+// ListUnspent returns the outputs of the wallet with a number of confirmations within the range
+// The immature coinbase outputs are not listed, the spendable outputs are listed before the watch-only ones
+func (b *BitcoinStandIn) ListUnspent(minConf, maxConf int) ([]btcjson.ListUnspentResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	outpoints := make([]wire.OutPoint, 0)
+	for outpoint, utxo := range b.utxos {
+		if _, found := b.walletAddresses[string(utxo.pkScript)]; found && !b.immature(utxo) {
+			outpoints = append(outpoints, outpoint)
+		}
+	}
+	sortOutPoints(outpoints)
+	sort.SliceStable(outpoints, func(i, j int) bool {
+		_, spendableI := b.walletKeys[string(b.utxos[outpoints[i]].pkScript)]
+		_, spendableJ := b.walletKeys[string(b.utxos[outpoints[j]].pkScript)]
+		return spendableI && !spendableJ
+	})
+
+	results := make([]btcjson.ListUnspentResult, 0, len(outpoints))
+	for _, outpoint := range outpoints {
+		utxo := b.utxos[outpoint]
+		confirmations := b.confirmations(utxo.height)
+		if confirmations < int64(minConf) || confirmations > int64(maxConf) {
+			continue
+		}
+		_, spendable := b.walletKeys[string(utxo.pkScript)]
+		results = append(results, btcjson.ListUnspentResult{
+			TxID:          outpoint.Hash.String(),
+			Vout:          outpoint.Index,
+			Address:       b.walletAddresses[string(utxo.pkScript)].EncodeAddress(),
+			ScriptPubKey:  hex.EncodeToString(utxo.pkScript),
+			Amount:        btcutil.Amount(utxo.value).ToBTC(),
+			Confirmations: confirmations,
+			Spendable:     spendable,
+		})
+	}
+	return results, nil
+}
+
+// GetBalance returns the balance of the spendable and mature outputs of the wallet with at least minConf confirmations
+func (b *BitcoinStandIn) GetBalance(minConf int) (btcutil.Amount, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	balance := btcutil.Amount(0)
+	for _, utxo := range b.utxos {
+		_, spendable := b.walletKeys[string(utxo.pkScript)]
+		if spendable && !b.immature(utxo) && b.confirmations(utxo.height) >= int64(minConf) {
+			balance += btcutil.Amount(utxo.value)
+		}
+	}
+	return balance, nil
+}
+
+// GetBalances returns the balances of the spendable and of the watch-only outputs of the wallet
+func (b *BitcoinStandIn) GetBalances() (*btcjson.GetBalancesResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var mine, watchOnly btcjson.BalanceDetailsResult
+	hasWatchOnly := false
+	for pkScript := range b.walletAddresses {
+		if _, spendable := b.walletKeys[pkScript]; !spendable {
+			hasWatchOnly = true
+		}
+	}
+	for _, utxo := range b.utxos {
+		if _, found := b.walletAddresses[string(utxo.pkScript)]; !found {
+			continue
+		}
+		balance := &watchOnly
+		if _, spendable := b.walletKeys[string(utxo.pkScript)]; spendable {
+			balance = &mine
+		}
+		amount := btcutil.Amount(utxo.value).ToBTC()
+		switch {
+		case utxo.height < 0:
+			balance.UntrustedPending += amount
+		case b.immature(utxo):
+			balance.Immature += amount
+		default:
+			balance.Trusted += amount
+		}
+	}
+
+	result := &btcjson.GetBalancesResult{Mine: mine}
+	if hasWatchOnly {
+		result.WatchOnly = &watchOnly
+	}
+	return result, nil
+}
+
+// CreateRawTransaction returns an unsigned transaction spending the inputs to the amounts
+// The outputs are ordered by address as the amounts are sent by the RPC client in a JSON object
+func (b *BitcoinStandIn) CreateRawTransaction(
+	inputs []btcjson.TransactionInput,
+	amounts map[btcutil.Address]btcutil.Amount,
+	lockTime *int64,
+) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, input := range inputs {
+		hash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, input.Vout), nil, nil))
+	}
+
+	addrs := make([]btcutil.Address, 0, len(amounts))
+	for addr := range amounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].EncodeAddress() < addrs[j].EncodeAddress()
+	})
+	for _, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(amounts[addr]), pkScript))
+	}
+
+	if lockTime != nil {
+		tx.LockTime = uint32(*lockTime)
+	}
+	return tx, nil
+}
+
+// SignRawTransactionWithWallet signs the inputs of the transaction spending the P2WPKH outputs of the imported keys
+// The spent outputs are looked up in the stand-in, the transaction is complete if all its inputs are signed
+func (b *BitcoinStandIn) SignRawTransactionWithWallet(tx *wire.MsgTx) (*wire.MsgTx, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	signed := tx.Copy()
+	sigHashes := txscript.NewTxSigHashes(signed)
+	complete := true
+	for i, in := range signed.TxIn {
+		utxo, found := b.utxos[in.PreviousOutPoint]
+		if !found {
+			complete = false
+			continue
+		}
+		privKey, found := b.walletKeys[string(utxo.pkScript)]
+		if !found {
+			complete = false
+			continue
+		}
+		witness, err := txscript.WitnessSignature(signed, sigHashes, i, utxo.value, utxo.pkScript, txscript.SigHashAll, privKey, true)
+		if err != nil {
+			return nil, false, err
+		}
+		in.Witness = witness
+	}
+	return signed, complete, nil
+}
+
+// immature returns true if the output is a coinbase output not spendable yet
+// As by bitcoind, a coinbase output is mature once it has one more confirmation than the coinbase maturity
+func (b *BitcoinStandIn) immature(utxo *bitcoinUTXO) bool {
+	return utxo.coinbase && b.confirmations(utxo.height) <= int64(b.params.CoinbaseMaturity)
+}
@@ -0,0 +1,388 @@
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// evmGasLimit is the block gas limit of the simulated EVM chain
+	evmGasLimit = 30_000_000
+)
+
+// SimulatedEVM is an EVM chain backed by a go-ethereum simulated backend, its chain ID is 1337 as the localnet Goerli chain
+// It serves a subset of the Ethereum JSON-RPC API in process and over HTTP so the chain can be used through an ethclient
+// Transactions are mined as soon as they are sent and empty blocks are produced at a regular interval
+type SimulatedEVM struct {
+	backend   *backends.SimulatedBackend
+	rpcServer *rpc.Server
+	http      *httptest.Server
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSimulatedEVM creates a simulated EVM chain with the given genesis allocation
+// An empty block is produced every blockTime, blocks are only produced by transactions if blockTime is zero
+func NewSimulatedEVM(alloc core.GenesisAlloc, blockTime time.Duration) (*SimulatedEVM, error) {
+	evm := &SimulatedEVM{
+		backend: backends.NewSimulatedBackend(alloc, evmGasLimit),
+		stop:    make(chan struct{}),
+	}
+
+	evm.rpcServer = rpc.NewServer()
+	if err := evm.rpcServer.RegisterName("eth", &evmEthAPI{evm: evm}); err != nil {
+		return nil, err
+	}
+	if err := evm.rpcServer.RegisterName("net", &evmNetAPI{evm: evm}); err != nil {
+		return nil, err
+	}
+	evm.http = httptest.NewServer(evm.rpcServer)
+
+	if blockTime > 0 {
+		evm.wg.Add(1)
+		go evm.produceBlocks(blockTime)
+	}
+	return evm, nil
+}
+
+// Endpoint returns the HTTP endpoint of the JSON-RPC server
+func (evm *SimulatedEVM) Endpoint() string {
+	return evm.http.URL
+}
+
+// Client returns an ethclient connected in process to the JSON-RPC server
+func (evm *SimulatedEVM) Client() *ethclient.Client {
+	return ethclient.NewClient(rpc.DialInProc(evm.rpcServer))
+}
+
+// Backend returns the underlying simulated backend
+func (evm *SimulatedEVM) Backend() *backends.SimulatedBackend {
+	return evm.backend
+}
+
+// Commit mines the pending transactions in a new block
+func (evm *SimulatedEVM) Commit() ethcommon.Hash {
+	evm.mu.Lock()
+	defer evm.mu.Unlock()
+	return evm.backend.Commit()
+}
+
+// Close stops the block production and the JSON-RPC server
+func (evm *SimulatedEVM) Close() {
+	close(evm.stop)
+	evm.wg.Wait()
+	evm.http.Close()
+	evm.rpcServer.Stop()
+	_ = evm.backend.Close()
+}
+
+// produceBlocks commits a block at every tick until the chain is closed
+func (evm *SimulatedEVM) produceBlocks(blockTime time.Duration) {
+	defer evm.wg.Done()
+	ticker := time.NewTicker(blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			evm.Commit()
+		case <-evm.stop:
+			return
+		}
+	}
+}
+
+// blockNumber converts a JSON-RPC block number to the number expected by the simulated backend, nil is the latest block
+func blockNumber(number rpc.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+// blockNumberOrHash converts a JSON-RPC block reference to the number expected by the simulated backend
+func (evm *SimulatedEVM) blockNumberOrHash(ctx context.Context, ref rpc.BlockNumberOrHash) (*big.Int, error) {
+	if number, ok := ref.Number(); ok {
+		return blockNumber(number), nil
+	}
+	hash, _ := ref.Hash()
+	header, err := evm.backend.HeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return header.Number, nil
+}
+
+// evmEthAPI implements the eth namespace of the JSON-RPC API on top of the simulated backend
+type evmEthAPI struct {
+	evm *SimulatedEVM
+}
+
+// evmCallArgs are the arguments of the calls and gas estimations
+type evmCallArgs struct {
+	From     *ethcommon.Address `json:"from"`
+	To       *ethcommon.Address `json:"to"`
+	Gas      *hexutil.Uint64    `json:"gas"`
+	GasPrice *hexutil.Big       `json:"gasPrice"`
+	Value    *hexutil.Big       `json:"value"`
+	Data     *hexutil.Bytes     `json:"data"`
+	Input    *hexutil.Bytes     `json:"input"`
+}
+
+func (args evmCallArgs) toCallMsg() ethereum.CallMsg {
+	msg := ethereum.CallMsg{To: args.To}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+func (api *evmEthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(ethparams.AllEthashProtocolChanges.ChainID)
+}
+
+func (api *evmEthAPI) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := api.evm.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Number.Uint64()), nil
+}
+
+func (api *evmEthAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	block, err := api.evm.backend.BlockByNumber(ctx, blockNumber(number))
+	if err != nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+func (api *evmEthAPI) GetBlockByHash(ctx context.Context, hash ethcommon.Hash, fullTx bool) (map[string]interface{}, error) {
+	block, err := api.evm.backend.BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+func (api *evmEthAPI) GetTransactionByHash(ctx context.Context, hash ethcommon.Hash) (map[string]interface{}, error) {
+	tx, isPending, err := api.evm.backend.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if isPending {
+		return marshalTx(tx, ethcommon.Hash{}, nil, 0)
+	}
+	receipt, err := api.evm.backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return marshalTx(tx, receipt.BlockHash, receipt.BlockNumber, receipt.TransactionIndex)
+}
+
+func (api *evmEthAPI) GetTransactionReceipt(ctx context.Context, hash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	receipt, err := api.evm.backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+func (api *evmEthAPI) GetTransactionCount(ctx context.Context, address ethcommon.Address, ref rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	if number, ok := ref.Number(); ok && number == rpc.PendingBlockNumber {
+		nonce, err := api.evm.backend.PendingNonceAt(ctx, address)
+		return hexutil.Uint64(nonce), err
+	}
+	number, err := api.evm.blockNumberOrHash(ctx, ref)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := api.evm.backend.NonceAt(ctx, address, number)
+	return hexutil.Uint64(nonce), err
+}
+
+func (api *evmEthAPI) GetBalance(ctx context.Context, address ethcommon.Address, ref rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	number, err := api.evm.blockNumberOrHash(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := api.evm.backend.BalanceAt(ctx, address, number)
+	return (*hexutil.Big)(balance), err
+}
+
+func (api *evmEthAPI) GetCode(ctx context.Context, address ethcommon.Address, ref rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if number, ok := ref.Number(); ok && number == rpc.PendingBlockNumber {
+		return api.evm.backend.PendingCodeAt(ctx, address)
+	}
+	number, err := api.evm.blockNumberOrHash(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return api.evm.backend.CodeAt(ctx, address, number)
+}
+
+func (api *evmEthAPI) GetStorageAt(ctx context.Context, address ethcommon.Address, key ethcommon.Hash, ref rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	number, err := api.evm.blockNumberOrHash(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return api.evm.backend.StorageAt(ctx, address, key, number)
+}
+
+func (api *evmEthAPI) Call(ctx context.Context, args evmCallArgs, ref rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if number, ok := ref.Number(); ok && number == rpc.PendingBlockNumber {
+		return api.evm.backend.PendingCallContract(ctx, args.toCallMsg())
+	}
+	number, err := api.evm.blockNumberOrHash(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return api.evm.backend.CallContract(ctx, args.toCallMsg(), number)
+}
+
+func (api *evmEthAPI) EstimateGas(ctx context.Context, args evmCallArgs) (hexutil.Uint64, error) {
+	gas, err := api.evm.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+func (api *evmEthAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	gasPrice, err := api.evm.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(gasPrice), err
+}
+
+func (api *evmEthAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := api.evm.backend.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+// SendRawTransaction adds the transaction to the pending block and mines it
+func (api *evmEthAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (ethcommon.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return ethcommon.Hash{}, err
+	}
+
+	api.evm.mu.Lock()
+	defer api.evm.mu.Unlock()
+	if err := api.evm.backend.SendTransaction(ctx, tx); err != nil {
+		return ethcommon.Hash{}, err
+	}
+	api.evm.backend.Commit()
+	return tx.Hash(), nil
+}
+
+func (api *evmEthAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]ethtypes.Log, error) {
+	logs, err := api.evm.backend.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		logs = []ethtypes.Log{}
+	}
+	return logs, nil
+}
+
+// evmNetAPI implements the net namespace of the JSON-RPC API
+type evmNetAPI struct {
+	evm *SimulatedEVM
+}
+
+func (api *evmNetAPI) Version() string {
+	return ethparams.AllEthashProtocolChanges.ChainID.String()
+}
+
+// marshalBlock returns the JSON-RPC representation of a block
+func marshalBlock(block *ethtypes.Block, fullTx bool) (map[string]interface{}, error) {
+	fields, err := marshalFields(block.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]interface{}, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		// #nosec G701 always in range
+		txs[i], err = marshalTx(tx, block.Hash(), block.Number(), uint(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	uncles := make([]ethcommon.Hash, len(block.Uncles()))
+	for i, uncle := range block.Uncles() {
+		uncles[i] = uncle.Hash()
+	}
+
+	fields["transactions"] = txs
+	fields["uncles"] = uncles
+	fields["size"] = hexutil.Uint64(block.Size())
+	return fields, nil
+}
+
+// marshalTx returns the JSON-RPC representation of a transaction, the block hash is empty for a pending transaction
+func marshalTx(tx *ethtypes.Transaction, blockHash ethcommon.Hash, number *big.Int, index uint) (map[string]interface{}, error) {
+	fields, err := marshalFields(tx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(ethparams.AllEthashProtocolChanges.ChainID), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields["from"] = from
+	if blockHash != (ethcommon.Hash{}) {
+		fields["blockHash"] = blockHash
+		fields["blockNumber"] = (*hexutil.Big)(number)
+		fields["transactionIndex"] = hexutil.Uint64(index)
+	}
+	return fields, nil
+}
+
+// marshalFields returns the JSON fields of the value as a map
+func marshalFields(v json.Marshaler) (map[string]interface{}, error) {
+	bz, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
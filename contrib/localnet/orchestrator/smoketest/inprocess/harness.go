@@ -0,0 +1,214 @@
+// Package inprocess runs the smoketests as Go tests without the docker-compose localnet
+// ZetaChain runs as an in-process testutil network, Goerli as a go-ethereum simulated backend and bitcoin as an in-memory stand-in
+// A single zetaclient observes and signs for the chains with a local single-party TSS
+package inprocess
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethcfg "github.com/evmos/ethermint/cmd/config"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/app"
+	cmdcfg "github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/txserver"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
+	"github.com/zeta-chain/zetacore/testutil/network"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc"
+)
+
+var (
+	// DeployerAddress is the account deploying the contracts and running the smoketests, funded on Goerli and ZEVM
+	DeployerAddress       = ethcommon.HexToAddress("0xE5C5367B8224807Ac2207d350E60e1b6F27a7ecC")
+	DeployerPrivateKey    = "d87baf7bf6dc560a252596678c12e41f7d1682837f05b29d411bc3f78ae2c263"                                   // #nosec G101 - used for testing
+	FungibleAdminMnemonic = "snow grace federal cupboard arrive fancy gym lady uniform rotate exercise either leave alien grass" // #nosec G101 - used for testing
+
+	// EVMBlockTime is the interval between the blocks of the simulated Goerli chain
+	EVMBlockTime = time.Second
+
+	sdkConfigOnce sync.Once
+)
+
+// Harness is an in-process localnet with the smoketest runner set up against it
+// The zetaclient uses the bitcoin stand-in in process and the runner through its bitcoind JSON-RPC server
+type Harness struct {
+	Runner  *runner.SmokeTestRunner
+	Network *network.Network
+	EVM     *SimulatedEVM
+	Bitcoin *BitcoinStandIn
+	TSS     *LocalTSS
+
+	zetaClient *zetaClient
+}
+
+// New starts the chains and the zetaclient, deploys the contracts and deposits the initial funds as the localnet smoketest does
+// The ZetaChain node listens on the default ports, only one harness can run at a time
+// Everything is stopped at the end of the test
+func New(t *testing.T) *Harness {
+	setSDKConfig()
+	chdirTemp(t)
+
+	h := &Harness{}
+	t.Cleanup(h.cleanup)
+
+	// local TSS
+	tssKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	h.TSS, err = NewLocalTSS(tssKey, common.BtcRegtestChain().ChainId)
+	require.NoError(t, err)
+
+	// simulated Goerli, the TSS is funded to pay for the outbound transactions
+	funds := new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18))
+	h.EVM, err = NewSimulatedEVM(core.GenesisAlloc{
+		DeployerAddress:    {Balance: funds},
+		h.TSS.EVMAddress(): {Balance: funds},
+	}, EVMBlockTime)
+	require.NoError(t, err)
+
+	// bitcoin stand-in, its blocks are generated by the runner as with the bitcoind of the localnet
+	h.Bitcoin = NewBitcoinStandIn(h.TSS.BitcoinParams)
+
+	// ZetaChain
+	cfg := newZetaChainConfig(t, h.TSS, FungibleAdminMnemonic, []ethcommon.Address{DeployerAddress})
+	h.Network, err = network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	_, err = h.Network.WaitForHeight(2)
+	require.NoError(t, err)
+
+	h.Runner = newRunner(t, h.EVM, h.Bitcoin, h.Network.Validators[0])
+	h.Runner.SetTSSAddresses()
+	h.Runner.SetupBitcoin()
+
+	// zetaclient, started once the bitcoin blocks of the setup are generated so it scans bitcoin from the tip as it
+	// observes a block per tick
+	h.zetaClient = startZetaClient(
+		t,
+		h.Network.Validators[0],
+		h.TSS,
+		h.EVM.Endpoint(),
+		h.Bitcoin,
+		filepath.Join(t.TempDir(), "chainobserver"),
+	)
+	h.Runner.SetupEVM(false)
+	h.Runner.SetZEVMContracts()
+	h.Runner.DepositEtherIntoZRC20()
+	h.Runner.SendZetaIn()
+	h.Runner.DepositBTC()
+	h.Runner.SetupZEVMSwapApp()
+	h.Runner.SetupContextApp()
+
+	return h
+}
+
+// RunSmokeTests runs the smoketests and waits for the asynchronous ones to complete
+func (h *Harness) RunSmokeTests(smokeTests ...runner.SmokeTest) {
+	h.Runner.RunSmokeTests(smokeTests)
+	h.Runner.WG.Wait()
+}
+
+// cleanup stops the harness, the JSON-RPC server of the bitcoin stand-in is kept open as the bitcoin smoketests
+// and the runner keep generating blocks from goroutines panicking on errors
+func (h *Harness) cleanup() {
+	if h.zetaClient != nil {
+		h.zetaClient.stop()
+	}
+	if h.Network != nil {
+		h.Network.Cleanup()
+	}
+	if h.EVM != nil {
+		h.EVM.Close()
+	}
+}
+
+// newRunner returns a smoketest runner connected to the simulated Goerli, to the bitcoin stand-in and to the validator
+func newRunner(t *testing.T, evm *SimulatedEVM, btc *BitcoinStandIn, val *network.Validator) *runner.SmokeTestRunner {
+	deployerKey, err := crypto.HexToECDSA(DeployerPrivateKey)
+	require.NoError(t, err)
+
+	goerliClient := evm.Client()
+	goerliChainID, err := goerliClient.ChainID(context.Background())
+	require.NoError(t, err)
+	goerliAuth, err := bind.NewKeyedTransactorWithChainID(deployerKey, goerliChainID)
+	require.NoError(t, err)
+
+	zevmClient, err := ethclient.Dial(zevmEndpoint)
+	require.NoError(t, err)
+	zevmChainID, err := zevmClient.ChainID(context.Background())
+	require.NoError(t, err)
+	zevmAuth, err := bind.NewKeyedTransactorWithChainID(deployerKey, zevmChainID)
+	require.NoError(t, err)
+
+	grpcConn, err := grpc.Dial(zetaCoreGRPCAddress, grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = grpcConn.Close()
+	})
+
+	btcRPCClient, err := btc.Client()
+	require.NoError(t, err)
+
+	zetaTxServer, err := txserver.NewZetaTxServer(
+		val.RPCAddress,
+		[]string{utils.FungibleAdminName},
+		[]string{FungibleAdminMnemonic},
+		ZetaChainID,
+	)
+	require.NoError(t, err)
+
+	return runner.NewSmokeTestRunner(
+		DeployerAddress,
+		DeployerPrivateKey,
+		FungibleAdminMnemonic,
+		goerliClient,
+		zevmClient,
+		crosschaintypes.NewQueryClient(grpcConn),
+		zetaTxServer,
+		fungibletypes.NewQueryClient(grpcConn),
+		authtypes.NewQueryClient(grpcConn),
+		banktypes.NewQueryClient(grpcConn),
+		observertypes.NewQueryClient(grpcConn),
+		goerliAuth,
+		zevmAuth,
+		btcRPCClient,
+	)
+}
+
+// setSDKConfig sets the address prefixes and the coin type of ZetaChain, the config is sealed once set
+func setSDKConfig() {
+	sdkConfigOnce.Do(func() {
+		config := sdk.GetConfig()
+		cmdcfg.SetBech32Prefixes(config)
+		ethcfg.SetBip44CoinType(config)
+		config.SetAddressVerifier(app.VerifyAddressFormat)
+		config.Seal()
+	})
+}
+
+// chdirTemp changes the working directory to a temporary directory for the duration of the test
+// The zetaclient and the runner write files in the working directory
+func chdirTemp(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+	})
+}
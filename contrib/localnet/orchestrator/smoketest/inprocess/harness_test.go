@@ -0,0 +1,37 @@
+//go:build inprocess
+// +build inprocess
+
+package inprocess_test
+
+import (
+	"testing"
+
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/inprocess"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/smoketests"
+)
+
+// smokeTests are the smoketests run with the harness, in the order of the localnet smoketest
+var smokeTests = []runner.SmokeTest{
+	smoketests.TestContextUpgrade,
+	smoketests.TestERC20Deposit,
+	smoketests.TestERC20Withdraw,
+	smoketests.TestSendZetaOut,
+	smoketests.TestSendZetaOutBTCRevert,
+	smoketests.TestMessagePassing,
+	smoketests.TestZRC20Swap,
+	smoketests.TestBitcoinWithdraw,
+	smoketests.TestCrosschainSwap,
+	smoketests.TestMessagePassingRevertFail,
+	smoketests.TestMessagePassingRevertSuccess,
+	smoketests.TestERC20DepositAndCallRefund,
+	smoketests.TestUpdateBytecode,
+	smoketests.TestEtherDepositAndCall,
+	smoketests.TestDepositEtherLiquidityCap,
+	smoketests.TestWhitelistERC20,
+}
+
+func TestHarness_SmokeTests(t *testing.T) {
+	h := inprocess.New(t)
+	h.RunSmokeTests(smokeTests...)
+}
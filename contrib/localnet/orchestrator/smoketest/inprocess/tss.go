@@ -0,0 +1,54 @@
+package inprocess
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/cosmos"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+var _ zetaclient.TSSSigner = (*LocalTSS)(nil)
+
+// LocalTSS is a single-party stand-in for the TSS, all the signatures are made with a local private key
+// The bitcoin addresses are derived for the network of the bitcoin chain
+type LocalTSS struct {
+	zetaclient.TestSigner
+	BitcoinParams *chaincfg.Params
+}
+
+// NewLocalTSS returns a local TSS signing with the private key for the bitcoin chain
+func NewLocalTSS(privKey *ecdsa.PrivateKey, bitcoinChainID int64) (*LocalTSS, error) {
+	params, err := common.BitcoinNetParamsFromChainID(bitcoinChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalTSS{
+		TestSigner:    zetaclient.TestSigner{PrivKey: privKey},
+		BitcoinParams: params,
+	}, nil
+}
+
+// Bech32Pubkey returns the TSS public key in the format stored in the observer module
+func (tss *LocalTSS) Bech32Pubkey() (string, error) {
+	return cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, &secp256k1.PubKey{Key: tss.PubKeyCompressedBytes()})
+}
+
+func (tss *LocalTSS) BTCAddress() string {
+	addr := tss.BTCAddressWitnessPubkeyHash()
+	if addr == nil {
+		return ""
+	}
+	return addr.EncodeAddress()
+}
+
+func (tss *LocalTSS) BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(tss.PubKeyCompressedBytes()), tss.BitcoinParams)
+	if err != nil {
+		return nil
+	}
+	return addr
+}
@@ -0,0 +1,141 @@
+package inprocess
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmostestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	cmdcfg "github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/network"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// ZetaChainID is the chain ID of the in-process ZetaChain network, the privnet chain observed by the smoketests
+	ZetaChainID = "athens_101-1"
+
+	// the zetaclient connects to the default ports of the node
+	zetaCoreIP          = "127.0.0.1"
+	zetaCoreRPCAddress  = "tcp://127.0.0.1:26657"
+	zetaCoreAPIAddress  = "tcp://127.0.0.1:1317"
+	zetaCoreGRPCAddress = "127.0.0.1:9090"
+	zevmJSONRPCAddress  = "127.0.0.1:8545"
+	zevmEndpoint        = "http://127.0.0.1:8545"
+)
+
+// zetaChainAccounts are the accounts set in the genesis of the in-process ZetaChain network
+type zetaChainAccounts struct {
+	operator       sdk.AccAddress // operator of the single validator, also the observer and the zetaclient hotkey
+	operatorPubkey string
+	fungibleAdmin  sdk.AccAddress
+}
+
+// newZetaChainConfig returns the config of a single validator ZetaChain network
+// The validator is the only observer of the privnet chains, the keygen is already completed with the local TSS
+// The fungible admin is set as admin for all the policies and the funded addresses receive ZETA on ZEVM
+func newZetaChainConfig(
+	t *testing.T,
+	tss *LocalTSS,
+	fungibleAdminMnemonic string,
+	fundedAddresses []ethcommon.Address,
+) network.Config {
+	cfg := network.DefaultConfig()
+	cfg.NumOfValidators = 1
+	cfg.ChainID = ZetaChainID
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	cfg.BlockMaxGas = 500_000_000
+	cfg.RPCAddress = zetaCoreRPCAddress
+	cfg.APIAddress = zetaCoreAPIAddress
+	cfg.GRPCAddress = zetaCoreGRPCAddress
+	cfg.JSONRPCAddress = zevmJSONRPCAddress
+
+	accounts := deriveZetaChainAccounts(t, cfg, fungibleAdminMnemonic)
+	observerList := []string{accounts.operator.String()}
+	network.SetupZetaGenesisState(t, cfg.GenesisState, cfg.Codec, observerList, true)
+
+	// observer genesis state: keygen completed with the local TSS
+	var observerGenesis observertypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[observertypes.ModuleName], &observerGenesis))
+	tssPubkey, err := tss.Bech32Pubkey()
+	require.NoError(t, err)
+	granteePubkey, err := common.NewPubKey(accounts.operatorPubkey)
+	require.NoError(t, err)
+	observerGenesis.NodeAccountList = []*observertypes.NodeAccount{
+		{
+			Operator:       accounts.operator.String(),
+			GranteeAddress: accounts.operator.String(),
+			GranteePubkey:  &common.PubKeySet{Secp256k1: granteePubkey},
+			NodeStatus:     observertypes.NodeStatus_Active,
+		},
+	}
+	observerGenesis.Keygen = &observertypes.Keygen{
+		Status:         observertypes.KeygenStatus_KeyGenSuccess,
+		GranteePubkeys: []string{accounts.operatorPubkey},
+	}
+	observerGenesis.Tss = &observertypes.TSS{
+		TssPubkey:           tssPubkey,
+		TssParticipantList:  []string{accounts.operatorPubkey},
+		OperatorAddressList: observerList,
+	}
+	observerGenesis.TssHistory = []observertypes.TSS{*observerGenesis.Tss}
+	for _, policy := range observerGenesis.Params.AdminPolicy {
+		policy.Address = accounts.fungibleAdmin.String()
+	}
+	require.NoError(t, observerGenesis.Validate())
+	cfg.GenesisState[observertypes.ModuleName] = cfg.Codec.MustMarshalJSON(&observerGenesis)
+
+	// auth and bank genesis state: fund the admin and the accounts used on ZEVM
+	fundedAccounts := []sdk.AccAddress{accounts.fungibleAdmin}
+	for _, address := range fundedAddresses {
+		fundedAccounts = append(fundedAccounts, sdk.AccAddress(address.Bytes()))
+	}
+	var authGenesis authtypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[authtypes.ModuleName], &authGenesis))
+	var bankGenesis banktypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenesis))
+	coins := sdk.NewCoins(sdk.NewCoin(cmdcfg.BaseDenom, sdk.TokensFromConsensusPower(100_000_000, sdk.DefaultPowerReduction)))
+	for _, account := range fundedAccounts {
+		packed, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(account, nil, 0, 0)})
+		require.NoError(t, err)
+		authGenesis.Accounts = append(authGenesis.Accounts, packed...)
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: account.String(),
+			Coins:   coins,
+		})
+	}
+	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenesis)
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenesis)
+
+	return cfg
+}
+
+// deriveZetaChainAccounts derives the accounts from the mnemonics the same way the network and the tx server do
+func deriveZetaChainAccounts(t *testing.T, cfg network.Config, fungibleAdminMnemonic string) zetaChainAccounts {
+	kb := keyring.NewInMemory(cfg.Codec)
+
+	operator, _, err := cosmostestutil.GenerateSaveCoinKey(kb, "node0", cfg.Mnemonics[0], true, hd.Secp256k1)
+	require.NoError(t, err)
+	operatorRecord, err := kb.Key("node0")
+	require.NoError(t, err)
+	operatorPubkey, err := common.GetPubkeyBech32FromRecord(operatorRecord)
+	require.NoError(t, err)
+
+	adminRecord, err := kb.NewAccount("fungibleadmin", fungibleAdminMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	fungibleAdmin, err := adminRecord.GetAddress()
+	require.NoError(t, err)
+
+	return zetaChainAccounts{
+		operator:       operator,
+		operatorPubkey: operatorPubkey,
+		fungibleAdmin:  fungibleAdmin,
+	}
+}
@@ -0,0 +1,118 @@
+package inprocess
+
+import (
+	"os"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/network"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
+)
+
+// zetaClient is an in-process zetaclient observing and signing for the simulated chains with the local TSS
+type zetaClient struct {
+	bridge    *zetaclient.ZetaCoreBridge
+	clientMap map[common.Chain]zetaclient.ChainClient
+}
+
+// startZetaClient starts a zetaclient for the validator, the validator key is used both as operator and hotkey
+func startZetaClient(
+	t *testing.T,
+	val *network.Validator,
+	tss *LocalTSS,
+	evmEndpoint string,
+	btc *BitcoinStandIn,
+	dbPath string,
+) *zetaClient {
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+	telemetryServer := zetaclient.NewTelemetryServer()
+
+	// the core params of the chains are updated from ZetaChain
+	goerliChain := common.GoerliLocalnetChain()
+	btcChain := common.BtcRegtestChain()
+	cfg := config.NewConfig()
+	cfg.ChainID = ZetaChainID
+	cfg.ZetaCoreURL = zetaCoreIP
+	cfg.AuthzGranter = val.Address.String()
+	cfg.AuthzHotkey = val.Moniker
+	cfg.KeyringBackend = config.KeyringBackendTest
+	cfg.ConfigUpdateTicker = 1
+	cfg.EVMChainConfigs = map[int64]*config.EVMConfig{
+		goerliChain.ChainId: {
+			CoreParams: observertypes.CoreParams{ChainId: goerliChain.ChainId},
+			Chain:      goerliChain,
+			Endpoint:   evmEndpoint,
+		},
+	}
+	cfg.BitcoinConfig = &config.BTCConfig{
+		CoreParams: observertypes.CoreParams{ChainId: btcChain.ChainId},
+		RPCParams:  "regtest",
+	}
+
+	keys := zetaclient.NewKeysWithKeybase(val.ClientCtx.Keyring, val.Address, val.Moniker)
+	bridge, err := zetaclient.NewZetaCoreBridge(keys, zetaCoreIP, val.Moniker, ZetaChainID, false, telemetryServer)
+	require.NoError(t, err)
+	bridge.WaitForCoreToCreateBlocks()
+	bridge.SetAccountNumber(common.ZetaClientGranteeKey)
+	zetaclient.SetupAuthZSignerList(keys.GetOperatorAddress().String(), keys.GetAddress())
+	require.NoError(t, bridge.UpdateConfigFromCore(cfg, true))
+	go bridge.ConfigUpdater(cfg)
+
+	// the metrics are registered but the metrics server is not started
+	m, err := metrics.NewMetrics()
+	require.NoError(t, err)
+
+	signerMap := make(map[common.Chain]zetaclient.ChainSigner)
+	clientMap := make(map[common.Chain]zetaclient.ChainClient)
+	for _, evmConfig := range cfg.GetAllEVMConfigs() {
+		signer, err := zetaclient.NewEVMSigner(
+			evmConfig.Chain,
+			evmConfig.Endpoint,
+			tss,
+			config.GetConnectorABI(),
+			config.GetERC20CustodyABI(),
+			ethcommon.HexToAddress(evmConfig.CoreParams.ConnectorContractAddress),
+			ethcommon.HexToAddress(evmConfig.CoreParams.Erc20CustodyContractAddress),
+			logger,
+			telemetryServer,
+		)
+		require.NoError(t, err)
+		signerMap[evmConfig.Chain] = signer
+
+		client, err := zetaclient.NewEVMChainClient(bridge, tss, store.Options{Dir: dbPath}, m, logger, cfg, *evmConfig, telemetryServer)
+		require.NoError(t, err)
+		clientMap[evmConfig.Chain] = client
+	}
+
+	_, btcConfig, _ := cfg.GetBTCConfig()
+	signerMap[btcChain] = zetaclient.NewBTCSignerWithRPCClient(tss, btc, logger, telemetryServer)
+	btcClient, err := zetaclient.NewBitcoinClientWithRPCClient(btcChain, bridge, tss, btc, store.Options{Dir: dbPath}, m, logger, btcConfig, telemetryServer)
+	require.NoError(t, err)
+	clientMap[btcChain] = btcClient
+
+	for _, client := range clientMap {
+		client.Start()
+	}
+	coreObserver := zetaclient.NewCoreObserver(bridge, signerMap, clientMap, m, logger, cfg, telemetryServer)
+	coreObserver.MonitorCore()
+
+	return &zetaClient{
+		bridge:    bridge,
+		clientMap: clientMap,
+	}
+}
+
+// stop stops the chain clients and the config updater of the zetaclient
+func (zc *zetaClient) stop() {
+	for _, client := range zc.clientMap {
+		client.Stop()
+	}
+	zc.bridge.Stop()
+}
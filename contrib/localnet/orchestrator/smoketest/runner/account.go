@@ -0,0 +1,204 @@
+package runner
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	zetaconnectoreth "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.eth.sol"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// amounts sent to the accounts created for the smoke tests
+var (
+	// accountGoerliEther is sent on Goerli to pay for the gas and the ether deposits
+	accountGoerliEther = big.NewInt(0).Mul(big.NewInt(5), big.NewInt(1e18))
+
+	// accountGoerliZeta is sent on Goerli, half of it is sent to ZetaChain
+	accountGoerliZeta = big.NewInt(0).Mul(big.NewInt(200), big.NewInt(1e18))
+
+	// accountZEVMEther, accountZEVMZeta and accountZEVMUSDT are deposited on ZetaChain
+	accountZEVMEther = big.NewInt(1e18)
+	accountZEVMZeta  = big.NewInt(0).Mul(big.NewInt(100), big.NewInt(1e18))
+	accountZEVMUSDT  = big.NewInt(1e18)
+)
+
+// NewAccountRunner returns a copy of the runner using a new deployer account
+// The account is funded on Goerli by the deployer of the runner, FundAccount must be called on the returned runner
+// to deposit the funds on ZetaChain before running smoke tests
+// The Bitcoin deployer address is shared with the runner
+func (sm *SmokeTestRunner) NewAccountRunner() (*SmokeTestRunner, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	goerliChainID, err := sm.GoerliClient.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	goerliAuth, err := bind.NewKeyedTransactorWithChainID(privateKey, goerliChainID)
+	if err != nil {
+		return nil, err
+	}
+	zevmChainID, err := sm.ZevmClient.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	zevmAuth, err := bind.NewKeyedTransactorWithChainID(privateKey, zevmChainID)
+	if err != nil {
+		return nil, err
+	}
+	goerliAuth.GasLimit = sm.GoerliAuth.GasLimit
+	zevmAuth.GasLimit = sm.ZevmAuth.GasLimit
+
+	// fund the account on Goerli
+	tx, err := sm.sendEtherTo(address, accountGoerliEther)
+	if err != nil {
+		return nil, err
+	}
+	if receipt := utils.MustWaitForTxReceipt(sm.GoerliClient, tx); receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("failed to fund %s with ether", address.Hex())
+	}
+	tx, err = sm.ZetaEth.Transfer(sm.GoerliAuth, address, accountGoerliZeta)
+	if err != nil {
+		return nil, err
+	}
+	if receipt := utils.MustWaitForTxReceipt(sm.GoerliClient, tx); receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("failed to fund %s with zeta", address.Hex())
+	}
+
+	return &SmokeTestRunner{
+		DeployerAddress:       address,
+		DeployerPrivateKey:    hex.EncodeToString(crypto.FromECDSA(privateKey)),
+		TSSAddress:            sm.TSSAddress,
+		BTCTSSAddress:         sm.BTCTSSAddress,
+		BTCDeployerAddress:    sm.BTCDeployerAddress,
+		FungibleAdminMnemonic: sm.FungibleAdminMnemonic,
+
+		ZevmClient:   sm.ZevmClient,
+		GoerliClient: sm.GoerliClient,
+		BtcRPCClient: sm.BtcRPCClient,
+
+		CctxClient:     sm.CctxClient,
+		FungibleClient: sm.FungibleClient,
+		AuthClient:     sm.AuthClient,
+		BankClient:     sm.BankClient,
+		ObserverClient: sm.ObserverClient,
+
+		ZetaTxServer: sm.ZetaTxServer,
+
+		GoerliAuth: goerliAuth,
+		ZevmAuth:   zevmAuth,
+
+		ZetaEthAddr:          sm.ZetaEthAddr,
+		ZetaEth:              sm.ZetaEth,
+		ConnectorEthAddr:     sm.ConnectorEthAddr,
+		ConnectorEth:         sm.ConnectorEth,
+		ERC20CustodyAddr:     sm.ERC20CustodyAddr,
+		ERC20Custody:         sm.ERC20Custody,
+		USDTERC20Addr:        sm.USDTERC20Addr,
+		USDTERC20:            sm.USDTERC20,
+		USDTZRC20Addr:        sm.USDTZRC20Addr,
+		USDTZRC20:            sm.USDTZRC20,
+		ETHZRC20Addr:         sm.ETHZRC20Addr,
+		ETHZRC20:             sm.ETHZRC20,
+		BTCZRC20Addr:         sm.BTCZRC20Addr,
+		BTCZRC20:             sm.BTCZRC20,
+		UniswapV2FactoryAddr: sm.UniswapV2FactoryAddr,
+		UniswapV2Factory:     sm.UniswapV2Factory,
+		UniswapV2RouterAddr:  sm.UniswapV2RouterAddr,
+		UniswapV2Router:      sm.UniswapV2Router,
+		TestDAppAddr:         sm.TestDAppAddr,
+		ZEVMSwapAppAddr:      sm.ZEVMSwapAppAddr,
+		ZEVMSwapApp:          sm.ZEVMSwapApp,
+		ContextAppAddr:       sm.ContextAppAddr,
+		ContextApp:           sm.ContextApp,
+		SystemContractAddr:   sm.SystemContractAddr,
+		SystemContract:       sm.SystemContract,
+
+		WG: sync.WaitGroup{},
+	}, nil
+}
+
+// FundAccount deposits ether, ZETA and USDT from Goerli to the deployer on ZetaChain and waits for the cctxs
+func (sm *SmokeTestRunner) FundAccount() error {
+	var inTxHashes []string
+
+	// ether
+	tx, err := sm.SendEther(sm.TSSAddress, accountZEVMEther, nil)
+	if err != nil {
+		return err
+	}
+	utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+	inTxHashes = append(inTxHashes, tx.Hash().Hex())
+
+	// zeta
+	tx, err = sm.ZetaEth.Approve(sm.GoerliAuth, sm.ConnectorEthAddr, accountZEVMZeta)
+	if err != nil {
+		return err
+	}
+	utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+	tx, err = sm.ConnectorEth.Send(sm.GoerliAuth, zetaconnectoreth.ZetaInterfacesSendInput{
+		DestinationChainId:  big.NewInt(common.ZetaPrivnetChain().ChainId),
+		DestinationAddress:  sm.DeployerAddress.Bytes(),
+		DestinationGasLimit: big.NewInt(250_000),
+		ZetaValueAndGas:     accountZEVMZeta,
+	})
+	if err != nil {
+		return err
+	}
+	utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+	inTxHashes = append(inTxHashes, tx.Hash().Hex())
+
+	// usdt
+	inTxHashes = append(inTxHashes, sm.DepositERC20(accountZEVMUSDT, nil).Hex())
+
+	for _, inTxHash := range inTxHashes {
+		cctx := utils.WaitCctxMinedByInTxHash(inTxHash, sm.CctxClient)
+		if cctx.CctxStatus.Status != crosschaintypes.CctxStatus_OutboundMined {
+			return fmt.Errorf("funding cctx %s of %s is %s", cctx.Index, sm.DeployerAddress.Hex(), cctx.CctxStatus.Status)
+		}
+	}
+	return nil
+}
+
+// sendEtherTo sends ether on Goerli from the deployer to the address
+func (sm *SmokeTestRunner) sendEtherTo(to ethcommon.Address, value *big.Int) (*ethtypes.Transaction, error) {
+	goerliClient := sm.GoerliClient
+
+	nonce, err := goerliClient.PendingNonceAt(context.Background(), sm.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := goerliClient.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	tx := ethtypes.NewTransaction(nonce, to, value, 21000, gasPrice, nil)
+	chainID, err := goerliClient.NetworkID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	deployerPrivkey, err := crypto.HexToECDSA(sm.DeployerPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.NewEIP155Signer(chainID), deployerPrivkey)
+	if err != nil {
+		return nil, err
+	}
+	if err := goerliClient.SendTransaction(context.Background(), signedTx); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
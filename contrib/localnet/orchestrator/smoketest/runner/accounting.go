@@ -2,24 +2,12 @@ package runner
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"math/big"
-	"net/http"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 )
 
-type Amount struct {
-	Denom  string `json:"denom"`
-	Amount string `json:"amount"`
-}
-
-type Response struct {
-	Amount Amount `json:"amount"`
-}
-
 func (sm *SmokeTestRunner) CheckZRC20ReserveAndSupply() {
 	fmt.Println("Checking ZRC20 Reserve and Supply")
 	sm.checkEthTSSBalance()
@@ -44,6 +32,10 @@ func (sm *SmokeTestRunner) checkEthTSSBalance() {
 }
 
 func (sm *SmokeTestRunner) checkBtcTSSBalance() {
+	// the balance can't be checked if the runner is not connected to bitcoin
+	if sm.BtcRPCClient == nil {
+		return
+	}
 	utxos, err := sm.BtcRPCClient.ListUnspent()
 	if err != nil {
 		panic(err)
@@ -87,21 +79,13 @@ func (sm *SmokeTestRunner) checkZetaTSSBalance() {
 	if err != nil {
 		panic(err)
 	}
-	resp, err := http.Get("http://zetacore0:1317/cosmos/bank/v1beta1/supply/by_denom?denom=azeta")
-	if err != nil {
-		panic(err)
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		panic(err)
-	}
-	var result Response
-	err = json.Unmarshal(body, &result)
+	res, err := sm.BankClient.SupplyOf(context.Background(), &banktypes.QuerySupplyOfRequest{
+		Denom: "azeta",
+	})
 	if err != nil {
 		panic(err)
 	}
-	zetaSupply, _ := big.NewInt(0).SetString(result.Amount.Amount, 10)
+	zetaSupply := res.Amount.Amount.BigInt()
 	if zetaLocked.Cmp(zetaSupply) < 0 {
 		fmt.Printf(fmt.Sprintf("ZETA: TSS balance (%d) < ZRC20 TotalSupply (%d) \n", zetaLocked, zetaSupply))
 	} else {
@@ -53,7 +53,7 @@ func (sm *SmokeTestRunner) DepositBTC() {
 	if err != nil {
 		panic(err)
 	}
-	_, err = sm.SendToTSSFromDeployerWithMemo(sm.BTCTSSAddress, 0.11, utxos[4:5], btc, []byte(zetaclient.DonationMessage), sm.BTCDeployerAddress)
+	_, err = sm.SendToTSSFromDeployerWithMemo(sm.BTCTSSAddress, 0.11, utxos[4:5], btc, []byte(common.DonationMessage), sm.BTCDeployerAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -16,7 +16,6 @@ import (
 	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
-	"github.com/zeta-chain/zetacore/zetaclient"
 )
 
 func (sm *SmokeTestRunner) DepositERC20(amount *big.Int, msg []byte) ethcommon.Hash {
@@ -179,7 +178,7 @@ func (sm *SmokeTestRunner) DepositEtherIntoZRC20() {
 	}
 
 	{
-		tx, err := sm.SendEther(sm.TSSAddress, big.NewInt(101000000000000000), []byte(zetaclient.DonationMessage))
+		tx, err := sm.SendEther(sm.TSSAddress, big.NewInt(101000000000000000), []byte(common.DonationMessage))
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,150 @@
+package runner
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+// WriteJUnitReport writes the results as a JUnit XML report
+// Failed tests are reported as failures and timed out tests as errors
+func WriteJUnitReport(w io.Writer, suiteName string, startTime time.Time, results []SmokeTestResult) error {
+	suite := junitTestSuite{
+		Name:      suiteName,
+		Tests:     len(results),
+		Timestamp: startTime.UTC().Format(time.RFC3339),
+	}
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+		testCase := junitTestCase{
+			Name:      r.Name,
+			Classname: classname(suiteName, r.Tags),
+			Time:      seconds(r.Duration),
+		}
+		switch r.Status {
+		case SmokeTestFailed:
+			suite.Failures++
+			testCase.Failure = &junitFailure{
+				Message: r.Failure,
+				Type:    string(r.Status),
+				Content: strings.TrimSpace(r.Failure + "\n" + r.Stack),
+			}
+		case SmokeTestTimedOut:
+			suite.Errors++
+			testCase.Error = &junitFailure{
+				Message: r.Failure,
+				Type:    string(r.Status),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// jsonReport is the JSON report of a suite of smoke tests
+type jsonReport struct {
+	Suite     string           `json:"suite"`
+	StartTime time.Time        `json:"start_time"`
+	Passed    int              `json:"passed"`
+	Failed    int              `json:"failed"`
+	TimedOut  int              `json:"timed_out"`
+	Tests     []jsonTestResult `json:"tests"`
+}
+
+type jsonTestResult struct {
+	Name     string          `json:"name"`
+	Tags     []string        `json:"tags"`
+	Status   SmokeTestStatus `json:"status"`
+	Duration float64         `json:"duration"`
+	Account  string          `json:"account,omitempty"`
+	Failure  string          `json:"failure,omitempty"`
+	Stack    string          `json:"stack,omitempty"`
+}
+
+// WriteJSONReport writes the results as a JSON report, durations are expressed in seconds
+func WriteJSONReport(w io.Writer, suiteName string, startTime time.Time, results []SmokeTestResult) error {
+	report := jsonReport{
+		Suite:     suiteName,
+		StartTime: startTime.UTC(),
+		Tests:     make([]jsonTestResult, 0, len(results)),
+	}
+	for _, r := range results {
+		switch r.Status {
+		case SmokeTestPassed:
+			report.Passed++
+		case SmokeTestFailed:
+			report.Failed++
+		case SmokeTestTimedOut:
+			report.TimedOut++
+		}
+		report.Tests = append(report.Tests, jsonTestResult{
+			Name:     r.Name,
+			Tags:     r.Tags,
+			Status:   r.Status,
+			Duration: r.Duration.Seconds(),
+			Account:  r.Account,
+			Failure:  r.Failure,
+			Stack:    r.Stack,
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// classname returns the JUnit classname of a test, tests are grouped by their first tag
+func classname(suiteName string, tags []string) string {
+	if len(tags) == 0 {
+		return suiteName
+	}
+	return suiteName + "." + tags[0]
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
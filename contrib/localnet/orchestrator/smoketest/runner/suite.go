@@ -0,0 +1,212 @@
+package runner
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSmokeTestTimeout is the timeout of a smoke test if neither the suite nor the test define one
+	DefaultSmokeTestTimeout = 10 * time.Minute
+
+	// SupplyCheckName is the name of the result of the supply check run after the parallel smoke tests
+	SupplyCheckName = "zrc20_reserve_and_supply"
+)
+
+// SmokeTestDefinition describes a registered smoke test
+type SmokeTestDefinition struct {
+	// Name is the unique name used to select the test
+	Name string
+
+	// Description describes what the test checks
+	Description string
+
+	// Tags are the tags used to select groups of tests
+	Tags []string
+
+	// Parallel is true if the test only relies on the deployer account and doesn't modify global state
+	// It can then run concurrently with other parallel tests, using its own funded account
+	Parallel bool
+
+	// Timeout overrides the timeout of the suite if set
+	Timeout time.Duration
+
+	// Test is the function running the test
+	Test SmokeTest
+}
+
+// HasTag returns true if the test has the tag
+func (d SmokeTestDefinition) HasTag(tag string) bool {
+	for _, t := range d.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// SmokeTestStatus is the outcome of a smoke test
+type SmokeTestStatus string
+
+const (
+	SmokeTestPassed   SmokeTestStatus = "passed"
+	SmokeTestFailed   SmokeTestStatus = "failed"
+	SmokeTestTimedOut SmokeTestStatus = "timed_out"
+)
+
+// SmokeTestResult is the result of a smoke test run by a suite
+type SmokeTestResult struct {
+	Name     string
+	Tags     []string
+	Status   SmokeTestStatus
+	Duration time.Duration
+
+	// Account is the deployer account used to run the test
+	Account string
+
+	// Failure is the reason of the failure, Stack is the stack trace of the panic if any
+	Failure string
+	Stack   string
+}
+
+// SuiteOptions are the options to run a suite of smoke tests
+type SuiteOptions struct {
+	// Parallelism is the maximum number of parallel tests run concurrently
+	// Tests are run sequentially with the deployer account of the runner if it is at most 1
+	Parallelism int
+
+	// Timeout is the timeout of each test, DefaultSmokeTestTimeout is used if zero
+	Timeout time.Duration
+}
+
+// RunSmokeTestSuite runs the smoke tests and returns their results in the same order
+// A failing test doesn't stop the suite
+// If parallelism is enabled, the parallel tests are run first, each with a new funded account, and the other
+// tests are then run sequentially with the deployer account of the runner
+// A test that times out keeps running in the background, a sequential test timing out can then interfere with the
+// following tests since they share its account
+func (sm *SmokeTestRunner) RunSmokeTestSuite(tests []SmokeTestDefinition, opts SuiteOptions) []SmokeTestResult {
+	results := make([]SmokeTestResult, len(tests))
+	sequential := make([]int, 0, len(tests))
+
+	if opts.Parallelism > 1 {
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, opts.Parallelism)
+		)
+		ranParallel := false
+		for i, test := range tests {
+			if !test.Parallel {
+				sequential = append(sequential, i)
+				continue
+			}
+			ranParallel = true
+
+			// accounts are funded one at a time since they are funded by the deployer of the runner
+			accountRunner, err := sm.NewAccountRunner()
+			if err != nil {
+				results[i] = SmokeTestResult{
+					Name:    test.Name,
+					Tags:    test.Tags,
+					Status:  SmokeTestFailed,
+					Failure: fmt.Sprintf("failed to create account: %s", err.Error()),
+				}
+				continue
+			}
+
+			i, test := i, test
+			sem <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				results[i] = accountRunner.runSmokeTest(test, opts.Timeout, true, false)
+			}()
+		}
+		wg.Wait()
+
+		// check the supplies once all parallel tests are done
+		if ranParallel {
+			results = append(results, sm.runSmokeTest(SmokeTestDefinition{
+				Name: SupplyCheckName,
+				Test: func(*SmokeTestRunner) {},
+			}, opts.Timeout, false, true))
+		}
+	} else {
+		for i := range tests {
+			sequential = append(sequential, i)
+		}
+	}
+
+	for _, i := range sequential {
+		results[i] = sm.runSmokeTest(tests[i], opts.Timeout, false, true)
+	}
+	return results
+}
+
+// runSmokeTest runs the smoke test with a timeout and recovers from its panics
+// If fund is true, the account of the runner is funded on ZetaChain before the test
+// If checkSupply is true, the ZRC20 reserves and supplies are checked after the test
+func (sm *SmokeTestRunner) runSmokeTest(
+	test SmokeTestDefinition,
+	suiteTimeout time.Duration,
+	fund bool,
+	checkSupply bool,
+) SmokeTestResult {
+	timeout := test.Timeout
+	if timeout == 0 {
+		timeout = suiteTimeout
+	}
+	if timeout == 0 {
+		timeout = DefaultSmokeTestTimeout
+	}
+
+	result := SmokeTestResult{
+		Name:    test.Name,
+		Tags:    test.Tags,
+		Account: sm.DeployerAddress.Hex(),
+	}
+
+	// a nil result is sent if the test passed, otherwise a result with only the failure fields set
+	done := make(chan *SmokeTestResult, 1)
+	startTime := time.Now()
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- &SmokeTestResult{Failure: fmt.Sprint(r), Stack: string(debug.Stack())}
+			}
+		}()
+		if fund {
+			if err := sm.FundAccount(); err != nil {
+				done <- &SmokeTestResult{Failure: fmt.Sprintf("failed to fund account: %s", err.Error())}
+				return
+			}
+		}
+		test.Test(sm)
+		sm.WG.Wait()
+		if checkSupply {
+			sm.CheckZRC20ReserveAndSupply()
+		}
+		done <- nil
+	}()
+
+	select {
+	case failure := <-done:
+		if failure == nil {
+			result.Status = SmokeTestPassed
+		} else {
+			result.Status = SmokeTestFailed
+			result.Failure, result.Stack = failure.Failure, failure.Stack
+		}
+	case <-time.After(timeout):
+		result.Status = SmokeTestTimedOut
+		result.Failure = fmt.Sprintf("test timed out after %s", timeout)
+	}
+	result.Duration = time.Since(startTime)
+	fmt.Printf("## Smoke test %s %s in %s\n", result.Name, result.Status, result.Duration)
+	return result
+}
@@ -0,0 +1,151 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSmokeTest(t *testing.T) {
+	sm := &SmokeTestRunner{}
+
+	t.Run("passed", func(t *testing.T) {
+		ran := false
+		result := sm.runSmokeTest(SmokeTestDefinition{
+			Name: "foo",
+			Tags: []string{"bar"},
+			Test: func(*SmokeTestRunner) { ran = true },
+		}, time.Minute, false, false)
+		require.True(t, ran)
+		require.Equal(t, "foo", result.Name)
+		require.Equal(t, []string{"bar"}, result.Tags)
+		require.Equal(t, SmokeTestPassed, result.Status)
+		require.Empty(t, result.Failure)
+	})
+
+	t.Run("failed on panic", func(t *testing.T) {
+		result := sm.runSmokeTest(SmokeTestDefinition{
+			Name: "foo",
+			Test: func(*SmokeTestRunner) { panic("balance is not correct") },
+		}, time.Minute, false, false)
+		require.Equal(t, SmokeTestFailed, result.Status)
+		require.Equal(t, "balance is not correct", result.Failure)
+		require.Contains(t, result.Stack, "runSmokeTest")
+	})
+
+	t.Run("failed on empty panic", func(t *testing.T) {
+		result := sm.runSmokeTest(SmokeTestDefinition{
+			Name: "foo",
+			Test: func(*SmokeTestRunner) { panic("") },
+		}, time.Minute, false, false)
+		require.Equal(t, SmokeTestFailed, result.Status)
+	})
+
+	t.Run("timed out with the timeout of the test", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+		result := sm.runSmokeTest(SmokeTestDefinition{
+			Name:    "foo",
+			Timeout: 10 * time.Millisecond,
+			Test:    func(*SmokeTestRunner) { <-block },
+		}, time.Hour, false, false)
+		require.Equal(t, SmokeTestTimedOut, result.Status)
+		require.Contains(t, result.Failure, "10ms")
+	})
+
+	t.Run("timed out with the timeout of the suite", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+		result := sm.runSmokeTest(SmokeTestDefinition{
+			Name: "foo",
+			Test: func(*SmokeTestRunner) { <-block },
+		}, 10*time.Millisecond, false, false)
+		require.Equal(t, SmokeTestTimedOut, result.Status)
+	})
+}
+
+var sampleResults = []SmokeTestResult{
+	{
+		Name:     "erc20_deposit",
+		Tags:     []string{"erc20"},
+		Status:   SmokeTestPassed,
+		Duration: 1500 * time.Millisecond,
+		Account:  "0x1",
+	},
+	{
+		Name:     "bitcoin_withdraw",
+		Tags:     []string{"bitcoin"},
+		Status:   SmokeTestFailed,
+		Duration: 2 * time.Second,
+		Failure:  "not enough balance",
+		Stack:    "goroutine 1",
+	},
+	{
+		Name:     "my_test",
+		Status:   SmokeTestTimedOut,
+		Duration: time.Minute,
+		Failure:  "test timed out after 1m0s",
+	},
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	var buf bytes.Buffer
+	startTime := time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, WriteJUnitReport(&buf, "smoketest", startTime, sampleResults))
+
+	var report junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &report))
+	require.Len(t, report.Suites, 1)
+
+	suite := report.Suites[0]
+	require.Equal(t, "smoketest", suite.Name)
+	require.Equal(t, 3, suite.Tests)
+	require.Equal(t, 1, suite.Failures)
+	require.Equal(t, 1, suite.Errors)
+	require.Equal(t, "63.500", suite.Time)
+	require.Equal(t, "2023-11-01T10:00:00Z", suite.Timestamp)
+	require.Len(t, suite.TestCases, 3)
+
+	require.Equal(t, junitTestCase{
+		Name:      "erc20_deposit",
+		Classname: "smoketest.erc20",
+		Time:      "1.500",
+	}, suite.TestCases[0])
+
+	require.Equal(t, "smoketest.bitcoin", suite.TestCases[1].Classname)
+	require.NotNil(t, suite.TestCases[1].Failure)
+	require.Equal(t, "not enough balance", suite.TestCases[1].Failure.Message)
+	require.Equal(t, "not enough balance\ngoroutine 1", suite.TestCases[1].Failure.Content)
+	require.Nil(t, suite.TestCases[1].Error)
+
+	require.Equal(t, "smoketest", suite.TestCases[2].Classname)
+	require.Nil(t, suite.TestCases[2].Failure)
+	require.NotNil(t, suite.TestCases[2].Error)
+}
+
+func TestWriteJSONReport(t *testing.T) {
+	var buf bytes.Buffer
+	startTime := time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, WriteJSONReport(&buf, "smoketest", startTime, sampleResults))
+
+	var report jsonReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	require.Equal(t, "smoketest", report.Suite)
+	require.True(t, startTime.Equal(report.StartTime))
+	require.Equal(t, 1, report.Passed)
+	require.Equal(t, 1, report.Failed)
+	require.Equal(t, 1, report.TimedOut)
+	require.Len(t, report.Tests, 3)
+	require.Equal(t, jsonTestResult{
+		Name:     "erc20_deposit",
+		Tags:     []string{"erc20"},
+		Status:   SmokeTestPassed,
+		Duration: 1.5,
+		Account:  "0x1",
+	}, report.Tests[0])
+	require.Equal(t, "goroutine 1", report.Tests[1].Stack)
+}
@@ -1,27 +1,216 @@
 package smoketests
 
-import "github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+import (
+	"fmt"
+	"time"
+
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+)
+
+// tags used to select groups of smoke tests
+const (
+	TagEther   = "ether"
+	TagERC20   = "erc20"
+	TagZeta    = "zeta"
+	TagBitcoin = "bitcoin"
+	TagAdmin   = "admin"
+	TagUpgrade = "upgrade"
+)
+
+// AllTags is the list of all tags used by the smoke tests
+var AllTags = []string{TagEther, TagERC20, TagZeta, TagBitcoin, TagAdmin, TagUpgrade}
 
 // AllSmokeTests is an ordered list of all smoke tests
-var AllSmokeTests = []runner.SmokeTest{
-	TestContextUpgrade,
-	TestDepositAndCallRefund,
-	TestERC20Deposit,
-	TestERC20Withdraw,
-	TestSendZetaOut,
-	TestSendZetaOutBTCRevert,
-	TestMessagePassing,
-	TestZRC20Swap,
-	TestBitcoinWithdraw,
-	TestCrosschainSwap,
-	TestMessagePassingRevertFail,
-	TestMessagePassingRevertSuccess,
-	TestPauseZRC20,
-	TestERC20DepositAndCallRefund,
-	TestUpdateBytecode,
-	TestEtherDepositAndCall,
-	TestDepositEtherLiquidityCap,
-	TestBlockHeaders,
-	TestWhitelistERC20,
-	TestMyTest,
+// Tests that are not parallel either change global state (admin messages, liquidity pools, contract events)
+// or use the Bitcoin deployer wallet, they are run sequentially with the deployer account
+var AllSmokeTests = []runner.SmokeTestDefinition{
+	{
+		Name:        "context_upgrade",
+		Description: "deposit ether calling a contract and check its message context",
+		Tags:        []string{TagEther},
+		Test:        TestContextUpgrade,
+	},
+	{
+		Name:        "deposit_and_call_refund",
+		Description: "deposit ether calling a reverting contract and check the deposit is refunded",
+		Tags:        []string{TagEther},
+		Parallel:    true,
+		Test:        TestDepositAndCallRefund,
+	},
+	{
+		Name:        "erc20_deposit",
+		Description: "deposit USDT, also in multiple deposits in the same transaction",
+		Tags:        []string{TagERC20},
+		Parallel:    true,
+		Test:        TestERC20Deposit,
+	},
+	{
+		Name:        "erc20_withdraw",
+		Description: "withdraw USDT, also in multiple withdraws in the same transaction",
+		Tags:        []string{TagERC20},
+		Parallel:    true,
+		Test:        TestERC20Withdraw,
+	},
+	{
+		Name:        "send_zeta_out",
+		Description: "send ZETA from ZEVM to Goerli",
+		Tags:        []string{TagZeta},
+		Parallel:    true,
+		Test:        TestSendZetaOut,
+	},
+	{
+		Name:        "send_zeta_out_btc_revert",
+		Description: "send ZETA from ZEVM to Bitcoin and check the transaction reverts",
+		Tags:        []string{TagZeta},
+		Parallel:    true,
+		Test:        TestSendZetaOutBTCRevert,
+	},
+	{
+		Name:        "message_passing",
+		Description: "send ZETA from Goerli to Goerli through ZetaChain",
+		Tags:        []string{TagZeta},
+		Parallel:    true,
+		Test:        TestMessagePassing,
+	},
+	{
+		Name:        "zrc20_swap",
+		Description: "swap USDT ZRC20 for ETH ZRC20 on the Uniswap pool",
+		Tags:        []string{TagERC20},
+		Parallel:    true,
+		Test:        TestZRC20Swap,
+	},
+	{
+		Name:        "bitcoin_withdraw",
+		Description: "withdraw BTC ZRC20 to Bitcoin",
+		Tags:        []string{TagBitcoin},
+		Timeout:     15 * time.Minute,
+		Test:        TestBitcoinWithdraw,
+	},
+	{
+		Name:        "crosschain_swap",
+		Description: "swap between ERC20 and BTC ZRC20 through the ZEVM swap app from Goerli and Bitcoin",
+		Tags:        []string{TagBitcoin, TagERC20},
+		Timeout:     15 * time.Minute,
+		Test:        TestCrosschainSwap,
+	},
+	{
+		Name:        "message_passing_revert_fail",
+		Description: "send a message from Goerli to a reverting dapp with a failing revert and check the cctx is aborted",
+		Tags:        []string{TagZeta},
+		Parallel:    true,
+		Test:        TestMessagePassingRevertFail,
+	},
+	{
+		Name:        "message_passing_revert_success",
+		Description: "send a message from Goerli to a reverting dapp and check the cctx is reverted",
+		Tags:        []string{TagZeta},
+		Parallel:    true,
+		Test:        TestMessagePassingRevertSuccess,
+	},
+	{
+		Name:        "pause_zrc20",
+		Description: "pause and unpause ETH ZRC20 with the admin policy",
+		Tags:        []string{TagAdmin, TagEther},
+		Test:        TestPauseZRC20,
+	},
+	{
+		Name:        "erc20_deposit_and_call_refund",
+		Description: "deposit USDT calling a reverting contract and check the refund with and without liquidity pool",
+		Tags:        []string{TagERC20},
+		Test:        TestERC20DepositAndCallRefund,
+	},
+	{
+		Name:        "update_bytecode",
+		Description: "update the bytecode of ETH ZRC20 with the admin policy and check its state is preserved",
+		Tags:        []string{TagAdmin, TagUpgrade},
+		Test:        TestUpdateBytecode,
+	},
+	{
+		Name:        "eth_deposit_and_call",
+		Description: "deposit ether calling a contract, also with a reverting call",
+		Tags:        []string{TagEther},
+		Parallel:    true,
+		Test:        TestEtherDepositAndCall,
+	},
+	{
+		Name:        "deposit_eth_liquidity_cap",
+		Description: "set a liquidity cap on ETH ZRC20 with the admin policy and check deposits above it are reverted",
+		Tags:        []string{TagAdmin, TagEther},
+		Test:        TestDepositEtherLiquidityCap,
+	},
+	{
+		Name:        "block_headers",
+		Description: "check the block headers of Goerli and Bitcoin are chained",
+		Tags:        []string{TagEther, TagBitcoin},
+		Test:        TestBlockHeaders,
+	},
+	{
+		Name:        "whitelist_erc20",
+		Description: "check the whitelisted ERC20 of the custody",
+		Tags:        []string{TagERC20},
+		Test:        TestWhitelistERC20,
+	},
+	{
+		Name:        "my_test",
+		Description: "custom test",
+		Test:        TestMyTest,
+	},
+}
+
+// SelectSmokeTests returns the smoke tests with one of the names or one of the tags and none of the skipped tags
+// All tests are selected if no name and no tag is given, the tests are returned in the order of AllSmokeTests
+func SelectSmokeTests(names, tags, skipTags []string) ([]runner.SmokeTestDefinition, error) {
+	selectedNames := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := FindSmokeTest(name); !ok {
+			return nil, fmt.Errorf("unknown smoke test %s", name)
+		}
+		selectedNames[name] = true
+	}
+	for _, tag := range append(append([]string{}, tags...), skipTags...) {
+		if !isKnownTag(tag) {
+			return nil, fmt.Errorf("unknown tag %s", tag)
+		}
+	}
+
+	selectAll := len(names) == 0 && len(tags) == 0
+	var selected []runner.SmokeTestDefinition
+	for _, test := range AllSmokeTests {
+		if !selectAll && !selectedNames[test.Name] && !hasOneTag(test, tags) {
+			continue
+		}
+		if hasOneTag(test, skipTags) {
+			continue
+		}
+		selected = append(selected, test)
+	}
+	return selected, nil
+}
+
+// FindSmokeTest returns the smoke test with the name
+func FindSmokeTest(name string) (runner.SmokeTestDefinition, bool) {
+	for _, test := range AllSmokeTests {
+		if test.Name == name {
+			return test, true
+		}
+	}
+	return runner.SmokeTestDefinition{}, false
+}
+
+func hasOneTag(test runner.SmokeTestDefinition, tags []string) bool {
+	for _, tag := range tags {
+		if test.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownTag(tag string) bool {
+	for _, t := range AllTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,86 @@
+package smoketests_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/smoketests"
+)
+
+func TestAllSmokeTests(t *testing.T) {
+	names := make(map[string]bool)
+	for _, test := range smoketests.AllSmokeTests {
+		require.NotEmpty(t, test.Name)
+		require.False(t, names[test.Name], "duplicate smoke test %s", test.Name)
+		names[test.Name] = true
+		require.NotNil(t, test.Test, test.Name)
+
+		for _, tag := range test.Tags {
+			require.Contains(t, smoketests.AllTags, tag, test.Name)
+		}
+
+		// tests changing global state or using the bitcoin wallet can't run in parallel
+		if test.HasTag(smoketests.TagAdmin) || test.HasTag(smoketests.TagBitcoin) {
+			require.False(t, test.Parallel, test.Name)
+		}
+	}
+}
+
+func TestSelectSmokeTests(t *testing.T) {
+	names := func(tests []runner.SmokeTestDefinition) []string {
+		var n []string
+		for _, test := range tests {
+			n = append(n, test.Name)
+		}
+		return n
+	}
+
+	t.Run("select all tests by default", func(t *testing.T) {
+		selected, err := smoketests.SelectSmokeTests(nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, names(smoketests.AllSmokeTests), names(selected))
+	})
+
+	t.Run("select by name in the order of the registry", func(t *testing.T) {
+		selected, err := smoketests.SelectSmokeTests([]string{"erc20_withdraw", "erc20_deposit"}, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"erc20_deposit", "erc20_withdraw"}, names(selected))
+	})
+
+	t.Run("select by name or tag", func(t *testing.T) {
+		selected, err := smoketests.SelectSmokeTests([]string{"my_test"}, []string{smoketests.TagBitcoin}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"bitcoin_withdraw", "crosschain_swap", "block_headers", "my_test"}, names(selected))
+	})
+
+	t.Run("skip tags", func(t *testing.T) {
+		selected, err := smoketests.SelectSmokeTests(
+			nil,
+			[]string{smoketests.TagERC20},
+			[]string{smoketests.TagBitcoin},
+		)
+		require.NoError(t, err)
+		for _, test := range selected {
+			require.True(t, test.HasTag(smoketests.TagERC20))
+			require.False(t, test.HasTag(smoketests.TagBitcoin))
+		}
+		require.NotContains(t, names(selected), "crosschain_swap")
+
+		selected, err = smoketests.SelectSmokeTests(nil, nil, []string{smoketests.TagAdmin})
+		require.NoError(t, err)
+		for _, test := range selected {
+			require.False(t, test.HasTag(smoketests.TagAdmin))
+		}
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		_, err := smoketests.SelectSmokeTests([]string{"foo"}, nil, nil)
+		require.ErrorContains(t, err, "unknown smoke test foo")
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := smoketests.SelectSmokeTests(nil, nil, []string{"foo"})
+		require.ErrorContains(t, err, "unknown tag foo")
+	})
+}
@@ -0,0 +1,228 @@
+package stress
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Workload is a type of transaction sent by the stress test
+type Workload string
+
+const (
+	WorkloadETHDeposit     Workload = "eth_deposit"
+	WorkloadERC20Deposit   Workload = "erc20_deposit"
+	WorkloadZETADeposit    Workload = "zeta_deposit"
+	WorkloadBTCDeposit     Workload = "btc_deposit"
+	WorkloadETHWithdraw    Workload = "eth_withdraw"
+	WorkloadERC20Withdraw  Workload = "erc20_withdraw"
+	WorkloadZETAWithdraw   Workload = "zeta_withdraw"
+	WorkloadBTCWithdraw    Workload = "btc_withdraw"
+	WorkloadMessagePassing Workload = "message_passing"
+)
+
+// AllWorkloads is the list of all supported workloads
+var AllWorkloads = []Workload{
+	WorkloadETHDeposit,
+	WorkloadERC20Deposit,
+	WorkloadZETADeposit,
+	WorkloadBTCDeposit,
+	WorkloadETHWithdraw,
+	WorkloadERC20Withdraw,
+	WorkloadZETAWithdraw,
+	WorkloadBTCWithdraw,
+	WorkloadMessagePassing,
+}
+
+// IsBitcoin returns true if the workload sends or receives transactions on the Bitcoin network
+func (w Workload) IsBitcoin() bool {
+	return w == WorkloadBTCDeposit || w == WorkloadBTCWithdraw
+}
+
+// Profile describes the load generated by the stress test
+type Profile struct {
+	Name string `yaml:"name" json:"name"`
+
+	// TPS is the target number of transactions sent per second
+	TPS float64 `yaml:"tps" json:"tps"`
+
+	// Duration is the time during which transactions are sent
+	Duration time.Duration `yaml:"duration" json:"duration"`
+
+	// Timeout is the time to wait for a cctx to reach a terminal status after its inbound is sent
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+
+	// Workloads maps each workload to its relative weight in the mix of transactions
+	Workloads map[Workload]uint `yaml:"workloads" json:"workloads"`
+}
+
+// BuiltinProfiles are the predefined profiles that can be selected by name
+var BuiltinProfiles = map[string]Profile{
+	"mixed": {
+		Name:     "mixed",
+		TPS:      1,
+		Duration: 10 * time.Minute,
+		Timeout:  10 * time.Minute,
+		Workloads: map[Workload]uint{
+			WorkloadETHDeposit:     3,
+			WorkloadERC20Deposit:   2,
+			WorkloadZETADeposit:    2,
+			WorkloadBTCDeposit:     1,
+			WorkloadETHWithdraw:    3,
+			WorkloadERC20Withdraw:  2,
+			WorkloadZETAWithdraw:   2,
+			WorkloadBTCWithdraw:    1,
+			WorkloadMessagePassing: 2,
+		},
+	},
+	"deposits": {
+		Name:     "deposits",
+		TPS:      2,
+		Duration: 5 * time.Minute,
+		Timeout:  10 * time.Minute,
+		Workloads: map[Workload]uint{
+			WorkloadETHDeposit:   1,
+			WorkloadERC20Deposit: 1,
+			WorkloadZETADeposit:  1,
+		},
+	},
+	"withdrawals": {
+		Name:     "withdrawals",
+		TPS:      2,
+		Duration: 5 * time.Minute,
+		Timeout:  10 * time.Minute,
+		Workloads: map[Workload]uint{
+			WorkloadETHWithdraw:   1,
+			WorkloadERC20Withdraw: 1,
+			WorkloadZETAWithdraw:  1,
+		},
+	},
+	"eth-withdraw": {
+		Name:     "eth-withdraw",
+		TPS:      2,
+		Duration: 100 * time.Minute,
+		Timeout:  10 * time.Minute,
+		Workloads: map[Workload]uint{
+			WorkloadETHWithdraw: 1,
+		},
+	},
+	"message-passing": {
+		Name:     "message-passing",
+		TPS:      1,
+		Duration: 5 * time.Minute,
+		Timeout:  10 * time.Minute,
+		Workloads: map[Workload]uint{
+			WorkloadMessagePassing: 1,
+		},
+	},
+}
+
+// GetProfile returns the builtin profile with the given name or reads the profile from the given yaml file
+func GetProfile(nameOrFile string) (Profile, error) {
+	if profile, ok := BuiltinProfiles[nameOrFile]; ok {
+		return profile, nil
+	}
+	return ReadProfile(nameOrFile)
+}
+
+// ReadProfile reads a profile from a yaml file
+func ReadProfile(file string) (profile Profile, err error) {
+	// #nosec G304 -- this is a profile file
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return Profile{}, err
+	}
+	if err := yaml.UnmarshalStrict(b, &profile); err != nil {
+		return Profile{}, err
+	}
+	if profile.Name == "" {
+		profile.Name = file
+	}
+	return profile, profile.Validate()
+}
+
+// Validate checks the profile can be run
+func (p Profile) Validate() error {
+	if p.TPS <= 0 {
+		return fmt.Errorf("tps must be positive, got %f", p.TPS)
+	}
+	if p.Duration <= 0 {
+		return fmt.Errorf("duration must be positive, got %s", p.Duration)
+	}
+	if p.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %s", p.Timeout)
+	}
+	totalWeight := uint(0)
+	for workload, weight := range p.Workloads {
+		if !isKnownWorkload(workload) {
+			return fmt.Errorf("unknown workload %s", workload)
+		}
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("at least one workload must have a positive weight")
+	}
+	return nil
+}
+
+// HasBitcoinWorkload returns true if the profile sends transactions on the Bitcoin network
+func (p Profile) HasBitcoinWorkload() bool {
+	for workload, weight := range p.Workloads {
+		if weight > 0 && workload.IsBitcoin() {
+			return true
+		}
+	}
+	return false
+}
+
+// Picker picks random workloads following the weights of a profile
+type Picker struct {
+	r           *rand.Rand
+	workloads   []Workload
+	cumulative  []uint
+	totalWeight uint
+}
+
+// NewPicker returns a picker for the workloads of the profile
+// Workloads are sorted so the sequence of picked workloads only depends on the seed
+func NewPicker(p Profile, seed int64) *Picker {
+	workloads := make([]Workload, 0, len(p.Workloads))
+	for workload, weight := range p.Workloads {
+		if weight > 0 {
+			workloads = append(workloads, workload)
+		}
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i] < workloads[j] })
+
+	picker := &Picker{
+		// #nosec G404 -- the workload mix doesn't need a secure random source
+		r:         rand.New(rand.NewSource(seed)),
+		workloads: workloads,
+	}
+	for _, workload := range workloads {
+		picker.totalWeight += p.Workloads[workload]
+		picker.cumulative = append(picker.cumulative, picker.totalWeight)
+	}
+	return picker
+}
+
+// Pick returns a random workload
+func (p *Picker) Pick() Workload {
+	// #nosec G701 -- total weight is always positive for a valid profile
+	n := uint(p.r.Int63n(int64(p.totalWeight)))
+	i := sort.Search(len(p.cumulative), func(i int) bool { return p.cumulative[i] > n })
+	return p.workloads[i]
+}
+
+func isKnownWorkload(w Workload) bool {
+	for _, workload := range AllWorkloads {
+		if workload == w {
+			return true
+		}
+	}
+	return false
+}
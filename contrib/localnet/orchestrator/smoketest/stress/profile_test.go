@@ -0,0 +1,128 @@
+package stress_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/stress"
+)
+
+func TestBuiltinProfiles(t *testing.T) {
+	for name, profile := range stress.BuiltinProfiles {
+		require.Equal(t, name, profile.Name)
+		require.NoError(t, profile.Validate(), name)
+	}
+}
+
+func TestProfile_Validate(t *testing.T) {
+	valid := stress.Profile{
+		TPS:       1,
+		Duration:  time.Minute,
+		Timeout:   time.Minute,
+		Workloads: map[stress.Workload]uint{stress.WorkloadETHDeposit: 1},
+	}
+	require.NoError(t, valid.Validate())
+
+	tt := []struct {
+		name   string
+		modify func(p *stress.Profile)
+	}{
+		{"zero tps", func(p *stress.Profile) { p.TPS = 0 }},
+		{"zero duration", func(p *stress.Profile) { p.Duration = 0 }},
+		{"zero timeout", func(p *stress.Profile) { p.Timeout = 0 }},
+		{"no workload", func(p *stress.Profile) { p.Workloads = nil }},
+		{"zero weights", func(p *stress.Profile) {
+			p.Workloads = map[stress.Workload]uint{stress.WorkloadETHDeposit: 0}
+		}},
+		{"unknown workload", func(p *stress.Profile) {
+			p.Workloads = map[stress.Workload]uint{"foo": 1}
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p := valid
+			tc.modify(&p)
+			require.Error(t, p.Validate())
+		})
+	}
+}
+
+func TestGetProfile(t *testing.T) {
+	t.Run("builtin profile", func(t *testing.T) {
+		profile, err := stress.GetProfile("deposits")
+		require.NoError(t, err)
+		require.Equal(t, stress.BuiltinProfiles["deposits"], profile)
+		require.False(t, profile.HasBitcoinWorkload())
+	})
+
+	t.Run("profile file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "profile.yml")
+		require.NoError(t, os.WriteFile(file, []byte(`
+name: btc
+tps: 0.5
+duration: 2m
+timeout: 5m
+workloads:
+  btc_deposit: 1
+  btc_withdraw: 3
+`), 0600))
+
+		profile, err := stress.GetProfile(file)
+		require.NoError(t, err)
+		require.Equal(t, stress.Profile{
+			Name:     "btc",
+			TPS:      0.5,
+			Duration: 2 * time.Minute,
+			Timeout:  5 * time.Minute,
+			Workloads: map[stress.Workload]uint{
+				stress.WorkloadBTCDeposit:  1,
+				stress.WorkloadBTCWithdraw: 3,
+			},
+		}, profile)
+		require.True(t, profile.HasBitcoinWorkload())
+	})
+
+	t.Run("profile file with unknown field", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "profile.yml")
+		require.NoError(t, os.WriteFile(file, []byte("tps: 1\nfoo: bar\n"), 0600))
+
+		_, err := stress.GetProfile(file)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := stress.GetProfile("foo")
+		require.Error(t, err)
+	})
+}
+
+func TestPicker(t *testing.T) {
+	profile := stress.Profile{
+		Workloads: map[stress.Workload]uint{
+			stress.WorkloadETHDeposit:  1,
+			stress.WorkloadETHWithdraw: 3,
+			stress.WorkloadBTCDeposit:  0,
+		},
+	}
+
+	picked := make(map[stress.Workload]int)
+	picker := stress.NewPicker(profile, 42)
+	sequence := make([]stress.Workload, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		w := picker.Pick()
+		picked[w]++
+		sequence = append(sequence, w)
+	}
+	require.Len(t, picked, 2)
+	require.InDelta(t, 250, picked[stress.WorkloadETHDeposit], 50)
+	require.InDelta(t, 750, picked[stress.WorkloadETHWithdraw], 50)
+
+	// the sequence only depends on the seed
+	picker = stress.NewPicker(profile, 42)
+	for _, w := range sequence {
+		require.Equal(t, w, picker.Pick())
+	}
+}
@@ -0,0 +1,160 @@
+package stress
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// Report is the machine-readable result of a stress test
+// Latencies are expressed in seconds
+type Report struct {
+	Profile     string    `json:"profile"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	TargetTPS   float64   `json:"target_tps"`
+	AchievedTPS float64   `json:"achieved_tps"`
+
+	Total     Summary                  `json:"total"`
+	Workloads map[Workload]Summary     `json:"workloads"`
+	Observers map[string]ObserverVotes `json:"observers"`
+}
+
+// Summary summarizes the results of a set of transactions
+type Summary struct {
+	Sent       int `json:"sent"`
+	SendFailed int `json:"send_failed"`
+	Mined      int `json:"mined"`
+	Reverted   int `json:"reverted"`
+	Aborted    int `json:"aborted"`
+	TimedOut   int `json:"timed_out"`
+
+	// InboundLatency is the time between sending the transaction and the creation of its cctx
+	InboundLatency Percentiles `json:"inbound_latency"`
+
+	// Latency is the time between sending the transaction and the cctx reaching a terminal status
+	Latency Percentiles `json:"latency"`
+}
+
+// Failures returns the number of transactions that didn't complete
+// Reverted cctxs are not failures, the revert is processed by the network
+func (s Summary) Failures() int {
+	return s.SendFailed + s.Aborted + s.TimedOut
+}
+
+// Percentiles are latency percentiles in seconds
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// NewReport builds the report from the results of the stress test
+// sendDuration is the time spent sending transactions and is used to compute the achieved TPS
+func NewReport(
+	profile Profile,
+	startTime, endTime time.Time,
+	sendDuration time.Duration,
+	results []*Result,
+	votes map[string]ObserverVotes,
+) Report {
+	report := Report{
+		Profile:   profile.Name,
+		StartTime: startTime,
+		EndTime:   endTime,
+		TargetTPS: profile.TPS,
+		Workloads: make(map[Workload]Summary),
+		Observers: votes,
+	}
+
+	byWorkload := make(map[Workload][]*Result)
+	for _, r := range results {
+		byWorkload[r.Workload] = append(byWorkload[r.Workload], r)
+	}
+	for workload, workloadResults := range byWorkload {
+		report.Workloads[workload] = summarize(workloadResults)
+	}
+	report.Total = summarize(results)
+
+	if sendDuration > 0 {
+		report.AchievedTPS = float64(report.Total.Sent) / sendDuration.Seconds()
+	}
+	return report
+}
+
+// WriteFile writes the report as JSON to the file
+func (r Report) WriteFile(file string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0600)
+}
+
+// summarize counts the outcomes of the results and computes their latencies
+func summarize(results []*Result) Summary {
+	var (
+		summary         Summary
+		inboundLatency  []time.Duration
+		finalityLatency []time.Duration
+	)
+	for _, r := range results {
+		if r.SendErr != nil {
+			summary.SendFailed++
+			continue
+		}
+		summary.Sent++
+		if r.IsObserved() {
+			inboundLatency = append(inboundLatency, r.ObservedAt.Sub(r.SentAt))
+		}
+		if r.TimedOut {
+			summary.TimedOut++
+			continue
+		}
+		if r.IsFinalized() {
+			finalityLatency = append(finalityLatency, r.FinalizedAt.Sub(r.SentAt))
+		}
+		switch r.Status {
+		case crosschaintypes.CctxStatus_OutboundMined:
+			summary.Mined++
+		case crosschaintypes.CctxStatus_Reverted:
+			summary.Reverted++
+		case crosschaintypes.CctxStatus_Aborted:
+			summary.Aborted++
+		}
+	}
+	summary.InboundLatency = percentiles(inboundLatency)
+	summary.Latency = percentiles(finalityLatency)
+	return summary
+}
+
+// percentiles computes the percentiles of the durations using the nearest-rank method
+func percentiles(durations []time.Duration) Percentiles {
+	if len(durations) == 0 {
+		return Percentiles{}
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := func(p int) float64 {
+		// nearest rank is ceil(p/100 * n)
+		i := (p*len(sorted) + 99) / 100
+		if i < 1 {
+			i = 1
+		}
+		return sorted[i-1].Seconds()
+	}
+	return Percentiles{
+		P50: rank(50),
+		P90: rank(90),
+		P95: rank(95),
+		P99: rank(99),
+		Max: sorted[len(sorted)-1].Seconds(),
+	}
+}
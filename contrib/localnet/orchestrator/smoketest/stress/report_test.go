@@ -0,0 +1,89 @@
+package stress
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestPercentiles(t *testing.T) {
+	require.Equal(t, Percentiles{}, percentiles(nil))
+
+	require.Equal(t, Percentiles{P50: 3, P90: 3, P95: 3, P99: 3, Max: 3}, percentiles([]time.Duration{3 * time.Second}))
+
+	// 1s to 100s in reverse order
+	durations := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Second)
+	}
+	require.Equal(t, Percentiles{P50: 50, P90: 90, P95: 95, P99: 99, Max: 100}, percentiles(durations))
+}
+
+func TestNewReport(t *testing.T) {
+	start := time.Now()
+	sentAt := start.Add(time.Second)
+	results := []*Result{
+		{
+			Workload:    WorkloadETHDeposit,
+			SentAt:      sentAt,
+			CctxIndex:   "0x1",
+			ObservedAt:  sentAt.Add(2 * time.Second),
+			FinalizedAt: sentAt.Add(10 * time.Second),
+			Status:      crosschaintypes.CctxStatus_OutboundMined,
+		},
+		{
+			Workload:    WorkloadETHDeposit,
+			SentAt:      sentAt,
+			CctxIndex:   "0x2",
+			ObservedAt:  sentAt.Add(4 * time.Second),
+			FinalizedAt: sentAt.Add(20 * time.Second),
+			Status:      crosschaintypes.CctxStatus_Aborted,
+		},
+		{
+			Workload:    WorkloadETHWithdraw,
+			SentAt:      sentAt,
+			CctxIndex:   "0x3",
+			ObservedAt:  sentAt.Add(6 * time.Second),
+			FinalizedAt: sentAt.Add(30 * time.Second),
+			Status:      crosschaintypes.CctxStatus_Reverted,
+		},
+		{
+			Workload: WorkloadETHWithdraw,
+			SentAt:   sentAt,
+			TimedOut: true,
+		},
+		{
+			Workload: WorkloadETHWithdraw,
+			SentAt:   sentAt,
+			SendErr:  errors.New("nonce too low"),
+		},
+	}
+	votes := map[string]ObserverVotes{"zeta1": {Ballots: 6, Missed: 1}}
+	profile := BuiltinProfiles["mixed"]
+
+	report := NewReport(profile, start, start.Add(time.Minute), 2*time.Second, results, votes)
+	require.Equal(t, profile.Name, report.Profile)
+	require.Equal(t, profile.TPS, report.TargetTPS)
+	require.Equal(t, 2.0, report.AchievedTPS)
+	require.Equal(t, votes, report.Observers)
+
+	require.Equal(t, Summary{
+		Sent:           4,
+		SendFailed:     1,
+		Mined:          1,
+		Reverted:       1,
+		Aborted:        1,
+		TimedOut:       1,
+		InboundLatency: Percentiles{P50: 4, P90: 6, P95: 6, P99: 6, Max: 6},
+		Latency:        Percentiles{P50: 20, P90: 30, P95: 30, P99: 30, Max: 30},
+	}, report.Total)
+	require.Equal(t, 3, report.Total.Failures())
+
+	require.Len(t, report.Workloads, 2)
+	require.Equal(t, 2, report.Workloads[WorkloadETHDeposit].Sent)
+	require.Equal(t, 1, report.Workloads[WorkloadETHDeposit].Failures())
+	require.Equal(t, 2, report.Workloads[WorkloadETHWithdraw].Failures())
+}
@@ -0,0 +1,264 @@
+package stress
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	zetaconnectoreth "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.eth.sol"
+	connectorzevm "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/connectorzevm.sol"
+	wzeta "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/wzeta.sol"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+var (
+	// ConnectorZEVMAddr and WZetaAddr are the addresses of the ZETA contracts deployed at genesis on ZEVM
+	ConnectorZEVMAddr = ethcommon.HexToAddress("0x239e96c8f17C85c30100AC26F635Ea15f23E9c67")
+	WZetaAddr         = ethcommon.HexToAddress("0x5F0b1a82749cb4E2278EC87F8BF6B618dC71a8bf")
+)
+
+// amounts sent by each workload
+var (
+	ethAmount   = big.NewInt(1e14)                              // 0.0001 ETH
+	erc20Amount = big.NewInt(100)                               // 100 units of USDT
+	zetaAmount  = big.NewInt(1e18)                              // 1 ZETA
+	btcAmount   = big.NewInt(0.001 * btcutil.SatoshiPerBitcoin) // 0.001 BTC
+)
+
+const (
+	// btcBlockInterval is the interval at which blocks are mined on the Bitcoin regnet to confirm transactions
+	btcBlockInterval = 5 * time.Second
+)
+
+// Sender sends the transactions of the workloads with the deployer account
+// Transactions are sent sequentially, the nonce of each transaction is the pending nonce of the deployer
+type Sender struct {
+	sm            *runner.SmokeTestRunner
+	connectorZEVM *connectorzevm.ZetaConnectorZEVM
+	wZeta         *wzeta.WETH9
+}
+
+// NewSender returns a sender using the contracts of the smoke test runner
+// The smoke test runner must have been set up before
+func NewSender(sm *runner.SmokeTestRunner) (*Sender, error) {
+	connectorZEVM, err := connectorzevm.NewZetaConnectorZEVM(ConnectorZEVMAddr, sm.ZevmClient)
+	if err != nil {
+		return nil, err
+	}
+	wZeta, err := wzeta.NewWETH9(WZetaAddr, sm.ZevmClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Sender{
+		sm:            sm,
+		connectorZEVM: connectorZEVM,
+		wZeta:         wZeta,
+	}, nil
+}
+
+// Prepare funds the deployer and sets the allowances required by the workloads of the profile
+// Allowances are set once for the whole test so each workload only sends a single transaction
+func (s *Sender) Prepare(profile Profile) error {
+	sm := s.sm
+	maxAllowance := new(big.Int).Lsh(big.NewInt(1), 255)
+
+	for workload, weight := range profile.Workloads {
+		if weight == 0 {
+			continue
+		}
+		utils.LoudPrintf("Preparing workload %s\n", workload)
+
+		switch workload {
+		case WorkloadERC20Deposit:
+			// mint a large amount of USDT for the deposits and approve the custody
+			supply := new(big.Int).Mul(erc20Amount, big.NewInt(1_000_000))
+			tx, err := sm.USDTERC20.Mint(sm.GoerliAuth, supply)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+			tx, err = sm.USDTERC20.Approve(sm.GoerliAuth, sm.ERC20CustodyAddr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+		case WorkloadZETADeposit, WorkloadMessagePassing:
+			tx, err := sm.ZetaEth.Approve(sm.GoerliAuth, sm.ConnectorEthAddr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.GoerliClient, tx)
+		case WorkloadETHWithdraw:
+			// the withdraw fee is paid in ETH ZRC20
+			tx, err := sm.ETHZRC20.Approve(sm.ZevmAuth, sm.ETHZRC20Addr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.ZevmClient, tx)
+		case WorkloadERC20Withdraw:
+			// deposit USDT to withdraw and approve the withdraw fee paid in ETH ZRC20
+			inTxHash := sm.DepositERC20(new(big.Int).Mul(erc20Amount, big.NewInt(1_000_000)), nil)
+			utils.WaitCctxMinedByInTxHash(inTxHash.Hex(), sm.CctxClient)
+			tx, err := sm.ETHZRC20.Approve(sm.ZevmAuth, sm.USDTZRC20Addr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.ZevmClient, tx)
+		case WorkloadZETAWithdraw:
+			// wrap ZETA to be sent through the connector
+			amount := new(big.Int).Mul(zetaAmount, big.NewInt(50))
+			sm.ZevmAuth.Value = amount
+			tx, err := s.wZeta.Deposit(sm.ZevmAuth)
+			sm.ZevmAuth.Value = big.NewInt(0)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.ZevmClient, tx)
+			tx, err = s.wZeta.Approve(sm.ZevmAuth, ConnectorZEVMAddr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.ZevmClient, tx)
+		case WorkloadBTCWithdraw:
+			// the withdraw fee is paid in BTC ZRC20
+			tx, err := sm.BTCZRC20.Approve(sm.ZevmAuth, sm.BTCZRC20Addr, maxAllowance)
+			if err != nil {
+				return err
+			}
+			utils.MustWaitForTxReceipt(sm.ZevmClient, tx)
+		}
+	}
+	return nil
+}
+
+// MineBitcoin mines Bitcoin blocks at a regular interval until the context is done
+// Bitcoin deposits and withdrawals are only observed once they are confirmed
+func (s *Sender) MineBitcoin(ctx context.Context) {
+	ticker := time.NewTicker(btcBlockInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.sm.BtcRPCClient.GenerateToAddress(1, s.sm.BTCDeployerAddress, nil); err != nil {
+				fmt.Printf("failed to mine bitcoin block: %s\n", err.Error())
+			}
+		}
+	}
+}
+
+// Send sends the transaction of the workload and returns its hash
+// The hash is the inbound hash of the cctx created for the transaction
+func (s *Sender) Send(workload Workload) (string, error) {
+	sm := s.sm
+
+	switch workload {
+	case WorkloadETHDeposit:
+		tx, err := sm.SendEther(sm.TSSAddress, ethAmount, nil)
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	case WorkloadERC20Deposit:
+		tx, err := sm.ERC20Custody.Deposit(sm.GoerliAuth, sm.DeployerAddress.Bytes(), sm.USDTERC20Addr, erc20Amount, nil)
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	case WorkloadZETADeposit:
+		return s.sendZetaFromGoerli(common.ZetaPrivnetChain().ChainId)
+	case WorkloadMessagePassing:
+		return s.sendZetaFromGoerli(common.GoerliLocalnetChain().ChainId)
+	case WorkloadBTCDeposit:
+		return s.depositBTC()
+	case WorkloadETHWithdraw:
+		tx, err := sm.ETHZRC20.Withdraw(sm.ZevmAuth, sm.DeployerAddress.Bytes(), ethAmount)
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	case WorkloadERC20Withdraw:
+		tx, err := sm.USDTZRC20.Withdraw(sm.ZevmAuth, sm.DeployerAddress.Bytes(), erc20Amount)
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	case WorkloadZETAWithdraw:
+		tx, err := s.connectorZEVM.Send(sm.ZevmAuth, connectorzevm.ZetaInterfacesSendInput{
+			DestinationChainId:  big.NewInt(common.GoerliLocalnetChain().ChainId),
+			DestinationAddress:  sm.DeployerAddress.Bytes(),
+			DestinationGasLimit: big.NewInt(250_000),
+			ZetaValueAndGas:     zetaAmount,
+		})
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	case WorkloadBTCWithdraw:
+		tx, err := sm.BTCZRC20.Withdraw(sm.ZevmAuth, []byte(sm.BTCDeployerAddress.EncodeAddress()), btcAmount)
+		if err != nil {
+			return "", err
+		}
+		return tx.Hash().Hex(), nil
+	default:
+		return "", fmt.Errorf("unknown workload %s", workload)
+	}
+}
+
+// sendZetaFromGoerli sends ZETA through the Goerli connector to the deployer on the destination chain
+func (s *Sender) sendZetaFromGoerli(destinationChainID int64) (string, error) {
+	sm := s.sm
+	tx, err := sm.ConnectorEth.Send(sm.GoerliAuth, zetaconnectoreth.ZetaInterfacesSendInput{
+		DestinationChainId:  big.NewInt(destinationChainID),
+		DestinationAddress:  sm.DeployerAddress.Bytes(),
+		DestinationGasLimit: big.NewInt(250_000),
+		ZetaValueAndGas:     zetaAmount,
+	})
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// depositBTC sends BTC to the TSS address from an UTXO of the deployer
+// Unconfirmed UTXOs are used so several deposits can be sent in the same block
+func (s *Sender) depositBTC() (string, error) {
+	sm := s.sm
+	amount := float64(btcAmount.Int64())/btcutil.SatoshiPerBitcoin + zetaclient.BtcDepositorFeeMin
+
+	utxos, err := sm.BtcRPCClient.ListUnspentMinMaxAddresses(0, 9999999, []btcutil.Address{sm.BTCDeployerAddress})
+	if err != nil {
+		return "", err
+	}
+	var utxo *btcjson.ListUnspentResult
+	for i := range utxos {
+		// keep a margin for the transaction fee
+		if utxos[i].Spendable && utxos[i].Amount > amount+0.001 {
+			utxo = &utxos[i]
+			break
+		}
+	}
+	if utxo == nil {
+		return "", fmt.Errorf("no spendable utxo to deposit %f BTC", amount)
+	}
+
+	txHash, err := sm.SendToTSSFromDeployerToDeposit(
+		sm.BTCTSSAddress,
+		amount,
+		[]btcjson.ListUnspentResult{*utxo},
+		sm.BtcRPCClient,
+		sm.BTCDeployerAddress,
+	)
+	if err != nil {
+		return "", err
+	}
+	return txHash.String(), nil
+}
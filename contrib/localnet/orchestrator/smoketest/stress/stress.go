@@ -0,0 +1,90 @@
+// Package stress implements a stress test sending a configurable mix of cross-chain transactions to the localnet
+// and tracking each cctx to finality
+package stress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/runner"
+)
+
+// statInterval is the interval at which the progress of the stress test is printed
+const statInterval = 30 * time.Second
+
+// Run sends transactions following the profile and tracks their cctxs until they reach a terminal status
+// The smoke test runner must have been set up before, seed determines the sequence of workloads
+func Run(ctx context.Context, sm *runner.SmokeTestRunner, profile Profile, seed int64) (Report, error) {
+	if err := profile.Validate(); err != nil {
+		return Report{}, err
+	}
+
+	sender, err := NewSender(sm)
+	if err != nil {
+		return Report{}, err
+	}
+	if err := sender.Prepare(profile); err != nil {
+		return Report{}, fmt.Errorf("failed to prepare workloads: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if profile.HasBitcoinWorkload() {
+		go sender.MineBitcoin(ctx)
+	}
+
+	tracker := NewTracker(sm.CctxClient, sm.ObserverClient, profile.Timeout)
+	trackerCtx, stopTracker := context.WithCancel(ctx)
+	trackerDone := make(chan struct{})
+	go func() {
+		tracker.Run(trackerCtx)
+		close(trackerDone)
+	}()
+
+	picker := NewPicker(profile, seed)
+	interval := time.Duration(float64(time.Second) / profile.TPS)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	statTicker := time.NewTicker(statInterval)
+	defer statTicker.Stop()
+
+	fmt.Printf("Running stress test profile %s: %.2f TPS for %s\n", profile.Name, profile.TPS, profile.Duration)
+	startTime := time.Now()
+	deadline := startTime.Add(profile.Duration)
+	sent := 0
+
+	// transactions are sent sequentially so the nonces of the deployer are consecutive
+	// if sending takes longer than the interval, ticks are dropped and the achieved TPS is lower than the target
+sendLoop:
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			break sendLoop
+		case <-statTicker.C:
+			fmt.Printf("Stress test: %d transactions sent, %d cctxs pending\n", sent, tracker.Pending())
+		case <-ticker.C:
+			workload := picker.Pick()
+			r := &Result{
+				Workload: workload,
+				SentAt:   time.Now(),
+			}
+			r.InTxHash, r.SendErr = sender.Send(workload)
+			if r.SendErr != nil {
+				fmt.Printf("failed to send %s: %s\n", workload, r.SendErr.Error())
+			}
+			tracker.Track(r)
+			sent++
+		}
+	}
+	sendDuration := time.Since(startTime)
+
+	// wait for the remaining cctxs, each of them times out after the profile timeout
+	stopTracker()
+	<-trackerDone
+	fmt.Printf("Stress test: %d transactions sent, waiting for %d cctxs\n", sent, tracker.Pending())
+	tracker.Wait(ctx)
+
+	results, votes := tracker.Results()
+	return NewReport(profile, startTime, time.Now(), sendDuration, results, votes), nil
+}
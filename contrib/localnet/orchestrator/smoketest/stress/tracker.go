@@ -0,0 +1,245 @@
+package stress
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zeta-chain/zetacore/contrib/localnet/orchestrator/smoketest/utils"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// pollInterval is the interval at which pending cctxs are queried
+	pollInterval = 3 * time.Second
+
+	// pollWorkers is the maximum number of concurrent queries to ZetaChain
+	pollWorkers = 16
+)
+
+// Result is the outcome of a transaction sent by the stress test
+type Result struct {
+	Workload Workload
+	InTxHash string
+
+	// SendErr is set if the transaction could not be sent
+	SendErr error
+
+	// CctxIndex is the index of the cctx created for the transaction, empty until the inbound is observed
+	CctxIndex string
+	Status    crosschaintypes.CctxStatus
+	TimedOut  bool
+
+	SentAt      time.Time
+	ObservedAt  time.Time
+	FinalizedAt time.Time
+}
+
+// IsObserved returns true if the cctx of the transaction has been created
+func (r *Result) IsObserved() bool {
+	return r.CctxIndex != ""
+}
+
+// IsFinalized returns true if the cctx of the transaction reached a terminal status
+func (r *Result) IsFinalized() bool {
+	return !r.FinalizedAt.IsZero()
+}
+
+// ObserverVotes counts the votes expected from an observer on the ballots of the tracked cctxs
+type ObserverVotes struct {
+	// Ballots is the number of ballots the observer was expected to vote on
+	Ballots int `json:"ballots"`
+
+	// Missed is the number of ballots the observer didn't vote on when the cctx was finalized
+	Missed int `json:"missed"`
+}
+
+// Tracker tracks the cctxs of the transactions sent by the stress test until they reach a terminal status
+type Tracker struct {
+	cctxClient     crosschaintypes.QueryClient
+	observerClient observertypes.QueryClient
+	timeout        time.Duration
+
+	mu      sync.Mutex
+	pending []*Result
+	done    []*Result
+	votes   map[string]*ObserverVotes
+}
+
+// NewTracker returns a tracker that waits for each cctx up to the given timeout after its inbound is sent
+func NewTracker(
+	cctxClient crosschaintypes.QueryClient,
+	observerClient observertypes.QueryClient,
+	timeout time.Duration,
+) *Tracker {
+	return &Tracker{
+		cctxClient:     cctxClient,
+		observerClient: observerClient,
+		timeout:        timeout,
+		votes:          make(map[string]*ObserverVotes),
+	}
+}
+
+// Track adds a result to track
+// Results of transactions that could not be sent are done immediately
+func (t *Tracker) Track(r *Result) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if r.SendErr != nil {
+		t.done = append(t.done, r)
+		return
+	}
+	t.pending = append(t.pending, r)
+}
+
+// Pending returns the number of results not done yet
+func (t *Tracker) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
+
+// Results returns the results done and the votes of the observers
+func (t *Tracker) Results() ([]*Result, map[string]ObserverVotes) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	results := make([]*Result, len(t.done))
+	copy(results, t.done)
+	votes := make(map[string]ObserverVotes, len(t.votes))
+	for observer, v := range t.votes {
+		votes[observer] = *v
+	}
+	return results, votes
+}
+
+// Run polls the pending results until the context is done
+func (t *Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.Poll(ctx)
+		}
+	}
+}
+
+// Wait polls the pending results until all of them are done or the context is done
+func (t *Tracker) Wait(ctx context.Context) {
+	for t.Pending() > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+			t.Poll(ctx)
+		}
+	}
+}
+
+// Poll queries the cctxs of the pending results once
+func (t *Tracker) Poll(ctx context.Context) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = nil
+	t.mu.Unlock()
+
+	// each pending result is only updated by a single worker
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, pollWorkers)
+	stillPending := make([]bool, len(pending))
+	for i, r := range pending {
+		i, r := i, r
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			stillPending[i] = !t.update(ctx, r)
+		}()
+	}
+	wg.Wait()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, r := range pending {
+		if stillPending[i] {
+			t.pending = append(t.pending, r)
+		} else {
+			t.done = append(t.done, r)
+		}
+	}
+}
+
+// update queries the cctx of the result and returns true if the result is done
+func (t *Tracker) update(ctx context.Context, r *Result) bool {
+	now := time.Now()
+	if now.Sub(r.SentAt) > t.timeout {
+		r.TimedOut = true
+		return true
+	}
+
+	if !r.IsObserved() {
+		res, err := t.cctxClient.InTxHashToCctx(ctx, &crosschaintypes.QueryGetInTxHashToCctxRequest{InTxHash: r.InTxHash})
+		if err != nil || len(res.InTxHashToCctx.CctxIndex) == 0 {
+			// the inbound has not been observed yet
+			return false
+		}
+		indexes := res.InTxHashToCctx.CctxIndex
+		r.CctxIndex = indexes[len(indexes)-1]
+		r.ObservedAt = now
+	}
+
+	res, err := t.cctxClient.Cctx(ctx, &crosschaintypes.QueryGetCctxRequest{Index: r.CctxIndex})
+	if err != nil {
+		fmt.Printf("failed to query cctx %s: %s\n", r.CctxIndex, err.Error())
+		return false
+	}
+	cctx := res.CrossChainTx
+	r.Status = cctx.CctxStatus.Status
+	if !utils.IsTerminalStatus(r.Status) {
+		return false
+	}
+	r.FinalizedAt = now
+
+	// count the votes cast on the ballots of the cctx
+	ballots := []string{cctx.InboundTxParams.InboundTxBallotIndex}
+	if outTxParams := cctx.GetCurrentOutTxParam(); outTxParams.OutboundTxBallotIndex != "" {
+		ballots = append(ballots, outTxParams.OutboundTxBallotIndex)
+	}
+	for _, ballot := range ballots {
+		t.countVotes(ctx, ballot)
+	}
+	return true
+}
+
+// countVotes counts the votes of each observer on the ballot
+func (t *Tracker) countVotes(ctx context.Context, ballot string) {
+	if ballot == "" {
+		return
+	}
+	res, err := t.observerClient.BallotByIdentifier(ctx, &observertypes.QueryBallotByIdentifierRequest{BallotIdentifier: ballot})
+	if err != nil {
+		fmt.Printf("failed to query ballot %s: %s\n", ballot, err.Error())
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, voter := range res.Voters {
+		votes, ok := t.votes[voter.VoterAddress]
+		if !ok {
+			votes = &ObserverVotes{}
+			t.votes[voter.VoterAddress] = votes
+		}
+		votes.Ballots++
+		if voter.VoteType == observertypes.VoteType_NotYetVoted {
+			votes.Missed++
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	rpctypes "github.com/zeta-chain/zetacore/rpc/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -49,3 +50,36 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// GetLogsFromIndex returns the logs within the inclusive block range [from, to] matching the addresses and topics
+// using the log index. The boolean is false if the log index is disabled or doesn't cover the block range,
+// in which case the logs must be fetched from the block results.
+func (b *Backend) GetLogsFromIndex(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.indexer.(rpctypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	first, err := logIndexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := logIndexer.LastIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.FilterLogs(from, to, addresses, topics, limit)
+	if err != nil {
+		return nil, true, err
+	}
+	return logs, true, nil
+}
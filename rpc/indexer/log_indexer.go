@@ -0,0 +1,371 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethermint "github.com/evmos/ethermint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/zeta-chain/zetacore/rpc/backend"
+	rpctypes "github.com/zeta-chain/zetacore/rpc/types"
+)
+
+const (
+	KeyPrefixBlockLogs = 1
+	KeyPrefixAddress   = 2
+	KeyPrefixTopic     = 3
+	KeyPrefixMeta      = 4
+
+	// MaxTopics is the maximum number of topics of an EVM log
+	MaxTopics = 4
+)
+
+var (
+	keyFirstIndexedBlock = []byte{KeyPrefixMeta, 1}
+	keyLastIndexedBlock  = []byte{KeyPrefixMeta, 2}
+)
+
+var _ rpctypes.EVMLogIndexer = &KVLogIndexer{}
+
+// TxIndexer is the tx indexer wrapped by the log indexer
+type TxIndexer interface {
+	ethermint.EVMTxIndexer
+
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+}
+
+// KVLogIndexer wraps an eth tx indexer and maintains, in a separate KV db, the EVM logs of each block
+// together with an address index and a per-position topic index keyed by height.
+// The indexed heights always form a contiguous range, so the index can tell if it covers a query.
+type KVLogIndexer struct {
+	TxIndexer
+
+	db     dbm.DB
+	logger log.Logger
+}
+
+// NewKVLogIndexer creates the KVLogIndexer
+func NewKVLogIndexer(txIndexer TxIndexer, db dbm.DB, logger log.Logger) *KVLogIndexer {
+	return &KVLogIndexer{
+		TxIndexer: txIndexer,
+		db:        db,
+		logger:    logger,
+	}
+}
+
+// IndexBlock indexes the eth txs of the block with the wrapped tx indexer, then indexes the logs of the block
+func (kv *KVLogIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	if err := kv.TxIndexer.IndexBlock(block, txResults); err != nil {
+		return err
+	}
+	return kv.IndexBlockLogs(block.Header.Height, txResults)
+}
+
+// IndexBlockLogs indexes the EVM logs contained in the tx results of the block at the given height
+func (kv *KVLogIndexer) IndexBlockLogs(height int64, txResults []*abci.ResponseDeliverTx) error {
+	var logs []*ethtypes.Log
+	for txIndex, result := range txResults {
+		txLogs, err := backend.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to parse logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for _, msgLogs := range txLogs {
+			logs = append(logs, msgLogs...)
+		}
+	}
+
+	first, last, err := kv.indexedRange()
+	if err != nil {
+		return err
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if len(logs) > 0 {
+		bz, err := json.Marshal(logs)
+		if err != nil {
+			return errorsmod.Wrapf(err, "fail to marshal logs of block %d", height)
+		}
+		if err := batch.Set(BlockLogsKey(height), bz); err != nil {
+			return errorsmod.Wrap(err, "IndexBlockLogs")
+		}
+		for _, ethLog := range logs {
+			if err := batch.Set(AddressKey(ethLog.Address, height), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "IndexBlockLogs")
+			}
+			for position, topic := range ethLog.Topics {
+				if err := batch.Set(TopicKey(position, topic, height), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "IndexBlockLogs")
+				}
+			}
+		}
+	}
+
+	// keep the indexed range contiguous, a block that is not adjacent to the range restarts it
+	switch {
+	case first == -1, height > last+1, height < first-1:
+		if first != -1 {
+			kv.logger.Error("non contiguous block indexed, resetting the log index range",
+				"block", height, "first", first, "last", last)
+		}
+		first, last = height, height
+	case height == first-1:
+		first = height
+	case height == last+1:
+		last = height
+	}
+	if err := batch.Set(keyFirstIndexedBlock, heightToBytes(first)); err != nil {
+		return errorsmod.Wrap(err, "IndexBlockLogs")
+	}
+	if err := batch.Set(keyLastIndexedBlock, heightToBytes(last)); err != nil {
+		return errorsmod.Wrap(err, "IndexBlockLogs")
+	}
+
+	return batch.WriteSync()
+}
+
+// FirstIndexedBlock returns the first block of the log index, or -1 if the log index is empty
+func (kv *KVLogIndexer) FirstIndexedBlock() (int64, error) {
+	first, _, err := kv.indexedRange()
+	return first, err
+}
+
+// LastIndexedBlock returns the last block of the log index, or -1 if the log index is empty
+func (kv *KVLogIndexer) LastIndexedBlock() (int64, error) {
+	_, last, err := kv.indexedRange()
+	return last, err
+}
+
+// FilterLogs returns the logs within the inclusive block range [from, to] matching the addresses and topics.
+// The block range must be covered by the log index.
+func (kv *KVLogIndexer) FilterLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	first, last, err := kv.indexedRange()
+	if err != nil {
+		return nil, err
+	}
+	if first == -1 || from < first || to > last {
+		return nil, fmt.Errorf("block range [%d, %d] not covered by the log index [%d, %d]", from, to, first, last)
+	}
+	if len(topics) > MaxTopics {
+		return []*ethtypes.Log{}, nil
+	}
+
+	// candidate heights are the intersection of the heights matching each criterion, nil means all the heights
+	var candidates map[int64]struct{}
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = addressPrefix(address)
+		}
+		heights, err := kv.heightsWithPrefixes(prefixes, from, to)
+		if err != nil {
+			return nil, err
+		}
+		candidates = intersect(candidates, heights)
+	}
+	for position, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		prefixes := make([][]byte, len(topicList))
+		for i, topic := range topicList {
+			prefixes[i] = topicPrefix(position, topic)
+		}
+		heights, err := kv.heightsWithPrefixes(prefixes, from, to)
+		if err != nil {
+			return nil, err
+		}
+		candidates = intersect(candidates, heights)
+	}
+
+	var heights []int64
+	if candidates == nil {
+		heights, err = kv.heightsWithPrefixes([][]byte{{KeyPrefixBlockLogs}}, from, to)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		heights = make([]int64, 0, len(candidates))
+		for height := range candidates {
+			heights = append(heights, height)
+		}
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		blockLogs, err := kv.blockLogs(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, ethLog := range blockLogs {
+			if !MatchLog(ethLog, addresses, topics) {
+				continue
+			}
+			if len(logs) >= limit {
+				return nil, fmt.Errorf("query returned more than %d results", limit)
+			}
+			logs = append(logs, ethLog)
+		}
+	}
+	return logs, nil
+}
+
+// MatchLog returns true if the log matches the addresses and the topics, an empty list of addresses or
+// an empty list of topics at a given position matches anything
+func MatchLog(ethLog *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if ethLog.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(ethLog.Topics) {
+		return false
+	}
+	for position, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topicList {
+			if ethLog.Topics[position] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// indexedRange returns the first and last blocks of the log index, or -1, -1 if the log index is empty
+func (kv *KVLogIndexer) indexedRange() (int64, int64, error) {
+	firstBz, err := kv.db.Get(keyFirstIndexedBlock)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "indexedRange")
+	}
+	lastBz, err := kv.db.Get(keyLastIndexedBlock)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "indexedRange")
+	}
+	if firstBz == nil || lastBz == nil {
+		return -1, -1, nil
+	}
+	return bytesToHeight(firstBz), bytesToHeight(lastBz), nil
+}
+
+// blockLogs returns the indexed logs of the block at the given height
+func (kv *KVLogIndexer) blockLogs(height int64) ([]*ethtypes.Log, error) {
+	bz, err := kv.db.Get(BlockLogsKey(height))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "fail to get logs of block %d", height)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	var logs []*ethtypes.Log
+	if err := json.Unmarshal(bz, &logs); err != nil {
+		return nil, errorsmod.Wrapf(err, "fail to unmarshal logs of block %d", height)
+	}
+	return logs, nil
+}
+
+// heightsWithPrefixes returns the sorted union of the heights within [from, to] indexed under the prefixes
+func (kv *KVLogIndexer) heightsWithPrefixes(prefixes [][]byte, from, to int64) ([]int64, error) {
+	seen := make(map[int64]struct{})
+	heights := make([]int64, 0)
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), heightToBytes(from)...)
+		end := append(append([]byte{}, prefix...), heightToBytes(to+1)...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "heightsWithPrefixes")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			height := bytesToHeight(key[len(key)-8:])
+			if _, ok := seen[height]; ok {
+				continue
+			}
+			seen[height] = struct{}{}
+			heights = append(heights, height)
+		}
+		if err := it.Close(); err != nil {
+			return nil, errorsmod.Wrap(err, "heightsWithPrefixes")
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// intersect returns the heights of the set that are also in the list, a nil set is considered as the full set
+func intersect(set map[int64]struct{}, heights []int64) map[int64]struct{} {
+	res := make(map[int64]struct{}, len(heights))
+	for _, height := range heights {
+		if set == nil {
+			res[height] = struct{}{}
+		} else if _, ok := set[height]; ok {
+			res[height] = struct{}{}
+		}
+	}
+	return res
+}
+
+// BlockLogsKey returns the key for the logs of a block
+func BlockLogsKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockLogs}, heightToBytes(height)...)
+}
+
+// AddressKey returns the key indexing a block with a log emitted by the address
+func AddressKey(address common.Address, height int64) []byte {
+	return append(addressPrefix(address), heightToBytes(height)...)
+}
+
+// TopicKey returns the key indexing a block with a log containing the topic at the given position
+func TopicKey(position int, topic common.Hash, height int64) []byte {
+	return append(topicPrefix(position, topic), heightToBytes(height)...)
+}
+
+func addressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddress}, address.Bytes()...)
+}
+
+func topicPrefix(position int, topic common.Hash) []byte {
+	// #nosec G701 position is always lower than MaxTopics
+	return append([]byte{KeyPrefixTopic, byte(position)}, topic.Bytes()...)
+}
+
+func heightToBytes(height int64) []byte {
+	// #nosec G701 heights are always positive
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+func bytesToHeight(bz []byte) int64 {
+	// #nosec G701 heights are always positive
+	return int64(sdk.BigEndianToUint64(bz))
+}
@@ -0,0 +1,149 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/zeta-chain/zetacore/rpc/indexer"
+)
+
+// mockTxIndexer is a tx indexer that does nothing
+type mockTxIndexer struct{}
+
+func (mockTxIndexer) LastIndexedBlock() (int64, error)                           { return -1, nil }
+func (mockTxIndexer) FirstIndexedBlock() (int64, error)                          { return -1, nil }
+func (mockTxIndexer) IndexBlock(*tmtypes.Block, []*abci.ResponseDeliverTx) error { return nil }
+func (mockTxIndexer) GetByTxHash(common.Hash) (*ethermint.TxResult, error)       { return nil, nil }
+func (mockTxIndexer) GetByBlockAndIndex(int64, int32) (*ethermint.TxResult, error) {
+	return nil, nil
+}
+
+func txResultWithLogs(t *testing.T, logs ...*ethtypes.Log) *abci.ResponseDeliverTx {
+	attrs := make([]abci.EventAttribute, len(logs))
+	for i, ethLog := range logs {
+		bz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+		require.NoError(t, err)
+		attrs[i] = abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyTxLog), Value: bz}
+	}
+	return &abci.ResponseDeliverTx{
+		Events: []abci.Event{{Type: evmtypes.EventTypeTxLog, Attributes: attrs}},
+	}
+}
+
+func TestKVLogIndexer(t *testing.T) {
+	addr1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	addr2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	topicA := common.HexToHash("0xaa")
+	topicB := common.HexToHash("0xbb")
+	topicC := common.HexToHash("0xcc")
+
+	newIndexer := func(t *testing.T) *indexer.KVLogIndexer {
+		idxer := indexer.NewKVLogIndexer(mockTxIndexer{}, dbm.NewMemDB(), log.NewNopLogger())
+
+		// block 1: addr1 [A, B]
+		// block 2: no logs
+		// block 3: addr2 [A], addr1 [C, B]
+		// block 4: addr2 [B]
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 1}}, []*abci.ResponseDeliverTx{
+			txResultWithLogs(t, &ethtypes.Log{Address: addr1, Topics: []common.Hash{topicA, topicB}, BlockNumber: 1}),
+		}))
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 2}}, []*abci.ResponseDeliverTx{
+			{},
+		}))
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 3}}, []*abci.ResponseDeliverTx{
+			txResultWithLogs(t, &ethtypes.Log{Address: addr2, Topics: []common.Hash{topicA}, BlockNumber: 3}),
+			txResultWithLogs(t, &ethtypes.Log{Address: addr1, Topics: []common.Hash{topicC, topicB}, BlockNumber: 3}),
+		}))
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 4}}, []*abci.ResponseDeliverTx{
+			txResultWithLogs(t, &ethtypes.Log{Address: addr2, Topics: []common.Hash{topicB}, BlockNumber: 4}),
+		}))
+		return idxer
+	}
+
+	t.Run("should track the indexed range", func(t *testing.T) {
+		idxer := indexer.NewKVLogIndexer(mockTxIndexer{}, dbm.NewMemDB(), log.NewNopLogger())
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, first)
+
+		idxer = newIndexer(t)
+		first, err = idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 1, first)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 4, last)
+
+		// backward indexing extends the range
+		require.NoError(t, idxer.IndexBlockLogs(0, nil))
+		first, err = idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 0, first)
+
+		// a gap resets the range
+		require.NoError(t, idxer.IndexBlockLogs(10, nil))
+		first, err = idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 10, first)
+		last, err = idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 10, last)
+	})
+
+	t.Run("should fail if range is not covered", func(t *testing.T) {
+		idxer := newIndexer(t)
+		_, err := idxer.FilterLogs(0, 4, nil, nil, 100)
+		require.Error(t, err)
+		_, err = idxer.FilterLogs(1, 5, nil, nil, 100)
+		require.Error(t, err)
+	})
+
+	t.Run("should filter logs", func(t *testing.T) {
+		idxer := newIndexer(t)
+
+		tt := []struct {
+			name      string
+			from, to  int64
+			addresses []common.Address
+			topics    [][]common.Hash
+			expected  []uint64
+		}{
+			{"all logs", 1, 4, nil, nil, []uint64{1, 3, 3, 4}},
+			{"sub range", 2, 3, nil, nil, []uint64{3, 3}},
+			{"by address", 1, 4, []common.Address{addr1}, nil, []uint64{1, 3}},
+			{"by addresses", 1, 4, []common.Address{addr1, addr2}, nil, []uint64{1, 3, 3, 4}},
+			{"by first topic", 1, 4, nil, [][]common.Hash{{topicA}}, []uint64{1, 3}},
+			{"by second topic", 1, 4, nil, [][]common.Hash{{}, {topicB}}, []uint64{1, 3}},
+			{"by topic alternatives", 1, 4, nil, [][]common.Hash{{topicB, topicC}}, []uint64{3, 4}},
+			{"by address and topic", 1, 4, []common.Address{addr2}, [][]common.Hash{{topicA}}, []uint64{3}},
+			{"no match", 1, 4, []common.Address{addr2}, [][]common.Hash{{topicC}}, []uint64{}},
+			{"too many topics", 1, 4, nil, [][]common.Hash{{}, {}, {}, {}, {topicA}}, []uint64{}},
+		}
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				logs, err := idxer.FilterLogs(tc.from, tc.to, tc.addresses, tc.topics, 100)
+				require.NoError(t, err)
+				heights := make([]uint64, len(logs))
+				for i, ethLog := range logs {
+					heights[i] = ethLog.BlockNumber
+				}
+				require.Equal(t, tc.expected, heights)
+			})
+		}
+	})
+
+	t.Run("should fail if logs limit is exceeded", func(t *testing.T) {
+		idxer := newIndexer(t)
+		_, err := idxer.FilterLogs(1, 4, nil, nil, 3)
+		require.ErrorContains(t, err, "query returned more than 3 results")
+	})
+}
@@ -153,6 +153,20 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
+	// use the log index when it covers the range, the block range cap doesn't apply since blocks are not walked
+	if from, to := f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64(); from <= head {
+		if to > head {
+			to = head
+		}
+		indexedLogs, ok, err := f.backend.GetLogsFromIndex(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return indexedLogs, nil
+		}
+	}
+
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// EVMLogIndexer defines the interface of an EVM tx indexer that also maintains an address and topic
+// index of the EVM logs, allowing `eth_getLogs` to be served without walking the block results one by one.
+type EVMLogIndexer interface {
+	ethermint.EVMTxIndexer
+
+	// FirstIndexedBlock returns -1 if the log index is empty
+	FirstIndexedBlock() (int64, error)
+	// FilterLogs returns the logs within the inclusive block range [from, to] matching the addresses and topics,
+	// it returns an error if more than limit logs are matching
+	FilterLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
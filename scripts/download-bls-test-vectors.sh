@@ -0,0 +1,13 @@
+#!/bin/bash
+
+# Downloads the BLS test vectors of the consensus specs into common/beacon/testdata/bls, the vectors are run by the
+# tests of common/beacon
+#
+# Usage: download-bls-test-vectors.sh [release]
+
+set -e
+
+RELEASE=${1:-v0.1.1}
+DIR=$(dirname "$0")/../common/beacon/testdata/bls
+
+curl -sSfL "https://github.com/ethereum/bls12-381-tests/releases/download/${RELEASE}/bls_tests_json.tar.gz" | tar -xzf - -C "$DIR"
@@ -0,0 +1,101 @@
+#!/bin/bash
+
+# Generates an upgrade fixture of app/testdata/upgrades from the exported state of a node of a previous version
+#
+# A single-node network of the git ref is initialized as the standalone network, with the validator as the only
+# observer, and run for a few blocks. Its state is exported with zetacored export, the export is imported in the app of
+# the git ref, the bitcoin block headers are added by the observer and the stores are written in the fixture.
+#
+# Usage: gen-upgrade-fixture.sh <git ref> <fixture> <description> [flags of scripts/upgrade-fixture]
+# Example:
+#   gen-upgrade-fixture.sh fd8e5b0 observer_v4.json "observer store of v11.0.0" -versions observer=4 -btc-headers 3
+#
+# The export is written next to the fixture as <fixture>.export.json
+# GOFLAGS is used to build the git ref, for example to set a -modfile
+
+set -e
+
+if [ $# -lt 3 ]; then
+  echo "Usage: gen-upgrade-fixture.sh <git ref> <fixture> <description> [generator flags]"
+  exit 1
+fi
+REF=$1
+FIXTURE=$2
+DESCRIPTION=$3
+shift 3
+
+CHAINID="localnet_101-1"
+KEYRING="test"
+REPO=$(git rev-parse --show-toplevel)
+FIXTURES_DIR="$REPO/app/testdata/upgrades"
+EXPORT="$FIXTURES_DIR/${FIXTURE%.json}.export.json"
+
+WORKDIR=$(mktemp -d)
+WORKTREE="$WORKDIR/zetacore"
+NODE_HOME="$WORKDIR/home"
+cleanup() {
+  if [ -n "$NODE_PID" ]; then
+    kill "$NODE_PID" 2>/dev/null || true
+  fi
+  git -C "$REPO" worktree remove --force "$WORKTREE" 2>/dev/null || true
+  rm -rf "$WORKDIR"
+}
+trap cleanup EXIT
+
+# build the zetacored of the git ref
+git -C "$REPO" worktree add --detach "$WORKTREE" "$REF"
+(cd "$WORKTREE" && go build -o "$WORKDIR/zetacored" ./cmd/zetacored)
+zetacored() {
+  "$WORKDIR/zetacored" --home "$NODE_HOME" "$@"
+}
+
+# init a single-node network as the standalone network, the validator is the only observer
+zetacored config keyring-backend $KEYRING
+zetacored config chain-id $CHAINID
+echo "race draft rival universe maid cheese steel logic crowd fork comic easy truth drift tomorrow eye buddy head time cash swing swift midnight borrow" | zetacored keys add zeta --algo=secp256k1 --recover --keyring-backend=$KEYRING
+zetacored init Zetanode-Fixture --chain-id=$CHAINID > /dev/null 2>&1
+
+GENESIS="$NODE_HOME/config/genesis.json"
+set_genesis() {
+  jq "$1" "$GENESIS" > "$GENESIS.tmp" && mv "$GENESIS.tmp" "$GENESIS"
+}
+set_genesis '.app_state["staking"]["params"]["bond_denom"]="azeta"'
+set_genesis '.app_state["crisis"]["constant_fee"]["denom"]="azeta"'
+set_genesis '.app_state["gov"]["deposit_params"]["min_deposit"][0]["denom"]="azeta"'
+set_genesis '.app_state["mint"]["params"]["mint_denom"]="azeta"'
+set_genesis '.app_state["evm"]["params"]["evm_denom"]="azeta"'
+set_genesis '.consensus_params["block"]["max_gas"]="10000000"'
+
+jq '.[0:1]' "$WORKTREE/standalone-network/observers.json" > "$WORKDIR/observers.json"
+zetacored add-observer-list "$WORKDIR/observers.json" --keygen-block=5
+
+# the block header verification of the evm chains is disabled, the migrations must keep the flags of the network
+set_genesis '.app_state["observer"]["crosschain_flags"]["blockHeaderVerificationFlags"]={"isEthTypeChainEnabled":false,"isBtcTypeChainEnabled":true}'
+
+zetacored gentx zeta 1000000000000000000000azeta --chain-id=$CHAINID --keyring-backend=$KEYRING
+zetacored collect-gentxs > /dev/null 2>&1
+zetacored validate-genesis
+
+# run the node for a few blocks and export its state
+"$WORKDIR/zetacored" start --home "$NODE_HOME" --minimum-gas-prices=0.0001azeta > "$WORKDIR/zetacored.log" 2>&1 &
+NODE_PID=$!
+for _ in $(seq 1 60); do
+  HEIGHT=$(zetacored status 2>&1 | jq -r '.SyncInfo.latest_block_height' 2>/dev/null || echo 0)
+  if [ "${HEIGHT:-0}" -ge 5 ] 2>/dev/null; then
+    break
+  fi
+  sleep 1
+done
+kill "$NODE_PID"
+wait "$NODE_PID" || true
+NODE_PID=""
+zetacored export > "$EXPORT" 2> "$WORKDIR/export.log"
+
+# write the fixture from the export with the generator of this tree built against the git ref
+mkdir -p "$WORKTREE/scripts/upgrade-fixture"
+cp "$REPO/scripts/upgrade-fixture/main.go" "$WORKTREE/scripts/upgrade-fixture/"
+(cd "$WORKTREE" && go run ./scripts/upgrade-fixture \
+  -genesis "$EXPORT" \
+  -description "$DESCRIPTION" \
+  -output "$FIXTURES_DIR/$FIXTURE" \
+  "$@")
@@ -0,0 +1,311 @@
+// Command upgrade-fixture writes an upgrade fixture of app/testdata/upgrades from the exported state of a node
+// It is run by scripts/gen-upgrade-fixture.sh in a worktree of the version of the node, the export is imported in the
+// app of this version so the stores are written as by the node, and only uses the API of the app of the previous versions
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/common"
+	observerkeeper "github.com/zeta-chain/zetacore/x/observer/keeper"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// storeTypes are the protobuf message types of the values of the stores by key prefix, the longest matching prefix of a
+// key gives the type of its value
+var storeTypes = map[string]map[string]string{
+	observertypes.StoreKey: {
+		"Blame-":                  "zetachain.zetacore.observer.Blame",
+		"Voter-value-":            "zetachain.zetacore.observer.Ballot",
+		"CoreParams":              "zetachain.zetacore.observer.CoreParamsList",
+		"Observer-value-":         "zetachain.zetacore.observer.ObserverMapper",
+		"PermissionFlags-value-":  "zetachain.zetacore.observer.CrosschainFlags",
+		"ObserverCount-value-":    "zetachain.zetacore.observer.LastObserverCount",
+		"NodeAccount-value-":      "zetachain.zetacore.observer.NodeAccount",
+		"Keygen-value-":           "zetachain.zetacore.observer.Keygen",
+		"BlockHeader-value-":      "common.BlockHeader",
+		"BlockHeaderState-value-": "zetachain.zetacore.observer.BlockHeaderState",
+		"BallotList-value-":       "zetachain.zetacore.observer.BallotListForHeight",
+		"TSS-value-":              "zetachain.zetacore.observer.TSS",
+		"TSS-History-value-":      "zetachain.zetacore.observer.TSS",
+		"FundsMigrator-value-":    "zetachain.zetacore.observer.TssFundMigratorInfo",
+		"PendingNonces-value-":    "zetachain.zetacore.observer.PendingNonces",
+		"ChainNonces-value-":      "zetachain.zetacore.observer.ChainNonces",
+		"NonceToCctx-value-":      "zetachain.zetacore.observer.NonceToCctx",
+	},
+}
+
+// fixture is an upgrade fixture as read by the upgrade tests of the app
+type fixture struct {
+	Description string            `json:"description"`
+	Versions    map[string]uint64 `json:"versions"`
+	Stores      []fixtureStore    `json:"stores"`
+}
+
+type fixtureStore struct {
+	Name    string         `json:"name"`
+	Entries []fixtureEntry `json:"entries"`
+}
+
+// fixtureEntry is a key-value pair of a store, the binary suffix of a key after its prefix is written in hex
+type fixtureEntry struct {
+	Key    string          `json:"key"`
+	KeyHex string          `json:"key_hex,omitempty"`
+	Type   string          `json:"type"`
+	Value  json.RawMessage `json:"value"`
+}
+
+func main() {
+	genesisFile := flag.String("genesis", "", "genesis exported by the node")
+	description := flag.String("description", "", "description of the fixture")
+	output := flag.String("output", "", "file of the fixture")
+	stores := flag.String("stores", observertypes.StoreKey, "comma-separated stores of the fixture")
+	versions := flag.String("versions", "", "comma-separated consensus versions of the modules of the fixture, as module=version")
+	btcHeaders := flag.Int("btc-headers", 0, "number of bitcoin regtest block headers added by the observers after the import")
+	flag.Parse()
+
+	// the addresses of the export are read with the prefixes of the node
+	app.SetConfig()
+	if err := run(*genesisFile, *description, *output, *stores, *versions, *btcHeaders); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(genesisFile, description, output, stores, versions string, btcHeaders int) error {
+	f := fixture{
+		Description: description,
+		Versions:    make(map[string]uint64),
+	}
+	for _, v := range strings.Split(versions, ",") {
+		if v == "" {
+			continue
+		}
+		name, version, ok := strings.Cut(v, "=")
+		if !ok {
+			return fmt.Errorf("invalid version %s", v)
+		}
+		n, err := strconv.ParseUint(version, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %s: %w", v, err)
+		}
+		f.Versions[name] = n
+	}
+
+	zetaApp, ctx, err := importGenesis(genesisFile)
+	if err != nil {
+		return err
+	}
+	if err := addBitcoinHeaders(ctx, zetaApp, btcHeaders); err != nil {
+		return err
+	}
+
+	for _, name := range strings.Split(stores, ",") {
+		key := zetaApp.GetKey(name)
+		if key == nil {
+			return fmt.Errorf("unknown store %s", name)
+		}
+		entries, err := dumpStore(ctx, zetaApp.AppCodec(), key, storeTypes[name])
+		if err != nil {
+			return fmt.Errorf("store %s: %w", name, err)
+		}
+		f.Stores = append(f.Stores, fixtureStore{Name: name, Entries: entries})
+	}
+
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, append(bz, '\n'), 0600)
+}
+
+// importGenesis initializes an app with the exported genesis and returns a context of the first block after the export
+func importGenesis(genesisFile string) (*app.App, sdk.Context, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	home, err := os.MkdirTemp("", "upgrade-fixture")
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+	defer os.RemoveAll(home)
+
+	zetaApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		home,
+		0,
+		app.MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+	)
+	zetaApp.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	ctx := zetaApp.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: genDoc.ChainID,
+		Height:  genDoc.InitialHeight,
+		Time:    genDoc.GenesisTime,
+	})
+	return zetaApp, ctx, nil
+}
+
+// addBitcoinHeaders adds a chain of bitcoin regtest block headers voted by the observers of the chain
+// The block headers are mined from the regtest genesis block with a 10 minutes interval up to the time of the context
+func addBitcoinHeaders(ctx sdk.Context, zetaApp *app.App, count int) error {
+	if count == 0 {
+		return nil
+	}
+	params := chaincfg.RegressionNetParams
+	chain := common.BtcRegtestChain()
+	k := zetaApp.ZetaObserverKeeper
+	mapper, found := k.GetObserverMapper(ctx, &chain)
+	if !found {
+		return fmt.Errorf("no observers for chain %d", chain.ChainId)
+	}
+	msgServer := observerkeeper.NewMsgServerImpl(*k)
+
+	prevHash := *params.GenesisHash
+	for height := int64(1); height <= int64(count); height++ {
+		merkleRoot := make([]byte, 8)
+		binary.BigEndian.PutUint64(merkleRoot, uint64(height))
+		header := wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  prevHash,
+			MerkleRoot: chainhash.DoubleHashH(merkleRoot),
+			Timestamp:  time.Unix(ctx.BlockTime().Unix()-(int64(count)-height+1)*600, 0),
+			Bits:       params.PowLimitBits,
+		}
+		for hash := header.BlockHash(); blockchain.HashToBig(&hash).Cmp(blockchain.CompactToBig(header.Bits)) > 0; hash = header.BlockHash() {
+			header.Nonce++
+		}
+		var buf bytes.Buffer
+		if err := header.Serialize(&buf); err != nil {
+			return err
+		}
+		hash := header.BlockHash()
+
+		msg := &observertypes.MsgAddBlockHeader{
+			ChainId:   chain.ChainId,
+			BlockHash: hash[:],
+			Height:    height,
+			Header:    common.NewBitcoinHeader(buf.Bytes()),
+		}
+		for _, observer := range mapper.ObserverList {
+			msg.Creator = observer
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if _, err := msgServer.AddBlockHeader(sdk.WrapSDKContext(ctx), msg); err != nil {
+				return fmt.Errorf("block header %d: %w", height, err)
+			}
+			if _, found = k.GetBlockHeader(ctx, hash[:]); found {
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("block header %d is not voted", height)
+		}
+		prevHash = hash
+	}
+	return nil
+}
+
+// dumpStore returns the entries of a store, a value is written as the JSON of its type and must be encoded back as stored
+func dumpStore(ctx sdk.Context, cdc codec.Codec, key storetypes.StoreKey, types map[string]string) ([]fixtureEntry, error) {
+	prefixes := make([]string, 0, len(types))
+	for prefix := range types {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	var entries []fixtureEntry
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		prefix := ""
+		for _, p := range prefixes {
+			if bytes.HasPrefix(key, []byte(p)) {
+				prefix = p
+				break
+			}
+		}
+		if prefix == "" {
+			return nil, fmt.Errorf("no type for key %q", key)
+		}
+
+		typeName := types[prefix]
+		msgType := proto.MessageType(typeName)
+		if msgType == nil {
+			return nil, fmt.Errorf("unknown type %s", typeName)
+		}
+		msg, ok := reflect.New(msgType.Elem()).Interface().(codec.ProtoMarshaler)
+		if !ok {
+			return nil, fmt.Errorf("type %s is not a protobuf message", typeName)
+		}
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, fmt.Errorf("key %q: %w", key, err)
+		}
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		if encoded, err := cdc.Marshal(msg); err != nil || !bytes.Equal(encoded, value) {
+			return nil, fmt.Errorf("key %q: the value is not encoded back as stored", key)
+		}
+
+		entry := fixtureEntry{Key: string(key), Type: typeName, Value: bz}
+		if !printable(key) {
+			entry.Key = prefix
+			entry.KeyHex = hex.EncodeToString(key[len(prefix):])
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// printable returns true if the key is printable ASCII
+func printable(key []byte) bool {
+	for _, b := range key {
+		if b < 0x20 || b > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -88,6 +88,10 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the address and topic log index used to serve 'eth_getLogs' over large block ranges.
+# It requires enable-indexer to be set, the index can be back-filled with the 'index-eth-tx' command.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
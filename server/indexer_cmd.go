@@ -26,6 +26,8 @@ import (
 	tmnode "github.com/tendermint/tendermint/node"
 	sm "github.com/tendermint/tendermint/state"
 	tmstore "github.com/tendermint/tendermint/store"
+	logindexer "github.com/zeta-chain/zetacore/rpc/indexer"
+	srvflags "github.com/zeta-chain/zetacore/server/flags"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -38,6 +40,9 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		If the log indexer is enabled (json-rpc.enable-log-indexer), the logs are indexed together with the txs,
+		the traversal then starts from the first or latest block of the log index, allowing to back-fill it.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,7 +65,15 @@ func NewIndexTxCmd() *cobra.Command {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			var idxer logindexer.TxIndexer = indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			if serverCtx.Viper.GetBool(srvflags.JSONRPCEnableLogIndexer) {
+				logIdxDB, err := OpenLogIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+				if err != nil {
+					logger.Error("failed to open evm log indexer DB", "error", err.Error())
+					return err
+				}
+				idxer = logindexer.NewKVLogIndexer(idxer, logIdxDB, logger.With("module", "evmlogindex"))
+			}
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
@@ -129,5 +142,6 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Also index the logs used by eth_getLogs")
 	return cmd
 }
@@ -63,6 +63,7 @@ import (
 
 	"github.com/evmos/ethermint/indexer"
 	ethermint "github.com/evmos/ethermint/types"
+	logindexer "github.com/zeta-chain/zetacore/rpc/indexer"
 	ethdebug "github.com/zeta-chain/zetacore/rpc/namespaces/ethereum/debug"
 	"github.com/zeta-chain/zetacore/server/config"
 	srvflags "github.com/zeta-chain/zetacore/server/flags"
@@ -215,6 +216,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the log index used by eth_getLogs, requires the custom tx indexer")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -374,6 +376,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		logger.Info("starting node in query only mode; Tendermint is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.EnableLogIndexer = false
 	} else {
 		logger.Info("starting node with ABCI Tendermint in-process")
 
@@ -438,7 +441,16 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		txIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		idxer = txIdxer
+		if config.JSONRPC.EnableLogIndexer {
+			logIdxDB, err := OpenLogIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm log indexer DB", "error", err.Error())
+				return err
+			}
+			idxer = logindexer.NewKVLogIndexer(txIdxer, logIdxDB, idxLogger)
+		}
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
 		indexerService.SetLogger(idxLogger)
 
@@ -661,6 +673,12 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenLogIndexerDB opens the eth log indexer db, using the same db backend as the main app
+func OpenLogIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmlogindexer", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
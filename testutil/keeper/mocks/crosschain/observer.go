@@ -24,6 +24,11 @@ func (_m *CrosschainObserverKeeper) AddBallotToList(ctx types.Context, ballot ob
 	_m.Called(ctx, ballot)
 }
 
+// AddBlockHeaderReference provides a mock function with given fields: ctx, hash
+func (_m *CrosschainObserverKeeper) AddBlockHeaderReference(ctx types.Context, hash []byte) {
+	_m.Called(ctx, hash)
+}
+
 // AddVoteToBallot provides a mock function with given fields: ctx, ballot, address, observationType
 func (_m *CrosschainObserverKeeper) AddVoteToBallot(ctx types.Context, ballot observertypes.Ballot, address string, observationType observertypes.VoteType) (observertypes.Ballot, error) {
 	ret := _m.Called(ctx, ballot, address, observationType)
@@ -52,6 +57,42 @@ func (_m *CrosschainObserverKeeper) AddVoteToBallot(ctx types.Context, ballot ob
 	return r0, r1
 }
 
+// CheckBlockHeaderConfirmed provides a mock function with given fields: ctx, header, confirmationCount
+func (_m *CrosschainObserverKeeper) CheckBlockHeaderConfirmed(ctx types.Context, header common.BlockHeader, confirmationCount uint64) error {
+	ret := _m.Called(ctx, header, confirmationCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlockHeaderConfirmed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.BlockHeader, uint64) error); ok {
+		r0 = rf(ctx, header, confirmationCount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckBlockHeaderFinalized provides a mock function with given fields: ctx, header
+func (_m *CrosschainObserverKeeper) CheckBlockHeaderFinalized(ctx types.Context, header common.BlockHeader) error {
+	ret := _m.Called(ctx, header)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlockHeaderFinalized")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.BlockHeader) error); ok {
+		r0 = rf(ctx, header)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckIfFinalizingVote provides a mock function with given fields: ctx, ballot
 func (_m *CrosschainObserverKeeper) CheckIfFinalizingVote(ctx types.Context, ballot observertypes.Ballot) (observertypes.Ballot, bool) {
 	ret := _m.Called(ctx, ballot)
@@ -108,6 +149,11 @@ func (_m *CrosschainObserverKeeper) CheckIfTssPubkeyHasBeenGenerated(ctx types.C
 	return r0, r1
 }
 
+// FinalizeKeygenAttempt provides a mock function with given fields: ctx, success
+func (_m *CrosschainObserverKeeper) FinalizeKeygenAttempt(ctx types.Context, success bool) {
+	_m.Called(ctx, success)
+}
+
 // FindBallot provides a mock function with given fields: ctx, index, chain, observationType
 func (_m *CrosschainObserverKeeper) FindBallot(ctx types.Context, index string, chain *common.Chain, observationType observertypes.ObservationType) (observertypes.Ballot, bool, error) {
 	ret := _m.Called(ctx, index, chain, observationType)
@@ -763,11 +809,21 @@ func (_m *CrosschainObserverKeeper) IsInboundEnabled(ctx types.Context) bool {
 	return r0
 }
 
+// RecordKeygenReport provides a mock function with given fields: ctx, operator, status, tssPubkey
+func (_m *CrosschainObserverKeeper) RecordKeygenReport(ctx types.Context, operator string, status common.ReceiveStatus, tssPubkey string) {
+	_m.Called(ctx, operator, status, tssPubkey)
+}
+
 // RemoveAllExistingMigrators provides a mock function with given fields: ctx
 func (_m *CrosschainObserverKeeper) RemoveAllExistingMigrators(ctx types.Context) {
 	_m.Called(ctx)
 }
 
+// RemoveBlockHeaderReference provides a mock function with given fields: ctx, hash
+func (_m *CrosschainObserverKeeper) RemoveBlockHeaderReference(ctx types.Context, hash []byte) {
+	_m.Called(ctx, hash)
+}
+
 // RemoveChainNonces provides a mock function with given fields: ctx, index
 func (_m *CrosschainObserverKeeper) RemoveChainNonces(ctx types.Context, index string) {
 	_m.Called(ctx, index)
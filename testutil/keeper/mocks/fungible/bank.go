@@ -191,6 +191,26 @@ func (_m *FungibleBankKeeper) SetDenomMetaData(ctx types.Context, denomMetaData
 	_m.Called(ctx, denomMetaData)
 }
 
+// SpendableCoins provides a mock function with given fields: ctx, addr
+func (_m *FungibleBankKeeper) SpendableCoins(ctx types.Context, addr types.AccAddress) types.Coins {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpendableCoins")
+	}
+
+	var r0 types.Coins
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress) types.Coins); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Coins)
+		}
+	}
+
+	return r0
+}
+
 // NewFungibleBankKeeper creates a new instance of FungibleBankKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFungibleBankKeeper(t interface {
@@ -93,6 +93,8 @@ type Config struct {
 	RPCAddress       string                     // RPC listen address (including port)
 	APIAddress       string                     // REST API listen address (including port)
 	GRPCAddress      string                     // GRPC server listen address (including port)
+	JSONRPCAddress   string                     // EVM JSON-RPC listen address (including port), the JSON-RPC server is not started if empty
+	BlockMaxGas      int64                      // the maximum gas of a block, the default consensus params are used if zero
 	PrintMnemonic    bool                       // print the mnemonic of first validator as log output for testing
 }
 
@@ -119,24 +121,26 @@ type (
 	// a client can make RPC and API calls and interact with any client command
 	// or handler.
 	Validator struct {
-		AppConfig  *srvconfig.Config
-		ClientCtx  client.Context
-		Ctx        *server.Context
-		Dir        string
-		NodeID     string
-		PubKey     cryptotypes.PubKey
-		Moniker    string
-		APIAddress string
-		RPCAddress string
-		P2PAddress string
-		Address    sdk.AccAddress
-		ValAddress sdk.ValAddress
-		RPCClient  tmclient.Client
+		AppConfig      *srvconfig.Config
+		ClientCtx      client.Context
+		Ctx            *server.Context
+		Dir            string
+		NodeID         string
+		PubKey         cryptotypes.PubKey
+		Moniker        string
+		APIAddress     string
+		RPCAddress     string
+		P2PAddress     string
+		JSONRPCAddress string
+		Address        sdk.AccAddress
+		ValAddress     sdk.ValAddress
+		RPCClient      tmclient.Client
 
 		tmNode  *node.Node
 		api     *api.Server
 		grpc    *grpc.Server
 		grpcWeb *http.Server
+		jsonRPC *http.Server
 	}
 )
 
@@ -219,7 +223,10 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		appCfg.GRPC.Enable = false
 		appCfg.GRPCWeb.Enable = false
 		apiListenAddr := ""
+		jsonRPCAddr := ""
 		if i == 0 {
+			jsonRPCAddr = cfg.JSONRPCAddress
+
 			if cfg.APIAddress != "" {
 				apiListenAddr = cfg.APIAddress
 			} else {
@@ -432,18 +439,19 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			WithAccountRetriever(cfg.AccountRetriever)
 
 		network.Validators[i] = &Validator{
-			AppConfig:  appCfg,
-			ClientCtx:  clientCtx,
-			Ctx:        ctx,
-			Dir:        filepath.Join(network.BaseDir, nodeDirName),
-			NodeID:     nodeID,
-			PubKey:     pubKey,
-			Moniker:    nodeDirName,
-			RPCAddress: tmCfg.RPC.ListenAddress,
-			P2PAddress: tmCfg.P2P.ListenAddress,
-			APIAddress: apiAddr,
-			Address:    addr,
-			ValAddress: sdk.ValAddress(addr),
+			AppConfig:      appCfg,
+			ClientCtx:      clientCtx,
+			Ctx:            ctx,
+			Dir:            filepath.Join(network.BaseDir, nodeDirName),
+			NodeID:         nodeID,
+			PubKey:         pubKey,
+			Moniker:        nodeDirName,
+			RPCAddress:     tmCfg.RPC.ListenAddress,
+			P2PAddress:     tmCfg.P2P.ListenAddress,
+			APIAddress:     apiAddr,
+			JSONRPCAddress: jsonRPCAddr,
+			Address:        addr,
+			ValAddress:     sdk.ValAddress(addr),
 		}
 	}
 
@@ -589,6 +597,10 @@ func (n *Network) Cleanup() {
 				_ = v.grpcWeb.Close()
 			}
 		}
+
+		if v.jsonRPC != nil {
+			_ = v.jsonRPC.Close()
+		}
 	}
 
 	// Give a brief pause for things to finish closing in other processes. Hopefully this helps with the address-in-use errors.
@@ -6,6 +6,9 @@ import (
 	"path/filepath"
 	"time"
 
+	ethermintserver "github.com/evmos/ethermint/server"
+	ethermintconfig "github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
@@ -114,6 +117,35 @@ func startInProcess(cfg Config, val *Validator) error {
 			}
 		}
 	}
+
+	if val.JSONRPCAddress != "" {
+		jsonRPCCfg := ethermintconfig.DefaultConfig()
+		jsonRPCCfg.Config = *val.AppConfig
+		jsonRPCCfg.JSONRPC.Address = val.JSONRPCAddress
+		jsonRPCCfg.JSONRPC.WsAddress = ""
+
+		// the JSON-RPC backend reads its limits from the server context
+		val.Ctx.Viper.Set(srvflags.JSONRPCGasCap, jsonRPCCfg.JSONRPC.GasCap)
+		val.Ctx.Viper.Set(srvflags.JSONRPCEVMTimeout, jsonRPCCfg.JSONRPC.EVMTimeout)
+		val.Ctx.Viper.Set(srvflags.JSONRPCTxFeeCap, jsonRPCCfg.JSONRPC.TxFeeCap)
+		val.Ctx.Viper.Set(srvflags.JSONRPCFilterCap, jsonRPCCfg.JSONRPC.FilterCap)
+		val.Ctx.Viper.Set(srvflags.JSONRPCLogsCap, jsonRPCCfg.JSONRPC.LogsCap)
+		val.Ctx.Viper.Set(srvflags.JSONRPCBlockRangeCap, jsonRPCCfg.JSONRPC.BlockRangeCap)
+
+		jsonRPCSrv, _, err := ethermintserver.StartJSONRPC(
+			val.Ctx,
+			val.ClientCtx,
+			tmCfg.RPC.ListenAddress,
+			"/websocket",
+			jsonRPCCfg,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		val.jsonRPC = jsonRPCSrv
+	}
 	return nil
 }
 
@@ -144,7 +176,11 @@ func collectGenFiles(cfg Config, vals []*Validator, outputDir string) error {
 		}
 
 		// overwrite each validator's genesis file to have a canonical genesis time
-		if err := genutil.ExportGenesisFileWithTime(genFile, cfg.ChainID, nil, appState, genTime); err != nil {
+		// the consensus params of the genesis file are kept
+		genDoc.GenesisTime = genTime
+		genDoc.AppState = appState
+		genDoc.Validators = nil
+		if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
 			return err
 		}
 	}
@@ -182,6 +218,10 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 		AppState:   appGenStateJSON,
 		Validators: nil,
 	}
+	if cfg.BlockMaxGas != 0 {
+		genDoc.ConsensusParams = types.DefaultConsensusParams()
+		genDoc.ConsensusParams.Block.MaxGas = cfg.BlockMaxGas
+	}
 
 	// generate empty genesis files for each validator and save
 	for i := 0; i < cfg.NumOfValidators; i++ {
@@ -0,0 +1,114 @@
+package sample
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// The light client objects in the JSON format of the beacon node API, with the bytes in hex and the integers in decimal
+// strings: https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientBootstrap
+
+// BeaconAPIVersion returns the name of the fork of a slot in the versioned envelope of the beacon node API
+func BeaconAPIVersion(config *beacon.Config, slot uint64) string {
+	switch {
+	case config.IsElectra(slot):
+		return "electra"
+	case config.IsDeneb(slot):
+		return "deneb"
+	default:
+		return "capella"
+	}
+}
+
+// BeaconAPIEnvelope returns an object in the versioned envelope of the beacon node API
+func BeaconAPIEnvelope(version string, data interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"version": version,
+		"data":    data,
+	}
+}
+
+// BeaconAPILightClientHeader returns a light client header in the JSON format of the beacon node API
+func BeaconAPILightClientHeader(h types.LightClientHeader) map[string]interface{} {
+	execution := map[string]interface{}{
+		"parent_hash":       hexutil.Bytes(h.Execution.ParentHash),
+		"fee_recipient":     hexutil.Bytes(h.Execution.FeeRecipient),
+		"state_root":        hexutil.Bytes(h.Execution.StateRoot),
+		"receipts_root":     hexutil.Bytes(h.Execution.ReceiptsRoot),
+		"logs_bloom":        hexutil.Bytes(h.Execution.LogsBloom),
+		"prev_randao":       hexutil.Bytes(h.Execution.PrevRandao),
+		"block_number":      strconv.FormatUint(h.Execution.BlockNumber, 10),
+		"gas_limit":         strconv.FormatUint(h.Execution.GasLimit, 10),
+		"gas_used":          strconv.FormatUint(h.Execution.GasUsed, 10),
+		"timestamp":         strconv.FormatUint(h.Execution.Timestamp, 10),
+		"extra_data":        hexutil.Bytes(h.Execution.ExtraData),
+		"base_fee_per_gas":  h.Execution.BaseFeePerGas,
+		"block_hash":        hexutil.Bytes(h.Execution.BlockHash),
+		"transactions_root": hexutil.Bytes(h.Execution.TransactionsRoot),
+		"withdrawals_root":  hexutil.Bytes(h.Execution.WithdrawalsRoot),
+	}
+	if h.Execution.BlobGasUsed != 0 || h.Execution.ExcessBlobGas != 0 {
+		execution["blob_gas_used"] = strconv.FormatUint(h.Execution.BlobGasUsed, 10)
+		execution["excess_blob_gas"] = strconv.FormatUint(h.Execution.ExcessBlobGas, 10)
+	}
+	return map[string]interface{}{
+		"beacon": map[string]interface{}{
+			"slot":           strconv.FormatUint(h.Beacon.Slot, 10),
+			"proposer_index": strconv.FormatUint(h.Beacon.ProposerIndex, 10),
+			"parent_root":    hexutil.Bytes(h.Beacon.ParentRoot),
+			"state_root":     hexutil.Bytes(h.Beacon.StateRoot),
+			"body_root":      hexutil.Bytes(h.Beacon.BodyRoot),
+		},
+		"execution":        execution,
+		"execution_branch": BeaconAPIBytesList(h.ExecutionBranch),
+	}
+}
+
+// BeaconAPISyncCommittee returns a sync committee in the JSON format of the beacon node API
+func BeaconAPISyncCommittee(c types.SyncCommittee) map[string]interface{} {
+	return map[string]interface{}{
+		"pubkeys":          BeaconAPIBytesList(c.Pubkeys),
+		"aggregate_pubkey": hexutil.Bytes(c.AggregatePubkey),
+	}
+}
+
+// BeaconAPILightClientBootstrap returns a light client bootstrap in the JSON format of the beacon node API
+func BeaconAPILightClientBootstrap(b types.LightClientBootstrap) map[string]interface{} {
+	return map[string]interface{}{
+		"header":                        BeaconAPILightClientHeader(b.Header),
+		"current_sync_committee":        BeaconAPISyncCommittee(b.CurrentSyncCommittee),
+		"current_sync_committee_branch": BeaconAPIBytesList(b.CurrentSyncCommitteeBranch),
+	}
+}
+
+// BeaconAPILightClientUpdate returns a light client update in the JSON format of the beacon node API, a finality update
+// if the update has no next sync committee
+func BeaconAPILightClientUpdate(u types.LightClientUpdate) map[string]interface{} {
+	res := map[string]interface{}{
+		"attested_header":  BeaconAPILightClientHeader(u.AttestedHeader),
+		"finalized_header": BeaconAPILightClientHeader(u.FinalizedHeader),
+		"finality_branch":  BeaconAPIBytesList(u.FinalityBranch),
+		"sync_aggregate": map[string]interface{}{
+			"sync_committee_bits":      hexutil.Bytes(u.SyncAggregate.SyncCommitteeBits),
+			"sync_committee_signature": hexutil.Bytes(u.SyncAggregate.SyncCommitteeSignature),
+		},
+		"signature_slot": strconv.FormatUint(u.SignatureSlot, 10),
+	}
+	if u.NextSyncCommittee != nil {
+		res["next_sync_committee"] = BeaconAPISyncCommittee(*u.NextSyncCommittee)
+		res["next_sync_committee_branch"] = BeaconAPIBytesList(u.NextSyncCommitteeBranch)
+	}
+	return res
+}
+
+// BeaconAPIBytesList returns a list of bytes in the JSON format of the beacon node API
+func BeaconAPIBytesList(list [][]byte) []hexutil.Bytes {
+	res := make([]hexutil.Bytes, len(list))
+	for i, b := range list {
+		res[i] = b
+	}
+	return res
+}
@@ -1,6 +1,7 @@
 package sample
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -19,6 +20,28 @@ func OutTxTracker(t *testing.T, index string) types.OutTxTracker {
 	}
 }
 
+func OutTxNonceGap(t *testing.T, index string) types.OutTxNonceGap {
+	r := newRandFromStringSeed(t, index)
+
+	chainID := r.Int63()
+	nonce := r.Uint64()
+	return types.OutTxNonceGap{
+		Index:     fmt.Sprintf("%d-%d", chainID, nonce),
+		ChainId:   chainID,
+		Nonce:     nonce,
+		CctxIndex: index,
+		Reports: []types.OutTxNonceGapReport{
+			{
+				Reporter:    AccAddress(),
+				TssNonce:    nonce,
+				Cause:       types.OutTxNonceGapCause_NotMined,
+				Action:      types.OutTxNonceGapAction_Reprioritized,
+				BlockHeight: r.Int63(),
+			},
+		},
+	}
+}
+
 func GasPrice(t *testing.T, index string) *types.GasPrice {
 	r := newRandFromStringSeed(t, index)
 
@@ -0,0 +1,218 @@
+package sample
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// LightClientFixture generates beacon chain light client headers and updates signed by generated sync committees
+type LightClientFixture struct {
+	Config     *beacon.Config
+	keys       map[uint64][]*big.Int
+	committees map[uint64]types.SyncCommittee
+}
+
+func NewLightClientFixture(config *beacon.Config) *LightClientFixture {
+	return &LightClientFixture{
+		Config:     config,
+		keys:       make(map[uint64][]*big.Int),
+		committees: make(map[uint64]types.SyncCommittee),
+	}
+}
+
+// SyncCommittee returns the sync committee of a period, the secret keys of the members are derived from the period
+func (f *LightClientFixture) SyncCommittee(period uint64) types.SyncCommittee {
+	if committee, found := f.committees[period]; found {
+		return committee
+	}
+	keys := make([]*big.Int, beacon.SyncCommitteeSize)
+	pubkeys := make([][]byte, beacon.SyncCommitteeSize)
+	aggregateKey := new(big.Int)
+	for i := range keys {
+		keys[i] = new(big.Int).SetUint64(period*beacon.SyncCommitteeSize + uint64(i) + 1)
+		pubkeys[i] = beacon.PublicKey(keys[i])
+		aggregateKey.Add(aggregateKey, keys[i])
+	}
+	committee := types.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: beacon.PublicKey(aggregateKey),
+	}
+	f.keys[period] = keys
+	f.committees[period] = committee
+	return committee
+}
+
+// Header returns a light client header of the slot with its execution block header proven against the block body
+func (f *LightClientFixture) Header(t *testing.T, slot uint64, blockNumber uint64, blockHash []byte) types.LightClientHeader {
+	execution := types.ExecutionPayloadHeader{
+		ParentHash:       fixtureRoot("parent hash", blockNumber),
+		FeeRecipient:     fixtureRoot("fee recipient", blockNumber)[:20],
+		StateRoot:        fixtureRoot("state root", blockNumber),
+		ReceiptsRoot:     fixtureRoot("receipts root", blockNumber),
+		LogsBloom:        make([]byte, 256),
+		PrevRandao:       fixtureRoot("prev randao", blockNumber),
+		BlockNumber:      blockNumber,
+		GasLimit:         30_000_000,
+		GasUsed:          15_000_000,
+		Timestamp:        1_700_000_000 + slot*12,
+		ExtraData:        []byte("zeta"),
+		BaseFeePerGas:    "1000000000",
+		BlockHash:        blockHash,
+		TransactionsRoot: fixtureRoot("transactions root", blockNumber),
+		WithdrawalsRoot:  fixtureRoot("withdrawals root", blockNumber),
+	}
+	if f.Config.IsDeneb(slot) {
+		execution.BlobGasUsed = 131072
+		execution.ExcessBlobGas = 262144
+	}
+	executionRoot, err := execution.HashTreeRoot(f.Config.IsDeneb(slot))
+	require.NoError(t, err)
+
+	body := merkleTree{beacon.ExecutionPayloadGIndex: executionRoot}
+	bodyRoot := body.root()
+	return types.LightClientHeader{
+		Beacon: types.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: slot % 1000,
+			ParentRoot:    fixtureRoot("parent root", slot),
+			StateRoot:     fixtureRoot("state root", slot),
+			BodyRoot:      bodyRoot[:],
+		},
+		Execution:       execution,
+		ExecutionBranch: body.branch(beacon.ExecutionPayloadGIndex),
+	}
+}
+
+// Bootstrap returns a light client bootstrap of a header with the sync committee of its period
+func (f *LightClientFixture) Bootstrap(t *testing.T, header types.LightClientHeader) types.LightClientBootstrap {
+	committee := f.SyncCommittee(beacon.SyncCommitteePeriodAtSlot(header.Beacon.Slot))
+	committeeRoot, err := committee.HashTreeRoot()
+	require.NoError(t, err)
+
+	gindex := f.Config.CurrentSyncCommitteeGIndexAtSlot(header.Beacon.Slot)
+	state := merkleTree{gindex: committeeRoot}
+	stateRoot := state.root()
+	header.Beacon.StateRoot = stateRoot[:]
+	return types.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: state.branch(gindex),
+	}
+}
+
+// Update returns a light client update finalizing a header, attested at the slot and signed at the next slot by the
+// first participants of the sync committee of the period of the signature slot
+// The next sync committee of the period of the attested slot is included if withNextSyncCommittee is true
+func (f *LightClientFixture) Update(
+	t *testing.T,
+	attestedSlot uint64,
+	finalizedHeader types.LightClientHeader,
+	withNextSyncCommittee bool,
+	participants int,
+) types.LightClientUpdate {
+	finalizedRoot, err := finalizedHeader.Beacon.HashTreeRoot()
+	require.NoError(t, err)
+	finalityGIndex := f.Config.FinalizedRootGIndexAtSlot(attestedSlot)
+	state := merkleTree{finalityGIndex: finalizedRoot}
+
+	var nextSyncCommittee *types.SyncCommittee
+	nextSyncCommitteeGIndex := f.Config.NextSyncCommitteeGIndexAtSlot(attestedSlot)
+	if withNextSyncCommittee {
+		committee := f.SyncCommittee(beacon.SyncCommitteePeriodAtSlot(attestedSlot) + 1)
+		committeeRoot, err := committee.HashTreeRoot()
+		require.NoError(t, err)
+		state[nextSyncCommitteeGIndex] = committeeRoot
+		nextSyncCommittee = &committee
+	}
+
+	attestedHeader := f.Header(t, attestedSlot, finalizedHeader.Execution.BlockNumber+attestedSlot-finalizedHeader.Beacon.Slot, fixtureRoot("block hash", attestedSlot))
+	stateRoot := state.root()
+	attestedHeader.Beacon.StateRoot = stateRoot[:]
+
+	update := types.LightClientUpdate{
+		AttestedHeader:  attestedHeader,
+		FinalizedHeader: finalizedHeader,
+		FinalityBranch:  state.branch(finalityGIndex),
+		SignatureSlot:   attestedSlot + 1,
+	}
+	if nextSyncCommittee != nil {
+		update.NextSyncCommittee = nextSyncCommittee
+		update.NextSyncCommitteeBranch = state.branch(nextSyncCommitteeGIndex)
+	}
+	update.SyncAggregate = f.SyncAggregate(t, attestedHeader.Beacon, update.SignatureSlot, participants)
+	return update
+}
+
+// SyncAggregate returns the signature of the beacon block header by the first participants of the sync committee of
+// the period of the signature slot
+func (f *LightClientFixture) SyncAggregate(t *testing.T, header types.BeaconBlockHeader, signatureSlot uint64, participants int) types.SyncAggregate {
+	period := beacon.SyncCommitteePeriodAtSlot(signatureSlot)
+	f.SyncCommittee(period)
+	bits := make([]byte, beacon.SyncCommitteeSize/8)
+	aggregateKey := new(big.Int)
+	for i := 0; i < participants; i++ {
+		bits[i/8] |= 1 << (i % 8)
+		aggregateKey.Add(aggregateKey, f.keys[period][i])
+	}
+
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot := beacon.ComputeSigningRoot(root, f.Config.SyncCommitteeDomain(signatureSlot))
+	signature, err := beacon.Sign(aggregateKey, signingRoot[:])
+	require.NoError(t, err)
+	return types.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: signature,
+	}
+}
+
+// fixtureRoot returns a root derived from a name and a number
+func fixtureRoot(name string, n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	root := sha256.Sum256(append([]byte(name), b...))
+	return root[:]
+}
+
+// merkleTree is a sparse Merkle tree from its nodes at generalized indices, the other leaves are zero
+type merkleTree map[beacon.GeneralizedIndex]beacon.Root
+
+func (m merkleTree) depth() int {
+	depth := 0
+	for gindex := range m {
+		if gindex.Depth() > depth {
+			depth = gindex.Depth()
+		}
+	}
+	return depth
+}
+
+func (m merkleTree) node(gindex beacon.GeneralizedIndex, depth int) beacon.Root {
+	if root, found := m[gindex]; found {
+		return root
+	}
+	if gindex.Depth() >= depth {
+		return beacon.Root{}
+	}
+	return beacon.HashPair(m.node(2*gindex, depth), m.node(2*gindex+1, depth))
+}
+
+func (m merkleTree) root() beacon.Root {
+	return m.node(1, m.depth())
+}
+
+func (m merkleTree) branch(gindex beacon.GeneralizedIndex) [][]byte {
+	depth := m.depth()
+	var branch [][]byte
+	for g := gindex; g > 1; g >>= 1 {
+		sibling := m.node(g^1, depth)
+		branch = append(branch, sibling[:])
+	}
+	return branch
+}
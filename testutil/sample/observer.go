@@ -66,6 +66,62 @@ func Keygen(t *testing.T) *types.Keygen {
 	}
 }
 
+func KeygenAttempt(t *testing.T, index uint64) types.KeygenAttempt {
+	pubKey := ed25519.GenPrivKey().PubKey().String()
+	r := newRandFromStringSeed(t, pubKey)
+
+	return types.KeygenAttempt{
+		Index:          index,
+		BlockNumber:    r.Int63(),
+		GranteePubkeys: []string{pubKey},
+		Status:         types.KeygenAttemptStatus_AttemptFailed,
+		Reports: []types.KeygenGranteeReport{
+			{
+				GranteePubkey:  pubKey,
+				Operator:       AccAddress(),
+				Outcome:        types.KeygenOutcome_ReportedFailure,
+				ReportedHeight: r.Int63(),
+			},
+		},
+		BlamedPubkeys:      []string{pubKey},
+		CreatedHeight:      r.Int63(),
+		FinalizedHeight:    r.Int63(),
+		ProposedRetryBlock: r.Int63(),
+	}
+}
+
+func KeygenAttemptList(t *testing.T, n int) []types.KeygenAttempt {
+	list := make([]types.KeygenAttempt, n)
+	for i := 0; i < n; i++ {
+		list[i] = KeygenAttempt(t, uint64(i+1))
+	}
+	return list
+}
+
+func ObserverLiveness(t *testing.T, index string) types.ObserverLiveness {
+	r := newRandFromStringSeed(t, index)
+
+	return types.ObserverLiveness{
+		ObserverAddress:    AccAddress(),
+		ChainId:            r.Int63(),
+		WindowSize:         100,
+		IndexOffset:        r.Uint64(),
+		MissedVotesCounter: 1,
+		MissedVotes:        []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		Jailed:             r.Intn(2) == 1,
+		JailedHeight:       r.Int63(),
+		JailedUntil:        r.Int63(),
+	}
+}
+
+func ObserverLivenessList(t *testing.T, n int) []types.ObserverLiveness {
+	list := make([]types.ObserverLiveness, n)
+	for i := 0; i < n; i++ {
+		list[i] = ObserverLiveness(t, fmt.Sprintf("%d", i))
+	}
+	return list
+}
+
 func LastObserverCount(lastChangeHeight int64) *types.LastObserverCount {
 	r := newRandFromSeed(lastChangeHeight)
 
@@ -114,6 +114,11 @@ export declare class InboundTxParams extends Message<InboundTxParams> {
    */
   inboundTxFinalizedZetaHeight: bigint;
 
+  /**
+   * @generated from field: bool inbound_tx_proven = 11;
+   */
+  inboundTxProven: boolean;
+
   constructor(data?: PartialMessage<InboundTxParams>);
 
   static readonly runtime: typeof proto3;
@@ -194,6 +199,13 @@ export declare class OutboundTxParams extends Message<OutboundTxParams> {
    */
   outboundTxGasPrice: string;
 
+  /**
+   * priority fee of the EIP-1559 outbound tx, empty for a legacy outbound tx
+   *
+   * @generated from field: string outbound_tx_gas_priority_fee = 23;
+   */
+  outboundTxGasPriorityFee: string;
+
   /**
    * the above are commands for zetaclients
    * the following fields are used when the outbound tx is mined
@@ -291,3 +291,67 @@ export declare class EventOutboundSuccess extends Message<EventOutboundSuccess>
   static equals(a: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined, b: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventOutTxNonceGapReported
+ */
+export declare class EventOutTxNonceGapReported extends Message<EventOutTxNonceGapReported> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: string chain_id = 3;
+   */
+  chainId: string;
+
+  /**
+   * @generated from field: string nonce = 4;
+   */
+  nonce: string;
+
+  /**
+   * @generated from field: string tss_nonce = 5;
+   */
+  tssNonce: string;
+
+  /**
+   * @generated from field: string cause = 6;
+   */
+  cause: string;
+
+  /**
+   * @generated from field: string action = 7;
+   */
+  action: string;
+
+  /**
+   * @generated from field: string cancel_tx_hash = 8;
+   */
+  cancelTxHash: string;
+
+  /**
+   * @generated from field: string reporter = 9;
+   */
+  reporter: string;
+
+  constructor(data?: PartialMessage<EventOutTxNonceGapReported>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventOutTxNonceGapReported";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventOutTxNonceGapReported;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventOutTxNonceGapReported;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventOutTxNonceGapReported;
+
+  static equals(a: EventOutTxNonceGapReported | PlainMessage<EventOutTxNonceGapReported> | undefined, b: EventOutTxNonceGapReported | PlainMessage<EventOutTxNonceGapReported> | undefined): boolean;
+}
+
@@ -45,6 +45,13 @@ export declare class GasPrice extends Message<GasPrice> {
    */
   medianIndex: bigint;
 
+  /**
+   * priority fees of the signers, only set for the chains with EIP-1559 fees
+   *
+   * @generated from field: repeated uint64 priority_fees = 8;
+   */
+  priorityFees: bigint[];
+
   constructor(data?: PartialMessage<GasPrice>);
 
   static readonly runtime: typeof proto3;
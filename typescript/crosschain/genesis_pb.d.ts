@@ -6,7 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Params } from "./params_pb.js";
-import type { OutTxTracker } from "./out_tx_tracker_pb.js";
+import type { OutTxNonceGap, OutTxTracker } from "./out_tx_tracker_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { CrossChainTx, ZetaAccounting } from "./cross_chain_tx_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
@@ -59,6 +59,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   zetaAccounting?: ZetaAccounting;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.OutTxNonceGap out_tx_nonce_gap_list = 13;
+   */
+  outTxNonceGapList: OutTxNonceGap[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
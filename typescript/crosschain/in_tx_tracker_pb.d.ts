@@ -26,6 +26,11 @@ export declare class InTxTracker extends Message<InTxTracker> {
    */
   coinType: CoinType;
 
+  /**
+   * @generated from field: string block_hash = 4;
+   */
+  blockHash: string;
+
   constructor(data?: PartialMessage<InTxTracker>);
 
   static readonly runtime: typeof proto3;
@@ -6,6 +6,48 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * OutTxNonceGapCause is the cause of a nonce gap blocking the outbound txs of a chain
+ *
+ * @generated from enum zetachain.zetacore.crosschain.OutTxNonceGapCause
+ */
+export declare enum OutTxNonceGapCause {
+  /**
+   * the outTx tracker has no tx for the nonce
+   *
+   * @generated from enum value: NotBroadcast = 0;
+   */
+  NotBroadcast = 0,
+
+  /**
+   * the txs of the nonce in the outTx tracker are not mined
+   *
+   * @generated from enum value: NotMined = 1;
+   */
+  NotMined = 1,
+}
+
+/**
+ * OutTxNonceGapAction is the action taken by an observer to fill a nonce gap
+ *
+ * @generated from enum zetachain.zetacore.crosschain.OutTxNonceGapAction
+ */
+export declare enum OutTxNonceGapAction {
+  /**
+   * the keysign of the outbound tx of the nonce is retried first
+   *
+   * @generated from enum value: Reprioritized = 0;
+   */
+  Reprioritized = 0,
+
+  /**
+   * a cancel tx is broadcast for the nonce
+   *
+   * @generated from enum value: Cancelled = 1;
+   */
+  Cancelled = 1,
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.TxHashList
  */
@@ -25,6 +67,11 @@ export declare class TxHashList extends Message<TxHashList> {
    */
   proved: boolean;
 
+  /**
+   * @generated from field: string block_hash = 4;
+   */
+  blockHash: string;
+
   constructor(data?: PartialMessage<TxHashList>);
 
   static readonly runtime: typeof proto3;
@@ -81,3 +128,108 @@ export declare class OutTxTracker extends Message<OutTxTracker> {
   static equals(a: OutTxTracker | PlainMessage<OutTxTracker> | undefined, b: OutTxTracker | PlainMessage<OutTxTracker> | undefined): boolean;
 }
 
+
+/**
+ * OutTxNonceGapReport is the action taken by an observer on a nonce gap
+ *
+ * @generated from message zetachain.zetacore.crosschain.OutTxNonceGapReport
+ */
+export declare class OutTxNonceGapReport extends Message<OutTxNonceGapReport> {
+  /**
+   * @generated from field: string reporter = 1;
+   */
+  reporter: string;
+
+  /**
+   * nonce of the TSS account on the chain
+   *
+   * @generated from field: uint64 tss_nonce = 2;
+   */
+  tssNonce: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.OutTxNonceGapCause cause = 3;
+   */
+  cause: OutTxNonceGapCause;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.OutTxNonceGapAction action = 4;
+   */
+  action: OutTxNonceGapAction;
+
+  /**
+   * hash of the cancel tx, empty unless cancelled
+   *
+   * @generated from field: string cancel_tx_hash = 5;
+   */
+  cancelTxHash: string;
+
+  /**
+   * zeta height of the report
+   *
+   * @generated from field: int64 block_height = 6;
+   */
+  blockHeight: bigint;
+
+  constructor(data?: PartialMessage<OutTxNonceGapReport>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.OutTxNonceGapReport";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutTxNonceGapReport;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutTxNonceGapReport;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutTxNonceGapReport;
+
+  static equals(a: OutTxNonceGapReport | PlainMessage<OutTxNonceGapReport> | undefined, b: OutTxNonceGapReport | PlainMessage<OutTxNonceGapReport> | undefined): boolean;
+}
+
+/**
+ * OutTxNonceGap is the list of the reports of the observers on a nonce blocking the outbound txs of a chain
+ *
+ * @generated from message zetachain.zetacore.crosschain.OutTxNonceGap
+ */
+export declare class OutTxNonceGap extends Message<OutTxNonceGap> {
+  /**
+   * format: "chain-nonce"
+   *
+   * @generated from field: string index = 1;
+   */
+  index: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 nonce = 3;
+   */
+  nonce: bigint;
+
+  /**
+   * @generated from field: string cctx_index = 4;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.OutTxNonceGapReport reports = 5;
+   */
+  reports: OutTxNonceGapReport[];
+
+  constructor(data?: PartialMessage<OutTxNonceGap>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.OutTxNonceGap";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutTxNonceGap;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutTxNonceGap;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutTxNonceGap;
+
+  static equals(a: OutTxNonceGap | PlainMessage<OutTxNonceGap> | undefined, b: OutTxNonceGap | PlainMessage<OutTxNonceGap> | undefined): boolean;
+}
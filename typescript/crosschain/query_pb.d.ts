@@ -6,7 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Params } from "./params_pb.js";
-import type { OutTxTracker } from "./out_tx_tracker_pb.js";
+import type { OutTxNonceGap, OutTxTracker } from "./out_tx_tracker_pb.js";
 import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { InTxTracker } from "./in_tx_tracker_pb.js";
 import type { InTxHashToCctx } from "./in_tx_hash_to_cctx_pb.js";
@@ -270,6 +270,59 @@ export declare class QueryAllOutTxTrackerByChainResponse extends Message<QueryAl
   static equals(a: QueryAllOutTxTrackerByChainResponse | PlainMessage<QueryAllOutTxTrackerByChainResponse> | undefined, b: QueryAllOutTxTrackerByChainResponse | PlainMessage<QueryAllOutTxTrackerByChainResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryGetOutTxNonceGapRequest
+ */
+export declare class QueryGetOutTxNonceGapRequest extends Message<QueryGetOutTxNonceGapRequest> {
+  /**
+   * @generated from field: int64 chainID = 1;
+   */
+  chainID: bigint;
+
+  /**
+   * @generated from field: uint64 nonce = 2;
+   */
+  nonce: bigint;
+
+  constructor(data?: PartialMessage<QueryGetOutTxNonceGapRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryGetOutTxNonceGapRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetOutTxNonceGapRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetOutTxNonceGapRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetOutTxNonceGapRequest;
+
+  static equals(a: QueryGetOutTxNonceGapRequest | PlainMessage<QueryGetOutTxNonceGapRequest> | undefined, b: QueryGetOutTxNonceGapRequest | PlainMessage<QueryGetOutTxNonceGapRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryGetOutTxNonceGapResponse
+ */
+export declare class QueryGetOutTxNonceGapResponse extends Message<QueryGetOutTxNonceGapResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.OutTxNonceGap outTxNonceGap = 1;
+   */
+  outTxNonceGap?: OutTxNonceGap;
+
+  constructor(data?: PartialMessage<QueryGetOutTxNonceGapResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryGetOutTxNonceGapResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetOutTxNonceGapResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetOutTxNonceGapResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetOutTxNonceGapResponse;
+
+  static equals(a: QueryGetOutTxNonceGapResponse | PlainMessage<QueryGetOutTxNonceGapResponse> | undefined, b: QueryGetOutTxNonceGapResponse | PlainMessage<QueryGetOutTxNonceGapResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest
  */
@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { CoinType, Proof, ReceiveStatus } from "../common/common_pb.js";
+import type { OutTxNonceGapAction, OutTxNonceGapCause } from "./out_tx_tracker_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgCreateTSSVoter
@@ -239,6 +240,93 @@ export declare class MsgAddToInTxTrackerResponse extends Message<MsgAddToInTxTra
   static equals(a: MsgAddToInTxTrackerResponse | PlainMessage<MsgAddToInTxTrackerResponse> | undefined, b: MsgAddToInTxTrackerResponse | PlainMessage<MsgAddToInTxTrackerResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgAddProvenInboundTx
+ */
+export declare class MsgAddProvenInboundTx extends Message<MsgAddProvenInboundTx> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string tx_hash = 3;
+   */
+  txHash: string;
+
+  /**
+   * @generated from field: common.Proof proof = 4;
+   */
+  proof?: Proof;
+
+  /**
+   * @generated from field: string block_hash = 5;
+   */
+  blockHash: string;
+
+  /**
+   * @generated from field: int64 tx_index = 6;
+   */
+  txIndex: bigint;
+
+  /**
+   * proof of the receipt of the tx against the block header, only for the EVM chains
+   *
+   * @generated from field: common.Proof receipt_proof = 7;
+   */
+  receiptProof?: Proof;
+
+  /**
+   * index in the block of the first log of the receipt, only for the EVM chains
+   *
+   * @generated from field: uint64 first_log_index = 8;
+   */
+  firstLogIndex: bigint;
+
+  constructor(data?: PartialMessage<MsgAddProvenInboundTx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgAddProvenInboundTx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAddProvenInboundTx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAddProvenInboundTx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAddProvenInboundTx;
+
+  static equals(a: MsgAddProvenInboundTx | PlainMessage<MsgAddProvenInboundTx> | undefined, b: MsgAddProvenInboundTx | PlainMessage<MsgAddProvenInboundTx> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgAddProvenInboundTxResponse
+ */
+export declare class MsgAddProvenInboundTxResponse extends Message<MsgAddProvenInboundTxResponse> {
+  /**
+   * @generated from field: repeated string cctx_indexes = 1;
+   */
+  cctxIndexes: string[];
+
+  constructor(data?: PartialMessage<MsgAddProvenInboundTxResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgAddProvenInboundTxResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAddProvenInboundTxResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAddProvenInboundTxResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAddProvenInboundTxResponse;
+
+  static equals(a: MsgAddProvenInboundTxResponse | PlainMessage<MsgAddProvenInboundTxResponse> | undefined, b: MsgAddProvenInboundTxResponse | PlainMessage<MsgAddProvenInboundTxResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgWhitelistERC20
  */
@@ -455,6 +543,83 @@ export declare class MsgRemoveFromOutTxTrackerResponse extends Message<MsgRemove
   static equals(a: MsgRemoveFromOutTxTrackerResponse | PlainMessage<MsgRemoveFromOutTxTrackerResponse> | undefined, b: MsgRemoveFromOutTxTrackerResponse | PlainMessage<MsgRemoveFromOutTxTrackerResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgReportOutTxNonceGap
+ */
+export declare class MsgReportOutTxNonceGap extends Message<MsgReportOutTxNonceGap> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 nonce = 3;
+   */
+  nonce: bigint;
+
+  /**
+   * nonce of the TSS account on the chain
+   *
+   * @generated from field: uint64 tss_nonce = 4;
+   */
+  tssNonce: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.OutTxNonceGapCause cause = 5;
+   */
+  cause: OutTxNonceGapCause;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.OutTxNonceGapAction action = 6;
+   */
+  action: OutTxNonceGapAction;
+
+  /**
+   * hash of the cancel tx, empty unless cancelled
+   *
+   * @generated from field: string cancel_tx_hash = 7;
+   */
+  cancelTxHash: string;
+
+  constructor(data?: PartialMessage<MsgReportOutTxNonceGap>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReportOutTxNonceGap";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReportOutTxNonceGap;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReportOutTxNonceGap;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReportOutTxNonceGap;
+
+  static equals(a: MsgReportOutTxNonceGap | PlainMessage<MsgReportOutTxNonceGap> | undefined, b: MsgReportOutTxNonceGap | PlainMessage<MsgReportOutTxNonceGap> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgReportOutTxNonceGapResponse
+ */
+export declare class MsgReportOutTxNonceGapResponse extends Message<MsgReportOutTxNonceGapResponse> {
+  constructor(data?: PartialMessage<MsgReportOutTxNonceGapResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgReportOutTxNonceGapResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgReportOutTxNonceGapResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgReportOutTxNonceGapResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgReportOutTxNonceGapResponse;
+
+  static equals(a: MsgReportOutTxNonceGapResponse | PlainMessage<MsgReportOutTxNonceGapResponse> | undefined, b: MsgReportOutTxNonceGapResponse | PlainMessage<MsgReportOutTxNonceGapResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgGasPriceVoter
  */
@@ -484,6 +649,11 @@ export declare class MsgGasPriceVoter extends Message<MsgGasPriceVoter> {
    */
   supply: string;
 
+  /**
+   * @generated from field: uint64 priority_fee = 6;
+   */
+  priorityFee: bigint;
+
   constructor(data?: PartialMessage<MsgGasPriceVoter>);
 
   static readonly runtime: typeof proto3;
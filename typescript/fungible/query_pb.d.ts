@@ -371,6 +371,78 @@ export declare class QueryAllGasStabilityPoolBalanceResponse_Balance extends Mes
   static equals(a: QueryAllGasStabilityPoolBalanceResponse_Balance | PlainMessage<QueryAllGasStabilityPoolBalanceResponse_Balance> | undefined, b: QueryAllGasStabilityPoolBalanceResponse_Balance | PlainMessage<QueryAllGasStabilityPoolBalanceResponse_Balance> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryAllZRC20SupplyRequest
+ */
+export declare class QueryAllZRC20SupplyRequest extends Message<QueryAllZRC20SupplyRequest> {
+  constructor(data?: PartialMessage<QueryAllZRC20SupplyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryAllZRC20SupplyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllZRC20SupplyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyRequest;
+
+  static equals(a: QueryAllZRC20SupplyRequest | PlainMessage<QueryAllZRC20SupplyRequest> | undefined, b: QueryAllZRC20SupplyRequest | PlainMessage<QueryAllZRC20SupplyRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse
+ */
+export declare class QueryAllZRC20SupplyResponse extends Message<QueryAllZRC20SupplyResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse.Supply supplies = 1;
+   */
+  supplies: QueryAllZRC20SupplyResponse_Supply[];
+
+  constructor(data?: PartialMessage<QueryAllZRC20SupplyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllZRC20SupplyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyResponse;
+
+  static equals(a: QueryAllZRC20SupplyResponse | PlainMessage<QueryAllZRC20SupplyResponse> | undefined, b: QueryAllZRC20SupplyResponse | PlainMessage<QueryAllZRC20SupplyResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse.Supply
+ */
+export declare class QueryAllZRC20SupplyResponse_Supply extends Message<QueryAllZRC20SupplyResponse_Supply> {
+  /**
+   * @generated from field: zetachain.zetacore.fungible.ForeignCoins foreign_coin = 1;
+   */
+  foreignCoin?: ForeignCoins;
+
+  /**
+   * @generated from field: string total_supply = 2;
+   */
+  totalSupply: string;
+
+  constructor(data?: PartialMessage<QueryAllZRC20SupplyResponse_Supply>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse.Supply";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllZRC20SupplyResponse_Supply;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyResponse_Supply;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllZRC20SupplyResponse_Supply;
+
+  static equals(a: QueryAllZRC20SupplyResponse_Supply | PlainMessage<QueryAllZRC20SupplyResponse_Supply> | undefined, b: QueryAllZRC20SupplyResponse_Supply | PlainMessage<QueryAllZRC20SupplyResponse_Supply> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.fungible.QueryCodeHashRequest
  */
@@ -93,6 +93,13 @@ export declare class Ballot extends Message<Ballot> {
    */
   ballotCreationHeight: bigint;
 
+  /**
+   * chain of the observation, 0 if the ballot is not related to a chain
+   *
+   * @generated from field: int64 chain_id = 9;
+   */
+  chainId: bigint;
+
   constructor(data?: PartialMessage<Ballot>);
 
   static readonly runtime: typeof proto3;
@@ -30,6 +30,13 @@ export declare class BlockHeaderState extends Message<BlockHeaderState> {
    */
   latestBlockHash: Uint8Array;
 
+  /**
+   * the block headers below this height are pruned, except the ones referenced by the trackers
+   *
+   * @generated from field: int64 pruned_height = 5;
+   */
+  prunedHeight: bigint;
+
   constructor(data?: PartialMessage<BlockHeaderState>);
 
   static readonly runtime: typeof proto3;
@@ -45,3 +52,69 @@ export declare class BlockHeaderState extends Message<BlockHeaderState> {
   static equals(a: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined, b: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined): boolean;
 }
 
+/**
+ * HeightRange is an inclusive range of block heights
+ *
+ * @generated from message zetachain.zetacore.observer.HeightRange
+ */
+export declare class HeightRange extends Message<HeightRange> {
+  /**
+   * @generated from field: int64 start_height = 1;
+   */
+  startHeight: bigint;
+
+  /**
+   * @generated from field: int64 end_height = 2;
+   */
+  endHeight: bigint;
+
+  constructor(data?: PartialMessage<HeightRange>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.HeightRange";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HeightRange;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HeightRange;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HeightRange;
+
+  static equals(a: HeightRange | PlainMessage<HeightRange> | undefined, b: HeightRange | PlainMessage<HeightRange> | undefined): boolean;
+}
+
+/**
+ * BlockChainWork is the cumulative proof-of-work of the Bitcoin header chain ending at a block header
+ *
+ * @generated from message zetachain.zetacore.observer.BlockChainWork
+ */
+export declare class BlockChainWork extends Message<BlockChainWork> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bytes block_hash = 2;
+   */
+  blockHash: Uint8Array;
+
+  /**
+   * @generated from field: string chain_work = 3;
+   */
+  chainWork: string;
+
+  constructor(data?: PartialMessage<BlockChainWork>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.BlockChainWork";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockChainWork;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockChainWork;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockChainWork;
+
+  static equals(a: BlockChainWork | PlainMessage<BlockChainWork> | undefined, b: BlockChainWork | PlainMessage<BlockChainWork> | undefined): boolean;
+}
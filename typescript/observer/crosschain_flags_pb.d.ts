@@ -69,6 +69,13 @@ export declare class BlockHeaderVerificationFlags extends Message<BlockHeaderVer
    */
   isBtcTypeChainEnabled: boolean;
 
+  /**
+   * chains whose inbound txs proven against a block header create a cctx without observer ballot
+   *
+   * @generated from field: repeated int64 permissionlessInboundChainIds = 3;
+   */
+  permissionlessInboundChainIds: bigint[];
+
   constructor(data?: PartialMessage<BlockHeaderVerificationFlags>);
 
   static readonly runtime: typeof proto3;
@@ -84,6 +91,49 @@ export declare class BlockHeaderVerificationFlags extends Message<BlockHeaderVer
   static equals(a: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined, b: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined): boolean;
 }
 
+/**
+ * OutboundPause is the pause of the outbound of a chain, the observers don't schedule the outbound txs of a paused chain
+ *
+ * @generated from message zetachain.zetacore.observer.OutboundPause
+ */
+export declare class OutboundPause extends Message<OutboundPause> {
+  /**
+   * @generated from field: int64 chainId = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * admin policy account or observer that paused the outbound
+   *
+   * @generated from field: string creator = 3;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 blockHeight = 4;
+   */
+  blockHeight: bigint;
+
+  constructor(data?: PartialMessage<OutboundPause>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.OutboundPause";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutboundPause;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutboundPause;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutboundPause;
+
+  static equals(a: OutboundPause | PlainMessage<OutboundPause> | undefined, b: OutboundPause | PlainMessage<OutboundPause> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.CrosschainFlags
  */
@@ -108,6 +158,11 @@ export declare class CrosschainFlags extends Message<CrosschainFlags> {
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.OutboundPause outboundPauses = 5;
+   */
+  outboundPauses: OutboundPause[];
+
   constructor(data?: PartialMessage<CrosschainFlags>);
 
   static readonly runtime: typeof proto3;
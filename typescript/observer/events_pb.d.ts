@@ -178,3 +178,207 @@ export declare class EventCrosschainFlagsUpdated extends Message<EventCrosschain
   static equals(a: EventCrosschainFlagsUpdated | PlainMessage<EventCrosschainFlagsUpdated> | undefined, b: EventCrosschainFlagsUpdated | PlainMessage<EventCrosschainFlagsUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventKeygenAttemptFinalized
+ */
+export declare class EventKeygenAttemptFinalized extends Message<EventKeygenAttemptFinalized> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: uint64 attempt_index = 2;
+   */
+  attemptIndex: bigint;
+
+  /**
+   * @generated from field: string keygen_block = 3;
+   */
+  keygenBlock: string;
+
+  /**
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string blamed_pubkeys = 5;
+   */
+  blamedPubkeys: string;
+
+  /**
+   * @generated from field: string proposed_retry_block = 6;
+   */
+  proposedRetryBlock: string;
+
+  constructor(data?: PartialMessage<EventKeygenAttemptFinalized>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventKeygenAttemptFinalized";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventKeygenAttemptFinalized;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventKeygenAttemptFinalized;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventKeygenAttemptFinalized;
+
+  static equals(a: EventKeygenAttemptFinalized | PlainMessage<EventKeygenAttemptFinalized> | undefined, b: EventKeygenAttemptFinalized | PlainMessage<EventKeygenAttemptFinalized> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverMissedVotes
+ */
+export declare class EventObserverMissedVotes extends Message<EventObserverMissedVotes> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 missed_votes = 3;
+   */
+  missedVotes: bigint;
+
+  /**
+   * @generated from field: uint64 window_size = 4;
+   */
+  windowSize: bigint;
+
+  constructor(data?: PartialMessage<EventObserverMissedVotes>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverMissedVotes";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverMissedVotes;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverMissedVotes;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverMissedVotes;
+
+  static equals(a: EventObserverMissedVotes | PlainMessage<EventObserverMissedVotes> | undefined, b: EventObserverMissedVotes | PlainMessage<EventObserverMissedVotes> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export declare class EventObserverJailed extends Message<EventObserverJailed> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 missed_votes = 3;
+   */
+  missedVotes: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 4;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<EventObserverJailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverJailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverJailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static equals(a: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined, b: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverUnjailed
+ */
+export declare class EventObserverUnjailed extends Message<EventObserverUnjailed> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<EventObserverUnjailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverUnjailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverUnjailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static equals(a: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined, b: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventOutboundPauseUpdated
+ */
+export declare class EventOutboundPauseUpdated extends Message<EventOutboundPauseUpdated> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bool paused = 3;
+   */
+  paused: boolean;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string signer = 5;
+   */
+  signer: string;
+
+  constructor(data?: PartialMessage<EventOutboundPauseUpdated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventOutboundPauseUpdated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventOutboundPauseUpdated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventOutboundPauseUpdated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventOutboundPauseUpdated;
+
+  static equals(a: EventOutboundPauseUpdated | PlainMessage<EventOutboundPauseUpdated> | undefined, b: EventOutboundPauseUpdated | PlainMessage<EventOutboundPauseUpdated> | undefined): boolean;
+}
@@ -10,7 +10,8 @@ import type { LastObserverCount, ObserverMapper } from "./observer_pb.js";
 import type { NodeAccount } from "./node_account_pb.js";
 import type { CrosschainFlags } from "./crosschain_flags_pb.js";
 import type { CoreParamsList, Params } from "./params_pb.js";
-import type { Keygen } from "./keygen_pb.js";
+import type { Keygen, KeygenAttempt } from "./keygen_pb.js";
+import type { LivenessParams, ObserverLiveness } from "./liveness_pb.js";
 import type { TSS } from "./tss_pb.js";
 import type { TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
 import type { Blame } from "./blame_pb.js";
@@ -97,6 +98,21 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   nonceToCctx: NonceToCctx[];
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.KeygenAttempt keygen_attempts = 16;
+   */
+  keygenAttempts: KeygenAttempt[];
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 17;
+   */
+  livenessParams?: LivenessParams;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 18;
+   */
+  observerLiveness: ObserverLiveness[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -26,6 +26,53 @@ export declare enum KeygenStatus {
   KeyGenFailed = 3,
 }
 
+/**
+ * @generated from enum zetachain.zetacore.observer.KeygenAttemptStatus
+ */
+export declare enum KeygenAttemptStatus {
+  /**
+   * @generated from enum value: AttemptPending = 0;
+   */
+  AttemptPending = 0,
+
+  /**
+   * @generated from enum value: AttemptSucceeded = 1;
+   */
+  AttemptSucceeded = 1,
+
+  /**
+   * @generated from enum value: AttemptFailed = 2;
+   */
+  AttemptFailed = 2,
+
+  /**
+   * a new keygen was set before the attempt was finalized
+   *
+   * @generated from enum value: AttemptAborted = 3;
+   */
+  AttemptAborted = 3,
+}
+
+/**
+ * @generated from enum zetachain.zetacore.observer.KeygenOutcome
+ */
+export declare enum KeygenOutcome {
+  /**
+   * @generated from enum value: NotReported = 0;
+   */
+  NotReported = 0,
+
+  /**
+   * @generated from enum value: ReportedSuccess = 1;
+   */
+  ReportedSuccess = 1,
+
+  /**
+   * @generated from enum value: ReportedFailure = 2;
+   */
+  ReportedFailure = 2,
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.Keygen
  */
@@ -64,3 +111,129 @@ export declare class Keygen extends Message<Keygen> {
   static equals(a: Keygen | PlainMessage<Keygen> | undefined, b: Keygen | PlainMessage<Keygen> | undefined): boolean;
 }
 
+/**
+ * KeygenGranteeReport is the outcome of a keygen attempt reported by a grantee
+ *
+ * @generated from message zetachain.zetacore.observer.KeygenGranteeReport
+ */
+export declare class KeygenGranteeReport extends Message<KeygenGranteeReport> {
+  /**
+   * @generated from field: string grantee_pubkey = 1;
+   */
+  granteePubkey: string;
+
+  /**
+   * @generated from field: string operator = 2;
+   */
+  operator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.KeygenOutcome outcome = 3;
+   */
+  outcome: KeygenOutcome;
+
+  /**
+   * @generated from field: string tss_pubkey = 4;
+   */
+  tssPubkey: string;
+
+  /**
+   * @generated from field: int64 reported_height = 5;
+   */
+  reportedHeight: bigint;
+
+  constructor(data?: PartialMessage<KeygenGranteeReport>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.KeygenGranteeReport";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeygenGranteeReport;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeygenGranteeReport;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeygenGranteeReport;
+
+  static equals(a: KeygenGranteeReport | PlainMessage<KeygenGranteeReport> | undefined, b: KeygenGranteeReport | PlainMessage<KeygenGranteeReport> | undefined): boolean;
+}
+
+/**
+ * KeygenAttempt is the record of a keygen ceremony scheduled at a given block
+ *
+ * @generated from message zetachain.zetacore.observer.KeygenAttempt
+ */
+export declare class KeygenAttempt extends Message<KeygenAttempt> {
+  /**
+   * @generated from field: uint64 index = 1;
+   */
+  index: bigint;
+
+  /**
+   * @generated from field: int64 block_number = 2;
+   */
+  blockNumber: bigint;
+
+  /**
+   * @generated from field: repeated string grantee_pubkeys = 3;
+   */
+  granteePubkeys: string[];
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.KeygenAttemptStatus status = 4;
+   */
+  status: KeygenAttemptStatus;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.KeygenGranteeReport reports = 5;
+   */
+  reports: KeygenGranteeReport[];
+
+  /**
+   * @generated from field: repeated string blamed_pubkeys = 6;
+   */
+  blamedPubkeys: string[];
+
+  /**
+   * @generated from field: int64 created_height = 7;
+   */
+  createdHeight: bigint;
+
+  /**
+   * @generated from field: int64 finalized_height = 8;
+   */
+  finalizedHeight: bigint;
+
+  /**
+   * index of the failed attempt retried by this attempt, 0 if none
+   *
+   * @generated from field: uint64 retry_of = 9;
+   */
+  retryOf: bigint;
+
+  /**
+   * block proposed to retry the failed attempt, pending admin approval
+   *
+   * @generated from field: int64 proposed_retry_block = 10;
+   */
+  proposedRetryBlock: bigint;
+
+  /**
+   * @generated from field: bool retry_approved = 11;
+   */
+  retryApproved: boolean;
+
+  constructor(data?: PartialMessage<KeygenAttempt>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.KeygenAttempt";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeygenAttempt;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeygenAttempt;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeygenAttempt;
+
+  static equals(a: KeygenAttempt | PlainMessage<KeygenAttempt> | undefined, b: KeygenAttempt | PlainMessage<KeygenAttempt> | undefined): boolean;
+}
+
@@ -0,0 +1,390 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file observer/light_client.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * BeaconBlockHeader is the header of a block of the beacon chain
+ *
+ * @generated from message zetachain.zetacore.observer.BeaconBlockHeader
+ */
+export declare class BeaconBlockHeader extends Message<BeaconBlockHeader> {
+  /**
+   * @generated from field: uint64 slot = 1;
+   */
+  slot: bigint;
+
+  /**
+   * @generated from field: uint64 proposer_index = 2;
+   */
+  proposerIndex: bigint;
+
+  /**
+   * @generated from field: bytes parent_root = 3;
+   */
+  parentRoot: Uint8Array;
+
+  /**
+   * @generated from field: bytes state_root = 4;
+   */
+  stateRoot: Uint8Array;
+
+  /**
+   * @generated from field: bytes body_root = 5;
+   */
+  bodyRoot: Uint8Array;
+
+  constructor(data?: PartialMessage<BeaconBlockHeader>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.BeaconBlockHeader";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeaconBlockHeader;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeaconBlockHeader;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeaconBlockHeader;
+
+  static equals(a: BeaconBlockHeader | PlainMessage<BeaconBlockHeader> | undefined, b: BeaconBlockHeader | PlainMessage<BeaconBlockHeader> | undefined): boolean;
+}
+
+/**
+ * ExecutionPayloadHeader is the header of the execution block of a beacon block from Capella
+ *
+ * @generated from message zetachain.zetacore.observer.ExecutionPayloadHeader
+ */
+export declare class ExecutionPayloadHeader extends Message<ExecutionPayloadHeader> {
+  /**
+   * @generated from field: bytes parent_hash = 1;
+   */
+  parentHash: Uint8Array;
+
+  /**
+   * @generated from field: bytes fee_recipient = 2;
+   */
+  feeRecipient: Uint8Array;
+
+  /**
+   * @generated from field: bytes state_root = 3;
+   */
+  stateRoot: Uint8Array;
+
+  /**
+   * @generated from field: bytes receipts_root = 4;
+   */
+  receiptsRoot: Uint8Array;
+
+  /**
+   * @generated from field: bytes logs_bloom = 5;
+   */
+  logsBloom: Uint8Array;
+
+  /**
+   * @generated from field: bytes prev_randao = 6;
+   */
+  prevRandao: Uint8Array;
+
+  /**
+   * @generated from field: uint64 block_number = 7;
+   */
+  blockNumber: bigint;
+
+  /**
+   * @generated from field: uint64 gas_limit = 8;
+   */
+  gasLimit: bigint;
+
+  /**
+   * @generated from field: uint64 gas_used = 9;
+   */
+  gasUsed: bigint;
+
+  /**
+   * @generated from field: uint64 timestamp = 10;
+   */
+  timestamp: bigint;
+
+  /**
+   * @generated from field: bytes extra_data = 11;
+   */
+  extraData: Uint8Array;
+
+  /**
+   * @generated from field: string base_fee_per_gas = 12;
+   */
+  baseFeePerGas: string;
+
+  /**
+   * @generated from field: bytes block_hash = 13;
+   */
+  blockHash: Uint8Array;
+
+  /**
+   * @generated from field: bytes transactions_root = 14;
+   */
+  transactionsRoot: Uint8Array;
+
+  /**
+   * @generated from field: bytes withdrawals_root = 15;
+   */
+  withdrawalsRoot: Uint8Array;
+
+  /**
+   * @generated from field: uint64 blob_gas_used = 16;
+   */
+  blobGasUsed: bigint;
+
+  /**
+   * @generated from field: uint64 excess_blob_gas = 17;
+   */
+  excessBlobGas: bigint;
+
+  constructor(data?: PartialMessage<ExecutionPayloadHeader>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ExecutionPayloadHeader";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionPayloadHeader;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionPayloadHeader;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionPayloadHeader;
+
+  static equals(a: ExecutionPayloadHeader | PlainMessage<ExecutionPayloadHeader> | undefined, b: ExecutionPayloadHeader | PlainMessage<ExecutionPayloadHeader> | undefined): boolean;
+}
+
+/**
+ * LightClientHeader is a beacon block header with its execution block header proven against the block body
+ *
+ * @generated from message zetachain.zetacore.observer.LightClientHeader
+ */
+export declare class LightClientHeader extends Message<LightClientHeader> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.BeaconBlockHeader beacon = 1;
+   */
+  beacon?: BeaconBlockHeader;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.ExecutionPayloadHeader execution = 2;
+   */
+  execution?: ExecutionPayloadHeader;
+
+  /**
+   * @generated from field: repeated bytes execution_branch = 3;
+   */
+  executionBranch: Uint8Array[];
+
+  constructor(data?: PartialMessage<LightClientHeader>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LightClientHeader";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LightClientHeader;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LightClientHeader;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LightClientHeader;
+
+  static equals(a: LightClientHeader | PlainMessage<LightClientHeader> | undefined, b: LightClientHeader | PlainMessage<LightClientHeader> | undefined): boolean;
+}
+
+/**
+ * SyncCommittee is the set of validators signing the beacon blocks during a sync committee period
+ *
+ * @generated from message zetachain.zetacore.observer.SyncCommittee
+ */
+export declare class SyncCommittee extends Message<SyncCommittee> {
+  /**
+   * @generated from field: repeated bytes pubkeys = 1;
+   */
+  pubkeys: Uint8Array[];
+
+  /**
+   * @generated from field: bytes aggregate_pubkey = 2;
+   */
+  aggregatePubkey: Uint8Array;
+
+  constructor(data?: PartialMessage<SyncCommittee>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.SyncCommittee";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncCommittee;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncCommittee;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncCommittee;
+
+  static equals(a: SyncCommittee | PlainMessage<SyncCommittee> | undefined, b: SyncCommittee | PlainMessage<SyncCommittee> | undefined): boolean;
+}
+
+/**
+ * SyncAggregate is the aggregate signature of the participants of a sync committee
+ *
+ * @generated from message zetachain.zetacore.observer.SyncAggregate
+ */
+export declare class SyncAggregate extends Message<SyncAggregate> {
+  /**
+   * @generated from field: bytes sync_committee_bits = 1;
+   */
+  syncCommitteeBits: Uint8Array;
+
+  /**
+   * @generated from field: bytes sync_committee_signature = 2;
+   */
+  syncCommitteeSignature: Uint8Array;
+
+  constructor(data?: PartialMessage<SyncAggregate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.SyncAggregate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncAggregate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncAggregate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncAggregate;
+
+  static equals(a: SyncAggregate | PlainMessage<SyncAggregate> | undefined, b: SyncAggregate | PlainMessage<SyncAggregate> | undefined): boolean;
+}
+
+/**
+ * LightClientBootstrap is the trusted beacon block header the light client is initialized from, with its sync committee
+ *
+ * @generated from message zetachain.zetacore.observer.LightClientBootstrap
+ */
+export declare class LightClientBootstrap extends Message<LightClientBootstrap> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientHeader header = 1;
+   */
+  header?: LightClientHeader;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.SyncCommittee current_sync_committee = 2;
+   */
+  currentSyncCommittee?: SyncCommittee;
+
+  /**
+   * @generated from field: repeated bytes current_sync_committee_branch = 3;
+   */
+  currentSyncCommitteeBranch: Uint8Array[];
+
+  constructor(data?: PartialMessage<LightClientBootstrap>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LightClientBootstrap";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LightClientBootstrap;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LightClientBootstrap;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LightClientBootstrap;
+
+  static equals(a: LightClientBootstrap | PlainMessage<LightClientBootstrap> | undefined, b: LightClientBootstrap | PlainMessage<LightClientBootstrap> | undefined): boolean;
+}
+
+/**
+ * LightClientUpdate is a finalized beacon block header signed by a sync committee
+ *
+ * @generated from message zetachain.zetacore.observer.LightClientUpdate
+ */
+export declare class LightClientUpdate extends Message<LightClientUpdate> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientHeader attested_header = 1;
+   */
+  attestedHeader?: LightClientHeader;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.SyncCommittee next_sync_committee = 2;
+   */
+  nextSyncCommittee?: SyncCommittee;
+
+  /**
+   * @generated from field: repeated bytes next_sync_committee_branch = 3;
+   */
+  nextSyncCommitteeBranch: Uint8Array[];
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientHeader finalized_header = 4;
+   */
+  finalizedHeader?: LightClientHeader;
+
+  /**
+   * @generated from field: repeated bytes finality_branch = 5;
+   */
+  finalityBranch: Uint8Array[];
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.SyncAggregate sync_aggregate = 6;
+   */
+  syncAggregate?: SyncAggregate;
+
+  /**
+   * @generated from field: uint64 signature_slot = 7;
+   */
+  signatureSlot: bigint;
+
+  constructor(data?: PartialMessage<LightClientUpdate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LightClientUpdate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LightClientUpdate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LightClientUpdate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LightClientUpdate;
+
+  static equals(a: LightClientUpdate | PlainMessage<LightClientUpdate> | undefined, b: LightClientUpdate | PlainMessage<LightClientUpdate> | undefined): boolean;
+}
+
+/**
+ * LightClientState is the state of the beacon chain light client of an Ethereum chain
+ *
+ * @generated from message zetachain.zetacore.observer.LightClientState
+ */
+export declare class LightClientState extends Message<LightClientState> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientHeader finalized_header = 2;
+   */
+  finalizedHeader?: LightClientHeader;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.SyncCommittee current_sync_committee = 3;
+   */
+  currentSyncCommittee?: SyncCommittee;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.SyncCommittee next_sync_committee = 4;
+   */
+  nextSyncCommittee?: SyncCommittee;
+
+  constructor(data?: PartialMessage<LightClientState>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LightClientState";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LightClientState;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LightClientState;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LightClientState;
+
+  static equals(a: LightClientState | PlainMessage<LightClientState> | undefined, b: LightClientState | PlainMessage<LightClientState> | undefined): boolean;
+}
@@ -0,0 +1,129 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file observer/liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * LivenessParams defines how the participation of the observers in the ballots is tracked
+ *
+ * @generated from message zetachain.zetacore.observer.LivenessParams
+ */
+export declare class LivenessParams extends Message<LivenessParams> {
+  /**
+   * number of matured ballots of a chain tracked for each observer, 0 disables the tracking
+   *
+   * @generated from field: uint64 window_size = 1;
+   */
+  windowSize: bigint;
+
+  /**
+   * number of missed votes in the window above which a warning event is emitted, 0 disables the warning
+   *
+   * @generated from field: uint64 warning_missed_votes = 2;
+   */
+  warningMissedVotes: bigint;
+
+  /**
+   * number of missed votes in the window above which the observer is jailed, 0 disables the jailing
+   *
+   * @generated from field: uint64 max_missed_votes = 3;
+   */
+  maxMissedVotes: bigint;
+
+  /**
+   * number of blocks a jailed observer must wait before unjailing
+   *
+   * @generated from field: int64 jail_duration = 4;
+   */
+  jailDuration: bigint;
+
+  constructor(data?: PartialMessage<LivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static equals(a: LivenessParams | PlainMessage<LivenessParams> | undefined, b: LivenessParams | PlainMessage<LivenessParams> | undefined): boolean;
+}
+
+/**
+ * ObserverLiveness tracks the missed votes of an observer over the last matured ballots of a chain
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLiveness
+ */
+export declare class ObserverLiveness extends Message<ObserverLiveness> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * size of the window when the tracking started, the window is reset if the param changes
+   *
+   * @generated from field: uint64 window_size = 3;
+   */
+  windowSize: bigint;
+
+  /**
+   * number of ballots tracked since the window started
+   *
+   * @generated from field: uint64 index_offset = 4;
+   */
+  indexOffset: bigint;
+
+  /**
+   * @generated from field: uint64 missed_votes_counter = 5;
+   */
+  missedVotesCounter: bigint;
+
+  /**
+   * bit array of the missed votes in the window
+   *
+   * @generated from field: bytes missed_votes = 6;
+   */
+  missedVotes: Uint8Array;
+
+  /**
+   * @generated from field: bool jailed = 7;
+   */
+  jailed: boolean;
+
+  /**
+   * @generated from field: int64 jailed_height = 8;
+   */
+  jailedHeight: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 9;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<ObserverLiveness>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLiveness";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLiveness;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static equals(a: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined, b: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined): boolean;
+}
+
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { Chain } from "../common/common_pb.js";
+import type { Chain, CoinType } from "../common/common_pb.js";
 
 /**
  * @generated from enum zetachain.zetacore.observer.Policy_Type
@@ -105,6 +105,20 @@ export declare class CoreParams extends Message<CoreParams> {
    */
   outboundTxScheduleLookahead: bigint;
 
+  /**
+   * confirmation counts of the transfers from an amount, the confirmation_count is used below the lowest amount
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ConfirmationTier confirmation_tiers = 14;
+   */
+  confirmationTiers: ConfirmationTier[];
+
+  /**
+   * number of the latest block heights of the chain whose block headers are kept, 0 keeps all the block headers
+   *
+   * @generated from field: uint64 block_header_retention = 15;
+   */
+  blockHeaderRetention: bigint;
+
   constructor(data?: PartialMessage<CoreParams>);
 
   static readonly runtime: typeof proto3;
@@ -120,6 +134,49 @@ export declare class CoreParams extends Message<CoreParams> {
   static equals(a: CoreParams | PlainMessage<CoreParams> | undefined, b: CoreParams | PlainMessage<CoreParams> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.ConfirmationTier
+ */
+export declare class ConfirmationTier extends Message<ConfirmationTier> {
+  /**
+   * @generated from field: common.CoinType coin_type = 1;
+   */
+  coinType: CoinType;
+
+  /**
+   * ERC20 contract address of the asset for ERC20, empty for Gas and Zeta
+   *
+   * @generated from field: string asset = 2;
+   */
+  asset: string;
+
+  /**
+   * amount in units of the asset, normalized with the decimals of its ZRC20
+   *
+   * @generated from field: string amount_threshold = 3;
+   */
+  amountThreshold: string;
+
+  /**
+   * @generated from field: uint64 confirmation_count = 4;
+   */
+  confirmationCount: bigint;
+
+  constructor(data?: PartialMessage<ConfirmationTier>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ConfirmationTier";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmationTier;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static equals(a: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined, b: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.ObserverParams
  */
@@ -15,9 +15,11 @@ import type { BallotStatus, VoteType } from "./ballot_pb.js";
 import type { LastObserverCount, ObservationType, ObserverMapper } from "./observer_pb.js";
 import type { NodeAccount } from "./node_account_pb.js";
 import type { CrosschainFlags } from "./crosschain_flags_pb.js";
-import type { Keygen } from "./keygen_pb.js";
+import type { Keygen, KeygenAttempt } from "./keygen_pb.js";
+import type { LivenessParams, ObserverLiveness } from "./liveness_pb.js";
 import type { Blame } from "./blame_pb.js";
-import type { BlockHeaderState } from "./block_header_pb.js";
+import type { BlockHeaderState, HeightRange } from "./block_header_pb.js";
+import type { LightClientState } from "./light_client_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.QueryGetChainNoncesRequest
@@ -1100,6 +1102,256 @@ export declare class QueryGetKeygenResponse extends Message<QueryGetKeygenRespon
   static equals(a: QueryGetKeygenResponse | PlainMessage<QueryGetKeygenResponse> | undefined, b: QueryGetKeygenResponse | PlainMessage<QueryGetKeygenResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetKeygenAttemptRequest
+ */
+export declare class QueryGetKeygenAttemptRequest extends Message<QueryGetKeygenAttemptRequest> {
+  /**
+   * @generated from field: uint64 index = 1;
+   */
+  index: bigint;
+
+  constructor(data?: PartialMessage<QueryGetKeygenAttemptRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetKeygenAttemptRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetKeygenAttemptRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetKeygenAttemptRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetKeygenAttemptRequest;
+
+  static equals(a: QueryGetKeygenAttemptRequest | PlainMessage<QueryGetKeygenAttemptRequest> | undefined, b: QueryGetKeygenAttemptRequest | PlainMessage<QueryGetKeygenAttemptRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetKeygenAttemptResponse
+ */
+export declare class QueryGetKeygenAttemptResponse extends Message<QueryGetKeygenAttemptResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.KeygenAttempt keygen_attempt = 1;
+   */
+  keygenAttempt?: KeygenAttempt;
+
+  constructor(data?: PartialMessage<QueryGetKeygenAttemptResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetKeygenAttemptResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetKeygenAttemptResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetKeygenAttemptResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetKeygenAttemptResponse;
+
+  static equals(a: QueryGetKeygenAttemptResponse | PlainMessage<QueryGetKeygenAttemptResponse> | undefined, b: QueryGetKeygenAttemptResponse | PlainMessage<QueryGetKeygenAttemptResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllKeygenAttemptRequest
+ */
+export declare class QueryAllKeygenAttemptRequest extends Message<QueryAllKeygenAttemptRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllKeygenAttemptRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllKeygenAttemptRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllKeygenAttemptRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllKeygenAttemptRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllKeygenAttemptRequest;
+
+  static equals(a: QueryAllKeygenAttemptRequest | PlainMessage<QueryAllKeygenAttemptRequest> | undefined, b: QueryAllKeygenAttemptRequest | PlainMessage<QueryAllKeygenAttemptRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllKeygenAttemptResponse
+ */
+export declare class QueryAllKeygenAttemptResponse extends Message<QueryAllKeygenAttemptResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.KeygenAttempt keygen_attempt = 1;
+   */
+  keygenAttempt: KeygenAttempt[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllKeygenAttemptResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllKeygenAttemptResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllKeygenAttemptResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllKeygenAttemptResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllKeygenAttemptResponse;
+
+  static equals(a: QueryAllKeygenAttemptResponse | PlainMessage<QueryAllKeygenAttemptResponse> | undefined, b: QueryAllKeygenAttemptResponse | PlainMessage<QueryAllKeygenAttemptResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLivenessParamsRequest
+ */
+export declare class QueryGetLivenessParamsRequest extends Message<QueryGetLivenessParamsRequest> {
+  constructor(data?: PartialMessage<QueryGetLivenessParamsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLivenessParamsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLivenessParamsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsRequest;
+
+  static equals(a: QueryGetLivenessParamsRequest | PlainMessage<QueryGetLivenessParamsRequest> | undefined, b: QueryGetLivenessParamsRequest | PlainMessage<QueryGetLivenessParamsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLivenessParamsResponse
+ */
+export declare class QueryGetLivenessParamsResponse extends Message<QueryGetLivenessParamsResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 1;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<QueryGetLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsResponse;
+
+  static equals(a: QueryGetLivenessParamsResponse | PlainMessage<QueryGetLivenessParamsResponse> | undefined, b: QueryGetLivenessParamsResponse | PlainMessage<QueryGetLivenessParamsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessRequest
+ */
+export declare class QueryGetObserverLivenessRequest extends Message<QueryGetObserverLivenessRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static equals(a: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined, b: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessResponse
+ */
+export declare class QueryGetObserverLivenessResponse extends Message<QueryGetObserverLivenessResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness?: ObserverLiveness;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static equals(a: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined, b: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessRequest
+ */
+export declare class QueryAllObserverLivenessRequest extends Message<QueryAllObserverLivenessRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static equals(a: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined, b: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessResponse
+ */
+export declare class QueryAllObserverLivenessResponse extends Message<QueryAllObserverLivenessResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness: ObserverLiveness[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static equals(a: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined, b: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryShowObserverCountRequest
  */
@@ -1446,3 +1698,103 @@ export declare class QueryGetBlockHeaderStateResponse extends Message<QueryGetBl
   static equals(a: QueryGetBlockHeaderStateResponse | PlainMessage<QueryGetBlockHeaderStateResponse> | undefined, b: QueryGetBlockHeaderStateResponse | PlainMessage<QueryGetBlockHeaderStateResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesRequest
+ */
+export declare class QueryPrunedBlockHeaderRangesRequest extends Message<QueryPrunedBlockHeaderRangesRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryPrunedBlockHeaderRangesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPrunedBlockHeaderRangesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPrunedBlockHeaderRangesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPrunedBlockHeaderRangesRequest;
+
+  static equals(a: QueryPrunedBlockHeaderRangesRequest | PlainMessage<QueryPrunedBlockHeaderRangesRequest> | undefined, b: QueryPrunedBlockHeaderRangesRequest | PlainMessage<QueryPrunedBlockHeaderRangesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesResponse
+ */
+export declare class QueryPrunedBlockHeaderRangesResponse extends Message<QueryPrunedBlockHeaderRangesResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.HeightRange pruned_ranges = 1;
+   */
+  prunedRanges: HeightRange[];
+
+  /**
+   * @generated from field: int64 pruned_height = 2;
+   */
+  prunedHeight: bigint;
+
+  constructor(data?: PartialMessage<QueryPrunedBlockHeaderRangesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPrunedBlockHeaderRangesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPrunedBlockHeaderRangesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPrunedBlockHeaderRangesResponse;
+
+  static equals(a: QueryPrunedBlockHeaderRangesResponse | PlainMessage<QueryPrunedBlockHeaderRangesResponse> | undefined, b: QueryPrunedBlockHeaderRangesResponse | PlainMessage<QueryPrunedBlockHeaderRangesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLightClientStateRequest
+ */
+export declare class QueryGetLightClientStateRequest extends Message<QueryGetLightClientStateRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryGetLightClientStateRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLightClientStateRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLightClientStateRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLightClientStateRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLightClientStateRequest;
+
+  static equals(a: QueryGetLightClientStateRequest | PlainMessage<QueryGetLightClientStateRequest> | undefined, b: QueryGetLightClientStateRequest | PlainMessage<QueryGetLightClientStateRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLightClientStateResponse
+ */
+export declare class QueryGetLightClientStateResponse extends Message<QueryGetLightClientStateResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientState light_client_state = 1;
+   */
+  lightClientState?: LightClientState;
+
+  constructor(data?: PartialMessage<QueryGetLightClientStateResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLightClientStateResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLightClientStateResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLightClientStateResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLightClientStateResponse;
+
+  static equals(a: QueryGetLightClientStateResponse | PlainMessage<QueryGetLightClientStateResponse> | undefined, b: QueryGetLightClientStateResponse | PlainMessage<QueryGetLightClientStateResponse> | undefined): boolean;
+}
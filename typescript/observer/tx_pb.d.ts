@@ -10,6 +10,8 @@ import type { HeaderData } from "../common/common_pb.js";
 import type { CoreParams } from "./params_pb.js";
 import type { Blame } from "./blame_pb.js";
 import type { BlockHeaderVerificationFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { LivenessParams } from "./liveness_pb.js";
+import type { LightClientBootstrap, LightClientUpdate } from "./light_client_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -402,3 +404,317 @@ export declare class MsgUpdateKeygenResponse extends Message<MsgUpdateKeygenResp
   static equals(a: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined, b: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.MsgApproveKeygenRetry
+ */
+export declare class MsgApproveKeygenRetry extends Message<MsgApproveKeygenRetry> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: uint64 attempt_index = 2;
+   */
+  attemptIndex: bigint;
+
+  /**
+   * overrides the proposed retry block if set
+   *
+   * @generated from field: int64 block = 3;
+   */
+  block: bigint;
+
+  constructor(data?: PartialMessage<MsgApproveKeygenRetry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgApproveKeygenRetry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgApproveKeygenRetry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgApproveKeygenRetry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgApproveKeygenRetry;
+
+  static equals(a: MsgApproveKeygenRetry | PlainMessage<MsgApproveKeygenRetry> | undefined, b: MsgApproveKeygenRetry | PlainMessage<MsgApproveKeygenRetry> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgApproveKeygenRetryResponse
+ */
+export declare class MsgApproveKeygenRetryResponse extends Message<MsgApproveKeygenRetryResponse> {
+  constructor(data?: PartialMessage<MsgApproveKeygenRetryResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgApproveKeygenRetryResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgApproveKeygenRetryResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgApproveKeygenRetryResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgApproveKeygenRetryResponse;
+
+  static equals(a: MsgApproveKeygenRetryResponse | PlainMessage<MsgApproveKeygenRetryResponse> | undefined, b: MsgApproveKeygenRetryResponse | PlainMessage<MsgApproveKeygenRetryResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParams
+ */
+export declare class MsgUpdateLivenessParams extends Message<MsgUpdateLivenessParams> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 2;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<MsgUpdateLivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static equals(a: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined, b: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse
+ */
+export declare class MsgUpdateLivenessParamsResponse extends Message<MsgUpdateLivenessParamsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static equals(a: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined, b: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserver
+ */
+export declare class MsgUnjailObserver extends Message<MsgUnjailObserver> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<MsgUnjailObserver>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserver";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserver;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static equals(a: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined, b: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserverResponse
+ */
+export declare class MsgUnjailObserverResponse extends Message<MsgUnjailObserverResponse> {
+  constructor(data?: PartialMessage<MsgUnjailObserverResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserverResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static equals(a: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined, b: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgInitLightClient
+ */
+export declare class MsgInitLightClient extends Message<MsgInitLightClient> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientBootstrap bootstrap = 3;
+   */
+  bootstrap?: LightClientBootstrap;
+
+  constructor(data?: PartialMessage<MsgInitLightClient>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgInitLightClient";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgInitLightClient;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgInitLightClient;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgInitLightClient;
+
+  static equals(a: MsgInitLightClient | PlainMessage<MsgInitLightClient> | undefined, b: MsgInitLightClient | PlainMessage<MsgInitLightClient> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgInitLightClientResponse
+ */
+export declare class MsgInitLightClientResponse extends Message<MsgInitLightClientResponse> {
+  constructor(data?: PartialMessage<MsgInitLightClientResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgInitLightClientResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgInitLightClientResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgInitLightClientResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgInitLightClientResponse;
+
+  static equals(a: MsgInitLightClientResponse | PlainMessage<MsgInitLightClientResponse> | undefined, b: MsgInitLightClientResponse | PlainMessage<MsgInitLightClientResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgSubmitLightClientUpdate
+ */
+export declare class MsgSubmitLightClientUpdate extends Message<MsgSubmitLightClientUpdate> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LightClientUpdate update = 3;
+   */
+  update?: LightClientUpdate;
+
+  constructor(data?: PartialMessage<MsgSubmitLightClientUpdate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgSubmitLightClientUpdate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitLightClientUpdate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitLightClientUpdate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitLightClientUpdate;
+
+  static equals(a: MsgSubmitLightClientUpdate | PlainMessage<MsgSubmitLightClientUpdate> | undefined, b: MsgSubmitLightClientUpdate | PlainMessage<MsgSubmitLightClientUpdate> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgSubmitLightClientUpdateResponse
+ */
+export declare class MsgSubmitLightClientUpdateResponse extends Message<MsgSubmitLightClientUpdateResponse> {
+  constructor(data?: PartialMessage<MsgSubmitLightClientUpdateResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgSubmitLightClientUpdateResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitLightClientUpdateResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitLightClientUpdateResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitLightClientUpdateResponse;
+
+  static equals(a: MsgSubmitLightClientUpdateResponse | PlainMessage<MsgSubmitLightClientUpdateResponse> | undefined, b: MsgSubmitLightClientUpdateResponse | PlainMessage<MsgSubmitLightClientUpdateResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateOutboundPause
+ */
+export declare class MsgUpdateOutboundPause extends Message<MsgUpdateOutboundPause> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bool paused = 3;
+   */
+  paused: boolean;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  constructor(data?: PartialMessage<MsgUpdateOutboundPause>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateOutboundPause";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateOutboundPause;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateOutboundPause;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateOutboundPause;
+
+  static equals(a: MsgUpdateOutboundPause | PlainMessage<MsgUpdateOutboundPause> | undefined, b: MsgUpdateOutboundPause | PlainMessage<MsgUpdateOutboundPause> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateOutboundPauseResponse
+ */
+export declare class MsgUpdateOutboundPauseResponse extends Message<MsgUpdateOutboundPauseResponse> {
+  constructor(data?: PartialMessage<MsgUpdateOutboundPauseResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateOutboundPauseResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateOutboundPauseResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateOutboundPauseResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateOutboundPauseResponse;
+
+  static equals(a: MsgUpdateOutboundPauseResponse | PlainMessage<MsgUpdateOutboundPauseResponse> | undefined, b: MsgUpdateOutboundPauseResponse | PlainMessage<MsgUpdateOutboundPauseResponse> | undefined): boolean;
+}
@@ -0,0 +1 @@
+export * from "./remote_signer_pb";
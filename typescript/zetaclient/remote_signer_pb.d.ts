@@ -0,0 +1,115 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetaclient/remote_signer.proto (package zetachain.zetacore.zetaclient, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message zetachain.zetacore.zetaclient.PubKeyRequest
+ */
+export declare class PubKeyRequest extends Message<PubKeyRequest> {
+  constructor(data?: PartialMessage<PubKeyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.zetaclient.PubKeyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PubKeyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PubKeyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PubKeyRequest;
+
+  static equals(a: PubKeyRequest | PlainMessage<PubKeyRequest> | undefined, b: PubKeyRequest | PlainMessage<PubKeyRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.zetaclient.PubKeyResponse
+ */
+export declare class PubKeyResponse extends Message<PubKeyResponse> {
+  /**
+   * compressed secp256k1 public key of the hot key
+   *
+   * @generated from field: bytes pub_key = 1;
+   */
+  pubKey: Uint8Array;
+
+  constructor(data?: PartialMessage<PubKeyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.zetaclient.PubKeyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PubKeyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PubKeyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PubKeyResponse;
+
+  static equals(a: PubKeyResponse | PlainMessage<PubKeyResponse> | undefined, b: PubKeyResponse | PlainMessage<PubKeyResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.zetaclient.SignTxRequest
+ */
+export declare class SignTxRequest extends Message<SignTxRequest> {
+  /**
+   * @generated from field: string chain_id = 1;
+   */
+  chainId: string;
+
+  /**
+   * @generated from field: uint64 account_number = 2;
+   */
+  accountNumber: bigint;
+
+  /**
+   * @generated from field: bytes body_bytes = 3;
+   */
+  bodyBytes: Uint8Array;
+
+  /**
+   * @generated from field: bytes auth_info_bytes = 4;
+   */
+  authInfoBytes: Uint8Array;
+
+  constructor(data?: PartialMessage<SignTxRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.zetaclient.SignTxRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignTxRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignTxRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignTxRequest;
+
+  static equals(a: SignTxRequest | PlainMessage<SignTxRequest> | undefined, b: SignTxRequest | PlainMessage<SignTxRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.zetaclient.SignTxResponse
+ */
+export declare class SignTxResponse extends Message<SignTxResponse> {
+  /**
+   * @generated from field: bytes signature = 1;
+   */
+  signature: Uint8Array;
+
+  constructor(data?: PartialMessage<SignTxResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.zetaclient.SignTxResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignTxResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignTxResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignTxResponse;
+
+  static equals(a: SignTxResponse | PlainMessage<SignTxResponse> | undefined, b: SignTxResponse | PlainMessage<SignTxResponse> | undefined): boolean;
+}
@@ -74,6 +74,8 @@ func CmdShowGasPrice() *cobra.Command {
 
 // Transaction CLI /////////////////////////
 
+const flagPriorityFee = "priority-fee"
+
 func CmdGasPriceVoter() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gas-price-voter [chain] [price] [supply] [blockNumber]",
@@ -99,7 +101,12 @@ func CmdGasPriceVoter() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsSupply, argsBlockNumber)
+			argsPriorityFee, err := cmd.Flags().GetUint64(flagPriorityFee)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsPriorityFee, argsSupply, argsBlockNumber)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -107,6 +114,7 @@ func CmdGasPriceVoter() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagPriorityFee, 0, "priority fee of the chain, only for the chains with EIP-1559 fees")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"os"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -50,6 +51,89 @@ func CmdAddToInTxTracker() *cobra.Command {
 	return cmd
 }
 
+const (
+	flagReceiptProofFile = "receipt-proof-file"
+	flagFirstLogIndex    = "first-log-index"
+)
+
+func CmdAddProvenInboundTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "add-proven-inbound-tx [chain-id] [tx-hash] [block-hash] [tx-index] [proof-file]",
+		Short: `Create the cctx of an inbound tx proven against a block header
+				The proof file contains the JSON of the proof of the tx, the proof of its receipt is required for the evm chains`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChain, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argTxHash := args[1]
+			argBlockHash := args[2]
+			argTxIndex, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proof, err := readProofFile(clientCtx, args[4])
+			if err != nil {
+				return err
+			}
+			var receiptProof *common.Proof
+			receiptProofFile, err := cmd.Flags().GetString(flagReceiptProofFile)
+			if err != nil {
+				return err
+			}
+			if receiptProofFile != "" {
+				receiptProof, err = readProofFile(clientCtx, receiptProofFile)
+				if err != nil {
+					return err
+				}
+			}
+			firstLogIndex, err := cmd.Flags().GetUint64(flagFirstLogIndex)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAddProvenInboundTx(
+				clientCtx.GetFromAddress().String(),
+				argChain,
+				argTxHash,
+				proof,
+				argBlockHash,
+				argTxIndex,
+				receiptProof,
+				firstLogIndex,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReceiptProofFile, "", "file containing the JSON of the proof of the receipt of the tx")
+	cmd.Flags().Uint64(flagFirstLogIndex, 0, "index in the block of the first log of the receipt of the tx")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readProofFile reads the JSON of a proof from a file
+func readProofFile(clientCtx client.Context, path string) (*common.Proof, error) {
+	// #nosec G304 -- the proof file is given by the user
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var proof common.Proof
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
 func CmdListInTxTrackerByChain() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-in-tx-tracker [chainId]",
@@ -28,6 +28,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(
 		CmdListOutTxTracker(),
 		CmdShowOutTxTracker(),
+		CmdShowOutTxNonceGap(),
 		CmdListGasPrice(),
 		CmdShowGasPrice(),
 
@@ -18,6 +18,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetOutTxTracker(ctx, elem)
 	}
 
+	// Set all the outTxNonceGap
+	for _, elem := range genState.OutTxNonceGapList {
+		k.SetOutTxNonceGap(ctx, elem)
+	}
+
 	// Set all the inTxTracker
 	for _, elem := range genState.InTxTrackerList {
 		k.SetInTxTracker(ctx, elem)
@@ -61,6 +66,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.OutTxTrackerList = k.GetAllOutTxTracker(ctx)
 	genesis.InTxHashToCctxList = k.GetAllInTxHashToCctx(ctx)
 	genesis.InTxTrackerList = k.GetAllInTxTracker(ctx)
+	genesis.OutTxNonceGapList = k.GetAllOutTxNonceGap(ctx)
 
 	// Get all gas prices
 	gasPriceList := k.GetAllGasPrice(ctx)
@@ -20,6 +20,11 @@ func TestGenesis(t *testing.T) {
 			sample.OutTxTracker(t, "1"),
 			sample.OutTxTracker(t, "2"),
 		},
+		OutTxNonceGapList: []types.OutTxNonceGap{
+			sample.OutTxNonceGap(t, "0"),
+			sample.OutTxNonceGap(t, "1"),
+			sample.OutTxNonceGap(t, "2"),
+		},
 		GasPriceList: []*types.GasPrice{
 			sample.GasPrice(t, "0"),
 			sample.GasPrice(t, "1"),
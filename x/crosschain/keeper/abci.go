@@ -102,6 +102,12 @@ func (k Keeper) CheckAndUpdateCctxGasPrice(
 		return math.ZeroUint(), math.ZeroUint(), nil
 	}
 
+	// compute new priority fee for EIP-1559 outbound tx, increased by the same percentage of the median priority fee
+	newPriorityFee, err := k.increasedPriorityFee(ctx, cctx, chainID, flags, newGasPrice)
+	if err != nil {
+		return math.ZeroUint(), math.ZeroUint(), err
+	}
+
 	// withdraw additional fees from the gas stability pool
 	gasLimit := math.NewUint(cctx.GetCurrentOutTxParam().OutboundTxGasLimit)
 	additionalFees := gasLimit.Mul(gasPriceIncrease)
@@ -114,8 +120,36 @@ func (k Keeper) CheckAndUpdateCctxGasPrice(
 
 	// set new gas price and last update timestamp
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = newGasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = newPriorityFee
 	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
 	k.SetCrossChainTx(ctx, cctx)
 
 	return gasPriceIncrease, additionalFees, nil
 }
+
+// increasedPriorityFee returns the priority fee of the outbound tx increased by the gas price increase percent of the
+// median priority fee, capped to the new gas price. An empty string is returned for a legacy outbound tx
+func (k Keeper) increasedPriorityFee(
+	ctx sdk.Context,
+	cctx types.CrossChainTx,
+	chainID int64,
+	flags observertypes.GasPriceIncreaseFlags,
+	newGasPrice math.Uint,
+) (string, error) {
+	currentPriorityFee, err := cctx.GetCurrentOutTxParam().GetPriorityFee()
+	if err != nil {
+		return "", err
+	}
+	if currentPriorityFee == 0 {
+		return "", nil
+	}
+
+	newPriorityFee := math.NewUint(currentPriorityFee)
+	if medianPriorityFee, isFound := k.GetMedianPriorityFeeInUint(ctx, chainID); isFound {
+		newPriorityFee = newPriorityFee.Add(medianPriorityFee.MulUint64(uint64(flags.GasPriceIncreasePercent)).QuoUint64(100))
+	}
+	if newPriorityFee.GT(newGasPrice) {
+		newPriorityFee = newGasPrice
+	}
+	return newPriorityFee.String(), nil
+}
@@ -23,10 +23,12 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 		flags                                  observertypes.GasPriceIncreaseFlags
 		blockTimestamp                         time.Time
 		medianGasPrice                         uint64
+		medianPriorityFee                      uint64
 		withdrawFromGasStabilityPoolReturn     error
 		expectWithdrawFromGasStabilityPoolCall bool
 		expectedGasPriceIncrease               math.Uint
 		expectedAdditionalFees                 math.Uint
+		expectedPriorityFee                    string
 		isError                                bool
 	}{
 		{
@@ -108,6 +110,58 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update priority fee of EIP-1559 outbound tx",
+			cctx: types.CrossChainTx{
+				Index: "a4",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:          42,
+						OutboundTxGasLimit:       1000,
+						OutboundTxGasPrice:       "100",
+						OutboundTxGasPriorityFee: "10",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         50,
+			medianPriorityFee:                      4,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
+			expectedPriorityFee:                    "14",                // 100% medianPriorityFee
+		},
+		{
+			name: "priority fee is capped to the new gas price",
+			cctx: types.CrossChainTx{
+				Index: "a5",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:          42,
+						OutboundTxGasLimit:       1000,
+						OutboundTxGasPrice:       "100",
+						OutboundTxGasPriorityFee: "90",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         50,
+			medianPriorityFee:                      80,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
+			expectedPriorityFee:                    "150",               // new gas price
+		},
 		{
 			name: "skip if max limit reached",
 			cctx: types.CrossChainTx{
@@ -261,9 +315,10 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 			// set median gas price if not zero
 			if tc.medianGasPrice != 0 {
 				k.SetGasPrice(ctx, types.GasPrice{
-					ChainId:     chainID,
-					Prices:      []uint64{tc.medianGasPrice},
-					MedianIndex: 0,
+					ChainId:      chainID,
+					Prices:       []uint64{tc.medianGasPrice},
+					PriorityFees: []uint64{tc.medianPriorityFee},
+					MedianIndex:  0,
 				})
 
 				// ensure median gas price is set
@@ -302,6 +357,7 @@ func TestKeeper_CheckAndUpdateCctxGasPrice(t *testing.T) {
 				require.NoError(t, err)
 				require.EqualValues(t, tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(), newGasPrice, "%d - %d", tc.expectedGasPriceIncrease.Uint64(), previousGasPrice)
 				require.EqualValues(t, tc.blockTimestamp.Unix(), cctx.CctxStatus.LastUpdateTimestamp)
+				require.Equal(t, tc.expectedPriorityFee, cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
 			}
 		})
 	}
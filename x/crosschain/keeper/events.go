@@ -85,3 +85,20 @@ func EmitOutboundFailure(ctx sdk.Context, msg *types.MsgVoteOnObservedOutboundTx
 		ctx.Logger().Error("Error emitting MsgVoteOnObservedOutboundTx :", err)
 	}
 }
+
+func EmitOutTxNonceGapReported(ctx sdk.Context, msg *types.MsgReportOutTxNonceGap, cctx types.CrossChainTx) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventOutTxNonceGapReported{
+		MsgTypeUrl:   sdk.MsgTypeURL(&types.MsgReportOutTxNonceGap{}),
+		CctxIndex:    cctx.Index,
+		ChainId:      strconv.FormatInt(msg.ChainId, 10),
+		Nonce:        strconv.FormatUint(msg.Nonce, 10),
+		TssNonce:     strconv.FormatUint(msg.TssNonce, 10),
+		Cause:        msg.Cause.String(),
+		Action:       msg.Action.String(),
+		CancelTxHash: msg.CancelTxHash,
+		Reporter:     msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting MsgReportOutTxNonceGap :", err)
+	}
+}
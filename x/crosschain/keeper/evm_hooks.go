@@ -166,6 +166,7 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 		return fmt.Errorf("gasprice not found for %s", receiverChain)
 	}
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
+	k.SetOutTxPriorityFee(ctx, receiverChain.ChainId, &cctx, math.NewUint(gasprice.Prices[gasprice.MedianIndex]))
 	cctx.GetCurrentOutTxParam().Amount = cctx.InboundTxParams.Amount
 
 	EmitZRCWithdrawCreated(ctx, cctx)
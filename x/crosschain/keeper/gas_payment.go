@@ -121,6 +121,7 @@ func (k Keeper) PayGasNativeAndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	k.SetOutTxPriorityFee(ctx, chainID, cctx, gasPrice)
 
 	return nil
 }
@@ -246,6 +247,7 @@ func (k Keeper) PayGasInERC20AndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	k.SetOutTxPriorityFee(ctx, chainID, cctx, gasPrice)
 
 	return nil
 }
@@ -347,6 +349,7 @@ func (k Keeper) PayGasInZetaAndUpdateCctx(
 
 	// Update the cctx
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	k.SetOutTxPriorityFee(ctx, chainID, cctx, gasPrice)
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	if cctx.ZetaFees.IsNil() {
 		cctx.ZetaFees = feeInZeta
@@ -37,6 +37,34 @@ func (k Keeper) GetMedianGasPriceInUint(ctx sdk.Context, chainID int64) (sdk.Uin
 	return sdk.NewUint(gasPrice.Prices[mi]), true
 }
 
+// GetMedianPriorityFeeInUint returns the median of the priority fees voted for the chain
+// false is returned if the chain has no priority fee, the outbound txs of the chain are legacy txs
+func (k Keeper) GetMedianPriorityFeeInUint(ctx sdk.Context, chainID int64) (sdk.Uint, bool) {
+	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
+	if !isFound || len(gasPrice.PriorityFees) == 0 {
+		return math.ZeroUint(), false
+	}
+	priorityFee := gasPrice.PriorityFees[medianOfArray(gasPrice.PriorityFees)]
+	if priorityFee == 0 {
+		return math.ZeroUint(), false
+	}
+	return sdk.NewUint(priorityFee), true
+}
+
+// SetOutTxPriorityFee sets the median priority fee of the chain as the priority fee of the current outbound tx of the
+// cctx, the priority fee is capped to the gas price of the outbound tx
+func (k Keeper) SetOutTxPriorityFee(ctx sdk.Context, chainID int64, cctx *types.CrossChainTx, gasPrice sdk.Uint) {
+	priorityFee, isFound := k.GetMedianPriorityFeeInUint(ctx, chainID)
+	if !isFound {
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = ""
+		return
+	}
+	if priorityFee.GT(gasPrice) {
+		priorityFee = gasPrice
+	}
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = priorityFee.String()
+}
+
 // RemoveGasPrice removes a gasPrice from the store
 func (k Keeper) RemoveGasPrice(ctx sdk.Context, index string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GasPriceKey))
@@ -45,3 +45,62 @@ func TestGasPriceGetAll(t *testing.T) {
 	items := createNGasPrice(keeper, ctx, 10)
 	assert.Equal(t, items, keeper.GetAllGasPrice(ctx))
 }
+
+func TestGetMedianPriorityFeeInUint(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	// no gas price
+	_, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.False(t, found)
+
+	// gas price voted without priority fee
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId: 1,
+		Prices:  []uint64{10, 20, 30},
+	})
+	_, found = keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.False(t, found)
+
+	// legacy chain
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId:      1,
+		Prices:       []uint64{10, 20, 30},
+		PriorityFees: []uint64{0, 0, 0},
+	})
+	_, found = keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.False(t, found)
+
+	// EIP-1559 chain
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId:      1,
+		Prices:       []uint64{10, 20, 30},
+		PriorityFees: []uint64{3, 1, 2},
+	})
+	priorityFee, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, sdk.NewUint(2), priorityFee)
+}
+
+func TestSetOutTxPriorityFee(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	cctx := types.CrossChainTx{
+		OutboundTxParams: []*types.OutboundTxParams{{}},
+	}
+
+	// legacy chain
+	keeper.SetOutTxPriorityFee(ctx, 1, &cctx, sdk.NewUint(100))
+	assert.Equal(t, "", cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+
+	// EIP-1559 chain
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId:      1,
+		Prices:       []uint64{100},
+		PriorityFees: []uint64{10},
+	})
+	keeper.SetOutTxPriorityFee(ctx, 1, &cctx, sdk.NewUint(100))
+	assert.Equal(t, "10", cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+
+	// capped to the gas price
+	keeper.SetOutTxPriorityFee(ctx, 1, &cctx, sdk.NewUint(5))
+	assert.Equal(t, "5", cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OutTxNonceGap(c context.Context, req *types.QueryGetOutTxNonceGapRequest) (*types.QueryGetOutTxNonceGapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	val, found := k.GetOutTxNonceGap(
+		ctx,
+		req.ChainID,
+		req.Nonce,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetOutTxNonceGapResponse{OutTxNonceGap: val}, nil
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOutTxNonceGapQuerySingle(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNOutTxNonceGap(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetOutTxNonceGapRequest
+		response *types.QueryGetOutTxNonceGapResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetOutTxNonceGapRequest{ChainID: msgs[0].ChainId, Nonce: msgs[0].Nonce},
+			response: &types.QueryGetOutTxNonceGapResponse{OutTxNonceGap: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetOutTxNonceGapRequest{ChainID: msgs[1].ChainId, Nonce: msgs[1].Nonce},
+			response: &types.QueryGetOutTxNonceGapResponse{OutTxNonceGap: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetOutTxNonceGapRequest{ChainID: 100, Nonce: 100},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.OutTxNonceGap(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -14,7 +14,13 @@ func getInTrackerKey(chainID int64, txHash string) string {
 }
 
 // SetInTxTracker set a specific InTxTracker in the store from its index
+// The block header of the proof of the tracker is referenced until the tracker is removed
 func (k Keeper) SetInTxTracker(ctx sdk.Context, InTxTracker types.InTxTracker) {
+	if old, found := k.GetInTxTracker(ctx, InTxTracker.ChainId, InTxTracker.TxHash); found {
+		k.removeBlockHeaderReference(ctx, old.ChainId, old.BlockHash)
+	}
+	k.addBlockHeaderReference(ctx, InTxTracker.ChainId, InTxTracker.BlockHash)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InTxTrackerKeyPrefix))
 	b := k.cdc.MustMarshal(&InTxTracker)
 	key := types.KeyPrefix(getInTrackerKey(InTxTracker.ChainId, InTxTracker.TxHash))
@@ -34,12 +40,17 @@ func (k Keeper) GetInTxTracker(ctx sdk.Context, chainID int64, txHash string) (v
 }
 
 func (k Keeper) RemoveInTxTrackerIfExists(ctx sdk.Context, chainID int64, txHash string) {
+	tracker, found := k.GetInTxTracker(ctx, chainID, txHash)
+	if !found {
+		return
+	}
+	k.removeBlockHeaderReference(ctx, tracker.ChainId, tracker.BlockHash)
+
 	key := getInTrackerKey(chainID, txHash)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InTxTrackerKeyPrefix))
-	if store.Has(types.KeyPrefix(key)) {
-		store.Delete(types.KeyPrefix(key))
-	}
+	store.Delete(types.KeyPrefix(key))
 }
+
 func (k Keeper) GetAllInTxTrackerPaginated(ctx sdk.Context, pagination *query.PageRequest) (inTxTrackers []types.InTxTracker, pageRes *query.PageResponse, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InTxTrackerKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
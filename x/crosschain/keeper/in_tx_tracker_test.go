@@ -11,6 +11,7 @@ import (
 	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
@@ -101,3 +102,23 @@ func TestKeeper_GetAllInTxTrackerForChain(t *testing.T) {
 		require.Equal(t, 0, len(rst))
 	})
 }
+
+func TestKeeper_InTxTrackerBlockHeaderReference(t *testing.T) {
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+	chainID := common.GoerliChain().ChainId
+	blockHash := sample.Hash()
+	tracker := types.InTxTracker{
+		ChainId:   chainID,
+		TxHash:    sample.Hash().Hex(),
+		CoinType:  common.CoinType_Gas,
+		BlockHash: blockHash.Hex(),
+	}
+
+	// the block header is referenced once per tracker
+	k.SetInTxTracker(ctx, tracker)
+	k.SetInTxTracker(ctx, tracker)
+	require.EqualValues(t, 1, zk.ObserverKeeper.GetBlockHeaderReferenceCount(ctx, blockHash.Bytes()))
+
+	k.RemoveInTxTrackerIfExists(ctx, chainID, tracker.TxHash)
+	require.EqualValues(t, 0, zk.ObserverKeeper.GetBlockHeaderReferenceCount(ctx, blockHash.Bytes()))
+}
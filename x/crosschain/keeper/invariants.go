@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// CctxStatusInvariantName is the name of the invariant checking the consistency of the cctx status with its outbounds and nonce
+	CctxStatusInvariantName = "cctx-status"
+
+	// CctxBallotInvariantName is the name of the invariant checking the link between the cctxs and their ballots
+	CctxBallotInvariantName = "cctx-ballot"
+
+	// ZetaAccountingInvariantName is the name of the invariant checking the aborted zeta amount
+	ZetaAccountingInvariantName = "zeta-accounting"
+)
+
+// RegisterInvariants registers the crosschain module invariants
+// The invariants are not registered yet: the cctxs, ballots and aborted zeta amount written by the previous releases
+// may not satisfy them and a broken invariant halts the chain, they are run by the tests with AllInvariants until they
+// are checked against the state exported from the networks
+func RegisterInvariants(_ sdk.InvariantRegistry, _ Keeper) {}
+
+// AllInvariants runs all invariants of the crosschain module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := CctxStatusInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = CctxBallotInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ZetaAccountingInvariant(k)(ctx)
+	}
+}
+
+// CctxStatusInvariant checks that the outbounds of each cctx match its status
+// and that each pending cctx of the current TSS is mapped from its outbound nonce
+func CctxStatusInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		tss, tssFound := k.zetaObserverKeeper.GetTSS(ctx)
+		for _, cctx := range k.GetAllCrossChainTx(ctx) {
+			if cctx.CctxStatus == nil || cctx.InboundTxParams == nil || len(cctx.OutboundTxParams) == 0 {
+				broken = true
+				msg += fmt.Sprintf("\tcctx %s: missing status, inbound or outbound\n", cctx.Index)
+				continue
+			}
+
+			// a revert outbound is added when the status changes to pending revert
+			status := cctx.CctxStatus.Status
+			switch status {
+			case types.CctxStatus_PendingOutbound, types.CctxStatus_OutboundMined:
+				if len(cctx.OutboundTxParams) != 1 {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: status %s with %d outbounds\n", cctx.Index, status, len(cctx.OutboundTxParams))
+				}
+			case types.CctxStatus_PendingRevert, types.CctxStatus_Reverted:
+				if len(cctx.OutboundTxParams) != 2 {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: status %s with %d outbounds\n", cctx.Index, status, len(cctx.OutboundTxParams))
+				}
+			}
+
+			if !tssFound || (status != types.CctxStatus_PendingOutbound && status != types.CctxStatus_PendingRevert) {
+				continue
+			}
+			outbound := cctx.GetCurrentOutTxParam()
+			// revert outbounds don't record the TSS, they are signed by the current TSS
+			if outbound.TssPubkey != "" && outbound.TssPubkey != tss.TssPubkey {
+				continue
+			}
+			// #nosec G701 always in range
+			nonceToCctx, found := k.zetaObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, outbound.ReceiverChainId, int64(outbound.OutboundTxTssNonce))
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tcctx %s: pending with no mapping for nonce %d on chain %d\n", cctx.Index, outbound.OutboundTxTssNonce, outbound.ReceiverChainId)
+			} else if nonceToCctx.CctxIndex != cctx.Index {
+				broken = true
+				msg += fmt.Sprintf("\tcctx %s: nonce %d on chain %d mapped to cctx %s\n", cctx.Index, outbound.OutboundTxTssNonce, outbound.ReceiverChainId, nonceToCctx.CctxIndex)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, CctxStatusInvariantName, fmt.Sprintf("cctx status inconsistent:\n%s", msg)), broken
+	}
+}
+
+// CctxBallotInvariant checks that the ballots referenced by each cctx exist and are finalized
+// the inbound ballot must be finalized with a success observation as the cctx is created upon its finalization
+// the cctxs of the withdrawals from ZetaChain and of the proven inbound txs are created without ballot
+func CctxBallotInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, cctx := range k.GetAllCrossChainTx(ctx) {
+			if cctx.InboundTxParams != nil && cctx.InboundTxParams.InboundTxBallotIndex != "" &&
+				!cctx.InboundTxParams.InboundTxProven && !common.IsZetaChain(cctx.InboundTxParams.SenderChainId) {
+				ballot, found := k.zetaObserverKeeper.GetBallot(ctx, cctx.InboundTxParams.InboundTxBallotIndex)
+				if !found {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: inbound ballot %s not found\n", cctx.Index, cctx.InboundTxParams.InboundTxBallotIndex)
+				} else if ballot.BallotStatus != observertypes.BallotStatus_BallotFinalized_SuccessObservation {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: inbound ballot %s with status %s\n", cctx.Index, ballot.BallotIdentifier, ballot.BallotStatus)
+				}
+			}
+
+			for _, outbound := range cctx.OutboundTxParams {
+				if outbound == nil || outbound.OutboundTxBallotIndex == "" {
+					continue
+				}
+				ballot, found := k.zetaObserverKeeper.GetBallot(ctx, outbound.OutboundTxBallotIndex)
+				if !found {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: outbound ballot %s not found\n", cctx.Index, outbound.OutboundTxBallotIndex)
+				} else if ballot.BallotStatus == observertypes.BallotStatus_BallotInProgress {
+					broken = true
+					msg += fmt.Sprintf("\tcctx %s: outbound ballot %s not finalized\n", cctx.Index, ballot.BallotIdentifier)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, CctxBallotInvariantName, fmt.Sprintf("cctx ballots inconsistent:\n%s", msg)), broken
+	}
+}
+
+// ZetaAccountingInvariant checks that the aborted zeta amount is the sum of the amounts of the aborted zeta cctxs
+func ZetaAccountingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdkmath.ZeroUint()
+		for _, cctx := range k.GetAllCrossChainTx(ctx) {
+			outbound := cctx.GetCurrentOutTxParam()
+			if cctx.CctxStatus != nil && cctx.CctxStatus.Status == types.CctxStatus_Aborted && outbound.CoinType == common.CoinType_Zeta && !outbound.Amount.IsNil() {
+				expected = expected.Add(outbound.Amount)
+			}
+		}
+
+		actual := sdkmath.ZeroUint()
+		if zetaAccounting, found := k.GetZetaAccounting(ctx); found && !zetaAccounting.AbortedZetaAmount.IsNil() {
+			actual = zetaAccounting.AbortedZetaAmount
+		}
+
+		broken := !actual.Equal(expected)
+		return sdk.FormatInvariant(types.ModuleName, ZetaAccountingInvariantName, fmt.Sprintf("aborted zeta amount %s, sum of aborted zeta cctxs %s", actual, expected)), broken
+	}
+}
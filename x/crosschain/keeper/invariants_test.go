@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// newInvariantCctx returns a cctx with the given status and number of outbounds signed by the tss
+func newInvariantCctx(index string, status types.CctxStatus, outbounds int, tssPubkey string) types.CrossChainTx {
+	cctx := types.CrossChainTx{
+		Index:      index,
+		CctxStatus: &types.Status{Status: status},
+		InboundTxParams: &types.InboundTxParams{
+			InboundTxObservedHash: sample.Hash().Hex(),
+			CoinType:              common.CoinType_Zeta,
+			Amount:                sdkmath.NewUint(42),
+		},
+	}
+	for i := 0; i < outbounds; i++ {
+		cctx.OutboundTxParams = append(cctx.OutboundTxParams, &types.OutboundTxParams{
+			ReceiverChainId:    1337,
+			OutboundTxTssNonce: uint64(i),
+			CoinType:           common.CoinType_Zeta,
+			Amount:             sdkmath.NewUint(42),
+			TssPubkey:          tssPubkey,
+		})
+	}
+	return cctx
+}
+
+func TestKeeper_CctxStatusInvariant(t *testing.T) {
+	t.Run("should not be broken with consistent cctxs", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("pending", types.CctxStatus_PendingOutbound, 1, tss.TssPubkey))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("mined", types.CctxStatus_OutboundMined, 1, tss.TssPubkey))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("reverted", types.CctxStatus_Reverted, 2, tss.TssPubkey))
+
+		_, broken := keeper.CctxStatusInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the outbounds don't match the status", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetCrossChainTx(ctx, newInvariantCctx("reverted", types.CctxStatus_Reverted, 1, ""))
+
+		msg, broken := keeper.CctxStatusInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "with 1 outbounds")
+	})
+
+	t.Run("should be broken if a pending cctx has no nonce mapping", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		k.SetCrossChainTx(ctx, newInvariantCctx("pending", types.CctxStatus_PendingOutbound, 1, tss.TssPubkey))
+
+		msg, broken := keeper.CctxStatusInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "pending with no mapping")
+	})
+
+	t.Run("should be broken if the nonce is mapped to another cctx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("pending", types.CctxStatus_PendingOutbound, 1, tss.TssPubkey))
+		zk.ObserverKeeper.SetNonceToCctx(ctx, observertypes.NonceToCctx{
+			ChainId:   1337,
+			Nonce:     0,
+			CctxIndex: "other",
+			Tss:       tss.TssPubkey,
+		})
+
+		msg, broken := keeper.CctxStatusInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "mapped to cctx other")
+	})
+}
+
+func TestKeeper_CctxBallotInvariant(t *testing.T) {
+	setBallot := func(zk keepertest.ZetaKeepers, ctx sdk.Context, index string, status observertypes.BallotStatus) {
+		zk.ObserverKeeper.SetBallot(ctx, &observertypes.Ballot{
+			BallotIdentifier: index,
+			BallotStatus:     status,
+		})
+	}
+
+	t.Run("should not be broken if the ballots are finalized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setBallot(zk, ctx, "inbound", observertypes.BallotStatus_BallotFinalized_SuccessObservation)
+		setBallot(zk, ctx, "outbound", observertypes.BallotStatus_BallotFinalized_FailureObservation)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_PendingRevert, 2, "")
+		cctx.InboundTxParams.InboundTxBallotIndex = "inbound"
+		cctx.OutboundTxParams[0].OutboundTxBallotIndex = "outbound"
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the inbound ballot doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_PendingOutbound, 1, "")
+		cctx.InboundTxParams.InboundTxBallotIndex = "inbound"
+		k.SetCrossChainTx(ctx, cctx)
+
+		msg, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "inbound ballot inbound not found")
+	})
+
+	t.Run("should not be broken for the withdrawals from ZetaChain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_PendingOutbound, 1, "")
+		cctx.InboundTxParams.SenderChainId = common.ZetaPrivnetChain().ChainId
+		cctx.InboundTxParams.InboundTxBallotIndex = "cctx"
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should not be broken for the proven inbound txs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_PendingOutbound, 1, "")
+		cctx.InboundTxParams.InboundTxProven = true
+		cctx.InboundTxParams.InboundTxBallotIndex = "inbound"
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the inbound ballot is not a success", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setBallot(zk, ctx, "inbound", observertypes.BallotStatus_BallotInProgress)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_PendingOutbound, 1, "")
+		cctx.InboundTxParams.InboundTxBallotIndex = "inbound"
+		k.SetCrossChainTx(ctx, cctx)
+
+		msg, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "inbound ballot inbound with status")
+	})
+
+	t.Run("should be broken if the outbound ballot is not finalized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setBallot(zk, ctx, "outbound", observertypes.BallotStatus_BallotInProgress)
+		cctx := newInvariantCctx("cctx", types.CctxStatus_OutboundMined, 1, "")
+		cctx.OutboundTxParams[0].OutboundTxBallotIndex = "outbound"
+		k.SetCrossChainTx(ctx, cctx)
+
+		msg, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "outbound ballot outbound not finalized")
+	})
+}
+
+func TestKeeper_ZetaAccountingInvariant(t *testing.T) {
+	t.Run("should not be broken if the aborted amount is tracked", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("aborted1", types.CctxStatus_Aborted, 1, ""))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("aborted2", types.CctxStatus_Aborted, 2, ""))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("mined", types.CctxStatus_OutboundMined, 1, ""))
+
+		_, broken := keeper.ZetaAccountingInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the aborted amount is not tracked", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, newInvariantCctx("aborted1", types.CctxStatus_Aborted, 1, ""))
+		k.SetCrossChainTx(ctx, newInvariantCctx("aborted2", types.CctxStatus_Aborted, 1, ""))
+
+		msg, broken := keeper.ZetaAccountingInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "aborted zeta amount 42, sum of aborted zeta cctxs 84")
+	})
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// AddProvenInboundTx creates the cctxs of an inbound tx proven against a block header of the sender chain, without the
+// ballot of the observers. Any account can submit the proof of an inbound tx once the block header is confirmed: an
+// Ethereum block header must be finalized by the light client of the chain, a Bitcoin block header must be buried by
+// the confirmation count required for the amount of the inbounds.
+//
+// For the EVM chains, the proof of the tx and the proof of its receipt are verified against the block header, the
+// inbounds are the ZetaSent events of the connector, the Deposited events of the ERC20 custody and the gas token
+// transfer to the TSS address. The event index of an inbound is the index of its log in the block, following the
+// first log index of the message, as for the ballots of the observers. For Bitcoin, the tx must pay the TSS address in
+// its first output with a memo in its second output.
+//
+// The sender chain must be enabled for permissionless inbound in the block header verification flags. The cctxs are
+// processed as the cctxs of a finalized inbound ballot, and the ballot of the observers for the same inbound tx no
+// longer creates a cctx.
+func (k msgServer) AddProvenInboundTx(goCtx context.Context, msg *types.MsgAddProvenInboundTx) (*types.MsgAddProvenInboundTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.zetaObserverKeeper.IsInboundEnabled(ctx) {
+		return nil, types.ErrNotEnoughPermissions
+	}
+	crosschainFlags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if !found || !crosschainFlags.BlockHeaderVerificationFlags.IsPermissionlessInboundEnabled(msg.ChainId) {
+		return nil, types.ErrPermissionlessInboundNotEnabled.Wrapf("chain %d", msg.ChainId)
+	}
+	senderChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if senderChain == nil {
+		return nil, observertypes.ErrSupportedChains
+	}
+	if len(k.getInboundTxCctxs(ctx, msg.ChainId, msg.TxHash)) > 0 {
+		return nil, types.ErrInboundAlreadyFinalized.Wrapf("inbound tx %s of chain %d", msg.TxHash, msg.ChainId)
+	}
+
+	header, err := k.getProvableBlockHeader(ctx, msg.ChainId, msg.BlockHash)
+	if err != nil {
+		return nil, types.ErrProofVerificationFail.Wrapf(err.Error())
+	}
+	txBytes, err := msg.Proof.Verify(header.Header, int(msg.TxIndex))
+	if err != nil {
+		return nil, types.ErrProofVerificationFail.Wrapf(err.Error())
+	}
+
+	var inbounds []*types.MsgVoteOnObservedInboundTx
+	switch {
+	case common.IsEVMChain(msg.ChainId):
+		receiptBytes, err := msg.ReceiptProof.VerifyReceipt(header.Header, int(msg.TxIndex))
+		if err != nil {
+			return nil, types.ErrProofVerificationFail.Wrapf(err.Error())
+		}
+		inbounds, err = k.ParseProvenEVMInboundTx(ctx, msg, header.Height, txBytes, receiptBytes)
+		if err != nil {
+			return nil, types.ErrTxBodyVerificationFail.Wrapf(err.Error())
+		}
+	case common.IsBitcoinChain(msg.ChainId):
+		inbounds, err = k.ParseProvenBTCInboundTx(ctx, msg, header.Height, txBytes)
+		if err != nil {
+			return nil, types.ErrTxBodyVerificationFail.Wrapf(err.Error())
+		}
+	default:
+		return nil, types.ErrTxBodyVerificationFail.Wrapf(fmt.Sprintf("cannot verify inTx body for chain %d", msg.ChainId))
+	}
+	if len(inbounds) == 0 {
+		return nil, types.ErrNoInboundInTx.Wrapf("tx %s", msg.TxHash)
+	}
+	if err := k.checkProvenInboundConfirmed(ctx, header, inbounds); err != nil {
+		return nil, types.ErrProofVerificationFail.Wrapf(err.Error())
+	}
+
+	cctxIndexes := make([]string, 0, len(inbounds))
+	for _, inbound := range inbounds {
+		if err := inbound.ValidateBasic(); err != nil {
+			return nil, types.ErrTxBodyVerificationFail.Wrapf(err.Error())
+		}
+		receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(inbound.ReceiverChain)
+		if receiverChain == nil {
+			return nil, types.ErrUnsupportedChain.Wrapf("receiver chain %d", inbound.ReceiverChain)
+		}
+		cctx, err := k.FinalizeInboundTx(ctx, inbound, inbound.Digest(), senderChain, receiverChain, true)
+		if err != nil {
+			return nil, err
+		}
+		cctxIndexes = append(cctxIndexes, cctx.Index)
+	}
+	return &types.MsgAddProvenInboundTxResponse{CctxIndexes: cctxIndexes}, nil
+}
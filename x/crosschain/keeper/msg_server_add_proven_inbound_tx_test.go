@@ -0,0 +1,631 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/bitcoin"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	provenInboundConnector = "0x00000000000000000000000000000000000c0ffe"
+	provenInboundCustody   = "0x000000000000000000000000000000000000c057"
+
+	// provenInboundFirstLogIndex is the index in the block of the first log of the proven tx, after the logs of the
+	// tx before it
+	provenInboundFirstLogIndex = 3
+)
+
+// setupProvenInbound sets the zeta chain, the tss and the flags enabling the permissionless inbound of the chain
+func setupProvenInbound(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, chainID int64) (sdk.Context, *observertypes.QueryGetTssAddressResponse) {
+	ctx = ctx.WithChainID("athens_101-1")
+	zk.ObserverKeeper.SetParams(ctx, observertypes.DefaultParams())
+	zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+	zk.ObserverKeeper.SetCoreParams(ctx, observertypes.CoreParamsList{CoreParams: []*observertypes.CoreParams{
+		{
+			ChainId:                     chainID,
+			ConnectorContractAddress:    provenInboundConnector,
+			Erc20CustodyContractAddress: provenInboundCustody,
+			ConfirmationCount:           1,
+		},
+	}})
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{
+		IsInboundEnabled: true,
+		BlockHeaderVerificationFlags: &observertypes.BlockHeaderVerificationFlags{
+			IsEthTypeChainEnabled:         true,
+			IsBtcTypeChainEnabled:         true,
+			PermissionlessInboundChainIds: []int64{chainID},
+		},
+	})
+	tss, err := zk.ObserverKeeper.GetTssAddress(ctx, &observertypes.QueryGetTssAddressRequest{BitcoinChainId: common.BtcRegtestChain().ChainId})
+	require.NoError(t, err)
+	return ctx, tss
+}
+
+// signEVMTx returns a dynamic fee tx of the chain signed by a new key
+func signEVMTx(t *testing.T, chainID int64, to ethcommon.Address, value *big.Int, data []byte) (*ethtypes.Transaction, ethcommon.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := ethtypes.SignNewTx(key, ethtypes.NewLondonSigner(big.NewInt(chainID)), &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       100_000,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+	require.NoError(t, err)
+	return tx, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// provenEVMTx stores the header of a block with the tx at index 1 finalized by the light client of the chain, and
+// returns the message proving the tx and its receipt
+func provenEVMTx(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, chainID int64, tx *ethtypes.Transaction, logs []*ethtypes.Log) *types.MsgAddProvenInboundTx {
+	msg := unfinalizedProvenEVMTx(t, ctx, zk, chainID, tx, logs)
+	zk.ObserverKeeper.SetLightClientState(ctx, observertypes.LightClientState{ChainId: chainID})
+	zk.ObserverKeeper.SetFinalizedExecutionBlock(ctx, ethcommon.HexToHash(msg.BlockHash).Bytes(), 100)
+	return msg
+}
+
+// unfinalizedProvenEVMTx stores the header of a block with the tx at index 1, and returns the message proving the tx and its receipt
+func unfinalizedProvenEVMTx(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, chainID int64, tx *ethtypes.Transaction, logs []*ethtypes.Log) *types.MsgAddProvenInboundTx {
+	filler, _ := signEVMTx(t, chainID, sample.EthAddress(), big.NewInt(1), nil)
+	fillerLogs := make([]*ethtypes.Log, provenInboundFirstLogIndex)
+	for i := range fillerLogs {
+		fillerLogs[i] = &ethtypes.Log{Address: sample.EthAddress(), Topics: []ethcommon.Hash{sample.Hash()}}
+	}
+	txs := ethtypes.Transactions{filler, tx}
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21_000, Logs: fillerLogs},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 80_000, Logs: logs},
+	}
+	txTrie := ethereum.NewTrie(txs)
+	receiptTrie := ethereum.NewTrie(receipts)
+	header := ethtypes.Header{
+		ParentHash:  sample.Hash(),
+		Number:      big.NewInt(100),
+		Difficulty:  big.NewInt(0),
+		TxHash:      txTrie.Hash(),
+		ReceiptHash: receiptTrie.Hash(),
+	}
+	headerRLP, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+	zk.ObserverKeeper.SetBlockHeader(ctx, common.BlockHeader{
+		Height:     header.Number.Int64(),
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+		ChainId:    chainID,
+		Header:     common.NewEthereumHeader(headerRLP),
+	})
+
+	txProof, err := txTrie.GenerateProof(1)
+	require.NoError(t, err)
+	receiptProof, err := receiptTrie.GenerateProof(1)
+	require.NoError(t, err)
+	return types.NewMsgAddProvenInboundTx(
+		sample.AccAddress(),
+		chainID,
+		tx.Hash().Hex(),
+		common.NewEthereumProof(txProof),
+		header.Hash().Hex(),
+		1,
+		common.NewEthereumProof(receiptProof),
+		provenInboundFirstLogIndex,
+	)
+}
+
+// zetaSentLog returns a ZetaSent log of the connector
+func zetaSentLog(t *testing.T, sender ethcommon.Address, destChainID int64, destAddress ethcommon.Address, amount *big.Int) *ethtypes.Log {
+	abi, err := zetaconnector.ZetaConnectorNonEthMetaData.GetAbi()
+	require.NoError(t, err)
+	event := abi.Events["ZetaSent"]
+	data, err := event.Inputs.NonIndexed().Pack(sender, destAddress.Bytes(), amount, big.NewInt(250_000), []byte("hello"), []byte{})
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: ethcommon.HexToAddress(provenInboundConnector),
+		Topics: []ethcommon.Hash{
+			event.ID,
+			ethcommon.BytesToHash(sender.Bytes()),
+			ethcommon.BigToHash(big.NewInt(destChainID)),
+		},
+		Data: data,
+	}
+}
+
+// depositedLog returns a Deposited log of the ERC20 custody
+func depositedLog(t *testing.T, asset ethcommon.Address, recipient ethcommon.Address, amount *big.Int) *ethtypes.Log {
+	abi, err := erc20custody.ERC20CustodyMetaData.GetAbi()
+	require.NoError(t, err)
+	event := abi.Events["Deposited"]
+	data, err := event.Inputs.NonIndexed().Pack(recipient.Bytes(), amount, []byte{})
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: ethcommon.HexToAddress(provenInboundCustody),
+		Topics:  []ethcommon.Hash{event.ID, ethcommon.BytesToHash(asset.Bytes())},
+		Data:    data,
+	}
+}
+
+// provenBTCTx stores the confirmed header of a block with the tx at index 1, and returns the message proving the tx
+func provenBTCTx(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, tx *wire.MsgTx) *types.MsgAddProvenInboundTx {
+	chainID := common.BtcRegtestChain().ChainId
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), []byte{0x01, 0x02}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(50_000_000, []byte{txscript.OP_TRUE}))
+	txs := []*btcutil.Tx{btcutil.NewTx(coinbase), btcutil.NewTx(tx)}
+
+	merkles := blockchain.BuildMerkleTreeStore(txs, false)
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash(sample.Hash()),
+		MerkleRoot: *merkles[len(merkles)-1],
+		Bits:       0x207fffff,
+	}
+	var headerBytes bytes.Buffer
+	require.NoError(t, header.Serialize(&headerBytes))
+	blockHash := header.BlockHash()
+	zk.ObserverKeeper.SetBlockHeader(ctx, common.BlockHeader{
+		Height:     100,
+		Hash:       blockHash[:],
+		ParentHash: header.PrevBlock[:],
+		ChainId:    chainID,
+		Header:     common.NewBitcoinHeader(headerBytes.Bytes()),
+	})
+	zk.ObserverKeeper.SetBestChainBlockHash(ctx, chainID, 100, blockHash[:])
+	zk.ObserverKeeper.SetBlockHeaderState(ctx, observertypes.BlockHeaderState{
+		ChainId:         chainID,
+		EarliestHeight:  100,
+		LatestHeight:    100,
+		LatestBlockHash: blockHash[:],
+	})
+
+	path, index, err := bitcoin.NewMerkle(txs).BuildMerkleProof(1)
+	require.NoError(t, err)
+	var txBytes bytes.Buffer
+	require.NoError(t, tx.Serialize(&txBytes))
+	return types.NewMsgAddProvenInboundTx(
+		sample.AccAddress(),
+		chainID,
+		tx.TxHash().String(),
+		common.NewBitcoinProof(txBytes.Bytes(), path, index),
+		blockHash.String(),
+		1,
+		nil,
+		0,
+	)
+}
+
+// btcDepositTx returns a bitcoin tx depositing to the TSS address with a memo, and its sender address
+func btcDepositTx(t *testing.T, tssAddress string, amount int64, memo []byte) (*wire.MsgTx, string) {
+	netParams, err := common.BitcoinNetParamsFromChainID(common.BtcRegtestChain().ChainId)
+	require.NoError(t, err)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := crypto.CompressPubkey(&key.PublicKey)
+	sender, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), netParams)
+	require.NoError(t, err)
+
+	tss, err := btcutil.DecodeAddress(tssAddress, netParams)
+	require.NoError(t, err)
+	tssScript, err := txscript.PayToAddrScript(tss)
+	require.NoError(t, err)
+	memoScript, err := txscript.NullDataScript(memo)
+	require.NoError(t, err)
+
+	sig, err := (*btcec.PrivateKey)(key).Sign(chainhash.DoubleHashB([]byte("deposit")))
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	txIn := wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, 0), nil, nil)
+	txIn.Witness = wire.TxWitness{append(sig.Serialize(), byte(txscript.SigHashAll)), pubKey}
+	tx.AddTxIn(txIn)
+	tx.AddTxOut(wire.NewTxOut(amount, tssScript))
+	tx.AddTxOut(wire.NewTxOut(0, memoScript))
+	return tx, sender.EncodeAddress()
+}
+
+func TestMsgServer_AddProvenInboundTx(t *testing.T) {
+	chainID := getValidEthChainID(t)
+
+	t.Run("should create the cctx of a gas token transfer to the tss", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, from := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+
+		res, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 1)
+
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.True(t, cctx.InboundTxParams.InboundTxProven)
+		require.Empty(t, cctx.InboundTxParams.InboundTxBallotIndex)
+		require.Equal(t, from.Hex(), cctx.InboundTxParams.Sender)
+		require.Equal(t, chainID, cctx.InboundTxParams.SenderChainId)
+		require.Equal(t, common.CoinType_Gas, cctx.InboundTxParams.CoinType)
+		require.EqualValues(t, 42, cctx.InboundTxParams.Amount.Uint64())
+		require.EqualValues(t, 100, cctx.InboundTxParams.InboundTxObservedExternalHeight)
+		require.Equal(t, msg.Creator, cctx.Creator)
+		require.True(t, k.IsInboundTxProven(ctx, chainID, tx.Hash().Hex()))
+
+		// the cctx references no ballot
+		_, broken := keeper.CctxBallotInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should create the cctxs of the connector and custody events", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, _ = setupProvenInbound(t, ctx, zk, chainID)
+		sender, receiver, asset := sample.EthAddress(), sample.EthAddress(), sample.EthAddress()
+		tx, from := signEVMTx(t, chainID, ethcommon.HexToAddress(provenInboundConnector), big.NewInt(0), []byte{0x01})
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{
+			zetaSentLog(t, sender, common.ZetaPrivnetChain().ChainId, receiver, big.NewInt(1000)),
+			depositedLog(t, asset, receiver, big.NewInt(2000)),
+		})
+
+		res, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 2)
+
+		zetaSent, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.Equal(t, common.CoinType_Zeta, zetaSent.InboundTxParams.CoinType)
+		require.Equal(t, sender.Hex(), zetaSent.InboundTxParams.Sender)
+		require.EqualValues(t, 1000, zetaSent.InboundTxParams.Amount.Uint64())
+		require.Equal(t, common.ZetaPrivnetChain().ChainId, zetaSent.GetCurrentOutTxParam().ReceiverChainId)
+
+		deposited, found := k.GetCrossChainTx(ctx, res.CctxIndexes[1])
+		require.True(t, found)
+		require.Equal(t, common.CoinType_ERC20, deposited.InboundTxParams.CoinType)
+		require.Equal(t, from.Hex(), deposited.InboundTxParams.Sender)
+		require.Equal(t, asset.String(), deposited.InboundTxParams.Asset)
+		require.EqualValues(t, 2000, deposited.InboundTxParams.Amount.Uint64())
+
+		inTxHashToCctx, found := k.GetInTxHashToCctx(ctx, tx.Hash().Hex())
+		require.True(t, found)
+		require.ElementsMatch(t, res.CctxIndexes, inTxHashToCctx.CctxIndex)
+	})
+
+	t.Run("should create the cctx of a bitcoin deposit to the tss", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		btcChainID := common.BtcRegtestChain().ChainId
+		ctx, tss := setupProvenInbound(t, ctx, zk, btcChainID)
+		tx, sender := btcDepositTx(t, tss.Btc, 100_000, []byte("memo"))
+		msg := provenBTCTx(t, ctx, zk, tx)
+
+		res, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Len(t, res.CctxIndexes, 1)
+
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.True(t, cctx.InboundTxParams.InboundTxProven)
+		require.Equal(t, sender, cctx.InboundTxParams.Sender)
+		require.Equal(t, btcChainID, cctx.InboundTxParams.SenderChainId)
+		require.Equal(t, tx.TxHash().String(), cctx.InboundTxParams.InboundTxObservedHash)
+		require.EqualValues(t, 100_000-745, cctx.InboundTxParams.Amount.Uint64())
+		require.Equal(t, "6d656d6f", cctx.RelayedMessage)
+	})
+
+	t.Run("should fail if the first input of a bitcoin deposit doesn't spend a P2WPKH output", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		btcChainID := common.BtcRegtestChain().ChainId
+		ctx, tss := setupProvenInbound(t, ctx, zk, btcChainID)
+
+		// a P2WSH input spending a 1-of-2 multisig script with two witness items
+		tx, _ := btcDepositTx(t, tss.Btc, 100_000, []byte("memo"))
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+		builder := txscript.NewScriptBuilder().AddOp(txscript.OP_1)
+		builder.AddData(key.PubKey().SerializeCompressed()).AddData(tx.TxIn[0].Witness[1])
+		witnessScript, err := builder.AddOp(txscript.OP_2).AddOp(txscript.OP_CHECKMULTISIG).Script()
+		require.NoError(t, err)
+		tx.TxIn[0].Witness = wire.TxWitness{tx.TxIn[0].Witness[0], witnessScript}
+		msg := provenBTCTx(t, ctx, zk, tx)
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+		require.ErrorContains(t, err, "doesn't spend a P2WPKH output")
+
+		// a witness of two items that are not a signature and a public key
+		tx, _ = btcDepositTx(t, tss.Btc, 100_000, []byte("memo"))
+		tx.TxIn[0].Witness = wire.TxWitness{[]byte{0x01}, bytes.Repeat([]byte{0x02}, 33)}
+		msg = provenBTCTx(t, ctx, zk, tx)
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+		require.ErrorContains(t, err, "doesn't spend a P2WPKH output")
+
+		// a P2SH wrapped input
+		tx, _ = btcDepositTx(t, tss.Btc, 100_000, []byte("memo"))
+		tx.TxIn[0].SignatureScript = []byte{txscript.OP_0}
+		msg = provenBTCTx(t, ctx, zk, tx)
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+		require.ErrorContains(t, err, "doesn't spend a P2WPKH output")
+	})
+
+	t.Run("should require the confirmation count of the amount of a bitcoin deposit", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		btcChainID := common.BtcRegtestChain().ChainId
+		ctx, tss := setupProvenInbound(t, ctx, zk, btcChainID)
+		zk.ObserverKeeper.SetCoreParams(ctx, observertypes.CoreParamsList{CoreParams: []*observertypes.CoreParams{
+			{
+				ChainId:           btcChainID,
+				ConfirmationCount: 1,
+				ConfirmationTiers: []observertypes.ConfirmationTier{
+					observertypes.NewConfirmationTier(common.CoinType_Gas, "", sdk.MustNewDecFromStr("0.005"), 2),
+				},
+			},
+		}})
+		small, _ := btcDepositTx(t, tss.Btc, 100_000, []byte("memo"))
+		large, _ := btcDepositTx(t, tss.Btc, 1_000_000, []byte("memo"))
+
+		// the max confirmation count is required while the decimals of the ZRC20 are unknown
+		msg := provenBTCTx(t, ctx, zk, small)
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		require.ErrorContains(t, err, "1 confirmations, 2 required")
+
+		zk.FungibleKeeper.SetForeignCoins(ctx, fungibletypes.ForeignCoins{
+			Zrc20ContractAddress: sample.EthAddress().Hex(),
+			ForeignChainId:       btcChainID,
+			Decimals:             8,
+			CoinType:             common.CoinType_Gas,
+		})
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		msg = provenBTCTx(t, ctx, zk, large)
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		require.ErrorContains(t, err, "1 confirmations, 2 required")
+	})
+
+	t.Run("should fail if the evm block header is not finalized by the light client", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		zk.ObserverKeeper.RemoveFinalizedExecutionBlock(ctx, ethcommon.HexToHash(msg.BlockHash).Bytes())
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		require.ErrorContains(t, err, "not finalized by the light client")
+	})
+
+	t.Run("should fail if the evm chain has no light client", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := unfinalizedProvenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		require.ErrorContains(t, err, "light client not initialized")
+	})
+
+	t.Run("should remove the inbound tracker of the tx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		k.SetInTxTracker(ctx, types.InTxTracker{ChainId: chainID, TxHash: msg.TxHash, CoinType: common.CoinType_Gas})
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		_, found := k.GetInTxTracker(ctx, chainID, msg.TxHash)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if the permissionless inbound is not enabled for the chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		flags, _ := zk.ObserverKeeper.GetCrosschainFlags(ctx)
+		flags.BlockHeaderVerificationFlags.PermissionlessInboundChainIds = nil
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, flags)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrPermissionlessInboundNotEnabled)
+	})
+
+	t.Run("should fail if the inbound is already finalized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		_, err = keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrInboundAlreadyFinalized)
+	})
+
+	t.Run("should fail if the tx has no inbound", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, _ = setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, sample.EthAddress(), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrNoInboundInTx)
+	})
+
+	t.Run("should fail if the receipt proof is invalid", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		msg.ReceiptProof = msg.Proof
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the block header is of another chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		header, found := zk.ObserverKeeper.GetBlockHeader(ctx, ethcommon.HexToHash(msg.BlockHash).Bytes())
+		require.True(t, found)
+		header.ChainId = common.BscMainnetChain().ChainId
+		zk.ObserverKeeper.SetBlockHeader(ctx, header)
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+		require.ErrorContains(t, err, "not of chain")
+	})
+
+	t.Run("should fail if the tx hash doesn't match the proven tx", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		msg.TxHash = sample.Hash().Hex()
+
+		_, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrTxBodyVerificationFail)
+	})
+}
+
+func TestMsgServer_VoteOnObservedInboundTxProven(t *testing.T) {
+	t.Run("should not create a cctx when the ballot of a proven inbound is finalized", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		ctx, tss := setupProvenInbound(t, ctx, zk, chainID)
+
+		// a single observer finalizes the ballot
+		validator := sample.Validator(t, rand.New(rand.NewSource(42)))
+		sdkk.StakingKeeper.SetValidator(ctx, validator)
+		observer := sdk.AccAddress(validator.GetOperator()).String()
+		zk.ObserverKeeper.SetObserverMapper(ctx, &observertypes.ObserverMapper{
+			Index:         "proven",
+			ObserverChain: zk.ObserverKeeper.GetParams(ctx).GetChainFromChainID(chainID),
+			ObserverList:  []string{observer},
+		})
+
+		tx, from := signEVMTx(t, chainID, ethcommon.HexToAddress(tss.Eth), big.NewInt(42), nil)
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{})
+		res, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		vote := types.NewMsgVoteOnObservedInboundTx(
+			observer,
+			from.Hex(),
+			chainID,
+			from.Hex(),
+			from.Hex(),
+			common.ZetaPrivnetChain().ChainId,
+			sdk.NewUint(42),
+			"",
+			tx.Hash().Hex(),
+			100,
+			90_000,
+			common.CoinType_Gas,
+			"",
+			7,
+		)
+		_, err = keeper.NewMsgServerImpl(*k).VoteOnObservedInboundTx(sdk.WrapSDKContext(ctx), vote)
+		require.NoError(t, err)
+
+		_, found := k.GetCrossChainTx(ctx, vote.Digest())
+		require.False(t, found)
+		inTxHashToCctx, found := k.GetInTxHashToCctx(ctx, tx.Hash().Hex())
+		require.True(t, found)
+		require.Equal(t, res.CctxIndexes, inTxHashToCctx.CctxIndex)
+	})
+}
+
+func TestMsgServer_AddProvenInboundTxAndVote(t *testing.T) {
+	t.Run("should create a single cctx for an event both voted by the observers and proven", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		ctx, _ = setupProvenInbound(t, ctx, zk, chainID)
+
+		// two observers are required to finalize the ballot
+		r := rand.New(rand.NewSource(42))
+		var observers []string
+		for i := 0; i < 2; i++ {
+			validator := sample.Validator(t, r)
+			sdkk.StakingKeeper.SetValidator(ctx, validator)
+			observers = append(observers, sdk.AccAddress(validator.GetOperator()).String())
+		}
+		zk.ObserverKeeper.SetObserverMapper(ctx, &observertypes.ObserverMapper{
+			Index:         "proven",
+			ObserverChain: zk.ObserverKeeper.GetParams(ctx).GetChainFromChainID(chainID),
+			ObserverList:  observers,
+		})
+
+		sender, receiver := sample.EthAddress(), sample.EthAddress()
+		tx, _ := signEVMTx(t, chainID, ethcommon.HexToAddress(provenInboundConnector), big.NewInt(0), []byte{0x01})
+		msg := provenEVMTx(t, ctx, zk, chainID, tx, []*ethtypes.Log{
+			zetaSentLog(t, sender, common.ZetaPrivnetChain().ChainId, receiver, big.NewInt(1000)),
+		})
+
+		// the vote of the observers with the index of the log in the block
+		vote := func(observer string) *types.MsgVoteOnObservedInboundTx {
+			return types.NewMsgVoteOnObservedInboundTx(
+				observer,
+				sender.Hex(),
+				chainID,
+				sender.Hex(),
+				"0x"+ethcommon.Bytes2Hex(receiver.Bytes()),
+				common.ZetaPrivnetChain().ChainId,
+				sdk.NewUint(1000),
+				base64.StdEncoding.EncodeToString([]byte("hello")),
+				tx.Hash().Hex(),
+				100,
+				250_000,
+				common.CoinType_Zeta,
+				"",
+				provenInboundFirstLogIndex,
+			)
+		}
+		_, err := keeper.NewMsgServerImpl(*k).VoteOnObservedInboundTx(sdk.WrapSDKContext(ctx), vote(observers[0]))
+		require.NoError(t, err)
+
+		// the proven inbound has the index of the ballot
+		res, err := keeper.NewMsgServerImpl(*k).AddProvenInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Equal(t, []string{vote(observers[0]).Digest()}, res.CctxIndexes)
+
+		// the finalized ballot doesn't create another cctx
+		_, err = keeper.NewMsgServerImpl(*k).VoteOnObservedInboundTx(sdk.WrapSDKContext(ctx), vote(observers[1]))
+		require.NoError(t, err)
+		inTxHashToCctx, found := k.GetInTxHashToCctx(ctx, tx.Hash().Hex())
+		require.True(t, found)
+		require.Equal(t, res.CctxIndexes, inTxHashToCctx.CctxIndex)
+		cctx, found := k.GetCrossChainTx(ctx, res.CctxIndexes[0])
+		require.True(t, found)
+		require.True(t, cctx.InboundTxParams.InboundTxProven)
+		require.Empty(t, cctx.InboundTxParams.InboundTxBallotIndex)
+	})
+}
@@ -47,10 +47,14 @@ func (k msgServer) AddToInTxTracker(goCtx context.Context, msg *types.MsgAddToIn
 		return nil, errorsmod.Wrap(observertypes.ErrNotAuthorized, fmt.Sprintf("Creator %s", msg.Creator))
 	}
 
-	k.SetInTxTracker(ctx, types.InTxTracker{
+	tracker := types.InTxTracker{
 		ChainId:  msg.ChainId,
 		TxHash:   msg.TxHash,
 		CoinType: msg.CoinType,
-	})
+	}
+	if isProven {
+		tracker.BlockHash = msg.BlockHash
+	}
+	k.SetInTxTracker(ctx, tracker)
 	return &types.MsgAddToInTxTrackerResponse{}, nil
 }
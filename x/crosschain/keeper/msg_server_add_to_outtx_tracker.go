@@ -69,6 +69,10 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 		TxSigner: msg.Creator,
 	}
 	if !found {
+		if isProven {
+			hash.Proved = true
+			hash.BlockHash = msg.BlockHash
+		}
 		k.SetOutTxTracker(ctx, types.OutTxTracker{
 			Index:    "",
 			ChainId:  chain.ChainId,
@@ -85,6 +89,7 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 			isDup = true
 			if isProven {
 				hash.Proved = true
+				hash.BlockHash = msg.BlockHash
 				k.SetOutTxTracker(ctx, tracker)
 				k.Logger(ctx).Info("Proof'd outbound transaction")
 				return &types.MsgAddToOutTxTrackerResponse{}, nil
@@ -95,6 +100,7 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 	if !isDup {
 		if isProven {
 			hash.Proved = true
+			hash.BlockHash = msg.BlockHash
 			tracker.HashList = append([]*types.TxHashList{&hash}, tracker.HashList...)
 			k.Logger(ctx).Info("Proof'd outbound transaction")
 		} else if len(tracker.HashList) < 2 {
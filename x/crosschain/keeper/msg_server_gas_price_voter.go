@@ -37,21 +37,28 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 	gasPrice, isFound := k.GetGasPrice(ctx, chain.ChainId)
 	if !isFound {
 		gasPrice = types.GasPrice{
-			Creator:     msg.Creator,
-			Index:       strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
-			ChainId:     chain.ChainId,
-			Prices:      []uint64{msg.Price},
-			BlockNums:   []uint64{msg.BlockNumber},
-			Signers:     []string{msg.Creator},
-			MedianIndex: 0,
+			Creator:      msg.Creator,
+			Index:        strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
+			ChainId:      chain.ChainId,
+			Prices:       []uint64{msg.Price},
+			PriorityFees: []uint64{msg.PriorityFee},
+			BlockNums:    []uint64{msg.BlockNumber},
+			Signers:      []string{msg.Creator},
+			MedianIndex:  0,
 		}
 	} else {
+		// the gas prices voted before the priority fees have no priority fee
+		for len(gasPrice.PriorityFees) < len(gasPrice.Prices) {
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, 0)
+		}
+
 		signers := gasPrice.Signers
 		exist := false
 		for i, s := range signers {
 			if s == msg.Creator { // update existing entry
 				gasPrice.BlockNums[i] = msg.BlockNumber
 				gasPrice.Prices[i] = msg.Price
+				gasPrice.PriorityFees[i] = msg.PriorityFee
 				exist = true
 				break
 			}
@@ -60,6 +67,7 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 			gasPrice.Signers = append(gasPrice.Signers, msg.Creator)
 			gasPrice.BlockNums = append(gasPrice.BlockNums, msg.BlockNumber)
 			gasPrice.Prices = append(gasPrice.Prices, msg.Price)
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, msg.PriorityFee)
 		}
 		// recompute the median gas price
 		mi := medianOfArray(gasPrice.Prices)
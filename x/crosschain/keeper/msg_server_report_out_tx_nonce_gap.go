@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// ReportOutTxNonceGap records that a zetaclient found an outbound nonce blocking the TSS account of an EVM chain
+// and either re-prioritized it or replaced it with a cancel transaction.
+// Only the observer validators of the chain are authorized to broadcast this message.
+// A cancellation can only be reported once the cctx has been pending for NonceGapCancelTimeout since its last status
+// update. The report is stored with the reports of the other observers for the chain and the nonce until the outbound
+// of the nonce is observed, and emitted as an event so that the self-healing actions can be audited.
+func (k msgServer) ReportOutTxNonceGap(goCtx context.Context, msg *types.MsgReportOutTxNonceGap) (*types.MsgReportOutTxNonceGapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	chain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, observertypes.ErrSupportedChains
+	}
+	if !k.zetaObserverKeeper.IsAuthorized(ctx, msg.Creator, chain) {
+		return nil, cosmoserrors.Wrap(observertypes.ErrNotAuthorized, fmt.Sprintf("Creator %s", msg.Creator))
+	}
+
+	cctx, err := k.CctxByNonce(ctx, &types.QueryGetCctxByNonceRequest{
+		ChainID: msg.ChainId,
+		Nonce:   msg.Nonce,
+	})
+	if err != nil || cctx == nil || cctx.CrossChainTx == nil {
+		return nil, cosmoserrors.Wrap(types.ErrCannotFindCctx, "cannot report nonce gap: no corresponding cctx found")
+	}
+	if !IsPending(*cctx.CrossChainTx) {
+		return nil, cosmoserrors.Wrapf(types.ErrInvalidNonceGapReport, "cctx %s is not pending", cctx.CrossChainTx.Index)
+	}
+
+	if msg.Action == types.OutTxNonceGapAction_Cancelled && !cctx.CrossChainTx.IsNonceGapCancelDue(ctx.BlockTime()) {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidNonceGapReport,
+			"cctx %s can't be cancelled before %s",
+			cctx.CrossChainTx.Index,
+			time.Unix(cctx.CrossChainTx.CctxStatus.LastUpdateTimestamp, 0).Add(types.NonceGapCancelTimeout).UTC(),
+		)
+	}
+
+	k.AddOutTxNonceGapReport(ctx, msg.ChainId, msg.Nonce, cctx.CrossChainTx.Index, types.OutTxNonceGapReport{
+		Reporter:     msg.Creator,
+		TssNonce:     msg.TssNonce,
+		Cause:        msg.Cause,
+		Action:       msg.Action,
+		CancelTxHash: msg.CancelTxHash,
+		BlockHeight:  ctx.BlockHeight(),
+	})
+	EmitOutTxNonceGapReported(ctx, msg, *cctx.CrossChainTx)
+	return &types.MsgReportOutTxNonceGapResponse{}, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_ReportOutTxNonceGap(t *testing.T) {
+	chain := getValidEthChain(t)
+	tss := sample.Tss()
+	lastUpdate := time.Unix(1_700_000_000, 0)
+
+	setup := func(t *testing.T, status types.CctxStatus, authorized bool) (*keeper.Keeper, sdk.Context, *types.MsgReportOutTxNonceGap) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+
+		cctx := sample.CrossChainTx(t, "foo")
+		cctx.CctxStatus.Status = status
+		cctx.CctxStatus.LastUpdateTimestamp = lastUpdate.Unix()
+		k.SetCrossChainTx(ctx, *cctx)
+		ctx = ctx.WithBlockTime(lastUpdate.Add(types.NonceGapCancelTimeout + time.Second))
+
+		observerMock.On("GetParams", mock.Anything).Return(observertypes.Params{
+			ObserverParams: []*observertypes.ObserverParams{{Chain: chain, IsSupported: true}},
+		})
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything, chain).Return(authorized).Maybe()
+		observerMock.On("GetTSS", mock.Anything).Return(tss, true).Maybe()
+		observerMock.On("GetNonceToCctx", mock.Anything, tss.TssPubkey, chain.ChainId, int64(7)).Return(observertypes.NonceToCctx{
+			ChainId:   chain.ChainId,
+			Nonce:     7,
+			CctxIndex: cctx.Index,
+			Tss:       tss.TssPubkey,
+		}, true).Maybe()
+
+		msg := types.NewMsgReportOutTxNonceGap(
+			sample.AccAddress(),
+			chain.ChainId,
+			7,
+			7,
+			types.OutTxNonceGapCause_NotMined,
+			types.OutTxNonceGapAction_Cancelled,
+			sample.Hash().Hex(),
+		)
+		return k, ctx, msg
+	}
+
+	t.Run("stores and emits the report for a pending cctx", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, true)
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.NoError(t, err)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "zetachain.zetacore.crosschain.EventOutTxNonceGapReported", events[0].Type)
+
+		gap, found := k.GetOutTxNonceGap(ctx, chain.ChainId, 7)
+		require.True(t, found)
+		require.Equal(t, "foo", gap.CctxIndex)
+		require.Equal(t, []types.OutTxNonceGapReport{{
+			Reporter:     msg.Creator,
+			TssNonce:     msg.TssNonce,
+			Cause:        msg.Cause,
+			Action:       msg.Action,
+			CancelTxHash: msg.CancelTxHash,
+			BlockHeight:  ctx.BlockHeight(),
+		}}, gap.Reports)
+	})
+
+	t.Run("stores a re-prioritization before the cancel timeout", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, true)
+		ctx = ctx.WithBlockTime(lastUpdate)
+		msg.Action = types.OutTxNonceGapAction_Reprioritized
+		msg.CancelTxHash = ""
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.NoError(t, err)
+
+		gap, found := k.GetOutTxNonceGap(ctx, chain.ChainId, 7)
+		require.True(t, found)
+		require.Len(t, gap.Reports, 1)
+		require.Equal(t, types.OutTxNonceGapAction_Reprioritized, gap.Reports[0].Action)
+	})
+
+	t.Run("fails to report a cancellation before the cancel timeout", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, true)
+		ctx = ctx.WithBlockTime(lastUpdate.Add(types.NonceGapCancelTimeout))
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidNonceGapReport)
+		require.Empty(t, ctx.EventManager().Events())
+
+		_, found := k.GetOutTxNonceGap(ctx, chain.ChainId, 7)
+		require.False(t, found)
+	})
+
+	t.Run("fails for an unsupported chain", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, true)
+		msg.ChainId = common.BtcMainnetChain().ChainId
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.ErrorIs(t, err, observertypes.ErrSupportedChains)
+	})
+
+	t.Run("fails if the creator is not an observer", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, false)
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.ErrorIs(t, err, observertypes.ErrNotAuthorized)
+	})
+
+	t.Run("fails if no cctx is assigned to the nonce", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_PendingOutbound, true)
+		keepertest.GetCrosschainObserverMock(t, k).On("GetNonceToCctx", mock.Anything, tss.TssPubkey, chain.ChainId, int64(8)).
+			Return(observertypes.NonceToCctx{}, false)
+		msg.Nonce = 8
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.ErrorIs(t, err, types.ErrCannotFindCctx)
+	})
+
+	t.Run("fails if the cctx is no longer pending", func(t *testing.T) {
+		k, ctx, msg := setup(t, types.CctxStatus_OutboundMined, true)
+		_, err := keeper.NewMsgServerImpl(*k).ReportOutTxNonceGap(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidNonceGapReport)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+}
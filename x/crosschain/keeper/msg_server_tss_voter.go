@@ -49,7 +49,15 @@ func (k msgServer) CreateTSSVoter(goCtx context.Context, msg *types.MsgCreateTSS
 	if !found {
 		var voterList []string
 
+		// only the grantees of the keygen take part in the ceremony, the nodes excluded from a keygen retry do not vote
+		grantees := make(map[string]bool, len(keygen.GranteePubkeys))
+		for _, pubkey := range keygen.GranteePubkeys {
+			grantees[pubkey] = true
+		}
 		for _, nodeAccount := range k.zetaObserverKeeper.GetAllNodeAccount(ctx) {
+			if nodeAccount.GranteePubkey != nil && !grantees[nodeAccount.GranteePubkey.Secp256k1.String()] {
+				continue
+			}
 			voterList = append(voterList, nodeAccount.Operator)
 		}
 		ballot = observertypes.Ballot{
@@ -76,6 +84,7 @@ func (k msgServer) CreateTSSVoter(goCtx context.Context, msg *types.MsgCreateTSS
 			return &types.MsgCreateTSSVoterResponse{}, err
 		}
 	}
+	k.zetaObserverKeeper.RecordKeygenReport(ctx, msg.Creator, msg.Status, msg.TssPubkey)
 	if !found {
 		keeper.EmitEventBallotCreated(ctx, ballot, msg.TssPubkey, "Common-TSS-For-All-Chain")
 	}
@@ -111,6 +120,7 @@ func (k msgServer) CreateTSSVoter(goCtx context.Context, msg *types.MsgCreateTSS
 		keygen.BlockNumber = math2.MaxInt64
 	}
 	k.zetaObserverKeeper.SetKeygen(ctx, keygen)
+	k.zetaObserverKeeper.FinalizeKeygenAttempt(ctx, keygen.Status == observertypes.KeygenStatus_KeyGenSuccess)
 	return &types.MsgCreateTSSVoterResponse{}, nil
 }
 
@@ -7,9 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observerKeeper "github.com/zeta-chain/zetacore/x/observer/keeper"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // FIXME: use more specific error types & codes
@@ -54,8 +56,11 @@ import (
 //
 // ```
 //
+// If a CCTX has already been created for the inbound transaction from a proof
+// against a block header, no CCTX is created when the ballot is finalized.
+//
 // Only observer validators are authorized to broadcast this message.
-func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.MsgVoteOnObservedInboundTx) (*types.MsgVoteOnObservedInboundTxResponse, error) {
+func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.MsgVoteOnObservedInboundTx) (_ *types.MsgVoteOnObservedInboundTxResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	observationType := observerTypes.ObservationType_InBoundTx
@@ -71,17 +76,22 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	if receiverChain == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d, Observation %s", msg.ReceiverChain, observationType.String()))
 	}
-	tssPub := ""
-	tss, tssFound := k.zetaObserverKeeper.GetTSS(ctx)
-	if tssFound {
-		tssPub = tss.TssPubkey
-	}
 	// IsAuthorized does various checks against the list of observer mappers
 	if ok := k.zetaObserverKeeper.IsAuthorized(ctx, msg.Creator, observationChain); !ok {
 		return nil, observerTypes.ErrNotAuthorizedPolicy
 	}
 
 	index := msg.Digest()
+	span := startCctxSpan(ctx, "VoteOnObservedInboundTx", index,
+		tracing.AttrInboundHash.String(msg.InTxHash),
+		tracing.AttrSenderChainID.Int64(msg.SenderChainId),
+		tracing.AttrReceiverChainID.Int64(msg.ReceiverChain),
+		attribute.String("observer", msg.Creator),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	// Add votes and Set Ballot
 	// GetBallot checks against the supported chains list before querying for Ballot
 	ballot, isNew, err := k.zetaObserverKeeper.FindBallot(ctx, index, observationChain, observationType)
@@ -98,28 +108,63 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	}
 
 	_, isFinalized := k.zetaObserverKeeper.CheckIfFinalizingVote(ctx, ballot)
+	span.SetAttributes(attribute.Bool("ballot.finalized", isFinalized))
 	if !isFinalized {
 		// Return nil here to add vote to ballot and commit state
 		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 	}
 
+	// the inbound tx may have already been proven against a block header without ballot
+	if k.IsInboundTxProven(ctx, msg.SenderChainId, msg.InTxHash) {
+		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+	}
+
+	cctx, err := k.FinalizeInboundTx(ctx, msg, index, observationChain, receiverChain, false)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("cctx.status", cctx.CctxStatus.Status.String()))
+	return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+}
+
+// FinalizeInboundTx creates the cctx of a finalized inbound tx and processes it, the inbound tx is finalized either by
+// the ballot of the observers or by a proof against a block header
+func (k Keeper) FinalizeInboundTx(
+	ctx sdk.Context,
+	msg *types.MsgVoteOnObservedInboundTx,
+	index string,
+	senderChain *common.Chain,
+	receiverChain *common.Chain,
+	proven bool,
+) (types.CrossChainTx, error) {
 	// Validation if we want to send ZETA to external chain, but there is no ZETA token.
 	if receiverChain.IsExternalChain() {
 		coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, receiverChain.ChainId)
 		if !found {
-			return nil, types.ErrNotFoundCoreParams
+			return types.CrossChainTx{}, types.ErrNotFoundCoreParams
 		}
 		if coreParams.ZetaTokenContractAddress == "" && msg.CoinType == common.CoinType_Zeta {
-			return nil, types.ErrUnableToSendCoinType
+			return types.CrossChainTx{}, types.ErrUnableToSendCoinType
 		}
 	}
 
+	tssPub := ""
+	tss, tssFound := k.zetaObserverKeeper.GetTSS(ctx)
+	if tssFound {
+		tssPub = tss.TssPubkey
+	}
+
 	// ******************************************************************************
 	// below only happens when ballot is finalized: exactly when threshold vote is in
 	// ******************************************************************************
 
 	// Inbound Ballot has been finalized , Create CCTX
-	cctx := k.CreateNewCCTX(ctx, msg, index, tssPub, types.CctxStatus_PendingInbound, observationChain, receiverChain)
+	cctx := k.CreateNewCCTX(ctx, msg, index, tssPub, types.CctxStatus_PendingInbound, senderChain, receiverChain)
+	if proven {
+		// the cctx of a proven inbound tx is created without ballot
+		cctx.InboundTxParams.InboundTxProven = true
+		cctx.InboundTxParams.InboundTxBallotIndex = ""
+	}
 	defer func() {
 		EmitEventInboundFinalized(ctx, &cctx)
 		// #nosec G701 always positive
@@ -131,23 +176,23 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	// Aborts is any of the updates fail
 	if receiverChain.IsZetaChain() {
 		tmpCtx, commit := ctx.CacheContext()
-		isContractReverted, err := k.HandleEVMDeposit(tmpCtx, &cctx, *msg, observationChain)
+		isContractReverted, err := k.HandleEVMDeposit(tmpCtx, &cctx, *msg, senderChain)
 
 		if err != nil && !isContractReverted { // exceptional case; internal error; should abort CCTX
 			cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			return cctx, nil
 		} else if err != nil && isContractReverted { // contract call reverted; should refund
 			revertMessage := err.Error()
 			chain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(cctx.InboundTxParams.SenderChainId)
 			if chain == nil {
 				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "invalid sender chain")
-				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+				return cctx, nil
 			}
 
 			gasLimit, err := k.GetRevertGasLimit(ctx, cctx)
 			if err != nil {
 				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "can't get revert tx gas limit"+err.Error())
-				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+				return cctx, nil
 			}
 			if gasLimit == 0 {
 				// use same gas limit of outbound as a fallback -- should not happen
@@ -197,22 +242,22 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 				}
 
 				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error()+" deposit revert message: "+revertMessage)
-				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+				return cctx, nil
 			}
 			commit()
 			cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, revertMessage)
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			return cctx, nil
 
 		}
 		// successful HandleEVMDeposit;
 		commit()
 		cctx.CctxStatus.ChangeStatus(types.CctxStatus_OutboundMined, "Remote omnichain contract call completed")
-		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+		return cctx, nil
 	}
 
 	// Receiver is not ZetaChain: Cross Chain SWAP
 	tmpCtx, commit := ctx.CacheContext()
-	err = func() error {
+	err := func() error {
 		err := k.PayGasAndUpdateCctx(
 			tmpCtx,
 			receiverChain.ChainId,
@@ -228,9 +273,9 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	if err != nil {
 		// do not commit anything here as the CCTX should be aborted
 		cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
-		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+		return cctx, nil
 	}
 	commit()
 	cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingOutbound, "")
-	return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+	return cctx, nil
 }
@@ -12,9 +12,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observerKeeper "github.com/zeta-chain/zetacore/x/observer/keeper"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // VoteOnObservedOutboundTx casts a vote on an outbound transaction observed on a connected chain (after
@@ -58,8 +60,20 @@ import (
 // ```
 //
 // Only observer validators are authorized to broadcast this message.
-func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.MsgVoteOnObservedOutboundTx) (*types.MsgVoteOnObservedOutboundTxResponse, error) {
+func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.MsgVoteOnObservedOutboundTx) (_ *types.MsgVoteOnObservedOutboundTxResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	span := startCctxSpan(ctx, "VoteOnObservedOutboundTx", msg.CctxHash,
+		tracing.AttrReceiverChainID.Int64(msg.OutTxChain),
+		// #nosec G701 always in range
+		tracing.AttrOutboundNonce.Int64(int64(msg.OutTxTssNonce)),
+		tracing.AttrOutboundHash.String(msg.ObservedOutTxHash),
+		attribute.String("observer", msg.Creator),
+		attribute.String("vote.status", msg.Status.String()),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	observationType := observerTypes.ObservationType_OutBoundTx
 	// Observer Chain already checked then inbound is created
 	/* EDGE CASE : Params updated in during the finalization process
@@ -69,7 +83,7 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	if observationChain == nil {
 		return nil, observerTypes.ErrSupportedChains
 	}
-	err := observerTypes.CheckReceiveStatus(msg.Status)
+	err = observerTypes.CheckReceiveStatus(msg.Status)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +97,10 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("CCTX %s does not exist", msg.CctxHash))
 	}
+	span.SetAttributes(
+		tracing.AttrInboundHash.String(cctx.InboundTxParams.InboundTxObservedHash),
+		tracing.AttrSenderChainID.Int64(cctx.InboundTxParams.SenderChainId),
+	)
 
 	if cctx.GetCurrentOutTxParam().OutboundTxTssNonce != msg.OutTxTssNonce {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("OutTxTssNonce %d does not match CCTX OutTxTssNonce %d", msg.OutTxTssNonce, cctx.GetCurrentOutTxParam().OutboundTxTssNonce))
@@ -108,6 +126,7 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	}
 
 	ballot, isFinalizedInThisBlock := k.zetaObserverKeeper.CheckIfFinalizingVote(ctx, ballot)
+	span.SetAttributes(attribute.Bool("ballot.finalized", isFinalizedInThisBlock))
 	if !isFinalizedInThisBlock {
 		// Return nil here to add vote to ballot and commit state
 		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
@@ -215,7 +234,9 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 		// #nosec G701 always in range
 		k.GetObserverKeeper().RemoveFromPendingNonces(ctx, tss.TssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
 		k.RemoveOutTxTracker(ctx, msg.OutTxChain, msg.OutTxTssNonce)
+		k.RemoveOutTxNonceGap(ctx, msg.OutTxChain, msg.OutTxTssNonce)
 		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+		span.SetAttributes(attribute.String("cctx.status", cctx.CctxStatus.Status.String()))
 		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
 	}
 	commit()
@@ -224,8 +245,10 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	// #nosec G701 always in range
 	k.GetObserverKeeper().RemoveFromPendingNonces(ctx, tss.TssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
 	k.RemoveOutTxTracker(ctx, msg.OutTxChain, msg.OutTxTssNonce)
+	k.RemoveOutTxNonceGap(ctx, msg.OutTxChain, msg.OutTxTssNonce)
 	ctx.Logger().Info(fmt.Sprintf("Remove tracker %s: , Block Height : %d ", getOutTrackerIndex(msg.OutTxChain, msg.OutTxTssNonce), ctx.BlockHeight()))
 	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	span.SetAttributes(attribute.String("cctx.status", cctx.CctxStatus.Status.String()))
 	return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
 }
 
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// SetOutTxNonceGap set the nonce gap reports of an outbound nonce in the store from its index
+func (k Keeper) SetOutTxNonceGap(ctx sdk.Context, outTxNonceGap types.OutTxNonceGap) {
+	outTxNonceGap.Index = getOutTrackerIndex(outTxNonceGap.ChainId, outTxNonceGap.Nonce)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxNonceGapKeyPrefix))
+	b := k.cdc.MustMarshal(&outTxNonceGap)
+	store.Set(types.OutTxNonceGapKey(outTxNonceGap.Index), b)
+}
+
+// GetOutTxNonceGap returns the nonce gap reports of an outbound nonce
+func (k Keeper) GetOutTxNonceGap(ctx sdk.Context, chainID int64, nonce uint64) (val types.OutTxNonceGap, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxNonceGapKeyPrefix))
+	b := store.Get(types.OutTxNonceGapKey(getOutTrackerIndex(chainID, nonce)))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOutTxNonceGap removes the nonce gap reports of an outbound nonce from the store
+func (k Keeper) RemoveOutTxNonceGap(ctx sdk.Context, chainID int64, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxNonceGapKeyPrefix))
+	store.Delete(types.OutTxNonceGapKey(getOutTrackerIndex(chainID, nonce)))
+}
+
+// GetAllOutTxNonceGap returns the nonce gap reports of all the outbound nonces
+func (k Keeper) GetAllOutTxNonceGap(ctx sdk.Context) (list []types.OutTxNonceGap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxNonceGapKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OutTxNonceGap
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddOutTxNonceGapReport stores the report of an observer on an outbound nonce, it replaces the previous report of the
+// observer with the same action
+func (k Keeper) AddOutTxNonceGapReport(
+	ctx sdk.Context,
+	chainID int64,
+	nonce uint64,
+	cctxIndex string,
+	report types.OutTxNonceGapReport,
+) types.OutTxNonceGap {
+	gap, found := k.GetOutTxNonceGap(ctx, chainID, nonce)
+	if !found || gap.CctxIndex != cctxIndex {
+		gap = types.OutTxNonceGap{
+			ChainId:   chainID,
+			Nonce:     nonce,
+			CctxIndex: cctxIndex,
+		}
+	}
+	replaced := false
+	for i, r := range gap.Reports {
+		if r.Reporter == report.Reporter && r.Action == report.Action {
+			gap.Reports[i] = report
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		gap.Reports = append(gap.Reports, report)
+	}
+	k.SetOutTxNonceGap(ctx, gap)
+	return gap
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func createNOutTxNonceGap(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.OutTxNonceGap {
+	items := make([]types.OutTxNonceGap, n)
+	for i := range items {
+		items[i].ChainId = int64(i)
+		items[i].Nonce = uint64(i)
+		items[i].Index = fmt.Sprintf("%d-%d", items[i].ChainId, items[i].Nonce)
+
+		keeper.SetOutTxNonceGap(ctx, items[i])
+	}
+	return items
+}
+
+func TestOutTxNonceGapGet(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	items := createNOutTxNonceGap(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetOutTxNonceGap(ctx, item.ChainId, item.Nonce)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestOutTxNonceGapRemove(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	items := createNOutTxNonceGap(k, ctx, 10)
+	for _, item := range items {
+		k.RemoveOutTxNonceGap(ctx, item.ChainId, item.Nonce)
+		_, found := k.GetOutTxNonceGap(ctx, item.ChainId, item.Nonce)
+		require.False(t, found)
+	}
+}
+
+func TestOutTxNonceGapGetAll(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	items := createNOutTxNonceGap(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllOutTxNonceGap(ctx)),
+	)
+}
+
+func TestKeeper_AddOutTxNonceGapReport(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	observer1, observer2 := sample.AccAddress(), sample.AccAddress()
+	reprioritized := types.OutTxNonceGapReport{
+		Reporter: observer1,
+		TssNonce: 5,
+		Cause:    types.OutTxNonceGapCause_NotBroadcast,
+		Action:   types.OutTxNonceGapAction_Reprioritized,
+	}
+
+	t.Run("should add the reports of the observers", func(t *testing.T) {
+		k.AddOutTxNonceGapReport(ctx, 1, 5, "foo", reprioritized)
+		other := reprioritized
+		other.Reporter = observer2
+		k.AddOutTxNonceGapReport(ctx, 1, 5, "foo", other)
+
+		gap, found := k.GetOutTxNonceGap(ctx, 1, 5)
+		require.True(t, found)
+		require.Equal(t, "1-5", gap.Index)
+		require.Equal(t, "foo", gap.CctxIndex)
+		require.Equal(t, []types.OutTxNonceGapReport{reprioritized, other}, gap.Reports)
+	})
+
+	t.Run("should replace the report of an observer with the same action", func(t *testing.T) {
+		notMined := reprioritized
+		notMined.Cause = types.OutTxNonceGapCause_NotMined
+		k.AddOutTxNonceGapReport(ctx, 1, 5, "foo", notMined)
+		cancelled := reprioritized
+		cancelled.Action = types.OutTxNonceGapAction_Cancelled
+		cancelled.CancelTxHash = sample.Hash().Hex()
+		k.AddOutTxNonceGapReport(ctx, 1, 5, "foo", cancelled)
+
+		gap, found := k.GetOutTxNonceGap(ctx, 1, 5)
+		require.True(t, found)
+		require.Len(t, gap.Reports, 3)
+		require.Equal(t, notMined, gap.Reports[0])
+		require.Equal(t, cancelled, gap.Reports[2])
+	})
+
+	t.Run("should reset the reports if the nonce is assigned to another cctx", func(t *testing.T) {
+		k.AddOutTxNonceGapReport(ctx, 1, 5, "bar", reprioritized)
+
+		gap, found := k.GetOutTxNonceGap(ctx, 1, 5)
+		require.True(t, found)
+		require.Equal(t, "bar", gap.CctxIndex)
+		require.Equal(t, []types.OutTxNonceGapReport{reprioritized}, gap.Reports)
+	})
+}
@@ -13,7 +13,13 @@ func getOutTrackerIndex(chainID int64, nonce uint64) string {
 }
 
 // SetOutTxTracker set a specific outTxTracker in the store from its index
+// The block headers of the proven hashes of the tracker are referenced until the tracker is removed
 func (k Keeper) SetOutTxTracker(ctx sdk.Context, outTxTracker types.OutTxTracker) {
+	if old, found := k.GetOutTxTracker(ctx, outTxTracker.ChainId, outTxTracker.Nonce); found {
+		k.removeOutTxTrackerBlockHeaderReferences(ctx, old)
+	}
+	k.addOutTxTrackerBlockHeaderReferences(ctx, outTxTracker)
+
 	outTxTracker.Index = getOutTrackerIndex(outTxTracker.ChainId, outTxTracker.Nonce)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxTrackerKeyPrefix))
 	b := k.cdc.MustMarshal(&outTxTracker)
@@ -50,6 +56,10 @@ func (k Keeper) RemoveOutTxTracker(
 	nonce uint64,
 
 ) {
+	if tracker, found := k.GetOutTxTracker(ctx, chainID, nonce); found {
+		k.removeOutTxTrackerBlockHeaderReferences(ctx, tracker)
+	}
+
 	index := getOutTrackerIndex(chainID, nonce)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxTrackerKeyPrefix))
 	store.Delete(types.OutTxTrackerKey(
@@ -57,6 +67,22 @@ func (k Keeper) RemoveOutTxTracker(
 	))
 }
 
+func (k Keeper) addOutTxTrackerBlockHeaderReferences(ctx sdk.Context, outTxTracker types.OutTxTracker) {
+	for _, hash := range outTxTracker.HashList {
+		if hash != nil {
+			k.addBlockHeaderReference(ctx, outTxTracker.ChainId, hash.BlockHash)
+		}
+	}
+}
+
+func (k Keeper) removeOutTxTrackerBlockHeaderReferences(ctx sdk.Context, outTxTracker types.OutTxTracker) {
+	for _, hash := range outTxTracker.HashList {
+		if hash != nil {
+			k.removeBlockHeaderReference(ctx, outTxTracker.ChainId, hash.BlockHash)
+		}
+	}
+}
+
 // GetAllOutTxTracker returns all outTxTracker
 func (k Keeper) GetAllOutTxTracker(ctx sdk.Context) (list []types.OutTxTracker) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutTxTrackerKeyPrefix))
@@ -6,8 +6,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
@@ -64,3 +66,29 @@ func TestOutTxTrackerGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllOutTxTracker(ctx)),
 	)
 }
+
+func TestOutTxTrackerBlockHeaderReference(t *testing.T) {
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+	chainID := common.GoerliChain().ChainId
+	blockHash := sample.Hash()
+	tracker := types.OutTxTracker{
+		ChainId: chainID,
+		Nonce:   1,
+		HashList: []*types.TxHashList{
+			{TxHash: sample.Hash().Hex()},
+		},
+	}
+
+	// the block headers are only referenced by the proven hashes
+	k.SetOutTxTracker(ctx, tracker)
+	require.EqualValues(t, 0, zk.ObserverKeeper.GetBlockHeaderReferenceCount(ctx, blockHash.Bytes()))
+
+	tracker.HashList[0].Proved = true
+	tracker.HashList[0].BlockHash = blockHash.Hex()
+	k.SetOutTxTracker(ctx, tracker)
+	k.SetOutTxTracker(ctx, tracker)
+	require.EqualValues(t, 1, zk.ObserverKeeper.GetBlockHeaderReferenceCount(ctx, blockHash.Bytes()))
+
+	k.RemoveOutTxTracker(ctx, chainID, tracker.Nonce)
+	require.EqualValues(t, 0, zk.ObserverKeeper.GetBlockHeaderReferenceCount(ctx, blockHash.Bytes()))
+}
@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	eth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// evmDepositGasLimit is the gas limit of the deposits of ERC20 on the EVM chains
+	evmDepositGasLimit = 1_500_000
+
+	// evmGasDepositGasLimit is the gas limit of the deposits of gas tokens to the TSS on the EVM chains
+	evmGasDepositGasLimit = 90_000
+)
+
+// IsInboundTxProven returns true if a cctx has been created for the inbound tx from a proof against a block header
+func (k Keeper) IsInboundTxProven(ctx sdk.Context, chainID int64, txHash string) bool {
+	for _, cctx := range k.getInboundTxCctxs(ctx, chainID, txHash) {
+		if cctx.InboundTxParams.InboundTxProven {
+			return true
+		}
+	}
+	return false
+}
+
+// getInboundTxCctxs returns the cctxs created for an inbound tx of a chain
+func (k Keeper) getInboundTxCctxs(ctx sdk.Context, chainID int64, txHash string) []types.CrossChainTx {
+	inTxHashToCctx, found := k.GetInTxHashToCctx(ctx, txHash)
+	if !found {
+		return nil
+	}
+	var cctxs []types.CrossChainTx
+	for _, index := range inTxHashToCctx.CctxIndex {
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if found && cctx.InboundTxParams != nil && cctx.InboundTxParams.SenderChainId == chainID {
+			cctxs = append(cctxs, cctx)
+		}
+	}
+	return cctxs
+}
+
+// checkProvenInboundConfirmed checks the block header of a proven tx is confirmed for its inbounds
+// An Ethereum block header must be finalized by the light client of the chain, a Bitcoin block header must be buried
+// by the highest confirmation count required for the amounts of the inbounds
+func (k Keeper) checkProvenInboundConfirmed(ctx sdk.Context, header common.BlockHeader, inbounds []*types.MsgVoteOnObservedInboundTx) error {
+	if common.IsEVMChain(header.ChainId) {
+		return k.zetaObserverKeeper.CheckBlockHeaderFinalized(ctx, header)
+	}
+	coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, header.ChainId)
+	if !found {
+		return fmt.Errorf("core params not found for chain %d", header.ChainId)
+	}
+	confirmationCount := uint64(0)
+	for _, inbound := range inbounds {
+		if count := k.inboundConfirmationCount(ctx, *coreParams, inbound); count > confirmationCount {
+			confirmationCount = count
+		}
+	}
+	return k.zetaObserverKeeper.CheckBlockHeaderConfirmed(ctx, header, confirmationCount)
+}
+
+// inboundConfirmationCount returns the confirmation count required for the amount of an inbound, the highest
+// confirmation count of the asset is returned if the decimals of its ZRC20 are unknown
+func (k Keeper) inboundConfirmationCount(ctx sdk.Context, coreParams observertypes.CoreParams, inbound *types.MsgVoteOnObservedInboundTx) uint64 {
+	var (
+		asset       string
+		foreignCoin fungibletypes.ForeignCoins
+		found       bool
+	)
+	switch inbound.CoinType {
+	case common.CoinType_Gas:
+		foreignCoin, found = k.fungibleKeeper.GetGasCoinForForeignCoin(ctx, inbound.SenderChainId)
+	case common.CoinType_ERC20:
+		asset = inbound.Asset
+		foreignCoin, found = k.fungibleKeeper.GetForeignCoinFromAsset(ctx, asset, inbound.SenderChainId)
+	}
+	if !found {
+		return coreParams.MaxConfirmationCountForAsset(inbound.CoinType, asset)
+	}
+	return coreParams.ConfirmationCountForAmount(inbound.CoinType, asset, inbound.Amount, foreignCoin.Decimals)
+}
+
+// ParseProvenEVMInboundTx returns the inbounds of a proven EVM tx and its receipt
+// The inbounds are the ZetaSent events of the connector, the Deposited events of the ERC20 custody and the gas token
+// transfer to the TSS address, the event index of an inbound is the index of its log in the block as for the ballots of
+// the observers
+func (k Keeper) ParseProvenEVMInboundTx(
+	ctx sdk.Context,
+	msg *types.MsgAddProvenInboundTx,
+	blockHeight int64,
+	txBytes []byte,
+	receiptBytes []byte,
+) ([]*types.MsgVoteOnObservedInboundTx, error) {
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, err
+	}
+	if tx.Hash().Hex() != msg.TxHash {
+		return nil, fmt.Errorf("want tx hash %s, got %s", tx.Hash().Hex(), msg.TxHash)
+	}
+	if tx.ChainId().Cmp(big.NewInt(msg.ChainId)) != 0 {
+		return nil, fmt.Errorf("want evm chain id %d, got %d", tx.ChainId(), msg.ChainId)
+	}
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(receiptBytes); err != nil {
+		return nil, err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("tx %s failed", msg.TxHash)
+	}
+	// the log indexes in the block are not encoded in the receipt, they follow the index of the first log of the receipt
+	for i, log := range receipt.Logs {
+		if log != nil {
+			// #nosec G701 always positive
+			log.Index = uint(msg.FirstLogIndex) + uint(i)
+		}
+	}
+	from, err := ethtypes.NewLondonSigner(big.NewInt(msg.ChainId)).Sender(&tx)
+	if err != nil {
+		return nil, fmt.Errorf("can't recover the sender of tx %s: %s", msg.TxHash, err)
+	}
+
+	coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrNotFoundCoreParams
+	}
+	zetaChain, err := common.ZetaChainFromChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G701 always positive
+	height := uint64(blockHeight)
+
+	var inbounds []*types.MsgVoteOnObservedInboundTx
+	for _, log := range receipt.Logs {
+		if log == nil || len(log.Topics) == 0 {
+			continue
+		}
+		switch {
+		case strings.EqualFold(log.Address.Hex(), coreParams.ConnectorContractAddress):
+			connector, err := zetaconnector.NewZetaConnectorNonEthFilterer(log.Address, nil)
+			if err != nil {
+				return nil, err
+			}
+			event, err := connector.ParseZetaSent(*log)
+			if err != nil {
+				continue
+			}
+			destChain := common.GetChainFromChainID(event.DestinationChainId.Int64())
+			if destChain == nil {
+				return nil, types.ErrUnsupportedChain.Wrapf("destination chain %d", event.DestinationChainId.Int64())
+			}
+			destAddr := "0x" + hex.EncodeToString(event.DestinationAddress)
+			if !destChain.IsZetaChain() {
+				destCoreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, destChain.ChainId)
+				if !found {
+					return nil, types.ErrNotFoundCoreParams
+				}
+				if strings.EqualFold(destAddr, destCoreParams.ZetaTokenContractAddress) {
+					return nil, fmt.Errorf("destination address %s is the ZETA token contract address", destAddr)
+				}
+			}
+			inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+				msg.Creator,
+				event.ZetaTxSenderAddress.Hex(),
+				msg.ChainId,
+				event.SourceTxOriginAddress.Hex(),
+				destAddr,
+				destChain.ChainId,
+				math.NewUintFromBigInt(event.ZetaValueAndGas),
+				base64.StdEncoding.EncodeToString(event.Message),
+				msg.TxHash,
+				height,
+				event.DestinationGasLimit.Uint64(),
+				common.CoinType_Zeta,
+				"",
+				log.Index,
+			))
+		case strings.EqualFold(log.Address.Hex(), coreParams.Erc20CustodyContractAddress):
+			custody, err := erc20custody.NewERC20CustodyFilterer(log.Address, nil)
+			if err != nil {
+				return nil, err
+			}
+			event, err := custody.ParseDeposited(*log)
+			if err != nil {
+				continue
+			}
+			if bytes.Equal(event.Message, []byte(common.DonationMessage)) {
+				continue
+			}
+			inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+				msg.Creator,
+				from.Hex(),
+				msg.ChainId,
+				"",
+				"0x"+hex.EncodeToString(event.Recipient),
+				zetaChain.ChainId,
+				math.NewUintFromBigInt(event.Amount),
+				hex.EncodeToString(event.Message),
+				msg.TxHash,
+				height,
+				evmDepositGasLimit,
+				common.CoinType_ERC20,
+				event.Asset.String(),
+				log.Index,
+			))
+		}
+	}
+
+	// gas token transferred to the TSS address
+	if tx.To() != nil && tx.Value().Sign() > 0 && !bytes.Equal(tx.Data(), []byte(common.DonationMessage)) {
+		tss, err := k.zetaObserverKeeper.GetTssAddress(ctx, &observertypes.QueryGetTssAddressRequest{})
+		if err != nil {
+			return nil, err
+		}
+		if tssAddr := eth.HexToAddress(tss.Eth); tssAddr != (eth.Address{}) && *tx.To() == tssAddr {
+			message := ""
+			if len(tx.Data()) != 0 {
+				message = hex.EncodeToString(tx.Data())
+			}
+			inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+				msg.Creator,
+				from.Hex(),
+				msg.ChainId,
+				from.Hex(),
+				from.Hex(),
+				zetaChain.ChainId,
+				math.NewUintFromBigInt(tx.Value()),
+				message,
+				msg.TxHash,
+				height,
+				evmGasDepositGasLimit,
+				common.CoinType_Gas,
+				"",
+				0,
+			))
+		}
+	}
+	return inbounds, nil
+}
+
+// ParseProvenBTCInboundTx returns the inbound of a proven bitcoin tx
+// The first output must pay the TSS address with a P2WPKH script, the second output must be an OP_RETURN memo and
+// the sender is derived from the witness of the first input
+func (k Keeper) ParseProvenBTCInboundTx(
+	ctx sdk.Context,
+	msg *types.MsgAddProvenInboundTx,
+	blockHeight int64,
+	txBytes []byte,
+) ([]*types.MsgVoteOnObservedInboundTx, error) {
+	tx, err := btcutil.NewTxFromBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Hash().String() != msg.TxHash {
+		return nil, fmt.Errorf("want tx hash %s, got %s", tx.Hash().String(), msg.TxHash)
+	}
+	msgTx := tx.MsgTx()
+	if len(msgTx.TxOut) < 2 || len(msgTx.TxIn) == 0 {
+		return nil, nil
+	}
+	netParams, err := common.BitcoinNetParamsFromChainID(msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first output pays the TSS address
+	tss, err := k.zetaObserverKeeper.GetTssAddress(ctx, &observertypes.QueryGetTssAddressRequest{
+		BitcoinChainId: msg.ChainId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := msgTx.TxOut[0]
+	if len(out.PkScript) != 22 || out.PkScript[0] != txscript.OP_0 || out.PkScript[1] != txscript.OP_DATA_20 {
+		return nil, nil
+	}
+	toAddr, err := btcutil.NewAddressWitnessPubKeyHash(out.PkScript[2:], netParams)
+	if err != nil {
+		return nil, err
+	}
+	if tss.Btc == "" || toAddr.EncodeAddress() != tss.Btc {
+		return nil, nil
+	}
+	if out.Value < common.BtcDepositorFeeSat {
+		return nil, fmt.Errorf("deposit amount %d is less than the depositor fee %d", out.Value, common.BtcDepositorFeeSat)
+	}
+
+	// the second output is the memo
+	script := msgTx.TxOut[1].PkScript
+	if len(script) < 2 || script[0] != txscript.OP_RETURN {
+		return nil, nil
+	}
+	if int(script[1]) != len(script)-2 {
+		return nil, fmt.Errorf("memo size mismatch: %d != %d", script[1], len(script)-2)
+	}
+	memo := script[2:]
+	if bytes.Equal(memo, []byte(common.DonationMessage)) {
+		return nil, nil
+	}
+
+	// the sender is the P2WPKH address of the public key of the first input
+	if !isP2WPKHSpend(msgTx.TxIn[0]) {
+		return nil, fmt.Errorf("can't derive the sender of tx %s: the first input doesn't spend a P2WPKH output", msg.TxHash)
+	}
+	fromAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(msgTx.TxIn[0].Witness[1]), netParams)
+	if err != nil {
+		return nil, err
+	}
+	from := fromAddr.EncodeAddress()
+
+	zetaChain, err := common.ZetaChainFromChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G701 always positive
+	inbound := types.NewMsgVoteOnObservedInboundTx(
+		msg.Creator,
+		from,
+		msg.ChainId,
+		from,
+		from,
+		zetaChain.ChainId,
+		math.NewUint(uint64(out.Value-common.BtcDepositorFeeSat)),
+		hex.EncodeToString(memo),
+		msg.TxHash,
+		uint64(blockHeight),
+		0,
+		common.CoinType_Gas,
+		"",
+		0,
+	)
+	return []*types.MsgVoteOnObservedInboundTx{inbound}, nil
+}
+
+// isP2WPKHSpend returns true if the input has the shape of the spending of a P2WPKH output: an empty signature script
+// and a witness made of a signature and a compressed public key
+// The previous output is not available on chain, so a P2WSH or P2SH input whose witness would have two items, e.g. a
+// script and its argument, is rejected by its witness not being a signature and a public key
+func isP2WPKHSpend(txIn *wire.TxIn) bool {
+	if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 2 {
+		return false
+	}
+	sig, pubKey := txIn.Witness[0], txIn.Witness[1]
+	if len(pubKey) != btcec.PubKeyBytesLenCompressed {
+		return false
+	}
+	if _, err := btcec.ParsePubKey(pubKey, btcec.S256()); err != nil {
+		return false
+	}
+	if len(sig) < 2 {
+		return false
+	}
+	_, err := btcec.ParseDERSignature(sig[:len(sig)-1], btcec.S256())
+	return err == nil
+}
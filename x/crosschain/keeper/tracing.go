@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AttrZetaHeight is the attribute of the ZetaChain height of the spans of the vote handlers
+const AttrZetaHeight = attribute.Key("zeta.height")
+
+var tracer = tracing.Tracer("zetacore/crosschain")
+
+// startCctxSpan starts a span of a vote handler in the trace of the cctx, no span is emitted for the simulated votes
+func startCctxSpan(ctx sdk.Context, name string, cctxIndex string, attrs ...attribute.KeyValue) trace.Span {
+	if ctx.IsCheckTx() {
+		return trace.SpanFromContext(context.Background())
+	}
+	attrs = append(attrs, AttrZetaHeight.Int64(ctx.BlockHeight()))
+	_, span := tracing.StartCctxSpan(ctx.Context(), tracer, name, cctxIndex, attrs...)
+	return span
+}
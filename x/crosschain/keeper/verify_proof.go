@@ -13,46 +13,79 @@ import (
 )
 
 func (k Keeper) VerifyProof(ctx sdk.Context, proof *common.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error) {
+	header, err := k.GetVerifiableBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify merkle proof
+	txBytes, err := proof.Verify(header.Header, int(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	return txBytes, err
+}
+
+// GetVerifiableBlockHeader returns the block header a proof of the chain can be verified against
+// The header-based verification must be enabled for the chain and the block header must be confirmed
+func (k Keeper) GetVerifiableBlockHeader(ctx sdk.Context, chainID int64, blockHash string) (common.BlockHeader, error) {
+	res, err := k.getProvableBlockHeader(ctx, chainID, blockHash)
+	if err != nil {
+		return common.BlockHeader{}, err
+	}
+
+	// bitcoin block header must be buried on the best chain by the confirmation count of any amount
+	coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, chainID)
+	if !found {
+		return common.BlockHeader{}, fmt.Errorf("core params not found for chain %d", chainID)
+	}
+	if err := k.zetaObserverKeeper.CheckBlockHeaderConfirmed(ctx, res, coreParams.MaxConfirmationCount()); err != nil {
+		return common.BlockHeader{}, err
+	}
+
+	return res, nil
+}
+
+// getProvableBlockHeader returns the stored block header of the chain if the header-based verification is enabled
+// for the chain, the confirmation of the block header is checked by the caller
+func (k Keeper) getProvableBlockHeader(ctx sdk.Context, chainID int64, blockHash string) (common.BlockHeader, error) {
 	// header-based merkle proof verification must be enabled
 	crosschainFlags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
 	if !found {
-		return nil, fmt.Errorf("crosschain flags not found")
+		return common.BlockHeader{}, fmt.Errorf("crosschain flags not found")
 	}
 	if crosschainFlags.BlockHeaderVerificationFlags == nil {
-		return nil, fmt.Errorf("block header verification flags not found")
+		return common.BlockHeader{}, fmt.Errorf("block header verification flags not found")
 	}
 	if common.IsBitcoinChain(chainID) && !crosschainFlags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled {
-		return nil, fmt.Errorf("proof verification not enabled for bitcoin chain")
+		return common.BlockHeader{}, fmt.Errorf("proof verification not enabled for bitcoin chain")
 	}
 	if common.IsEVMChain(chainID) && !crosschainFlags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled {
-		return nil, fmt.Errorf("proof verification not enabled for evm chain")
+		return common.BlockHeader{}, fmt.Errorf("proof verification not enabled for evm chain")
 	}
 
 	// chain must support header-based merkle proof verification
 	senderChain := common.GetChainFromChainID(chainID)
 	if senderChain == nil {
-		return nil, types.ErrUnsupportedChain
+		return common.BlockHeader{}, types.ErrUnsupportedChain
 	}
 	if !senderChain.SupportMerkleProof() {
-		return nil, fmt.Errorf("chain %d does not support block header-based verification", chainID)
+		return common.BlockHeader{}, fmt.Errorf("chain %d does not support block header-based verification", chainID)
 	}
 
 	// get block header from the store
 	hashBytes, err := common.StringToHash(chainID, blockHash)
 	if err != nil {
-		return nil, fmt.Errorf("block hash %s conversion failed %s", blockHash, err)
+		return common.BlockHeader{}, fmt.Errorf("block hash %s conversion failed %s", blockHash, err)
 	}
 	res, found := k.zetaObserverKeeper.GetBlockHeader(ctx, hashBytes)
 	if !found {
-		return nil, fmt.Errorf("block header not found %s", blockHash)
+		return common.BlockHeader{}, fmt.Errorf("block header not found %s", blockHash)
 	}
-
-	// verify merkle proof
-	txBytes, err := proof.Verify(res.Header, int(txIndex))
-	if err != nil {
-		return nil, err
+	if res.ChainId != chainID {
+		return common.BlockHeader{}, fmt.Errorf("block header %s of chain %d, not of chain %d", blockHash, res.ChainId, chainID)
 	}
-	return txBytes, err
+	return res, nil
 }
 
 func (k Keeper) VerifyEVMInTxBody(ctx sdk.Context, msg *types.MsgAddToInTxTracker, txBytes []byte) error {
@@ -105,3 +138,27 @@ func (k Keeper) VerifyEVMInTxBody(ctx sdk.Context, msg *types.MsgAddToInTxTracke
 		return fmt.Errorf("coin type %s not supported", msg.CoinType)
 	}
 }
+
+// addBlockHeaderReference references the block header of a tracker proof to keep it from the pruning
+func (k Keeper) addBlockHeaderReference(ctx sdk.Context, chainID int64, blockHash string) {
+	if blockHash == "" {
+		return
+	}
+	hash, err := common.StringToHash(chainID, blockHash)
+	if err != nil {
+		return
+	}
+	k.zetaObserverKeeper.AddBlockHeaderReference(ctx, hash)
+}
+
+// removeBlockHeaderReference removes the reference to the block header of a tracker proof
+func (k Keeper) removeBlockHeaderReference(ctx sdk.Context, chainID int64, blockHash string) {
+	if blockHash == "" {
+		return
+	}
+	hash, err := common.StringToHash(chainID, blockHash)
+	if err != nil {
+		return
+	}
+	k.zetaObserverKeeper.RemoveBlockHeaderReference(ctx, hash)
+}
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding crosschain type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		var a, b codec.ProtoMarshaler
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SendKey)):
+			a, b = &types.CrossChainTx{}, &types.CrossChainTx{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.LastBlockHeightKey)):
+			a, b = &types.LastBlockHeight{}, &types.LastBlockHeight{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.GasPriceKey)):
+			a, b = &types.GasPrice{}, &types.GasPrice{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.OutTxTrackerKeyPrefix)):
+			a, b = &types.OutTxTracker{}, &types.OutTxTracker{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.InTxTrackerKeyPrefix)):
+			a, b = &types.InTxTracker{}, &types.InTxTracker{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.InTxHashToCctxKeyPrefix)):
+			a, b = &types.InTxHashToCctx{}, &types.InTxHashToCctx{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ZetaAccountingKey)):
+			a, b = &types.ZetaAccounting{}, &types.ZetaAccounting{}
+		default:
+			panic(fmt.Sprintf("invalid crosschain key prefix %X", kvA.Key))
+		}
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+}
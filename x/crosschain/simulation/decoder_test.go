@@ -0,0 +1,54 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/simulation"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keepertest.NewCodec()
+	dec := simulation.NewDecodeStore(cdc)
+
+	cctx := sample.CrossChainTx(t, "cctx")
+	gasPrice := sample.GasPrice(t, "gasPrice")
+	outTxTracker := sample.OutTxTracker(t, "outTxTracker")
+	zetaAccounting := sample.ZetaAccounting(t, "zetaAccounting")
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefix(types.SendKey), Value: cdc.MustMarshal(cctx)},
+			{Key: types.KeyPrefix(types.GasPriceKey), Value: cdc.MustMarshal(gasPrice)},
+			{Key: types.KeyPrefix(types.OutTxTrackerKeyPrefix), Value: cdc.MustMarshal(&outTxTracker)},
+			{Key: types.KeyPrefix(types.ZetaAccountingKey), Value: cdc.MustMarshal(&zetaAccounting)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"CrossChainTx", fmt.Sprintf("%v\n%v", cctx, cctx)},
+		{"GasPrice", fmt.Sprintf("%v\n%v", gasPrice, gasPrice)},
+		{"OutTxTracker", fmt.Sprintf("%v\n%v", &outTxTracker, &outTxTracker)},
+		{"ZetaAccounting", fmt.Sprintf("%v\n%v", &zetaAccounting, &zetaAccounting)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedLog == "" {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
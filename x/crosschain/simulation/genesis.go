@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// Simulation parameter constants
+const (
+	InTxTrackers = "in_tx_trackers"
+)
+
+// GenInTxTrackers returns randomized inbound trackers for the external privnet chains
+func GenInTxTrackers(r *rand.Rand) []types.InTxTracker {
+	var trackers []types.InTxTracker
+	for _, chain := range common.PrivnetChainList() {
+		if !chain.IsExternalChain() {
+			continue
+		}
+		for i := r.Intn(5); i > 0; i-- {
+			trackers = append(trackers, types.InTxTracker{
+				ChainId:  chain.ChainId,
+				TxHash:   RandomHash(r),
+				CoinType: RandomCoinType(r),
+			})
+		}
+	}
+	return trackers
+}
+
+// RandomizedGenState generates a random GenesisState for the crosschain module
+// No cctx exists at genesis, they are created by the inbound votes of the simulation
+func RandomizedGenState(simState *module.SimulationState) {
+	var inTxTrackers []types.InTxTracker
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InTxTrackers, &inTxTrackers, simState.Rand,
+		func(r *rand.Rand) { inTxTrackers = GenInTxTrackers(r) },
+	)
+
+	crosschainGenesis := types.GenesisState{
+		Params:          types.DefaultParams(),
+		InTxTrackerList: inTxTrackers,
+		ZetaAccounting: types.ZetaAccounting{
+			AbortedZetaAmount: sdkmath.ZeroUint(),
+		},
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&crosschainGenesis)
+}
+
+// RandomHash returns a random transaction hash
+func RandomHash(r *rand.Rand) string {
+	b := make([]byte, ethcommon.HashLength)
+	r.Read(b)
+	return ethcommon.BytesToHash(b).Hex()
+}
+
+// RandomCoinType returns a random coin type that can be transferred by a cctx
+func RandomCoinType(r *rand.Rand) common.CoinType {
+	coinTypes := []common.CoinType{common.CoinType_Zeta, common.CoinType_Gas, common.CoinType_ERC20}
+	return coinTypes[r.Intn(len(coinTypes))]
+}
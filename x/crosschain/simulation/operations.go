@@ -0,0 +1,546 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// Simulation operation weights constants
+// #nosec G101 not hardcoded credentials
+const (
+	OpWeightMsgVoteOnObservedInboundTx  = "op_weight_msg_vote_on_observed_inbound_tx"
+	OpWeightMsgVoteOnObservedOutboundTx = "op_weight_msg_vote_on_observed_outbound_tx"
+	OpWeightMsgGasPriceVoter            = "op_weight_msg_gas_price_voter"
+	OpWeightMsgAddToOutTxTracker        = "op_weight_msg_add_to_out_tx_tracker"
+	OpWeightMsgRemoveFromOutTxTracker   = "op_weight_msg_remove_from_out_tx_tracker"
+	OpWeightMsgAddToInTxTracker         = "op_weight_msg_add_to_in_tx_tracker"
+
+	DefaultWeightMsgVoteOnObservedInboundTx  = 100
+	DefaultWeightMsgVoteOnObservedOutboundTx = 50
+	DefaultWeightMsgGasPriceVoter            = 50
+	DefaultWeightMsgAddToOutTxTracker        = 20
+	DefaultWeightMsgRemoveFromOutTxTracker   = 10
+	DefaultWeightMsgAddToInTxTracker         = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgVoteOnObservedInboundTx  int
+		weightMsgVoteOnObservedOutboundTx int
+		weightMsgGasPriceVoter            int
+		weightMsgAddToOutTxTracker        int
+		weightMsgRemoveFromOutTxTracker   int
+		weightMsgAddToInTxTracker         int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteOnObservedInboundTx, &weightMsgVoteOnObservedInboundTx, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteOnObservedInboundTx = DefaultWeightMsgVoteOnObservedInboundTx
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteOnObservedOutboundTx, &weightMsgVoteOnObservedOutboundTx, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteOnObservedOutboundTx = DefaultWeightMsgVoteOnObservedOutboundTx
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgGasPriceVoter, &weightMsgGasPriceVoter, nil,
+		func(_ *rand.Rand) {
+			weightMsgGasPriceVoter = DefaultWeightMsgGasPriceVoter
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddToOutTxTracker, &weightMsgAddToOutTxTracker, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddToOutTxTracker = DefaultWeightMsgAddToOutTxTracker
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveFromOutTxTracker, &weightMsgRemoveFromOutTxTracker, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveFromOutTxTracker = DefaultWeightMsgRemoveFromOutTxTracker
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddToInTxTracker, &weightMsgAddToInTxTracker, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddToInTxTracker = DefaultWeightMsgAddToInTxTracker
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgVoteOnObservedInboundTx,
+			SimulateMsgVoteOnObservedInboundTx(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteOnObservedOutboundTx,
+			SimulateMsgVoteOnObservedOutboundTx(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGasPriceVoter,
+			SimulateMsgGasPriceVoter(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddToOutTxTracker,
+			SimulateMsgAddToOutTxTracker(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveFromOutTxTracker,
+			SimulateMsgRemoveFromOutTxTracker(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddToInTxTracker,
+			SimulateMsgAddToInTxTracker(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgVoteOnObservedInboundTx generates a MsgVoteOnObservedInboundTx for a random inbound from an external chain
+// The first observer votes for the inbound, the votes of the other observers are scheduled for the next blocks
+func SimulateMsgVoteOnObservedInboundTx(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgVoteOnObservedInboundTx{}).Type()
+		observerKeeper := k.GetObserverKeeper()
+
+		if !observerKeeper.IsInboundEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "inbound disabled"), nil, nil
+		}
+		chains := observerKeeper.GetParams(ctx).GetSupportedChains()
+		senderChain, found := randomExternalChain(r, chains)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no supported external chain"), nil, nil
+		}
+		receiverChain := chains[r.Intn(len(chains))]
+		if receiverChain.IsEqual(*senderChain) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "receiver chain is the sender chain"), nil, nil
+		}
+		observers := authorizedObservers(ctx, k, senderChain, accs)
+		if len(observers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no authorized observer"), nil, nil
+		}
+
+		// ZETA can only be sent to an external chain with a ZETA token
+		coinType := RandomCoinType(r)
+		if coinType == common.CoinType_Zeta && receiverChain.IsExternalChain() {
+			coreParams, found := observerKeeper.GetCoreParamsByChainID(ctx, receiverChain.ChainId)
+			if !found || coreParams.ZetaTokenContractAddress == "" {
+				coinType = common.CoinType_Gas
+			}
+		}
+		// ERC20 deposits are done for an asset with a deployed ZRC20
+		asset := ""
+		if coinType == common.CoinType_ERC20 {
+			var assets []string
+			for _, foreignCoin := range k.GetFungibleKeeper().GetAllForeignCoinsForChain(ctx, senderChain.ChainId) {
+				if foreignCoin.CoinType == common.CoinType_ERC20 {
+					assets = append(assets, foreignCoin.Asset)
+				}
+			}
+			if len(assets) == 0 {
+				coinType = common.CoinType_Gas
+			} else {
+				asset = assets[r.Intn(len(assets))]
+			}
+		}
+
+		// the receiver on ZetaChain is one of the simulation accounts
+		receiver := randomEthAddress(r).Hex()
+		if receiverChain.IsZetaChain() {
+			receiverAccount, _ := simtypes.RandomAcc(r, accs)
+			receiver = ethcommon.BytesToAddress(receiverAccount.Address).Hex()
+		}
+		sender := randomEthAddress(r).Hex()
+
+		r.Shuffle(len(observers), func(i, j int) {
+			observers[i], observers[j] = observers[j], observers[i]
+		})
+		msg := types.NewMsgVoteOnObservedInboundTx(
+			observers[0].Address.String(),
+			sender,
+			senderChain.ChainId,
+			sender,
+			receiver,
+			receiverChain.ChainId,
+			sdkmath.NewUint(uint64(simtypes.RandIntBetween(r, 1e15, 9e18))),
+			"",
+			RandomHash(r),
+			uint64(r.Int63n(1_000_000)),
+			uint64(simtypes.RandIntBetween(r, 21_000, 1_000_000)),
+			coinType,
+			asset,
+			uint(r.Intn(10)),
+		)
+
+		opMsg, _, err := deliverTx(r, app, ctx, ak, bk, observers[0], msg)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simtypes.FutureOperation, 0, len(observers)-1)
+		for _, observer := range observers[1:] {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 1, 3),
+				Op:          operationVoteOnObservedInboundTx(k, ak, bk, *msg, observer),
+			})
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
+// operationVoteOnObservedInboundTx votes for an inbound already observed with a different observer
+func operationVoteOnObservedInboundTx(
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	msg types.MsgVoteOnObservedInboundTx,
+	observer simtypes.Account,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		observerKeeper := k.GetObserverKeeper()
+
+		if !observerKeeper.IsInboundEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "inbound disabled"), nil, nil
+		}
+		chain := observerKeeper.GetParams(ctx).GetChainFromChainID(msg.SenderChainId)
+		if chain == nil || !observerKeeper.IsAuthorized(ctx, observer.Address.String(), chain) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "observer not authorized"), nil, nil
+		}
+		if !canVote(ctx, k, msg.Digest(), observer) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "observer can't vote on the ballot"), nil, nil
+		}
+
+		msg.Creator = observer.Address.String()
+		return deliverTx(r, app, ctx, ak, bk, observer, &msg)
+	}
+}
+
+// SimulateMsgVoteOnObservedOutboundTx generates a MsgVoteOnObservedOutboundTx for a random pending cctx
+// The first observer votes for the outbound, the votes of the other observers are scheduled for the next blocks
+func SimulateMsgVoteOnObservedOutboundTx(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgVoteOnObservedOutboundTx{}).Type()
+
+		cctx, found := randomPendingCctx(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pending cctx"), nil, nil
+		}
+		outbound := cctx.GetCurrentOutTxParam()
+		chain := k.GetObserverKeeper().GetParams(ctx).GetChainFromChainID(outbound.ReceiverChainId)
+		if chain == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "receiver chain not supported"), nil, nil
+		}
+		observers := authorizedObservers(ctx, k, chain, accs)
+		if len(observers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no authorized observer"), nil, nil
+		}
+
+		// the outbound fails one time out of five
+		status := common.ReceiveStatus_Success
+		if r.Intn(5) == 0 {
+			status = common.ReceiveStatus_Failed
+		}
+
+		r.Shuffle(len(observers), func(i, j int) {
+			observers[i], observers[j] = observers[j], observers[i]
+		})
+		msg := types.NewMsgVoteOnObservedOutboundTx(
+			observers[0].Address.String(),
+			cctx.Index,
+			RandomHash(r),
+			uint64(r.Int63n(1_000_000)),
+			0,
+			sdkmath.ZeroInt(),
+			0,
+			outbound.Amount,
+			status,
+			outbound.ReceiverChainId,
+			outbound.OutboundTxTssNonce,
+			outbound.CoinType,
+		)
+
+		opMsg, _, err := deliverTx(r, app, ctx, ak, bk, observers[0], msg)
+		if err != nil {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simtypes.FutureOperation, 0, len(observers)-1)
+		for _, observer := range observers[1:] {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + simtypes.RandIntBetween(r, 1, 3),
+				Op:          operationVoteOnObservedOutboundTx(k, ak, bk, *msg, observer),
+			})
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
+// operationVoteOnObservedOutboundTx votes for an outbound already observed with a different observer
+// The vote is skipped if the outbound of the cctx has changed since the observation
+func operationVoteOnObservedOutboundTx(
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	msg types.MsgVoteOnObservedOutboundTx,
+	observer simtypes.Account,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		cctx, found := k.GetCrossChainTx(ctx, msg.CctxHash)
+		if !found || !keeper.IsPending(cctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "cctx not pending"), nil, nil
+		}
+		outbound := cctx.GetCurrentOutTxParam()
+		if outbound.ReceiverChainId != msg.OutTxChain ||
+			outbound.OutboundTxTssNonce != msg.OutTxTssNonce ||
+			!outbound.Amount.Equal(msg.ValueReceived) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "cctx outbound changed"), nil, nil
+		}
+		chain := k.GetObserverKeeper().GetParams(ctx).GetChainFromChainID(msg.OutTxChain)
+		if chain == nil || !k.GetObserverKeeper().IsAuthorized(ctx, observer.Address.String(), chain) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "observer not authorized"), nil, nil
+		}
+		if !canVote(ctx, k, msg.Digest(), observer) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "observer can't vote on the ballot"), nil, nil
+		}
+
+		msg.Creator = observer.Address.String()
+		return deliverTx(r, app, ctx, ak, bk, observer, &msg)
+	}
+}
+
+// SimulateMsgGasPriceVoter generates a MsgGasPriceVoter with a random gas price for a random external chain
+func SimulateMsgGasPriceVoter(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgGasPriceVoter{}).Type()
+
+		// the gas price is set in the system contract
+		if _, found := k.GetFungibleKeeper().GetSystemContract(ctx); !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "system contract not deployed"), nil, nil
+		}
+		chain, found := randomExternalChain(r, k.GetObserverKeeper().GetParams(ctx).GetSupportedChains())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no supported external chain"), nil, nil
+		}
+		observers := authorizedObservers(ctx, k, chain, accs)
+		if len(observers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no authorized observer"), nil, nil
+		}
+		observer := observers[r.Intn(len(observers))]
+
+		msg := types.NewMsgGasPriceVoter(
+			observer.Address.String(),
+			chain.ChainId,
+			uint64(simtypes.RandIntBetween(r, 1, 1_000_000_000)),
+			uint64(simtypes.RandIntBetween(r, 0, 1_000_000)),
+			"",
+			uint64(ctx.BlockHeight()),
+		)
+		return deliverTx(r, app, ctx, ak, bk, observer, msg)
+	}
+}
+
+// SimulateMsgAddToOutTxTracker generates a MsgAddToOutTxTracker for the outbound of a random pending cctx
+// The tracker is added by an observer or by the admin policy account
+func SimulateMsgAddToOutTxTracker(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		cctx, found := randomPendingCctx(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToOutTxTracker, "no pending cctx"), nil, nil
+		}
+		outbound := cctx.GetCurrentOutTxParam()
+		chain := k.GetObserverKeeper().GetParams(ctx).GetChainFromChainID(outbound.ReceiverChainId)
+		if chain == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToOutTxTracker, "receiver chain not supported"), nil, nil
+		}
+		simAccount, found := randomObserverOrAdmin(r, ctx, k, chain, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToOutTxTracker, "no authorized account"), nil, nil
+		}
+
+		msg := types.NewMsgAddToOutTxTracker(
+			simAccount.Address.String(),
+			outbound.ReceiverChainId,
+			outbound.OutboundTxTssNonce,
+			RandomHash(r),
+			nil,
+			"",
+			0,
+		)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgRemoveFromOutTxTracker generates a MsgRemoveFromOutTxTracker for a random outbound tracker
+func SimulateMsgRemoveFromOutTxTracker(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		trackers := k.GetAllOutTxTracker(ctx)
+		if len(trackers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromOutTxTracker, "no outbound tracker"), nil, nil
+		}
+		tracker := trackers[r.Intn(len(trackers))]
+
+		admin := k.GetObserverKeeper().GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group1)
+		simAccount, found := FindAccount(accs, admin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveFromOutTxTracker, "admin policy account not found"), nil, nil
+		}
+
+		msg := types.NewMsgRemoveFromOutTxTracker(simAccount.Address.String(), tracker.ChainId, tracker.Nonce)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgAddToInTxTracker generates a MsgAddToInTxTracker for a random inbound of an external chain
+// The tracker is added by an observer or by the admin policy account
+func SimulateMsgAddToInTxTracker(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		chain, found := randomExternalChain(r, k.GetObserverKeeper().GetParams(ctx).GetSupportedChains())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToInTxTracker, "no supported external chain"), nil, nil
+		}
+		simAccount, found := randomObserverOrAdmin(r, ctx, k, chain, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddToInTxTracker, "no authorized account"), nil, nil
+		}
+
+		msg := types.NewMsgAddToInTxTracker(simAccount.Address.String(), chain.ChainId, RandomCoinType(r), RandomHash(r))
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// deliverTx delivers a tx containing the message signed by the simulation account with random fees
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// authorizedObservers returns the simulation accounts authorized to vote for the chain
+func authorizedObservers(ctx sdk.Context, k keeper.Keeper, chain *common.Chain, accs []simtypes.Account) []simtypes.Account {
+	observerMapper, found := k.GetObserverKeeper().GetObserverMapper(ctx, chain)
+	if !found {
+		return nil
+	}
+	var observers []simtypes.Account
+	for _, observer := range observerMapper.ObserverList {
+		simAccount, found := FindAccount(accs, observer)
+		if found && k.GetObserverKeeper().IsAuthorized(ctx, observer, chain) {
+			observers = append(observers, simAccount)
+		}
+	}
+	return observers
+}
+
+// randomObserverOrAdmin returns either an observer of the chain or the admin policy account
+func randomObserverOrAdmin(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	chain *common.Chain,
+	accs []simtypes.Account,
+) (simtypes.Account, bool) {
+	if r.Intn(2) == 0 {
+		admin := k.GetObserverKeeper().GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group1)
+		return FindAccount(accs, admin)
+	}
+	observers := authorizedObservers(ctx, k, chain, accs)
+	if len(observers) == 0 {
+		return simtypes.Account{}, false
+	}
+	return observers[r.Intn(len(observers))], true
+}
+
+// canVote checks the observer is a voter of the ballot that hasn't voted yet
+func canVote(ctx sdk.Context, k keeper.Keeper, ballotIndex string, observer simtypes.Account) bool {
+	ballot, found := k.GetObserverKeeper().GetBallot(ctx, ballotIndex)
+	if !found {
+		return false
+	}
+	address := observer.Address.String()
+	return ballot.GetVoterIndex(address) != -1 && !ballot.HasVoted(address)
+}
+
+// randomPendingCctx returns a random cctx waiting for an outbound
+func randomPendingCctx(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.CrossChainTx, bool) {
+	var pending []types.CrossChainTx
+	for _, cctx := range k.GetAllCrossChainTx(ctx) {
+		if keeper.IsPending(cctx) {
+			pending = append(pending, cctx)
+		}
+	}
+	if len(pending) == 0 {
+		return types.CrossChainTx{}, false
+	}
+	return pending[r.Intn(len(pending))], true
+}
+
+// randomExternalChain returns a random external chain from the list
+func randomExternalChain(r *rand.Rand, chains []*common.Chain) (*common.Chain, bool) {
+	var externalChains []*common.Chain
+	for _, chain := range chains {
+		if chain.IsExternalChain() {
+			externalChains = append(externalChains, chain)
+		}
+	}
+	if len(externalChains) == 0 {
+		return nil, false
+	}
+	return externalChains[r.Intn(len(externalChains))], true
+}
+
+// randomEthAddress returns a random EVM address
+func randomEthAddress(r *rand.Rand) ethcommon.Address {
+	b := make([]byte, ethcommon.AddressLength)
+	r.Read(b)
+	return ethcommon.BytesToAddress(b)
+}
@@ -6,10 +6,11 @@ import (
 )
 
 // FindAccount find a specific address from an account list
+// An invalid address is not found in the list
 func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
 	creator, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
-		panic(err)
+		return simtypes.Account{}, false
 	}
 	return simtypes.FindAccount(accs, creator)
 }
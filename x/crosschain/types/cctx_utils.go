@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -33,6 +34,19 @@ func (m CrossChainTx) OriginalDestinationChainID() int64 {
 	return m.OutboundTxParams[0].ReceiverChainId
 }
 
+// NonceGapCancelTimeout is the time after which the outbound tx of a cctx blocking the nonces of a chain can be cancelled
+// It is counted from the last status update of the cctx so that all the signers agree on the cancellation
+const NonceGapCancelTimeout = 30 * time.Minute
+
+// IsNonceGapCancelDue returns true if the cctx has been pending longer than NonceGapCancelTimeout
+func (m CrossChainTx) IsNonceGapCancelDue(now time.Time) bool {
+	if m.CctxStatus == nil {
+		return false
+	}
+	lastUpdate := time.Unix(m.CctxStatus.LastUpdateTimestamp, 0)
+	return now.After(lastUpdate.Add(NonceGapCancelTimeout))
+}
+
 // GetAllAuthzZetaclientTxTypes returns all the authz types for zetaclient
 func GetAllAuthzZetaclientTxTypes() []string {
 	return []string{
@@ -41,6 +55,7 @@ func GetAllAuthzZetaclientTxTypes() []string {
 		sdk.MsgTypeURL(&MsgVoteOnObservedOutboundTx{}),
 		sdk.MsgTypeURL(&MsgCreateTSSVoter{}),
 		sdk.MsgTypeURL(&MsgAddToOutTxTracker{}),
+		sdk.MsgTypeURL(&MsgReportOutTxNonceGap{}),
 		sdk.MsgTypeURL(&observertypes.MsgAddBlameVote{}),
 		sdk.MsgTypeURL(&observertypes.MsgAddBlockHeader{}),
 	}
@@ -55,3 +70,16 @@ func (m OutboundTxParams) GetGasPrice() (uint64, error) {
 
 	return gasPrice, nil
 }
+
+// GetPriorityFee returns the priority fee of the outbound tx, 0 if the outbound tx is a legacy tx
+func (m OutboundTxParams) GetPriorityFee() (uint64, error) {
+	if m.OutboundTxGasPriorityFee == "" {
+		return 0, nil
+	}
+	priorityFee, err := strconv.ParseUint(m.OutboundTxGasPriorityFee, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse cctx priority fee %s: %s", m.OutboundTxGasPriorityFee, err.Error())
+	}
+
+	return priorityFee, nil
+}
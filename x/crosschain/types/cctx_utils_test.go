@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/testutil/sample"
@@ -23,6 +24,20 @@ func TestCrossChainTx_GetCurrentOutTxParam(t *testing.T) {
 	require.Equal(t, cctx.OutboundTxParams[1], cctx.GetCurrentOutTxParam())
 }
 
+func TestCrossChainTx_IsNonceGapCancelDue(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cctx := sample.CrossChainTx(t, "foo")
+
+	cctx.CctxStatus.LastUpdateTimestamp = now.Add(-types.NonceGapCancelTimeout).Unix()
+	require.False(t, cctx.IsNonceGapCancelDue(now))
+
+	cctx.CctxStatus.LastUpdateTimestamp = now.Add(-types.NonceGapCancelTimeout - time.Second).Unix()
+	require.True(t, cctx.IsNonceGapCancelDue(now))
+
+	cctx.CctxStatus = nil
+	require.False(t, cctx.IsNonceGapCancelDue(now))
+}
+
 func TestCrossChainTx_IsCurrentOutTxRevert(t *testing.T) {
 	r := rand.New(rand.NewSource(42))
 	cctx := sample.CrossChainTx(t, "foo")
@@ -65,3 +80,23 @@ func TestOutboundTxParams_GetGasPrice(t *testing.T) {
 	_, err = outTxParams.GetGasPrice()
 	require.Error(t, err)
 }
+
+func TestOutboundTxParams_GetPriorityFee(t *testing.T) {
+	// #nosec G404 - random seed is not used for security purposes
+	r := rand.New(rand.NewSource(42))
+	outTxParams := sample.OutboundTxParams(r)
+
+	outTxParams.OutboundTxGasPriorityFee = ""
+	priorityFee, err := outTxParams.GetPriorityFee()
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(0), priorityFee)
+
+	outTxParams.OutboundTxGasPriorityFee = "42"
+	priorityFee, err = outTxParams.GetPriorityFee()
+	require.NoError(t, err)
+	require.EqualValues(t, uint64(42), priorityFee)
+
+	outTxParams.OutboundTxGasPriorityFee = "invalid"
+	_, err = outTxParams.GetPriorityFee()
+	require.Error(t, err)
+}
@@ -10,7 +10,9 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddToOutTxTracker{}, "crosschain/AddToOutTxTracker", nil)
 	cdc.RegisterConcrete(&MsgAddToInTxTracker{}, "crosschain/AddToInTxTracker", nil)
+	cdc.RegisterConcrete(&MsgAddProvenInboundTx{}, "crosschain/AddProvenInboundTx", nil)
 	cdc.RegisterConcrete(&MsgRemoveFromOutTxTracker{}, "crosschain/RemoveFromOutTxTracker", nil)
+	cdc.RegisterConcrete(&MsgReportOutTxNonceGap{}, "crosschain/ReportOutTxNonceGap", nil)
 	cdc.RegisterConcrete(&MsgCreateTSSVoter{}, "crosschain/CreateTSSVoter", nil)
 	cdc.RegisterConcrete(&MsgGasPriceVoter{}, "crosschain/GasPriceVoter", nil)
 	cdc.RegisterConcrete(&MsgVoteOnObservedOutboundTx{}, "crosschain/VoteOnObservedOutboundTx", nil)
@@ -24,7 +26,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddToOutTxTracker{},
 		&MsgAddToInTxTracker{},
+		&MsgAddProvenInboundTx{},
 		&MsgRemoveFromOutTxTracker{},
+		&MsgReportOutTxNonceGap{},
 		&MsgCreateTSSVoter{},
 		&MsgGasPriceVoter{},
 		&MsgVoteOnObservedOutboundTx{},
@@ -74,6 +74,7 @@ type InboundTxParams struct {
 	InboundTxObservedExternalHeight uint64                                  `protobuf:"varint,8,opt,name=inbound_tx_observed_external_height,json=inboundTxObservedExternalHeight,proto3" json:"inbound_tx_observed_external_height,omitempty"`
 	InboundTxBallotIndex            string                                  `protobuf:"bytes,9,opt,name=inbound_tx_ballot_index,json=inboundTxBallotIndex,proto3" json:"inbound_tx_ballot_index,omitempty"`
 	InboundTxFinalizedZetaHeight    uint64                                  `protobuf:"varint,10,opt,name=inbound_tx_finalized_zeta_height,json=inboundTxFinalizedZetaHeight,proto3" json:"inbound_tx_finalized_zeta_height,omitempty"`
+	InboundTxProven                 bool                                    `protobuf:"varint,11,opt,name=inbound_tx_proven,json=inboundTxProven,proto3" json:"inbound_tx_proven,omitempty"`
 }
 
 func (m *InboundTxParams) Reset()         { *m = InboundTxParams{} }
@@ -172,6 +173,13 @@ func (m *InboundTxParams) GetInboundTxFinalizedZetaHeight() uint64 {
 	return 0
 }
 
+func (m *InboundTxParams) GetInboundTxProven() bool {
+	if m != nil {
+		return m.InboundTxProven
+	}
+	return false
+}
+
 type ZetaAccounting struct {
 	// aborted_zeta_amount stores the total aborted amount for cctx of coin-type ZETA
 	AbortedZetaAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=aborted_zeta_amount,json=abortedZetaAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"aborted_zeta_amount"`
@@ -218,6 +226,8 @@ type OutboundTxParams struct {
 	OutboundTxTssNonce uint64                                  `protobuf:"varint,5,opt,name=outbound_tx_tss_nonce,json=outboundTxTssNonce,proto3" json:"outbound_tx_tss_nonce,omitempty"`
 	OutboundTxGasLimit uint64                                  `protobuf:"varint,6,opt,name=outbound_tx_gas_limit,json=outboundTxGasLimit,proto3" json:"outbound_tx_gas_limit,omitempty"`
 	OutboundTxGasPrice string                                  `protobuf:"bytes,7,opt,name=outbound_tx_gas_price,json=outboundTxGasPrice,proto3" json:"outbound_tx_gas_price,omitempty"`
+	// priority fee of the EIP-1559 outbound tx, empty for a legacy outbound tx
+	OutboundTxGasPriorityFee string `protobuf:"bytes,23,opt,name=outbound_tx_gas_priority_fee,json=outboundTxGasPriorityFee,proto3" json:"outbound_tx_gas_priority_fee,omitempty"`
 	// the above are commands for zetaclients
 	// the following fields are used when the outbound tx is mined
 	OutboundTxHash                   string                                 `protobuf:"bytes,8,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
@@ -304,6 +314,13 @@ func (m *OutboundTxParams) GetOutboundTxGasPrice() string {
 	return ""
 }
 
+func (m *OutboundTxParams) GetOutboundTxGasPriorityFee() string {
+	if m != nil {
+		return m.OutboundTxGasPriorityFee
+	}
+	return ""
+}
+
 func (m *OutboundTxParams) GetOutboundTxHash() string {
 	if m != nil {
 		return m.OutboundTxHash
@@ -503,72 +520,75 @@ func init() {
 func init() { proto.RegisterFile("crosschain/cross_chain_tx.proto", fileDescriptor_af3a0ad055343c21) }
 
 var fileDescriptor_af3a0ad055343c21 = []byte{
-	// 1037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6e, 0x1b, 0xb7,
-	0x13, 0xf6, 0xfe, 0x24, 0xcb, 0xd2, 0x28, 0x96, 0xd6, 0xb4, 0x9c, 0xdf, 0xc2, 0x69, 0x24, 0x41,
-	0x6d, 0x12, 0xa5, 0x80, 0x25, 0xd8, 0x45, 0x11, 0xa0, 0x37, 0xdb, 0x8d, 0x13, 0xa3, 0x49, 0x6c,
-	0x6c, 0xed, 0x8b, 0x81, 0x62, 0x4b, 0xed, 0x8e, 0x25, 0x22, 0xd2, 0x52, 0x5d, 0x52, 0x86, 0x1c,
-	0xf4, 0xd4, 0x27, 0xe8, 0x43, 0xb4, 0x40, 0xfb, 0x26, 0x39, 0xf4, 0x90, 0x63, 0xd1, 0x83, 0x51,
-	0xd8, 0x6f, 0xd0, 0x27, 0x28, 0x48, 0xee, 0x4a, 0x2b, 0xd5, 0x8e, 0xfb, 0xe7, 0xb4, 0xc3, 0x21,
-	0xbf, 0x8f, 0x33, 0xc3, 0x6f, 0xc8, 0x85, 0x9a, 0x1f, 0x71, 0x21, 0xfc, 0x1e, 0x65, 0x61, 0x5b,
-	0x9b, 0x9e, 0xb6, 0x3d, 0x39, 0x6e, 0x0d, 0x23, 0x2e, 0x39, 0xb9, 0xff, 0x06, 0x25, 0xd5, 0xbe,
-	0x96, 0xb6, 0x78, 0x84, 0xad, 0x29, 0x66, 0x7d, 0xd5, 0xe7, 0x83, 0x01, 0x0f, 0xdb, 0xe6, 0x63,
-	0x30, 0xeb, 0x95, 0x2e, 0xef, 0x72, 0x6d, 0xb6, 0x95, 0x65, 0xbc, 0x8d, 0xef, 0xb2, 0x50, 0xde,
-	0x0f, 0x3b, 0x7c, 0x14, 0x06, 0x47, 0xe3, 0x43, 0x1a, 0xd1, 0x81, 0x20, 0x77, 0x21, 0x27, 0x30,
-	0x0c, 0x30, 0x72, 0xac, 0xba, 0xd5, 0x2c, 0xb8, 0xf1, 0x88, 0x3c, 0x84, 0xb2, 0xb1, 0xe2, 0x70,
-	0x58, 0xe0, 0xfc, 0xaf, 0x6e, 0x35, 0x33, 0xee, 0xb2, 0x71, 0xef, 0x2a, 0xef, 0x7e, 0x40, 0xee,
-	0x41, 0x41, 0x8e, 0x3d, 0x1e, 0xb1, 0x2e, 0x0b, 0x9d, 0x8c, 0xa6, 0xc8, 0xcb, 0xf1, 0x81, 0x1e,
-	0x93, 0x0d, 0x28, 0xf8, 0x5c, 0xe5, 0x72, 0x3e, 0x44, 0x27, 0x5b, 0xb7, 0x9a, 0xa5, 0x2d, 0xbb,
-	0x15, 0x07, 0xba, 0xcb, 0x59, 0x78, 0x74, 0x3e, 0x44, 0x37, 0xef, 0xc7, 0x16, 0xa9, 0xc0, 0x22,
-	0x15, 0x02, 0xa5, 0xb3, 0xa8, 0x79, 0xcc, 0x80, 0x3c, 0x83, 0x1c, 0x1d, 0xf0, 0x51, 0x28, 0x9d,
-	0x9c, 0x72, 0xef, 0xb4, 0xdf, 0x5e, 0xd4, 0x16, 0x7e, 0xbb, 0xa8, 0x3d, 0xea, 0x32, 0xd9, 0x1b,
-	0x75, 0x14, 0x5f, 0xdb, 0xe7, 0x62, 0xc0, 0x45, 0xfc, 0xd9, 0x10, 0xc1, 0xeb, 0xb6, 0xda, 0x52,
-	0xb4, 0x8e, 0x59, 0x28, 0xdd, 0x18, 0x4e, 0x9e, 0x80, 0xc3, 0x4c, 0xf6, 0x9e, 0x0a, 0xb9, 0x23,
-	0x30, 0x3a, 0xc3, 0xc0, 0xeb, 0x51, 0xd1, 0x73, 0x96, 0xf4, 0x8e, 0x6b, 0x2c, 0xa9, 0xce, 0x41,
-	0x3c, 0xfb, 0x9c, 0x8a, 0x1e, 0x79, 0x01, 0x1f, 0x5e, 0x07, 0xc4, 0xb1, 0xc4, 0x28, 0xa4, 0x7d,
-	0xaf, 0x87, 0xac, 0xdb, 0x93, 0x4e, 0xbe, 0x6e, 0x35, 0xb3, 0x6e, 0xed, 0x2f, 0x1c, 0x4f, 0xe3,
-	0x75, 0xcf, 0xf5, 0x32, 0xf2, 0x29, 0xfc, 0x3f, 0xc5, 0xd6, 0xa1, 0xfd, 0x3e, 0x97, 0x1e, 0x0b,
-	0x03, 0x1c, 0x3b, 0x05, 0x1d, 0x45, 0x65, 0xc2, 0xb0, 0xa3, 0x27, 0xf7, 0xd5, 0x1c, 0xd9, 0x83,
-	0x7a, 0x0a, 0x76, 0xca, 0x42, 0xda, 0x67, 0x6f, 0x30, 0xf0, 0x94, 0x26, 0x92, 0x08, 0x40, 0x47,
-	0xf0, 0xc1, 0x04, 0xbf, 0x97, 0xac, 0x3a, 0x41, 0x49, 0xcd, 0xf6, 0x8d, 0x6f, 0xa0, 0xa4, 0x46,
-	0xdb, 0xbe, 0xaf, 0x8a, 0xc2, 0xc2, 0x2e, 0xf1, 0x60, 0x95, 0x76, 0x78, 0x24, 0x13, 0xb2, 0xb8,
-	0xda, 0xd6, 0xbf, 0xab, 0xf6, 0x4a, 0xcc, 0xa5, 0x37, 0xd1, 0x4c, 0x8d, 0x9f, 0x73, 0x60, 0x1f,
-	0x8c, 0xe4, 0xac, 0xf0, 0xd6, 0x21, 0x1f, 0xa1, 0x8f, 0xec, 0x6c, 0x22, 0xbd, 0xc9, 0x98, 0x3c,
-	0x06, 0x3b, 0xb1, 0x8d, 0xfc, 0xf6, 0x13, 0xf5, 0x95, 0x13, 0x7f, 0xa2, 0xbf, 0x19, 0x89, 0x65,
-	0x6e, 0x95, 0xd8, 0x54, 0x4c, 0xd9, 0xff, 0x26, 0xa6, 0x4d, 0x58, 0xe3, 0x71, 0x4a, 0xea, 0x3c,
-	0xa4, 0x10, 0x5e, 0xc8, 0x43, 0x1f, 0xb5, 0x76, 0xb3, 0x2e, 0xe1, 0x93, 0x7c, 0x8f, 0x84, 0x78,
-	0xa5, 0x66, 0xe6, 0x21, 0x5d, 0x2a, 0xbc, 0x3e, 0x1b, 0x30, 0xa3, 0xeb, 0x19, 0xc8, 0x33, 0x2a,
-	0x5e, 0xa8, 0x99, 0xeb, 0x20, 0xc3, 0x88, 0xf9, 0x18, 0xeb, 0x75, 0x16, 0x72, 0xa8, 0x66, 0x48,
-	0x13, 0xec, 0x34, 0x44, 0xab, 0x3b, 0xaf, 0x57, 0x97, 0xa6, 0xab, 0xb5, 0xac, 0x9f, 0x80, 0x93,
-	0x5e, 0x79, 0x8d, 0x12, 0xd7, 0xa6, 0x88, 0xb4, 0x14, 0x5f, 0xc1, 0x47, 0x69, 0xe0, 0x8d, 0x0d,
-	0x61, 0xe4, 0x58, 0x9f, 0x92, 0xdc, 0xd0, 0x11, 0x6d, 0xa8, 0xcc, 0x67, 0x39, 0x12, 0x18, 0x38,
-	0x15, 0x8d, 0x5f, 0x99, 0x49, 0xf2, 0x58, 0x60, 0x40, 0x24, 0xd4, 0xd2, 0x00, 0x3c, 0x3d, 0x45,
-	0x5f, 0xb2, 0x33, 0x4c, 0x15, 0x68, 0x4d, 0x1f, 0x6f, 0x2b, 0x3e, 0xde, 0x87, 0x7f, 0xe3, 0x78,
-	0xf7, 0x43, 0xe9, 0xde, 0x9b, 0xee, 0xf5, 0x34, 0x21, 0x9d, 0x54, 0xf6, 0xf3, 0xf7, 0xed, 0x6a,
-	0x4e, 0xf2, 0xae, 0x8e, 0xf8, 0x06, 0x16, 0x73, 0xa4, 0xf7, 0x01, 0x94, 0x58, 0x86, 0xa3, 0xce,
-	0x6b, 0x3c, 0x77, 0x8a, 0xba, 0xce, 0x05, 0x29, 0xc4, 0xa1, 0x76, 0x34, 0x7e, 0xb4, 0x20, 0xf7,
-	0xa5, 0xa4, 0x72, 0x24, 0xc8, 0x36, 0xe4, 0x84, 0xb6, 0x74, 0x7f, 0x94, 0xb6, 0x1e, 0xb7, 0xde,
-	0xfb, 0x12, 0xb4, 0x76, 0x7d, 0x39, 0x36, 0x50, 0x37, 0x06, 0x92, 0x07, 0x50, 0x32, 0x96, 0x37,
-	0x40, 0x21, 0x68, 0x17, 0x75, 0x1b, 0x15, 0xdc, 0x65, 0xe3, 0x7d, 0x69, 0x9c, 0x64, 0x13, 0x2a,
-	0x7d, 0x2a, 0xe4, 0xf1, 0x30, 0xa0, 0x12, 0x3d, 0xc9, 0x06, 0x28, 0x24, 0x1d, 0x0c, 0x75, 0x3f,
-	0x65, 0xdc, 0xd5, 0xe9, 0xdc, 0x51, 0x32, 0xd5, 0xf8, 0x25, 0x03, 0x77, 0x76, 0xd5, 0xde, 0xba,
-	0x11, 0x8f, 0xc6, 0xc4, 0x81, 0x25, 0x3f, 0x42, 0x2a, 0x79, 0xd2, 0xce, 0xc9, 0x50, 0x5d, 0xeb,
-	0x46, 0x54, 0x66, 0x6f, 0x33, 0x20, 0x5f, 0x43, 0x41, 0xdf, 0x36, 0xa7, 0x88, 0xc2, 0x5c, 0xf8,
-	0x3b, 0xbb, 0xff, 0xb0, 0x19, 0xff, 0xb8, 0xa8, 0xd9, 0xe7, 0x74, 0xd0, 0xff, 0xac, 0x31, 0x61,
-	0x6a, 0xb8, 0x79, 0x65, 0xef, 0x21, 0x0a, 0xf2, 0x08, 0xca, 0x11, 0xf6, 0xe9, 0x39, 0x06, 0x93,
-	0xec, 0x73, 0xa6, 0x11, 0x62, 0x77, 0x92, 0xfe, 0x1e, 0x14, 0x7d, 0x5f, 0x8e, 0xbd, 0xb8, 0xda,
-	0xaa, 0x5b, 0x8a, 0x5b, 0x0f, 0x6e, 0xa9, 0x76, 0x5c, 0x69, 0xf0, 0x27, 0x55, 0x27, 0x27, 0xb0,
-	0x92, 0xba, 0xa2, 0x87, 0xfa, 0x9e, 0xd3, 0x9d, 0x54, 0xdc, 0x6a, 0xdd, 0xc2, 0x36, 0xf7, 0x2c,
-	0xbb, 0x65, 0x36, 0xf7, 0x4e, 0x7f, 0x05, 0x24, 0x2d, 0xbe, 0x98, 0x1c, 0xea, 0x99, 0x66, 0x71,
-	0xab, 0x7d, 0x0b, 0xf9, 0xfc, 0xdd, 0xeb, 0xda, 0x7c, 0xce, 0xf3, 0xf1, 0xb7, 0x00, 0x53, 0xf9,
-	0x10, 0x02, 0xa5, 0x43, 0x0c, 0x03, 0x16, 0x76, 0xe3, 0xb8, 0xec, 0x05, 0xb2, 0x0a, 0xe5, 0xd8,
-	0x97, 0xd0, 0xd9, 0x16, 0x59, 0x81, 0xe5, 0x64, 0xf4, 0x92, 0x85, 0x18, 0xd8, 0x19, 0xe5, 0x8a,
-	0xd7, 0xb9, 0x78, 0x86, 0x91, 0xb4, 0xb3, 0xe4, 0x0e, 0xe4, 0x8d, 0x8d, 0x81, 0xbd, 0x48, 0x8a,
-	0xb0, 0xb4, 0x6d, 0x9e, 0x08, 0x3b, 0xb7, 0x9e, 0xfd, 0xe9, 0x87, 0xaa, 0xb5, 0xf3, 0xc5, 0xdb,
-	0xcb, 0xaa, 0xf5, 0xee, 0xb2, 0x6a, 0xfd, 0x7e, 0x59, 0xb5, 0xbe, 0xbf, 0xaa, 0x2e, 0xbc, 0xbb,
-	0xaa, 0x2e, 0xfc, 0x7a, 0x55, 0x5d, 0x38, 0xd9, 0x4c, 0x49, 0x41, 0xa5, 0xb6, 0x61, 0x7e, 0x94,
-	0x92, 0x2c, 0xdb, 0xe3, 0x76, 0xea, 0xf7, 0x49, 0x2b, 0xa3, 0x93, 0xd3, 0x3f, 0x3b, 0x9f, 0xfc,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x5f, 0xb2, 0x04, 0x59, 0x09, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x3f, 0xc9, 0xb2, 0x74, 0x15, 0x4b, 0xf4, 0x58, 0x4e, 0x08, 0x27, 0x91, 0x04, 0x7d,
+	0x4d, 0xa2, 0x04, 0xb0, 0x04, 0xbb, 0x28, 0x02, 0x74, 0x51, 0xc0, 0x76, 0xe3, 0xc4, 0x68, 0x12,
+	0x1b, 0xac, 0xbd, 0x31, 0x50, 0xb0, 0x23, 0xf2, 0x5a, 0x1a, 0x44, 0xe2, 0xa8, 0x9c, 0x91, 0x21,
+	0x05, 0x7d, 0x88, 0x3e, 0x44, 0x0b, 0x74, 0xd5, 0xe7, 0xc8, 0xa2, 0x8b, 0x2c, 0x8b, 0x2e, 0x8c,
+	0xc2, 0x5e, 0x75, 0xdb, 0x27, 0x28, 0x38, 0x43, 0x52, 0x94, 0x6a, 0xc7, 0xfd, 0x59, 0xf1, 0xce,
+	0x9d, 0x39, 0xe7, 0xfe, 0xf0, 0xdc, 0x21, 0xa1, 0xe6, 0x06, 0x5c, 0x08, 0xb7, 0x47, 0x99, 0xdf,
+	0x56, 0xa6, 0xa3, 0x6c, 0x47, 0x8e, 0x5b, 0xc3, 0x80, 0x4b, 0x4e, 0xee, 0xbf, 0x45, 0x49, 0x95,
+	0xaf, 0xa5, 0x2c, 0x1e, 0x60, 0x6b, 0x8a, 0x59, 0x5f, 0x75, 0xf9, 0x60, 0xc0, 0xfd, 0xb6, 0x7e,
+	0x68, 0xcc, 0x7a, 0xa5, 0xcb, 0xbb, 0x5c, 0x99, 0xed, 0xd0, 0xd2, 0xde, 0xc6, 0x4f, 0x59, 0x28,
+	0xef, 0xfb, 0x1d, 0x3e, 0xf2, 0xbd, 0xa3, 0xf1, 0x21, 0x0d, 0xe8, 0x40, 0x90, 0xdb, 0x90, 0x13,
+	0xe8, 0x7b, 0x18, 0x58, 0x46, 0xdd, 0x68, 0x16, 0xec, 0x68, 0x45, 0x1e, 0x42, 0x59, 0x5b, 0x51,
+	0x3a, 0xcc, 0xb3, 0xfe, 0x57, 0x37, 0x9a, 0x19, 0x7b, 0x59, 0xbb, 0x77, 0x43, 0xef, 0xbe, 0x47,
+	0xee, 0x42, 0x41, 0x8e, 0x1d, 0x1e, 0xb0, 0x2e, 0xf3, 0xad, 0x8c, 0xa2, 0xc8, 0xcb, 0xf1, 0x81,
+	0x5a, 0x93, 0x0d, 0x28, 0xb8, 0x3c, 0xac, 0x65, 0x32, 0x44, 0x2b, 0x5b, 0x37, 0x9a, 0xa5, 0x2d,
+	0xb3, 0x15, 0x25, 0xba, 0xcb, 0x99, 0x7f, 0x34, 0x19, 0xa2, 0x9d, 0x77, 0x23, 0x8b, 0x54, 0x60,
+	0x91, 0x0a, 0x81, 0xd2, 0x5a, 0x54, 0x3c, 0x7a, 0x41, 0x9e, 0x43, 0x8e, 0x0e, 0xf8, 0xc8, 0x97,
+	0x56, 0x2e, 0x74, 0xef, 0xb4, 0xdf, 0x9d, 0xd7, 0x16, 0x7e, 0x3d, 0xaf, 0x3d, 0xea, 0x32, 0xd9,
+	0x1b, 0x75, 0x42, 0xbe, 0xb6, 0xcb, 0xc5, 0x80, 0x8b, 0xe8, 0xb1, 0x21, 0xbc, 0x37, 0xed, 0x30,
+	0xa4, 0x68, 0x1d, 0x33, 0x5f, 0xda, 0x11, 0x9c, 0x3c, 0x05, 0x8b, 0xe9, 0xea, 0x9d, 0x30, 0xe5,
+	0x8e, 0xc0, 0xe0, 0x0c, 0x3d, 0xa7, 0x47, 0x45, 0xcf, 0x5a, 0x52, 0x11, 0xd7, 0x58, 0xdc, 0x9d,
+	0x83, 0x68, 0xf7, 0x05, 0x15, 0x3d, 0xf2, 0x12, 0xfe, 0x7f, 0x15, 0x10, 0xc7, 0x12, 0x03, 0x9f,
+	0xf6, 0x9d, 0x1e, 0xb2, 0x6e, 0x4f, 0x5a, 0xf9, 0xba, 0xd1, 0xcc, 0xda, 0xb5, 0xbf, 0x70, 0x3c,
+	0x8b, 0xce, 0xbd, 0x50, 0xc7, 0xc8, 0x27, 0x70, 0x27, 0xc5, 0xd6, 0xa1, 0xfd, 0x3e, 0x97, 0x0e,
+	0xf3, 0x3d, 0x1c, 0x5b, 0x05, 0x95, 0x45, 0x25, 0x61, 0xd8, 0x51, 0x9b, 0xfb, 0xe1, 0x1e, 0xd9,
+	0x83, 0x7a, 0x0a, 0x76, 0xca, 0x7c, 0xda, 0x67, 0x6f, 0xd1, 0x73, 0x42, 0x4d, 0xc4, 0x19, 0x80,
+	0xca, 0xe0, 0x5e, 0x82, 0xdf, 0x8b, 0x4f, 0x9d, 0xa0, 0xa4, 0x51, 0xf8, 0x27, 0xb0, 0x92, 0xe2,
+	0x19, 0x06, 0xfc, 0x0c, 0x7d, 0xab, 0x58, 0x37, 0x9a, 0x79, 0xbb, 0x9c, 0x00, 0x0f, 0x95, 0xbb,
+	0xf1, 0x0d, 0x94, 0x42, 0xe4, 0xb6, 0xeb, 0x86, 0x0d, 0x64, 0x7e, 0x97, 0x38, 0xb0, 0x4a, 0x3b,
+	0x3c, 0x90, 0x71, 0xe0, 0xe8, 0xcd, 0x18, 0xff, 0xee, 0xcd, 0xac, 0x44, 0x5c, 0x2a, 0x88, 0x62,
+	0x6a, 0xfc, 0x9e, 0x03, 0xf3, 0x60, 0x24, 0x67, 0x45, 0xba, 0x0e, 0xf9, 0x00, 0x5d, 0x64, 0x67,
+	0x89, 0x4c, 0x93, 0x35, 0x79, 0x0c, 0x66, 0x6c, 0x6b, 0xa9, 0xee, 0xc7, 0x4a, 0x2d, 0xc7, 0xfe,
+	0x58, 0xab, 0x33, 0x72, 0xcc, 0xdc, 0x28, 0xc7, 0xa9, 0xf0, 0xb2, 0xff, 0x4d, 0x78, 0x9b, 0xb0,
+	0xc6, 0x47, 0x32, 0xe9, 0xb9, 0x14, 0xc2, 0xf1, 0xb9, 0xef, 0xa2, 0xd2, 0x79, 0xd6, 0x26, 0x3c,
+	0xa9, 0xf7, 0x48, 0x88, 0xd7, 0xe1, 0xce, 0x3c, 0xa4, 0x4b, 0x85, 0xd3, 0x67, 0x03, 0xa6, 0x67,
+	0x60, 0x06, 0xf2, 0x9c, 0x8a, 0x97, 0xe1, 0xce, 0x55, 0x90, 0x61, 0xc0, 0x5c, 0x8c, 0xb4, 0x3d,
+	0x0b, 0x39, 0x0c, 0x77, 0xc8, 0x67, 0x70, 0xef, 0x0a, 0x08, 0x0f, 0x98, 0x9c, 0x38, 0xa7, 0x88,
+	0xd6, 0x1d, 0x85, 0xb4, 0xe6, 0x91, 0xea, 0xc0, 0x1e, 0x22, 0x69, 0x82, 0x99, 0xc6, 0xab, 0x49,
+	0xca, 0x2b, 0x4c, 0x69, 0x8a, 0x51, 0x23, 0xf4, 0x14, 0xac, 0xf4, 0xc9, 0x2b, 0x54, 0xbf, 0x36,
+	0x45, 0xa4, 0x65, 0xff, 0x1a, 0x3e, 0x4a, 0x03, 0xaf, 0x1d, 0x3e, 0x2d, 0xfd, 0xfa, 0x94, 0xe4,
+	0x9a, 0xe9, 0x6b, 0x43, 0x65, 0xbe, 0xe4, 0x91, 0x40, 0xcf, 0xaa, 0x28, 0xfc, 0xca, 0x4c, 0xa9,
+	0xc7, 0x02, 0x3d, 0x22, 0xa1, 0x96, 0x06, 0xe0, 0xe9, 0x29, 0xba, 0x92, 0x9d, 0x61, 0xaa, 0xc1,
+	0x6b, 0x4a, 0x1e, 0xad, 0x48, 0x1e, 0x0f, 0xff, 0x86, 0x3c, 0xf6, 0x7d, 0x69, 0xdf, 0x9d, 0xc6,
+	0x7a, 0x16, 0x93, 0x26, 0x6f, 0xe6, 0xf3, 0x0f, 0x45, 0xd5, 0x4a, 0xb8, 0xad, 0x32, 0xbe, 0x86,
+	0x45, 0x4b, 0xe2, 0x3e, 0x40, 0x28, 0xb6, 0xe1, 0xa8, 0xf3, 0x06, 0x27, 0x6a, 0xc8, 0x0b, 0x76,
+	0x41, 0x0a, 0x71, 0xa8, 0x1c, 0x8d, 0x1f, 0x0c, 0xc8, 0x7d, 0x29, 0xa9, 0x1c, 0x09, 0xb2, 0x0d,
+	0x39, 0xa1, 0x2c, 0x35, 0x5f, 0xa5, 0xad, 0xc7, 0xad, 0x0f, 0x7e, 0x75, 0x5a, 0xbb, 0xae, 0x1c,
+	0x6b, 0xa8, 0x1d, 0x01, 0xc9, 0x03, 0x28, 0x69, 0xcb, 0x19, 0xa0, 0x10, 0xb4, 0x8b, 0x6a, 0x0c,
+	0x0b, 0xf6, 0xb2, 0xf6, 0xbe, 0xd2, 0x4e, 0xb2, 0x09, 0x95, 0x3e, 0x15, 0xf2, 0x78, 0xe8, 0x51,
+	0x89, 0x8e, 0x64, 0x03, 0x14, 0x92, 0x0e, 0x86, 0x6a, 0x1e, 0x33, 0xf6, 0xea, 0x74, 0xef, 0x28,
+	0xde, 0x6a, 0xfc, 0x9c, 0x81, 0x5b, 0xbb, 0x61, 0x6c, 0x35, 0xc8, 0x47, 0x63, 0x62, 0xc1, 0x92,
+	0x1b, 0x20, 0x95, 0x3c, 0xbe, 0x0e, 0xe2, 0x65, 0xf8, 0x09, 0xd1, 0xa2, 0xd2, 0xb1, 0xf5, 0x82,
+	0x7c, 0x0d, 0x05, 0x75, 0x5b, 0x9d, 0x22, 0x0a, 0xfd, 0x71, 0xd9, 0xd9, 0xfd, 0x87, 0xc3, 0xfc,
+	0xc7, 0x79, 0xcd, 0x9c, 0xd0, 0x41, 0xff, 0xd3, 0x46, 0xc2, 0xd4, 0xb0, 0xf3, 0xa1, 0xbd, 0x87,
+	0x28, 0xc8, 0x23, 0x28, 0x07, 0xd8, 0xa7, 0x13, 0xf4, 0x92, 0xea, 0x73, 0x7a, 0x10, 0x22, 0x77,
+	0x5c, 0xfe, 0x1e, 0x14, 0x5d, 0x57, 0x8e, 0x9d, 0xa8, 0xdb, 0xe1, 0xb4, 0x14, 0xb7, 0x1e, 0xdc,
+	0xd0, 0xed, 0xa8, 0xd3, 0xe0, 0x26, 0x5d, 0x27, 0x27, 0xb3, 0xd7, 0xb8, 0xba, 0x27, 0xd5, 0x24,
+	0x15, 0xb7, 0x5a, 0x37, 0xb0, 0xcd, 0xfd, 0x02, 0xa4, 0xaf, 0x7d, 0xe5, 0x20, 0x5f, 0x01, 0x49,
+	0x8b, 0x2f, 0x22, 0x87, 0x7a, 0xa6, 0x59, 0xdc, 0x6a, 0xdf, 0x40, 0x3e, 0x7f, 0x77, 0xdb, 0x26,
+	0x9f, 0xf3, 0x3c, 0xf9, 0x16, 0x60, 0x2a, 0x1f, 0x42, 0xa0, 0x74, 0x88, 0xbe, 0xc7, 0xfc, 0x6e,
+	0x94, 0x97, 0xb9, 0x40, 0x56, 0xa1, 0x1c, 0xf9, 0x62, 0x3a, 0xd3, 0x20, 0x2b, 0xb0, 0x1c, 0xaf,
+	0x5e, 0x31, 0x1f, 0x3d, 0x33, 0x13, 0xba, 0xa2, 0x73, 0x36, 0x9e, 0x61, 0x20, 0xcd, 0x2c, 0xb9,
+	0x05, 0x79, 0x6d, 0xa3, 0x67, 0x2e, 0x92, 0x22, 0x2c, 0x6d, 0xeb, 0x4f, 0x8c, 0x99, 0x5b, 0xcf,
+	0xfe, 0xf8, 0x7d, 0xd5, 0xd8, 0xf9, 0xe2, 0xdd, 0x45, 0xd5, 0x78, 0x7f, 0x51, 0x35, 0x7e, 0xbb,
+	0xa8, 0x1a, 0xdf, 0x5d, 0x56, 0x17, 0xde, 0x5f, 0x56, 0x17, 0x7e, 0xb9, 0xac, 0x2e, 0x9c, 0x6c,
+	0xa6, 0xa4, 0x10, 0x96, 0xb6, 0xa1, 0x7f, 0xca, 0xe2, 0x2a, 0xdb, 0xe3, 0x76, 0xea, 0x57, 0x4d,
+	0x29, 0xa3, 0x93, 0x53, 0x3f, 0x56, 0x1f, 0xff, 0x39, 0x00, 0xfc, 0x5e, 0x4d, 0x64, 0xc5, 0x09,
+	0x00, 0x00,
 }
 
 func (m *InboundTxParams) Marshal() (dAtA []byte, err error) {
@@ -591,6 +611,16 @@ func (m *InboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InboundTxProven {
+		i--
+		if m.InboundTxProven {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.InboundTxFinalizedZetaHeight != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.InboundTxFinalizedZetaHeight))
 		i--
@@ -712,6 +742,15 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundTxGasPriorityFee) > 0 {
+		i -= len(m.OutboundTxGasPriorityFee)
+		copy(dAtA[i:], m.OutboundTxGasPriorityFee)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.OutboundTxGasPriorityFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OutboundTxEffectiveGasLimit))
 		i--
@@ -994,6 +1033,9 @@ func (m *InboundTxParams) Size() (n int) {
 	if m.InboundTxFinalizedZetaHeight != 0 {
 		n += 1 + sovCrossChainTx(uint64(m.InboundTxFinalizedZetaHeight))
 	}
+	if m.InboundTxProven {
+		n += 2
+	}
 	return n
 }
 
@@ -1059,6 +1101,10 @@ func (m *OutboundTxParams) Size() (n int) {
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		n += 2 + sovCrossChainTx(uint64(m.OutboundTxEffectiveGasLimit))
 	}
+	l = len(m.OutboundTxGasPriorityFee)
+	if l > 0 {
+		n += 2 + l + sovCrossChainTx(uint64(l))
+	}
 	return n
 }
 
@@ -1423,6 +1469,26 @@ func (m *InboundTxParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTxProven", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundTxProven = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -1918,6 +1984,38 @@ func (m *OutboundTxParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxGasPriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxGasPriorityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -42,4 +42,10 @@ var (
 	ErrTxBodyVerificationFail = errorsmod.Register(ModuleName, 1141, "transaction body verification fail")
 	ErrReceiverIsEmpty        = errorsmod.Register(ModuleName, 1142, "receiver is empty")
 	ErrUnsupportedStatus      = errorsmod.Register(ModuleName, 1143, "unsupported status")
+
+	ErrPermissionlessInboundNotEnabled = errorsmod.Register(ModuleName, 1144, "permissionless inbound not enabled")
+	ErrInboundAlreadyFinalized         = errorsmod.Register(ModuleName, 1145, "inbound already finalized")
+	ErrNoInboundInTx                   = errorsmod.Register(ModuleName, 1146, "no inbound in tx")
+
+	ErrInvalidNonceGapReport = errorsmod.Register(ModuleName, 1147, "invalid nonce gap report")
 )
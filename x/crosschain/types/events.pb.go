@@ -509,55 +509,170 @@ func (m *EventOutboundSuccess) GetValueReceived() string {
 	return ""
 }
 
+type EventOutTxNonceGapReported struct {
+	MsgTypeUrl   string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex    string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	ChainId      string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce        string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TssNonce     string `protobuf:"bytes,5,opt,name=tss_nonce,json=tssNonce,proto3" json:"tss_nonce,omitempty"`
+	Cause        string `protobuf:"bytes,6,opt,name=cause,proto3" json:"cause,omitempty"`
+	Action       string `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	CancelTxHash string `protobuf:"bytes,8,opt,name=cancel_tx_hash,json=cancelTxHash,proto3" json:"cancel_tx_hash,omitempty"`
+	Reporter     string `protobuf:"bytes,9,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *EventOutTxNonceGapReported) Reset()         { *m = EventOutTxNonceGapReported{} }
+func (m *EventOutTxNonceGapReported) String() string { return proto.CompactTextString(m) }
+func (*EventOutTxNonceGapReported) ProtoMessage()    {}
+func (*EventOutTxNonceGapReported) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{5}
+}
+func (m *EventOutTxNonceGapReported) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutTxNonceGapReported) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutTxNonceGapReported.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOutTxNonceGapReported) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutTxNonceGapReported.Merge(m, src)
+}
+func (m *EventOutTxNonceGapReported) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutTxNonceGapReported) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutTxNonceGapReported.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOutTxNonceGapReported proto.InternalMessageInfo
+
+func (m *EventOutTxNonceGapReported) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetTssNonce() string {
+	if m != nil {
+		return m.TssNonce
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetCancelTxHash() string {
+	if m != nil {
+		return m.CancelTxHash
+	}
+	return ""
+}
+
+func (m *EventOutTxNonceGapReported) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
 	proto.RegisterType((*EventZetaWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZetaWithdrawCreated")
 	proto.RegisterType((*EventOutboundFailure)(nil), "zetachain.zetacore.crosschain.EventOutboundFailure")
 	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
+	proto.RegisterType((*EventOutTxNonceGapReported)(nil), "zetachain.zetacore.crosschain.EventOutTxNonceGapReported")
 }
 
 func init() { proto.RegisterFile("crosschain/events.proto", fileDescriptor_7398db8b12b87b9e) }
 
 var fileDescriptor_7398db8b12b87b9e = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
-	0x14, 0x6e, 0xda, 0xdd, 0xed, 0xee, 0xf4, 0x0f, 0x62, 0xb5, 0x63, 0xb1, 0xa1, 0x2e, 0xf8, 0x73,
-	0xe3, 0x06, 0xf1, 0x0d, 0x5a, 0x94, 0x16, 0x91, 0x42, 0x5b, 0x11, 0x7a, 0x33, 0xcc, 0x26, 0x87,
-	0x64, 0x30, 0x99, 0x59, 0x66, 0x26, 0xbb, 0xd9, 0x3e, 0x85, 0x2f, 0x22, 0xf8, 0x00, 0x3e, 0x80,
-	0x97, 0xbd, 0xf0, 0xc2, 0x4b, 0xd9, 0x7d, 0x11, 0x99, 0x99, 0x44, 0xbb, 0xa9, 0xe8, 0x85, 0x28,
-	0x78, 0x95, 0x73, 0xbe, 0x73, 0x72, 0xf2, 0xcd, 0xf7, 0x4d, 0x0e, 0xda, 0x89, 0xa4, 0x50, 0x2a,
-	0x4a, 0x29, 0xe3, 0x21, 0x8c, 0x81, 0x6b, 0x35, 0x18, 0x49, 0xa1, 0x85, 0xbf, 0x77, 0x09, 0x9a,
-	0x5a, 0x7c, 0x60, 0x23, 0x21, 0x61, 0xf0, 0xa3, 0x77, 0xf7, 0x56, 0x24, 0xf2, 0x5c, 0xf0, 0xd0,
-	0x3d, 0xdc, 0x3b, 0xbb, 0xdb, 0x89, 0x48, 0x84, 0x0d, 0x43, 0x13, 0x39, 0xb4, 0xff, 0x79, 0x05,
-	0xdd, 0x7e, 0x6e, 0x46, 0x1f, 0xf3, 0xa1, 0x28, 0x78, 0xfc, 0x82, 0x71, 0x9a, 0xb1, 0x4b, 0x88,
-	0xfd, 0x7d, 0xb4, 0x9e, 0xab, 0x84, 0xe8, 0xe9, 0x08, 0x48, 0x21, 0x33, 0xec, 0xed, 0x7b, 0x8f,
-	0x7b, 0xa7, 0x28, 0x57, 0xc9, 0xf9, 0x74, 0x04, 0xaf, 0x65, 0xe6, 0xef, 0x21, 0x14, 0x45, 0xba,
-	0x24, 0x8c, 0xc7, 0x50, 0xe2, 0x65, 0x5b, 0xef, 0x19, 0xe4, 0xd8, 0x00, 0xfe, 0x1d, 0xd4, 0x51,
-	0xc0, 0x63, 0x90, 0x78, 0xc5, 0x96, 0xaa, 0xcc, 0xbf, 0x8b, 0xba, 0xba, 0x24, 0x42, 0x26, 0x8c,
-	0xe3, 0x96, 0xad, 0xac, 0xea, 0xf2, 0xc4, 0xa4, 0xfe, 0x36, 0x6a, 0x53, 0xa5, 0x40, 0xe3, 0xb6,
-	0xc5, 0x5d, 0xe2, 0xdf, 0x43, 0x88, 0x71, 0xa2, 0x4b, 0x92, 0x52, 0x95, 0xe2, 0x8e, 0x2d, 0x75,
-	0x19, 0x3f, 0x2f, 0x8f, 0xa8, 0x4a, 0xfd, 0x87, 0x68, 0x8b, 0x71, 0x32, 0xcc, 0x44, 0xf4, 0x96,
-	0xa4, 0xc0, 0x92, 0x54, 0xe3, 0x55, 0xdb, 0xb2, 0xc1, 0xf8, 0x81, 0x41, 0x8f, 0x2c, 0xe8, 0xef,
-	0xa2, 0xae, 0x84, 0x08, 0xd8, 0x18, 0x24, 0xee, 0xba, 0x19, 0x75, 0xee, 0x3f, 0x40, 0x9b, 0x75,
-	0x4c, 0xac, 0x84, 0xb8, 0xe7, 0x46, 0xd4, 0xe8, 0xa1, 0x01, 0xcd, 0x89, 0x68, 0x2e, 0x0a, 0xae,
-	0x31, 0x72, 0x27, 0x72, 0x99, 0xff, 0x08, 0x6d, 0x49, 0xc8, 0xe8, 0x14, 0x62, 0x92, 0x83, 0x52,
-	0x34, 0x01, 0xbc, 0x66, 0x1b, 0x36, 0x2b, 0xf8, 0x95, 0x43, 0x8d, 0x62, 0x1c, 0x26, 0x44, 0x69,
-	0xaa, 0x0b, 0x85, 0xd7, 0x9d, 0x62, 0x1c, 0x26, 0x67, 0x16, 0x30, 0x34, 0x5c, 0xe9, 0xfb, 0x98,
-	0x0d, 0x47, 0xc3, 0xa1, 0xf5, 0x94, 0xfb, 0x68, 0xdd, 0x49, 0x59, 0x71, 0xdd, 0xb4, 0x4d, 0x6b,
-	0x0e, 0xb3, 0x4c, 0xfb, 0xef, 0x97, 0xd1, 0x8e, 0xb5, 0xf5, 0x42, 0x46, 0x6f, 0x98, 0x4e, 0x63,
-	0x49, 0x27, 0x87, 0x12, 0xa8, 0xfe, 0x9b, 0xc6, 0x36, 0x79, 0xb5, 0x6e, 0xf0, 0x6a, 0x58, 0xd9,
-	0x6e, 0x58, 0x79, 0xdd, 0xa2, 0xce, 0x6f, 0x2d, 0x5a, 0xfd, 0xb5, 0x45, 0xdd, 0x05, 0x8b, 0x16,
-	0x95, 0xef, 0x35, 0x94, 0xef, 0x7f, 0xf0, 0x10, 0x76, 0x7a, 0x81, 0xa6, 0xff, 0x4c, 0xb0, 0x45,
-	0x35, 0x5a, 0x0d, 0x35, 0x16, 0x29, 0xb7, 0x9b, 0x94, 0x3f, 0x7a, 0x68, 0xdb, 0x52, 0x3e, 0x29,
-	0xb4, 0xfb, 0x75, 0x29, 0xcb, 0x0a, 0x09, 0x7f, 0x4e, 0x77, 0x0f, 0x21, 0x91, 0xc5, 0xf5, 0x87,
-	0x1d, 0xe5, 0x9e, 0xc8, 0xe2, 0xea, 0x96, 0x2e, 0xf2, 0x6a, 0xfd, 0xe4, 0x12, 0x8f, 0x69, 0x56,
-	0x00, 0xa9, 0x8c, 0x89, 0x2b, 0xea, 0x1b, 0x16, 0x3d, 0xad, 0xc0, 0x9b, 0xf4, 0xcf, 0x8a, 0x28,
-	0x02, 0xa5, 0xfe, 0x0f, 0xfa, 0x07, 0x2f, 0x3f, 0xcd, 0x02, 0xef, 0x6a, 0x16, 0x78, 0x5f, 0x67,
-	0x81, 0xf7, 0x6e, 0x1e, 0x2c, 0x5d, 0xcd, 0x83, 0xa5, 0x2f, 0xf3, 0x60, 0xe9, 0xe2, 0x69, 0xc2,
-	0x74, 0x5a, 0x0c, 0x07, 0x91, 0xc8, 0x43, 0xb3, 0x9c, 0x9f, 0xb8, 0xfd, 0x5d, 0xef, 0xe9, 0xb0,
-	0x0c, 0xaf, 0x6d, 0x75, 0x73, 0x4a, 0x35, 0xec, 0xd8, 0x5d, 0xfc, 0xec, 0x5b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x0c, 0x96, 0x59, 0xf0, 0x05, 0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcb, 0x4e, 0x14, 0x41,
+	0x14, 0xa5, 0x61, 0x9e, 0xc5, 0x30, 0x24, 0x2d, 0x4a, 0x33, 0xca, 0x04, 0x89, 0xaf, 0x8d, 0x33,
+	0x31, 0xfe, 0x01, 0x44, 0x85, 0x18, 0x25, 0x01, 0x8c, 0x09, 0x9b, 0x4a, 0x4d, 0xf5, 0x4d, 0x77,
+	0xc5, 0xee, 0xaa, 0x49, 0x55, 0x35, 0x34, 0x7c, 0x85, 0x7b, 0xbf, 0xc1, 0xc4, 0x0f, 0xf0, 0x03,
+	0x5c, 0xb2, 0x70, 0xe1, 0xd2, 0xc0, 0x8f, 0x98, 0x7a, 0x34, 0x32, 0x83, 0xd1, 0x85, 0x8f, 0xc4,
+	0xd5, 0xd4, 0x39, 0xb7, 0xba, 0xea, 0xd4, 0xb9, 0xf7, 0xce, 0x45, 0xcb, 0x54, 0x0a, 0xa5, 0x68,
+	0x4a, 0x18, 0x1f, 0xc2, 0x21, 0x70, 0xad, 0x06, 0x63, 0x29, 0xb4, 0x08, 0x57, 0x4f, 0x40, 0x13,
+	0xcb, 0x0f, 0xec, 0x4a, 0x48, 0x18, 0x7c, 0xdf, 0xdb, 0xbb, 0x46, 0x45, 0x9e, 0x0b, 0x3e, 0x74,
+	0x3f, 0xee, 0x9b, 0xde, 0x52, 0x22, 0x12, 0x61, 0x97, 0x43, 0xb3, 0x72, 0xec, 0xfa, 0xe7, 0x39,
+	0x74, 0xfd, 0x89, 0x39, 0x7a, 0x9b, 0x8f, 0x44, 0xc1, 0xe3, 0xa7, 0x8c, 0x93, 0x8c, 0x9d, 0x40,
+	0x1c, 0xae, 0xa1, 0x4e, 0xae, 0x12, 0xac, 0x8f, 0xc7, 0x80, 0x0b, 0x99, 0x45, 0xc1, 0x5a, 0xf0,
+	0xa0, 0xbd, 0x8b, 0x72, 0x95, 0xec, 0x1f, 0x8f, 0xe1, 0x95, 0xcc, 0xc2, 0x55, 0x84, 0x28, 0xd5,
+	0x25, 0x66, 0x3c, 0x86, 0x32, 0x9a, 0xb5, 0xf1, 0xb6, 0x61, 0xb6, 0x0d, 0x11, 0xde, 0x40, 0x0d,
+	0x05, 0x3c, 0x06, 0x19, 0xcd, 0xd9, 0x90, 0x47, 0xe1, 0x0a, 0x6a, 0xe9, 0x12, 0x0b, 0x99, 0x30,
+	0x1e, 0xd5, 0x6c, 0xa4, 0xa9, 0xcb, 0x1d, 0x03, 0xc3, 0x25, 0x54, 0x27, 0x4a, 0x81, 0x8e, 0xea,
+	0x96, 0x77, 0x20, 0xbc, 0x85, 0x10, 0xe3, 0x58, 0x97, 0x38, 0x25, 0x2a, 0x8d, 0x1a, 0x36, 0xd4,
+	0x62, 0x7c, 0xbf, 0xdc, 0x22, 0x2a, 0x0d, 0xef, 0xa1, 0x45, 0xc6, 0xf1, 0x28, 0x13, 0xf4, 0x0d,
+	0x4e, 0x81, 0x25, 0xa9, 0x8e, 0x9a, 0x76, 0xcb, 0x02, 0xe3, 0x1b, 0x86, 0xdd, 0xb2, 0x64, 0xd8,
+	0x43, 0x2d, 0x09, 0x14, 0xd8, 0x21, 0xc8, 0xa8, 0xe5, 0xce, 0xa8, 0x70, 0x78, 0x17, 0x75, 0xab,
+	0x35, 0xb6, 0x16, 0x46, 0x6d, 0x77, 0x44, 0xc5, 0x6e, 0x1a, 0xd2, 0xbc, 0x88, 0xe4, 0xa2, 0xe0,
+	0x3a, 0x42, 0xee, 0x45, 0x0e, 0x85, 0xf7, 0xd1, 0xa2, 0x84, 0x8c, 0x1c, 0x43, 0x8c, 0x73, 0x50,
+	0x8a, 0x24, 0x10, 0xcd, 0xdb, 0x0d, 0x5d, 0x4f, 0xbf, 0x70, 0xac, 0x71, 0x8c, 0xc3, 0x11, 0x56,
+	0x9a, 0xe8, 0x42, 0x45, 0x1d, 0xe7, 0x18, 0x87, 0xa3, 0x3d, 0x4b, 0x18, 0x19, 0x2e, 0x74, 0x71,
+	0xcc, 0x82, 0x93, 0xe1, 0xd8, 0xea, 0x94, 0xdb, 0xa8, 0xe3, 0xac, 0xf4, 0x5a, 0xbb, 0x76, 0xd3,
+	0xbc, 0xe3, 0xac, 0xd2, 0xf5, 0xf7, 0xb3, 0x68, 0xd9, 0xa6, 0xf5, 0x40, 0xd2, 0xd7, 0x4c, 0xa7,
+	0xb1, 0x24, 0x47, 0x9b, 0x12, 0x88, 0xfe, 0x9b, 0x89, 0x9d, 0xd6, 0x55, 0xbb, 0xa2, 0x6b, 0x2a,
+	0x95, 0xf5, 0xa9, 0x54, 0x5e, 0x4e, 0x51, 0xe3, 0x97, 0x29, 0x6a, 0xfe, 0x3c, 0x45, 0xad, 0x89,
+	0x14, 0x4d, 0x3a, 0xdf, 0x9e, 0x72, 0x7e, 0xfd, 0x43, 0x80, 0x22, 0xe7, 0x17, 0x68, 0xf2, 0xcf,
+	0x0c, 0x9b, 0x74, 0xa3, 0x36, 0xe5, 0xc6, 0xa4, 0xe4, 0xfa, 0xb4, 0xe4, 0x8f, 0x01, 0x5a, 0xb2,
+	0x92, 0x77, 0x0a, 0xed, 0x5a, 0x97, 0xb0, 0xac, 0x90, 0xf0, 0xfb, 0x72, 0x57, 0x11, 0x12, 0x59,
+	0x5c, 0x5d, 0xec, 0x24, 0xb7, 0x45, 0x16, 0xfb, 0x2a, 0x9d, 0xd4, 0x55, 0xfb, 0x41, 0x11, 0x1f,
+	0x92, 0xac, 0x00, 0xec, 0x13, 0x13, 0x7b, 0xe9, 0x0b, 0x96, 0xdd, 0xf5, 0xe4, 0x55, 0xf9, 0x7b,
+	0x05, 0xa5, 0xa0, 0xd4, 0x7f, 0x22, 0xff, 0xdd, 0x2c, 0xea, 0x55, 0xf2, 0xf7, 0xcb, 0x97, 0x82,
+	0x53, 0x78, 0x46, 0xc6, 0xbb, 0x30, 0x16, 0xf2, 0x8f, 0x94, 0xcc, 0x0a, 0x6a, 0xd9, 0x2a, 0xc7,
+	0x2c, 0xf6, 0x4f, 0x68, 0x5a, 0xbc, 0x1d, 0x9b, 0x3f, 0x49, 0x6e, 0xee, 0xf3, 0xda, 0x1d, 0x08,
+	0x6f, 0xa2, 0xb6, 0x56, 0x0a, 0xbb, 0x88, 0x6f, 0x2c, 0xad, 0x94, 0x55, 0x66, 0x3e, 0xa1, 0xa4,
+	0x50, 0xe0, 0xbb, 0xca, 0x01, 0xdb, 0x2b, 0x54, 0x33, 0x51, 0xb5, 0x92, 0x47, 0xe1, 0x1d, 0xd4,
+	0xa5, 0x84, 0x53, 0xc8, 0x2e, 0x4a, 0xd3, 0xf5, 0x52, 0xc7, 0xb1, 0x97, 0x9b, 0xd5, 0x3e, 0x57,
+	0xfa, 0x7e, 0xba, 0xc0, 0x1b, 0xcf, 0x3f, 0x9d, 0xf5, 0x83, 0xd3, 0xb3, 0x7e, 0xf0, 0xf5, 0xac,
+	0x1f, 0xbc, 0x3d, 0xef, 0xcf, 0x9c, 0x9e, 0xf7, 0x67, 0xbe, 0x9c, 0xf7, 0x67, 0x0e, 0x1e, 0x25,
+	0x4c, 0xa7, 0xc5, 0x68, 0x40, 0x45, 0x3e, 0x34, 0xa3, 0xeb, 0xa1, 0x9b, 0x6e, 0xd5, 0x14, 0x1b,
+	0x96, 0xc3, 0x4b, 0x33, 0xcf, 0xd8, 0xa7, 0x46, 0x0d, 0x3b, 0xa9, 0x1e, 0x7f, 0x1b, 0x00, 0x25,
+	0x62, 0x5a, 0x06, 0x0e, 0x07, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -941,6 +1056,92 @@ func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOutTxNonceGapReported) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutTxNonceGapReported) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutTxNonceGapReported) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CancelTxHash) > 0 {
+		i -= len(m.CancelTxHash)
+		copy(dAtA[i:], m.CancelTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelTxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TssNonce) > 0 {
+		i -= len(m.TssNonce)
+		copy(dAtA[i:], m.TssNonce)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TssNonce)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1149,6 +1350,51 @@ func (m *EventOutboundSuccess) Size() (n int) {
 	return n
 }
 
+func (m *EventOutTxNonceGapReported) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TssNonce)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CancelTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2621,6 +2867,344 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOutTxNonceGapReported) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutTxNonceGapReported: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutTxNonceGapReported: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssNonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssNonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
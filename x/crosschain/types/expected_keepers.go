@@ -60,6 +60,8 @@ type ZetaObserverKeeper interface {
 	GetCrosschainFlags(ctx sdk.Context) (val observertypes.CrosschainFlags, found bool)
 	GetKeygen(ctx sdk.Context) (val observertypes.Keygen, found bool)
 	SetKeygen(ctx sdk.Context, keygen observertypes.Keygen)
+	RecordKeygenReport(ctx sdk.Context, operator string, status common.ReceiveStatus, tssPubkey string)
+	FinalizeKeygenAttempt(ctx sdk.Context, success bool)
 	SetCrosschainFlags(ctx sdk.Context, crosschainFlags observertypes.CrosschainFlags)
 	SetLastObserverCount(ctx sdk.Context, lbc *observertypes.LastObserverCount)
 	AddVoteToBallot(ctx sdk.Context, ballot observertypes.Ballot, address string, observationType observertypes.VoteType) (observertypes.Ballot, error)
@@ -68,6 +70,10 @@ type ZetaObserverKeeper interface {
 	FindBallot(ctx sdk.Context, index string, chain *common.Chain, observationType observertypes.ObservationType) (ballot observertypes.Ballot, isNew bool, err error)
 	AddBallotToList(ctx sdk.Context, ballot observertypes.Ballot)
 	GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHeader, found bool)
+	CheckBlockHeaderConfirmed(ctx sdk.Context, header common.BlockHeader, confirmationCount uint64) error
+	CheckBlockHeaderFinalized(ctx sdk.Context, header common.BlockHeader) error
+	AddBlockHeaderReference(ctx sdk.Context, hash []byte)
+	RemoveBlockHeaderReference(ctx sdk.Context, hash []byte)
 	CheckIfTssPubkeyHasBeenGenerated(ctx sdk.Context, tssPubkey string) (observertypes.TSS, bool)
 	GetPreviousTSS(ctx sdk.Context) (val observertypes.TSS, found bool)
 	GetAllTSS(ctx sdk.Context) (list []observertypes.TSS)
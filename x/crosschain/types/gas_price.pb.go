@@ -31,6 +31,8 @@ type GasPrice struct {
 	BlockNums   []uint64 `protobuf:"varint,5,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
 	Prices      []uint64 `protobuf:"varint,6,rep,packed,name=prices,proto3" json:"prices,omitempty"`
 	MedianIndex uint64   `protobuf:"varint,7,opt,name=median_index,json=medianIndex,proto3" json:"median_index,omitempty"`
+	// priority fees of the signers, only set for the chains with EIP-1559 fees
+	PriorityFees []uint64 `protobuf:"varint,8,rep,packed,name=priority_fees,json=priorityFees,proto3" json:"priority_fees,omitempty"`
 }
 
 func (m *GasPrice) Reset()         { *m = GasPrice{} }
@@ -115,6 +117,13 @@ func (m *GasPrice) GetMedianIndex() uint64 {
 	return 0
 }
 
+func (m *GasPrice) GetPriorityFees() []uint64 {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GasPrice)(nil), "zetachain.zetacore.crosschain.GasPrice")
 }
@@ -122,25 +131,26 @@ func init() {
 func init() { proto.RegisterFile("crosschain/gas_price.proto", fileDescriptor_a9c78c67aa323583) }
 
 var fileDescriptor_a9c78c67aa323583 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4a, 0xc4, 0x30,
-	0x14, 0x86, 0x27, 0xb6, 0xd3, 0xce, 0x44, 0x57, 0x41, 0x24, 0x0a, 0x13, 0xaa, 0xab, 0x6e, 0x6c,
-	0x11, 0x6f, 0xe0, 0x46, 0x06, 0x41, 0xa4, 0x4b, 0x37, 0x25, 0x4d, 0x43, 0x27, 0x68, 0x9b, 0x92,
-	0xd7, 0xc2, 0xe8, 0x29, 0x3c, 0x96, 0xee, 0x66, 0xe9, 0x52, 0xda, 0x8b, 0x48, 0xd2, 0x19, 0x74,
-	0x97, 0xef, 0x7f, 0x79, 0x3f, 0x8f, 0x0f, 0x5f, 0x08, 0xa3, 0x01, 0xc4, 0x86, 0xab, 0x26, 0xad,
-	0x38, 0xe4, 0xad, 0x51, 0x42, 0x26, 0xad, 0xd1, 0x9d, 0x26, 0xab, 0x77, 0xd9, 0x71, 0x37, 0x4a,
-	0xdc, 0x4b, 0x1b, 0x99, 0xfc, 0x7d, 0xbf, 0xfa, 0x42, 0x78, 0x71, 0xcf, 0xe1, 0xc9, 0x6e, 0x10,
-	0x8a, 0x43, 0x61, 0x24, 0xef, 0xb4, 0xa1, 0x28, 0x42, 0xf1, 0x32, 0x3b, 0x20, 0x39, 0xc5, 0x73,
-	0xd5, 0x94, 0x72, 0x4b, 0x8f, 0x5c, 0x3e, 0x01, 0x39, 0xc7, 0x0b, 0xd7, 0x92, 0xab, 0x92, 0x7a,
-	0x11, 0x8a, 0xbd, 0x2c, 0x74, 0xbc, 0x2e, 0x6d, 0x15, 0xa8, 0xaa, 0x91, 0x06, 0xa8, 0x1f, 0x79,
-	0xb6, 0x6a, 0x8f, 0x64, 0x85, 0x71, 0xf1, 0xaa, 0xc5, 0x4b, 0xde, 0xf4, 0x35, 0xd0, 0x79, 0xe4,
-	0xc5, 0x7e, 0xb6, 0x74, 0xc9, 0x63, 0x5f, 0x03, 0x39, 0xc3, 0x81, 0x3b, 0x1f, 0x68, 0xe0, 0x46,
-	0x7b, 0x22, 0x97, 0xf8, 0xa4, 0x96, 0xa5, 0xe2, 0x4d, 0x3e, 0x1d, 0x12, 0x46, 0x28, 0xf6, 0xb3,
-	0xe3, 0x29, 0x5b, 0xdb, 0xe8, 0xee, 0xe1, 0x73, 0x60, 0x68, 0x37, 0x30, 0xf4, 0x33, 0x30, 0xf4,
-	0x31, 0xb2, 0xd9, 0x6e, 0x64, 0xb3, 0xef, 0x91, 0xcd, 0x9e, 0x6f, 0x2a, 0xd5, 0x6d, 0xfa, 0x22,
-	0x11, 0xba, 0x4e, 0xad, 0x85, 0xeb, 0xc9, 0xd5, 0x41, 0x48, 0xba, 0x4d, 0xff, 0x19, 0xec, 0xde,
-	0x5a, 0x09, 0x45, 0xe0, 0xf4, 0xdd, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0xdc, 0xbf, 0x3d,
-	0x5c, 0x01, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x17, 0xbb, 0xbf, 0x71, 0x5e, 0x82, 0x48, 0x14, 0x16, 0xaa, 0x5e, 0x7a, 0xb1, 0x45,
+	0xfc, 0x06, 0x1e, 0x94, 0x21, 0x88, 0xf4, 0xe8, 0xa5, 0x64, 0xe9, 0xeb, 0x16, 0xb4, 0x4d, 0xc9,
+	0x9b, 0xc1, 0xe6, 0xa7, 0xf0, 0x63, 0x79, 0xdc, 0xd1, 0xa3, 0x6c, 0x77, 0x3f, 0x83, 0x34, 0x5d,
+	0xd1, 0x5b, 0x7f, 0xcf, 0xdb, 0x3c, 0x3c, 0xfc, 0xe8, 0x99, 0xb2, 0x06, 0x51, 0x2d, 0xa4, 0x2e,
+	0x93, 0xb9, 0xc4, 0xac, 0xb2, 0x5a, 0x41, 0x5c, 0x59, 0xe3, 0x0c, 0x9b, 0xbc, 0x83, 0x93, 0xfe,
+	0x14, 0xfb, 0x2f, 0x63, 0x21, 0xfe, 0xfb, 0xfd, 0xe2, 0x87, 0xd0, 0xe1, 0xbd, 0xc4, 0xa7, 0xfa,
+	0x05, 0xe3, 0x74, 0xa0, 0x2c, 0x48, 0x67, 0x2c, 0x27, 0x21, 0x89, 0x46, 0x69, 0x8b, 0xec, 0x98,
+	0xf6, 0x74, 0x99, 0xc3, 0x8a, 0x1f, 0xf8, 0xbc, 0x01, 0x76, 0x4a, 0x87, 0xbe, 0x25, 0xd3, 0x39,
+	0x0f, 0x42, 0x12, 0x05, 0xe9, 0xc0, 0xf3, 0x34, 0xaf, 0xab, 0x50, 0xcf, 0x4b, 0xb0, 0xc8, 0xbb,
+	0x61, 0x50, 0x57, 0xed, 0x91, 0x4d, 0x28, 0x9d, 0xbd, 0x19, 0xf5, 0x9a, 0x95, 0xcb, 0x02, 0x79,
+	0x2f, 0x0c, 0xa2, 0x6e, 0x3a, 0xf2, 0xc9, 0xe3, 0xb2, 0x40, 0x76, 0x42, 0xfb, 0x7e, 0x3e, 0xf2,
+	0xbe, 0x3f, 0xed, 0x89, 0x9d, 0xd3, 0x71, 0x01, 0xb9, 0x96, 0x65, 0xd6, 0x0c, 0x19, 0x84, 0x24,
+	0xea, 0xa6, 0x87, 0x4d, 0x36, 0xf5, 0x73, 0x2e, 0xe9, 0x51, 0x65, 0xb5, 0xb1, 0xda, 0xad, 0xb3,
+	0x17, 0x00, 0xe4, 0x43, 0xdf, 0x30, 0x6e, 0xc3, 0x3b, 0x00, 0xbc, 0x7d, 0xf8, 0xdc, 0x0a, 0xb2,
+	0xd9, 0x0a, 0xf2, 0xbd, 0x15, 0xe4, 0x63, 0x27, 0x3a, 0x9b, 0x9d, 0xe8, 0x7c, 0xed, 0x44, 0xe7,
+	0xf9, 0x7a, 0xae, 0xdd, 0x62, 0x39, 0x8b, 0x95, 0x29, 0x92, 0x5a, 0xd5, 0x55, 0x23, 0xb4, 0xb5,
+	0x96, 0xac, 0x92, 0x7f, 0x9a, 0xdd, 0xba, 0x02, 0x9c, 0xf5, 0xbd, 0xe3, 0x9b, 0xdf, 0x01, 0x00,
+	0x26, 0x33, 0x19, 0x15, 0x81, 0x01, 0x00, 0x00,
 }
 
 func (m *GasPrice) Marshal() (dAtA []byte, err error) {
@@ -163,15 +173,10 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MedianIndex != 0 {
-		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Prices) > 0 {
-		dAtA2 := make([]byte, len(m.Prices)*10)
+	if len(m.PriorityFees) > 0 {
+		dAtA2 := make([]byte, len(m.PriorityFees)*10)
 		var j1 int
-		for _, num := range m.Prices {
+		for _, num := range m.PriorityFees {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -184,12 +189,17 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
-	if len(m.BlockNums) > 0 {
-		dAtA4 := make([]byte, len(m.BlockNums)*10)
+	if m.MedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Prices) > 0 {
+		dAtA4 := make([]byte, len(m.Prices)*10)
 		var j3 int
-		for _, num := range m.BlockNums {
+		for _, num := range m.Prices {
 			for num >= 1<<7 {
 				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -202,6 +212,24 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j3))
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockNums) > 0 {
+		dAtA6 := make([]byte, len(m.BlockNums)*10)
+		var j5 int
+		for _, num := range m.BlockNums {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j5))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.Signers) > 0 {
@@ -286,6 +314,13 @@ func (m *GasPrice) Size() (n int) {
 	if m.MedianIndex != 0 {
 		n += 1 + sovGasPrice(uint64(m.MedianIndex))
 	}
+	if len(m.PriorityFees) > 0 {
+		l = 0
+		for _, e := range m.PriorityFees {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
 	return n
 }
 
@@ -610,6 +645,82 @@ func (m *GasPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriorityFees = append(m.PriorityFees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PriorityFees) == 0 {
+					m.PriorityFees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriorityFees = append(m.PriorityFees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFees", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasPrice(dAtA[iNdEx:])
@@ -34,6 +34,16 @@ func (gs GenesisState) Validate() error {
 		}
 		outTxTrackerIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in outTxNonceGap
+	outTxNonceGapIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OutTxNonceGapList {
+		index := string(OutTxNonceGapKey(elem.Index))
+		if _, ok := outTxNonceGapIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for outTxNonceGap")
+		}
+		outTxNonceGapIndexMap[index] = struct{}{}
+	}
 	// Check for duplicated index in inTxHashToCctx
 	inTxHashToCctxIndexMap := make(map[string]struct{})
 
@@ -64,6 +64,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated outTxNonceGap",
+			genState: &types.GenesisState{
+				OutTxNonceGapList: []types.OutTxNonceGap{
+					{
+						Index: "1-5",
+					},
+					{
+						Index: "1-5",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated inTxHashToCctx",
 			genState: &types.GenesisState{
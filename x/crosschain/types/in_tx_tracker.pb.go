@@ -25,9 +25,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type InTxTracker struct {
-	ChainId  int64           `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	TxHash   string          `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	CoinType common.CoinType `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	ChainId   int64           `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash    string          `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CoinType  common.CoinType `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	BlockHash string          `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *InTxTracker) Reset()         { *m = InTxTracker{} }
@@ -84,6 +85,13 @@ func (m *InTxTracker) GetCoinType() common.CoinType {
 	return common.CoinType_Zeta
 }
 
+func (m *InTxTracker) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InTxTracker)(nil), "zetachain.zetacore.crosschain.InTxTracker")
 }
@@ -91,22 +99,23 @@ func init() {
 func init() { proto.RegisterFile("crosschain/in_tx_tracker.proto", fileDescriptor_799b411f065af0ce) }
 
 var fileDescriptor_799b411f065af0ce = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
+	// 255 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2e, 0xca, 0x2f,
 	0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcc, 0x8b, 0x2f, 0xa9, 0x88, 0x2f, 0x29, 0x4a,
 	0x4c, 0xce, 0x4e, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xad, 0x4a, 0x2d, 0x49,
 	0x04, 0x4b, 0xeb, 0x81, 0x59, 0xf9, 0x45, 0xa9, 0x7a, 0x08, 0x2d, 0x52, 0xc2, 0xc9, 0xf9, 0xb9,
-	0xb9, 0xf9, 0x79, 0xfa, 0x10, 0x0a, 0xa2, 0x47, 0xa9, 0x80, 0x8b, 0xdb, 0x33, 0x2f, 0xa4, 0x22,
-	0x04, 0x62, 0x90, 0x90, 0x24, 0x17, 0x07, 0x58, 0x71, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0x73, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x22, 0x24, 0xce, 0xc5, 0x5e, 0x52, 0x11, 0x9f, 0x91,
-	0x58, 0x9c, 0x21, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x56, 0x52, 0xe1, 0x91, 0x58, 0x9c,
-	0x21, 0xa4, 0xcb, 0xc5, 0x99, 0x9c, 0x0f, 0x72, 0x4f, 0x65, 0x41, 0xaa, 0x04, 0xb3, 0x02, 0xa3,
-	0x06, 0x9f, 0x91, 0x80, 0x1e, 0xd4, 0x12, 0xe7, 0xfc, 0xcc, 0xbc, 0x90, 0xca, 0x82, 0xd4, 0x20,
-	0x8e, 0x64, 0x28, 0xcb, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x0c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0x40, 0x7a, 0xf5, 0x41, 0x1e, 0xd0, 0x85, 0x78, 0x15,
-	0xe6, 0x17, 0xfd, 0x0a, 0x7d, 0xa4, 0x00, 0x00, 0xd9, 0x56, 0x9c, 0xc4, 0x06, 0xf6, 0x85, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x60, 0xe9, 0x97, 0x6f, 0x1b, 0x01, 0x00, 0x00,
+	0xb9, 0xf9, 0x79, 0xfa, 0x10, 0x0a, 0xa2, 0x47, 0xa9, 0x9f, 0x91, 0x8b, 0xdb, 0x33, 0x2f, 0xa4,
+	0x22, 0x04, 0x62, 0x92, 0x90, 0x24, 0x17, 0x07, 0x58, 0x75, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02,
+	0xa3, 0x06, 0x73, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x22, 0x24, 0xce, 0xc5, 0x5e, 0x52, 0x11, 0x9f,
+	0x91, 0x58, 0x9c, 0x21, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x56, 0x52, 0xe1, 0x91, 0x58,
+	0x9c, 0x21, 0xa4, 0xcb, 0xc5, 0x99, 0x9c, 0x0f, 0x72, 0x50, 0x65, 0x41, 0xaa, 0x04, 0xb3, 0x02,
+	0xa3, 0x06, 0x9f, 0x91, 0x80, 0x1e, 0xd4, 0x16, 0xe7, 0xfc, 0xcc, 0xbc, 0x90, 0xca, 0x82, 0xd4,
+	0x20, 0x8e, 0x64, 0x28, 0x4b, 0x48, 0x96, 0x8b, 0x2b, 0x29, 0x27, 0x3f, 0x39, 0x1b, 0x62, 0x14,
+	0x0b, 0xd8, 0x28, 0x4e, 0xb0, 0x08, 0xc8, 0x34, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
+	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
+	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0x02, 0x19, 0xad, 0x0f, 0xf2,
+	0xa0, 0x2e, 0x24, 0x28, 0x60, 0x7e, 0xd5, 0xaf, 0xd0, 0x47, 0x0a, 0x20, 0x90, 0x63, 0x8a, 0x93,
+	0xd8, 0xc0, 0xbe, 0x34, 0x06, 0x0c, 0x00, 0xed, 0xca, 0xb9, 0x25, 0x3b, 0x01, 0x00, 0x00,
 }
 
 func (m *InTxTracker) Marshal() (dAtA []byte, err error) {
@@ -129,6 +138,13 @@ func (m *InTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintInTxTracker(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.CoinType != 0 {
 		i = encodeVarintInTxTracker(dAtA, i, uint64(m.CoinType))
 		i--
@@ -176,6 +192,10 @@ func (m *InTxTracker) Size() (n int) {
 	if m.CoinType != 0 {
 		n += 1 + sovInTxTracker(uint64(m.CoinType))
 	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovInTxTracker(uint64(l))
+	}
 	return n
 }
 
@@ -284,6 +304,38 @@ func (m *InTxTracker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInTxTracker(dAtA[iNdEx:])
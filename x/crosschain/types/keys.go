@@ -43,8 +43,9 @@ const (
 
 	GasBalanceKey = "GasBalance-value-"
 
-	OutTxTrackerKeyPrefix = "OutTxTracker-value-"
-	InTxTrackerKeyPrefix  = "InTxTracker-value-"
+	OutTxTrackerKeyPrefix  = "OutTxTracker-value-"
+	InTxTrackerKeyPrefix   = "InTxTracker-value-"
+	OutTxNonceGapKeyPrefix = "OutTxNonceGap-value-"
 
 	// #nosec G101: Potential hardcoded credentials (gosec)
 	// ZetaAccountingKey value is used as prefix for storing ZetaAccountingKey
@@ -64,6 +65,19 @@ func OutTxTrackerKey(
 	return key
 }
 
+// OutTxNonceGapKey returns the store key to retrieve a OutTxNonceGap from the index fields
+func OutTxNonceGapKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
 // TODO: what's the purpose of this log identifier?
 func (m CrossChainTx) LogIdentifierForCCTX() string {
 	if len(m.OutboundTxParams) == 0 {
@@ -0,0 +1,79 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgAddProvenInboundTx = "AddProvenInboundTx"
+
+var _ sdk.Msg = &MsgAddProvenInboundTx{}
+
+func NewMsgAddProvenInboundTx(
+	creator string,
+	chain int64,
+	txHash string,
+	proof *common.Proof,
+	blockHash string,
+	txIndex int64,
+	receiptProof *common.Proof,
+	firstLogIndex uint64,
+) *MsgAddProvenInboundTx {
+	return &MsgAddProvenInboundTx{
+		Creator:       creator,
+		ChainId:       chain,
+		TxHash:        txHash,
+		Proof:         proof,
+		BlockHash:     blockHash,
+		TxIndex:       txIndex,
+		ReceiptProof:  receiptProof,
+		FirstLogIndex: firstLogIndex,
+	}
+}
+
+func (msg *MsgAddProvenInboundTx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddProvenInboundTx) Type() string {
+	return TypeMsgAddProvenInboundTx
+}
+
+func (msg *MsgAddProvenInboundTx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddProvenInboundTx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddProvenInboundTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	chain := common.GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d)", msg.ChainId)
+	}
+	if !chain.SupportMerkleProof() {
+		return errorsmod.Wrapf(ErrProofVerificationFail, "chain id %d does not support proof-based inbound", msg.ChainId)
+	}
+	if msg.TxHash == "" {
+		return errorsmod.Wrapf(ErrProofVerificationFail, "tx hash is empty")
+	}
+	if msg.Proof == nil {
+		return errorsmod.Wrapf(ErrProofVerificationFail, "proof is missing")
+	}
+	if common.IsEVMChain(msg.ChainId) && msg.ReceiptProof == nil {
+		return errorsmod.Wrapf(ErrProofVerificationFail, "receipt proof is missing for evm chain")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgAddProvenInboundTx_ValidateBasic(t *testing.T) {
+	proof := common.NewEthereumProof(ethereum.NewProof())
+	tests := []struct {
+		name string
+		msg  *types.MsgAddProvenInboundTx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgAddProvenInboundTx("invalid_address", common.GoerliChain().ChainId, "hash", proof, "block", 0, proof, 0),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), 42, "hash", proof, "block", 0, proof, 0),
+			err:  errorsmod.Wrapf(types.ErrInvalidChainID, "chain id (%d)", 42),
+		},
+		{
+			name: "chain without merkle proof",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.ZetaChainMainnet().ChainId, "hash", proof, "block", 0, proof, 0),
+			err:  types.ErrProofVerificationFail,
+		},
+		{
+			name: "missing tx hash",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "", proof, "block", 0, proof, 0),
+			err:  types.ErrProofVerificationFail,
+		},
+		{
+			name: "missing proof",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "hash", nil, "block", 0, proof, 0),
+			err:  types.ErrProofVerificationFail,
+		},
+		{
+			name: "missing receipt proof for evm chain",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "hash", proof, "block", 0, nil, 0),
+			err:  types.ErrProofVerificationFail,
+		},
+		{
+			name: "valid evm",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "hash", proof, "block", 0, proof, 0),
+			err:  nil,
+		},
+		{
+			name: "valid bitcoin without receipt proof",
+			msg:  types.NewMsgAddProvenInboundTx(sample.AccAddress(), common.BtcRegtestChain().ChainId, "hash", proof, "block", 0, nil, 0),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
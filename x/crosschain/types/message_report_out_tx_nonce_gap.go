@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgReportOutTxNonceGap = "ReportOutTxNonceGap"
+
+var _ sdk.Msg = &MsgReportOutTxNonceGap{}
+
+func NewMsgReportOutTxNonceGap(
+	creator string,
+	chainID int64,
+	nonce uint64,
+	tssNonce uint64,
+	cause OutTxNonceGapCause,
+	action OutTxNonceGapAction,
+	cancelTxHash string,
+) *MsgReportOutTxNonceGap {
+	return &MsgReportOutTxNonceGap{
+		Creator:      creator,
+		ChainId:      chainID,
+		Nonce:        nonce,
+		TssNonce:     tssNonce,
+		Cause:        cause,
+		Action:       action,
+		CancelTxHash: cancelTxHash,
+	}
+}
+
+func (msg *MsgReportOutTxNonceGap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReportOutTxNonceGap) Type() string {
+	return TypeMsgReportOutTxNonceGap
+}
+
+func (msg *MsgReportOutTxNonceGap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReportOutTxNonceGap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReportOutTxNonceGap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !common.IsEVMChain(msg.ChainId) {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d) is not an evm chain", msg.ChainId)
+	}
+	if msg.TssNonce > msg.Nonce {
+		return errorsmod.Wrapf(ErrInvalidNonceGapReport, "nonce %d is below the tss nonce %d", msg.Nonce, msg.TssNonce)
+	}
+	if _, ok := OutTxNonceGapCause_name[int32(msg.Cause)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidNonceGapReport, "invalid cause %d", msg.Cause)
+	}
+	switch msg.Action {
+	case OutTxNonceGapAction_Reprioritized:
+		if msg.CancelTxHash != "" {
+			return errorsmod.Wrapf(ErrInvalidNonceGapReport, "cancel tx hash is set for a reprioritized nonce")
+		}
+	case OutTxNonceGapAction_Cancelled:
+		if msg.CancelTxHash == "" {
+			return errorsmod.Wrapf(ErrInvalidNonceGapReport, "cancel tx hash is empty for a cancelled nonce")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidNonceGapReport, "invalid action %d", msg.Action)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgReportOutTxNonceGap_ValidateBasic(t *testing.T) {
+	ethChainID := common.GoerliChain().ChainId
+	tests := []struct {
+		name string
+		msg  *types.MsgReportOutTxNonceGap
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.NewMsgReportOutTxNonceGap("invalid_address", ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction_Reprioritized, ""),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "non evm chain",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), common.BtcMainnetChain().ChainId, 5, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction_Reprioritized, ""),
+			err: types.ErrInvalidChainID,
+		},
+		{
+			name: "nonce below tss nonce",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 4, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction_Reprioritized, ""),
+			err: types.ErrInvalidNonceGapReport,
+		},
+		{
+			name: "invalid cause",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause(9), types.OutTxNonceGapAction_Reprioritized, ""),
+			err: types.ErrInvalidNonceGapReport,
+		},
+		{
+			name: "invalid action",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction(9), ""),
+			err: types.ErrInvalidNonceGapReport,
+		},
+		{
+			name: "reprioritized with cancel tx hash",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction_Reprioritized, sample.Hash().Hex()),
+			err: types.ErrInvalidNonceGapReport,
+		},
+		{
+			name: "cancelled without cancel tx hash",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotMined, types.OutTxNonceGapAction_Cancelled, ""),
+			err: types.ErrInvalidNonceGapReport,
+		},
+		{
+			name: "valid reprioritized",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotBroadcast, types.OutTxNonceGapAction_Reprioritized, ""),
+		},
+		{
+			name: "valid cancelled",
+			msg: types.NewMsgReportOutTxNonceGap(sample.AccAddress(), ethChainID, 5, 5,
+				types.OutTxNonceGapCause_NotMined, types.OutTxNonceGapAction_Cancelled, sample.Hash().Hex()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
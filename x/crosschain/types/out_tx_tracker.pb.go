@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -23,10 +24,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OutTxNonceGapCause is the cause of a nonce gap blocking the outbound txs of a chain
+type OutTxNonceGapCause int32
+
+const (
+	OutTxNonceGapCause_NotBroadcast OutTxNonceGapCause = 0
+	OutTxNonceGapCause_NotMined     OutTxNonceGapCause = 1
+)
+
+var OutTxNonceGapCause_name = map[int32]string{
+	0: "NotBroadcast",
+	1: "NotMined",
+}
+
+var OutTxNonceGapCause_value = map[string]int32{
+	"NotBroadcast": 0,
+	"NotMined":     1,
+}
+
+func (x OutTxNonceGapCause) String() string {
+	return proto.EnumName(OutTxNonceGapCause_name, int32(x))
+}
+
+func (OutTxNonceGapCause) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5638c11005e4d36d, []int{0}
+}
+
+// OutTxNonceGapAction is the action taken by an observer to fill a nonce gap
+type OutTxNonceGapAction int32
+
+const (
+	OutTxNonceGapAction_Reprioritized OutTxNonceGapAction = 0
+	OutTxNonceGapAction_Cancelled     OutTxNonceGapAction = 1
+)
+
+var OutTxNonceGapAction_name = map[int32]string{
+	0: "Reprioritized",
+	1: "Cancelled",
+}
+
+var OutTxNonceGapAction_value = map[string]int32{
+	"Reprioritized": 0,
+	"Cancelled":     1,
+}
+
+func (x OutTxNonceGapAction) String() string {
+	return proto.EnumName(OutTxNonceGapAction_name, int32(x))
+}
+
+func (OutTxNonceGapAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5638c11005e4d36d, []int{1}
+}
+
 type TxHashList struct {
-	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	TxSigner string `protobuf:"bytes,2,opt,name=tx_signer,json=txSigner,proto3" json:"tx_signer,omitempty"`
-	Proved   bool   `protobuf:"varint,3,opt,name=proved,proto3" json:"proved,omitempty"`
+	TxHash    string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxSigner  string `protobuf:"bytes,2,opt,name=tx_signer,json=txSigner,proto3" json:"tx_signer,omitempty"`
+	Proved    bool   `protobuf:"varint,3,opt,name=proved,proto3" json:"proved,omitempty"`
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *TxHashList) Reset()         { *m = TxHashList{} }
@@ -83,6 +137,13 @@ func (m *TxHashList) GetProved() bool {
 	return false
 }
 
+func (m *TxHashList) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 type OutTxTracker struct {
 	Index    string        `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 	ChainId  int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -151,34 +212,217 @@ func (m *OutTxTracker) GetHashList() []*TxHashList {
 	return nil
 }
 
+// OutTxNonceGapReport is the action taken by an observer on a nonce gap
+type OutTxNonceGapReport struct {
+	Reporter     string              `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	TssNonce     uint64              `protobuf:"varint,2,opt,name=tss_nonce,json=tssNonce,proto3" json:"tss_nonce,omitempty"`
+	Cause        OutTxNonceGapCause  `protobuf:"varint,3,opt,name=cause,proto3,enum=zetachain.zetacore.crosschain.OutTxNonceGapCause" json:"cause,omitempty"`
+	Action       OutTxNonceGapAction `protobuf:"varint,4,opt,name=action,proto3,enum=zetachain.zetacore.crosschain.OutTxNonceGapAction" json:"action,omitempty"`
+	CancelTxHash string              `protobuf:"bytes,5,opt,name=cancel_tx_hash,json=cancelTxHash,proto3" json:"cancel_tx_hash,omitempty"`
+	BlockHeight  int64               `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *OutTxNonceGapReport) Reset()         { *m = OutTxNonceGapReport{} }
+func (m *OutTxNonceGapReport) String() string { return proto.CompactTextString(m) }
+func (*OutTxNonceGapReport) ProtoMessage()    {}
+func (*OutTxNonceGapReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5638c11005e4d36d, []int{2}
+}
+func (m *OutTxNonceGapReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutTxNonceGapReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutTxNonceGapReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutTxNonceGapReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutTxNonceGapReport.Merge(m, src)
+}
+func (m *OutTxNonceGapReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutTxNonceGapReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutTxNonceGapReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutTxNonceGapReport proto.InternalMessageInfo
+
+func (m *OutTxNonceGapReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *OutTxNonceGapReport) GetTssNonce() uint64 {
+	if m != nil {
+		return m.TssNonce
+	}
+	return 0
+}
+
+func (m *OutTxNonceGapReport) GetCause() OutTxNonceGapCause {
+	if m != nil {
+		return m.Cause
+	}
+	return OutTxNonceGapCause_NotBroadcast
+}
+
+func (m *OutTxNonceGapReport) GetAction() OutTxNonceGapAction {
+	if m != nil {
+		return m.Action
+	}
+	return OutTxNonceGapAction_Reprioritized
+}
+
+func (m *OutTxNonceGapReport) GetCancelTxHash() string {
+	if m != nil {
+		return m.CancelTxHash
+	}
+	return ""
+}
+
+func (m *OutTxNonceGapReport) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// OutTxNonceGap is the list of the reports of the observers on a nonce blocking the outbound txs of a chain
+type OutTxNonceGap struct {
+	Index     string                `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	ChainId   int64                 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce     uint64                `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	CctxIndex string                `protobuf:"bytes,4,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Reports   []OutTxNonceGapReport `protobuf:"bytes,5,rep,name=reports,proto3" json:"reports"`
+}
+
+func (m *OutTxNonceGap) Reset()         { *m = OutTxNonceGap{} }
+func (m *OutTxNonceGap) String() string { return proto.CompactTextString(m) }
+func (*OutTxNonceGap) ProtoMessage()    {}
+func (*OutTxNonceGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5638c11005e4d36d, []int{3}
+}
+func (m *OutTxNonceGap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutTxNonceGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutTxNonceGap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutTxNonceGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutTxNonceGap.Merge(m, src)
+}
+func (m *OutTxNonceGap) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutTxNonceGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutTxNonceGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutTxNonceGap proto.InternalMessageInfo
+
+func (m *OutTxNonceGap) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *OutTxNonceGap) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *OutTxNonceGap) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *OutTxNonceGap) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *OutTxNonceGap) GetReports() []OutTxNonceGapReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.crosschain.OutTxNonceGapCause", OutTxNonceGapCause_name, OutTxNonceGapCause_value)
+	proto.RegisterEnum("zetachain.zetacore.crosschain.OutTxNonceGapAction", OutTxNonceGapAction_name, OutTxNonceGapAction_value)
 	proto.RegisterType((*TxHashList)(nil), "zetachain.zetacore.crosschain.TxHashList")
 	proto.RegisterType((*OutTxTracker)(nil), "zetachain.zetacore.crosschain.OutTxTracker")
+	proto.RegisterType((*OutTxNonceGapReport)(nil), "zetachain.zetacore.crosschain.OutTxNonceGapReport")
+	proto.RegisterType((*OutTxNonceGap)(nil), "zetachain.zetacore.crosschain.OutTxNonceGap")
 }
 
 func init() { proto.RegisterFile("crosschain/out_tx_tracker.proto", fileDescriptor_5638c11005e4d36d) }
 
 var fileDescriptor_5638c11005e4d36d = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xeb, 0xdb, 0xde, 0x36, 0x35, 0x4c, 0x16, 0x82, 0x20, 0x84, 0xa9, 0x3a, 0x95, 0x01,
-	0x47, 0xc0, 0x1b, 0x30, 0x20, 0x10, 0x48, 0x48, 0xa1, 0x53, 0x17, 0x2b, 0x4d, 0xac, 0xda, 0x02,
-	0xe2, 0xc8, 0x3e, 0x41, 0x86, 0xa7, 0xe0, 0x05, 0x78, 0x1f, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11,
-	0x14, 0x27, 0xa8, 0x4c, 0x6c, 0xe7, 0xd3, 0xd1, 0xf9, 0xf4, 0x9f, 0x1f, 0x1f, 0xa5, 0x46, 0x5b,
-	0x9b, 0xca, 0x44, 0xe5, 0x91, 0x2e, 0x81, 0x83, 0xe3, 0x60, 0x92, 0xf4, 0x41, 0x18, 0x56, 0x18,
-	0x0d, 0x9a, 0x1c, 0xbe, 0x0a, 0x48, 0xfc, 0x9e, 0xf9, 0x49, 0x1b, 0xc1, 0x36, 0x37, 0xd3, 0x05,
-	0xc6, 0x73, 0x77, 0x95, 0x58, 0x79, 0xab, 0x2c, 0x90, 0x3d, 0x3c, 0x02, 0xc7, 0x65, 0x62, 0x65,
-	0x88, 0x26, 0x68, 0x36, 0x8e, 0x87, 0xe0, 0x97, 0xe4, 0x00, 0x8f, 0xc1, 0x71, 0xab, 0x56, 0xb9,
-	0x30, 0xe1, 0x3f, 0xbf, 0x0a, 0xc0, 0xdd, 0x7b, 0x26, 0xbb, 0x78, 0x58, 0x18, 0xfd, 0x2c, 0xb2,
-	0xb0, 0x3f, 0x41, 0xb3, 0x20, 0xee, 0x68, 0xfa, 0x8e, 0xf0, 0xf6, 0x5d, 0x09, 0x73, 0x37, 0x6f,
-	0x13, 0x91, 0x1d, 0xfc, 0x5f, 0xe5, 0x99, 0x70, 0x9d, 0xbc, 0x05, 0xb2, 0x8f, 0x03, 0x9f, 0x85,
-	0xab, 0xcc, 0xab, 0xfb, 0xf1, 0xc8, 0xf3, 0x75, 0xd6, 0x1c, 0xe4, 0x3a, 0x4f, 0x85, 0x17, 0x0f,
-	0xe2, 0x16, 0xc8, 0x25, 0x1e, 0x37, 0x11, 0xf9, 0xa3, 0xb2, 0x10, 0x0e, 0x26, 0xfd, 0xd9, 0xd6,
-	0xd9, 0x31, 0xfb, 0xf3, 0x4d, 0xb6, 0xf9, 0x31, 0x0e, 0x64, 0x37, 0x5d, 0xdc, 0x7c, 0x54, 0x14,
-	0xad, 0x2b, 0x8a, 0xbe, 0x2a, 0x8a, 0xde, 0x6a, 0xda, 0x5b, 0xd7, 0xb4, 0xf7, 0x59, 0xd3, 0xde,
-	0xe2, 0x74, 0xa5, 0x40, 0x96, 0x4b, 0x96, 0xea, 0xa7, 0xa8, 0xd1, 0x9d, 0xb4, 0x05, 0xff, 0x98,
-	0x23, 0x17, 0xfd, 0xaa, 0x1d, 0x5e, 0x0a, 0x61, 0x97, 0x43, 0x5f, 0xf7, 0xf9, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0c, 0x73, 0x3d, 0x85, 0x91, 0x01, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0xdb, 0x24, 0x75, 0x6e, 0xd3, 0x2a, 0xdf, 0xb4, 0xfa, 0x30, 0x45, 0x71, 0x43, 0xc4,
+	0x22, 0x54, 0xc2, 0x51, 0xc3, 0x0e, 0x89, 0x05, 0xa9, 0x44, 0x29, 0x3f, 0x41, 0x32, 0x59, 0xb1,
+	0xb1, 0x9c, 0xf1, 0x28, 0x1e, 0x35, 0x78, 0xac, 0x99, 0x09, 0x72, 0xfb, 0x14, 0xbc, 0x00, 0x3b,
+	0x16, 0x2c, 0x78, 0x90, 0xb2, 0xeb, 0x92, 0x15, 0x42, 0xc9, 0x8b, 0x20, 0xdf, 0x71, 0x08, 0x15,
+	0x08, 0x54, 0x89, 0xdd, 0xfd, 0xc9, 0x39, 0xf7, 0xde, 0x73, 0xe2, 0x81, 0x7d, 0x2a, 0x85, 0x52,
+	0x34, 0x89, 0x78, 0xda, 0x13, 0x33, 0x1d, 0xea, 0x3c, 0xd4, 0x32, 0xa2, 0xa7, 0x4c, 0xfa, 0x99,
+	0x14, 0x5a, 0x90, 0xd6, 0x39, 0xd3, 0x11, 0xf6, 0x7d, 0x8c, 0x84, 0x64, 0xfe, 0x0a, 0xb3, 0xb7,
+	0x3b, 0x11, 0x13, 0x81, 0xbf, 0xec, 0x15, 0x91, 0x01, 0x75, 0xce, 0x00, 0x46, 0xf9, 0x93, 0x48,
+	0x25, 0xcf, 0xb9, 0xd2, 0xe4, 0x06, 0x6c, 0xe8, 0x3c, 0x4c, 0x22, 0x95, 0xb8, 0x76, 0xdb, 0xee,
+	0xd6, 0x83, 0x9a, 0xc6, 0x26, 0xb9, 0x05, 0x75, 0x9d, 0x87, 0x8a, 0x4f, 0x52, 0x26, 0xdd, 0x35,
+	0x6c, 0x39, 0x3a, 0x7f, 0x85, 0x39, 0xf9, 0x1f, 0x6a, 0x99, 0x14, 0x6f, 0x59, 0xec, 0xae, 0xb7,
+	0xed, 0xae, 0x13, 0x94, 0x19, 0x69, 0x01, 0x8c, 0xa7, 0x82, 0x9e, 0x1a, 0xc2, 0x0a, 0xa2, 0xea,
+	0x58, 0x29, 0x38, 0x3b, 0xef, 0x6d, 0x68, 0xbc, 0x9c, 0xe9, 0x51, 0x3e, 0x32, 0x67, 0x90, 0x5d,
+	0xa8, 0xf2, 0x34, 0x66, 0x79, 0x39, 0xdb, 0x24, 0xe4, 0x26, 0x38, 0x78, 0x40, 0xc8, 0x63, 0x9c,
+	0xbc, 0x1e, 0x6c, 0x60, 0x7e, 0x12, 0x17, 0x80, 0x54, 0xa4, 0x94, 0xe1, 0xdc, 0x4a, 0x60, 0x12,
+	0xf2, 0x18, 0xea, 0xc5, 0xc0, 0x70, 0xca, 0x95, 0x76, 0x2b, 0xed, 0xf5, 0xee, 0x66, 0xff, 0xae,
+	0xff, 0x47, 0x6d, 0xfc, 0x95, 0x04, 0x81, 0x93, 0x94, 0x51, 0xe7, 0xd3, 0x1a, 0xec, 0xe0, 0x7e,
+	0xc3, 0x82, 0xf6, 0x38, 0xca, 0x02, 0x96, 0x09, 0xa9, 0xc9, 0x1e, 0x38, 0x12, 0x23, 0x26, 0xcb,
+	0x4d, 0x7f, 0xe4, 0xa8, 0x93, 0x52, 0xa1, 0xd9, 0x6a, 0x0d, 0xb7, 0x72, 0xb4, 0x52, 0xc8, 0x40,
+	0x8e, 0xa1, 0x4a, 0xa3, 0x99, 0x32, 0xeb, 0x6e, 0xf7, 0x0f, 0xff, 0xb2, 0xd4, 0x95, 0xd9, 0x47,
+	0x05, 0x30, 0x30, 0x78, 0xf2, 0x14, 0x6a, 0x11, 0xd5, 0x5c, 0xa4, 0x28, 0xea, 0x76, 0xbf, 0x7f,
+	0x1d, 0xa6, 0x47, 0x88, 0x0c, 0x4a, 0x06, 0x72, 0x07, 0xb6, 0x69, 0x94, 0x52, 0x36, 0x0d, 0x97,
+	0xce, 0x57, 0xf1, 0xa6, 0x86, 0xa9, 0x1a, 0x65, 0xc8, 0x6d, 0x68, 0x94, 0x56, 0x32, 0x3e, 0x49,
+	0xb4, 0x5b, 0x43, 0x23, 0x36, 0x8d, 0x99, 0x58, 0xea, 0x7c, 0xb6, 0x61, 0xeb, 0xca, 0xa0, 0x7f,
+	0xe5, 0x67, 0x0b, 0x80, 0x52, 0x9d, 0x87, 0x86, 0xab, 0xfc, 0x1b, 0x15, 0x95, 0x13, 0xe4, 0x0b,
+	0x60, 0xc3, 0xc8, 0xaf, 0xdc, 0x2a, 0x9a, 0x7d, 0x2d, 0x35, 0x8c, 0xa7, 0x83, 0xca, 0xc5, 0xd7,
+	0x7d, 0x2b, 0x58, 0x12, 0x1d, 0x3c, 0x00, 0xf2, 0xab, 0xfa, 0xa4, 0x09, 0x8d, 0xa1, 0xd0, 0x03,
+	0x29, 0xa2, 0x98, 0x46, 0x4a, 0x37, 0x2d, 0xd2, 0x00, 0x67, 0x28, 0xf4, 0x0b, 0x9e, 0xb2, 0xb8,
+	0x69, 0xef, 0x55, 0x3e, 0x7e, 0xf0, 0xec, 0x83, 0x87, 0xb0, 0xf3, 0x1b, 0xbd, 0xc9, 0x7f, 0xb0,
+	0x15, 0xb0, 0x4c, 0x72, 0x21, 0xb9, 0xe6, 0xe7, 0x2c, 0x6e, 0x5a, 0x64, 0x0b, 0xea, 0x47, 0x28,
+	0xf2, 0x74, 0x05, 0x1f, 0x3c, 0xbb, 0x98, 0x7b, 0xf6, 0xe5, 0xdc, 0xb3, 0xbf, 0xcd, 0x3d, 0xfb,
+	0xdd, 0xc2, 0xb3, 0x2e, 0x17, 0x9e, 0xf5, 0x65, 0xe1, 0x59, 0xaf, 0x0f, 0x27, 0x5c, 0x27, 0xb3,
+	0xb1, 0x4f, 0xc5, 0x9b, 0x5e, 0x71, 0xd7, 0x3d, 0xf3, 0x16, 0x2c, 0x4f, 0xec, 0xe5, 0xbd, 0x9f,
+	0x5e, 0x08, 0x7d, 0x96, 0x31, 0x35, 0xae, 0xe1, 0x47, 0x7e, 0xff, 0xfb, 0x00, 0xb6, 0x7b, 0x91,
+	0x32, 0x3c, 0x04, 0x00, 0x00,
 }
 
 func (m *TxHashList) Marshal() (dAtA []byte, err error) {
@@ -201,6 +445,13 @@ func (m *TxHashList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Proved {
 		i--
 		if m.Proved {
@@ -282,6 +533,124 @@ func (m *OutTxTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutTxNonceGapReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutTxNonceGapReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutTxNonceGapReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CancelTxHash) > 0 {
+		i -= len(m.CancelTxHash)
+		copy(dAtA[i:], m.CancelTxHash)
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(len(m.CancelTxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Cause != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TssNonce != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.TssNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutTxNonceGap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutTxNonceGap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutTxNonceGap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutTxTracker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintOutTxTracker(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOutTxTracker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOutTxTracker(v)
 	base := offset
@@ -310,6 +679,10 @@ func (m *TxHashList) Size() (n int) {
 	if m.Proved {
 		n += 2
 	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovOutTxTracker(uint64(l))
+	}
 	return n
 }
 
@@ -338,31 +711,89 @@ func (m *OutTxTracker) Size() (n int) {
 	return n
 }
 
-func sovOutTxTracker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOutTxTracker(x uint64) (n int) {
-	return sovOutTxTracker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *OutTxNonceGapReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovOutTxTracker(uint64(l))
+	}
+	if m.TssNonce != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.TssNonce))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.Cause))
+	}
+	if m.Action != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.Action))
+	}
+	l = len(m.CancelTxHash)
+	if l > 0 {
+		n += 1 + l + sovOutTxTracker(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.BlockHeight))
+	}
+	return n
 }
-func (m *TxHashList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOutTxTracker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *OutTxNonceGap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovOutTxTracker(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOutTxTracker(uint64(m.Nonce))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovOutTxTracker(uint64(l))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovOutTxTracker(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOutTxTracker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutTxTracker(x uint64) (n int) {
+	return sovOutTxTracker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxHashList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutTxTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -457,6 +888,38 @@ func (m *TxHashList) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Proved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOutTxTracker(dAtA[iNdEx:])
@@ -632,6 +1095,382 @@ func (m *OutTxTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OutTxNonceGapReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutTxTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutTxNonceGapReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutTxNonceGapReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssNonce", wireType)
+			}
+			m.TssNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TssNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= OutTxNonceGapCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= OutTxNonceGapAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutTxTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutTxNonceGap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutTxTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutTxNonceGap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutTxNonceGap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutTxTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, OutTxNonceGapReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutTxTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutTxTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOutTxTracker(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -490,6 +490,102 @@ func (m *QueryAllOutTxTrackerByChainResponse) GetPagination() *query.PageRespons
 	return nil
 }
 
+type QueryGetOutTxNonceGapRequest struct {
+	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryGetOutTxNonceGapRequest) Reset()         { *m = QueryGetOutTxNonceGapRequest{} }
+func (m *QueryGetOutTxNonceGapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutTxNonceGapRequest) ProtoMessage()    {}
+func (*QueryGetOutTxNonceGapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{10}
+}
+func (m *QueryGetOutTxNonceGapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutTxNonceGapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutTxNonceGapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutTxNonceGapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutTxNonceGapRequest.Merge(m, src)
+}
+func (m *QueryGetOutTxNonceGapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutTxNonceGapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutTxNonceGapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutTxNonceGapRequest proto.InternalMessageInfo
+
+func (m *QueryGetOutTxNonceGapRequest) GetChainID() int64 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *QueryGetOutTxNonceGapRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type QueryGetOutTxNonceGapResponse struct {
+	OutTxNonceGap OutTxNonceGap `protobuf:"bytes,1,opt,name=outTxNonceGap,proto3" json:"outTxNonceGap"`
+}
+
+func (m *QueryGetOutTxNonceGapResponse) Reset()         { *m = QueryGetOutTxNonceGapResponse{} }
+func (m *QueryGetOutTxNonceGapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutTxNonceGapResponse) ProtoMessage()    {}
+func (*QueryGetOutTxNonceGapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{11}
+}
+func (m *QueryGetOutTxNonceGapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutTxNonceGapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutTxNonceGapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutTxNonceGapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutTxNonceGapResponse.Merge(m, src)
+}
+func (m *QueryGetOutTxNonceGapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutTxNonceGapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutTxNonceGapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutTxNonceGapResponse proto.InternalMessageInfo
+
+func (m *QueryGetOutTxNonceGapResponse) GetOutTxNonceGap() OutTxNonceGap {
+	if m != nil {
+		return m.OutTxNonceGap
+	}
+	return OutTxNonceGap{}
+}
+
 type QueryAllInTxTrackerByChainRequest struct {
 	ChainId    int64              `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -499,7 +595,7 @@ func (m *QueryAllInTxTrackerByChainRequest) Reset()         { *m = QueryAllInTxT
 func (m *QueryAllInTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackerByChainRequest) ProtoMessage()    {}
 func (*QueryAllInTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{10}
+	return fileDescriptor_65a992045e92a606, []int{12}
 }
 func (m *QueryAllInTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +647,7 @@ func (m *QueryAllInTxTrackerByChainResponse) Reset()         { *m = QueryAllInTx
 func (m *QueryAllInTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackerByChainResponse) ProtoMessage()    {}
 func (*QueryAllInTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{11}
+	return fileDescriptor_65a992045e92a606, []int{13}
 }
 func (m *QueryAllInTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,7 +698,7 @@ func (m *QueryAllInTxTrackersRequest) Reset()         { *m = QueryAllInTxTracker
 func (m *QueryAllInTxTrackersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackersRequest) ProtoMessage()    {}
 func (*QueryAllInTxTrackersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{12}
+	return fileDescriptor_65a992045e92a606, []int{14}
 }
 func (m *QueryAllInTxTrackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +743,7 @@ func (m *QueryAllInTxTrackersResponse) Reset()         { *m = QueryAllInTxTracke
 func (m *QueryAllInTxTrackersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxTrackersResponse) ProtoMessage()    {}
 func (*QueryAllInTxTrackersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{13}
+	return fileDescriptor_65a992045e92a606, []int{15}
 }
 func (m *QueryAllInTxTrackersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,7 +794,7 @@ func (m *QueryGetInTxHashToCctxRequest) Reset()         { *m = QueryGetInTxHashT
 func (m *QueryGetInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInTxHashToCctxRequest) ProtoMessage()    {}
 func (*QueryGetInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{14}
+	return fileDescriptor_65a992045e92a606, []int{16}
 }
 func (m *QueryGetInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +838,7 @@ func (m *QueryGetInTxHashToCctxResponse) Reset()         { *m = QueryGetInTxHash
 func (m *QueryGetInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInTxHashToCctxResponse) ProtoMessage()    {}
 func (*QueryGetInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{15}
+	return fileDescriptor_65a992045e92a606, []int{17}
 }
 func (m *QueryGetInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +882,7 @@ func (m *QueryInTxHashToCctxDataRequest) Reset()         { *m = QueryInTxHashToC
 func (m *QueryInTxHashToCctxDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToCctxDataRequest) ProtoMessage()    {}
 func (*QueryInTxHashToCctxDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{16}
+	return fileDescriptor_65a992045e92a606, []int{18}
 }
 func (m *QueryInTxHashToCctxDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +926,7 @@ func (m *QueryInTxHashToCctxDataResponse) Reset()         { *m = QueryInTxHashTo
 func (m *QueryInTxHashToCctxDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToCctxDataResponse) ProtoMessage()    {}
 func (*QueryInTxHashToCctxDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{17}
+	return fileDescriptor_65a992045e92a606, []int{19}
 }
 func (m *QueryInTxHashToCctxDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +970,7 @@ func (m *QueryAllInTxHashToCctxRequest) Reset()         { *m = QueryAllInTxHashT
 func (m *QueryAllInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxHashToCctxRequest) ProtoMessage()    {}
 func (*QueryAllInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{18}
+	return fileDescriptor_65a992045e92a606, []int{20}
 }
 func (m *QueryAllInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1015,7 @@ func (m *QueryAllInTxHashToCctxResponse) Reset()         { *m = QueryAllInTxHash
 func (m *QueryAllInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxHashToCctxResponse) ProtoMessage()    {}
 func (*QueryAllInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{19}
+	return fileDescriptor_65a992045e92a606, []int{21}
 }
 func (m *QueryAllInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1066,7 @@ func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest
 func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceRequest) ProtoMessage()    {}
 func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{20}
+	return fileDescriptor_65a992045e92a606, []int{22}
 }
 func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1110,7 @@ func (m *QueryGetGasPriceResponse) Reset()         { *m = QueryGetGasPriceRespon
 func (m *QueryGetGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceResponse) ProtoMessage()    {}
 func (*QueryGetGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{21}
+	return fileDescriptor_65a992045e92a606, []int{23}
 }
 func (m *QueryGetGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1154,7 @@ func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest
 func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceRequest) ProtoMessage()    {}
 func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{22}
+	return fileDescriptor_65a992045e92a606, []int{24}
 }
 func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1199,7 @@ func (m *QueryAllGasPriceResponse) Reset()         { *m = QueryAllGasPriceRespon
 func (m *QueryAllGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceResponse) ProtoMessage()    {}
 func (*QueryAllGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{23}
+	return fileDescriptor_65a992045e92a606, []int{25}
 }
 func (m *QueryAllGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,7 +1250,7 @@ func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBloc
 func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{24}
+	return fileDescriptor_65a992045e92a606, []int{26}
 }
 func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1294,7 @@ func (m *QueryGetLastBlockHeightResponse) Reset()         { *m = QueryGetLastBlo
 func (m *QueryGetLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{25}
+	return fileDescriptor_65a992045e92a606, []int{27}
 }
 func (m *QueryGetLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1338,7 @@ func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBloc
 func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{26}
+	return fileDescriptor_65a992045e92a606, []int{28}
 }
 func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1383,7 @@ func (m *QueryAllLastBlockHeightResponse) Reset()         { *m = QueryAllLastBlo
 func (m *QueryAllLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{27}
+	return fileDescriptor_65a992045e92a606, []int{29}
 }
 func (m *QueryAllLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1434,7 @@ func (m *QueryGetCctxRequest) Reset()         { *m = QueryGetCctxRequest{} }
 func (m *QueryGetCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxRequest) ProtoMessage()    {}
 func (*QueryGetCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{28}
+	return fileDescriptor_65a992045e92a606, []int{30}
 }
 func (m *QueryGetCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1479,7 @@ func (m *QueryGetCctxByNonceRequest) Reset()         { *m = QueryGetCctxByNonceR
 func (m *QueryGetCctxByNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxByNonceRequest) ProtoMessage()    {}
 func (*QueryGetCctxByNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{29}
+	return fileDescriptor_65a992045e92a606, []int{31}
 }
 func (m *QueryGetCctxByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1530,7 @@ func (m *QueryGetCctxResponse) Reset()         { *m = QueryGetCctxResponse{} }
 func (m *QueryGetCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxResponse) ProtoMessage()    {}
 func (*QueryGetCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{30}
+	return fileDescriptor_65a992045e92a606, []int{32}
 }
 func (m *QueryGetCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,7 +1574,7 @@ func (m *QueryAllCctxRequest) Reset()         { *m = QueryAllCctxRequest{} }
 func (m *QueryAllCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxRequest) ProtoMessage()    {}
 func (*QueryAllCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{31}
+	return fileDescriptor_65a992045e92a606, []int{33}
 }
 func (m *QueryAllCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1619,7 @@ func (m *QueryAllCctxResponse) Reset()         { *m = QueryAllCctxResponse{} }
 func (m *QueryAllCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxResponse) ProtoMessage()    {}
 func (*QueryAllCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{32}
+	return fileDescriptor_65a992045e92a606, []int{34}
 }
 func (m *QueryAllCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,7 +1671,7 @@ func (m *QueryListCctxPendingRequest) Reset()         { *m = QueryListCctxPendin
 func (m *QueryListCctxPendingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListCctxPendingRequest) ProtoMessage()    {}
 func (*QueryListCctxPendingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{33}
+	return fileDescriptor_65a992045e92a606, []int{35}
 }
 func (m *QueryListCctxPendingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1627,7 +1723,7 @@ func (m *QueryListCctxPendingResponse) Reset()         { *m = QueryListCctxPendi
 func (m *QueryListCctxPendingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListCctxPendingResponse) ProtoMessage()    {}
 func (*QueryListCctxPendingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{34}
+	return fileDescriptor_65a992045e92a606, []int{36}
 }
 func (m *QueryListCctxPendingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +1773,7 @@ func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightR
 func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
 func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{35}
+	return fileDescriptor_65a992045e92a606, []int{37}
 }
 func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1714,7 +1810,7 @@ func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeight
 func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
 func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{36}
+	return fileDescriptor_65a992045e92a606, []int{38}
 }
 func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,7 +1855,7 @@ func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZ
 func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
 func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{37}
+	return fileDescriptor_65a992045e92a606, []int{39}
 }
 func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1812,7 +1908,7 @@ func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasTo
 func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
 func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{38}
+	return fileDescriptor_65a992045e92a606, []int{40}
 }
 func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +1965,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMess
 func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{39}
+	return fileDescriptor_65a992045e92a606, []int{41}
 }
 func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1908,7 +2004,7 @@ func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
 func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{40}
+	return fileDescriptor_65a992045e92a606, []int{42}
 }
 func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,6 +2051,8 @@ func init() {
 	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
 	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
 	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
+	proto.RegisterType((*QueryGetOutTxNonceGapRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxNonceGapRequest")
+	proto.RegisterType((*QueryGetOutTxNonceGapResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxNonceGapResponse")
 	proto.RegisterType((*QueryAllInTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest")
 	proto.RegisterType((*QueryAllInTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainResponse")
 	proto.RegisterType((*QueryAllInTxTrackersRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersRequest")
@@ -1991,119 +2089,123 @@ func init() {
 func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
 
 var fileDescriptor_65a992045e92a606 = []byte{
-	// 1782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdf, 0x6f, 0x14, 0x55,
-	0x14, 0xee, 0xed, 0xd2, 0x52, 0x6e, 0x0b, 0x95, 0x4b, 0x85, 0x3a, 0xb4, 0x5b, 0x98, 0x5a, 0x5a,
-	0xc1, 0xee, 0xd0, 0x02, 0x45, 0xa0, 0x18, 0xb7, 0x45, 0x0a, 0xb1, 0x40, 0xdd, 0xd4, 0x68, 0x30,
-	0x66, 0x73, 0x3b, 0x3b, 0xce, 0x4e, 0x98, 0xce, 0x94, 0x9d, 0x59, 0xd2, 0xd2, 0xf4, 0x85, 0x07,
-	0x5f, 0x7c, 0x31, 0x21, 0xd1, 0x17, 0x5f, 0x8d, 0x3e, 0xf8, 0xe0, 0x83, 0xd1, 0x07, 0x13, 0x8c,
-	0x51, 0x91, 0x47, 0x12, 0x13, 0x63, 0x34, 0x31, 0x06, 0xfc, 0x43, 0xcc, 0xdc, 0x39, 0xb3, 0x7b,
-	0xe7, 0xd7, 0xee, 0xed, 0x76, 0x79, 0xe0, 0xa9, 0x3b, 0x73, 0xef, 0x39, 0xe7, 0xfb, 0xbe, 0xfb,
-	0x63, 0xce, 0x39, 0xc5, 0x07, 0xd5, 0x8a, 0xed, 0x38, 0x6a, 0x99, 0x1a, 0x96, 0x72, 0xbb, 0xaa,
-	0x55, 0x36, 0x72, 0x6b, 0x15, 0xdb, 0xb5, 0xc9, 0xf0, 0x5d, 0xcd, 0xa5, 0xec, 0x75, 0x8e, 0xfd,
-	0xb2, 0x2b, 0x5a, 0xae, 0x3e, 0x55, 0x3a, 0xae, 0xda, 0xce, 0xaa, 0xed, 0x28, 0x2b, 0xd4, 0xd1,
-	0x7c, 0x3b, 0xe5, 0xce, 0xd4, 0x8a, 0xe6, 0xd2, 0x29, 0x65, 0x8d, 0xea, 0x86, 0x45, 0x5d, 0xc3,
-	0xb6, 0x7c, 0x57, 0xd2, 0x08, 0x17, 0x82, 0xfd, 0x2c, 0xb2, 0xdf, 0x45, 0x77, 0x1d, 0x26, 0x48,
-	0xdc, 0x04, 0x9d, 0x3a, 0xc5, 0xb5, 0x8a, 0xa1, 0x6a, 0x30, 0x36, 0xca, 0x8d, 0x31, 0x9b, 0x62,
-	0x99, 0x3a, 0xe5, 0xa2, 0x6b, 0x17, 0x55, 0xb5, 0xe6, 0x20, 0x1b, 0x9b, 0xe4, 0x56, 0xa8, 0x7a,
-	0x4b, 0xab, 0xc0, 0xb8, 0xcc, 0x8d, 0x9b, 0xd4, 0x71, 0x8b, 0x2b, 0xa6, 0xad, 0xde, 0x2a, 0x96,
-	0x35, 0x43, 0x2f, 0xbb, 0x09, 0x28, 0xed, 0xaa, 0x1b, 0x77, 0x72, 0x88, 0x9b, 0xb0, 0x46, 0x2b,
-	0x74, 0xd5, 0x81, 0x81, 0x01, 0xdd, 0xd6, 0x6d, 0xf6, 0x53, 0xf1, 0x7e, 0xc1, 0xdb, 0x21, 0xdd,
-	0xb6, 0x75, 0x53, 0x53, 0xe8, 0x9a, 0xa1, 0x50, 0xcb, 0xb2, 0x5d, 0x26, 0x09, 0xd8, 0xc8, 0x43,
-	0x58, 0x7a, 0xdb, 0x53, 0xed, 0xa6, 0xe6, 0xd2, 0xbc, 0xaa, 0xda, 0x55, 0xcb, 0x35, 0x2c, 0xbd,
-	0xa0, 0xdd, 0xae, 0x6a, 0x8e, 0x2b, 0x5f, 0xc3, 0x87, 0x13, 0x47, 0x9d, 0x35, 0xdb, 0x72, 0x34,
-	0x92, 0xc3, 0x07, 0xe8, 0x8a, 0x5d, 0x71, 0xb5, 0x52, 0xd1, 0x5b, 0x9b, 0x22, 0x5d, 0xf5, 0x66,
-	0x0c, 0xa2, 0x23, 0x68, 0x62, 0x4f, 0x61, 0x3f, 0x0c, 0x31, 0x5b, 0x36, 0x20, 0x0f, 0x60, 0xc2,
-	0xdc, 0x2d, 0x31, 0xd4, 0x41, 0x90, 0x9b, 0xf8, 0x40, 0xe8, 0x2d, 0x38, 0x9f, 0xc7, 0xdd, 0x3e,
-	0x3b, 0xe6, 0xaf, 0x77, 0x7a, 0x2c, 0xd7, 0x70, 0x27, 0xe4, 0x7c, 0xf3, 0xb9, 0x5d, 0x8f, 0xfe,
-	0x19, 0xe9, 0x28, 0x80, 0x69, 0x8d, 0xc0, 0x82, 0xe6, 0xde, 0xa8, 0xba, 0xcb, 0xeb, 0xcb, 0xbe,
-	0x92, 0x10, 0x9a, 0x0c, 0xe2, 0xdd, 0xcc, 0xf8, 0xea, 0x25, 0x16, 0x24, 0x53, 0x08, 0x1e, 0xc9,
-	0x00, 0xee, 0xb2, 0x6c, 0x4b, 0xd5, 0x06, 0x3b, 0x8f, 0xa0, 0x89, 0x5d, 0x05, 0xff, 0x41, 0xae,
-	0xe2, 0xa1, 0x64, 0x77, 0x80, 0xf9, 0x1d, 0xdc, 0x67, 0x73, 0xef, 0x01, 0xf9, 0x89, 0x26, 0xc8,
-	0x79, 0x57, 0x80, 0x3f, 0xe4, 0x46, 0xd6, 0x80, 0x45, 0xde, 0x34, 0x93, 0x58, 0x5c, 0xc6, 0xb8,
-	0xbe, 0xd7, 0x21, 0xe6, 0xb1, 0x9c, 0x7f, 0x30, 0x72, 0xde, 0xc1, 0xc8, 0xf9, 0x07, 0x0a, 0x0e,
-	0x46, 0x6e, 0x89, 0xea, 0x1a, 0xd8, 0x16, 0x38, 0x4b, 0xf9, 0x01, 0x02, 0x7a, 0xb1, 0x38, 0xa9,
-	0xf4, 0x32, 0x6d, 0xa0, 0x47, 0x16, 0x42, 0xf8, 0x3b, 0x19, 0xfe, 0xf1, 0xa6, 0xf8, 0x7d, 0x4c,
-	0x21, 0x02, 0xf7, 0x10, 0x96, 0x93, 0x08, 0xcc, 0x6d, 0xcc, 0x7b, 0x48, 0x02, 0xbd, 0x06, 0x70,
-	0x17, 0x43, 0x06, 0x6b, 0xee, 0x3f, 0x44, 0x54, 0xec, 0x6c, 0x59, 0xc5, 0x5f, 0x11, 0x1e, 0x6d,
-	0x08, 0xe2, 0x39, 0x11, 0xf3, 0x23, 0x84, 0x8f, 0x06, 0x3c, 0xae, 0x5a, 0x69, 0x5a, 0xbe, 0x84,
-	0x7b, 0xfc, 0x4b, 0xd4, 0x28, 0x85, 0x8f, 0x50, 0xa9, 0x6d, 0x82, 0xfe, 0xc4, 0xad, 0x6a, 0x12,
-	0x10, 0xd0, 0xb3, 0x80, 0x7b, 0x0d, 0x2b, 0x2a, 0xe7, 0xf1, 0x26, 0x72, 0xf2, 0xfe, 0x7c, 0x35,
-	0x79, 0x27, 0xed, 0x13, 0x93, 0x3b, 0xc1, 0x5c, 0x48, 0xa7, 0xdd, 0x27, 0xf8, 0x07, 0xee, 0x04,
-	0x87, 0xe3, 0x3c, 0x0f, 0x22, 0x5d, 0xc0, 0xc3, 0xc1, 0xed, 0xea, 0x85, 0xbc, 0x42, 0x9d, 0xf2,
-	0xb2, 0x3d, 0xaf, 0xba, 0xeb, 0x81, 0x4c, 0x12, 0xee, 0x31, 0x60, 0x00, 0x3e, 0x32, 0xb5, 0x67,
-	0x79, 0x0b, 0x67, 0xd3, 0x8c, 0x81, 0xfb, 0xfb, 0x78, 0x9f, 0x11, 0x1a, 0x01, 0xa1, 0x27, 0x05,
-	0xe8, 0xd7, 0x8d, 0x40, 0x81, 0x88, 0x2b, 0x79, 0x16, 0xc2, 0x87, 0x27, 0x5f, 0xa2, 0x2e, 0x15,
-	0x01, 0x7f, 0x17, 0x8f, 0xa4, 0x5a, 0x03, 0xfa, 0x77, 0xf1, 0xde, 0x79, 0x0f, 0x13, 0xdb, 0xf4,
-	0xcb, 0xeb, 0x8e, 0xe0, 0x7d, 0xc1, 0xdb, 0x00, 0xf4, 0xb0, 0x1f, 0x59, 0x07, 0xd5, 0x61, 0xcb,
-	0xc4, 0x55, 0x6f, 0xd7, 0xe6, 0x7c, 0x88, 0x40, 0xa3, 0x84, 0x48, 0x0d, 0x96, 0x28, 0xd3, 0xa6,
-	0x25, 0x6a, 0xdf, 0x3e, 0x55, 0xf0, 0xa1, 0x60, 0xab, 0x2d, 0x50, 0x67, 0xc9, 0x4b, 0x12, 0xb9,
-	0x4f, 0x8b, 0x61, 0x95, 0xb4, 0x75, 0x58, 0x61, 0xff, 0x41, 0x2e, 0xe2, 0xc1, 0xb8, 0x41, 0x2d,
-	0xcd, 0xe9, 0x09, 0xde, 0x81, 0xb6, 0xe3, 0x4d, 0xc8, 0xd6, 0x5c, 0xd4, 0x0c, 0x65, 0x0a, 0x88,
-	0xf2, 0xa6, 0x19, 0x45, 0xd4, 0xae, 0xd5, 0xfb, 0x0a, 0x01, 0x89, 0x50, 0x8c, 0x44, 0x12, 0x99,
-	0x96, 0x48, 0xb4, 0x6f, 0x7d, 0x66, 0xea, 0x57, 0xc1, 0x22, 0x75, 0xdc, 0x39, 0x2f, 0xc7, 0xbe,
-	0xc2, 0x52, 0xec, 0xc6, 0xcb, 0xb4, 0x09, 0xa7, 0x30, 0xc9, 0x0e, 0x88, 0xbe, 0x87, 0xfb, 0x23,
-	0x43, 0x20, 0x69, 0xae, 0x09, 0xdf, 0xa8, 0xc3, 0xa8, 0x1b, 0xb9, 0x5c, 0x3f, 0x1c, 0x29, 0xa0,
-	0xdb, 0xb5, 0x92, 0xbf, 0x20, 0xe0, 0x99, 0x14, 0xaa, 0x11, 0xcf, 0x4c, 0x1b, 0x78, 0xb6, 0x6f,
-	0x95, 0x4f, 0x40, 0xd9, 0xb0, 0xa0, 0xb9, 0xfc, 0x6d, 0x95, 0xbc, 0xb4, 0x8b, 0x50, 0xe6, 0xc0,
-	0xe4, 0xb9, 0x8d, 0xeb, 0x5e, 0x3e, 0xdf, 0x6a, 0x19, 0xa0, 0xe3, 0x81, 0x70, 0x68, 0x50, 0xed,
-	0x06, 0xee, 0xe3, 0xef, 0x56, 0xc1, 0xf4, 0x9f, 0x37, 0x29, 0x84, 0x1c, 0xc8, 0x1f, 0x00, 0xc7,
-	0xbc, 0x69, 0x3e, 0x8b, 0x1b, 0xf9, 0x1b, 0x04, 0x44, 0x6a, 0xfe, 0x53, 0x89, 0x64, 0x76, 0x44,
-	0xa4, 0x7d, 0xab, 0x7e, 0x1d, 0x12, 0xa9, 0x45, 0xc3, 0x61, 0xda, 0x2f, 0x69, 0x56, 0xa9, 0x5e,
-	0xb0, 0x36, 0x4a, 0x47, 0x07, 0x70, 0x97, 0x69, 0xac, 0x1a, 0x2e, 0x8b, 0xbe, 0xb7, 0xe0, 0x3f,
-	0xc8, 0xf7, 0x83, 0x8c, 0x29, 0xe6, 0xf0, 0x59, 0x49, 0x21, 0xe3, 0x3e, 0xd7, 0x76, 0xa9, 0x09,
-	0x81, 0x60, 0x67, 0x85, 0xde, 0xd5, 0xaa, 0x72, 0xef, 0xf0, 0x78, 0xf5, 0x73, 0xe8, 0x22, 0x90,
-	0xcf, 0x04, 0x1a, 0x44, 0x46, 0x01, 0xf1, 0x41, 0xdc, 0xcd, 0x5d, 0x4d, 0x99, 0x02, 0x3c, 0xc9,
-	0xcb, 0xc0, 0x74, 0xde, 0xb6, 0xee, 0x68, 0x15, 0xef, 0x4b, 0xb4, 0x6c, 0x7b, 0xe6, 0xb1, 0x53,
-	0x10, 0x93, 0x4e, 0xc2, 0x3d, 0x3a, 0x75, 0x16, 0x6b, 0xea, 0xed, 0x29, 0xd4, 0x9e, 0xe5, 0x2f,
-	0x10, 0xe4, 0x0f, 0x71, 0xb7, 0x80, 0xe7, 0x55, 0xbc, 0xdf, 0xae, 0xba, 0x2b, 0x76, 0xd5, 0x2a,
-	0x2d, 0x50, 0xe7, 0xaa, 0xe5, 0x0d, 0x06, 0x3d, 0x82, 0xd8, 0x80, 0x37, 0x9b, 0x75, 0x26, 0x54,
-	0xdb, 0xbc, 0xac, 0x69, 0x30, 0xdb, 0x0f, 0x1a, 0x1f, 0x20, 0x13, 0xb8, 0xdf, 0xfb, 0xcb, 0xdf,
-	0x53, 0x19, 0xa6, 0x67, 0xf4, 0xb5, 0x3c, 0x8e, 0xc7, 0x18, 0xcc, 0x6b, 0x9a, 0xe3, 0x50, 0x5d,
-	0x5b, 0xa2, 0x8e, 0x63, 0x58, 0xfa, 0x52, 0xdd, 0x63, 0xa0, 0xee, 0x65, 0x7c, 0xac, 0xd9, 0x44,
-	0x20, 0x36, 0x84, 0xf7, 0x7c, 0x58, 0x83, 0xe8, 0x13, 0xaa, 0xbf, 0x98, 0xfe, 0x78, 0x04, 0x77,
-	0x31, 0x47, 0xe4, 0x53, 0x84, 0xbb, 0xfd, 0xee, 0x04, 0x99, 0x6a, 0xb2, 0x6f, 0xe2, 0xed, 0x11,
-	0x69, 0x7a, 0x3b, 0x26, 0x3e, 0x32, 0x79, 0xec, 0xde, 0xef, 0xff, 0xdd, 0xef, 0x1c, 0x21, 0xc3,
-	0x8a, 0x67, 0x31, 0xc9, 0xb5, 0xbc, 0xf8, 0xb6, 0x11, 0x79, 0x88, 0x70, 0x1f, 0x5f, 0x50, 0x92,
-	0xf3, 0x22, 0xb1, 0x92, 0x7b, 0x29, 0xd2, 0x85, 0x96, 0x6c, 0x01, 0xf0, 0x45, 0x06, 0xf8, 0x2c,
-	0x39, 0x93, 0x02, 0x98, 0x2f, 0x71, 0x95, 0x4d, 0xb8, 0x9d, 0xb7, 0x94, 0x4d, 0x76, 0x1f, 0x6f,
-	0x91, 0xef, 0x11, 0xee, 0xe7, 0xfd, 0xe6, 0x4d, 0x53, 0x8c, 0x4b, 0x72, 0x47, 0x45, 0x8c, 0x4b,
-	0x4a, 0x97, 0x44, 0x3e, 0xc1, 0xb8, 0x8c, 0x91, 0x51, 0x01, 0x2e, 0xe4, 0x6f, 0x84, 0x0f, 0x46,
-	0x90, 0x43, 0x61, 0x4b, 0xf2, 0x2d, 0x80, 0x08, 0x57, 0xe7, 0xd2, 0xdc, 0x4e, 0x5c, 0x00, 0x9d,
-	0xf3, 0x8c, 0xce, 0x69, 0x32, 0x2d, 0x40, 0x07, 0x6c, 0x61, 0x85, 0xb6, 0xc8, 0x5f, 0x08, 0xbf,
-	0xc8, 0x55, 0x8f, 0x1c, 0xb9, 0x37, 0x04, 0x91, 0xa5, 0x76, 0x1e, 0xa4, 0xfc, 0x0e, 0x3c, 0x00,
-	0xb5, 0x59, 0x46, 0x6d, 0x86, 0x9c, 0x4e, 0xa1, 0x66, 0x58, 0x29, 0xcc, 0x8a, 0x46, 0x69, 0x8b,
-	0x7c, 0x87, 0xf0, 0xbe, 0x30, 0x39, 0xe1, 0x3d, 0x97, 0xd0, 0x03, 0x10, 0xde, 0x73, 0x49, 0x75,
-	0x7d, 0xd3, 0x3d, 0xc7, 0x31, 0x71, 0xc8, 0x6f, 0x00, 0x9c, 0xab, 0x8d, 0x66, 0x05, 0x0f, 0x6f,
-	0x62, 0x85, 0x28, 0x5d, 0x6c, 0xd1, 0x1a, 0xc0, 0xbf, 0xc6, 0xc0, 0x4f, 0x93, 0x93, 0x0d, 0xc0,
-	0xd7, 0xcd, 0x94, 0xcd, 0xe0, 0x79, 0x8b, 0xfc, 0x81, 0x30, 0x89, 0xd7, 0xcc, 0x44, 0x08, 0x4f,
-	0x6a, 0xa5, 0x2e, 0xbd, 0xde, 0xaa, 0x39, 0xf0, 0xc9, 0x33, 0x3e, 0x17, 0xc8, 0xb9, 0x54, 0x3e,
-	0xd1, 0x7f, 0x1f, 0x14, 0x4b, 0xd4, 0xa5, 0x3c, 0xb1, 0x1f, 0x11, 0xde, 0x1f, 0x8e, 0xe0, 0x6d,
-	0xaf, 0xd9, 0x6d, 0x6c, 0x91, 0x16, 0x57, 0x29, 0xb5, 0x36, 0x97, 0x27, 0x19, 0xab, 0x71, 0x32,
-	0x26, 0xb4, 0x4a, 0xe4, 0x6b, 0x54, 0xaf, 0x09, 0xc9, 0x8c, 0xe0, 0x06, 0x89, 0x14, 0xaf, 0xd2,
-	0xd9, 0x6d, 0xdb, 0x01, 0x58, 0x85, 0x81, 0x7d, 0x85, 0x8c, 0xa7, 0x80, 0xd5, 0xc1, 0xc0, 0xd3,
-	0xbc, 0xa4, 0xad, 0x6f, 0x91, 0x2f, 0x11, 0xee, 0x0d, 0xbc, 0x78, 0x52, 0xcf, 0x08, 0x8a, 0xd5,
-	0x12, 0xe2, 0x84, 0x12, 0x5a, 0x1e, 0x67, 0x88, 0x8f, 0x92, 0x91, 0x26, 0x88, 0xc9, 0x03, 0x84,
-	0x5f, 0x88, 0xe6, 0x5a, 0x44, 0xe8, 0xf2, 0x48, 0x49, 0xfc, 0xa4, 0xd9, 0xd6, 0x8c, 0x05, 0xa5,
-	0x56, 0xa3, 0x58, 0x1f, 0x22, 0xdc, 0xcb, 0xa5, 0x53, 0xe4, 0x92, 0x48, 0xf8, 0x66, 0x69, 0x9b,
-	0xf4, 0xe6, 0x0e, 0xbd, 0x00, 0x9b, 0xe3, 0x8c, 0xcd, 0xcb, 0x44, 0x4e, 0xcb, 0x9c, 0x38, 0xe0,
-	0x8f, 0x50, 0xac, 0x4a, 0x26, 0xa2, 0x57, 0x61, 0x72, 0x8d, 0x2f, 0x76, 0xf5, 0xa4, 0xf7, 0x27,
-	0xe4, 0x19, 0x06, 0xff, 0x24, 0xc9, 0xa5, 0xc0, 0x37, 0xc3, 0x76, 0xb5, 0xed, 0xff, 0x33, 0xc2,
-	0x24, 0xe2, 0xd3, 0x3b, 0x05, 0xa2, 0x57, 0xc6, 0x4e, 0xd8, 0xa4, 0x77, 0x21, 0xe4, 0x1c, 0x63,
-	0x33, 0x41, 0x8e, 0x89, 0xb1, 0x21, 0x9f, 0x23, 0xbc, 0x8b, 0x5d, 0x3e, 0xd3, 0x82, 0x32, 0xf2,
-	0xd7, 0xe3, 0xa9, 0x6d, 0xd9, 0x08, 0x7e, 0x77, 0x55, 0xf8, 0x60, 0x31, 0x91, 0xbf, 0x45, 0xb8,
-	0x97, 0xeb, 0x3e, 0x90, 0x73, 0xdb, 0x88, 0x18, 0xee, 0x58, 0xb4, 0x06, 0xf6, 0x0c, 0x03, 0xab,
-	0x90, 0xc9, 0x86, 0x60, 0x63, 0xc9, 0xf5, 0x67, 0x08, 0xef, 0x0e, 0xbe, 0x40, 0xd3, 0x82, 0x2b,
-	0xba, 0x6d, 0x61, 0x23, 0x1d, 0x08, 0x79, 0x94, 0x61, 0x1d, 0x26, 0x87, 0x1b, 0x60, 0xf5, 0x32,
-	0xb0, 0x7e, 0xcf, 0xca, 0xab, 0xdd, 0xa1, 0x74, 0x16, 0x4b, 0xc1, 0x92, 0xbb, 0x07, 0x62, 0x29,
-	0x58, 0x4a, 0xa3, 0xa0, 0xe9, 0xcd, 0xa1, 0xd6, 0x6d, 0x58, 0xea, 0x18, 0xfe, 0x9f, 0xba, 0xd8,
-	0x66, 0x48, 0xfc, 0x2f, 0xbd, 0x74, 0xbe, 0x15, 0x53, 0xc1, 0xaf, 0xfa, 0xdd, 0x30, 0x4a, 0x0f,
-	0x78, 0xb8, 0xed, 0x20, 0x06, 0x3c, 0xb1, 0x91, 0x21, 0x06, 0x3c, 0xb9, 0xcb, 0xd1, 0x14, 0xb8,
-	0x19, 0x32, 0x9b, 0x7b, 0xeb, 0xd1, 0x93, 0x2c, 0x7a, 0xfc, 0x24, 0x8b, 0xfe, 0x7d, 0x92, 0x45,
-	0x9f, 0x3c, 0xcd, 0x76, 0x3c, 0x7e, 0x9a, 0xed, 0xf8, 0xf3, 0x69, 0xb6, 0xe3, 0xe6, 0x94, 0x6e,
-	0xb8, 0xe5, 0xea, 0x4a, 0x4e, 0xb5, 0x57, 0x79, 0x57, 0x01, 0x1e, 0x65, 0x9d, 0xf7, 0xea, 0x6e,
-	0xac, 0x69, 0xce, 0x4a, 0x37, 0xfb, 0x0a, 0x9c, 0xfa, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x77,
-	0x46, 0x98, 0xb4, 0x22, 0x00, 0x00,
+	// 1841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x6f, 0x14, 0x47,
+	0x16, 0x76, 0x31, 0xd8, 0x98, 0x67, 0x1b, 0x2f, 0x85, 0x17, 0xbc, 0x8d, 0x3d, 0x86, 0xf6, 0x1a,
+	0x7b, 0x01, 0xcf, 0x60, 0x03, 0x06, 0x6c, 0x83, 0x76, 0x6c, 0xd6, 0xc6, 0x5a, 0x03, 0xde, 0x91,
+	0x57, 0xbb, 0x62, 0xb5, 0x1a, 0x95, 0x7b, 0x7a, 0x7b, 0x5a, 0xb4, 0xbb, 0x87, 0xe9, 0x1e, 0x64,
+	0x63, 0xf9, 0xc2, 0x61, 0xcf, 0x91, 0x90, 0x92, 0x4b, 0xae, 0x51, 0x72, 0xc8, 0x21, 0x87, 0x28,
+	0x39, 0x44, 0x22, 0x8a, 0x92, 0x10, 0x94, 0x13, 0x52, 0xa4, 0x08, 0x25, 0x52, 0x14, 0x41, 0xfe,
+	0x90, 0xa8, 0xab, 0xab, 0x67, 0xaa, 0x7b, 0xba, 0x67, 0xca, 0xed, 0xe1, 0xc0, 0x89, 0xa9, 0xae,
+	0xfa, 0xde, 0xfb, 0xbe, 0x57, 0xaf, 0x7e, 0x3d, 0x0c, 0xc7, 0x95, 0x8a, 0x65, 0xdb, 0x4a, 0x89,
+	0xe8, 0x66, 0xf6, 0x41, 0x55, 0xad, 0x6c, 0x67, 0xca, 0x15, 0xcb, 0xb1, 0xf0, 0xf0, 0x23, 0xd5,
+	0x21, 0xf4, 0x73, 0x86, 0xfe, 0xb2, 0x2a, 0x6a, 0xa6, 0x3e, 0x54, 0x3a, 0xab, 0x58, 0xf6, 0xa6,
+	0x65, 0x67, 0x37, 0x88, 0xad, 0x7a, 0xb8, 0xec, 0xc3, 0xa9, 0x0d, 0xd5, 0x21, 0x53, 0xd9, 0x32,
+	0xd1, 0x74, 0x93, 0x38, 0xba, 0x65, 0x7a, 0xa6, 0xa4, 0x11, 0xce, 0x05, 0xfd, 0x59, 0xa0, 0xbf,
+	0x0b, 0xce, 0x16, 0x1b, 0x20, 0x71, 0x03, 0x34, 0x62, 0x17, 0xca, 0x15, 0x5d, 0x51, 0x59, 0xdf,
+	0x28, 0xd7, 0x47, 0x31, 0x85, 0x12, 0xb1, 0x4b, 0x05, 0xc7, 0x2a, 0x28, 0x4a, 0xcd, 0x40, 0xba,
+	0x61, 0x90, 0x53, 0x21, 0xca, 0x7d, 0xb5, 0xc2, 0xfa, 0x65, 0xae, 0xdf, 0x20, 0xb6, 0x53, 0xd8,
+	0x30, 0x2c, 0xe5, 0x7e, 0xa1, 0xa4, 0xea, 0x5a, 0xc9, 0x89, 0x60, 0x69, 0x55, 0x9d, 0x46, 0x23,
+	0x27, 0xb8, 0x01, 0x65, 0x52, 0x21, 0x9b, 0x36, 0xeb, 0x18, 0xd0, 0x2c, 0xcd, 0xa2, 0x3f, 0xb3,
+	0xee, 0x2f, 0xf6, 0x75, 0x48, 0xb3, 0x2c, 0xcd, 0x50, 0xb3, 0xa4, 0xac, 0x67, 0x89, 0x69, 0x5a,
+	0x0e, 0x0d, 0x09, 0xc3, 0xc8, 0x43, 0x20, 0xfd, 0xc3, 0x8d, 0xda, 0x3d, 0xd5, 0x21, 0x39, 0x45,
+	0xb1, 0xaa, 0xa6, 0xa3, 0x9b, 0x5a, 0x5e, 0x7d, 0x50, 0x55, 0x6d, 0x47, 0xbe, 0x0d, 0x27, 0x23,
+	0x7b, 0xed, 0xb2, 0x65, 0xda, 0x2a, 0xce, 0xc0, 0x31, 0xb2, 0x61, 0x55, 0x1c, 0xb5, 0x58, 0x70,
+	0xe7, 0xa6, 0x40, 0x36, 0xdd, 0x11, 0x83, 0xe8, 0x14, 0x9a, 0x38, 0x9c, 0x3f, 0xca, 0xba, 0x28,
+	0x96, 0x76, 0xc8, 0x03, 0x80, 0xa9, 0xb9, 0x35, 0xca, 0xda, 0x77, 0x72, 0x0f, 0x8e, 0x05, 0xbe,
+	0x32, 0xe3, 0x8b, 0xd0, 0xe5, 0xa9, 0xa3, 0xf6, 0x7a, 0xa6, 0xc7, 0x32, 0x4d, 0x33, 0x21, 0xe3,
+	0xc1, 0x17, 0x0e, 0x3e, 0xff, 0x65, 0xa4, 0x23, 0xcf, 0xa0, 0x35, 0x01, 0xcb, 0xaa, 0x73, 0xb7,
+	0xea, 0xac, 0x6f, 0xad, 0x7b, 0x91, 0x64, 0xae, 0xf1, 0x20, 0x1c, 0xa2, 0xe0, 0x95, 0x9b, 0xd4,
+	0x49, 0x2a, 0xef, 0x37, 0xf1, 0x00, 0x74, 0x9a, 0x96, 0xa9, 0xa8, 0x83, 0x07, 0x4e, 0xa1, 0x89,
+	0x83, 0x79, 0xaf, 0x21, 0x57, 0x61, 0x28, 0xda, 0x1c, 0xe3, 0xfc, 0x4f, 0xe8, 0xb5, 0xb8, 0xef,
+	0x8c, 0xf9, 0xb9, 0x16, 0xcc, 0x79, 0x53, 0x8c, 0x7f, 0xc0, 0x8c, 0xac, 0x32, 0x15, 0x39, 0xc3,
+	0x88, 0x52, 0xb1, 0x04, 0x50, 0xcf, 0x75, 0xe6, 0xf3, 0x4c, 0xc6, 0x5b, 0x18, 0x19, 0x77, 0x61,
+	0x64, 0xbc, 0x05, 0xc5, 0x16, 0x46, 0x66, 0x8d, 0x68, 0x2a, 0xc3, 0xe6, 0x39, 0xa4, 0xfc, 0x14,
+	0xc1, 0x50, 0xb4, 0x9f, 0x58, 0x79, 0xa9, 0x36, 0xc8, 0xc3, 0xcb, 0x01, 0xfe, 0x07, 0x28, 0xff,
+	0xf1, 0x96, 0xfc, 0x3d, 0x4e, 0x01, 0x01, 0x8f, 0x11, 0xc8, 0x51, 0x02, 0x16, 0xb6, 0x17, 0x5d,
+	0x26, 0x7e, 0xbc, 0x06, 0xa0, 0x93, 0x32, 0x63, 0x73, 0xee, 0x35, 0xf0, 0x52, 0x04, 0x8b, 0x24,
+	0x51, 0xfc, 0x16, 0xc1, 0x68, 0x53, 0x12, 0x6f, 0x49, 0x30, 0xef, 0x84, 0x72, 0xfd, 0x8e, 0xbb,
+	0x02, 0x96, 0x49, 0x39, 0xe9, 0xda, 0xd9, 0x86, 0xe1, 0x18, 0x7b, 0x2c, 0x20, 0xff, 0x86, 0x3e,
+	0x8b, 0xef, 0x60, 0x99, 0x7c, 0x5e, 0x24, 0x22, 0x3e, 0x86, 0x85, 0x24, 0x68, 0x48, 0xfe, 0x3f,
+	0x82, 0xd3, 0xfe, 0x94, 0xac, 0x98, 0x71, 0x69, 0xf1, 0x27, 0xe8, 0xf6, 0xce, 0x03, 0xbd, 0x18,
+	0x54, 0x54, 0x6c, 0x5b, 0x6e, 0x7c, 0xc5, 0x25, 0x68, 0x14, 0x11, 0x16, 0x89, 0x3c, 0xf4, 0xe8,
+	0x66, 0x38, 0x33, 0xce, 0xb6, 0x88, 0x03, 0x6f, 0xcf, 0x8b, 0x02, 0x6f, 0xa4, 0x7d, 0x79, 0xc1,
+	0x6d, 0x46, 0x9c, 0x4b, 0xbb, 0xdd, 0x9b, 0xd1, 0x17, 0xdc, 0x66, 0x14, 0xf4, 0xf3, 0x36, 0x04,
+	0x69, 0xae, 0x9e, 0xec, 0xae, 0xcb, 0x5b, 0xc4, 0x2e, 0xad, 0x5b, 0x8b, 0x8a, 0xb3, 0xe5, 0x87,
+	0x49, 0x82, 0x6e, 0x9d, 0x75, 0xb0, 0xf3, 0xb2, 0xd6, 0x96, 0x77, 0x21, 0x1d, 0x07, 0x66, 0xda,
+	0xff, 0x03, 0x47, 0xf4, 0x40, 0x0f, 0x0b, 0xf4, 0xa4, 0x80, 0xfc, 0x3a, 0x88, 0x45, 0x20, 0x64,
+	0x4a, 0x9e, 0x67, 0xee, 0x83, 0x83, 0x6f, 0x12, 0x87, 0x88, 0x90, 0x7f, 0x04, 0x23, 0xb1, 0x68,
+	0xc6, 0xfe, 0x5f, 0xd0, 0xb7, 0xe8, 0x72, 0xa2, 0x49, 0xbf, 0xbe, 0x65, 0x0b, 0x6e, 0x7d, 0x3c,
+	0xc6, 0x5f, 0xe7, 0x01, 0x3b, 0xb2, 0x06, 0xc3, 0x7c, 0xca, 0x34, 0x46, 0xbd, 0x5d, 0xc9, 0xf9,
+	0x0c, 0x41, 0x3a, 0xce, 0x53, 0x93, 0x29, 0x4a, 0xb5, 0x69, 0x8a, 0xda, 0x97, 0xa7, 0x59, 0x38,
+	0xe1, 0xa7, 0xda, 0x32, 0xb1, 0xd7, 0x2a, 0xba, 0xa2, 0x72, 0xa7, 0xa4, 0x6e, 0x16, 0xd5, 0x2d,
+	0x36, 0xc3, 0x5e, 0x43, 0x2e, 0xc0, 0x60, 0x23, 0xa0, 0x76, 0x63, 0xeb, 0xf6, 0xbf, 0xb1, 0xd8,
+	0x8e, 0xb7, 0x10, 0x5b, 0x33, 0x51, 0x03, 0xca, 0x84, 0x31, 0xca, 0x19, 0x46, 0x98, 0x51, 0xbb,
+	0x66, 0xef, 0x23, 0x04, 0x83, 0x8d, 0x3e, 0x22, 0x45, 0xa4, 0x12, 0x89, 0x68, 0xdf, 0xfc, 0xcc,
+	0xd4, 0xb7, 0x82, 0x55, 0x62, 0x3b, 0x0b, 0xee, 0x73, 0xe1, 0x16, 0x7d, 0x2d, 0x34, 0x9f, 0xa6,
+	0x1d, 0x18, 0x89, 0xc5, 0xd5, 0x8e, 0xdb, 0xfe, 0x50, 0x17, 0x0b, 0x69, 0xa6, 0x85, 0xde, 0xb0,
+	0xc1, 0xb0, 0x19, 0xb9, 0x54, 0x5f, 0x1c, 0x31, 0xa4, 0xdb, 0x35, 0x93, 0xdf, 0x20, 0x18, 0x89,
+	0x75, 0xd5, 0x4c, 0x67, 0xaa, 0x0d, 0x3a, 0xdb, 0x37, 0xcb, 0xe7, 0xd8, 0x0b, 0x68, 0x59, 0x75,
+	0xf8, 0xdd, 0x2a, 0x7a, 0x6a, 0x57, 0x41, 0xe2, 0x07, 0x2f, 0x6c, 0xd3, 0x6b, 0x4e, 0xd2, 0x5b,
+	0x99, 0x06, 0x03, 0x41, 0xd7, 0x2c, 0x6a, 0x77, 0xa1, 0x97, 0xdf, 0x5b, 0x05, 0x5f, 0x32, 0x3c,
+	0x24, 0x1f, 0x30, 0x20, 0xff, 0x97, 0x69, 0xcc, 0x19, 0xc6, 0x9b, 0xd8, 0x91, 0x3f, 0x41, 0x30,
+	0x10, 0xb4, 0x1f, 0x2b, 0x24, 0xb5, 0x2f, 0x21, 0xed, 0xbc, 0x60, 0x7b, 0x17, 0xa9, 0x55, 0xdd,
+	0xa6, 0xb1, 0x5f, 0x53, 0xcd, 0x62, 0xfd, 0xed, 0xdd, 0xec, 0x3a, 0x3a, 0x00, 0x9d, 0x86, 0xbe,
+	0xa9, 0x3b, 0xd4, 0x7b, 0x5f, 0xde, 0x6b, 0xc8, 0x4f, 0xfc, 0x1b, 0x53, 0x83, 0xc1, 0x37, 0x15,
+	0x0a, 0x19, 0x7a, 0x1d, 0xcb, 0x21, 0x06, 0x73, 0xc4, 0x32, 0x2b, 0xf0, 0xad, 0x56, 0x60, 0x70,
+	0x17, 0x8f, 0x5b, 0x0a, 0x08, 0x6c, 0x04, 0xf2, 0x65, 0x38, 0x19, 0xd9, 0xcb, 0x18, 0x1f, 0x87,
+	0x2e, 0x6e, 0x6b, 0x4a, 0xe5, 0x59, 0x4b, 0x5e, 0x67, 0x4a, 0x17, 0x2d, 0xf3, 0xa1, 0x5a, 0x71,
+	0x4f, 0xa2, 0x75, 0xcb, 0x85, 0x37, 0xac, 0x82, 0x86, 0xd0, 0x49, 0xd0, 0xad, 0x11, 0x7b, 0xb5,
+	0x16, 0xbd, 0xc3, 0xf9, 0x5a, 0x5b, 0xfe, 0x00, 0xc1, 0x70, 0x8c, 0x59, 0xc6, 0xe7, 0x3c, 0x1c,
+	0xb5, 0xaa, 0xce, 0x86, 0x55, 0x35, 0x8b, 0xcb, 0xc4, 0x5e, 0x31, 0xdd, 0x4e, 0xbf, 0xdc, 0xd1,
+	0xd0, 0xe1, 0x8e, 0xa6, 0x45, 0x16, 0xc5, 0x32, 0x96, 0x54, 0x95, 0x8d, 0xf6, 0x9c, 0x36, 0x76,
+	0xe0, 0x09, 0xe8, 0x77, 0xff, 0xe5, 0xf7, 0xa9, 0x14, 0x8d, 0x67, 0xf8, 0xb3, 0x3c, 0x0e, 0x63,
+	0x94, 0xe6, 0x6d, 0xd5, 0xb6, 0x89, 0xa6, 0xae, 0x11, 0xdb, 0xd6, 0x4d, 0x6d, 0xad, 0x6e, 0xd1,
+	0x8f, 0xee, 0x12, 0x9c, 0x69, 0x35, 0x90, 0x09, 0x1b, 0x82, 0xc3, 0xff, 0x53, 0xd5, 0x80, 0xa0,
+	0xfa, 0x87, 0xe9, 0x97, 0xa7, 0xa0, 0x93, 0x1a, 0xc2, 0xef, 0x22, 0xe8, 0xf2, 0x0a, 0x2d, 0x78,
+	0xaa, 0x45, 0xde, 0x34, 0x56, 0x7a, 0xa4, 0xe9, 0xbd, 0x40, 0x3c, 0x66, 0xf2, 0xd8, 0xe3, 0x1f,
+	0x7e, 0x7b, 0x72, 0x60, 0x04, 0x0f, 0x67, 0x5d, 0xc4, 0x24, 0x57, 0xbd, 0xe3, 0x2b, 0x60, 0xf8,
+	0x19, 0x82, 0x5e, 0xfe, 0x6d, 0x8c, 0x67, 0x45, 0x7c, 0x45, 0x97, 0x85, 0xa4, 0xb9, 0x44, 0x58,
+	0x46, 0xf8, 0x3a, 0x25, 0x7c, 0x05, 0x5f, 0x8e, 0x21, 0xcc, 0xbf, 0xd6, 0xb3, 0x3b, 0x6c, 0x77,
+	0xde, 0xcd, 0xee, 0xd0, 0xfd, 0x78, 0x17, 0x7f, 0x8e, 0xa0, 0x9f, 0xb7, 0x9b, 0x33, 0x0c, 0x31,
+	0x2d, 0xd1, 0xc5, 0x21, 0x69, 0x2e, 0x11, 0x96, 0x69, 0x39, 0x47, 0xb5, 0x8c, 0xe1, 0x51, 0x01,
+	0x2d, 0xf8, 0x67, 0x04, 0xc7, 0x43, 0xcc, 0xd9, 0xc3, 0x16, 0xe7, 0x12, 0x90, 0x08, 0xbe, 0xce,
+	0xa5, 0x85, 0xfd, 0x98, 0x60, 0x72, 0x66, 0xa9, 0x9c, 0x4b, 0x78, 0x5a, 0x40, 0x0e, 0xc3, 0xb2,
+	0x19, 0xda, 0xc5, 0xdf, 0x23, 0xe8, 0x0b, 0x94, 0x1a, 0xf0, 0x9e, 0xb2, 0x24, 0x54, 0x3d, 0x91,
+	0xe6, 0x93, 0x81, 0x99, 0x90, 0x1b, 0x54, 0xc8, 0x55, 0x3c, 0xd3, 0x4c, 0x88, 0x8f, 0x8a, 0x48,
+	0xb2, 0x9f, 0x10, 0xfc, 0x71, 0xc5, 0x8c, 0x9a, 0xa9, 0xbf, 0x0a, 0x86, 0x39, 0xb6, 0x8c, 0x22,
+	0xe5, 0xf6, 0x61, 0x81, 0xc9, 0x9b, 0xa7, 0xf2, 0x66, 0xf0, 0xa5, 0x18, 0x79, 0xba, 0x19, 0x33,
+	0x4d, 0x05, 0xbd, 0xb8, 0x8b, 0x3f, 0x43, 0x70, 0x64, 0xc5, 0x4c, 0xb4, 0x80, 0x22, 0x0a, 0x1a,
+	0xd2, 0x5c, 0x22, 0xac, 0xe0, 0x02, 0xe2, 0x94, 0xd8, 0xf8, 0x3b, 0x46, 0x9c, 0x7b, 0xe8, 0x89,
+	0xa6, 0x49, 0xe4, 0x73, 0x57, 0xba, 0x9e, 0x10, 0xcd, 0xc8, 0x5f, 0xa5, 0xe4, 0xa7, 0xf1, 0x85,
+	0x26, 0xe4, 0xeb, 0xb0, 0xec, 0x8e, 0xdf, 0xde, 0xc5, 0x3f, 0x22, 0xc0, 0x8d, 0x05, 0x00, 0x2c,
+	0xc4, 0x27, 0xb6, 0xec, 0x20, 0xdd, 0x48, 0x0a, 0x67, 0x7a, 0x72, 0x54, 0xcf, 0x1c, 0xbe, 0x16,
+	0xab, 0x27, 0xfc, 0xdf, 0x3a, 0x85, 0x22, 0x71, 0x08, 0x2f, 0xec, 0x4b, 0x04, 0x47, 0x83, 0x1e,
+	0xdc, 0xf4, 0x9a, 0xdf, 0x43, 0x8a, 0x24, 0x9c, 0xa5, 0xd8, 0x42, 0x83, 0x3c, 0x49, 0x55, 0x8d,
+	0xe3, 0x31, 0xa1, 0x59, 0xc2, 0x1f, 0xa3, 0xfa, 0x03, 0x17, 0xcf, 0x08, 0x26, 0x48, 0xe8, 0x25,
+	0x2e, 0x5d, 0xd9, 0x33, 0x8e, 0x91, 0xcd, 0x52, 0xb2, 0x7f, 0xc1, 0xe3, 0x31, 0x64, 0x35, 0x06,
+	0x70, 0x63, 0x5e, 0x54, 0xb7, 0x76, 0xf1, 0x87, 0x08, 0x7a, 0x7c, 0x2b, 0x6e, 0xa8, 0x67, 0x04,
+	0x83, 0x95, 0x88, 0x71, 0x44, 0x3d, 0x40, 0x1e, 0xa7, 0x8c, 0x4f, 0xe3, 0x91, 0x16, 0x8c, 0xf1,
+	0x53, 0x04, 0x7f, 0x08, 0x5f, 0x1c, 0xc5, 0xce, 0x88, 0x98, 0x5b, 0xac, 0x34, 0x9f, 0x0c, 0x2c,
+	0x18, 0x6a, 0x25, 0xcc, 0xf5, 0x19, 0x82, 0x1e, 0xee, 0x6e, 0x88, 0x6f, 0x8a, 0xb8, 0x6f, 0x75,
+	0x07, 0x95, 0xfe, 0xb6, 0x4f, 0x2b, 0x4c, 0xcd, 0x59, 0xaa, 0xe6, 0xcf, 0x58, 0x8e, 0xbb, 0x06,
+	0x72, 0xc4, 0x9f, 0xa3, 0x86, 0x27, 0x3f, 0x16, 0xdd, 0x0a, 0xa3, 0x0b, 0x16, 0xd2, 0x8d, 0xa4,
+	0x70, 0x46, 0x7f, 0x86, 0xd2, 0xbf, 0x80, 0x33, 0x31, 0xf4, 0x8d, 0x20, 0xae, 0x96, 0xfe, 0x5f,
+	0x23, 0xc0, 0x21, 0x9b, 0xee, 0x2a, 0x10, 0xdd, 0x32, 0xf6, 0xa3, 0x26, 0xbe, 0xa4, 0x22, 0x67,
+	0xa8, 0x9a, 0x09, 0x7c, 0x46, 0x4c, 0x0d, 0x7e, 0x1f, 0xc1, 0x41, 0xba, 0xf9, 0x4c, 0x0b, 0x86,
+	0x91, 0xdf, 0x1e, 0x2f, 0xee, 0x09, 0x23, 0x78, 0xee, 0x2a, 0xec, 0xc0, 0xa2, 0x41, 0xfe, 0x14,
+	0x41, 0x0f, 0x57, 0x4a, 0xc1, 0xd7, 0xf6, 0xe0, 0x31, 0x58, 0x7e, 0x49, 0x46, 0xf6, 0x32, 0x25,
+	0x9b, 0xc5, 0x93, 0x4d, 0xc9, 0x36, 0x5c, 0xe2, 0xde, 0x43, 0x70, 0xc8, 0x3f, 0x81, 0xa6, 0x05,
+	0x67, 0x74, 0xcf, 0x81, 0x0d, 0x95, 0x53, 0xe4, 0x51, 0xca, 0x75, 0x18, 0x9f, 0x6c, 0xc2, 0xd5,
+	0xbd, 0x81, 0xf5, 0xbb, 0x28, 0xb7, 0x10, 0xc1, 0xea, 0x00, 0x62, 0x57, 0xb0, 0xe8, 0x52, 0x88,
+	0x34, 0x97, 0x08, 0x2b, 0xb8, 0x73, 0x28, 0x75, 0x0c, 0xbd, 0x3a, 0x06, 0xff, 0xd6, 0x41, 0x2c,
+	0x19, 0x22, 0xff, 0x7a, 0x42, 0x9a, 0x4d, 0x02, 0x15, 0x3c, 0xd5, 0x1f, 0x05, 0x59, 0xba, 0xc4,
+	0x83, 0x35, 0x14, 0x31, 0xe2, 0x91, 0x55, 0x19, 0x69, 0x36, 0x09, 0x54, 0x90, 0xb8, 0x11, 0x80,
+	0x2d, 0xfc, 0xfd, 0xf9, 0xab, 0x34, 0x7a, 0xf1, 0x2a, 0x8d, 0x7e, 0x7d, 0x95, 0x46, 0xef, 0xbc,
+	0x4e, 0x77, 0xbc, 0x78, 0x9d, 0xee, 0x78, 0xf9, 0x3a, 0xdd, 0x71, 0x6f, 0x4a, 0xd3, 0x9d, 0x52,
+	0x75, 0x23, 0xa3, 0x58, 0x9b, 0xbc, 0x29, 0x9f, 0x4f, 0x76, 0x8b, 0xb7, 0xea, 0x6c, 0x97, 0x55,
+	0x7b, 0xa3, 0x8b, 0x9e, 0x02, 0x17, 0x7f, 0x1f, 0x00, 0xd4, 0x4b, 0xe9, 0x69, 0x4c, 0x24, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2125,6 +2227,8 @@ type QueryClient interface {
 	// Queries a list of OutTxTracker items.
 	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
 	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
+	// Queries the nonce gap reports of an outbound nonce of a chain.
+	OutTxNonceGap(ctx context.Context, in *QueryGetOutTxNonceGapRequest, opts ...grpc.CallOption) (*QueryGetOutTxNonceGapResponse, error)
 	InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error)
 	InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error)
 	// Queries a InTxHashToCctx by index.
@@ -2200,6 +2304,15 @@ func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOu
 	return out, nil
 }
 
+func (c *queryClient) OutTxNonceGap(ctx context.Context, in *QueryGetOutTxNonceGapRequest, opts ...grpc.CallOption) (*QueryGetOutTxNonceGapResponse, error) {
+	out := new(QueryGetOutTxNonceGapResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxNonceGap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error) {
 	out := new(QueryAllInTxTrackerByChainResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain", in, out, opts...)
@@ -2362,6 +2475,8 @@ type QueryServer interface {
 	// Queries a list of OutTxTracker items.
 	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
 	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
+	// Queries the nonce gap reports of an outbound nonce of a chain.
+	OutTxNonceGap(context.Context, *QueryGetOutTxNonceGapRequest) (*QueryGetOutTxNonceGapResponse, error)
 	InTxTrackerAllByChain(context.Context, *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error)
 	InTxTrackerAll(context.Context, *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error)
 	// Queries a InTxHashToCctx by index.
@@ -2409,6 +2524,9 @@ func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
 }
+func (*UnimplementedQueryServer) OutTxNonceGap(ctx context.Context, req *QueryGetOutTxNonceGapRequest) (*QueryGetOutTxNonceGapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxNonceGap not implemented")
+}
 func (*UnimplementedQueryServer) InTxTrackerAllByChain(ctx context.Context, req *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAllByChain not implemented")
 }
@@ -2537,6 +2655,24 @@ func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OutTxNonceGap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutTxNonceGapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxNonceGap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxNonceGap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxNonceGap(ctx, req.(*QueryGetOutTxNonceGapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllInTxTrackerByChainRequest)
 	if err := dec(in); err != nil {
@@ -2863,6 +2999,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OutTxTrackerAllByChain",
 			Handler:    _Query_OutTxTrackerAllByChain_Handler,
 		},
+		{
+			MethodName: "OutTxNonceGap",
+			Handler:    _Query_OutTxNonceGap_Handler,
+		},
 		{
 			MethodName: "InTxTrackerAllByChain",
 			Handler:    _Query_InTxTrackerAllByChain_Handler,
@@ -3284,6 +3424,72 @@ func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetOutTxNonceGapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutTxNonceGapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutTxNonceGapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutTxNonceGapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutTxNonceGapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutTxNonceGapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OutTxNonceGap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAllInTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4553,6 +4759,32 @@ func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetOutTxNonceGapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetOutTxNonceGapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTxNonceGap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryAllInTxTrackerByChainRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5878,6 +6110,177 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetOutTxNonceGapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxNonceGapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxNonceGapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			m.ChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxNonceGapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxNonceGapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxNonceGapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxNonceGap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxNonceGap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
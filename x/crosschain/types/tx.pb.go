@@ -440,6 +440,152 @@ func (m *MsgAddToInTxTrackerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToInTxTrackerResponse proto.InternalMessageInfo
 
+type MsgAddProvenInboundTx struct {
+	Creator   string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId   int64         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash    string        `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Proof     *common.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	BlockHash string        `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxIndex   int64         `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// proof of the receipt of the tx against the block header, only for the EVM chains
+	ReceiptProof *common.Proof `protobuf:"bytes,7,opt,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+	// index in the block of the first log of the receipt, only for the EVM chains
+	FirstLogIndex uint64 `protobuf:"varint,8,opt,name=first_log_index,json=firstLogIndex,proto3" json:"first_log_index,omitempty"`
+}
+
+func (m *MsgAddProvenInboundTx) Reset()         { *m = MsgAddProvenInboundTx{} }
+func (m *MsgAddProvenInboundTx) String() string { return proto.CompactTextString(m) }
+func (*MsgAddProvenInboundTx) ProtoMessage()    {}
+func (*MsgAddProvenInboundTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{8}
+}
+func (m *MsgAddProvenInboundTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddProvenInboundTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddProvenInboundTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddProvenInboundTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddProvenInboundTx.Merge(m, src)
+}
+func (m *MsgAddProvenInboundTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddProvenInboundTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddProvenInboundTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddProvenInboundTx proto.InternalMessageInfo
+
+func (m *MsgAddProvenInboundTx) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddProvenInboundTx) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgAddProvenInboundTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgAddProvenInboundTx) GetProof() *common.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgAddProvenInboundTx) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *MsgAddProvenInboundTx) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgAddProvenInboundTx) GetReceiptProof() *common.Proof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+func (m *MsgAddProvenInboundTx) GetFirstLogIndex() uint64 {
+	if m != nil {
+		return m.FirstLogIndex
+	}
+	return 0
+}
+
+type MsgAddProvenInboundTxResponse struct {
+	CctxIndexes []string `protobuf:"bytes,1,rep,name=cctx_indexes,json=cctxIndexes,proto3" json:"cctx_indexes,omitempty"`
+}
+
+func (m *MsgAddProvenInboundTxResponse) Reset()         { *m = MsgAddProvenInboundTxResponse{} }
+func (m *MsgAddProvenInboundTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddProvenInboundTxResponse) ProtoMessage()    {}
+func (*MsgAddProvenInboundTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{9}
+}
+func (m *MsgAddProvenInboundTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddProvenInboundTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddProvenInboundTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddProvenInboundTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddProvenInboundTxResponse.Merge(m, src)
+}
+func (m *MsgAddProvenInboundTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddProvenInboundTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddProvenInboundTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddProvenInboundTxResponse proto.InternalMessageInfo
+
+func (m *MsgAddProvenInboundTxResponse) GetCctxIndexes() []string {
+	if m != nil {
+		return m.CctxIndexes
+	}
+	return nil
+}
+
 type MsgWhitelistERC20 struct {
 	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
@@ -454,7 +600,7 @@ func (m *MsgWhitelistERC20) Reset()         { *m = MsgWhitelistERC20{} }
 func (m *MsgWhitelistERC20) String() string { return proto.CompactTextString(m) }
 func (*MsgWhitelistERC20) ProtoMessage()    {}
 func (*MsgWhitelistERC20) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{8}
+	return fileDescriptor_81d6d611190b7635, []int{10}
 }
 func (m *MsgWhitelistERC20) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,7 +687,7 @@ func (m *MsgWhitelistERC20Response) Reset()         { *m = MsgWhitelistERC20Resp
 func (m *MsgWhitelistERC20Response) String() string { return proto.CompactTextString(m) }
 func (*MsgWhitelistERC20Response) ProtoMessage()    {}
 func (*MsgWhitelistERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{9}
+	return fileDescriptor_81d6d611190b7635, []int{11}
 }
 func (m *MsgWhitelistERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +744,7 @@ func (m *MsgAddToOutTxTracker) Reset()         { *m = MsgAddToOutTxTracker{} }
 func (m *MsgAddToOutTxTracker) String() string { return proto.CompactTextString(m) }
 func (*MsgAddToOutTxTracker) ProtoMessage()    {}
 func (*MsgAddToOutTxTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{10}
+	return fileDescriptor_81d6d611190b7635, []int{12}
 }
 func (m *MsgAddToOutTxTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +830,7 @@ func (m *MsgAddToOutTxTrackerResponse) Reset()         { *m = MsgAddToOutTxTrack
 func (m *MsgAddToOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddToOutTxTrackerResponse) ProtoMessage()    {}
 func (*MsgAddToOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{11}
+	return fileDescriptor_81d6d611190b7635, []int{13}
 }
 func (m *MsgAddToOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +876,7 @@ func (m *MsgRemoveFromOutTxTracker) Reset()         { *m = MsgRemoveFromOutTxTra
 func (m *MsgRemoveFromOutTxTracker) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveFromOutTxTracker) ProtoMessage()    {}
 func (*MsgRemoveFromOutTxTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{12}
+	return fileDescriptor_81d6d611190b7635, []int{14}
 }
 func (m *MsgRemoveFromOutTxTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +933,7 @@ func (m *MsgRemoveFromOutTxTrackerResponse) Reset()         { *m = MsgRemoveFrom
 func (m *MsgRemoveFromOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveFromOutTxTrackerResponse) ProtoMessage()    {}
 func (*MsgRemoveFromOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{13}
+	return fileDescriptor_81d6d611190b7635, []int{15}
 }
 func (m *MsgRemoveFromOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,19 +962,148 @@ func (m *MsgRemoveFromOutTxTrackerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveFromOutTxTrackerResponse proto.InternalMessageInfo
 
+type MsgReportOutTxNonceGap struct {
+	Creator      string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId      int64               `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce        uint64              `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TssNonce     uint64              `protobuf:"varint,4,opt,name=tss_nonce,json=tssNonce,proto3" json:"tss_nonce,omitempty"`
+	Cause        OutTxNonceGapCause  `protobuf:"varint,5,opt,name=cause,proto3,enum=zetachain.zetacore.crosschain.OutTxNonceGapCause" json:"cause,omitempty"`
+	Action       OutTxNonceGapAction `protobuf:"varint,6,opt,name=action,proto3,enum=zetachain.zetacore.crosschain.OutTxNonceGapAction" json:"action,omitempty"`
+	CancelTxHash string              `protobuf:"bytes,7,opt,name=cancel_tx_hash,json=cancelTxHash,proto3" json:"cancel_tx_hash,omitempty"`
+}
+
+func (m *MsgReportOutTxNonceGap) Reset()         { *m = MsgReportOutTxNonceGap{} }
+func (m *MsgReportOutTxNonceGap) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOutTxNonceGap) ProtoMessage()    {}
+func (*MsgReportOutTxNonceGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{16}
+}
+func (m *MsgReportOutTxNonceGap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportOutTxNonceGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOutTxNonceGap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportOutTxNonceGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOutTxNonceGap.Merge(m, src)
+}
+func (m *MsgReportOutTxNonceGap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportOutTxNonceGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOutTxNonceGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportOutTxNonceGap proto.InternalMessageInfo
+
+func (m *MsgReportOutTxNonceGap) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReportOutTxNonceGap) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgReportOutTxNonceGap) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgReportOutTxNonceGap) GetTssNonce() uint64 {
+	if m != nil {
+		return m.TssNonce
+	}
+	return 0
+}
+
+func (m *MsgReportOutTxNonceGap) GetCause() OutTxNonceGapCause {
+	if m != nil {
+		return m.Cause
+	}
+	return OutTxNonceGapCause_NotBroadcast
+}
+
+func (m *MsgReportOutTxNonceGap) GetAction() OutTxNonceGapAction {
+	if m != nil {
+		return m.Action
+	}
+	return OutTxNonceGapAction_Reprioritized
+}
+
+func (m *MsgReportOutTxNonceGap) GetCancelTxHash() string {
+	if m != nil {
+		return m.CancelTxHash
+	}
+	return ""
+}
+
+type MsgReportOutTxNonceGapResponse struct {
+}
+
+func (m *MsgReportOutTxNonceGapResponse) Reset()         { *m = MsgReportOutTxNonceGapResponse{} }
+func (m *MsgReportOutTxNonceGapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOutTxNonceGapResponse) ProtoMessage()    {}
+func (*MsgReportOutTxNonceGapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{17}
+}
+func (m *MsgReportOutTxNonceGapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportOutTxNonceGapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOutTxNonceGapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportOutTxNonceGapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOutTxNonceGapResponse.Merge(m, src)
+}
+func (m *MsgReportOutTxNonceGapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportOutTxNonceGapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOutTxNonceGapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportOutTxNonceGapResponse proto.InternalMessageInfo
+
 type MsgGasPriceVoter struct {
 	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChainId     int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Price       uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	Supply      string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	PriorityFee uint64 `protobuf:"varint,6,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
 }
 
 func (m *MsgGasPriceVoter) Reset()         { *m = MsgGasPriceVoter{} }
 func (m *MsgGasPriceVoter) String() string { return proto.CompactTextString(m) }
 func (*MsgGasPriceVoter) ProtoMessage()    {}
 func (*MsgGasPriceVoter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{14}
+	return fileDescriptor_81d6d611190b7635, []int{18}
 }
 func (m *MsgGasPriceVoter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,6 +1167,13 @@ func (m *MsgGasPriceVoter) GetSupply() string {
 	return ""
 }
 
+func (m *MsgGasPriceVoter) GetPriorityFee() uint64 {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return 0
+}
+
 type MsgGasPriceVoterResponse struct {
 }
 
@@ -899,7 +1181,7 @@ func (m *MsgGasPriceVoterResponse) Reset()         { *m = MsgGasPriceVoterRespon
 func (m *MsgGasPriceVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGasPriceVoterResponse) ProtoMessage()    {}
 func (*MsgGasPriceVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{15}
+	return fileDescriptor_81d6d611190b7635, []int{19}
 }
 func (m *MsgGasPriceVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1229,7 @@ func (m *MsgVoteOnObservedOutboundTx) Reset()         { *m = MsgVoteOnObservedOu
 func (m *MsgVoteOnObservedOutboundTx) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnObservedOutboundTx) ProtoMessage()    {}
 func (*MsgVoteOnObservedOutboundTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{16}
+	return fileDescriptor_81d6d611190b7635, []int{20}
 }
 func (m *MsgVoteOnObservedOutboundTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1335,7 @@ func (m *MsgVoteOnObservedOutboundTxResponse) Reset()         { *m = MsgVoteOnOb
 func (m *MsgVoteOnObservedOutboundTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnObservedOutboundTxResponse) ProtoMessage()    {}
 func (*MsgVoteOnObservedOutboundTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{17}
+	return fileDescriptor_81d6d611190b7635, []int{21}
 }
 func (m *MsgVoteOnObservedOutboundTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,9 +1370,9 @@ type MsgVoteOnObservedInboundTx struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message       string          `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InTxHash      string          `protobuf:"bytes,9,opt,name=in_tx_hash,json=inTxHash,proto3" json:"in_tx_hash,omitempty"`
 	InBlockHeight uint64          `protobuf:"varint,10,opt,name=in_block_height,json=inBlockHeight,proto3" json:"in_block_height,omitempty"`
@@ -1106,7 +1388,7 @@ func (m *MsgVoteOnObservedInboundTx) Reset()         { *m = MsgVoteOnObservedInb
 func (m *MsgVoteOnObservedInboundTx) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnObservedInboundTx) ProtoMessage()    {}
 func (*MsgVoteOnObservedInboundTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{18}
+	return fileDescriptor_81d6d611190b7635, []int{22}
 }
 func (m *MsgVoteOnObservedInboundTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1515,7 @@ func (m *MsgVoteOnObservedInboundTxResponse) Reset()         { *m = MsgVoteOnObs
 func (m *MsgVoteOnObservedInboundTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteOnObservedInboundTxResponse) ProtoMessage()    {}
 func (*MsgVoteOnObservedInboundTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81d6d611190b7635, []int{19}
+	return fileDescriptor_81d6d611190b7635, []int{23}
 }
 func (m *MsgVoteOnObservedInboundTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,12 +1553,16 @@ func init() {
 	proto.RegisterType((*MsgUpdateTssAddressResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateTssAddressResponse")
 	proto.RegisterType((*MsgAddToInTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTracker")
 	proto.RegisterType((*MsgAddToInTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToInTxTrackerResponse")
+	proto.RegisterType((*MsgAddProvenInboundTx)(nil), "zetachain.zetacore.crosschain.MsgAddProvenInboundTx")
+	proto.RegisterType((*MsgAddProvenInboundTxResponse)(nil), "zetachain.zetacore.crosschain.MsgAddProvenInboundTxResponse")
 	proto.RegisterType((*MsgWhitelistERC20)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20")
 	proto.RegisterType((*MsgWhitelistERC20Response)(nil), "zetachain.zetacore.crosschain.MsgWhitelistERC20Response")
 	proto.RegisterType((*MsgAddToOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTracker")
 	proto.RegisterType((*MsgAddToOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgAddToOutTxTrackerResponse")
 	proto.RegisterType((*MsgRemoveFromOutTxTracker)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTracker")
 	proto.RegisterType((*MsgRemoveFromOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.MsgRemoveFromOutTxTrackerResponse")
+	proto.RegisterType((*MsgReportOutTxNonceGap)(nil), "zetachain.zetacore.crosschain.MsgReportOutTxNonceGap")
+	proto.RegisterType((*MsgReportOutTxNonceGapResponse)(nil), "zetachain.zetacore.crosschain.MsgReportOutTxNonceGapResponse")
 	proto.RegisterType((*MsgGasPriceVoter)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoter")
 	proto.RegisterType((*MsgGasPriceVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgGasPriceVoterResponse")
 	proto.RegisterType((*MsgVoteOnObservedOutboundTx)(nil), "zetachain.zetacore.crosschain.MsgVoteOnObservedOutboundTx")
@@ -1288,95 +1574,112 @@ func init() {
 func init() { proto.RegisterFile("crosschain/tx.proto", fileDescriptor_81d6d611190b7635) }
 
 var fileDescriptor_81d6d611190b7635 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x6d, 0x4f, 0x1b, 0xc7,
-	0x13, 0xe7, 0xfe, 0x80, 0xb1, 0x07, 0x0c, 0xe4, 0x20, 0x89, 0x73, 0x04, 0x43, 0x8e, 0x7f, 0x52,
-	0x54, 0x09, 0x3b, 0x21, 0xaa, 0x9a, 0xa4, 0xad, 0xd4, 0x80, 0x12, 0x42, 0x5b, 0x42, 0x74, 0x71,
-	0x5a, 0x29, 0x6f, 0x4e, 0xe7, 0xbb, 0xe5, 0x7c, 0xc2, 0xb7, 0x6b, 0xdd, 0xae, 0x91, 0x8d, 0x2a,
-	0x55, 0x8a, 0xd4, 0xf7, 0x55, 0x55, 0xa9, 0x55, 0xbf, 0x40, 0xbf, 0x4a, 0x5e, 0x46, 0x7d, 0xd5,
-	0x54, 0x6a, 0x54, 0x85, 0x4f, 0xd0, 0x7e, 0x82, 0x6a, 0x1f, 0xee, 0xf0, 0x19, 0xfc, 0x00, 0x51,
-	0x5e, 0xdd, 0xce, 0xec, 0xce, 0xec, 0xcc, 0x6f, 0x66, 0x76, 0xf6, 0x16, 0xe6, 0xdc, 0x88, 0x50,
-	0xea, 0xd6, 0x9c, 0x00, 0x97, 0x59, 0xab, 0xd4, 0x88, 0x08, 0x23, 0xfa, 0xe2, 0x21, 0x62, 0x8e,
-	0xe0, 0x95, 0xc4, 0x88, 0x44, 0xa8, 0x74, 0xbc, 0xce, 0x98, 0x73, 0x49, 0x18, 0x12, 0x5c, 0x96,
-	0x1f, 0x29, 0x63, 0xcc, 0xfb, 0xc4, 0x27, 0x62, 0x58, 0xe6, 0x23, 0xc9, 0x35, 0x7f, 0xd3, 0xe0,
-	0xc2, 0x0e, 0xf5, 0x37, 0x23, 0xe4, 0x30, 0x54, 0x79, 0xfa, 0xf4, 0x6b, 0xc2, 0x50, 0xa4, 0x17,
-	0x60, 0xc2, 0xe5, 0x1c, 0x12, 0x15, 0xb4, 0x65, 0x6d, 0x35, 0x67, 0xc5, 0xa4, 0xbe, 0x08, 0xc0,
-	0x28, 0xb5, 0x1b, 0xcd, 0xea, 0x3e, 0x6a, 0x17, 0xfe, 0x27, 0x26, 0x73, 0x8c, 0xd2, 0x27, 0x82,
-	0xa1, 0x7f, 0x08, 0xb3, 0xfb, 0xa8, 0xbd, 0x85, 0xf0, 0x73, 0xc4, 0x9c, 0x47, 0x28, 0xf0, 0x6b,
-	0xac, 0x30, 0xba, 0xac, 0xad, 0x8e, 0x5a, 0x27, 0xf8, 0xfa, 0x1a, 0x64, 0x28, 0x73, 0x58, 0x93,
-	0x16, 0xc6, 0x96, 0xb5, 0xd5, 0xe9, 0xf5, 0x8b, 0x25, 0x65, 0xaf, 0x85, 0x5c, 0x14, 0x1c, 0xa0,
-	0xa7, 0x62, 0xd2, 0x52, 0x8b, 0xcc, 0x05, 0xb8, 0x72, 0xc2, 0x50, 0x0b, 0xd1, 0x06, 0xc1, 0x14,
-	0x99, 0x3f, 0x6a, 0xa0, 0xef, 0x50, 0x7f, 0x27, 0xf0, 0x23, 0x3e, 0x4d, 0xe9, 0xc3, 0x26, 0xf6,
-	0x68, 0x1f, 0x3f, 0xae, 0x40, 0x56, 0x60, 0x65, 0x07, 0x9e, 0xf0, 0x62, 0xd4, 0x9a, 0x10, 0xf4,
-	0xb6, 0xa7, 0x6f, 0x41, 0xc6, 0x09, 0x49, 0x13, 0x4b, 0xcb, 0x73, 0x1b, 0xe5, 0x97, 0x6f, 0x96,
-	0x46, 0xfe, 0x7c, 0xb3, 0xf4, 0x81, 0x1f, 0xb0, 0x5a, 0xb3, 0xca, 0xad, 0x2c, 0xbb, 0x84, 0x86,
-	0x84, 0xaa, 0xcf, 0x1a, 0xf5, 0xf6, 0xcb, 0xac, 0xdd, 0x40, 0xb4, 0xf4, 0x2c, 0xc0, 0xcc, 0x52,
-	0xe2, 0xe6, 0x55, 0x30, 0x4e, 0xda, 0x94, 0x98, 0xfc, 0x18, 0xe6, 0x76, 0xa8, 0xff, 0xac, 0xe1,
-	0xc9, 0xc9, 0xfb, 0x9e, 0x17, 0x21, 0x4a, 0xcf, 0x0d, 0xbd, 0xb9, 0x08, 0x0b, 0xa7, 0xe8, 0x4b,
-	0xb6, 0xfb, 0x47, 0x13, 0xfb, 0xdd, 0xf7, 0xbc, 0x0a, 0xd9, 0xc6, 0x95, 0x56, 0x25, 0x72, 0xdc,
-	0xfd, 0xbe, 0xa1, 0xee, 0x03, 0xd1, 0x65, 0x98, 0x60, 0x2d, 0xbb, 0xe6, 0xd0, 0x9a, 0xc4, 0xc8,
-	0xca, 0xb0, 0xd6, 0x23, 0x87, 0xd6, 0xf4, 0x35, 0xc8, 0xb9, 0x24, 0xc0, 0x36, 0x47, 0x43, 0x85,
-	0x75, 0x36, 0x0e, 0xeb, 0x26, 0x09, 0x70, 0xa5, 0xdd, 0x40, 0x56, 0xd6, 0x55, 0x23, 0x7d, 0x05,
-	0xc6, 0x1b, 0x11, 0x21, 0x7b, 0x85, 0xf1, 0x65, 0x6d, 0x75, 0x72, 0x3d, 0x1f, 0x2f, 0x7d, 0xc2,
-	0x99, 0x96, 0x9c, 0xe3, 0x7e, 0x57, 0xeb, 0xc4, 0xdd, 0x97, 0xfb, 0x65, 0xa4, 0xdf, 0x82, 0x23,
-	0xb6, 0xbc, 0x02, 0x59, 0xd6, 0xb2, 0x03, 0xec, 0xa1, 0x56, 0x61, 0x42, 0x9a, 0xc9, 0x5a, 0xdb,
-	0x9c, 0x54, 0x90, 0x74, 0xbb, 0x9c, 0x40, 0xf2, 0xbb, 0xcc, 0xfd, 0x6f, 0x6a, 0x01, 0x43, 0xf5,
-	0x80, 0xb2, 0x07, 0xd6, 0xe6, 0xfa, 0xcd, 0x3e, 0x80, 0xac, 0x40, 0x1e, 0x45, 0xee, 0xfa, 0x4d,
-	0xdb, 0x91, 0xd8, 0xaa, 0x18, 0x4c, 0x09, 0x66, 0x1c, 0xbf, 0x4e, 0xd4, 0x46, 0xd3, 0xa8, 0xe9,
-	0x30, 0x86, 0x9d, 0x50, 0xe2, 0x92, 0xb3, 0xc4, 0x58, 0xbf, 0x04, 0x19, 0xda, 0x0e, 0xab, 0xa4,
-	0x2e, 0x20, 0xc8, 0x59, 0x8a, 0xd2, 0x0d, 0xc8, 0x7a, 0xc8, 0x0d, 0x42, 0xa7, 0x4e, 0x85, 0xcb,
-	0x79, 0x2b, 0xa1, 0xf5, 0x05, 0xc8, 0xf9, 0x0e, 0xb5, 0xeb, 0x41, 0x18, 0x30, 0xe5, 0x72, 0xd6,
-	0x77, 0xe8, 0x57, 0x9c, 0x36, 0x6d, 0x51, 0x26, 0x69, 0x9f, 0x62, 0x8f, 0xb9, 0x07, 0x87, 0x29,
-	0x0f, 0xa4, 0x87, 0x53, 0x87, 0x9d, 0x1e, 0x2c, 0x02, 0xb8, 0x6e, 0x02, 0xa9, 0xca, 0x33, 0xce,
-	0x91, 0xa0, 0xbe, 0xd6, 0x60, 0x3e, 0x46, 0x75, 0xb7, 0xc9, 0xde, 0x31, 0x93, 0xe6, 0x61, 0x1c,
-	0x13, 0xec, 0x22, 0x81, 0xd5, 0x98, 0x25, 0x89, 0xce, 0xfc, 0x1a, 0x4b, 0xe5, 0xd7, 0x7b, 0x4e,
-	0x98, 0xcf, 0xe0, 0xea, 0x69, 0xae, 0x25, 0xf8, 0x2d, 0x02, 0x04, 0xd4, 0x8e, 0x50, 0x48, 0x0e,
-	0x90, 0x27, 0xbc, 0xcc, 0x5a, 0xb9, 0x80, 0x5a, 0x92, 0x61, 0xee, 0x09, 0xec, 0x25, 0xf5, 0x30,
-	0x22, 0xe1, 0x7b, 0x82, 0xc7, 0x5c, 0x81, 0x6b, 0x3d, 0xf7, 0x49, 0xb2, 0xfb, 0x17, 0x0d, 0x66,
-	0x77, 0xa8, 0xbf, 0xe5, 0xd0, 0x27, 0x51, 0xe0, 0xa2, 0x41, 0x07, 0x7b, 0x7f, 0x23, 0x1a, 0x5c,
-	0x45, 0x6c, 0x84, 0x20, 0xf4, 0x6b, 0x30, 0x25, 0x51, 0xc6, 0xcd, 0xb0, 0x8a, 0x22, 0x11, 0xa8,
-	0x31, 0x6b, 0x52, 0xf0, 0x1e, 0x0b, 0x96, 0x48, 0xee, 0x66, 0xa3, 0x51, 0x6f, 0x27, 0xc9, 0x2d,
-	0x28, 0xd3, 0x80, 0x42, 0xb7, 0x65, 0x89, 0xd9, 0xaf, 0xc7, 0x45, 0xd1, 0x72, 0xe6, 0x2e, 0xde,
-	0xad, 0x52, 0x14, 0x1d, 0x20, 0x6f, 0xb7, 0xc9, 0xaa, 0xa4, 0x89, 0xbd, 0x4a, 0xab, 0x8f, 0x07,
-	0x0b, 0x20, 0xb2, 0x54, 0x46, 0x5d, 0xa6, 0x6d, 0x96, 0x33, 0x44, 0xd0, 0x4b, 0x30, 0x47, 0x94,
-	0x32, 0x9b, 0x70, 0xb8, 0x3a, 0x4f, 0xaf, 0x0b, 0xe4, 0x78, 0x9f, 0x8a, 0x5c, 0xff, 0x29, 0x18,
-	0x5d, 0xeb, 0x65, 0x02, 0xc9, 0x96, 0x26, 0x7d, 0x2d, 0xa4, 0xc4, 0x36, 0x8e, 0xe7, 0xf5, 0x8f,
-	0xe0, 0x72, 0x97, 0x34, 0x2f, 0xd8, 0x26, 0x45, 0x5e, 0x01, 0x84, 0xe8, 0x7c, 0x4a, 0x74, 0xcb,
-	0xa1, 0xcf, 0x28, 0xf2, 0xf4, 0x43, 0x30, 0xbb, 0xc4, 0xd0, 0xde, 0x1e, 0x72, 0x59, 0x70, 0x80,
-	0x84, 0x02, 0x19, 0x85, 0x49, 0xd1, 0x95, 0x4a, 0xaa, 0x2b, 0xdd, 0x18, 0xa2, 0x2b, 0x6d, 0x63,
-	0x66, 0x15, 0x53, 0x3b, 0x3e, 0x88, 0xf5, 0xc6, 0x41, 0xd0, 0xbf, 0x18, 0xb0, 0xb7, 0x3c, 0x6d,
-	0xa6, 0x84, 0xf5, 0xbd, 0x75, 0x89, 0x33, 0x48, 0x27, 0x30, 0x7d, 0xe0, 0xd4, 0x9b, 0xc8, 0x8e,
-	0x64, 0x27, 0xf7, 0x64, 0xfc, 0x37, 0x1e, 0x9d, 0xb1, 0x93, 0xfe, 0xfb, 0x66, 0xe9, 0x62, 0xdb,
-	0x09, 0xeb, 0xf7, 0xcc, 0xb4, 0x3a, 0xd3, 0xca, 0x0b, 0x86, 0xba, 0x28, 0x78, 0x1d, 0x57, 0x89,
-	0xcc, 0x10, 0x57, 0x09, 0x7d, 0x09, 0x26, 0xa5, 0x8b, 0x22, 0xc3, 0xd5, 0x21, 0x00, 0x82, 0xb5,
-	0xc9, 0x39, 0xfa, 0x0d, 0x98, 0x91, 0x0b, 0x78, 0xc3, 0x95, 0x05, 0x98, 0x15, 0x9e, 0xe7, 0x05,
-	0xbb, 0x42, 0xe9, 0x63, 0x71, 0x4e, 0xa5, 0xda, 0x5d, 0x6e, 0x50, 0xbb, 0x33, 0xaf, 0xc3, 0x4a,
-	0x9f, 0xd4, 0x4e, 0x4a, 0xe0, 0xc5, 0x98, 0xb8, 0x38, 0xa4, 0xd7, 0x6d, 0xe3, 0xc1, 0x15, 0xc0,
-	0xeb, 0x0d, 0x61, 0x0f, 0x45, 0x2a, 0xfd, 0x15, 0xc5, 0xdd, 0x91, 0x23, 0xbb, 0xab, 0x35, 0xe5,
-	0x25, 0x7b, 0x53, 0x15, 0xba, 0x01, 0x59, 0x05, 0x71, 0xa4, 0xce, 0xdd, 0x84, 0xd6, 0xaf, 0xc3,
-	0x74, 0x3c, 0x56, 0xb0, 0x8d, 0x4b, 0x15, 0x31, 0x57, 0x22, 0x77, 0x7c, 0x79, 0xca, 0xbc, 0xd3,
-	0xe5, 0x89, 0x7b, 0x19, 0x22, 0x4a, 0x1d, 0x5f, 0x42, 0x9f, 0xb3, 0x62, 0x52, 0xbf, 0x0a, 0xc0,
-	0x21, 0x57, 0x15, 0x9c, 0x93, 0x76, 0x06, 0x58, 0x15, 0xee, 0x0d, 0x98, 0x09, 0xb0, 0xad, 0xce,
-	0x7f, 0x59, 0xad, 0xb2, 0xe4, 0xf2, 0x01, 0xee, 0x2c, 0xd1, 0x54, 0x13, 0x9d, 0x14, 0x2b, 0x92,
-	0x26, 0x9a, 0x8e, 0xeb, 0xd4, 0xc0, 0x6b, 0xcc, 0x02, 0xe4, 0x58, 0xcb, 0x26, 0x51, 0xe0, 0x07,
-	0xb8, 0x90, 0x97, 0x06, 0xb1, 0xd6, 0xae, 0xa0, 0xf9, 0xe9, 0xe9, 0x50, 0x8a, 0x58, 0x61, 0x5a,
-	0x4c, 0x48, 0x82, 0xa7, 0x20, 0x3a, 0x40, 0x98, 0xa9, 0x3e, 0x34, 0x23, 0x0c, 0x00, 0xc1, 0x92,
-	0xad, 0xe8, 0xff, 0x60, 0xf6, 0xce, 0x81, 0x38, 0x55, 0xd6, 0xff, 0x02, 0x18, 0xdd, 0xa1, 0xbe,
-	0xfe, 0xbd, 0x06, 0x17, 0x4e, 0x76, 0xe4, 0xdb, 0xa5, 0xbe, 0xff, 0x09, 0xa5, 0xd3, 0x7a, 0x9d,
-	0xf1, 0xc9, 0x39, 0x84, 0x92, 0x06, 0xf9, 0x42, 0x83, 0xd9, 0x13, 0x57, 0xcc, 0xf5, 0x21, 0x35,
-	0x76, 0xc8, 0x18, 0xf7, 0xce, 0x2e, 0x93, 0x18, 0xf1, 0x93, 0x06, 0x97, 0x7a, 0x34, 0xe1, 0x3b,
-	0x83, 0xd5, 0x9e, 0x2e, 0x69, 0x7c, 0x7e, 0x5e, 0xc9, 0xc4, 0xac, 0x36, 0xe4, 0xd3, 0xcd, 0xb8,
-	0x3c, 0x58, 0x65, 0x4a, 0xc0, 0xf8, 0xf8, 0x8c, 0x02, 0xc9, 0xd6, 0xbf, 0x6a, 0x50, 0xe8, 0xd9,
-	0x51, 0x87, 0x80, 0xba, 0x97, 0xac, 0xb1, 0x71, 0x7e, 0xd9, 0xc4, 0xb8, 0x9f, 0x35, 0xb8, 0xdc,
-	0xeb, 0xac, 0xbb, 0x7b, 0x56, 0xfd, 0x89, 0xa8, 0x71, 0xff, 0xdc, 0xa2, 0x89, 0x65, 0xdf, 0xc2,
-	0x74, 0xd7, 0xcf, 0xc1, 0xcd, 0xc1, 0x4a, 0xd3, 0x12, 0xc6, 0x9d, 0xb3, 0x4a, 0xa4, 0x6a, 0xe9,
-	0xc4, 0xef, 0xe1, 0x10, 0xb5, 0xd4, 0x2d, 0x33, 0x4c, 0x2d, 0xf5, 0xfa, 0x6d, 0xd4, 0xbf, 0x83,
-	0x99, 0xee, 0x9f, 0xea, 0x5b, 0x83, 0xd5, 0x75, 0x89, 0x18, 0x77, 0xcf, 0x2c, 0xd2, 0x19, 0x83,
-	0xae, 0xc7, 0x89, 0x21, 0x62, 0x90, 0x96, 0x18, 0x26, 0x06, 0xa7, 0xbf, 0x2b, 0x6c, 0x7c, 0xf9,
-	0xf2, 0x6d, 0x51, 0x7b, 0xf5, 0xb6, 0xa8, 0xfd, 0xfd, 0xb6, 0xa8, 0xfd, 0x70, 0x54, 0x1c, 0x79,
-	0x75, 0x54, 0x1c, 0xf9, 0xe3, 0xa8, 0x38, 0xf2, 0xfc, 0x56, 0x47, 0x43, 0xe3, 0x3a, 0xd7, 0xe4,
-	0x13, 0x4d, 0xac, 0xbe, 0xdc, 0x2a, 0x77, 0x3e, 0xdc, 0xf0, 0xfe, 0x56, 0xcd, 0x88, 0x27, 0x97,
-	0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x47, 0x0c, 0x03, 0xae, 0xd3, 0x11, 0x00, 0x00,
+	// 1665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x13, 0xcf,
+	0x15, 0xcf, 0x12, 0xc7, 0xb1, 0x5f, 0x62, 0x27, 0x6c, 0x02, 0x38, 0x1b, 0xe2, 0x84, 0x0d, 0xd0,
+	0xa8, 0x52, 0x6c, 0x30, 0x45, 0x05, 0x0a, 0x52, 0x93, 0x08, 0x42, 0x28, 0x21, 0xd1, 0x62, 0x5a,
+	0x89, 0xcb, 0x6a, 0xbd, 0x3b, 0xd9, 0xac, 0x62, 0xef, 0x58, 0x3b, 0xe3, 0xc8, 0x8e, 0x2a, 0x55,
+	0x42, 0xaa, 0x54, 0xa9, 0x97, 0xaa, 0xaa, 0x54, 0xa9, 0xa7, 0xde, 0xfa, 0x47, 0xf4, 0x1f, 0xe0,
+	0x88, 0x7a, 0x2a, 0x3d, 0xa0, 0x0a, 0xfe, 0x82, 0xf6, 0xf0, 0x3d, 0x7f, 0x35, 0x3f, 0x76, 0xed,
+	0xb5, 0x9d, 0xf8, 0x07, 0x70, 0xf2, 0xcc, 0x9b, 0xf9, 0xbc, 0x79, 0xef, 0x33, 0xef, 0xbd, 0x79,
+	0x5e, 0x58, 0xb0, 0x03, 0x4c, 0x88, 0x7d, 0x6c, 0x79, 0x7e, 0x91, 0x36, 0x0b, 0xf5, 0x00, 0x53,
+	0xac, 0xae, 0x9c, 0x21, 0x6a, 0x71, 0x59, 0x81, 0x8f, 0x70, 0x80, 0x0a, 0xed, 0x7d, 0xda, 0x82,
+	0x8d, 0x6b, 0x35, 0xec, 0x17, 0xc5, 0x8f, 0xc0, 0x68, 0xab, 0x1d, 0x8a, 0x70, 0x83, 0x9a, 0xb4,
+	0x69, 0xd2, 0xc0, 0xb2, 0x4f, 0x50, 0x20, 0x37, 0x2c, 0xba, 0xd8, 0xc5, 0x7c, 0x58, 0x64, 0x23,
+	0x21, 0xd5, 0xff, 0xa1, 0xc0, 0xe5, 0x7d, 0xe2, 0xee, 0x04, 0xc8, 0xa2, 0xa8, 0xfc, 0xfa, 0xf5,
+	0xaf, 0x31, 0x45, 0x81, 0x9a, 0x83, 0x69, 0x9b, 0x49, 0x70, 0x90, 0x53, 0xd6, 0x94, 0x8d, 0xb4,
+	0x11, 0x4e, 0xd5, 0x15, 0x00, 0x4a, 0x88, 0x59, 0x6f, 0x54, 0x4e, 0x50, 0x2b, 0x77, 0x89, 0x2f,
+	0xa6, 0x29, 0x21, 0x87, 0x5c, 0xa0, 0xfe, 0x14, 0xe6, 0x4f, 0x50, 0x6b, 0x17, 0xf9, 0x6f, 0x11,
+	0xb5, 0x9e, 0x23, 0xcf, 0x3d, 0xa6, 0xb9, 0xc9, 0x35, 0x65, 0x63, 0xd2, 0xe8, 0x91, 0xab, 0x9b,
+	0x90, 0x24, 0xd4, 0xa2, 0x0d, 0x92, 0x4b, 0xac, 0x29, 0x1b, 0xd9, 0xd2, 0x95, 0x82, 0x74, 0xc8,
+	0x40, 0x36, 0xf2, 0x4e, 0xd1, 0x6b, 0xbe, 0x68, 0xc8, 0x4d, 0xfa, 0x32, 0x2c, 0xf5, 0x18, 0x6a,
+	0x20, 0x52, 0xc7, 0x3e, 0x41, 0xfa, 0x9f, 0x15, 0x50, 0xf7, 0x89, 0xbb, 0xef, 0xb9, 0x01, 0x5b,
+	0x26, 0xe4, 0x59, 0xc3, 0x77, 0xc8, 0x05, 0x7e, 0x2c, 0x41, 0x8a, 0x73, 0x65, 0x7a, 0x0e, 0xf7,
+	0x62, 0xd2, 0x98, 0xe6, 0xf3, 0x3d, 0x47, 0xdd, 0x85, 0xa4, 0x55, 0xc3, 0x0d, 0x5f, 0x58, 0x9e,
+	0xde, 0x2e, 0xbe, 0xff, 0xb4, 0x3a, 0xf1, 0x9f, 0x4f, 0xab, 0x3f, 0x71, 0x3d, 0x7a, 0xdc, 0xa8,
+	0x30, 0x2b, 0x8b, 0x36, 0x26, 0x35, 0x4c, 0xe4, 0xcf, 0x26, 0x71, 0x4e, 0x8a, 0xb4, 0x55, 0x47,
+	0xa4, 0xf0, 0xc6, 0xf3, 0xa9, 0x21, 0xe1, 0xfa, 0x75, 0xd0, 0x7a, 0x6d, 0x8a, 0x4c, 0x7e, 0x05,
+	0x0b, 0xfb, 0xc4, 0x7d, 0x53, 0x77, 0xc4, 0xe2, 0x96, 0xe3, 0x04, 0x88, 0x90, 0xb1, 0xa9, 0xd7,
+	0x57, 0x60, 0xb9, 0x8f, 0xbe, 0xe8, 0xb8, 0xff, 0x29, 0xfc, 0xbc, 0x2d, 0xc7, 0x29, 0xe3, 0x3d,
+	0xbf, 0xdc, 0x2c, 0x8b, 0xe0, 0x18, 0x8f, 0xa2, 0x6b, 0x30, 0x4d, 0x9b, 0xe6, 0xb1, 0x45, 0x8e,
+	0x05, 0x47, 0x46, 0x92, 0x36, 0x9f, 0x5b, 0xe4, 0x58, 0xdd, 0x84, 0xb4, 0x8d, 0x3d, 0xdf, 0x64,
+	0x6c, 0xc8, 0x6b, 0x9d, 0x0f, 0xaf, 0x75, 0x07, 0x7b, 0x7e, 0xb9, 0x55, 0x47, 0x46, 0xca, 0x96,
+	0x23, 0x75, 0x1d, 0xa6, 0xea, 0x01, 0xc6, 0x47, 0xb9, 0xa9, 0x35, 0x65, 0x63, 0xa6, 0x94, 0x09,
+	0xb7, 0x1e, 0x32, 0xa1, 0x21, 0xd6, 0x98, 0xdf, 0x95, 0x2a, 0xb6, 0x4f, 0xc4, 0x79, 0x49, 0xe1,
+	0x37, 0x97, 0xf0, 0x23, 0x97, 0x20, 0x45, 0x9b, 0xa6, 0xe7, 0x3b, 0xa8, 0x99, 0x9b, 0x16, 0x66,
+	0xd2, 0xe6, 0x1e, 0x9b, 0x4a, 0x4a, 0xba, 0x5d, 0x8e, 0x28, 0xf9, 0xfb, 0x25, 0xb8, 0x22, 0xd6,
+	0x0f, 0x03, 0x7c, 0x8a, 0xfc, 0x3d, 0xbf, 0x82, 0x1b, 0xbe, 0x53, 0x6e, 0x7e, 0x63, 0x52, 0x22,
+	0x2f, 0x13, 0x43, 0x7b, 0x39, 0x75, 0x91, 0x97, 0xc9, 0x98, 0x97, 0x6a, 0x09, 0x32, 0x01, 0xcb,
+	0x98, 0x3a, 0x35, 0xc5, 0x31, 0xd3, 0xfd, 0x8e, 0x99, 0x95, 0x7b, 0xf8, 0x4c, 0xbd, 0x0d, 0x73,
+	0x47, 0x5e, 0x40, 0xa8, 0x59, 0xc5, 0xae, 0xd4, 0x9a, 0x5a, 0x53, 0x36, 0x12, 0x46, 0x86, 0x8b,
+	0x5f, 0x62, 0x57, 0x30, 0xb8, 0x0d, 0x2b, 0x7d, 0x19, 0x0a, 0x39, 0x54, 0x6f, 0xc0, 0xac, 0x6d,
+	0x87, 0x96, 0x21, 0x92, 0x53, 0xd6, 0x26, 0x37, 0xd2, 0xc6, 0x0c, 0x93, 0xed, 0x09, 0x91, 0xfe,
+	0x2f, 0x51, 0x62, 0x7e, 0x73, 0xec, 0x51, 0x54, 0xf5, 0x08, 0x7d, 0x6a, 0xec, 0x94, 0xee, 0x5c,
+	0x40, 0xf1, 0x3a, 0x64, 0x50, 0x60, 0x97, 0xee, 0x98, 0x96, 0x08, 0x61, 0x19, 0xea, 0xb3, 0x5c,
+	0x18, 0xa6, 0x49, 0xe7, 0x3d, 0x4c, 0xc6, 0xef, 0x41, 0x85, 0x84, 0x6f, 0xd5, 0x44, 0xf8, 0xa5,
+	0x0d, 0x3e, 0x56, 0xaf, 0x42, 0x92, 0xb4, 0x6a, 0x15, 0x5c, 0x95, 0xcc, 0xca, 0x99, 0xaa, 0x41,
+	0xca, 0x41, 0xb6, 0x57, 0xb3, 0xaa, 0x84, 0xd3, 0x9a, 0x31, 0xa2, 0xb9, 0xba, 0x0c, 0x69, 0xd7,
+	0x22, 0x66, 0xd5, 0xab, 0x79, 0x54, 0x46, 0x56, 0xca, 0xb5, 0xc8, 0x4b, 0x36, 0xd7, 0x4d, 0x58,
+	0xea, 0xf1, 0x29, 0x22, 0x65, 0x1d, 0x32, 0x67, 0x31, 0x0f, 0x84, 0x87, 0xb3, 0x67, 0x9d, 0x1e,
+	0xac, 0x00, 0xb4, 0x99, 0x0b, 0xd3, 0x39, 0xe2, 0x4d, 0xff, 0xa8, 0xc0, 0x62, 0x18, 0xbc, 0x07,
+	0x0d, 0xfa, 0x95, 0x09, 0xbb, 0x08, 0x53, 0x3e, 0xf6, 0x6d, 0xc4, 0xb9, 0x4a, 0x18, 0x62, 0xd2,
+	0x19, 0xb1, 0x89, 0xfe, 0x11, 0xfb, 0x7d, 0xf2, 0xf2, 0x09, 0x5c, 0xef, 0xe7, 0x5a, 0xc4, 0xdf,
+	0x0a, 0x80, 0x47, 0xcc, 0x00, 0xd5, 0xf0, 0x29, 0x72, 0xb8, 0x97, 0x29, 0x23, 0xed, 0x11, 0x43,
+	0x08, 0xf4, 0x23, 0xce, 0xbd, 0x98, 0x3d, 0x0b, 0x70, 0xed, 0x3b, 0xd1, 0xa3, 0xaf, 0xc3, 0x8d,
+	0x73, 0xcf, 0x89, 0x8a, 0xc8, 0x3f, 0x2f, 0xc1, 0x55, 0xbe, 0xab, 0x8e, 0x03, 0xca, 0x77, 0xbc,
+	0x62, 0xe0, 0x5d, 0xab, 0xfe, 0x2d, 0x6f, 0x6a, 0x19, 0x58, 0xa5, 0x37, 0xc5, 0x4a, 0x82, 0xaf,
+	0xa4, 0x28, 0x21, 0xfc, 0x28, 0x75, 0x17, 0xa6, 0x6c, 0xab, 0x41, 0x10, 0xbf, 0xad, 0x6c, 0xe9,
+	0x6e, 0xe1, 0xc2, 0xf6, 0xa1, 0x10, 0x33, 0x72, 0x87, 0x01, 0x0d, 0x81, 0x57, 0x5f, 0x40, 0xd2,
+	0xb2, 0xa9, 0x87, 0x7d, 0x7e, 0x9b, 0xd9, 0x52, 0x69, 0x14, 0x4d, 0x5b, 0x1c, 0x69, 0x48, 0x0d,
+	0xea, 0x4d, 0xc8, 0xda, 0x96, 0x6f, 0xa3, 0xaa, 0x19, 0x86, 0xd8, 0xb4, 0x48, 0x02, 0x21, 0x2d,
+	0xf3, 0x40, 0xd3, 0xd7, 0x20, 0xdf, 0x9f, 0xbc, 0x36, 0xbf, 0x0a, 0xcc, 0xef, 0x13, 0x77, 0xd7,
+	0x22, 0x87, 0x81, 0x67, 0xa3, 0x41, 0xfd, 0xc9, 0xc5, 0xcc, 0xd6, 0x03, 0xaf, 0xcd, 0x2c, 0x9f,
+	0xb0, 0x02, 0x26, 0xa2, 0xd8, 0x6f, 0xd4, 0x2a, 0x28, 0x90, 0xe4, 0xce, 0x70, 0xd9, 0x2b, 0x2e,
+	0xe2, 0xc5, 0xa3, 0x51, 0xaf, 0x57, 0x5b, 0x51, 0xf1, 0xe0, 0x33, 0x06, 0xad, 0x07, 0x1e, 0x0e,
+	0x3c, 0xda, 0x32, 0x8f, 0x10, 0xe2, 0xa4, 0x25, 0x8c, 0x99, 0x50, 0xf6, 0x0c, 0x21, 0x5d, 0x83,
+	0x5c, 0xb7, 0xf1, 0x91, 0x67, 0x1f, 0xa7, 0xf8, 0xf3, 0xc4, 0x84, 0x07, 0xfe, 0x41, 0x85, 0xa0,
+	0xe0, 0x14, 0x39, 0x07, 0x0d, 0x3a, 0xf8, 0x11, 0x5a, 0x06, 0x5e, 0x28, 0x04, 0xad, 0xa2, 0x72,
+	0xa4, 0x98, 0x80, 0xe7, 0x5d, 0x01, 0x16, 0xb0, 0x54, 0x66, 0x62, 0x46, 0x69, 0xe7, 0x93, 0x74,
+	0x19, 0xb7, 0xcf, 0x11, 0x57, 0xa0, 0x3e, 0x06, 0xad, 0x6b, 0xbf, 0xc8, 0x61, 0xd1, 0xbc, 0x09,
+	0x3a, 0x72, 0x31, 0xd8, 0x76, 0x7b, 0x5d, 0xbd, 0x0f, 0xd7, 0xba, 0xd0, 0xac, 0x66, 0x36, 0x08,
+	0x72, 0x72, 0xc0, 0xa1, 0x8b, 0x31, 0xe8, 0xae, 0x45, 0xde, 0x10, 0xe4, 0xa8, 0x67, 0xa0, 0x77,
+	0xc1, 0xd0, 0xd1, 0x11, 0xb2, 0xa9, 0x77, 0x8a, 0xb8, 0x02, 0x71, 0x51, 0x33, 0xbc, 0xff, 0x2a,
+	0xc8, 0xfe, 0xeb, 0xf6, 0x10, 0xfd, 0xd7, 0x9e, 0x4f, 0x8d, 0x7c, 0xec, 0xc4, 0xa7, 0xa1, 0xde,
+	0xf0, 0x12, 0xd4, 0x17, 0x03, 0xce, 0x16, 0x05, 0x7f, 0x96, 0x5b, 0x7f, 0xbe, 0x2e, 0xfe, 0x0c,
+	0xa8, 0x18, 0xb2, 0xa7, 0x56, 0xb5, 0x81, 0xcc, 0x40, 0xf4, 0xac, 0x8e, 0x08, 0x91, 0xed, 0xe7,
+	0x23, 0xf6, 0x8c, 0xff, 0xff, 0xb4, 0x7a, 0xa5, 0x65, 0xd5, 0xaa, 0x8f, 0xf4, 0xb8, 0x3a, 0xdd,
+	0xc8, 0x70, 0x81, 0x6c, 0x89, 0x9d, 0x8e, 0xa6, 0x39, 0x39, 0x44, 0xd3, 0xac, 0xae, 0xc2, 0x8c,
+	0x70, 0x91, 0x27, 0x81, 0xac, 0xc3, 0xc0, 0x45, 0x3b, 0x4c, 0xc2, 0x1a, 0x01, 0xb1, 0xa1, 0x5d,
+	0x5e, 0x64, 0x23, 0xc0, 0xc5, 0xe5, 0xb0, 0xc6, 0xc4, 0x1a, 0xbb, 0xf4, 0xa0, 0xc6, 0x4e, 0xbf,
+	0x05, 0xeb, 0x17, 0x84, 0x76, 0x94, 0x02, 0xef, 0x12, 0xa0, 0xf5, 0xec, 0x1b, 0xa6, 0x0d, 0x63,
+	0x29, 0x89, 0x7c, 0x07, 0x05, 0x32, 0xfc, 0xe5, 0x8c, 0xb9, 0x23, 0x46, 0x66, 0x57, 0x77, 0x90,
+	0x11, 0xe2, 0x1d, 0x59, 0x0b, 0x34, 0x48, 0x49, 0x8a, 0x03, 0xf9, 0xf4, 0x45, 0x73, 0xf5, 0x16,
+	0x64, 0xc3, 0xb1, 0xa4, 0x6d, 0x4a, 0xa8, 0x08, 0xa5, 0x82, 0xb9, 0xf6, 0xdf, 0x84, 0xe4, 0x57,
+	0xfd, 0x4d, 0x60, 0x5e, 0xd6, 0x10, 0x21, 0x96, 0x2b, 0xa8, 0x4f, 0x1b, 0xe1, 0x54, 0xbd, 0x0e,
+	0xc0, 0x28, 0x97, 0x19, 0x9c, 0x16, 0x76, 0x7a, 0xbe, 0x4c, 0xdc, 0xdb, 0x30, 0xe7, 0xf9, 0xa6,
+	0x7c, 0x82, 0x45, 0xb6, 0x8a, 0x94, 0xcb, 0x78, 0x7e, 0x67, 0x8a, 0xc6, 0xfa, 0x98, 0x19, 0xf1,
+	0x76, 0x84, 0x7d, 0x4c, 0xfc, 0x5e, 0x67, 0x07, 0x36, 0xec, 0xec, 0x1d, 0x6a, 0x9a, 0x38, 0xf0,
+	0x5c, 0xcf, 0xcf, 0x65, 0x84, 0x41, 0xb4, 0x79, 0xc0, 0xe7, 0xac, 0xc0, 0x5a, 0x84, 0x20, 0x9a,
+	0xcb, 0xf2, 0x05, 0x31, 0x61, 0x21, 0x88, 0x4e, 0x91, 0x4f, 0x65, 0x2b, 0x30, 0xc7, 0x0d, 0x00,
+	0x2e, 0x12, 0xdd, 0xc0, 0x4d, 0xd0, 0xcf, 0x8f, 0x81, 0x30, 0x54, 0x4a, 0x3f, 0xcc, 0xc2, 0xe4,
+	0x3e, 0x71, 0xd5, 0xdf, 0x2b, 0x70, 0xb9, 0xb7, 0x29, 0xba, 0x37, 0xe0, 0xa5, 0xea, 0xd7, 0x6e,
+	0x68, 0xbf, 0x18, 0x03, 0x14, 0xf5, 0x28, 0xef, 0x14, 0x98, 0xef, 0xf9, 0x33, 0x55, 0x1a, 0x52,
+	0x63, 0x07, 0x46, 0x7b, 0x34, 0x3a, 0x26, 0x32, 0xe2, 0x0f, 0x0a, 0xa8, 0x7d, 0xfe, 0xbe, 0xfc,
+	0x6c, 0x28, 0x95, 0x5d, 0x28, 0xed, 0xf1, 0x38, 0xa8, 0xc8, 0x94, 0xbf, 0x28, 0x70, 0xf5, 0x9c,
+	0x96, 0xec, 0xc1, 0x60, 0xc5, 0xfd, 0x91, 0xda, 0x2f, 0xc7, 0x45, 0x46, 0x66, 0xfd, 0x51, 0x81,
+	0x85, 0x7e, 0xbd, 0xd9, 0xfd, 0x61, 0x34, 0xf7, 0xc0, 0xb4, 0x27, 0x63, 0xc1, 0x22, 0x6b, 0x5a,
+	0x90, 0x89, 0x37, 0x32, 0xc5, 0xc1, 0xfa, 0x62, 0x00, 0xed, 0xe7, 0x23, 0x02, 0xa2, 0xa3, 0xff,
+	0xa6, 0x40, 0xee, 0xdc, 0x56, 0x63, 0x88, 0x18, 0x3c, 0x0f, 0xab, 0x6d, 0x8f, 0x8f, 0x8d, 0x8c,
+	0xfb, 0xab, 0x02, 0xd7, 0xce, 0x7b, 0x04, 0x1e, 0x8e, 0xaa, 0xbf, 0x1d, 0xd1, 0x5b, 0x63, 0x43,
+	0x23, 0xcb, 0x7e, 0x0b, 0xd9, 0xae, 0x3f, 0xae, 0x77, 0x06, 0x2b, 0x8d, 0x23, 0xb4, 0x07, 0xa3,
+	0x22, 0x62, 0x45, 0xa6, 0xe7, 0x0b, 0xd1, 0x10, 0x45, 0xa6, 0x1b, 0xa3, 0x3d, 0x1a, 0x1d, 0x13,
+	0x19, 0xf1, 0x3b, 0x98, 0xeb, 0xfe, 0xae, 0x76, 0x77, 0xb0, 0xba, 0x2e, 0x88, 0xf6, 0x70, 0x64,
+	0x48, 0xe7, 0x1d, 0x74, 0x7d, 0x9f, 0x1c, 0xe2, 0x0e, 0xe2, 0x08, 0xed, 0xc1, 0xa8, 0x88, 0xf0,
+	0xf4, 0xed, 0x5f, 0xbd, 0xff, 0x9c, 0x57, 0x3e, 0x7c, 0xce, 0x2b, 0xff, 0xfd, 0x9c, 0x57, 0xfe,
+	0xf4, 0x25, 0x3f, 0xf1, 0xe1, 0x4b, 0x7e, 0xe2, 0xdf, 0x5f, 0xf2, 0x13, 0x6f, 0xef, 0x76, 0xbc,
+	0xf4, 0x4c, 0xe7, 0xa6, 0xf8, 0xfa, 0x1a, 0xaa, 0x2f, 0x36, 0x8b, 0x9d, 0x1f, 0x77, 0xd9, 0xc3,
+	0x5f, 0x49, 0xf2, 0xaf, 0xae, 0xf7, 0x7e, 0x1c, 0x00, 0x90, 0x53, 0x1f, 0xfd, 0xf7, 0x15, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1393,7 +1696,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	AddToOutTxTracker(ctx context.Context, in *MsgAddToOutTxTracker, opts ...grpc.CallOption) (*MsgAddToOutTxTrackerResponse, error)
 	AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracker, opts ...grpc.CallOption) (*MsgAddToInTxTrackerResponse, error)
+	AddProvenInboundTx(ctx context.Context, in *MsgAddProvenInboundTx, opts ...grpc.CallOption) (*MsgAddProvenInboundTxResponse, error)
 	RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error)
+	ReportOutTxNonceGap(ctx context.Context, in *MsgReportOutTxNonceGap, opts ...grpc.CallOption) (*MsgReportOutTxNonceGapResponse, error)
 	GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error)
 	VoteOnObservedOutboundTx(ctx context.Context, in *MsgVoteOnObservedOutboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedOutboundTxResponse, error)
 	VoteOnObservedInboundTx(ctx context.Context, in *MsgVoteOnObservedInboundTx, opts ...grpc.CallOption) (*MsgVoteOnObservedInboundTxResponse, error)
@@ -1429,6 +1734,15 @@ func (c *msgClient) AddToInTxTracker(ctx context.Context, in *MsgAddToInTxTracke
 	return out, nil
 }
 
+func (c *msgClient) AddProvenInboundTx(ctx context.Context, in *MsgAddProvenInboundTx, opts ...grpc.CallOption) (*MsgAddProvenInboundTxResponse, error) {
+	out := new(MsgAddProvenInboundTxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/AddProvenInboundTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFromOutTxTracker, opts ...grpc.CallOption) (*MsgRemoveFromOutTxTrackerResponse, error) {
 	out := new(MsgRemoveFromOutTxTrackerResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RemoveFromOutTxTracker", in, out, opts...)
@@ -1438,6 +1752,15 @@ func (c *msgClient) RemoveFromOutTxTracker(ctx context.Context, in *MsgRemoveFro
 	return out, nil
 }
 
+func (c *msgClient) ReportOutTxNonceGap(ctx context.Context, in *MsgReportOutTxNonceGap, opts ...grpc.CallOption) (*MsgReportOutTxNonceGapResponse, error) {
+	out := new(MsgReportOutTxNonceGapResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ReportOutTxNonceGap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GasPriceVoter(ctx context.Context, in *MsgGasPriceVoter, opts ...grpc.CallOption) (*MsgGasPriceVoterResponse, error) {
 	out := new(MsgGasPriceVoterResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/GasPriceVoter", in, out, opts...)
@@ -1505,7 +1828,9 @@ func (c *msgClient) CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, o
 type MsgServer interface {
 	AddToOutTxTracker(context.Context, *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error)
 	AddToInTxTracker(context.Context, *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error)
+	AddProvenInboundTx(context.Context, *MsgAddProvenInboundTx) (*MsgAddProvenInboundTxResponse, error)
 	RemoveFromOutTxTracker(context.Context, *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error)
+	ReportOutTxNonceGap(context.Context, *MsgReportOutTxNonceGap) (*MsgReportOutTxNonceGapResponse, error)
 	GasPriceVoter(context.Context, *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error)
 	VoteOnObservedOutboundTx(context.Context, *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error)
 	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
@@ -1525,9 +1850,15 @@ func (*UnimplementedMsgServer) AddToOutTxTracker(ctx context.Context, req *MsgAd
 func (*UnimplementedMsgServer) AddToInTxTracker(ctx context.Context, req *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToInTxTracker not implemented")
 }
+func (*UnimplementedMsgServer) AddProvenInboundTx(ctx context.Context, req *MsgAddProvenInboundTx) (*MsgAddProvenInboundTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProvenInboundTx not implemented")
+}
 func (*UnimplementedMsgServer) RemoveFromOutTxTracker(ctx context.Context, req *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromOutTxTracker not implemented")
 }
+func (*UnimplementedMsgServer) ReportOutTxNonceGap(ctx context.Context, req *MsgReportOutTxNonceGap) (*MsgReportOutTxNonceGapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportOutTxNonceGap not implemented")
+}
 func (*UnimplementedMsgServer) GasPriceVoter(ctx context.Context, req *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPriceVoter not implemented")
 }
@@ -1590,6 +1921,24 @@ func _Msg_AddToInTxTracker_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddProvenInboundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddProvenInboundTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddProvenInboundTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/AddProvenInboundTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddProvenInboundTx(ctx, req.(*MsgAddProvenInboundTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveFromOutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveFromOutTxTracker)
 	if err := dec(in); err != nil {
@@ -1608,6 +1957,24 @@ func _Msg_RemoveFromOutTxTracker_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportOutTxNonceGap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportOutTxNonceGap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportOutTxNonceGap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ReportOutTxNonceGap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportOutTxNonceGap(ctx, req.(*MsgReportOutTxNonceGap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_GasPriceVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgGasPriceVoter)
 	if err := dec(in); err != nil {
@@ -1746,10 +2113,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToInTxTracker",
 			Handler:    _Msg_AddToInTxTracker_Handler,
 		},
+		{
+			MethodName: "AddProvenInboundTx",
+			Handler:    _Msg_AddProvenInboundTx_Handler,
+		},
 		{
 			MethodName: "RemoveFromOutTxTracker",
 			Handler:    _Msg_RemoveFromOutTxTracker_Handler,
 		},
+		{
+			MethodName: "ReportOutTxNonceGap",
+			Handler:    _Msg_ReportOutTxNonceGap_Handler,
+		},
 		{
 			MethodName: "GasPriceVoter",
 			Handler:    _Msg_GasPriceVoter_Handler,
@@ -2075,7 +2450,7 @@ func (m *MsgAddToInTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelistERC20) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddProvenInboundTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2085,25 +2460,140 @@ func (m *MsgWhitelistERC20) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWhitelistERC20) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddProvenInboundTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWhitelistERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddProvenInboundTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+	if m.FirstLogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FirstLogIndex))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x40
+	}
+	if m.ReceiptProof != nil {
+		{
+			size, err := m.ReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddProvenInboundTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddProvenInboundTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddProvenInboundTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndexes) > 0 {
+		for iNdEx := len(m.CctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CctxIndexes[iNdEx])
+			copy(dAtA[i:], m.CctxIndexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x30
 	}
 	if len(m.Symbol) > 0 {
 		i -= len(m.Symbol)
@@ -2345,6 +2835,91 @@ func (m *MsgRemoveFromOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgReportOutTxNonceGap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOutTxNonceGap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOutTxNonceGap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelTxHash) > 0 {
+		i -= len(m.CancelTxHash)
+		copy(dAtA[i:], m.CancelTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CancelTxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cause != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TssNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TssNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportOutTxNonceGapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOutTxNonceGapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOutTxNonceGapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgGasPriceVoter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2365,6 +2940,11 @@ func (m *MsgGasPriceVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriorityFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriorityFee))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Supply) > 0 {
 		i -= len(m.Supply)
 		copy(dAtA[i:], m.Supply)
@@ -2816,6 +3396,59 @@ func (m *MsgAddToInTxTrackerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddProvenInboundTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.ReceiptProof != nil {
+		l = m.ReceiptProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FirstLogIndex != 0 {
+		n += 1 + sovTx(uint64(m.FirstLogIndex))
+	}
+	return n
+}
+
+func (m *MsgAddProvenInboundTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CctxIndexes) > 0 {
+		for _, s := range m.CctxIndexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgWhitelistERC20) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2941,6 +3574,47 @@ func (m *MsgRemoveFromOutTxTrackerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReportOutTxNonceGap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.TssNonce != 0 {
+		n += 1 + sovTx(uint64(m.TssNonce))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovTx(uint64(m.Cause))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = len(m.CancelTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportOutTxNonceGapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgGasPriceVoter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2964,6 +3638,9 @@ func (m *MsgGasPriceVoter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.PriorityFee != 0 {
+		n += 1 + sovTx(uint64(m.PriorityFee))
+	}
 	return n
 }
 
@@ -3943,7 +4620,7 @@ func (m *MsgAddToInTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgAddProvenInboundTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3966,10 +4643,10 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddProvenInboundTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddProvenInboundTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4005,8 +4682,27 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4034,13 +4730,13 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4050,14 +4746,31 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &common.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4085,13 +4798,32 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4101,29 +4833,33 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if m.ReceiptProof == nil {
+				m.ReceiptProof = &common.Proof{}
+			}
+			if err := m.ReceiptProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstLogIndex", wireType)
 			}
-			m.Decimals = 0
+			m.FirstLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4133,16 +4869,66 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.FirstLogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasLimit = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProvenInboundTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProvenInboundTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProvenInboundTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndexes", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4152,11 +4938,24 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndexes = append(m.CctxIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4178,7 +4977,7 @@ func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgWhitelistERC20) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4201,15 +5000,15 @@ func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWhitelistERC20: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWhitelistERC20: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4237,11 +5036,62 @@ func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4269,8 +5119,501 @@ func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWhitelistERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWhitelistERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToOutTxTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToOutTxTracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToOutTxTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &common.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddToOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddToOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddToOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4292,7 +5635,7 @@ func (m *MsgWhitelistERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToOutTxTracker) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromOutTxTracker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4315,10 +5658,10 @@ func (m *MsgAddToOutTxTracker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToOutTxTracker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromOutTxTracker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToOutTxTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromOutTxTracker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4391,125 +5734,6 @@ func (m *MsgAddToOutTxTracker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Proof == nil {
-				m.Proof = &common.Proof{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
-			}
-			m.TxIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4531,7 +5755,7 @@ func (m *MsgAddToOutTxTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4554,32 +5778,12 @@ func (m *MsgAddToOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromOutTxTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsRemoved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4601,7 +5805,7 @@ func (m *MsgAddToOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromOutTxTracker) Unmarshal(dAtA []byte) error {
+func (m *MsgReportOutTxNonceGap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4624,10 +5828,10 @@ func (m *MsgRemoveFromOutTxTracker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromOutTxTracker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportOutTxNonceGap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromOutTxTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportOutTxNonceGap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4700,6 +5904,95 @@ func (m *MsgRemoveFromOutTxTracker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssNonce", wireType)
+			}
+			m.TssNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TssNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= OutTxNonceGapCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= OutTxNonceGapAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4721,7 +6014,7 @@ func (m *MsgRemoveFromOutTxTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportOutTxNonceGapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4744,10 +6037,10 @@ func (m *MsgRemoveFromOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportOutTxNonceGapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportOutTxNonceGapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4921,6 +6214,25 @@ func (m *MsgGasPriceVoter) Unmarshal(dAtA []byte) error {
 			}
 			m.Supply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			m.PriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,27 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding emissions type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		var a, b codec.ProtoMarshaler
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.WithdrawableEmissionsKey)):
+			a, b = &types.WithdrawableEmissions{}, &types.WithdrawableEmissions{}
+		default:
+			panic(fmt.Sprintf("invalid emissions key prefix %X", kvA.Key))
+		}
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+}
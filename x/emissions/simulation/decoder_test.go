@@ -0,0 +1,45 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/emissions/simulation"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keepertest.NewCodec()
+	dec := simulation.NewDecodeStore(cdc)
+
+	withdrawableEmissions := sample.WithdrawableEmissions(t)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefix(types.WithdrawableEmissionsKey), Value: cdc.MustMarshal(&withdrawableEmissions)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"WithdrawableEmissions", fmt.Sprintf("%v\n%v", &withdrawableEmissions, &withdrawableEmissions)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedLog == "" {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
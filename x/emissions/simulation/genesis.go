@@ -0,0 +1,16 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// RandomizedGenState generates a GenesisState for the emissions module
+// The params are not randomized since the keeper always distributes the emissions with the default params
+func RandomizedGenState(simState *module.SimulationState) {
+	emissionsGenesis := types.GenesisState{Params: types.DefaultParams()}
+	fmt.Printf("Selected emissions parameters:\n%s\n", emissionsGenesis.Params.String())
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&emissionsGenesis)
+}
@@ -26,6 +26,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdGasStabilityPoolAddress(),
 		CmdGasStabilityPoolBalance(),
 		CmdGasStabilityPoolBalances(),
+		CmdZRC20Supplies(),
 		CmdSystemContract(),
 		CmdQueryCodeHash(),
 	)
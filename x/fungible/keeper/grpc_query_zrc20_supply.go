@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ZRC20SupplyAll returns the total supply of the ZRC20 contract of each foreign coin
+func (k Keeper) ZRC20SupplyAll(
+	c context.Context,
+	req *types.QueryAllZRC20SupplyRequest,
+) (*types.QueryAllZRC20SupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	foreignCoins := k.GetAllForeignCoins(ctx)
+	supplies := make([]types.QueryAllZRC20SupplyResponse_Supply, 0, len(foreignCoins))
+	for _, foreignCoin := range foreignCoins {
+		totalSupply, err := k.TotalSupplyZRC4(ctx, ethcommon.HexToAddress(foreignCoin.Zrc20ContractAddress))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		supplies = append(supplies, types.QueryAllZRC20SupplyResponse_Supply{
+			ForeignCoin: foreignCoin,
+			TotalSupply: totalSupply.String(),
+		})
+	}
+
+	return &types.QueryAllZRC20SupplyResponse{
+		Supplies: supplies,
+	}, nil
+}
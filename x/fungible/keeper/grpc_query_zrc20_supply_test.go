@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestKeeper_ZRC20SupplyAll(t *testing.T) {
+	t.Run("should return the supply of each zrc20", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+
+		res, err := k.ZRC20SupplyAll(ctx, &types.QueryAllZRC20SupplyRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Supplies, 1)
+		require.Equal(t, zrc20.Hex(), res.Supplies[0].ForeignCoin.Zrc20ContractAddress)
+		initialSupply, ok := new(big.Int).SetString(res.Supplies[0].TotalSupply, 10)
+		require.True(t, ok)
+
+		_, _, err = k.ZRC20DepositAndCallContract(
+			ctx,
+			sample.EthAddress().Bytes(),
+			sample.EthAddress(),
+			big.NewInt(42),
+			common.GetChainFromChainID(chainID),
+			[]byte{},
+			common.CoinType_Gas,
+			"",
+		)
+		require.NoError(t, err)
+
+		res, err = k.ZRC20SupplyAll(ctx, &types.QueryAllZRC20SupplyRequest{})
+		require.NoError(t, err)
+		require.Equal(t, initialSupply.Add(initialSupply, big.NewInt(42)).String(), res.Supplies[0].TotalSupply)
+	})
+
+	t.Run("should fail if the zrc20 contract doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.SetForeignCoins(ctx, sample.ForeignCoins(t, sample.EthAddress().Hex()))
+
+		_, err := k.ZRC20SupplyAll(ctx, &types.QueryAllZRC20SupplyRequest{})
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if request is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		_, err := k.ZRC20SupplyAll(ctx, nil)
+		require.Error(t, err)
+	})
+}
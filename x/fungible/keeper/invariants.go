@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+const (
+	// ZRC20ContractsInvariantName is the name of the invariant checking the ZRC20 contracts of the foreign coins
+	ZRC20ContractsInvariantName = "zrc20-contracts"
+
+	// ForeignCoinsInvariantName is the name of the invariant checking the registered foreign coins
+	ForeignCoinsInvariantName = "foreign-coins"
+
+	// GasStabilityPoolInvariantName is the name of the invariant checking the gas stability pool of each chain
+	GasStabilityPoolInvariantName = "gas-stability-pool"
+)
+
+// RegisterInvariants registers the fungible module invariants
+// Only the invariants holding on the state written by the previous releases are registered, the foreign coins of the
+// networks may have been registered twice or replaced in the system contract, the other invariants are run by the
+// tests with AllInvariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, ZRC20ContractsInvariantName, ZRC20ContractsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the fungible module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ZRC20ContractsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ForeignCoinsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return GasStabilityPoolInvariant(k)(ctx)
+	}
+}
+
+// ZRC20ContractsInvariant checks that each foreign coin has a deployed ZRC20 contract
+// and that the gas stability pool balance of each gas coin doesn't exceed its supply
+func ZRC20ContractsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// the queries are run on a cached context to leave the state untouched
+		cacheCtx, _ := ctx.CacheContext()
+		for _, fc := range k.GetAllForeignCoins(ctx) {
+			zrc20 := ethcommon.HexToAddress(fc.Zrc20ContractAddress)
+			acc := k.evmKeeper.GetAccount(ctx, zrc20)
+			if acc == nil || !acc.IsContract() {
+				broken = true
+				msg += fmt.Sprintf("\tzrc20 %s: contract not deployed\n", fc.Zrc20ContractAddress)
+				continue
+			}
+			if fc.CoinType != common.CoinType_Gas {
+				continue
+			}
+
+			balance, err := k.BalanceOfZRC4(cacheCtx, zrc20, types.GasStabilityPoolAddressEVM())
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tzrc20 %s: cannot query gas stability pool balance: %s\n", fc.Zrc20ContractAddress, err.Error())
+				continue
+			}
+			supply, err := k.TotalSupplyZRC4(cacheCtx, zrc20)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tzrc20 %s: cannot query supply: %s\n", fc.Zrc20ContractAddress, err.Error())
+				continue
+			}
+			if balance.Cmp(supply) > 0 {
+				broken = true
+				msg += fmt.Sprintf("\tzrc20 %s: gas stability pool balance %s exceeds supply %s\n", fc.Zrc20ContractAddress, balance, supply)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, ZRC20ContractsInvariantName, fmt.Sprintf("zrc20 contracts inconsistent:\n%s", msg)), broken
+	}
+}
+
+// ForeignCoinsInvariant checks that each chain has at most one gas coin and that an asset is registered at most once
+// per chain
+func ForeignCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		gasCoins := make(map[int64]string)
+		assets := make(map[string]string)
+		for _, fc := range k.GetAllForeignCoins(ctx) {
+			switch fc.CoinType {
+			case common.CoinType_Gas:
+				if other, found := gasCoins[fc.ForeignChainId]; found {
+					broken = true
+					msg += fmt.Sprintf("\tzrc20 %s: chain %d already has gas coin %s\n", fc.Zrc20ContractAddress, fc.ForeignChainId, other)
+				}
+				gasCoins[fc.ForeignChainId] = fc.Zrc20ContractAddress
+			case common.CoinType_ERC20:
+				key := fmt.Sprintf("%d-%s", fc.ForeignChainId, strings.ToLower(fc.Asset))
+				if other, found := assets[key]; found {
+					broken = true
+					msg += fmt.Sprintf("\tzrc20 %s: asset %s on chain %d already registered by %s\n", fc.Zrc20ContractAddress, fc.Asset, fc.ForeignChainId, other)
+				}
+				assets[key] = fc.Zrc20ContractAddress
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, ForeignCoinsInvariantName, fmt.Sprintf("foreign coins inconsistent:\n%s", msg)), broken
+	}
+}
+
+// GasStabilityPoolInvariant checks for each gas coin that the system contract references its ZRC20 as the gas coin of the chain,
+// which is the ZRC20 holding the gas stability pool
+// the invariant is skipped if the system contract is not deployed
+func GasStabilityPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if _, found := k.GetSystemContract(ctx); !found {
+			return sdk.FormatInvariant(types.ModuleName, GasStabilityPoolInvariantName, "system contract not deployed, nothing to check"), false
+		}
+
+		// the queries are run on a cached context to leave the state untouched
+		cacheCtx, _ := ctx.CacheContext()
+		for _, fc := range k.GetAllForeignCoins(ctx) {
+			if fc.CoinType != common.CoinType_Gas {
+				continue
+			}
+			chainID := fc.ForeignChainId
+
+			gasZRC20, err := k.QuerySystemContractGasCoinZRC20(cacheCtx, big.NewInt(chainID))
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tchain %d: cannot query gas coin: %s\n", chainID, err.Error())
+				continue
+			}
+			if gasZRC20 != ethcommon.HexToAddress(fc.Zrc20ContractAddress) {
+				broken = true
+				msg += fmt.Sprintf("\tchain %d: gas coin %s in system contract, %s in foreign coins\n", chainID, gasZRC20.Hex(), fc.Zrc20ContractAddress)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, GasStabilityPoolInvariantName, fmt.Sprintf("gas stability pool inconsistent:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/fungible/keeper"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestKeeper_ZRC20ContractsInvariant(t *testing.T) {
+	t.Run("should not be broken with a funded gas stability pool", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		require.NoError(t, k.FundGasStabilityPool(ctx, chainID, big.NewInt(100)))
+
+		_, broken := keeper.ZRC20ContractsInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the zrc20 contract is not deployed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.SetForeignCoins(ctx, sample.ForeignCoins(t, sample.EthAddress().Hex()))
+
+		msg, broken := keeper.ZRC20ContractsInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "contract not deployed")
+	})
+}
+
+func TestKeeper_ForeignCoinsInvariant(t *testing.T) {
+	t.Run("should not be broken with registered coins", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, k, sdkk.EvmKeeper, getValidChainID(t), "foobar", "foobar")
+
+		_, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if a chain has two gas coins", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+
+		// register a deployed contract as a second gas coin
+		systemContract, found := k.GetSystemContract(ctx)
+		require.True(t, found)
+		fc := sample.ForeignCoins(t, systemContract.SystemContract)
+		fc.ForeignChainId = chainID
+		fc.CoinType = common.CoinType_Gas
+		k.SetForeignCoins(ctx, fc)
+
+		msg, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "already has gas coin")
+	})
+
+	t.Run("should be broken if an asset is registered twice on a chain", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		systemContract, found := k.GetSystemContract(ctx)
+		require.True(t, found)
+
+		fc := sample.ForeignCoins(t, systemContract.SystemContract)
+		k.SetForeignCoins(ctx, fc)
+		duplicate := fc
+		duplicate.Zrc20ContractAddress = systemContract.ConnectorZevm
+		k.SetForeignCoins(ctx, duplicate)
+
+		msg, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "already registered")
+	})
+}
+
+func TestKeeper_GasStabilityPoolInvariant(t *testing.T) {
+	t.Run("should not be broken without system contract", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		_, broken := keeper.GasStabilityPoolInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should not be broken with a funded gas stability pool", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		require.NoError(t, k.FundGasStabilityPool(ctx, chainID, big.NewInt(100)))
+
+		_, broken := keeper.GasStabilityPoolInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if the gas coin differs from the system contract", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+
+		// the foreign coin is moved to a chain with no gas coin in the system contract
+		fc, found := k.GetForeignCoins(ctx, zrc20.Hex())
+		require.True(t, found)
+		fc.ForeignChainId = chainID + 1
+		k.SetForeignCoins(ctx, fc)
+
+		msg, broken := keeper.GasStabilityPoolInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "in system contract")
+	})
+}
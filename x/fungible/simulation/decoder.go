@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding fungible type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		var a, b codec.ProtoMarshaler
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ForeignCoinsKeyPrefix)):
+			a, b = &types.ForeignCoins{}, &types.ForeignCoins{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SystemContractKey)):
+			a, b = &types.SystemContract{}, &types.SystemContract{}
+		default:
+			panic(fmt.Sprintf("invalid fungible key prefix %X", kvA.Key))
+		}
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+}
@@ -0,0 +1,48 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/fungible/simulation"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keepertest.NewCodec()
+	dec := simulation.NewDecodeStore(cdc)
+
+	foreignCoins := sample.ForeignCoins(t, sample.EthAddress().Hex())
+	systemContract := sample.SystemContract()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefix(types.ForeignCoinsKeyPrefix), Value: cdc.MustMarshal(&foreignCoins)},
+			{Key: types.KeyPrefix(types.SystemContractKey), Value: cdc.MustMarshal(systemContract)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ForeignCoins", fmt.Sprintf("%v\n%v", &foreignCoins, &foreignCoins)},
+		{"SystemContract", fmt.Sprintf("%v\n%v", systemContract, systemContract)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedLog == "" {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// RandomizedGenState generates a GenesisState for the fungible module
+// The foreign coins and the system contract must be backed by deployed contracts, they are not set at genesis
+// but deployed by the operations of the simulation
+func RandomizedGenState(simState *module.SimulationState) {
+	fungibleGenesis := types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&fungibleGenesis)
+}
@@ -0,0 +1,259 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/fungible/keeper"
+	"github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// Simulation operation weights constants
+// #nosec G101 not hardcoded credentials
+const (
+	OpWeightMsgDeploySystemContracts        = "op_weight_msg_deploy_system_contracts"
+	OpWeightMsgDeployFungibleCoinZRC20      = "op_weight_msg_deploy_fungible_coin_zrc20"
+	OpWeightMsgUpdateZRC20PausedStatus      = "op_weight_msg_update_zrc20_paused_status"
+	OpWeightMsgUpdateZRC20WithdrawFee       = "op_weight_msg_update_zrc20_withdraw_fee"
+	DefaultWeightMsgDeploySystemContracts   = 20
+	DefaultWeightMsgDeployFungibleCoinZRC20 = 20
+	DefaultWeightMsgUpdateZRC20PausedStatus = 10
+	DefaultWeightMsgUpdateZRC20WithdrawFee  = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgDeploySystemContracts   int
+		weightMsgDeployFungibleCoinZRC20 int
+		weightMsgUpdateZRC20PausedStatus int
+		weightMsgUpdateZRC20WithdrawFee  int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeploySystemContracts, &weightMsgDeploySystemContracts, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeploySystemContracts = DefaultWeightMsgDeploySystemContracts
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeployFungibleCoinZRC20, &weightMsgDeployFungibleCoinZRC20, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeployFungibleCoinZRC20 = DefaultWeightMsgDeployFungibleCoinZRC20
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateZRC20PausedStatus, &weightMsgUpdateZRC20PausedStatus, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateZRC20PausedStatus = DefaultWeightMsgUpdateZRC20PausedStatus
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateZRC20WithdrawFee, &weightMsgUpdateZRC20WithdrawFee, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateZRC20WithdrawFee = DefaultWeightMsgUpdateZRC20WithdrawFee
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgDeploySystemContracts,
+			SimulateMsgDeploySystemContracts(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDeployFungibleCoinZRC20,
+			SimulateMsgDeployFungibleCoinZRC20(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateZRC20PausedStatus,
+			SimulateMsgUpdateZRC20PausedStatus(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateZRC20WithdrawFee,
+			SimulateMsgUpdateZRC20WithdrawFee(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgDeploySystemContracts generates a MsgDeploySystemContracts if the system contracts are not deployed yet
+func SimulateMsgDeploySystemContracts(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if _, found := k.GetSystemContract(ctx); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeploySystemContracts, "system contracts already deployed"), nil, nil
+		}
+		simAccount, found := adminAccount(ctx, k, observertypes.Policy_Type_group2, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeploySystemContracts, "admin policy account not found"), nil, nil
+		}
+
+		msg := types.NewMsgDeploySystemContracts(simAccount.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgDeployFungibleCoinZRC20 generates a MsgDeployFungibleCoinZRC20 for a random external chain
+// The gas coin of the chain is deployed first, then ZRC20 for random ERC20 assets
+func SimulateMsgDeployFungibleCoinZRC20(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if _, found := k.GetSystemContract(ctx); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeployFungibleCoinZRC20, "system contracts not deployed"), nil, nil
+		}
+		var externalChains []*common.Chain
+		for _, chain := range k.GetObserverKeeper().GetParams(ctx).GetSupportedChains() {
+			if chain.IsExternalChain() {
+				externalChains = append(externalChains, chain)
+			}
+		}
+		if len(externalChains) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeployFungibleCoinZRC20, "no supported external chain"), nil, nil
+		}
+		chain := externalChains[r.Intn(len(externalChains))]
+		simAccount, found := adminAccount(ctx, k, observertypes.Policy_Type_group2, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeployFungibleCoinZRC20, "admin policy account not found"), nil, nil
+		}
+
+		var (
+			coinType = common.CoinType_Gas
+			asset    = ""
+			decimals = uint32(18)
+			gasLimit = int64(21_000)
+		)
+		if common.IsBitcoinChain(chain.ChainId) {
+			decimals = 8
+			gasLimit = 100
+		}
+		if _, found := k.GetGasCoinForForeignCoin(ctx, chain.ChainId); found {
+			coinType = common.CoinType_ERC20
+			asset = randomEthAddress(r).Hex()
+			decimals = uint32(simtypes.RandIntBetween(r, 6, 19))
+			gasLimit = int64(simtypes.RandIntBetween(r, 21_000, 100_000))
+		}
+		name := simtypes.RandStringOfLength(r, 10)
+
+		msg := types.NewMsgDeployFungibleCoinZRC20(
+			simAccount.Address.String(),
+			asset,
+			chain.ChainId,
+			decimals,
+			name,
+			name[:3],
+			coinType,
+			gasLimit,
+		)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgUpdateZRC20PausedStatus generates a MsgUpdateZRC20PausedStatus to pause or unpause random ZRC20
+func SimulateMsgUpdateZRC20PausedStatus(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		foreignCoins := k.GetAllForeignCoins(ctx)
+		if len(foreignCoins) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateZRC20PausedStatus, "no foreign coin"), nil, nil
+		}
+
+		// pausing is done by the emergency group, unpausing requires the operational group
+		action := types.UpdatePausedStatusAction_PAUSE
+		policyType := observertypes.Policy_Type_group1
+		if r.Intn(2) == 0 {
+			action = types.UpdatePausedStatusAction_UNPAUSE
+			policyType = observertypes.Policy_Type_group2
+		}
+		simAccount, found := adminAccount(ctx, k, policyType, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateZRC20PausedStatus, "admin policy account not found"), nil, nil
+		}
+
+		r.Shuffle(len(foreignCoins), func(i, j int) {
+			foreignCoins[i], foreignCoins[j] = foreignCoins[j], foreignCoins[i]
+		})
+		zrc20s := make([]string, simtypes.RandIntBetween(r, 1, len(foreignCoins)+1))
+		for i := range zrc20s {
+			zrc20s[i] = foreignCoins[i].Zrc20ContractAddress
+		}
+
+		msg := types.NewMsgUpdateZRC20PausedStatus(simAccount.Address.String(), zrc20s, action)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgUpdateZRC20WithdrawFee generates a MsgUpdateZRC20WithdrawFee with a random fee and gas limit for a random ZRC20
+func SimulateMsgUpdateZRC20WithdrawFee(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		foreignCoins := k.GetAllForeignCoins(ctx)
+		if len(foreignCoins) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateZRC20WithdrawFee, "no foreign coin"), nil, nil
+		}
+		simAccount, found := adminAccount(ctx, k, observertypes.Policy_Type_group2, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateZRC20WithdrawFee, "admin policy account not found"), nil, nil
+		}
+		foreignCoin := foreignCoins[r.Intn(len(foreignCoins))]
+
+		msg := types.NewMsgUpdateZRC20WithdrawFee(
+			simAccount.Address.String(),
+			foreignCoin.Zrc20ContractAddress,
+			sdkmath.NewUint(uint64(r.Int63n(1_000_000_000))),
+			sdkmath.NewUint(uint64(simtypes.RandIntBetween(r, 21_000, 1_000_000))),
+		)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// deliverTx delivers a tx containing the message signed by the simulation account with random fees
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// adminAccount returns the simulation account of the admin policy
+func adminAccount(ctx sdk.Context, k keeper.Keeper, policyType observertypes.Policy_Type, accs []simtypes.Account) (simtypes.Account, bool) {
+	return FindAccount(accs, k.GetObserverKeeper().GetParams(ctx).GetAdminPolicyAccount(policyType))
+}
+
+// randomEthAddress returns a random EVM address
+func randomEthAddress(r *rand.Rand) ethcommon.Address {
+	b := make([]byte, ethcommon.AddressLength)
+	r.Read(b)
+	return ethcommon.BytesToAddress(b)
+}
@@ -696,6 +696,138 @@ func (m *QueryAllGasStabilityPoolBalanceResponse_Balance) GetBalance() string {
 	return ""
 }
 
+type QueryAllZRC20SupplyRequest struct {
+}
+
+func (m *QueryAllZRC20SupplyRequest) Reset()         { *m = QueryAllZRC20SupplyRequest{} }
+func (m *QueryAllZRC20SupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllZRC20SupplyRequest) ProtoMessage()    {}
+func (*QueryAllZRC20SupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d671b6e9298b37cd, []int{14}
+}
+func (m *QueryAllZRC20SupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllZRC20SupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllZRC20SupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllZRC20SupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllZRC20SupplyRequest.Merge(m, src)
+}
+func (m *QueryAllZRC20SupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllZRC20SupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllZRC20SupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllZRC20SupplyRequest proto.InternalMessageInfo
+
+type QueryAllZRC20SupplyResponse struct {
+	Supplies []QueryAllZRC20SupplyResponse_Supply `protobuf:"bytes,1,rep,name=supplies,proto3" json:"supplies"`
+}
+
+func (m *QueryAllZRC20SupplyResponse) Reset()         { *m = QueryAllZRC20SupplyResponse{} }
+func (m *QueryAllZRC20SupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllZRC20SupplyResponse) ProtoMessage()    {}
+func (*QueryAllZRC20SupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d671b6e9298b37cd, []int{15}
+}
+func (m *QueryAllZRC20SupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllZRC20SupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllZRC20SupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllZRC20SupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllZRC20SupplyResponse.Merge(m, src)
+}
+func (m *QueryAllZRC20SupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllZRC20SupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllZRC20SupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllZRC20SupplyResponse proto.InternalMessageInfo
+
+func (m *QueryAllZRC20SupplyResponse) GetSupplies() []QueryAllZRC20SupplyResponse_Supply {
+	if m != nil {
+		return m.Supplies
+	}
+	return nil
+}
+
+type QueryAllZRC20SupplyResponse_Supply struct {
+	ForeignCoin ForeignCoins `protobuf:"bytes,1,opt,name=foreign_coin,json=foreignCoin,proto3" json:"foreign_coin"`
+	TotalSupply string       `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) Reset()         { *m = QueryAllZRC20SupplyResponse_Supply{} }
+func (m *QueryAllZRC20SupplyResponse_Supply) String() string { return proto.CompactTextString(m) }
+func (*QueryAllZRC20SupplyResponse_Supply) ProtoMessage()    {}
+func (*QueryAllZRC20SupplyResponse_Supply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d671b6e9298b37cd, []int{15, 0}
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllZRC20SupplyResponse_Supply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllZRC20SupplyResponse_Supply.Merge(m, src)
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllZRC20SupplyResponse_Supply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllZRC20SupplyResponse_Supply proto.InternalMessageInfo
+
+func (m *QueryAllZRC20SupplyResponse_Supply) GetForeignCoin() ForeignCoins {
+	if m != nil {
+		return m.ForeignCoin
+	}
+	return ForeignCoins{}
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return ""
+}
+
 type QueryCodeHashRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -704,7 +836,7 @@ func (m *QueryCodeHashRequest) Reset()         { *m = QueryCodeHashRequest{} }
 func (m *QueryCodeHashRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeHashRequest) ProtoMessage()    {}
 func (*QueryCodeHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d671b6e9298b37cd, []int{14}
+	return fileDescriptor_d671b6e9298b37cd, []int{16}
 }
 func (m *QueryCodeHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,7 +880,7 @@ func (m *QueryCodeHashResponse) Reset()         { *m = QueryCodeHashResponse{} }
 func (m *QueryCodeHashResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeHashResponse) ProtoMessage()    {}
 func (*QueryCodeHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d671b6e9298b37cd, []int{15}
+	return fileDescriptor_d671b6e9298b37cd, []int{17}
 }
 func (m *QueryCodeHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,6 +932,9 @@ func init() {
 	proto.RegisterType((*QueryAllGasStabilityPoolBalance)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalance")
 	proto.RegisterType((*QueryAllGasStabilityPoolBalanceResponse)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalanceResponse")
 	proto.RegisterType((*QueryAllGasStabilityPoolBalanceResponse_Balance)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalanceResponse.Balance")
+	proto.RegisterType((*QueryAllZRC20SupplyRequest)(nil), "zetachain.zetacore.fungible.QueryAllZRC20SupplyRequest")
+	proto.RegisterType((*QueryAllZRC20SupplyResponse)(nil), "zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse")
+	proto.RegisterType((*QueryAllZRC20SupplyResponse_Supply)(nil), "zetachain.zetacore.fungible.QueryAllZRC20SupplyResponse.Supply")
 	proto.RegisterType((*QueryCodeHashRequest)(nil), "zetachain.zetacore.fungible.QueryCodeHashRequest")
 	proto.RegisterType((*QueryCodeHashResponse)(nil), "zetachain.zetacore.fungible.QueryCodeHashResponse")
 }
@@ -807,65 +942,72 @@ func init() {
 func init() { proto.RegisterFile("fungible/query.proto", fileDescriptor_d671b6e9298b37cd) }
 
 var fileDescriptor_d671b6e9298b37cd = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xa6, 0x34, 0x49, 0x5f, 0x43, 0x91, 0x06, 0x57, 0x84, 0x4d, 0xbb, 0x81, 0x69, 0x69,
-	0x4b, 0x28, 0xbb, 0x49, 0x8a, 0x44, 0x09, 0x11, 0xc2, 0x31, 0x6a, 0xa8, 0xc4, 0x21, 0x38, 0x17,
-	0xe0, 0x62, 0x8d, 0xd7, 0x93, 0xf5, 0x4a, 0xeb, 0x1d, 0xc7, 0xb3, 0x8e, 0x6a, 0xac, 0x5c, 0xb8,
-	0x72, 0xa9, 0xc4, 0x4f, 0xe0, 0x1f, 0x70, 0xe1, 0xc2, 0x0f, 0xe8, 0xb1, 0x12, 0x12, 0x82, 0x0b,
-	0x02, 0x87, 0x1f, 0x82, 0x3c, 0xfb, 0x66, 0xe3, 0x35, 0xbb, 0xde, 0xc5, 0xb9, 0xed, 0xcc, 0xbc,
-	0xef, 0xbd, 0xef, 0x7b, 0xf3, 0xfc, 0x8d, 0xa1, 0x72, 0xdc, 0x0f, 0x3d, 0xbf, 0x19, 0x70, 0xe7,
-	0xa4, 0xcf, 0x7b, 0x03, 0xbb, 0xdb, 0x13, 0x91, 0x20, 0xeb, 0xdf, 0xf2, 0x88, 0xb9, 0x6d, 0xe6,
-	0x87, 0xb6, 0xfa, 0x12, 0x3d, 0x6e, 0xeb, 0x40, 0x73, 0xd3, 0x15, 0xb2, 0x23, 0xa4, 0xd3, 0x64,
-	0x12, 0x51, 0xce, 0xe9, 0x76, 0x93, 0x47, 0x6c, 0xdb, 0xe9, 0x32, 0xcf, 0x0f, 0x59, 0xe4, 0x8b,
-	0x30, 0x4e, 0x64, 0xde, 0x4a, 0xd2, 0x1f, 0x8b, 0x1e, 0xf7, 0xbd, 0xb0, 0xe1, 0x0a, 0x3f, 0x94,
-	0x78, 0x7a, 0x33, 0x39, 0xed, 0xb2, 0x1e, 0xeb, 0xe8, 0x6d, 0x2b, 0xd9, 0x96, 0x03, 0x19, 0xf1,
-	0x4e, 0xc3, 0x15, 0x61, 0xd4, 0x63, 0x6e, 0x84, 0xe7, 0x15, 0x4f, 0x78, 0x42, 0x7d, 0x3a, 0xe3,
-	0x2f, 0x5d, 0xca, 0x13, 0xc2, 0x0b, 0xb8, 0xc3, 0xba, 0xbe, 0xc3, 0xc2, 0x50, 0x44, 0x8a, 0x07,
-	0xe6, 0xa4, 0x15, 0x20, 0x5f, 0x8e, 0xa9, 0x1e, 0xaa, 0x42, 0x75, 0x7e, 0xd2, 0xe7, 0x32, 0xa2,
-	0x5f, 0xc1, 0xeb, 0xa9, 0x5d, 0xd9, 0x15, 0xa1, 0xe4, 0xa4, 0x0a, 0x4b, 0x31, 0xa1, 0x35, 0xe3,
-	0x2d, 0xe3, 0xc1, 0xf5, 0x9d, 0x3b, 0xf6, 0x8c, 0x7e, 0xd8, 0x31, 0x78, 0xff, 0x95, 0x17, 0x7f,
-	0x6e, 0x2c, 0xd4, 0x11, 0x48, 0x1f, 0xc1, 0xba, 0xca, 0x7c, 0xc0, 0xa3, 0x27, 0xb1, 0xf2, 0xda,
-	0x58, 0x38, 0x16, 0x26, 0x15, 0xb8, 0xea, 0x87, 0x2d, 0xfe, 0x4c, 0x15, 0xb8, 0x56, 0x8f, 0x17,
-	0x54, 0xc2, 0xad, 0x6c, 0x10, 0xf2, 0x3a, 0x82, 0xd5, 0xe3, 0x89, 0x7d, 0x64, 0xf7, 0xee, 0x4c,
-	0x76, 0x93, 0x89, 0x90, 0x63, 0x2a, 0x09, 0xe5, 0xc8, 0xb4, 0x1a, 0x04, 0x59, 0x4c, 0x9f, 0x00,
-	0x5c, 0xdc, 0x2a, 0x56, 0xbc, 0x67, 0xc7, 0x23, 0x60, 0x8f, 0x47, 0xc0, 0x8e, 0x07, 0x07, 0x47,
-	0xc0, 0x3e, 0x64, 0x1e, 0x47, 0x6c, 0x7d, 0x02, 0x49, 0x7f, 0x31, 0x50, 0xdc, 0x7f, 0xea, 0xe4,
-	0x8a, 0xbb, 0x72, 0x69, 0x71, 0xe4, 0x20, 0xc5, 0x7e, 0x51, 0xb1, 0xbf, 0x5f, 0xc8, 0x3e, 0x66,
-	0x94, 0xa2, 0xbf, 0x01, 0xb7, 0xf5, 0xd5, 0x1c, 0xa9, 0xa1, 0xac, 0xe1, 0x4c, 0xea, 0x51, 0x1a,
-	0x82, 0x95, 0x17, 0x80, 0x02, 0xbf, 0x86, 0x1b, 0xe9, 0x13, 0xec, 0xe6, 0x7b, 0x33, 0x25, 0xa6,
-	0x21, 0x28, 0x72, 0x2a, 0x11, 0x7d, 0x1b, 0x36, 0x74, 0xf1, 0x03, 0x26, 0x8f, 0x22, 0xd6, 0xf4,
-	0x03, 0x3f, 0x1a, 0x1c, 0x0a, 0x11, 0x54, 0x5b, 0xad, 0x1e, 0x97, 0x92, 0x9e, 0xc0, 0xfd, 0x82,
-	0x90, 0x84, 0xe8, 0x3b, 0x70, 0x23, 0xee, 0x50, 0x83, 0xc5, 0x27, 0x38, 0xa5, 0xaf, 0xc6, 0xbb,
-	0x18, 0x4e, 0x36, 0xe0, 0x3a, 0x3f, 0xed, 0x24, 0x31, 0x8b, 0x2a, 0x06, 0xf8, 0x69, 0x47, 0x97,
-	0xdc, 0xcb, 0x67, 0xb5, 0xcf, 0x02, 0x16, 0xba, 0x9c, 0xbc, 0x09, 0x2b, 0x4a, 0x78, 0xc3, 0x6f,
-	0xa9, 0x22, 0x57, 0xea, 0xcb, 0x6a, 0xfd, 0xb4, 0x45, 0x6b, 0xf9, 0x84, 0x11, 0x9d, 0x10, 0x5e,
-	0x83, 0xe5, 0x66, 0xbc, 0x85, 0x2c, 0xf4, 0x32, 0x69, 0x4c, 0x35, 0x08, 0x72, 0x92, 0xd0, 0x3f,
-	0x0c, 0x2c, 0x94, 0x1f, 0x93, 0x14, 0x0a, 0x61, 0x05, 0x33, 0xeb, 0xf9, 0xfc, 0x62, 0xe6, 0xe5,
-	0x95, 0xcc, 0x6b, 0xe3, 0x1a, 0x6f, 0x37, 0xa9, 0x61, 0x7e, 0x02, 0xcb, 0xc5, 0x9d, 0x9a, 0x21,
-	0x7f, 0x0b, 0x2a, 0x8a, 0x42, 0x4d, 0xb4, 0xf8, 0xe7, 0x4c, 0xb6, 0xf5, 0x8f, 0x7a, 0x0d, 0x96,
-	0xd3, 0x57, 0xab, 0x97, 0xf4, 0x03, 0xb8, 0x39, 0x85, 0x40, 0xe9, 0xeb, 0x70, 0xcd, 0x15, 0x2d,
-	0xde, 0x68, 0x33, 0xd9, 0x46, 0xd0, 0x8a, 0x8b, 0x41, 0x3b, 0xdf, 0xaf, 0xc2, 0x55, 0x05, 0x23,
-	0xcf, 0x0d, 0x58, 0x8a, 0x0d, 0x91, 0x38, 0xc5, 0xad, 0x49, 0xb9, 0xb1, 0xb9, 0x55, 0x1e, 0x10,
-	0x93, 0xa2, 0x77, 0xbe, 0xfb, 0xf5, 0x9f, 0x1f, 0x16, 0x6f, 0x93, 0x75, 0x67, 0x1c, 0xff, 0xbe,
-	0x82, 0x3a, 0x53, 0x8f, 0x0a, 0xf9, 0xd9, 0x80, 0xd5, 0x49, 0xa3, 0x20, 0x8f, 0x8b, 0xeb, 0x64,
-	0xdb, 0xb6, 0xf9, 0xd1, 0x1c, 0x48, 0xa4, 0xba, 0xa3, 0xa8, 0x3e, 0x24, 0x9b, 0x99, 0x54, 0x53,
-	0xaf, 0xa3, 0x33, 0x54, 0xcf, 0xc1, 0x19, 0xf9, 0xc9, 0x80, 0xd7, 0x26, 0x93, 0x55, 0x83, 0xa0,
-	0x0c, 0xf9, 0x6c, 0x27, 0x2f, 0x43, 0x3e, 0xc7, 0x9b, 0xe9, 0xa6, 0x22, 0x7f, 0x97, 0xd0, 0x62,
-	0xf2, 0xe3, 0x76, 0x4f, 0xd9, 0x13, 0xd9, 0x2d, 0xd5, 0xb6, 0x4c, 0x5f, 0x35, 0x3f, 0x9e, 0x0b,
-	0x8b, 0xbc, 0x1f, 0x2a, 0xde, 0xf7, 0xc8, 0xdd, 0x4c, 0xde, 0x53, 0xff, 0x2e, 0xc8, 0x6f, 0x06,
-	0xbc, 0x91, 0xe3, 0x8d, 0x64, 0xaf, 0x14, 0x8d, 0x1c, 0xb4, 0xf9, 0xd9, 0x65, 0xd0, 0x89, 0x9a,
-	0x0f, 0x95, 0x9a, 0x6d, 0xe2, 0x64, 0xaa, 0xf1, 0x98, 0x6c, 0x48, 0x0d, 0x6f, 0x74, 0x85, 0x08,
-	0xb4, 0x35, 0x93, 0xbf, 0x33, 0x84, 0x69, 0x5f, 0x99, 0x4f, 0x18, 0xa2, 0xe7, 0x14, 0x36, 0x65,
-	0x7f, 0x74, 0x5f, 0x09, 0xdb, 0x23, 0xbb, 0x65, 0x85, 0xa1, 0xbf, 0x39, 0x43, 0x6d, 0x89, 0x67,
-	0x64, 0x64, 0x80, 0x99, 0x53, 0x67, 0xfc, 0xb3, 0xd9, 0xbb, 0x8c, 0x4f, 0x97, 0x91, 0x59, 0xec,
-	0xf2, 0xf4, 0x53, 0x25, 0x73, 0x97, 0x3c, 0x9e, 0x94, 0xa9, 0xd3, 0x95, 0xd1, 0x4b, 0x7e, 0x34,
-	0x60, 0x45, 0x3b, 0x33, 0xd9, 0x2e, 0x26, 0x35, 0xe5, 0xfb, 0xe6, 0xce, 0xff, 0x81, 0x20, 0xeb,
-	0x2d, 0xc5, 0x7a, 0x93, 0x3c, 0xc8, 0xbc, 0x9c, 0xe4, 0x4d, 0x70, 0x86, 0x38, 0x6d, 0x67, 0xfb,
-	0x4f, 0x5f, 0x8c, 0x2c, 0xe3, 0xe5, 0xc8, 0x32, 0xfe, 0x1a, 0x59, 0xc6, 0xf3, 0x73, 0x6b, 0xe1,
-	0xe5, 0xb9, 0xb5, 0xf0, 0xfb, 0xb9, 0xb5, 0xf0, 0x8d, 0xe3, 0xf9, 0x51, 0xbb, 0xdf, 0xb4, 0x5d,
-	0xd1, 0xc9, 0xec, 0xc1, 0xb3, 0x8b, 0xc4, 0xd1, 0xa0, 0xcb, 0x65, 0x73, 0x49, 0xfd, 0x7b, 0x7f,
-	0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x51, 0x58, 0xac, 0xa7, 0x0c, 0x00, 0x00,
+	// 1037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa6, 0x34, 0x71, 0x5f, 0xd2, 0x20, 0x0d, 0xae, 0x08, 0xeb, 0xd4, 0x21, 0x9b, 0xd2,
+	0xb4, 0xa1, 0xec, 0x3a, 0x2e, 0x52, 0xd3, 0x10, 0x01, 0x8e, 0xa3, 0x86, 0x4a, 0x1c, 0x82, 0x73,
+	0x81, 0x5e, 0xac, 0xf1, 0x7a, 0xb2, 0x5e, 0x69, 0xbd, 0xe3, 0x78, 0xd6, 0x51, 0xdd, 0x28, 0x42,
+	0xf0, 0x09, 0x2a, 0xf1, 0x11, 0xb8, 0x73, 0xe0, 0xc2, 0xa5, 0x1f, 0xa0, 0xc7, 0x4a, 0x48, 0x08,
+	0x2e, 0x08, 0x12, 0x3e, 0x08, 0xf2, 0xcc, 0x9b, 0xad, 0x6d, 0xad, 0xff, 0xc4, 0xbe, 0xed, 0xcc,
+	0xbc, 0xdf, 0x7b, 0xbf, 0xdf, 0x9b, 0xb7, 0xfb, 0xb3, 0x21, 0x7d, 0xdc, 0x0a, 0x3d, 0xbf, 0x12,
+	0x30, 0xe7, 0xa4, 0xc5, 0x9a, 0x6d, 0xbb, 0xd1, 0xe4, 0x11, 0x27, 0x99, 0x17, 0x2c, 0xa2, 0x6e,
+	0x8d, 0xfa, 0xa1, 0x2d, 0x9f, 0x78, 0x93, 0xd9, 0x3a, 0xd0, 0xdc, 0x74, 0xb9, 0xa8, 0x73, 0xe1,
+	0x54, 0xa8, 0x40, 0x94, 0x73, 0xba, 0x55, 0x61, 0x11, 0xdd, 0x72, 0x1a, 0xd4, 0xf3, 0x43, 0x1a,
+	0xf9, 0x3c, 0x54, 0x89, 0xcc, 0x95, 0x38, 0xfd, 0x31, 0x6f, 0x32, 0xdf, 0x0b, 0xcb, 0x2e, 0xf7,
+	0x43, 0x81, 0xa7, 0xb7, 0xe2, 0xd3, 0x06, 0x6d, 0xd2, 0xba, 0xde, 0xce, 0xc6, 0xdb, 0xa2, 0x2d,
+	0x22, 0x56, 0x2f, 0xbb, 0x3c, 0x8c, 0x9a, 0xd4, 0x8d, 0xf0, 0x3c, 0xed, 0x71, 0x8f, 0xcb, 0x47,
+	0xa7, 0xf3, 0xa4, 0x4b, 0x79, 0x9c, 0x7b, 0x01, 0x73, 0x68, 0xc3, 0x77, 0x68, 0x18, 0xf2, 0x48,
+	0xf2, 0xc0, 0x9c, 0x56, 0x1a, 0xc8, 0x37, 0x1d, 0xaa, 0x87, 0xb2, 0x50, 0x89, 0x9d, 0xb4, 0x98,
+	0x88, 0xac, 0x6f, 0xe1, 0xbd, 0x9e, 0x5d, 0xd1, 0xe0, 0xa1, 0x60, 0xa4, 0x00, 0x73, 0x8a, 0xd0,
+	0xb2, 0xf1, 0xa1, 0x71, 0x6f, 0x21, 0xbf, 0x6e, 0x0f, 0xe9, 0x87, 0xad, 0xc0, 0x7b, 0xef, 0xbc,
+	0xfe, 0x7b, 0x75, 0xa6, 0x84, 0x40, 0xeb, 0x21, 0x64, 0x64, 0xe6, 0x03, 0x16, 0x3d, 0x51, 0xca,
+	0x8b, 0x1d, 0xe1, 0x58, 0x98, 0xa4, 0xe1, 0xba, 0x1f, 0x56, 0xd9, 0x73, 0x59, 0xe0, 0x46, 0x49,
+	0x2d, 0x2c, 0x01, 0x2b, 0xc9, 0x20, 0xe4, 0x75, 0x04, 0x8b, 0xc7, 0x5d, 0xfb, 0xc8, 0xee, 0xfe,
+	0x50, 0x76, 0xdd, 0x89, 0x90, 0x63, 0x4f, 0x12, 0x8b, 0x21, 0xd3, 0x42, 0x10, 0x24, 0x31, 0x7d,
+	0x02, 0xf0, 0xf6, 0x56, 0xb1, 0xe2, 0x5d, 0x5b, 0x8d, 0x80, 0xdd, 0x19, 0x01, 0x5b, 0x0d, 0x0e,
+	0x8e, 0x80, 0x7d, 0x48, 0x3d, 0x86, 0xd8, 0x52, 0x17, 0xd2, 0x7a, 0x65, 0xc0, 0x4a, 0x72, 0x9d,
+	0x81, 0xe2, 0xae, 0x4d, 0x2d, 0x8e, 0x1c, 0xf4, 0xb0, 0x9f, 0x95, 0xec, 0x37, 0x46, 0xb2, 0x57,
+	0x8c, 0x7a, 0xe8, 0xaf, 0xc2, 0x6d, 0x7d, 0x35, 0x47, 0x72, 0x28, 0x8b, 0x38, 0x93, 0x7a, 0x94,
+	0xce, 0x20, 0x3b, 0x28, 0x00, 0x05, 0x7e, 0x07, 0x4b, 0xbd, 0x27, 0xd8, 0xcd, 0x8f, 0x87, 0x4a,
+	0xec, 0x85, 0xa0, 0xc8, 0xbe, 0x44, 0xd6, 0x1a, 0xac, 0xea, 0xe2, 0x07, 0x54, 0x1c, 0x45, 0xb4,
+	0xe2, 0x07, 0x7e, 0xd4, 0x3e, 0xe4, 0x3c, 0x28, 0x54, 0xab, 0x4d, 0x26, 0x84, 0x75, 0x02, 0x1b,
+	0x23, 0x42, 0x62, 0xa2, 0x1f, 0xc1, 0x92, 0xea, 0x50, 0x99, 0xaa, 0x13, 0x9c, 0xd2, 0x9b, 0x6a,
+	0x17, 0xc3, 0xc9, 0x2a, 0x2c, 0xb0, 0xd3, 0x7a, 0x1c, 0x33, 0x2b, 0x63, 0x80, 0x9d, 0xd6, 0x75,
+	0xc9, 0xdd, 0xc1, 0xac, 0xf6, 0x68, 0x40, 0x43, 0x97, 0x91, 0x0f, 0x20, 0x25, 0x85, 0x97, 0xfd,
+	0xaa, 0x2c, 0x72, 0xad, 0x34, 0x2f, 0xd7, 0x4f, 0xab, 0x56, 0x11, 0x36, 0x46, 0xa0, 0x63, 0xc2,
+	0xcb, 0x30, 0x5f, 0x51, 0x5b, 0xc8, 0x42, 0x2f, 0xe3, 0xc6, 0x14, 0x82, 0x60, 0x40, 0x12, 0xeb,
+	0x2f, 0x03, 0x36, 0x46, 0xc4, 0xc4, 0x85, 0x42, 0x48, 0x61, 0x66, 0x3d, 0x9f, 0x5f, 0x0f, 0xbd,
+	0xbc, 0x31, 0xf3, 0xda, 0xb8, 0xc6, 0xdb, 0x8d, 0x6b, 0x98, 0x9f, 0xc3, 0xfc, 0xe8, 0x4e, 0x0d,
+	0x91, 0xbf, 0x02, 0xa6, 0xa6, 0xf0, 0xac, 0x54, 0xcc, 0xe7, 0x8e, 0x5a, 0x8d, 0x46, 0xd0, 0xd6,
+	0x23, 0xfb, 0xc3, 0x2c, 0x64, 0x12, 0x8f, 0x51, 0x2d, 0x85, 0x94, 0xe8, 0xec, 0xf8, 0xb1, 0xda,
+	0x2f, 0xc6, 0x52, 0x9b, 0x90, 0xcb, 0x56, 0x4b, 0x2d, 0x50, 0xa7, 0x35, 0xbf, 0x87, 0x39, 0x75,
+	0x42, 0x4a, 0xb0, 0xd8, 0x6d, 0x11, 0x93, 0x7e, 0xdb, 0x16, 0xba, 0x5e, 0x7f, 0xb2, 0x06, 0x8b,
+	0x11, 0x8f, 0x68, 0x50, 0x96, 0xf5, 0xda, 0xd8, 0x9d, 0x05, 0xb9, 0xa7, 0xca, 0x5a, 0x39, 0x48,
+	0x4b, 0xda, 0x45, 0x5e, 0x65, 0x5f, 0x51, 0x51, 0xd3, 0x9f, 0xbd, 0x65, 0x98, 0xef, 0x1d, 0x7e,
+	0xbd, 0xb4, 0x3e, 0x85, 0x5b, 0x7d, 0x08, 0x6c, 0x57, 0x06, 0x6e, 0xb8, 0xbc, 0xca, 0xca, 0x35,
+	0x2a, 0x6a, 0x08, 0x4a, 0xb9, 0x18, 0x94, 0x7f, 0x75, 0x13, 0xae, 0x4b, 0x18, 0x79, 0x69, 0xc0,
+	0x9c, 0xb2, 0x0c, 0xe2, 0x8c, 0x6e, 0x67, 0x8f, 0x5f, 0x99, 0xb9, 0xf1, 0x01, 0x8a, 0x94, 0xb5,
+	0xfe, 0xe3, 0xef, 0xff, 0xfd, 0x34, 0x7b, 0x9b, 0x64, 0x9c, 0x4e, 0xfc, 0x27, 0x12, 0xea, 0xf4,
+	0xd9, 0x2e, 0xf9, 0xcd, 0x80, 0xc5, 0xee, 0x5e, 0x92, 0xed, 0xd1, 0x75, 0x92, 0x8d, 0xcd, 0x7c,
+	0x3c, 0x01, 0x12, 0xa9, 0xe6, 0x25, 0xd5, 0x07, 0x64, 0x33, 0x91, 0x6a, 0xcf, 0xef, 0x07, 0xe7,
+	0x4c, 0x1a, 0xe6, 0x39, 0xf9, 0xd5, 0x80, 0x77, 0xbb, 0x93, 0x15, 0x82, 0x60, 0x1c, 0xf2, 0xc9,
+	0x5e, 0x67, 0x3e, 0x9e, 0x00, 0x89, 0xe4, 0x37, 0x25, 0xf9, 0x3b, 0xc4, 0x1a, 0x4d, 0xbe, 0xd3,
+	0xee, 0xbe, 0x0f, 0x38, 0xd9, 0x19, 0xab, 0x6d, 0x89, 0xce, 0x63, 0x7e, 0x36, 0x11, 0x16, 0x79,
+	0x3f, 0x90, 0xbc, 0xef, 0x92, 0x3b, 0x89, 0xbc, 0xfb, 0x7e, 0x7f, 0x91, 0x3f, 0x0c, 0x78, 0x7f,
+	0x80, 0x7b, 0x90, 0xdd, 0xb1, 0x68, 0x0c, 0x40, 0x9b, 0xfb, 0xd3, 0xa0, 0x63, 0x35, 0x8f, 0xa4,
+	0x9a, 0x2d, 0xe2, 0x24, 0xaa, 0xf1, 0xa8, 0x28, 0x0b, 0x0d, 0x2f, 0x37, 0x38, 0x0f, 0xb4, 0x79,
+	0x91, 0x7f, 0x13, 0x84, 0xe9, 0x2f, 0xef, 0x64, 0xc2, 0x10, 0x6d, 0xee, 0x4f, 0x83, 0x8e, 0x85,
+	0xed, 0x49, 0x61, 0xbb, 0x64, 0x67, 0x5c, 0x61, 0xe8, 0x00, 0xce, 0x99, 0x36, 0x8d, 0x73, 0x72,
+	0x61, 0x80, 0x39, 0xa0, 0x4e, 0xe7, 0xb5, 0xd9, 0x9d, 0xc6, 0xc9, 0xcc, 0xfd, 0x69, 0xd0, 0xb1,
+	0xcc, 0x2f, 0xa5, 0xcc, 0x1d, 0xb2, 0xdd, 0x2d, 0x53, 0xa7, 0x1b, 0x47, 0x2f, 0xf9, 0xc5, 0x80,
+	0xa5, 0x2e, 0xff, 0xe9, 0x08, 0x7b, 0x74, 0x75, 0xd3, 0x52, 0x2f, 0xd6, 0xf6, 0xa4, 0x6e, 0x67,
+	0xdd, 0x97, 0x3a, 0xd6, 0xc9, 0x5a, 0xe2, 0x75, 0xbd, 0x68, 0xba, 0xf9, 0x1c, 0x7a, 0x12, 0xf9,
+	0xd9, 0x80, 0x94, 0xb6, 0x12, 0xb2, 0x35, 0xba, 0x62, 0x9f, 0x51, 0x99, 0xf9, 0xab, 0x40, 0x90,
+	0x5e, 0x4e, 0xd2, 0xdb, 0x24, 0xf7, 0x12, 0xe9, 0xc5, 0x26, 0xe6, 0x9c, 0xe1, 0xeb, 0x71, 0xbe,
+	0xf7, 0xf4, 0xf5, 0x45, 0xd6, 0x78, 0x73, 0x91, 0x35, 0xfe, 0xb9, 0xc8, 0x1a, 0x2f, 0x2f, 0xb3,
+	0x33, 0x6f, 0x2e, 0xb3, 0x33, 0x7f, 0x5e, 0x66, 0x67, 0x9e, 0x39, 0x9e, 0x1f, 0xd5, 0x5a, 0x15,
+	0xdb, 0xe5, 0xf5, 0xc4, 0x4b, 0x7b, 0xfe, 0x36, 0x71, 0xd4, 0x6e, 0x30, 0x51, 0x99, 0x93, 0x7f,
+	0xc8, 0x1e, 0xfe, 0x3f, 0x00, 0x1f, 0xde, 0x91, 0xfb, 0x7a, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -894,6 +1036,8 @@ type QueryClient interface {
 	GasStabilityPoolBalance(ctx context.Context, in *QueryGetGasStabilityPoolBalance, opts ...grpc.CallOption) (*QueryGetGasStabilityPoolBalanceResponse, error)
 	// Queries all gas stability pool balances.
 	GasStabilityPoolBalanceAll(ctx context.Context, in *QueryAllGasStabilityPoolBalance, opts ...grpc.CallOption) (*QueryAllGasStabilityPoolBalanceResponse, error)
+	// Queries the total supply of all ZRC20 tokens.
+	ZRC20SupplyAll(ctx context.Context, in *QueryAllZRC20SupplyRequest, opts ...grpc.CallOption) (*QueryAllZRC20SupplyResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(ctx context.Context, in *QueryCodeHashRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
 }
@@ -969,6 +1113,15 @@ func (c *queryClient) GasStabilityPoolBalanceAll(ctx context.Context, in *QueryA
 	return out, nil
 }
 
+func (c *queryClient) ZRC20SupplyAll(ctx context.Context, in *QueryAllZRC20SupplyRequest, opts ...grpc.CallOption) (*QueryAllZRC20SupplyResponse, error) {
+	out := new(QueryAllZRC20SupplyResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/ZRC20SupplyAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CodeHash(ctx context.Context, in *QueryCodeHashRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
 	out := new(QueryCodeHashResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/CodeHash", in, out, opts...)
@@ -994,6 +1147,8 @@ type QueryServer interface {
 	GasStabilityPoolBalance(context.Context, *QueryGetGasStabilityPoolBalance) (*QueryGetGasStabilityPoolBalanceResponse, error)
 	// Queries all gas stability pool balances.
 	GasStabilityPoolBalanceAll(context.Context, *QueryAllGasStabilityPoolBalance) (*QueryAllGasStabilityPoolBalanceResponse, error)
+	// Queries the total supply of all ZRC20 tokens.
+	ZRC20SupplyAll(context.Context, *QueryAllZRC20SupplyRequest) (*QueryAllZRC20SupplyResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(context.Context, *QueryCodeHashRequest) (*QueryCodeHashResponse, error)
 }
@@ -1023,6 +1178,9 @@ func (*UnimplementedQueryServer) GasStabilityPoolBalance(ctx context.Context, re
 func (*UnimplementedQueryServer) GasStabilityPoolBalanceAll(ctx context.Context, req *QueryAllGasStabilityPoolBalance) (*QueryAllGasStabilityPoolBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasStabilityPoolBalanceAll not implemented")
 }
+func (*UnimplementedQueryServer) ZRC20SupplyAll(ctx context.Context, req *QueryAllZRC20SupplyRequest) (*QueryAllZRC20SupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRC20SupplyAll not implemented")
+}
 func (*UnimplementedQueryServer) CodeHash(ctx context.Context, req *QueryCodeHashRequest) (*QueryCodeHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeHash not implemented")
 }
@@ -1157,6 +1315,24 @@ func _Query_GasStabilityPoolBalanceAll_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ZRC20SupplyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllZRC20SupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZRC20SupplyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/ZRC20SupplyAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZRC20SupplyAll(ctx, req.(*QueryAllZRC20SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CodeHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeHashRequest)
 	if err := dec(in); err != nil {
@@ -1207,6 +1383,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasStabilityPoolBalanceAll",
 			Handler:    _Query_GasStabilityPoolBalanceAll_Handler,
 		},
+		{
+			MethodName: "ZRC20SupplyAll",
+			Handler:    _Query_ZRC20SupplyAll_Handler,
+		},
 		{
 			MethodName: "CodeHash",
 			Handler:    _Query_CodeHash_Handler,
@@ -1688,6 +1868,106 @@ func (m *QueryAllGasStabilityPoolBalanceResponse_Balance) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllZRC20SupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllZRC20SupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllZRC20SupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllZRC20SupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllZRC20SupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllZRC20SupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ForeignCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeHashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1946,6 +2226,45 @@ func (m *QueryAllGasStabilityPoolBalanceResponse_Balance) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllZRC20SupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllZRC20SupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for _, e := range m.Supplies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllZRC20SupplyResponse_Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForeignCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCodeHashRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3165,6 +3484,255 @@ func (m *QueryAllGasStabilityPoolBalanceResponse_Balance) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *QueryAllZRC20SupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllZRC20SupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllZRC20SupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllZRC20SupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllZRC20SupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllZRC20SupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, QueryAllZRC20SupplyResponse_Supply{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllZRC20SupplyResponse_Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForeignCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCodeHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
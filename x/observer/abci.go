@@ -9,6 +9,8 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UpdateObserverLiveness(ctx)
+
 	lastBlockObserverCount, found := k.GetLastObserverCount(ctx)
 	if !found {
 		ctx.Logger().Error("LastBlockObserverCount not found at height", ctx.BlockHeight())
@@ -37,3 +39,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// #nosec G701 always positive
 	k.SetLastObserverCount(ctx, &types.LastObserverCount{Count: uint64(totalObserverCountCurrentBlock), LastChangeHeight: ctx.BlockHeight()})
 }
+
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneBlockHeaders(ctx)
+}
@@ -36,6 +36,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowNodeAccount(),
 		CmdShowCrosschainFlags(),
 		CmdShowKeygen(),
+		CmdListKeygenAttempts(),
+		CmdShowKeygenAttempt(),
+		CmdShowLivenessParams(),
+		CmdListObserverLiveness(),
+		CmdShowObserverLiveness(),
 		CmdShowObserverCount(),
 		CmdBlameByIdentifier(),
 		CmdGetAllBlameRecords(),
@@ -47,6 +52,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListChainNonces(),
 		CmdShowChainNonces(),
 		CmdListPendingNonces(),
+		CmdShowLightClientState(),
+		CmdListPrunedBlockHeaderRanges(),
 	)
 
 	return cmd
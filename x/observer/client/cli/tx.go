@@ -25,8 +25,15 @@ func GetTxCmd() *cobra.Command {
 		CmdUpdateCoreParams(),
 		CmdUpdateCrosschainFlags(),
 		CmdUpdateKeygen(),
+		CmdApproveKeygenRetry(),
+		CmdUpdateLivenessParams(),
+		CmdUnjailObserver(),
 		CmdAddBlameVote(),
 		CmdUpdateObserver(),
+		CmdInitLightClient(),
+		CmdSubmitLightClientUpdate(),
+		CmdPauseOutbound(),
+		CmdResumeOutbound(),
 		CmdEncode(),
 	)
 
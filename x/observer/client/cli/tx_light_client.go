@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func CmdInitLightClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-light-client [chain-id] [bootstrap.json]",
+		Short: "command to initialize the beacon chain light client of a chain from a bootstrap served by a beacon node via a group proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			input, err := readJSONFile(args[1])
+			if err != nil {
+				return err
+			}
+			bootstrap, err := types.ParseBeaconAPILightClientBootstrap(input)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInitLightClient(clientCtx.GetFromAddress().String(), argChainID, bootstrap)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitLightClientUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-light-client-update [chain-id] [update.json]",
+		Short: "command to submit a light client update or finality update served by a beacon node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			input, err := readJSONFile(args[1])
+			if err != nil {
+				return err
+			}
+			update, err := types.ParseBeaconAPILightClientUpdate(input)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitLightClientUpdate(clientCtx.GetFromAddress().String(), argChainID, update)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readJSONFile(path string) ([]byte, error) {
+	file, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	file = filepath.Clean(file)
+	return os.ReadFile(file) // #nosec G304
+}
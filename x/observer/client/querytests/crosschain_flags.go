@@ -43,8 +43,8 @@ func (s *CliTestSuite) TestShowCrosschainFlags() {
 				s.Require().NoError(s.network.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 				s.Require().NotNil(resp.CrosschainFlags)
 				tc := tc
-				s.Require().Equal(nullify.Fill(&tc.obj),
-					nullify.Fill(&resp.CrosschainFlags),
+				s.Require().Equal(nullify.Fill(tc.obj),
+					nullify.Fill(resp.CrosschainFlags),
 				)
 			}
 		})
@@ -121,6 +121,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.NonceToCctx {
 		k.SetNonceToCctx(ctx, elem)
 	}
+	for _, elem := range genState.KeygenAttempts {
+		k.SetKeygenAttempt(ctx, elem)
+	}
+
+	if genState.LivenessParams != nil {
+		k.SetLivenessParams(ctx, *genState.LivenessParams)
+	}
+	for _, elem := range genState.ObserverLiveness {
+		k.SetObserverLiveness(ctx, elem)
+	}
 
 }
 
@@ -167,6 +177,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		tss = &t
 	}
 
+	livenessParams := k.GetLivenessParams(ctx)
+
 	var pendingNonces []types.PendingNonces
 	p, err := k.GetAllPendingNonces(ctx)
 	if err == nil {
@@ -188,5 +200,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		BlameList:         k.GetAllBlame(ctx),
 		ChainNonces:       k.GetAllChainNonces(ctx),
 		NonceToCctx:       k.GetAllNonceToCctx(ctx),
+		KeygenAttempts:    k.GetAllKeygenAttempts(ctx),
+		LivenessParams:    &livenessParams,
+		ObserverLiveness:  k.GetAllObserverLiveness(ctx),
 	}
 }
@@ -43,8 +43,16 @@ func TestGenesis(t *testing.T) {
 			sample.ChainNonces(t, "1"),
 			sample.ChainNonces(t, "2"),
 		},
-		PendingNonces: sample.PendingNoncesList(t, "sample", 20),
-		NonceToCctx:   sample.NonceToCctxList(t, "sample", 20),
+		PendingNonces:  sample.PendingNoncesList(t, "sample", 20),
+		NonceToCctx:    sample.NonceToCctxList(t, "sample", 20),
+		KeygenAttempts: sample.KeygenAttemptList(t, 5),
+		LivenessParams: &types.LivenessParams{
+			WindowSize:         50,
+			WarningMissedVotes: 10,
+			MaxMissedVotes:     20,
+			JailDuration:       1000,
+		},
+		ObserverLiveness: sample.ObserverLivenessList(t, 5),
 	}
 
 	// Init and export
@@ -61,6 +61,38 @@ func (k Keeper) AddBallotToList(ctx sdk.Context, ballot types.Ballot) {
 	k.SetBallotList(ctx, &list)
 }
 
+// RemoveBallot removes a ballot and its index from the list of ballots of its creation height
+func (k Keeper) RemoveBallot(ctx sdk.Context, index string) {
+	ballot, found := k.GetBallot(ctx, index)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+	k.removeBallotFromList(ctx, ballot.BallotCreationHeight, index)
+}
+
+// removeBallotFromList removes a ballot from the list of ballots for a given height, the empty list is removed
+func (k Keeper) removeBallotFromList(ctx sdk.Context, height int64, index string) {
+	list, found := k.GetBallotList(ctx, height)
+	if !found {
+		return
+	}
+	indexes := make([]string, 0, len(list.BallotsIndexList))
+	for _, ballotIndex := range list.BallotsIndexList {
+		if ballotIndex != index {
+			indexes = append(indexes, ballotIndex)
+		}
+	}
+	if len(indexes) == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+		store.Delete(types.BallotListKeyPrefix(height))
+		return
+	}
+	list.BallotsIndexList = indexes
+	k.SetBallotList(ctx, &list)
+}
+
 // GetMaturedBallotList Returns a list of ballots which are matured at current height
 func (k Keeper) GetMaturedBallotList(ctx sdk.Context) []string {
 	maturityBlocks := k.GetParams(ctx).BallotMaturityBlocks
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -21,3 +22,24 @@ func TestKeeper_GetBallot(t *testing.T) {
 
 	k.GetBallot(ctx, identifier)
 }
+
+func TestKeeper_RemoveBallot(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	for _, identifier := range []string{"ballot1", "ballot2"} {
+		ballot := types.Ballot{BallotIdentifier: identifier, BallotCreationHeight: 10}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+
+	k.RemoveBallot(ctx, "ballot1")
+	_, found := k.GetBallot(ctx, "ballot1")
+	require.False(t, found)
+	list, found := k.GetBallotList(ctx, 10)
+	require.True(t, found)
+	require.Equal(t, []string{"ballot2"}, list.BallotsIndexList)
+
+	// the empty list is removed
+	k.RemoveBallot(ctx, "ballot2")
+	_, found = k.GetBallotList(ctx, 10)
+	require.False(t, found)
+}
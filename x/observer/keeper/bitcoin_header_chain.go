@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// maxBestChainIndexDepth is the maximum number of block headers indexed when the best chain changes, the block headers
+// below are not indexed and the proofs against them are rejected
+const maxBestChainIndexDepth = 1000
+
+// SetBlockChainWork sets the cumulative work of the header chain ending at a block header
+func (k Keeper) SetBlockChainWork(ctx sdk.Context, chainWork types.BlockChainWork) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockChainWorkKey))
+	b := k.cdc.MustMarshal(&chainWork)
+	store.Set(chainWork.BlockHash, b)
+}
+
+// GetBlockChainWork returns the cumulative work of the header chain ending at a block header from its hash
+func (k Keeper) GetBlockChainWork(ctx sdk.Context, hash []byte) (val types.BlockChainWork, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockChainWorkKey))
+
+	b := store.Get(hash)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBlockChainWork removes the cumulative work of the header chain ending at a block header
+func (k Keeper) RemoveBlockChainWork(ctx sdk.Context, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockChainWorkKey))
+	store.Delete(hash)
+}
+
+// SetBestChainBlockHash sets the hash of the block header of the best chain at a height
+func (k Keeper) SetBestChainBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKey))
+	store.Set(bestChainBlockHashKey(chainID, height), hash)
+}
+
+// GetBestChainBlockHash returns the hash of the block header of the best chain at a height
+func (k Keeper) GetBestChainBlockHash(ctx sdk.Context, chainID int64, height int64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKey))
+	hash := store.Get(bestChainBlockHashKey(chainID, height))
+	return hash, hash != nil
+}
+
+// RemoveBestChainBlockHash removes the hash of the block header of the best chain at a height
+func (k Keeper) RemoveBestChainBlockHash(ctx sdk.Context, chainID int64, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKey))
+	store.Delete(bestChainBlockHashKey(chainID, height))
+}
+
+func bestChainBlockHashKey(chainID int64, height int64) []byte {
+	return types.KeyPrefix(fmt.Sprintf("%d-%d", chainID, height))
+}
+
+// GetBlockHeaderAncestor returns the ancestor at a height of a block header
+// false is returned if a block header between them is not in the store
+func (k Keeper) GetBlockHeaderAncestor(ctx sdk.Context, header common.BlockHeader, height int64) (common.BlockHeader, bool) {
+	for header.Height > height {
+		// the ancestor of a block header of the best chain is indexed
+		if hash, found := k.GetBestChainBlockHash(ctx, header.ChainId, header.Height); found && bytes.Equal(hash, header.Hash) {
+			ancestorHash, found := k.GetBestChainBlockHash(ctx, header.ChainId, height)
+			if !found {
+				return common.BlockHeader{}, false
+			}
+			return k.GetBlockHeader(ctx, ancestorHash)
+		}
+
+		parent, found := k.GetBlockHeader(ctx, header.ParentHash)
+		if !found {
+			return common.BlockHeader{}, false
+		}
+		header = parent
+	}
+	return header, header.Height == height
+}
+
+// ValidateBitcoinHeaderDifficulty checks the target of a Bitcoin block header follows the difficulty transitions of the
+// network from its parent block header
+// The block header is rejected if the block headers required to compute its target are not in the store, except for
+// a retarget block whose retarget interval starts below the stored block headers, its target is then only checked
+// against the maximum adjustment of the target of its parent
+func (k Keeper) ValidateBitcoinHeaderDifficulty(ctx sdk.Context, header *wire.BlockHeader, height int64, parent common.BlockHeader) error {
+	params, err := common.BitcoinNetParamsFromChainID(parent.ChainId)
+	if err != nil {
+		return err
+	}
+	parentHeader, err := parent.Header.BitcoinHeader()
+	if err != nil {
+		return err
+	}
+
+	expectedBits, known := k.expectedBitcoinBits(ctx, params, header, height, parent, parentHeader)
+	if !known {
+		blocksPerRetarget := common.BitcoinBlocksPerRetarget(params)
+		if height%blocksPerRetarget != 0 || !k.isBelowStoredBlockHeaders(ctx, parent.ChainId, height-blocksPerRetarget) {
+			return cosmoserrors.Wrapf(
+				types.ErrInvalidBlockHeaderDifficulty,
+				"target %08x of block %d cannot be checked, the block headers of its ancestry are not in the store",
+				header.Bits,
+				height,
+			)
+		}
+		if !common.IsBitcoinRetargetBitsInBounds(params, parentHeader.Bits, header.Bits) {
+			return cosmoserrors.Wrapf(
+				types.ErrInvalidBlockHeaderDifficulty,
+				"target %08x of retarget block %d exceeds the maximum adjustment of target %08x",
+				header.Bits,
+				height,
+				parentHeader.Bits,
+			)
+		}
+		return nil
+	}
+	if header.Bits != expectedBits {
+		return cosmoserrors.Wrapf(
+			types.ErrInvalidBlockHeaderDifficulty,
+			"target %08x of block %d, expected %08x",
+			header.Bits,
+			height,
+			expectedBits,
+		)
+	}
+	return nil
+}
+
+// isBelowStoredBlockHeaders returns true if a height is below the earliest block header stored or kept by the pruning
+func (k Keeper) isBelowStoredBlockHeaders(ctx sdk.Context, chainID int64, height int64) bool {
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	if !found {
+		return true
+	}
+	return height < bhs.EarliestHeight || height < bhs.PrunedHeight
+}
+
+// expectedBitcoinBits returns the target required for a Bitcoin block header from its parent as computed by btcd,
+// false is returned if the block headers required to compute it are not in the store
+func (k Keeper) expectedBitcoinBits(
+	ctx sdk.Context,
+	params *chaincfg.Params,
+	header *wire.BlockHeader,
+	height int64,
+	parent common.BlockHeader,
+	parentHeader *wire.BlockHeader,
+) (uint32, bool) {
+	if common.IsBitcoinNoRetargeting(params) {
+		return parentHeader.Bits, true
+	}
+
+	blocksPerRetarget := common.BitcoinBlocksPerRetarget(params)
+	if height%blocksPerRetarget != 0 {
+		if !params.ReduceMinDifficulty {
+			return parentHeader.Bits, true
+		}
+		if common.IsBitcoinMinDifficultyAllowed(params, parentHeader.Timestamp, header.Timestamp) {
+			return params.PowLimitBits, true
+		}
+		return k.lastBitcoinNonMinDifficultyBits(ctx, params, parent, parentHeader)
+	}
+
+	first, found := k.GetBlockHeaderAncestor(ctx, parent, height-blocksPerRetarget)
+	if !found {
+		return 0, false
+	}
+	firstHeader, err := first.Header.BitcoinHeader()
+	if err != nil {
+		return 0, false
+	}
+	return common.BitcoinRetargetBits(params, parentHeader.Bits, firstHeader.Timestamp, parentHeader.Timestamp), true
+}
+
+// lastBitcoinNonMinDifficultyBits returns the target of the last block header from a block header that is not a min
+// difficulty block or a retarget block, used by the networks allowing min difficulty blocks
+func (k Keeper) lastBitcoinNonMinDifficultyBits(
+	ctx sdk.Context,
+	params *chaincfg.Params,
+	header common.BlockHeader,
+	btcHeader *wire.BlockHeader,
+) (uint32, bool) {
+	blocksPerRetarget := common.BitcoinBlocksPerRetarget(params)
+	for header.Height%blocksPerRetarget != 0 && btcHeader.Bits == params.PowLimitBits {
+		parent, found := k.GetBlockHeader(ctx, header.ParentHash)
+		if !found {
+			return 0, false
+		}
+		parentHeader, err := parent.Header.BitcoinHeader()
+		if err != nil {
+			return 0, false
+		}
+		header, btcHeader = parent, parentHeader
+	}
+	return btcHeader.Bits, true
+}
+
+// AddBitcoinHeaderToBestChain sets the cumulative work of the header chain ending at a new Bitcoin block header, and
+// sets the block header as the tip of the block header state if its chain is heavier than the current best chain
+func (k Keeper) AddBitcoinHeaderToBestChain(ctx sdk.Context, bhs *types.BlockHeaderState, header common.BlockHeader) error {
+	btcHeader, err := header.Header.BitcoinHeader()
+	if err != nil {
+		return err
+	}
+	chainWork := sdkmath.NewUintFromBigInt(blockchain.CalcWork(btcHeader.Bits))
+	if parentWork, found := k.GetBlockChainWork(ctx, header.ParentHash); found {
+		chainWork = chainWork.Add(parentWork.ChainWork)
+	}
+	k.SetBlockChainWork(ctx, types.BlockChainWork{
+		ChainId:   header.ChainId,
+		BlockHash: header.Hash,
+		ChainWork: chainWork,
+	})
+
+	// the best chain is the chain with the most cumulative work
+	if len(bhs.LatestBlockHash) > 0 {
+		tipWork, found := k.GetBlockChainWork(ctx, bhs.LatestBlockHash)
+		if found && !chainWork.GT(tipWork.ChainWork) {
+			return nil
+		}
+	}
+	k.setBestChainTip(ctx, bhs, header)
+	return nil
+}
+
+// setBestChainTip indexes the best chain ending at the block header down to the fork point with the previous best
+// chain, and sets the block header as the tip of the block header state
+func (k Keeper) setBestChainTip(ctx sdk.Context, bhs *types.BlockHeaderState, tip common.BlockHeader) {
+	header := tip
+	for i := 0; i < maxBestChainIndexDepth; i++ {
+		hash, found := k.GetBestChainBlockHash(ctx, header.ChainId, header.Height)
+		if found && bytes.Equal(hash, header.Hash) {
+			break
+		}
+		k.SetBestChainBlockHash(ctx, header.ChainId, header.Height, header.Hash)
+
+		parent, found := k.GetBlockHeader(ctx, header.ParentHash)
+		if !found {
+			break
+		}
+		header = parent
+	}
+
+	// the previous best chain can be longer than the new best chain
+	for height := tip.Height + 1; height <= bhs.LatestHeight; height++ {
+		k.RemoveBestChainBlockHash(ctx, tip.ChainId, height)
+	}
+
+	if bhs.LatestHeight != 0 && !bytes.Equal(tip.ParentHash, bhs.LatestBlockHash) {
+		ctx.Logger().Info(
+			"best chain reorg",
+			"chain", tip.ChainId,
+			"previous tip", fmt.Sprintf("%d %x", bhs.LatestHeight, bhs.LatestBlockHash),
+			"new tip", fmt.Sprintf("%d %x", tip.Height, tip.Hash),
+		)
+	}
+	bhs.LatestHeight = tip.Height
+	bhs.LatestBlockHash = tip.Hash
+}
+
+// CheckBlockHeaderConfirmed checks a Bitcoin block header is on the best chain with at least the confirmation count,
+// and an Ethereum block header is finalized by the light client of the chain if initialized
+// The confirmation count is the depth required by the caller, e.g. the confirmation count of the inbound amount
+func (k Keeper) CheckBlockHeaderConfirmed(ctx sdk.Context, header common.BlockHeader, confirmationCount uint64) error {
+	if common.IsEVMChain(header.ChainId) {
+		return k.checkBlockHeaderFinalized(ctx, header)
+	}
+	if !common.IsBitcoinChain(header.ChainId) {
+		return nil
+	}
+	hash, found := k.GetBestChainBlockHash(ctx, header.ChainId, header.Height)
+	if !found || !bytes.Equal(hash, header.Hash) {
+		return cosmoserrors.Wrapf(types.ErrBlockHeaderNotConfirmed, "block %d %x is not on the best chain", header.Height, header.Hash)
+	}
+	bhs, found := k.GetBlockHeaderState(ctx, header.ChainId)
+	if !found {
+		return cosmoserrors.Wrapf(types.ErrBlockHeaderNotFound, "block header state not found for chain %d", header.ChainId)
+	}
+
+	confirmations := bhs.LatestHeight - header.Height + 1
+	// #nosec G701 confirmations is always positive on the best chain
+	if confirmations < 0 || uint64(confirmations) < confirmationCount {
+		return cosmoserrors.Wrapf(
+			types.ErrBlockHeaderNotConfirmed,
+			"block %d %x has %d confirmations, %d required",
+			header.Height,
+			header.Hash,
+			confirmations,
+			confirmationCount,
+		)
+	}
+	return nil
+}
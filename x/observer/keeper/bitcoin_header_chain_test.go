@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const regtestBits = uint32(0x207fffff)
+
+// bitcoinHeader returns a block header of the regtest chain extending the parent block header
+func bitcoinHeader(t *testing.T, parent *common.BlockHeader, bits uint32, nonce uint32) common.BlockHeader {
+	chainID := common.BtcRegtestChain().ChainId
+	header := wire.BlockHeader{
+		Version:   1,
+		Bits:      bits,
+		Timestamp: time.Unix(1700000000, 0),
+		Nonce:     nonce,
+	}
+	height := int64(1)
+	if parent != nil {
+		prevBlock, err := chainhash.NewHash(parent.Hash)
+		require.NoError(t, err)
+		header.PrevBlock = *prevBlock
+		header.Timestamp = time.Unix(1700000000+600*parent.Height, 0)
+		height = parent.Height + 1
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	hash := header.BlockHash()
+	return common.BlockHeader{
+		Header:     common.NewBitcoinHeader(buf.Bytes()),
+		Height:     height,
+		Hash:       hash[:],
+		ParentHash: header.PrevBlock[:],
+		ChainId:    chainID,
+	}
+}
+
+// addBitcoinBranch adds a branch of block headers extending the parent block header, and returns the block headers
+func addBitcoinBranch(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	bhs *types.BlockHeaderState,
+	parent *common.BlockHeader,
+	length int,
+	bits uint32,
+	nonce uint32,
+) []common.BlockHeader {
+	headers := make([]common.BlockHeader, 0, length)
+	for i := 0; i < length; i++ {
+		header := bitcoinHeader(t, parent, bits, nonce)
+		k.SetBlockHeader(ctx, header)
+		require.NoError(t, k.AddBitcoinHeaderToBestChain(ctx, bhs, header))
+		headers = append(headers, header)
+		parent = &headers[len(headers)-1]
+	}
+	return headers
+}
+
+func TestKeeper_AddBitcoinHeaderToBestChain(t *testing.T) {
+	t.Run("chain work is cumulative", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		bhs := &types.BlockHeaderState{ChainId: common.BtcRegtestChain().ChainId}
+		headers := addBitcoinBranch(t, ctx, k, bhs, nil, 3, regtestBits, 0)
+
+		work := blockchain.CalcWork(regtestBits)
+		for i, header := range headers {
+			chainWork, found := k.GetBlockChainWork(ctx, header.Hash)
+			require.True(t, found)
+			require.EqualValues(t, int64(i+1)*work.Int64(), chainWork.ChainWork.Uint64())
+		}
+		require.EqualValues(t, 3, bhs.LatestHeight)
+		require.Equal(t, headers[2].Hash, bhs.LatestBlockHash)
+	})
+
+	t.Run("longer fork with the same work becomes the best chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		bhs := &types.BlockHeaderState{ChainId: common.BtcRegtestChain().ChainId}
+		main := addBitcoinBranch(t, ctx, k, bhs, nil, 4, regtestBits, 0)
+
+		// a fork of the same length doesn't replace the best chain
+		fork := addBitcoinBranch(t, ctx, k, bhs, &main[1], 2, regtestBits, 1)
+		require.Equal(t, main[3].Hash, bhs.LatestBlockHash)
+
+		// the fork becomes the best chain once it has more work
+		fork = append(fork, addBitcoinBranch(t, ctx, k, bhs, &fork[1], 1, regtestBits, 1)...)
+		require.EqualValues(t, 5, bhs.LatestHeight)
+		require.Equal(t, fork[2].Hash, bhs.LatestBlockHash)
+		for _, header := range append(main[:2], fork...) {
+			hash, found := k.GetBestChainBlockHash(ctx, header.ChainId, header.Height)
+			require.True(t, found)
+			require.Equal(t, header.Hash, hash)
+		}
+	})
+
+	t.Run("shorter fork with more work becomes the best chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		bhs := &types.BlockHeaderState{ChainId: common.BtcRegtestChain().ChainId}
+		main := addBitcoinBranch(t, ctx, k, bhs, nil, 4, regtestBits, 0)
+
+		// a single block with a lower target has more work than the remaining blocks of the main chain
+		fork := addBitcoinBranch(t, ctx, k, bhs, &main[1], 1, 0x1d00ffff, 1)
+		require.EqualValues(t, 3, bhs.LatestHeight)
+		require.Equal(t, fork[0].Hash, bhs.LatestBlockHash)
+
+		hash, found := k.GetBestChainBlockHash(ctx, fork[0].ChainId, 3)
+		require.True(t, found)
+		require.Equal(t, fork[0].Hash, hash)
+		_, found = k.GetBestChainBlockHash(ctx, main[3].ChainId, 4)
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_GetBlockHeaderAncestor(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	bhs := &types.BlockHeaderState{ChainId: common.BtcRegtestChain().ChainId}
+	main := addBitcoinBranch(t, ctx, k, bhs, nil, 5, regtestBits, 0)
+	fork := addBitcoinBranch(t, ctx, k, bhs, &main[1], 2, regtestBits, 1)
+
+	ancestor, found := k.GetBlockHeaderAncestor(ctx, main[4], 2)
+	require.True(t, found)
+	require.Equal(t, main[1].Hash, ancestor.Hash)
+
+	ancestor, found = k.GetBlockHeaderAncestor(ctx, fork[1], 2)
+	require.True(t, found)
+	require.Equal(t, main[1].Hash, ancestor.Hash)
+
+	ancestor, found = k.GetBlockHeaderAncestor(ctx, fork[1], 3)
+	require.True(t, found)
+	require.Equal(t, fork[0].Hash, ancestor.Hash)
+
+	_, found = k.GetBlockHeaderAncestor(ctx, main[4], 0)
+	require.False(t, found)
+}
+
+func TestKeeper_ValidateBitcoinHeaderDifficulty(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	bhs := &types.BlockHeaderState{ChainId: common.BtcRegtestChain().ChainId}
+	main := addBitcoinBranch(t, ctx, k, bhs, nil, 1, regtestBits, 0)
+
+	header, err := bitcoinHeader(t, &main[0], regtestBits, 0).Header.BitcoinHeader()
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateBitcoinHeaderDifficulty(ctx, header, 2, main[0]))
+
+	header, err = bitcoinHeader(t, &main[0], 0x1d00ffff, 0).Header.BitcoinHeader()
+	require.NoError(t, err)
+	err = k.ValidateBitcoinHeaderDifficulty(ctx, header, 2, main[0])
+	require.ErrorIs(t, err, types.ErrInvalidBlockHeaderDifficulty)
+}
+
+// testnetHeader returns a block header of the testnet chain at a height, extending the parent hash
+func testnetHeader(t *testing.T, parentHash []byte, height int64, bits uint32, timestamp time.Time) common.BlockHeader {
+	prevBlock, err := chainhash.NewHash(parentHash)
+	require.NoError(t, err)
+	header := wire.BlockHeader{
+		Version:   1,
+		PrevBlock: *prevBlock,
+		Bits:      bits,
+		Timestamp: timestamp,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	hash := header.BlockHash()
+	return common.BlockHeader{
+		Header:     common.NewBitcoinHeader(buf.Bytes()),
+		Height:     height,
+		Hash:       hash[:],
+		ParentHash: parentHash,
+		ChainId:    common.BtcTestNetChain().ChainId,
+	}
+}
+
+func TestKeeper_ValidateBitcoinHeaderDifficulty_UnknownAncestry(t *testing.T) {
+	chainID := common.BtcTestNetChain().ChainId
+	params, err := common.BitcoinNetParamsFromChainID(chainID)
+	require.NoError(t, err)
+	timestamp := time.Unix(1700000000, 0)
+
+	t.Run("should reject a block header whose last non min difficulty ancestor is not in the store", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		parent := testnetHeader(t, bytes.Repeat([]byte{1}, 32), 101, params.PowLimitBits, timestamp)
+		k.SetBlockHeader(ctx, parent)
+		k.SetBlockHeaderState(ctx, types.BlockHeaderState{ChainId: chainID, EarliestHeight: 101, LatestHeight: 101})
+
+		header, err := testnetHeader(t, parent.Hash, 102, params.PowLimitBits, timestamp.Add(time.Minute)).Header.BitcoinHeader()
+		require.NoError(t, err)
+		err = k.ValidateBitcoinHeaderDifficulty(ctx, header, 102, parent)
+		require.ErrorIs(t, err, types.ErrInvalidBlockHeaderDifficulty)
+
+		// a min difficulty block doesn't depend on the ancestry
+		header, err = testnetHeader(t, parent.Hash, 102, params.PowLimitBits, timestamp.Add(time.Hour)).Header.BitcoinHeader()
+		require.NoError(t, err)
+		require.NoError(t, k.ValidateBitcoinHeaderDifficulty(ctx, header, 102, parent))
+	})
+
+	t.Run("should check a retarget block against the maximum adjustment if its interval starts below the stored block headers", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		parent := testnetHeader(t, bytes.Repeat([]byte{1}, 32), 4031, 0x1c00ffff, timestamp)
+		k.SetBlockHeader(ctx, parent)
+		k.SetBlockHeaderState(ctx, types.BlockHeaderState{ChainId: chainID, EarliestHeight: 4031, LatestHeight: 4031})
+
+		header, err := testnetHeader(t, parent.Hash, 4032, 0x1c00ffff, timestamp.Add(time.Minute)).Header.BitcoinHeader()
+		require.NoError(t, err)
+		require.NoError(t, k.ValidateBitcoinHeaderDifficulty(ctx, header, 4032, parent))
+
+		header, err = testnetHeader(t, parent.Hash, 4032, 0x1b00ffff, timestamp.Add(time.Minute)).Header.BitcoinHeader()
+		require.NoError(t, err)
+		err = k.ValidateBitcoinHeaderDifficulty(ctx, header, 4032, parent)
+		require.ErrorIs(t, err, types.ErrInvalidBlockHeaderDifficulty)
+	})
+
+	t.Run("should reject a retarget block if its interval is above the earliest block header but not in the store", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		parent := testnetHeader(t, bytes.Repeat([]byte{1}, 32), 4031, 0x1c00ffff, timestamp)
+		k.SetBlockHeader(ctx, parent)
+		k.SetBlockHeaderState(ctx, types.BlockHeaderState{ChainId: chainID, EarliestHeight: 2000, LatestHeight: 4031})
+
+		header, err := testnetHeader(t, parent.Hash, 4032, 0x1c00ffff, timestamp.Add(time.Minute)).Header.BitcoinHeader()
+		require.NoError(t, err)
+		err = k.ValidateBitcoinHeaderDifficulty(ctx, header, 4032, parent)
+		require.ErrorIs(t, err, types.ErrInvalidBlockHeaderDifficulty)
+	})
+}
+
+func TestKeeper_CheckBlockHeaderConfirmed(t *testing.T) {
+	chainID := common.BtcRegtestChain().ChainId
+	k, ctx := keepertest.ObserverKeeper(t)
+	bhs := &types.BlockHeaderState{ChainId: chainID}
+	main := addBitcoinBranch(t, ctx, k, bhs, nil, 3, regtestBits, 0)
+	fork := addBitcoinBranch(t, ctx, k, bhs, &main[0], 1, regtestBits, 1)
+	k.SetBlockHeaderState(ctx, *bhs)
+
+	require.NoError(t, k.CheckBlockHeaderConfirmed(ctx, main[1], 2))
+
+	err := k.CheckBlockHeaderConfirmed(ctx, main[2], 2)
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotConfirmed)
+
+	// the required depth is set by the caller
+	require.NoError(t, k.CheckBlockHeaderConfirmed(ctx, main[0], 3))
+	err = k.CheckBlockHeaderConfirmed(ctx, main[1], 3)
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotConfirmed)
+
+	err = k.CheckBlockHeaderConfirmed(ctx, fork[0], 1)
+	require.ErrorIs(t, err, types.ErrBlockHeaderNotConfirmed)
+
+	// the block headers of the other chains are not checked
+	require.NoError(t, k.CheckBlockHeaderConfirmed(ctx, common.BlockHeader{ChainId: common.GoerliChain().ChainId}, 2))
+}
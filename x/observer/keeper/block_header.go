@@ -9,11 +9,12 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
-// SetBlockHeader set a specific block header in the store from its index
+// SetBlockHeader set a specific block header in the store from its index, the block header is indexed by height
 func (k Keeper) SetBlockHeader(ctx sdk.Context, header common.BlockHeader) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
 	b := k.cdc.MustMarshal(&header)
 	store.Set(header.Hash, b)
+	k.SetBlockHeaderHeight(ctx, header)
 }
 
 // GetBlockHeader returns a block header from its hash
@@ -29,10 +30,39 @@ func (k Keeper) GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHe
 	return val, true
 }
 
-// RemoveBlockHeader removes a block header from the store
+// RemoveBlockHeader removes a block header from the store with its height index
 func (k Keeper) RemoveBlockHeader(ctx sdk.Context, hash []byte) {
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderKey))
 	store.Delete(hash)
+	k.RemoveBlockHeaderHeight(ctx, header)
+}
+
+// SetBlockHeaderHeight indexes a block header by its chain and height
+func (k Keeper) SetBlockHeaderHeight(ctx sdk.Context, header common.BlockHeader) {
+	store := k.blockHeaderHeightStore(ctx, header.ChainId)
+	store.Set(blockHeaderHeightKey(header.Height, header.Hash), []byte{})
+}
+
+// RemoveBlockHeaderHeight removes the height index of a block header
+func (k Keeper) RemoveBlockHeaderHeight(ctx sdk.Context, header common.BlockHeader) {
+	store := k.blockHeaderHeightStore(ctx, header.ChainId)
+	store.Delete(blockHeaderHeightKey(header.Height, header.Hash))
+}
+
+// blockHeaderHeightStore returns the store of the height index of the block headers of a chain, the keys are ordered
+// by height
+func (k Keeper) blockHeaderHeightStore(ctx sdk.Context, chainID int64) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	return prefix.NewStore(store, types.KeyPrefix(strconv.FormatInt(chainID, 10)))
+}
+
+func blockHeaderHeightKey(height int64, hash []byte) []byte {
+	// #nosec G701 block heights are always positive
+	return append(sdk.Uint64ToBigEndian(uint64(height)), hash...)
 }
 
 func (k Keeper) SetBlockHeaderState(ctx sdk.Context, blockHeaderState types.BlockHeaderState) {
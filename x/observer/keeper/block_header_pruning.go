@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// blockHeaderPruningBudget is the maximum number of block headers visited by the pruning at each block
+const blockHeaderPruningBudget = 100
+
+// AddBlockHeaderReference references a block header from a tracker, the referenced block headers are not pruned
+func (k Keeper) AddBlockHeaderReference(ctx sdk.Context, hash []byte) {
+	k.setBlockHeaderReferenceCount(ctx, hash, k.GetBlockHeaderReferenceCount(ctx, hash)+1)
+}
+
+// RemoveBlockHeaderReference removes a reference to a block header from a tracker, the block header is pruned if it is
+// no longer referenced and below the pruned height of its chain
+func (k Keeper) RemoveBlockHeaderReference(ctx sdk.Context, hash []byte) {
+	count := k.GetBlockHeaderReferenceCount(ctx, hash)
+	if count > 1 {
+		k.setBlockHeaderReferenceCount(ctx, hash, count-1)
+		return
+	}
+	k.setBlockHeaderReferenceCount(ctx, hash, 0)
+
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return
+	}
+	bhs, found := k.GetBlockHeaderState(ctx, header.ChainId)
+	if found && header.Height < bhs.PrunedHeight {
+		k.pruneBlockHeader(ctx, header)
+	}
+}
+
+// GetBlockHeaderReferenceCount returns the number of trackers referencing a block header
+func (k Keeper) GetBlockHeaderReferenceCount(ctx sdk.Context, hash []byte) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderReferenceKey))
+	b := store.Get(hash)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (k Keeper) setBlockHeaderReferenceCount(ctx sdk.Context, hash []byte, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderReferenceKey))
+	if count == 0 {
+		store.Delete(hash)
+		return
+	}
+	store.Set(hash, sdk.Uint64ToBigEndian(count))
+}
+
+// PruneBlockHeaders prunes the block headers below the retention of each chain, the referenced block headers are kept
+// At most blockHeaderPruningBudget block headers are visited, the pruning of a chain resumes at the next block from its
+// pruned height
+func (k Keeper) PruneBlockHeaders(ctx sdk.Context) {
+	coreParamsList, found := k.GetAllCoreParams(ctx)
+	if !found {
+		return
+	}
+	budget := blockHeaderPruningBudget
+	for _, coreParams := range coreParamsList.CoreParams {
+		if budget == 0 {
+			return
+		}
+		if coreParams == nil || coreParams.BlockHeaderRetention == 0 {
+			continue
+		}
+		bhs, found := k.GetBlockHeaderState(ctx, coreParams.ChainId)
+		if !found {
+			continue
+		}
+
+		// #nosec G701 retention is always in range
+		targetHeight := bhs.LatestHeight - int64(coreParams.BlockHeaderRetention) + 1
+		if targetHeight <= bhs.PrunedHeight {
+			continue
+		}
+		budget = k.pruneChainBlockHeaders(ctx, &bhs, targetHeight, budget)
+		k.SetBlockHeaderState(ctx, bhs)
+	}
+}
+
+// pruneChainBlockHeaders prunes the block headers of a chain from its pruned height up to the target height with the
+// budget, and returns the remaining budget
+func (k Keeper) pruneChainBlockHeaders(ctx sdk.Context, bhs *types.BlockHeaderState, targetHeight int64, budget int) int {
+	store := k.blockHeaderHeightStore(ctx, bhs.ChainId)
+	// #nosec G701 block heights are always positive
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(uint64(bhs.PrunedHeight)), sdk.Uint64ToBigEndian(uint64(targetHeight)))
+	defer iterator.Close()
+
+	var hashes [][]byte
+	prunedHeight := targetHeight
+	for ; iterator.Valid(); iterator.Next() {
+		// #nosec G701 block heights are always positive
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[:8]))
+		if budget == 0 {
+			// the block headers below the height have all been visited
+			prunedHeight = height
+			break
+		}
+		hashes = append(hashes, bytes.Clone(iterator.Key()[8:]))
+		budget--
+	}
+
+	for _, hash := range hashes {
+		if k.GetBlockHeaderReferenceCount(ctx, hash) > 0 {
+			continue
+		}
+		if header, found := k.GetBlockHeader(ctx, hash); found {
+			k.pruneBlockHeader(ctx, header)
+		}
+	}
+	bhs.PrunedHeight = prunedHeight
+	return budget
+}
+
+// pruneBlockHeader removes a block header with the data of its chain and the ballot of the observers that added it
+func (k Keeper) pruneBlockHeader(ctx sdk.Context, header common.BlockHeader) {
+	k.RemoveBlockHeader(ctx, header.Hash)
+	k.RemoveBallot(ctx, types.NewMsgAddBlockHeader("", header.ChainId, header.Hash, header.Height, header.Header).Digest())
+	if common.IsBitcoinChain(header.ChainId) {
+		k.RemoveBlockChainWork(ctx, header.Hash)
+		if hash, found := k.GetBestChainBlockHash(ctx, header.ChainId, header.Height); found && bytes.Equal(hash, header.Hash) {
+			k.RemoveBestChainBlockHash(ctx, header.ChainId, header.Height)
+		}
+	}
+	if common.IsEVMChain(header.ChainId) {
+		k.RemoveFinalizedExecutionBlock(ctx, header.Hash)
+	}
+}
+
+// GetPrunedBlockHeaderRanges returns the height ranges of the pruned block headers of a chain, the heights of the
+// referenced block headers below the pruned height are excluded from the ranges
+func (k Keeper) GetPrunedBlockHeaderRanges(ctx sdk.Context, bhs types.BlockHeaderState) []types.HeightRange {
+	if bhs.PrunedHeight <= bhs.EarliestHeight {
+		return []types.HeightRange{}
+	}
+	store := k.blockHeaderHeightStore(ctx, bhs.ChainId)
+	// #nosec G701 block heights are always positive
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(uint64(bhs.EarliestHeight)), sdk.Uint64ToBigEndian(uint64(bhs.PrunedHeight)))
+	defer iterator.Close()
+
+	ranges := []types.HeightRange{}
+	start := bhs.EarliestHeight
+	for ; iterator.Valid(); iterator.Next() {
+		// #nosec G701 block heights are always positive
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[:8]))
+		if height > start {
+			ranges = append(ranges, types.HeightRange{StartHeight: start, EndHeight: height - 1})
+		}
+		start = height + 1
+	}
+	if start < bhs.PrunedHeight {
+		ranges = append(ranges, types.HeightRange{StartHeight: start, EndHeight: bhs.PrunedHeight - 1})
+	}
+	return ranges
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setupBlockHeaders stores block headers of the chain from the height 1 to the latest height with a retention
+func setupBlockHeaders(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	chainID int64,
+	latestHeight int64,
+	retention uint64,
+) []common.BlockHeader {
+	k.SetCoreParams(ctx, types.CoreParamsList{CoreParams: []*types.CoreParams{
+		{ChainId: chainID, BlockHeaderRetention: retention},
+	}})
+	headers := make([]common.BlockHeader, 0, latestHeight)
+	for height := int64(1); height <= latestHeight; height++ {
+		header := common.BlockHeader{
+			Height:  height,
+			Hash:    sample.Hash().Bytes(),
+			ChainId: chainID,
+		}
+		k.SetBlockHeader(ctx, header)
+		headers = append(headers, header)
+	}
+	k.SetBlockHeaderState(ctx, types.BlockHeaderState{
+		ChainId:         chainID,
+		EarliestHeight:  1,
+		LatestHeight:    latestHeight,
+		LatestBlockHash: headers[len(headers)-1].Hash,
+	})
+	return headers
+}
+
+func requireBlockHeadersFound(t *testing.T, ctx sdk.Context, k *keeper.Keeper, headers []common.BlockHeader, found bool) {
+	for _, header := range headers {
+		_, f := k.GetBlockHeader(ctx, header.Hash)
+		require.Equal(t, found, f, "block header at height %d", header.Height)
+	}
+}
+
+func TestKeeper_PruneBlockHeaders(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+
+	t.Run("should keep the block headers within the retention", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 5)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers[:15], false)
+		requireBlockHeadersFound(t, ctx, k, headers[15:], true)
+
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 16, bhs.PrunedHeight)
+		require.Equal(t, []types.HeightRange{{StartHeight: 1, EndHeight: 15}}, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+	})
+
+	t.Run("should keep all the block headers without retention", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 0)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers, true)
+
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 0, bhs.PrunedHeight)
+		require.Empty(t, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+	})
+
+	t.Run("should resume the pruning at the next block when the budget is exhausted", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 160, 10)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers[:100], false)
+		requireBlockHeadersFound(t, ctx, k, headers[100:], true)
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 101, bhs.PrunedHeight)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers[:150], false)
+		requireBlockHeadersFound(t, ctx, k, headers[150:], true)
+		bhs, found = k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 151, bhs.PrunedHeight)
+	})
+
+	t.Run("should keep the referenced block headers until they are no longer referenced", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 5)
+		k.AddBlockHeaderReference(ctx, headers[4].Hash)
+		k.AddBlockHeaderReference(ctx, headers[4].Hash)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers[4:5], true)
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, []types.HeightRange{
+			{StartHeight: 1, EndHeight: 4},
+			{StartHeight: 6, EndHeight: 15},
+		}, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+
+		k.RemoveBlockHeaderReference(ctx, headers[4].Hash)
+		requireBlockHeadersFound(t, ctx, k, headers[4:5], true)
+
+		k.RemoveBlockHeaderReference(ctx, headers[4].Hash)
+		requireBlockHeadersFound(t, ctx, k, headers[4:5], false)
+		require.Equal(t, []types.HeightRange{{StartHeight: 1, EndHeight: 15}}, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+	})
+
+	t.Run("should keep the block header above the pruned height when it is no longer referenced", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 5)
+		k.AddBlockHeaderReference(ctx, headers[19].Hash)
+		k.RemoveBlockHeaderReference(ctx, headers[19].Hash)
+
+		requireBlockHeadersFound(t, ctx, k, headers[19:], true)
+		require.EqualValues(t, 0, k.GetBlockHeaderReferenceCount(ctx, headers[19].Hash))
+	})
+
+	t.Run("should prune the bitcoin best chain of the block headers", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		btcChainID := common.BtcRegtestChain().ChainId
+		k.SetCoreParams(ctx, types.CoreParamsList{CoreParams: []*types.CoreParams{
+			{ChainId: btcChainID, BlockHeaderRetention: 2},
+		}})
+		bhs := &types.BlockHeaderState{ChainId: btcChainID, EarliestHeight: 1}
+		headers := addBitcoinBranch(t, ctx, k, bhs, nil, 4, regtestBits, 0)
+		k.SetBlockHeaderState(ctx, *bhs)
+
+		k.PruneBlockHeaders(ctx)
+		requireBlockHeadersFound(t, ctx, k, headers[:2], false)
+		requireBlockHeadersFound(t, ctx, k, headers[2:], true)
+		for _, header := range headers[:2] {
+			_, found := k.GetBlockChainWork(ctx, header.Hash)
+			require.False(t, found)
+			_, found = k.GetBestChainBlockHash(ctx, btcChainID, header.Height)
+			require.False(t, found)
+		}
+		_, found := k.GetBestChainBlockHash(ctx, btcChainID, headers[2].Height)
+		require.True(t, found)
+	})
+
+	t.Run("should remove the ballots of the pruned block headers", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 5)
+		ballotIndex := func(header common.BlockHeader) string {
+			return types.NewMsgAddBlockHeader("", header.ChainId, header.Hash, header.Height, header.Header).Digest()
+		}
+
+		// the ballots of a pruned and of a kept block header, and another ballot, are created at the same height
+		indexes := []string{ballotIndex(headers[0]), ballotIndex(headers[19]), sample.Hash().Hex()}
+		for _, index := range indexes {
+			ballot := types.Ballot{BallotIdentifier: index, BallotCreationHeight: 42}
+			k.SetBallot(ctx, &ballot)
+			k.AddBallotToList(ctx, ballot)
+		}
+
+		k.PruneBlockHeaders(ctx)
+		_, found := k.GetBallot(ctx, indexes[0])
+		require.False(t, found)
+		_, found = k.GetBallot(ctx, indexes[1])
+		require.True(t, found)
+		list, found := k.GetBallotList(ctx, 42)
+		require.True(t, found)
+		require.Equal(t, indexes[1:], list.BallotsIndexList)
+	})
+}
+
+func TestKeeper_PrunedBlockHeaderRanges(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+
+	t.Run("should return the pruned ranges", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		headers := setupBlockHeaders(ctx, k, chainID, 20, 5)
+		k.AddBlockHeaderReference(ctx, headers[9].Hash)
+		k.PruneBlockHeaders(ctx)
+
+		res, err := k.PrunedBlockHeaderRanges(sdk.WrapSDKContext(ctx), &types.QueryPrunedBlockHeaderRangesRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.EqualValues(t, 16, res.PrunedHeight)
+		require.Equal(t, []types.HeightRange{
+			{StartHeight: 1, EndHeight: 9},
+			{StartHeight: 11, EndHeight: 15},
+		}, res.PrunedRanges)
+	})
+
+	t.Run("should fail if the block header state is not found", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		_, err := k.PrunedBlockHeaderRanges(sdk.WrapSDKContext(ctx), &types.QueryPrunedBlockHeaderRangesRequest{ChainId: chainID})
+		require.Error(t, err)
+
+		_, err = k.PrunedBlockHeaderRanges(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+}
@@ -44,3 +44,52 @@ func EmitEventAddObserver(ctx sdk.Context, observerCount uint64, operatorAddress
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventKeygenAttemptFinalized(ctx sdk.Context, attempt types.KeygenAttempt) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventKeygenAttemptFinalized{
+		MsgTypeUrl:         sdk.MsgTypeURL(&types.MsgApproveKeygenRetry{}),
+		AttemptIndex:       attempt.Index,
+		KeygenBlock:        strconv.FormatInt(attempt.BlockNumber, 10),
+		Status:             attempt.Status.String(),
+		BlamedPubkeys:      types2.PrettyPrintStruct(attempt.BlamedPubkeys),
+		ProposedRetryBlock: strconv.FormatInt(attempt.ProposedRetryBlock, 10),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventKeygenAttemptFinalized :", err)
+	}
+}
+
+func EmitEventObserverMissedVotes(ctx sdk.Context, liveness types.ObserverLiveness) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverMissedVotes{
+		ObserverAddress: liveness.ObserverAddress,
+		ChainId:         liveness.ChainId,
+		MissedVotes:     liveness.MissedVotesCounter,
+		WindowSize:      liveness.WindowSize,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverMissedVotes :", err)
+	}
+}
+
+func EmitEventObserverJailed(ctx sdk.Context, liveness types.ObserverLiveness, missedVotes uint64) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverJailed{
+		ObserverAddress: liveness.ObserverAddress,
+		ChainId:         liveness.ChainId,
+		MissedVotes:     missedVotes,
+		JailedUntil:     liveness.JailedUntil,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverJailed :", err)
+	}
+}
+
+func EmitEventObserverUnjailed(ctx sdk.Context, liveness types.ObserverLiveness) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverUnjailed{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnjailObserver{}),
+		ObserverAddress: liveness.ObserverAddress,
+		ChainId:         liveness.ChainId,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverUnjailed :", err)
+	}
+}
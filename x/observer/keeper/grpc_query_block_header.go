@@ -64,3 +64,21 @@ func (k Keeper) GetBlockHeaderStateByChain(c context.Context, req *types.QueryGe
 
 	return &types.QueryGetBlockHeaderStateResponse{BlockHeaderState: &state}, nil
 }
+
+// PrunedBlockHeaderRanges queries the height ranges of the pruned block headers of a chain
+func (k Keeper) PrunedBlockHeaderRanges(c context.Context, req *types.QueryPrunedBlockHeaderRangesRequest) (*types.QueryPrunedBlockHeaderRangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	state, found := k.GetBlockHeaderState(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: chain id %d", req.ChainId))
+	}
+
+	return &types.QueryPrunedBlockHeaderRangesResponse{
+		PrunedRanges: k.GetPrunedBlockHeaderRanges(ctx, state),
+		PrunedHeight: state.PrunedHeight,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) KeygenAttempt(goCtx context.Context, request *types.QueryGetKeygenAttemptRequest) (*types.QueryGetKeygenAttemptResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	attempt, found := k.GetKeygenAttempt(ctx, request.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "keygen attempt not found")
+	}
+	return &types.QueryGetKeygenAttemptResponse{KeygenAttempt: attempt}, nil
+}
+
+func (k Keeper) KeygenAttemptAll(goCtx context.Context, request *types.QueryAllKeygenAttemptRequest) (*types.QueryAllKeygenAttemptResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	attempts, pageRes, err := k.GetAllKeygenAttemptsPaginated(ctx, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllKeygenAttemptResponse{
+		KeygenAttempt: attempts,
+		Pagination:    pageRes,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LightClientState(goCtx context.Context, request *types.QueryGetLightClientStateRequest) (*types.QueryGetLightClientStateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	state, found := k.GetLightClientState(ctx, request.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "light client state not found")
+	}
+	return &types.QueryGetLightClientStateResponse{LightClientState: state}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LivenessParams(goCtx context.Context, request *types.QueryGetLivenessParamsRequest) (*types.QueryGetLivenessParamsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryGetLivenessParamsResponse{LivenessParams: k.GetLivenessParams(ctx)}, nil
+}
+
+func (k Keeper) ObserverLiveness(goCtx context.Context, request *types.QueryGetObserverLivenessRequest) (*types.QueryGetObserverLivenessResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	liveness, found := k.GetObserverLiveness(ctx, request.ChainId, request.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "observer liveness not found")
+	}
+	return &types.QueryGetObserverLivenessResponse{ObserverLiveness: liveness}, nil
+}
+
+func (k Keeper) ObserverLivenessAll(goCtx context.Context, request *types.QueryAllObserverLivenessRequest) (*types.QueryAllObserverLivenessResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	list, pageRes, err := k.GetAllObserverLivenessPaginated(ctx, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllObserverLivenessResponse{
+		ObserverLiveness: list,
+		Pagination:       pageRes,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// PendingNoncesInvariantName is the name of the invariant checking the nonce bookkeeping of the current TSS
+	PendingNoncesInvariantName = "pending-nonces"
+)
+
+// RegisterInvariants registers the observer module invariants
+// The invariants are not registered yet: the nonces written by the previous releases may not satisfy them and a broken
+// invariant halts the chain, they are run by the tests with AllInvariants until they are checked against the state
+// exported from the networks
+func RegisterInvariants(_ sdk.InvariantRegistry, _ Keeper) {}
+
+// AllInvariants runs all invariants of the observer module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return PendingNoncesInvariant(k)(ctx)
+	}
+}
+
+// PendingNoncesInvariant checks the pending nonces of the current TSS for each chain:
+// the pending range is valid, its upper bound is the next nonce of the chain, and each nonce of the range is mapped to a cctx
+func PendingNoncesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		tss, found := k.GetTSS(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, PendingNoncesInvariantName, "no tss set, nothing to check"), false
+		}
+
+		pendingNoncesList, err := k.GetAllPendingNonces(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, PendingNoncesInvariantName, fmt.Sprintf("cannot get pending nonces: %s", err.Error())), true
+		}
+		params := k.GetParams(ctx)
+		for _, pendingNonces := range pendingNoncesList {
+			if pendingNonces.Tss != tss.TssPubkey {
+				continue
+			}
+			chainID := pendingNonces.ChainId
+
+			if pendingNonces.NonceLow > pendingNonces.NonceHigh {
+				broken = true
+				msg += fmt.Sprintf("\tchain %d: low nonce %d greater than high nonce %d\n", chainID, pendingNonces.NonceLow, pendingNonces.NonceHigh)
+				continue
+			}
+
+			if chain := params.GetChainFromChainID(chainID); chain != nil {
+				chainNonces, found := k.GetChainNonces(ctx, chain.ChainName.String())
+				// #nosec G701 always in range
+				if found && int64(chainNonces.Nonce) != pendingNonces.NonceHigh {
+					broken = true
+					msg += fmt.Sprintf("\tchain %d: chain nonce %d doesn't match high nonce %d\n", chainID, chainNonces.Nonce, pendingNonces.NonceHigh)
+				}
+			}
+
+			for nonce := pendingNonces.NonceLow; nonce < pendingNonces.NonceHigh; nonce++ {
+				if _, found := k.GetNonceToCctx(ctx, tss.TssPubkey, chainID, nonce); !found {
+					broken = true
+					msg += fmt.Sprintf("\tchain %d: pending nonce %d not mapped to a cctx\n", chainID, nonce)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, PendingNoncesInvariantName, fmt.Sprintf("pending nonces inconsistent:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setInvariantNonces sets the pending nonces [low, high) for the chain, the chain nonce and the nonce to cctx mappings
+func setInvariantNonces(k *keeper.Keeper, ctx sdk.Context, tss types.TSS, chain common.Chain, low, high int64) {
+	k.SetPendingNonces(ctx, types.PendingNonces{
+		NonceLow:  low,
+		NonceHigh: high,
+		ChainId:   chain.ChainId,
+		Tss:       tss.TssPubkey,
+	})
+	k.SetChainNonces(ctx, types.ChainNonces{
+		Index:   chain.ChainName.String(),
+		ChainId: chain.ChainId,
+		// #nosec G701 always positive
+		Nonce: uint64(high),
+	})
+	for nonce := low; nonce < high; nonce++ {
+		k.SetNonceToCctx(ctx, types.NonceToCctx{
+			ChainId:   chain.ChainId,
+			Nonce:     nonce,
+			CctxIndex: fmt.Sprintf("cctx-%d", nonce),
+			Tss:       tss.TssPubkey,
+		})
+	}
+}
+
+func TestKeeper_PendingNoncesInvariant(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	t.Run("should not be broken without tss", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+
+		_, broken := keeper.PendingNoncesInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should not be broken with consistent nonces", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.DefaultParams())
+		tss := sample.Tss()
+		k.SetTSS(ctx, tss)
+		setInvariantNonces(k, ctx, tss, chain, 2, 5)
+
+		_, broken := keeper.PendingNoncesInvariant(*k)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("should be broken if low nonce is greater than high nonce", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.DefaultParams())
+		tss := sample.Tss()
+		k.SetTSS(ctx, tss)
+		k.SetPendingNonces(ctx, types.PendingNonces{
+			NonceLow:  5,
+			NonceHigh: 2,
+			ChainId:   chain.ChainId,
+			Tss:       tss.TssPubkey,
+		})
+
+		msg, broken := keeper.PendingNoncesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "low nonce 5 greater than high nonce 2")
+	})
+
+	t.Run("should be broken if chain nonce doesn't match high nonce", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.DefaultParams())
+		tss := sample.Tss()
+		k.SetTSS(ctx, tss)
+		setInvariantNonces(k, ctx, tss, chain, 2, 5)
+		k.SetChainNonces(ctx, types.ChainNonces{
+			Index:   chain.ChainName.String(),
+			ChainId: chain.ChainId,
+			Nonce:   6,
+		})
+
+		msg, broken := keeper.PendingNoncesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "chain nonce 6 doesn't match high nonce 5")
+	})
+
+	t.Run("should be broken if a pending nonce is not mapped", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.DefaultParams())
+		tss := sample.Tss()
+		k.SetTSS(ctx, tss)
+		setInvariantNonces(k, ctx, tss, chain, 2, 5)
+		k.RemoveNonceToCctx(ctx, types.NonceToCctx{
+			ChainId: chain.ChainId,
+			Nonce:   3,
+			Tss:     tss.TssPubkey,
+		})
+
+		msg, broken := keeper.PendingNoncesInvariant(*k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "pending nonce 3 not mapped")
+	})
+}
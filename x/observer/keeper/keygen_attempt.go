@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetKeygenAttempt set a keygen attempt in the store, the attempt count is updated if necessary
+func (k Keeper) SetKeygenAttempt(ctx sdk.Context, attempt types.KeygenAttempt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptKey))
+	b := k.cdc.MustMarshal(&attempt)
+	store.Set(sdk.Uint64ToBigEndian(attempt.Index), b)
+
+	if attempt.Index > k.GetKeygenAttemptCount(ctx) {
+		k.setKeygenAttemptCount(ctx, attempt.Index)
+	}
+}
+
+// GetKeygenAttempt returns a keygen attempt from its index
+func (k Keeper) GetKeygenAttempt(ctx sdk.Context, index uint64) (val types.KeygenAttempt, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptKey))
+	b := store.Get(sdk.Uint64ToBigEndian(index))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeygenAttempts returns all keygen attempts ordered by index
+func (k Keeper) GetAllKeygenAttempts(ctx sdk.Context) (list []types.KeygenAttempt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeygenAttempt
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetAllKeygenAttemptsPaginated returns the keygen attempts ordered by index with pagination
+func (k Keeper) GetAllKeygenAttemptsPaginated(ctx sdk.Context, pagination *query.PageRequest) (list []types.KeygenAttempt, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptKey))
+	pageRes, err = query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var attempt types.KeygenAttempt
+		if err := k.cdc.Unmarshal(value, &attempt); err != nil {
+			return err
+		}
+		list = append(list, attempt)
+		return nil
+	})
+	return
+}
+
+// GetKeygenAttemptCount returns the number of keygen attempts, which is also the index of the latest attempt
+func (k Keeper) GetKeygenAttemptCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptCountKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+func (k Keeper) setKeygenAttemptCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeygenAttemptCountKey))
+	store.Set([]byte{0}, sdk.Uint64ToBigEndian(count))
+}
+
+// GetLatestKeygenAttempt returns the latest keygen attempt
+func (k Keeper) GetLatestKeygenAttempt(ctx sdk.Context) (types.KeygenAttempt, bool) {
+	count := k.GetKeygenAttemptCount(ctx)
+	if count == 0 {
+		return types.KeygenAttempt{}, false
+	}
+	return k.GetKeygenAttempt(ctx, count)
+}
+
+// StartKeygenAttempt records a new keygen attempt for the keygen, the latest attempt is aborted if still pending
+// retryOf is the index of the failed attempt retried by the new attempt, 0 if none
+func (k Keeper) StartKeygenAttempt(ctx sdk.Context, keygen types.Keygen, retryOf uint64) types.KeygenAttempt {
+	latest, found := k.GetLatestKeygenAttempt(ctx)
+	if found && !latest.IsFinalized() {
+		latest.Status = types.KeygenAttemptStatus_AttemptAborted
+		latest.FinalizedHeight = ctx.BlockHeight()
+		k.SetKeygenAttempt(ctx, latest)
+	}
+
+	// resolve the operator of each grantee to track the reported outcomes
+	operators := make(map[string]string)
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if nodeAccount.GranteePubkey != nil {
+			operators[nodeAccount.GranteePubkey.Secp256k1.String()] = nodeAccount.Operator
+		}
+	}
+	reports := make([]types.KeygenGranteeReport, len(keygen.GranteePubkeys))
+	for i, pubkey := range keygen.GranteePubkeys {
+		reports[i] = types.KeygenGranteeReport{
+			GranteePubkey: pubkey,
+			Operator:      operators[pubkey],
+			Outcome:       types.KeygenOutcome_NotReported,
+		}
+	}
+
+	attempt := types.KeygenAttempt{
+		Index:          k.GetKeygenAttemptCount(ctx) + 1,
+		BlockNumber:    keygen.BlockNumber,
+		GranteePubkeys: keygen.GranteePubkeys,
+		Status:         types.KeygenAttemptStatus_AttemptPending,
+		Reports:        reports,
+		CreatedHeight:  ctx.BlockHeight(),
+		RetryOf:        retryOf,
+	}
+	k.SetKeygenAttempt(ctx, attempt)
+	return attempt
+}
+
+// RecordKeygenReport records the outcome reported by the operator for the pending keygen attempt
+func (k Keeper) RecordKeygenReport(ctx sdk.Context, operator string, status common.ReceiveStatus, tssPubkey string) {
+	attempt, found := k.GetLatestKeygenAttempt(ctx)
+	if !found || attempt.IsFinalized() {
+		return
+	}
+	for i, report := range attempt.Reports {
+		if report.Operator != operator {
+			continue
+		}
+		switch status {
+		case common.ReceiveStatus_Success:
+			attempt.Reports[i].Outcome = types.KeygenOutcome_ReportedSuccess
+		case common.ReceiveStatus_Failed:
+			attempt.Reports[i].Outcome = types.KeygenOutcome_ReportedFailure
+		default:
+			return
+		}
+		attempt.Reports[i].TssPubkey = tssPubkey
+		attempt.Reports[i].ReportedHeight = ctx.BlockHeight()
+		k.SetKeygenAttempt(ctx, attempt)
+		return
+	}
+}
+
+// FinalizeKeygenAttempt sets the final status of the pending keygen attempt
+// if the attempt failed, a retry is proposed at a later block, it must be approved by the admin policy to be scheduled
+func (k Keeper) FinalizeKeygenAttempt(ctx sdk.Context, success bool) {
+	attempt, found := k.GetLatestKeygenAttempt(ctx)
+	if !found || attempt.IsFinalized() {
+		return
+	}
+	attempt.FinalizedHeight = ctx.BlockHeight()
+	if success {
+		attempt.Status = types.KeygenAttemptStatus_AttemptSucceeded
+	} else {
+		attempt.Status = types.KeygenAttemptStatus_AttemptFailed
+		attempt.ProposedRetryBlock = ctx.BlockHeight() + types.KeygenRetryBlockDelay
+	}
+	k.SetKeygenAttempt(ctx, attempt)
+	EmitEventKeygenAttemptFinalized(ctx, attempt)
+}
+
+// RecordKeygenBlame adds the nodes blamed by a keygen blame record to the keygen attempt at the blamed block
+// blame records that are not related to a keygen are ignored
+func (k Keeper) RecordKeygenBlame(ctx sdk.Context, blame types.Blame) {
+	keygenBlock, ok := types.ParseKeygenBlameIndex(blame.Index)
+	if !ok {
+		return
+	}
+
+	// blame votes can be finalized after the keygen attempt, therefore the recent attempts are searched from the latest
+	for index := k.GetKeygenAttemptCount(ctx); index > 0; index-- {
+		attempt, found := k.GetKeygenAttempt(ctx, index)
+		if !found || attempt.BlockNumber != keygenBlock {
+			continue
+		}
+		for _, node := range blame.Nodes {
+			attempt.AddBlamedPubkey(node.PubKey)
+		}
+		k.SetKeygenAttempt(ctx, attempt)
+		return
+	}
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_KeygenAttempt(t *testing.T) {
+	t.Run("should start attempts and abort pending attempt", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		nodeAccount := sample.NodeAccount()
+		k.SetNodeAccount(ctx, *nodeAccount)
+		keygen := types.Keygen{
+			GranteePubkeys: []string{nodeAccount.GranteePubkey.Secp256k1.String()},
+			BlockNumber:    100,
+		}
+
+		_, found := k.GetLatestKeygenAttempt(ctx)
+		require.False(t, found)
+
+		first := k.StartKeygenAttempt(ctx, keygen, 0)
+		require.EqualValues(t, 1, first.Index)
+		require.Equal(t, types.KeygenAttemptStatus_AttemptPending, first.Status)
+		require.Len(t, first.Reports, 1)
+		require.Equal(t, nodeAccount.Operator, first.Reports[0].Operator)
+		require.Equal(t, types.KeygenOutcome_NotReported, first.Reports[0].Outcome)
+
+		second := k.StartKeygenAttempt(ctx, keygen, 0)
+		require.EqualValues(t, 2, second.Index)
+		require.EqualValues(t, 2, k.GetKeygenAttemptCount(ctx))
+
+		first, found = k.GetKeygenAttempt(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, types.KeygenAttemptStatus_AttemptAborted, first.Status)
+		require.Len(t, k.GetAllKeygenAttempts(ctx), 2)
+	})
+
+	t.Run("should record reports and finalize attempt", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		nodeAccount := sample.NodeAccount()
+		k.SetNodeAccount(ctx, *nodeAccount)
+		k.StartKeygenAttempt(ctx, types.Keygen{
+			GranteePubkeys: []string{nodeAccount.GranteePubkey.Secp256k1.String()},
+			BlockNumber:    100,
+		}, 0)
+
+		k.RecordKeygenReport(ctx, nodeAccount.Operator, common.ReceiveStatus_Failed, "")
+		k.FinalizeKeygenAttempt(ctx, false)
+
+		attempt, found := k.GetLatestKeygenAttempt(ctx)
+		require.True(t, found)
+		require.Equal(t, types.KeygenAttemptStatus_AttemptFailed, attempt.Status)
+		require.Equal(t, types.KeygenOutcome_ReportedFailure, attempt.Reports[0].Outcome)
+		require.Equal(t, ctx.BlockHeight()+types.KeygenRetryBlockDelay, attempt.ProposedRetryBlock)
+
+		// finalized attempts are not updated
+		k.RecordKeygenReport(ctx, nodeAccount.Operator, common.ReceiveStatus_Success, "pubkey")
+		k.FinalizeKeygenAttempt(ctx, true)
+		attempt, _ = k.GetLatestKeygenAttempt(ctx)
+		require.Equal(t, types.KeygenAttemptStatus_AttemptFailed, attempt.Status)
+		require.Equal(t, types.KeygenOutcome_ReportedFailure, attempt.Reports[0].Outcome)
+	})
+
+	t.Run("should record keygen blame", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		k.StartKeygenAttempt(ctx, types.Keygen{GranteePubkeys: []string{"a", "b"}, BlockNumber: 100}, 0)
+
+		// blame unrelated to the keygen is ignored
+		k.RecordKeygenBlame(ctx, types.Blame{
+			Index: "1-0xabcdef-42",
+			Nodes: []*types.Node{{PubKey: "a"}},
+		})
+		k.RecordKeygenBlame(ctx, types.Blame{
+			Index: types.GetKeygenBlameIndex("abcdef", 99),
+			Nodes: []*types.Node{{PubKey: "a"}},
+		})
+		attempt, _ := k.GetLatestKeygenAttempt(ctx)
+		require.Empty(t, attempt.BlamedPubkeys)
+
+		k.RecordKeygenBlame(ctx, types.Blame{
+			Index: types.GetKeygenBlameIndex("abcdef", 100),
+			Nodes: []*types.Node{{PubKey: "b"}},
+		})
+		attempt, _ = k.GetLatestKeygenAttempt(ctx)
+		require.Equal(t, []string{"b"}, attempt.BlamedPubkeys)
+	})
+}
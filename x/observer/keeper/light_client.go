@@ -0,0 +1,390 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	blst "github.com/supranational/blst/bindings/go"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// maxFinalizedAncestorDepth is the maximum number of stored ancestors of a finalized execution block marked as
+	// finalized at once
+	maxFinalizedAncestorDepth = 8192
+
+	// lightClientUpdateGas is the base gas consumed to verify a light client update
+	lightClientUpdateGas = 200_000
+
+	// syncCommitteeParticipantGas is the gas consumed per participant of the sync aggregate of an update, to aggregate
+	// its public key
+	syncCommitteeParticipantGas = 1_000
+
+	// syncCommitteePubkeyGas is the gas consumed per public key of a new next sync committee of an update, to validate
+	// and decompress it
+	syncCommitteePubkeyGas = 5_000
+)
+
+// SetLightClientState sets the state of the light client of a chain
+func (k Keeper) SetLightClientState(ctx sdk.Context, state types.LightClientState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LightClientStateKey))
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.KeyPrefix(strconv.FormatInt(state.ChainId, 10)), b)
+}
+
+// GetLightClientState returns the state of the light client of a chain
+func (k Keeper) GetLightClientState(ctx sdk.Context, chainID int64) (val types.LightClientState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LightClientStateKey))
+	b := store.Get(types.KeyPrefix(strconv.FormatInt(chainID, 10)))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetFinalizedExecutionBlock marks an execution block as finalized by the light client
+func (k Keeper) SetFinalizedExecutionBlock(ctx sdk.Context, hash []byte, number uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedExecutionBlockKey))
+	store.Set(hash, sdk.Uint64ToBigEndian(number))
+}
+
+// IsExecutionBlockFinalized returns true if the execution block is finalized by the light client
+func (k Keeper) IsExecutionBlockFinalized(ctx sdk.Context, hash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedExecutionBlockKey))
+	return store.Has(hash)
+}
+
+// RemoveFinalizedExecutionBlock removes the finalization mark of an execution block
+func (k Keeper) RemoveFinalizedExecutionBlock(ctx sdk.Context, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedExecutionBlockKey))
+	store.Delete(hash)
+}
+
+// SetSyncCommitteePubkeys sets the uncompressed public keys of the sync committee of a period of the light client of a
+// chain, the public keys must have been validated
+func (k Keeper) SetSyncCommitteePubkeys(ctx sdk.Context, chainID int64, period uint64, pubkeys [][]byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SyncCommitteePubkeysKey))
+	store.Set(syncCommitteePubkeysKey(chainID, period), bytes.Join(pubkeys, nil))
+}
+
+// GetSyncCommitteePubkeys returns the uncompressed public keys of the sync committee of a period of the light client
+// of a chain
+func (k Keeper) GetSyncCommitteePubkeys(ctx sdk.Context, chainID int64, period uint64) ([][]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SyncCommitteePubkeysKey))
+	b := store.Get(syncCommitteePubkeysKey(chainID, period))
+	if b == nil {
+		return nil, false
+	}
+	pubkeys := make([][]byte, 0, len(b)/beacon.SerializedPublicKeyLength)
+	for i := 0; i+beacon.SerializedPublicKeyLength <= len(b); i += beacon.SerializedPublicKeyLength {
+		pubkeys = append(pubkeys, b[i:i+beacon.SerializedPublicKeyLength])
+	}
+	return pubkeys, true
+}
+
+// RemoveSyncCommitteePubkeys removes the uncompressed public keys of the sync committee of a period of the light
+// client of a chain
+func (k Keeper) RemoveSyncCommitteePubkeys(ctx sdk.Context, chainID int64, period uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SyncCommitteePubkeysKey))
+	store.Delete(syncCommitteePubkeysKey(chainID, period))
+}
+
+func syncCommitteePubkeysKey(chainID int64, period uint64) []byte {
+	return types.KeyPrefix(fmt.Sprintf("%d-%d", chainID, period))
+}
+
+// setSyncCommittee stores the public keys of a sync committee decompressed once, the signatures of the updates are
+// verified against the stored keys
+func (k Keeper) setSyncCommittee(ctx sdk.Context, chainID int64, period uint64, committee types.SyncCommittee) error {
+	pubkeys := make([][]byte, len(committee.Pubkeys))
+	for i, pubkey := range committee.Pubkeys {
+		p, err := beacon.DecompressPublicKey(pubkey)
+		if err != nil {
+			return fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		pubkeys[i] = beacon.SerializePublicKey(p)
+	}
+	k.SetSyncCommitteePubkeys(ctx, chainID, period, pubkeys)
+	return nil
+}
+
+// InitLightClientFromBootstrap initializes the light client of a chain from a trusted bootstrap
+func (k Keeper) InitLightClientFromBootstrap(ctx sdk.Context, chainID int64, bootstrap types.LightClientBootstrap) error {
+	config, err := common.BeaconConfigFromChainID(chainID)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrSupportedChains, err.Error())
+	}
+	if err := bootstrap.Validate(config); err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+	period := beacon.SyncCommitteePeriodAtSlot(bootstrap.Header.Beacon.Slot)
+	if err := k.setSyncCommittee(ctx, chainID, period, bootstrap.CurrentSyncCommittee); err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+	k.SetLightClientState(ctx, types.LightClientState{
+		ChainId:              chainID,
+		FinalizedHeader:      bootstrap.Header,
+		CurrentSyncCommittee: bootstrap.CurrentSyncCommittee,
+	})
+	k.finalizeExecutionBlock(ctx, bootstrap.Header.Execution)
+	return nil
+}
+
+// ProcessLightClientUpdate verifies a finalized beacon block header signed by a sync committee and applies it to the
+// light client of a chain, the execution block of the finalized header is marked as finalized
+// Only the updates signed by a supermajority of the sync committee are applied, following the light client sync
+// protocol: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md
+func (k Keeper) ProcessLightClientUpdate(ctx sdk.Context, chainID int64, update types.LightClientUpdate) error {
+	config, err := common.BeaconConfigFromChainID(chainID)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrSupportedChains, err.Error())
+	}
+	state, found := k.GetLightClientState(ctx, chainID)
+	if !found {
+		return cosmoserrors.Wrapf(types.ErrLightClientNotInitialized, "chain id %d", chainID)
+	}
+	// the update is permissionless, the verification is paid before it is done
+	ctx.GasMeter().ConsumeGas(lightClientUpdateGasCost(state, update), "light client update")
+	if err := validateLightClientUpdate(config, state, update); err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+	if err := k.verifySyncAggregate(ctx, config, state, update); err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+
+	// apply the update
+	storePeriod := beacon.SyncCommitteePeriodAtSlot(state.FinalizedHeader.Beacon.Slot)
+	finalizedPeriod := beacon.SyncCommitteePeriodAtSlot(update.FinalizedHeader.Beacon.Slot)
+	if state.NextSyncCommittee == nil {
+		if finalizedPeriod != storePeriod {
+			return cosmoserrors.Wrapf(
+				types.ErrInvalidLightClientUpdate,
+				"next sync committee unknown, finalized period %d must be period %d",
+				finalizedPeriod,
+				storePeriod,
+			)
+		}
+		state.NextSyncCommittee = update.NextSyncCommittee
+		if err := k.setNextSyncCommittee(ctx, chainID, finalizedPeriod, state.NextSyncCommittee); err != nil {
+			return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+		}
+	} else if finalizedPeriod == storePeriod+1 {
+		state.CurrentSyncCommittee = *state.NextSyncCommittee
+		state.NextSyncCommittee = update.NextSyncCommittee
+		k.RemoveSyncCommitteePubkeys(ctx, chainID, storePeriod)
+		if err := k.setNextSyncCommittee(ctx, chainID, finalizedPeriod, state.NextSyncCommittee); err != nil {
+			return cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+		}
+	}
+	if update.FinalizedHeader.Beacon.Slot > state.FinalizedHeader.Beacon.Slot {
+		state.FinalizedHeader = update.FinalizedHeader
+		k.finalizeExecutionBlock(ctx, update.FinalizedHeader.Execution)
+	}
+	k.SetLightClientState(ctx, state)
+	return nil
+}
+
+// setNextSyncCommittee stores the public keys of the next sync committee of a period if it is known
+func (k Keeper) setNextSyncCommittee(ctx sdk.Context, chainID int64, period uint64, committee *types.SyncCommittee) error {
+	if committee == nil {
+		return nil
+	}
+	return k.setSyncCommittee(ctx, chainID, period+1, *committee)
+}
+
+// lightClientUpdateGasCost returns the gas of the verification of an update, proportional to the number of
+// participants of its sync aggregate and to the size of its next sync committee if it is not known
+func lightClientUpdateGasCost(state types.LightClientState, update types.LightClientUpdate) uint64 {
+	// #nosec G701 always positive
+	gas := lightClientUpdateGas + uint64(update.SyncAggregate.ParticipantCount())*syncCommitteeParticipantGas
+	if isNewNextSyncCommittee(state, update) {
+		// #nosec G701 always positive
+		gas += uint64(len(update.NextSyncCommittee.Pubkeys)) * syncCommitteePubkeyGas
+	}
+	return gas
+}
+
+// isNewNextSyncCommittee returns true if the update provides a next sync committee different from the known one
+func isNewNextSyncCommittee(state types.LightClientState, update types.LightClientUpdate) bool {
+	return update.IsSyncCommitteeUpdate() &&
+		(state.NextSyncCommittee == nil || !isSameSyncCommittee(*state.NextSyncCommittee, *update.NextSyncCommittee))
+}
+
+// validateLightClientUpdate checks the update is relevant for the light client and its headers and next sync committee
+// are proven against the attested header
+func validateLightClientUpdate(config *beacon.Config, state types.LightClientState, update types.LightClientUpdate) error {
+	if err := update.ValidateBasic(); err != nil {
+		return err
+	}
+	if !update.SyncAggregate.HasSupermajority() {
+		return fmt.Errorf("sync committee participation %d below supermajority", update.SyncAggregate.ParticipantCount())
+	}
+
+	// the signature period must have a known sync committee
+	storePeriod := beacon.SyncCommitteePeriodAtSlot(state.FinalizedHeader.Beacon.Slot)
+	signaturePeriod := beacon.SyncCommitteePeriodAtSlot(update.SignatureSlot)
+	if signaturePeriod != storePeriod && (state.NextSyncCommittee == nil || signaturePeriod != storePeriod+1) {
+		return fmt.Errorf("signature period %d without known sync committee, current period %d", signaturePeriod, storePeriod)
+	}
+
+	// the update must finalize a newer header, or provide the unknown next sync committee
+	attestedPeriod := beacon.SyncCommitteePeriodAtSlot(update.AttestedHeader.Beacon.Slot)
+	finalizedPeriod := beacon.SyncCommitteePeriodAtSlot(update.FinalizedHeader.Beacon.Slot)
+	providesNextSyncCommittee := state.NextSyncCommittee == nil &&
+		update.IsSyncCommitteeUpdate() &&
+		attestedPeriod == storePeriod &&
+		finalizedPeriod == attestedPeriod
+	if update.FinalizedHeader.Beacon.Slot <= state.FinalizedHeader.Beacon.Slot && !providesNextSyncCommittee {
+		return fmt.Errorf(
+			"finalized slot %d not after slot %d of the light client",
+			update.FinalizedHeader.Beacon.Slot,
+			state.FinalizedHeader.Beacon.Slot,
+		)
+	}
+
+	if err := update.AttestedHeader.Validate(config); err != nil {
+		return fmt.Errorf("invalid attested header: %w", err)
+	}
+	if err := update.FinalizedHeader.Validate(config); err != nil {
+		return fmt.Errorf("invalid finalized header: %w", err)
+	}
+	attestedStateRoot, err := beacon.RootFromBytes(update.AttestedHeader.Beacon.StateRoot)
+	if err != nil {
+		return fmt.Errorf("invalid attested state root: %w", err)
+	}
+
+	// the finalized header is proven against the state of the attested header
+	finalizedRoot, err := update.FinalizedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("invalid finalized header: %w", err)
+	}
+	gindex := config.FinalizedRootGIndexAtSlot(update.AttestedHeader.Beacon.Slot)
+	if err := beacon.VerifyMerkleBranch(finalizedRoot, update.FinalityBranch, gindex, attestedStateRoot); err != nil {
+		return fmt.Errorf("invalid finality branch: %w", err)
+	}
+
+	if update.IsSyncCommitteeUpdate() {
+		// the next sync committee of the current period is already known
+		if attestedPeriod == storePeriod && state.NextSyncCommittee != nil && !isSameSyncCommittee(*state.NextSyncCommittee, *update.NextSyncCommittee) {
+			return fmt.Errorf("next sync committee of period %d differs from the known one", attestedPeriod+1)
+		}
+		nextSyncCommitteeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("invalid next sync committee: %w", err)
+		}
+		gindex := config.NextSyncCommitteeGIndexAtSlot(update.AttestedHeader.Beacon.Slot)
+		if err := beacon.VerifyMerkleBranch(nextSyncCommitteeRoot, update.NextSyncCommitteeBranch, gindex, attestedStateRoot); err != nil {
+			return fmt.Errorf("invalid next sync committee branch: %w", err)
+		}
+		// the known next sync committee has been validated
+		if isNewNextSyncCommittee(state, update) {
+			if err := update.NextSyncCommittee.Validate(); err != nil {
+				return fmt.Errorf("invalid next sync committee: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// verifySyncAggregate verifies the attested header of the update is signed by the participants of the sync committee
+// of the signature period, with the stored public keys of the sync committee
+func (k Keeper) verifySyncAggregate(
+	ctx sdk.Context,
+	config *beacon.Config,
+	state types.LightClientState,
+	update types.LightClientUpdate,
+) error {
+	signaturePeriod := beacon.SyncCommitteePeriodAtSlot(update.SignatureSlot)
+	syncCommittee, found := k.GetSyncCommitteePubkeys(ctx, state.ChainId, signaturePeriod)
+	if !found {
+		return fmt.Errorf("public keys of the sync committee of period %d not found", signaturePeriod)
+	}
+
+	// the stored public keys have been validated
+	pubkeys := make([]*blst.P1Affine, 0, update.SyncAggregate.ParticipantCount())
+	for i, pubkey := range syncCommittee {
+		if !update.SyncAggregate.IsParticipant(i) {
+			continue
+		}
+		p, err := beacon.DeserializeValidatedPublicKey(pubkey)
+		if err != nil {
+			return fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		pubkeys = append(pubkeys, p)
+	}
+
+	attestedRoot, err := update.AttestedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	signingRoot := beacon.ComputeSigningRoot(attestedRoot, config.SyncCommitteeDomain(update.SignatureSlot))
+	if err := beacon.FastAggregateVerify(pubkeys, signingRoot[:], update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return fmt.Errorf("invalid sync committee signature: %w", err)
+	}
+	return nil
+}
+
+// finalizeExecutionBlock marks an execution block as finalized with its stored ancestors
+func (k Keeper) finalizeExecutionBlock(ctx sdk.Context, execution types.ExecutionPayloadHeader) {
+	k.SetFinalizedExecutionBlock(ctx, execution.BlockHash, execution.BlockNumber)
+	k.finalizeStoredAncestors(ctx, execution.BlockHash)
+}
+
+// finalizeStoredAncestors marks the stored ancestors of a finalized block header as finalized, down to the first
+// finalized ancestor whose ancestors have been marked when it was finalized or stored
+func (k Keeper) finalizeStoredAncestors(ctx sdk.Context, hash []byte) {
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return
+	}
+	for i := 0; i < maxFinalizedAncestorDepth; i++ {
+		parent, found := k.GetBlockHeader(ctx, header.ParentHash)
+		if !found || k.IsExecutionBlockFinalized(ctx, parent.Hash) {
+			return
+		}
+		// #nosec G701 always positive
+		k.SetFinalizedExecutionBlock(ctx, parent.Hash, uint64(parent.Height))
+		header = parent
+	}
+}
+
+// checkBlockHeaderFinalized checks an Ethereum block header is finalized by the light client of its chain, the block
+// headers of the chains without light client are not checked
+func (k Keeper) checkBlockHeaderFinalized(ctx sdk.Context, header common.BlockHeader) error {
+	if _, found := k.GetLightClientState(ctx, header.ChainId); !found {
+		return nil
+	}
+	return k.CheckBlockHeaderFinalized(ctx, header)
+}
+
+// CheckBlockHeaderFinalized checks an Ethereum block header is finalized by the light client of its chain, the light
+// client of the chain must be initialized
+func (k Keeper) CheckBlockHeaderFinalized(ctx sdk.Context, header common.BlockHeader) error {
+	if _, found := k.GetLightClientState(ctx, header.ChainId); !found {
+		return cosmoserrors.Wrapf(types.ErrLightClientNotInitialized, "chain %d", header.ChainId)
+	}
+	if !k.IsExecutionBlockFinalized(ctx, header.Hash) {
+		return cosmoserrors.Wrapf(types.ErrBlockHeaderNotConfirmed, "block %d %x not finalized by the light client", header.Height, header.Hash)
+	}
+	return nil
+}
+
+// isSameSyncCommittee returns true if the sync committees have the same public keys
+func isSameSyncCommittee(a, b types.SyncCommittee) bool {
+	if len(a.Pubkeys) != len(b.Pubkeys) || !bytes.Equal(a.AggregatePubkey, b.AggregatePubkey) {
+		return false
+	}
+	for i := range a.Pubkeys {
+		if !bytes.Equal(a.Pubkeys[i], b.Pubkeys[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// lightClientPeriod is a sync committee period of Sepolia after Electra
+	lightClientPeriod = 869
+
+	// lightClientSlot is the first slot of the sync committee period
+	lightClientSlot = lightClientPeriod * beacon.SlotsPerEpoch * beacon.EpochsPerSyncCommitteePeriod
+
+	// supermajority is the minimum number of participants of a sync committee for an update to be applied
+	supermajority = 342
+)
+
+// lightClientBlockHash returns the hash of a fixture execution block
+func lightClientBlockHash(n byte) []byte {
+	return append(make([]byte, 31), n)
+}
+
+// setupLightClient initializes the light client of Sepolia from a bootstrap at the first slot of the sync committee period
+func setupLightClient(t *testing.T) (*keeper.Keeper, sdk.Context, *sample.LightClientFixture, types.LightClientHeader) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+	header := fixture.Header(t, lightClientSlot, 1000, lightClientBlockHash(1))
+	bootstrap := fixture.Bootstrap(t, header)
+	require.NoError(t, k.InitLightClientFromBootstrap(ctx, common.SepoliaChain().ChainId, bootstrap))
+	return k, ctx, fixture, bootstrap.Header
+}
+
+func TestKeeper_InitLightClientFromBootstrap(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+
+	t.Run("should initialize the light client and finalize the execution block", func(t *testing.T) {
+		k, ctx, fixture, header := setupLightClient(t)
+
+		state, found := k.GetLightClientState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, header, state.FinalizedHeader)
+		require.Equal(t, fixture.SyncCommittee(lightClientPeriod), state.CurrentSyncCommittee)
+		require.Nil(t, state.NextSyncCommittee)
+		require.True(t, k.IsExecutionBlockFinalized(ctx, header.Execution.BlockHash))
+
+		pubkeys, found := k.GetSyncCommitteePubkeys(ctx, chainID, lightClientPeriod)
+		require.True(t, found)
+		require.Len(t, pubkeys, beacon.SyncCommitteeSize)
+		pubkey, err := beacon.DeserializeValidatedPublicKey(pubkeys[0])
+		require.NoError(t, err)
+		require.Equal(t, state.CurrentSyncCommittee.Pubkeys[0], beacon.CompressPublicKey(pubkey))
+	})
+
+	t.Run("should fail if the current sync committee branch is invalid", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+		bootstrap := fixture.Bootstrap(t, fixture.Header(t, lightClientSlot, 1000, lightClientBlockHash(1)))
+		bootstrap.CurrentSyncCommitteeBranch[0] = lightClientBlockHash(1)
+
+		err := k.InitLightClientFromBootstrap(ctx, chainID, bootstrap)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the execution branch is invalid", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+		header := fixture.Header(t, lightClientSlot, 1000, lightClientBlockHash(1))
+		header.Execution.BlockHash = lightClientBlockHash(2)
+
+		err := k.InitLightClientFromBootstrap(ctx, chainID, fixture.Bootstrap(t, header))
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the chain has no beacon chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+		bootstrap := fixture.Bootstrap(t, fixture.Header(t, lightClientSlot, 1000, lightClientBlockHash(1)))
+
+		err := k.InitLightClientFromBootstrap(ctx, common.BtcMainnetChain().ChainId, bootstrap)
+		require.ErrorIs(t, err, types.ErrSupportedChains)
+	})
+}
+
+func TestKeeper_ProcessLightClientUpdate(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+
+	t.Run("should finalize the header signed by a supermajority of the sync committee", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+
+		require.NoError(t, k.ProcessLightClientUpdate(ctx, chainID, update))
+		state, found := k.GetLightClientState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, finalized, state.FinalizedHeader)
+		require.True(t, k.IsExecutionBlockFinalized(ctx, finalized.Execution.BlockHash))
+	})
+
+	t.Run("should rotate the sync committee at the next period", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+
+		// the next sync committee is provided by an update of the current period
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, true, beacon.SyncCommitteeSize)
+		require.NoError(t, k.ProcessLightClientUpdate(ctx, chainID, update))
+		state, _ := k.GetLightClientState(ctx, chainID)
+		require.Equal(t, fixture.SyncCommittee(lightClientPeriod+1), *state.NextSyncCommittee)
+		_, found := k.GetSyncCommitteePubkeys(ctx, chainID, lightClientPeriod+1)
+		require.True(t, found)
+
+		// an update of the next period is signed by the next sync committee
+		nextPeriodSlot := uint64(lightClientSlot + beacon.SlotsPerEpoch*beacon.EpochsPerSyncCommitteePeriod)
+		finalized = fixture.Header(t, nextPeriodSlot+64, 9256, lightClientBlockHash(3))
+		update = fixture.Update(t, nextPeriodSlot+128, finalized, false, beacon.SyncCommitteeSize)
+		require.NoError(t, k.ProcessLightClientUpdate(ctx, chainID, update))
+
+		state, _ = k.GetLightClientState(ctx, chainID)
+		require.Equal(t, finalized, state.FinalizedHeader)
+		require.Equal(t, fixture.SyncCommittee(lightClientPeriod+1), state.CurrentSyncCommittee)
+		require.Nil(t, state.NextSyncCommittee)
+		require.True(t, k.IsExecutionBlockFinalized(ctx, finalized.Execution.BlockHash))
+
+		// the public keys of the previous sync committee are removed
+		_, found = k.GetSyncCommitteePubkeys(ctx, chainID, lightClientPeriod)
+		require.False(t, found)
+		_, found = k.GetSyncCommitteePubkeys(ctx, chainID, lightClientPeriod+1)
+		require.True(t, found)
+	})
+
+	t.Run("should finalize the header of a period before Electra", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+
+		// the period 518 starts at Deneb on Sepolia
+		slot := uint64(518 * beacon.SlotsPerEpoch * beacon.EpochsPerSyncCommitteePeriod)
+		require.False(t, beacon.SepoliaConfig.IsElectra(slot))
+		bootstrap := fixture.Bootstrap(t, fixture.Header(t, slot, 1000, lightClientBlockHash(1)))
+		require.NoError(t, k.InitLightClientFromBootstrap(ctx, chainID, bootstrap))
+
+		finalized := fixture.Header(t, slot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, slot+128, finalized, true, supermajority)
+		require.NoError(t, k.ProcessLightClientUpdate(ctx, chainID, update))
+		require.True(t, k.IsExecutionBlockFinalized(ctx, finalized.Execution.BlockHash))
+	})
+
+	t.Run("should fail if the sync committee of the signature period is unknown", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		nextPeriodSlot := uint64(lightClientSlot + beacon.SlotsPerEpoch*beacon.EpochsPerSyncCommitteePeriod)
+		finalized := fixture.Header(t, nextPeriodSlot+64, 9256, lightClientBlockHash(2))
+		update := fixture.Update(t, nextPeriodSlot+128, finalized, false, beacon.SyncCommitteeSize)
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the participation is below supermajority", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority-1)
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+		require.False(t, k.IsExecutionBlockFinalized(ctx, finalized.Execution.BlockHash))
+	})
+
+	t.Run("should fail if the signature doesn't match the participants", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+		update.SyncAggregate.SyncCommitteeBits[63] |= 0x80
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the finality branch is invalid", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+		update.FinalizedHeader = fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(3))
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the finalized header is not newer", func(t *testing.T) {
+		k, ctx, fixture, header := setupLightClient(t)
+		update := fixture.Update(t, lightClientSlot+128, header, false, supermajority)
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+
+	t.Run("should fail if the light client is not initialized", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrLightClientNotInitialized)
+	})
+
+	t.Run("should consume the gas of the participants before verifying the update", func(t *testing.T) {
+		k, ctx, fixture, header := setupLightClient(t)
+		update := fixture.Update(t, lightClientSlot+128, header, false, beacon.SyncCommitteeSize)
+
+		// the update is invalid, the gas of its verification is consumed
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		err := k.ProcessLightClientUpdate(ctx, chainID, update)
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(200_000+beacon.SyncCommitteeSize*1_000))
+
+		// the verification is not done without the gas
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(500_000))
+		require.Panics(t, func() {
+			_ = k.ProcessLightClientUpdate(ctx, chainID, update)
+		})
+	})
+}
+
+func TestKeeper_CheckBlockHeaderFinalized(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+
+	// ethHeaders returns stored block headers of a chain of execution blocks from the block number
+	ethHeaders := func(ctx sdk.Context, k *keeper.Keeper, number int64, hashes ...byte) []common.BlockHeader {
+		headers := make([]common.BlockHeader, len(hashes))
+		parentHash := lightClientBlockHash(0)
+		for i, hash := range hashes {
+			headers[i] = common.BlockHeader{
+				Height:     number + int64(i),
+				Hash:       lightClientBlockHash(hash),
+				ParentHash: parentHash,
+				ChainId:    chainID,
+			}
+			k.SetBlockHeader(ctx, headers[i])
+			parentHash = headers[i].Hash
+		}
+		return headers
+	}
+
+	t.Run("should finalize the stored ancestors of a finalized block", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		headers := ethHeaders(ctx, k, 1062, 10, 11, 12)
+		for _, header := range headers {
+			require.ErrorIs(t, k.CheckBlockHeaderConfirmed(ctx, header, 1), types.ErrBlockHeaderNotConfirmed)
+		}
+
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, headers[2].Hash)
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+		require.NoError(t, k.ProcessLightClientUpdate(ctx, chainID, update))
+		for _, header := range headers {
+			require.NoError(t, k.CheckBlockHeaderConfirmed(ctx, header, 1))
+		}
+	})
+
+	t.Run("should not check the block headers of a chain without light client", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		header := common.BlockHeader{Height: 1, Hash: lightClientBlockHash(1), ChainId: chainID}
+		require.NoError(t, k.CheckBlockHeaderConfirmed(ctx, header, 1))
+	})
+}
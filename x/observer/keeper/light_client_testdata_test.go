@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// updateLightClientTestdata regenerates the light client testdata with the fixture sync committees
+var updateLightClientTestdata = flag.Bool("update-light-client-testdata", false, "regenerate the light client testdata")
+
+// lightClientTestdataDir is the directory of the light client testdata, a directory per network with a bootstrap and
+// the updates applied in the order of their file names, in the JSON format of the beacon node API. The files are signed
+// by the fixture sync committees at the slots of the networks, responses of the bootstrap, updates and finality update
+// endpoints of a beacon node can replace them as is
+const lightClientTestdataDir = "testdata/light_client"
+
+// periodSlot returns the first slot of a sync committee period
+func periodSlot(period uint64) uint64 {
+	return period * beacon.SlotsPerEpoch * beacon.EpochsPerSyncCommitteePeriod
+}
+
+// testdataBlockHash returns the hash of a testdata execution block
+func testdataBlockHash(network string, number uint64) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s block %d", network, number)))
+}
+
+// lightClientTestdata are the bootstrap and the updates of a network
+type lightClientTestdata struct {
+	bootstrap types.LightClientBootstrap
+	updates   []types.LightClientUpdate
+}
+
+// generateLightClientTestdata generates the light client testdata of a network
+func generateLightClientTestdata(t *testing.T, network string, config *beacon.Config) lightClientTestdata {
+	fixture := sample.NewLightClientFixture(config)
+	header := func(slot, number uint64) types.LightClientHeader {
+		return fixture.Header(t, slot, number, testdataBlockHash(network, number))
+	}
+
+	switch network {
+	case "mainnet":
+		// Electra is activated at the first slot of the period 1422 on mainnet, the first update provides the next
+		// sync committee at Deneb, the second one is attested at Electra and finalizes a Deneb header, the last one
+		// rotates the sync committee at Electra
+		electra := periodSlot(1422)
+		return lightClientTestdata{
+			bootstrap: fixture.Bootstrap(t, header(periodSlot(1421), 22_000_000)),
+			updates: []types.LightClientUpdate{
+				fixture.Update(t, periodSlot(1421)+96, header(periodSlot(1421)+32, 22_000_032), true, 400),
+				fixture.Update(t, electra+32, header(electra-64, 22_008_128), true, 450),
+				fixture.Update(t, electra+160, header(electra+64, 22_008_256), true, beacon.SyncCommitteeSize),
+			},
+		}
+	case "sepolia":
+		// Electra is activated at the first slot of the period 869 on Sepolia, the bootstrap is an Electra header of
+		// the next period and the last update is a finality update rotating the sync committee
+		slot := periodSlot(870)
+		return lightClientTestdata{
+			bootstrap: fixture.Bootstrap(t, header(slot, 8_000_000)),
+			updates: []types.LightClientUpdate{
+				fixture.Update(t, slot+96, header(slot+32, 8_000_032), true, 500),
+				fixture.Update(t, periodSlot(871)+96, header(periodSlot(871)+32, 8_008_224), false, 342),
+			},
+		}
+	default:
+		t.Fatalf("unknown network %s", network)
+		return lightClientTestdata{}
+	}
+}
+
+// writeLightClientTestdata writes the bootstrap and the updates of a network, the sync committee updates are written
+// as served by the updates endpoint and the finality updates as served by the finality update endpoint
+func writeLightClientTestdata(t *testing.T, network string, config *beacon.Config, testdata lightClientTestdata) {
+	dir := filepath.Join(lightClientTestdataDir, network)
+	require.NoError(t, os.RemoveAll(dir))
+	require.NoError(t, os.MkdirAll(dir, 0o750))
+
+	write := func(name string, v interface{}) {
+		bz, err := json.MarshalIndent(v, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), append(bz, '\n'), 0o600))
+	}
+	write("bootstrap.json", sample.BeaconAPIEnvelope(
+		sample.BeaconAPIVersion(config, testdata.bootstrap.Header.Beacon.Slot),
+		sample.BeaconAPILightClientBootstrap(testdata.bootstrap),
+	))
+	for i, update := range testdata.updates {
+		envelope := sample.BeaconAPIEnvelope(
+			sample.BeaconAPIVersion(config, update.AttestedHeader.Beacon.Slot),
+			sample.BeaconAPILightClientUpdate(update),
+		)
+		if update.IsSyncCommitteeUpdate() {
+			write(fmt.Sprintf("update_%d.json", i+1), []interface{}{envelope})
+		} else {
+			write(fmt.Sprintf("update_%d.json", i+1), envelope)
+		}
+	}
+}
+
+// readLightClientTestdata reads and parses the bootstrap and the updates of a network
+func readLightClientTestdata(t *testing.T, network string) lightClientTestdata {
+	dir := filepath.Join(lightClientTestdataDir, network)
+	bz, err := os.ReadFile(filepath.Join(dir, "bootstrap.json"))
+	require.NoError(t, err)
+	bootstrap, err := types.ParseBeaconAPILightClientBootstrap(bz)
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "update_*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+	updates := make([]types.LightClientUpdate, len(files))
+	for i, file := range files {
+		bz, err := os.ReadFile(file)
+		require.NoError(t, err)
+		updates[i], err = types.ParseBeaconAPILightClientUpdate(bz)
+		require.NoError(t, err, file)
+	}
+	return lightClientTestdata{bootstrap: bootstrap, updates: updates}
+}
+
+func TestKeeper_LightClientTestdata(t *testing.T) {
+	for _, tc := range []struct {
+		network string
+		chain   common.Chain
+	}{
+		{network: "mainnet", chain: common.EthChain()},
+		{network: "sepolia", chain: common.SepoliaChain()},
+	} {
+		tc := tc
+		t.Run(tc.network, func(t *testing.T) {
+			config, err := common.BeaconConfigFromChainID(tc.chain.ChainId)
+			require.NoError(t, err)
+			if *updateLightClientTestdata {
+				writeLightClientTestdata(t, tc.network, config, generateLightClientTestdata(t, tc.network, config))
+			}
+			testdata := readLightClientTestdata(t, tc.network)
+
+			k, ctx := keepertest.ObserverKeeper(t)
+			require.NoError(t, k.InitLightClientFromBootstrap(ctx, tc.chain.ChainId, testdata.bootstrap))
+			require.True(t, k.IsExecutionBlockFinalized(ctx, testdata.bootstrap.Header.Execution.BlockHash))
+
+			for i, update := range testdata.updates {
+				require.NoError(t, k.ProcessLightClientUpdate(ctx, tc.chain.ChainId, update), "update %d", i+1)
+
+				state, found := k.GetLightClientState(ctx, tc.chain.ChainId)
+				require.True(t, found)
+				require.Equal(t, update.FinalizedHeader, state.FinalizedHeader)
+				require.True(t, k.IsExecutionBlockFinalized(ctx, update.FinalizedHeader.Execution.BlockHash))
+			}
+		})
+	}
+
+	t.Run("mainnet updates cross the Electra fork boundary", func(t *testing.T) {
+		config := &beacon.MainnetConfig
+		updates := readLightClientTestdata(t, "mainnet").updates
+		require.Len(t, updates, 3)
+
+		// the proofs of a Deneb attested header are against the gindices 105 and 55
+		require.False(t, config.IsElectra(updates[0].AttestedHeader.Beacon.Slot))
+		require.Len(t, updates[0].FinalityBranch, beacon.FinalizedRootGIndex.Depth())
+		require.Len(t, updates[0].NextSyncCommitteeBranch, beacon.NextSyncCommitteeGIndex.Depth())
+
+		// the first Electra attested header finalizes a Deneb header with the proofs against the gindices 169 and 87
+		require.True(t, config.IsElectra(updates[1].AttestedHeader.Beacon.Slot))
+		require.False(t, config.IsElectra(updates[1].FinalizedHeader.Beacon.Slot))
+		require.Len(t, updates[1].FinalityBranch, beacon.FinalizedRootGIndexElectra.Depth())
+		require.Len(t, updates[1].NextSyncCommitteeBranch, beacon.NextSyncCommitteeGIndexElectra.Depth())
+	})
+
+	t.Run("sepolia bootstrap is proven against the Electra gindex 86", func(t *testing.T) {
+		bootstrap := readLightClientTestdata(t, "sepolia").bootstrap
+		require.True(t, beacon.SepoliaConfig.IsElectra(bootstrap.Header.Beacon.Slot))
+		require.Len(t, bootstrap.CurrentSyncCommitteeBranch, beacon.CurrentSyncCommitteeGIndexElectra.Depth())
+	})
+}
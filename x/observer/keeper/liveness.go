@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetLivenessParams set the liveness params in the store
+func (k Keeper) SetLivenessParams(ctx sdk.Context, livenessParams types.LivenessParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+	b := k.cdc.MustMarshal(&livenessParams)
+	store.Set([]byte{0}, b)
+}
+
+// GetLivenessParams returns the liveness params, the default params are returned if not set
+func (k Keeper) GetLivenessParams(ctx sdk.Context) (val types.LivenessParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return types.DefaultLivenessParams()
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// SetObserverLiveness set the liveness of an observer for a chain in the store
+func (k Keeper) SetObserverLiveness(ctx sdk.Context, liveness types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := k.cdc.MustMarshal(&liveness)
+	store.Set(types.KeyPrefix(types.GetObserverLivenessIndex(liveness.ChainId, liveness.ObserverAddress)), b)
+}
+
+// GetObserverLiveness returns the liveness of an observer for a chain
+func (k Keeper) GetObserverLiveness(ctx sdk.Context, chainID int64, observerAddress string) (val types.ObserverLiveness, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := store.Get(types.KeyPrefix(types.GetObserverLivenessIndex(chainID, observerAddress)))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllObserverLiveness returns the liveness of all observers
+func (k Keeper) GetAllObserverLiveness(ctx sdk.Context) (list []types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetAllObserverLivenessPaginated returns the liveness of all observers with pagination
+func (k Keeper) GetAllObserverLivenessPaginated(ctx sdk.Context, pagination *query.PageRequest) (list []types.ObserverLiveness, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	pageRes, err = query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var liveness types.ObserverLiveness
+		if err := k.cdc.Unmarshal(value, &liveness); err != nil {
+			return err
+		}
+		list = append(list, liveness)
+		return nil
+	})
+	return
+}
+
+// IsObserverJailed returns true if the observer is jailed for the chain
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string, chainID int64) bool {
+	liveness, found := k.GetObserverLiveness(ctx, chainID, observerAddress)
+	return found && liveness.Jailed
+}
+
+// GetActiveObservers returns the observers of the list that are not jailed for the chain
+func (k Keeper) GetActiveObservers(ctx sdk.Context, observers []string, chainID int64) []string {
+	active := make([]string, 0, len(observers))
+	for _, observer := range observers {
+		if !k.IsObserverJailed(ctx, observer, chainID) {
+			active = append(active, observer)
+		}
+	}
+	return active
+}
+
+// UpdateObserverLiveness records the participation of the observers in the ballots maturing at the current height.
+// An observer missing more votes than the max missed votes in the window of a chain is jailed for the chain,
+// it is no longer added to the ballots of the chain until it unjails.
+// The last active observer of a chain is never jailed to not halt the observation of the chain.
+func (k Keeper) UpdateObserverLiveness(ctx sdk.Context) {
+	params := k.GetLivenessParams(ctx)
+	if params.WindowSize == 0 {
+		return
+	}
+
+	for _, ballotIdentifier := range k.GetMaturedBallotList(ctx) {
+		ballot, found := k.GetBallot(ctx, ballotIdentifier)
+		if !found || ballot.ChainId == 0 {
+			continue
+		}
+		for i, address := range ballot.VoterList {
+			if i >= len(ballot.Votes) {
+				break
+			}
+			liveness, found := k.GetObserverLiveness(ctx, ballot.ChainId, address)
+			if !found {
+				liveness = types.ObserverLiveness{
+					ObserverAddress: address,
+					ChainId:         ballot.ChainId,
+				}
+			}
+			if liveness.Jailed {
+				continue
+			}
+
+			previous := liveness.MissedVotesCounter
+			liveness.RecordBallot(ballot.Votes[i] == types.VoteType_NotYetVoted, params.WindowSize)
+			missed := liveness.MissedVotesCounter
+
+			if params.WarningMissedVotes > 0 && previous <= params.WarningMissedVotes && missed > params.WarningMissedVotes {
+				EmitEventObserverMissedVotes(ctx, liveness)
+			}
+			if params.MaxMissedVotes > 0 && missed > params.MaxMissedVotes {
+				if k.isLastActiveObserver(ctx, ballot.ChainId) {
+					ctx.Logger().Error(fmt.Sprintf("observer %s missed %d votes on chain %d but is the last active observer", address, missed, ballot.ChainId))
+				} else {
+					liveness.Jail(ctx.BlockHeight(), ctx.BlockHeight()+params.JailDuration)
+					EmitEventObserverJailed(ctx, liveness, missed)
+				}
+			}
+			k.SetObserverLiveness(ctx, liveness)
+		}
+	}
+}
+
+// isLastActiveObserver returns true if there is at most one observer not jailed for the chain
+func (k Keeper) isLastActiveObserver(ctx sdk.Context, chainID int64) bool {
+	chain := common.GetChainFromChainID(chainID)
+	if chain == nil {
+		return true
+	}
+	mapper, found := k.GetObserverMapper(ctx, chain)
+	if !found {
+		return true
+	}
+	return len(k.GetActiveObservers(ctx, mapper.ObserverList, chainID)) <= 1
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setupLiveness sets the observers of the chain and liveness params jailing after 2 missed votes in a window of 4 ballots
+func setupLiveness(t *testing.T, chain common.Chain) (*keeper.Keeper, sdk.Context, []string) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetLivenessParams(ctx, types.LivenessParams{
+		WindowSize:         4,
+		WarningMissedVotes: 1,
+		MaxMissedVotes:     2,
+		JailDuration:       10,
+	})
+	observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	k.SetObserverMapper(ctx, &types.ObserverMapper{
+		ObserverChain: &chain,
+		ObserverList:  observers,
+	})
+	return k, ctx, observers
+}
+
+// maturedBallotCtx adds a ballot for the chain maturing at the next block and returns the context of this block
+// the first voters of the list vote, the others miss the vote
+func maturedBallotCtx(k *keeper.Keeper, ctx sdk.Context, chainID int64, voters []string, votes int) sdk.Context {
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	ballot := types.Ballot{
+		BallotIdentifier:     fmt.Sprintf("ballot-%d", ctx.BlockHeight()),
+		VoterList:            voters,
+		Votes:                types.CreateVotes(len(voters)),
+		BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+		BallotCreationHeight: ctx.BlockHeight() - k.GetParams(ctx).BallotMaturityBlocks,
+		ChainId:              chainID,
+	}
+	for i := 0; i < votes; i++ {
+		ballot.Votes[i] = types.VoteType_SuccessObservation
+	}
+	k.SetBallot(ctx, &ballot)
+	k.AddBallotToList(ctx, ballot)
+	return ctx
+}
+
+func TestKeeper_UpdateObserverLiveness(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	t.Run("should track the missed votes and jail absent observers", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		ctx = ctx.WithBlockHeight(1000)
+
+		ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 2)
+		k.UpdateObserverLiveness(ctx)
+		liveness, found := k.GetObserverLiveness(ctx, chain.ChainId, observers[2])
+		require.True(t, found)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+		require.False(t, liveness.Jailed)
+		liveness, found = k.GetObserverLiveness(ctx, chain.ChainId, observers[0])
+		require.True(t, found)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+
+		ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 2)
+		k.UpdateObserverLiveness(ctx)
+		require.False(t, k.IsObserverJailed(ctx, observers[2], chain.ChainId))
+
+		ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 2)
+		k.UpdateObserverLiveness(ctx)
+		liveness, found = k.GetObserverLiveness(ctx, chain.ChainId, observers[2])
+		require.True(t, found)
+		require.True(t, liveness.Jailed)
+		require.Equal(t, ctx.BlockHeight(), liveness.JailedHeight)
+		require.Equal(t, ctx.BlockHeight()+10, liveness.JailedUntil)
+		require.False(t, k.IsObserverJailed(ctx, observers[0], chain.ChainId))
+
+		// the jailed observer is excluded from the new ballots and no longer authorized
+		require.Equal(t, observers[:2], k.GetActiveObservers(ctx, observers, chain.ChainId))
+		ballot, isNew, err := k.FindBallot(ctx, "new", &chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, observers[:2], ballot.VoterList)
+		require.Equal(t, chain.ChainId, ballot.ChainId)
+	})
+
+	t.Run("should not track ballots not related to a chain", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		ctx = ctx.WithBlockHeight(1000)
+
+		ctx = maturedBallotCtx(k, ctx, 0, observers, 0)
+		k.UpdateObserverLiveness(ctx)
+		require.Empty(t, k.GetAllObserverLiveness(ctx))
+	})
+
+	t.Run("should not track ballots if the window size is zero", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		k.SetLivenessParams(ctx, types.LivenessParams{})
+		ctx = ctx.WithBlockHeight(1000)
+
+		ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 0)
+		k.UpdateObserverLiveness(ctx)
+		require.Empty(t, k.GetAllObserverLiveness(ctx))
+	})
+
+	t.Run("should track the missed votes without jailing with the default params", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		k.SetLivenessParams(ctx, types.DefaultLivenessParams())
+		ctx = ctx.WithBlockHeight(1000)
+
+		for i := 0; i < 60; i++ {
+			ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 2)
+			k.UpdateObserverLiveness(ctx)
+		}
+		liveness, found := k.GetObserverLiveness(ctx, chain.ChainId, observers[2])
+		require.True(t, found)
+		require.EqualValues(t, 60, liveness.MissedVotesCounter)
+		require.False(t, liveness.Jailed)
+	})
+
+	t.Run("should not jail the last active observer", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		ctx = ctx.WithBlockHeight(1000)
+
+		for i := 0; i < 3; i++ {
+			ctx = maturedBallotCtx(k, ctx, chain.ChainId, observers, 0)
+			k.UpdateObserverLiveness(ctx)
+		}
+
+		// all observers missed the votes, the last one processed stays active
+		require.Len(t, k.GetActiveObservers(ctx, observers, chain.ChainId), 1)
+		require.False(t, k.IsObserverJailed(ctx, observers[2], chain.ChainId))
+	})
+}
+
+func TestKeeper_GetLivenessParams(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+
+	params := types.LivenessParams{WindowSize: 10}
+	k.SetLivenessParams(ctx, params)
+	require.Equal(t, params, k.GetLivenessParams(ctx))
+}
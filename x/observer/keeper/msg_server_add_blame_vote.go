@@ -52,5 +52,6 @@ func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVo
 	// ******************************************************************************
 
 	k.SetBlame(ctx, vote.BlameInfo)
+	k.RecordKeygenBlame(ctx, vote.BlameInfo)
 	return &types.MsgAddBlameVoteResponse{}, nil
 }
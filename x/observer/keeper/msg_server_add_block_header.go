@@ -44,8 +44,13 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	// the Earliest/Latest height with this block header (after voting, not here)
 	// if BlockHeaderState is found, check if the block height is valid
 	// validate block height as it's not part of the header itself
+	// for bitcoin, the header can extend any stored branch, the best chain is selected from the cumulative work
 	bhs, found := k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if found && bhs.EarliestHeight > 0 && bhs.EarliestHeight < msg.Height {
+	if found && bhs.EarliestHeight > 0 && common.IsBitcoinChain(msg.ChainId) {
+		if err := k.validateBitcoinBlockHeader(ctx, msg, bhs); err != nil {
+			return nil, err
+		}
+	} else if found && bhs.EarliestHeight > 0 && bhs.EarliestHeight < msg.Height {
 		pHash, err := msg.Header.ParentHash()
 		if err != nil {
 			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
@@ -88,8 +93,34 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	/**
 	 * Vote finalized, add block header to store
 	 */
+	bh := common.BlockHeader{
+		Header:     msg.Header,
+		Height:     msg.Height,
+		Hash:       msg.BlockHash,
+		ParentHash: pHash,
+		ChainId:    msg.ChainId,
+	}
+	k.SetBlockHeader(ctx, bh)
+
+	// the block header can be finalized by the light client before being added
+	if k.IsExecutionBlockFinalized(ctx, bh.Hash) {
+		k.finalizeStoredAncestors(ctx, bh.Hash)
+	}
+
 	bhs, found = k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if !found {
+	if common.IsBitcoinChain(msg.ChainId) {
+		if !found {
+			bhs = types.BlockHeaderState{
+				ChainId: msg.ChainId,
+			}
+		}
+		if bhs.EarliestHeight == 0 {
+			bhs.EarliestHeight = msg.Height
+		}
+		if err := k.AddBitcoinHeaderToBestChain(ctx, &bhs, bh); err != nil {
+			return nil, err
+		}
+	} else if !found {
 		bhs = types.BlockHeaderState{
 			ChainId:         msg.ChainId,
 			LatestHeight:    msg.Height,
@@ -107,14 +138,30 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	}
 	k.Keeper.SetBlockHeaderState(ctx, bhs)
 
-	bh := common.BlockHeader{
-		Header:     msg.Header,
-		Height:     msg.Height,
-		Hash:       msg.BlockHash,
-		ParentHash: pHash,
-		ChainId:    msg.ChainId,
-	}
-	k.SetBlockHeader(ctx, bh)
-
 	return &types.MsgAddBlockHeaderResponse{}, nil
 }
+
+// validateBitcoinBlockHeader checks the parent of the Bitcoin block header is stored at the previous height and
+// the target of the block header follows the difficulty transitions of the network
+// The proof-of-work of the block header against its target is checked in the basic validation of the message
+func (k msgServer) validateBitcoinBlockHeader(ctx sdk.Context, msg *types.MsgAddBlockHeader, bhs types.BlockHeaderState) error {
+	if msg.Height <= bhs.EarliestHeight {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, fmt.Sprintf("invalid block height: %d not above earliest height %d", msg.Height, bhs.EarliestHeight))
+	}
+	pHash, err := msg.Header.ParentHash()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
+	}
+	parent, found := k.GetBlockHeader(ctx, pHash)
+	if !found {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
+	}
+	if msg.Height != parent.Height+1 {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, fmt.Sprintf("invalid block height: wanted %d, got %d", parent.Height+1, msg.Height))
+	}
+	header, err := msg.Header.BitcoinHeader()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrUnrecognizedBlockHeader, err.Error())
+	}
+	return k.ValidateBitcoinHeaderDifficulty(ctx, header, msg.Height, parent)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// ApproveKeygenRetry schedules a new keygen to retry a failed keygen attempt.
+// The grantees blamed for the failure are excluded from the new keygen.
+// The proposed retry block of the attempt is used unless a block is provided in the message.
+//
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) ApproveKeygenRetry(goCtx context.Context, msg *types.MsgApproveKeygenRetry) (*types.MsgApproveKeygenRetryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group1) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+	keygen, found := k.GetKeygen(ctx)
+	if !found {
+		return nil, types.ErrKeygenNotFound
+	}
+	attempt, found := k.GetKeygenAttempt(ctx, msg.AttemptIndex)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrKeygenAttemptNotFound, "index %d", msg.AttemptIndex)
+	}
+	if attempt.Status != types.KeygenAttemptStatus_AttemptFailed {
+		return nil, cosmoserrors.Wrapf(types.ErrKeygenRetryNotAllowed, "attempt status is %s", attempt.Status.String())
+	}
+	if attempt.RetryApproved {
+		return nil, cosmoserrors.Wrap(types.ErrKeygenRetryNotAllowed, "retry already approved")
+	}
+	if attempt.Index != k.GetKeygenAttemptCount(ctx) {
+		return nil, cosmoserrors.Wrap(types.ErrKeygenRetryNotAllowed, "attempt is not the latest keygen attempt")
+	}
+
+	block := attempt.ProposedRetryBlock
+	if msg.Block != 0 {
+		block = msg.Block
+	}
+	if block <= (ctx.BlockHeight() + 10) {
+		return nil, types.ErrKeygenBlockTooLow
+	}
+	granteePubKeys := attempt.RetryGranteePubkeys()
+	if len(granteePubKeys) == 0 {
+		return nil, cosmoserrors.Wrap(types.ErrKeygenRetryNotAllowed, "all grantees are blamed")
+	}
+
+	attempt.RetryApproved = true
+	k.SetKeygenAttempt(ctx, attempt)
+
+	keygen.GranteePubkeys = granteePubKeys
+	keygen.BlockNumber = block
+	keygen.Status = types.KeygenStatus_PendingKeygen
+	k.SetKeygen(ctx, keygen)
+	k.StartKeygenAttempt(ctx, keygen, attempt.Index)
+	EmitEventKeyGenBlockUpdated(ctx, &keygen)
+	return &types.MsgApproveKeygenRetryResponse{}, nil
+}
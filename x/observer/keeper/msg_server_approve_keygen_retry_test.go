@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_ApproveKeygenRetry(t *testing.T) {
+	setupFailedAttempt := func(t *testing.T) (*keeper.Keeper, sdk.Context, string) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		keygen := types.Keygen{
+			Status:         types.KeygenStatus_PendingKeygen,
+			GranteePubkeys: []string{"a", "b", "c"},
+			BlockNumber:    100,
+		}
+		k.SetKeygen(ctx, keygen)
+		k.StartKeygenAttempt(ctx, keygen, 0)
+		k.RecordKeygenBlame(ctx, types.Blame{
+			Index: types.GetKeygenBlameIndex("abcdef", 100),
+			Nodes: []*types.Node{{PubKey: "b"}},
+		})
+		k.FinalizeKeygenAttempt(ctx, false)
+		return k, ctx, admin
+	}
+
+	t.Run("should schedule a keygen retry without the blamed grantees", func(t *testing.T) {
+		k, ctx, admin := setupFailedAttempt(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 1, 0))
+		require.NoError(t, err)
+
+		keygen, found := k.GetKeygen(ctx)
+		require.True(t, found)
+		require.Equal(t, types.KeygenStatus_PendingKeygen, keygen.Status)
+		require.Equal(t, []string{"a", "c"}, keygen.GranteePubkeys)
+		require.Equal(t, ctx.BlockHeight()+types.KeygenRetryBlockDelay, keygen.BlockNumber)
+
+		failed, found := k.GetKeygenAttempt(ctx, 1)
+		require.True(t, found)
+		require.True(t, failed.RetryApproved)
+
+		retry, found := k.GetLatestKeygenAttempt(ctx)
+		require.True(t, found)
+		require.EqualValues(t, 2, retry.Index)
+		require.EqualValues(t, 1, retry.RetryOf)
+		require.Equal(t, types.KeygenAttemptStatus_AttemptPending, retry.Status)
+
+		// retry can't be approved twice
+		_, err = srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 1, 0))
+		require.ErrorIs(t, err, types.ErrKeygenRetryNotAllowed)
+	})
+
+	t.Run("should use the block provided in the message", func(t *testing.T) {
+		k, ctx, admin := setupFailedAttempt(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		block := ctx.BlockHeight() + 1000
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 1, block))
+		require.NoError(t, err)
+
+		keygen, _ := k.GetKeygen(ctx)
+		require.Equal(t, block, keygen.BlockNumber)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _ := setupFailedAttempt(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(sample.AccAddress(), 1, 0))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("should fail if attempt not found", func(t *testing.T) {
+		k, ctx, admin := setupFailedAttempt(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 2, 0))
+		require.ErrorIs(t, err, types.ErrKeygenAttemptNotFound)
+	})
+
+	t.Run("should fail if block is too low", func(t *testing.T) {
+		k, ctx, admin := setupFailedAttempt(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 1, ctx.BlockHeight()+1))
+		require.ErrorIs(t, err, types.ErrKeygenBlockTooLow)
+	})
+
+	t.Run("should fail if attempt has not failed", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		keygen := types.Keygen{GranteePubkeys: []string{"a"}, BlockNumber: 100}
+		k.SetKeygen(ctx, keygen)
+		k.StartKeygenAttempt(ctx, keygen, 0)
+
+		_, err := srv.ApproveKeygenRetry(sdk.WrapSDKContext(ctx), types.NewMsgApproveKeygenRetry(admin, 1, 0))
+		require.ErrorIs(t, err, types.ErrKeygenRetryNotAllowed)
+	})
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// InitLightClient initializes the beacon chain light client of an Ethereum chain from a trusted bootstrap.
+// The header of the bootstrap is trusted, its sync committee is verified against its state.
+//
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) InitLightClient(goCtx context.Context, msg *types.MsgInitLightClient) (*types.MsgInitLightClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group2) {
+		return &types.MsgInitLightClientResponse{}, types.ErrNotAuthorizedPolicy
+	}
+	if _, found := k.GetLightClientState(ctx, msg.ChainId); found {
+		return nil, cosmoserrors.Wrapf(types.ErrLightClientAlreadyInitialized, "chain id %d", msg.ChainId)
+	}
+	if err := k.InitLightClientFromBootstrap(ctx, msg.ChainId, msg.Bootstrap); err != nil {
+		return nil, err
+	}
+	return &types.MsgInitLightClientResponse{}, nil
+}
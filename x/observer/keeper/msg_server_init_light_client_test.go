@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_InitLightClient(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+	bootstrap := fixture.Bootstrap(t, fixture.Header(t, lightClientSlot, 1000, lightClientBlockHash(1)))
+
+	t.Run("should initialize the light client", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+
+		_, err := srv.InitLightClient(sdk.WrapSDKContext(ctx), types.NewMsgInitLightClient(admin, chainID, bootstrap))
+		require.NoError(t, err)
+		state, found := k.GetLightClientState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, bootstrap.Header, state.FinalizedHeader)
+	})
+
+	t.Run("should fail if the light client is already initialized", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+
+		_, err := srv.InitLightClient(sdk.WrapSDKContext(ctx), types.NewMsgInitLightClient(admin, chainID, bootstrap))
+		require.NoError(t, err)
+		_, err = srv.InitLightClient(sdk.WrapSDKContext(ctx), types.NewMsgInitLightClient(admin, chainID, bootstrap))
+		require.ErrorIs(t, err, types.ErrLightClientAlreadyInitialized)
+	})
+
+	t.Run("should fail if the creator is not the admin", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		setAdminCrossChainFlags(ctx, k, sample.AccAddress(), types.Policy_Type_group2)
+
+		_, err := srv.InitLightClient(sdk.WrapSDKContext(ctx), types.NewMsgInitLightClient(sample.AccAddress(), chainID, bootstrap))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+		_, found := k.GetLightClientState(ctx, chainID)
+		require.False(t, found)
+	})
+}
+
+func TestMsgServer_SubmitLightClientUpdate(t *testing.T) {
+	t.Run("any account can submit a light client update", func(t *testing.T) {
+		k, ctx, fixture, _ := setupLightClient(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		finalized := fixture.Header(t, lightClientSlot+64, 1064, lightClientBlockHash(2))
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, supermajority)
+
+		msg := types.NewMsgSubmitLightClientUpdate(sample.AccAddress(), common.SepoliaChain().ChainId, update)
+		_, err := srv.SubmitLightClientUpdate(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.True(t, k.IsExecutionBlockFinalized(ctx, finalized.Execution.BlockHash))
+
+		res, err := k.LightClientState(sdk.WrapSDKContext(ctx), &types.QueryGetLightClientStateRequest{ChainId: msg.ChainId})
+		require.NoError(t, err)
+		require.Equal(t, finalized, res.LightClientState.FinalizedHeader)
+	})
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SubmitLightClientUpdate applies a light client update to the beacon chain light client of an Ethereum chain.
+// The execution block of the finalized header of the update, and its stored ancestors, are marked as finalized and the
+// inbound proofs against them can be verified without the observers.
+//
+// Any account can broadcast this message, the update is verified with the signature of the sync committee.
+// The gas of the verification, proportional to the number of signers, is consumed before the update is verified.
+func (k msgServer) SubmitLightClientUpdate(goCtx context.Context, msg *types.MsgSubmitLightClientUpdate) (*types.MsgSubmitLightClientUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ProcessLightClientUpdate(ctx, msg.ChainId, msg.Update); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitLightClientUpdateResponse{}, nil
+}
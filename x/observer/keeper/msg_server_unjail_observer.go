@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UnjailObserver unjails an observer jailed for missing too many votes on a chain.
+// The observer is added again to the ballots of the chain created after unjailing.
+//
+// Only the jailed observer is authorized to broadcast this message, once the jail duration elapsed.
+func (k msgServer) UnjailObserver(goCtx context.Context, msg *types.MsgUnjailObserver) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	chain := common.GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, cosmoserrors.Wrapf(types.ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+	if !k.IsObserverPresentInMappers(ctx, msg.Creator, chain) {
+		return nil, types.ErrNotAuthorized
+	}
+	liveness, found := k.GetObserverLiveness(ctx, msg.ChainId, msg.Creator)
+	if !found || !liveness.Jailed {
+		return nil, types.ErrObserverNotJailed
+	}
+	if ctx.BlockHeight() < liveness.JailedUntil {
+		return nil, cosmoserrors.Wrapf(types.ErrObserverJailed, "jailed until block %d", liveness.JailedUntil)
+	}
+
+	liveness.Unjail()
+	k.SetObserverLiveness(ctx, liveness)
+	EmitEventObserverUnjailed(ctx, liveness)
+	return &types.MsgUnjailObserverResponse{}, nil
+}
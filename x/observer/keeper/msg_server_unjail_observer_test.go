@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	t.Run("should unjail the observer after the jail duration", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		srv := keeper.NewMsgServerImpl(*k)
+		liveness := types.ObserverLiveness{ObserverAddress: observers[0], ChainId: chain.ChainId}
+		liveness.Jail(ctx.BlockHeight(), ctx.BlockHeight()+10)
+		k.SetObserverLiveness(ctx, liveness)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observers[0], chain.ChainId))
+		require.ErrorIs(t, err, types.ErrObserverJailed)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		_, err = srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observers[0], chain.ChainId))
+		require.NoError(t, err)
+		require.False(t, k.IsObserverJailed(ctx, observers[0], chain.ChainId))
+	})
+
+	t.Run("should fail if the observer is not jailed", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observers[0], chain.ChainId))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if the creator is not an observer of the chain", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observers[0], common.BtcRegtestChain().ChainId))
+		require.ErrorIs(t, err, types.ErrNotAuthorized)
+	})
+}
+
+func TestMsgServer_UpdateLivenessParams(t *testing.T) {
+	chain := common.GoerliLocalnetChain()
+
+	t.Run("should update the liveness params", func(t *testing.T) {
+		k, ctx, _ := setupLiveness(t, chain)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group2)
+		params := types.LivenessParams{WindowSize: 200, MaxMissedVotes: 100}
+
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateLivenessParams(admin, params))
+		require.NoError(t, err)
+		require.Equal(t, params, k.GetLivenessParams(ctx))
+	})
+
+	t.Run("should fail if not the admin policy", func(t *testing.T) {
+		k, ctx, observers := setupLiveness(t, chain)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateLivenessParams(observers[0], types.LivenessParams{}))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+}
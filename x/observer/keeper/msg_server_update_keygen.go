@@ -32,6 +32,7 @@ func (k msgServer) UpdateKeygen(goCtx context.Context, msg *types.MsgUpdateKeyge
 	keygen.BlockNumber = msg.Block
 	keygen.Status = types.KeygenStatus_PendingKeygen
 	k.SetKeygen(ctx, keygen)
+	k.StartKeygenAttempt(ctx, keygen, 0)
 	EmitEventKeyGenBlockUpdated(ctx, &keygen)
 	return &types.MsgUpdateKeygenResponse{}, nil
 }
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateLivenessParams updates the params of the tracking of the observer participation in the ballots.
+// The missed votes windows of the observers are reset if the window size changes.
+//
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) UpdateLivenessParams(goCtx context.Context, msg *types.MsgUpdateLivenessParams) (*types.MsgUpdateLivenessParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group2) {
+		return &types.MsgUpdateLivenessParamsResponse{}, types.ErrNotAuthorizedPolicy
+	}
+	k.SetLivenessParams(ctx, msg.LivenessParams)
+	return &types.MsgUpdateLivenessParamsResponse{}, nil
+}
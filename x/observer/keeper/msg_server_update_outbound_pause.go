@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateOutboundPause pauses or resumes the outbound of a chain, the observers don't schedule the outbound txs of a
+// paused chain.
+//
+// Only the emergency policy account is authorized to pause the outbound and only the operational policy account is
+// authorized to resume it, a single observer can't halt the outbound of a chain for all the observers.
+func (k msgServer) UpdateOutboundPause(goCtx context.Context, msg *types.MsgUpdateOutboundPause) (*types.MsgUpdateOutboundPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	chain := common.GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, cosmoserrors.Wrapf(types.ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+
+	// check permission
+	requiredGroup := types.Policy_Type_group2
+	if msg.Paused {
+		requiredGroup = types.Policy_Type_group1
+	}
+	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(requiredGroup) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		flags = *types.DefaultCrosschainFlags()
+	}
+	if msg.Paused {
+		flags.SetOutboundPause(types.OutboundPause{
+			ChainId:     msg.ChainId,
+			Reason:      msg.Reason,
+			Creator:     msg.Creator,
+			BlockHeight: ctx.BlockHeight(),
+		})
+	} else {
+		flags.RemoveOutboundPause(msg.ChainId)
+	}
+	k.SetCrosschainFlags(ctx, flags)
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventOutboundPauseUpdated{
+		MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUpdateOutboundPause{}),
+		ChainId:    msg.ChainId,
+		Paused:     msg.Paused,
+		Reason:     msg.Reason,
+		Signer:     msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventOutboundPauseUpdated :", err)
+	}
+
+	return &types.MsgUpdateOutboundPauseResponse{}, nil
+}
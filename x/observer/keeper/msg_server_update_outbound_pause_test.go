@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UpdateOutboundPause(t *testing.T) {
+	chainID := common.GoerliLocalnetChain().ChainId
+
+	t.Run("emergency admin can pause and operational admin can resume the outbound", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		emergencyAdmin, operationalAdmin := sample.AccAddress(), sample.AccAddress()
+		k.SetParams(ctx, types.Params{
+			AdminPolicy: []*types.Admin_Policy{
+				{PolicyType: types.Policy_Type_group1, Address: emergencyAdmin},
+				{PolicyType: types.Policy_Type_group2, Address: operationalAdmin},
+			},
+		})
+
+		_, err := srv.UpdateOutboundPause(sdk.WrapSDKContext(ctx), types.NewMsgUpdateOutboundPause(emergencyAdmin, chainID, true, "supply"))
+		require.NoError(t, err)
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsOutboundEnabled)
+		pause, paused := flags.GetOutboundPause(chainID)
+		require.True(t, paused)
+		require.Equal(t, types.OutboundPause{
+			ChainId:     chainID,
+			Reason:      "supply",
+			Creator:     emergencyAdmin,
+			BlockHeight: ctx.BlockHeight(),
+		}, pause)
+
+		// the emergency admin can't resume the outbound
+		_, err = srv.UpdateOutboundPause(sdk.WrapSDKContext(ctx), types.NewMsgUpdateOutboundPause(emergencyAdmin, chainID, false, ""))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		_, err = srv.UpdateOutboundPause(sdk.WrapSDKContext(ctx), types.NewMsgUpdateOutboundPause(operationalAdmin, chainID, false, ""))
+		require.NoError(t, err)
+		flags, found = k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		_, paused = flags.GetOutboundPause(chainID)
+		require.False(t, paused)
+	})
+
+	t.Run("observer of the chain can't pause the outbound", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		validator := sample.Validator(t, rand.New(rand.NewSource(9)))
+		observerAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+		require.NoError(t, err)
+		observerChain := common.GoerliLocalnetChain()
+		k.SetObserverMapper(ctx, &types.ObserverMapper{
+			ObserverChain: &observerChain,
+			ObserverList:  []string{observerAddress.String()},
+		})
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+
+		_, err = srv.UpdateOutboundPause(sdk.WrapSDKContext(ctx), types.NewMsgUpdateOutboundPause(observerAddress.String(), chainID, true, "supply"))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+		_, found := k.GetCrosschainFlags(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if not the emergency admin", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UpdateOutboundPause(sdk.WrapSDKContext(ctx), types.NewMsgUpdateOutboundPause(sample.AccAddress(), chainID, true, "supply"))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+		_, found := k.GetCrosschainFlags(ctx)
+		require.False(t, found)
+	})
+}
@@ -32,7 +32,7 @@ func (k Keeper) CheckIfFinalizingVote(ctx sdk.Context, ballot types.Ballot) (typ
 }
 
 // IsAuthorized checks whether a signer is authorized to sign , by checking their address against the observer mapper which contains the observer list for the chain and type
-// It also checks if the signer is a validator and if they are not tombstoned
+// It also checks if the signer is a validator, if they are not tombstoned and if they are not jailed for the chain
 func (k Keeper) IsAuthorized(ctx sdk.Context, address string, chain *common.Chain) bool {
 	isPresentInMapper := k.IsObserverPresentInMappers(ctx, address, chain)
 	if !isPresentInMapper {
@@ -42,7 +42,7 @@ func (k Keeper) IsAuthorized(ctx sdk.Context, address string, chain *common.Chai
 	if err != nil || isTombstoned {
 		return false
 	}
-	return true
+	return !k.IsObserverJailed(ctx, address, chain.ChainId)
 }
 
 func (k Keeper) IsObserverPresentInMappers(ctx sdk.Context, address string, chain *common.Chain) bool {
@@ -68,15 +68,18 @@ func (k Keeper) FindBallot(ctx sdk.Context, index string, chain *common.Chain, o
 			err = errors.Wrap(types.ErrSupportedChains, fmt.Sprintf("Thresholds not set for Chain %s and Observation %s", chain.String(), observationType))
 			return
 		}
+		// observers jailed for the chain don't take part in the new ballots
+		voterList := k.GetActiveObservers(ctx, observerMapper.ObserverList, chain.ChainId)
 		ballot = types.Ballot{
 			Index:                "",
 			BallotIdentifier:     index,
-			VoterList:            observerMapper.ObserverList,
-			Votes:                types.CreateVotes(len(observerMapper.ObserverList)),
+			VoterList:            voterList,
+			Votes:                types.CreateVotes(len(voterList)),
 			ObservationType:      observationType,
 			BallotThreshold:      obsParams.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
+			ChainId:              chain.ChainId,
 		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
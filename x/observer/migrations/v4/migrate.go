@@ -8,6 +8,8 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
+// MigrateStore adds the default block header verification flags to the crosschain flags
+// The block header verification flags already set are kept, so the migration can run again on a migrated store
 func MigrateStore(ctx sdk.Context, observerStoreKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
 	newCrossChainFlags := types.DefaultCrosschainFlags()
 	var val types.LegacyCrosschainFlags
@@ -20,6 +22,13 @@ func MigrateStore(ctx sdk.Context, observerStoreKey storetypes.StoreKey, cdc cod
 		}
 		newCrossChainFlags.IsOutboundEnabled = val.IsOutboundEnabled
 		newCrossChainFlags.IsInboundEnabled = val.IsInboundEnabled
+
+		// the legacy flags ignore the block header verification flags of a migrated store
+		var migrated types.CrosschainFlags
+		cdc.MustUnmarshal(b, &migrated)
+		if migrated.BlockHeaderVerificationFlags != nil {
+			newCrossChainFlags.BlockHeaderVerificationFlags = migrated.BlockHeaderVerificationFlags
+		}
 	}
 	b, err := cdc.Marshal(newCrossChainFlags)
 	if err != nil {
@@ -28,3 +28,23 @@ func TestMigrateStore(t *testing.T) {
 	assert.True(t, flags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled)
 	assert.True(t, flags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled)
 }
+
+func TestMigrateStore_KeepBlockHeaderVerificationFlags(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	flags := types.DefaultCrosschainFlags()
+	flags.IsOutboundEnabled = false
+	flags.BlockHeaderVerificationFlags = &types.BlockHeaderVerificationFlags{
+		IsEthTypeChainEnabled:         false,
+		IsBtcTypeChainEnabled:         true,
+		PermissionlessInboundChainIds: []int64{18444},
+	}
+	k.SetCrosschainFlags(ctx, *flags)
+
+	err := v4.MigrateStore(ctx, k.StoreKey(), k.Codec())
+	assert.NoError(t, err)
+	migrated, found := k.GetCrosschainFlags(ctx)
+	assert.True(t, found)
+	assert.True(t, migrated.IsInboundEnabled)
+	assert.False(t, migrated.IsOutboundEnabled)
+	assert.Equal(t, flags.BlockHeaderVerificationFlags, migrated.BlockHeaderVerificationFlags)
+}
@@ -0,0 +1,37 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	StoreKey() storetypes.StoreKey
+	Codec() codec.BinaryCodec
+	SetBlockHeaderHeight(ctx sdk.Context, header common.BlockHeader)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 4 to 5
+// This migration indexes the stored block headers by height for their pruning
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	store := prefix.NewStore(ctx.KVStore(k.StoreKey()), types.KeyPrefix(types.BlockHeaderKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var headers []common.BlockHeader
+	for ; iterator.Valid(); iterator.Next() {
+		var header common.BlockHeader
+		if err := k.Codec().Unmarshal(iterator.Value(), &header); err != nil {
+			return err
+		}
+		headers = append(headers, header)
+	}
+	for _, header := range headers {
+		k.SetBlockHeaderHeight(ctx, header)
+	}
+	return nil
+}
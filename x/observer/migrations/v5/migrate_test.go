@@ -0,0 +1,41 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	v5 "github.com/zeta-chain/zetacore/x/observer/migrations/v5"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	chainID := common.GoerliChain().ChainId
+
+	// block headers stored without height index
+	store := prefix.NewStore(ctx.KVStore(k.StoreKey()), types.KeyPrefix(types.BlockHeaderKey))
+	for height := int64(1); height <= 3; height++ {
+		header := common.BlockHeader{
+			Height:  height,
+			Hash:    sample.Hash().Bytes(),
+			ChainId: chainID,
+		}
+		store.Set(header.Hash, k.Codec().MustMarshal(&header))
+	}
+	bhs := types.BlockHeaderState{
+		ChainId:        chainID,
+		EarliestHeight: 1,
+		LatestHeight:   3,
+		PrunedHeight:   3,
+	}
+	require.Equal(t, []types.HeightRange{{StartHeight: 1, EndHeight: 2}}, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+
+	require.NoError(t, v5.MigrateStore(ctx, k))
+
+	// the indexed block headers are not reported as pruned
+	require.Empty(t, k.GetPrunedBlockHeaderRanges(ctx, bhs))
+}
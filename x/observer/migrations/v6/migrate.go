@@ -0,0 +1,29 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetAllCoreParams(ctx sdk.Context) (types.CoreParamsList, bool)
+	SetCoreParams(ctx sdk.Context, coreParams types.CoreParamsList)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 5 to 6
+// This migration sets the default confirmation tiers of the bitcoin chains without tiers, the zetaclient required
+// 6 confirmations from 2 BTC before the tiers were added to the core params
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	coreParamsList, found := k.GetAllCoreParams(ctx)
+	if !found {
+		return nil
+	}
+	for _, coreParams := range coreParamsList.CoreParams {
+		if coreParams != nil && common.IsBitcoinChain(coreParams.ChainId) && len(coreParams.ConfirmationTiers) == 0 {
+			coreParams.ConfirmationTiers = types.BitcoinConfirmationTiers()
+		}
+	}
+	k.SetCoreParams(ctx, coreParamsList)
+	return nil
+}
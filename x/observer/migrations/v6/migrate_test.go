@@ -0,0 +1,42 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v6 "github.com/zeta-chain/zetacore/x/observer/migrations/v6"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+
+	customTiers := []types.ConfirmationTier{
+		types.NewConfirmationTier(common.CoinType_Gas, "", types.BitcoinConfirmationTiers()[0].AmountThreshold, 3),
+	}
+	k.SetCoreParams(ctx, types.CoreParamsList{
+		CoreParams: []*types.CoreParams{
+			{ChainId: common.BtcMainnetChain().ChainId, ConfirmationCount: 2},
+			{ChainId: common.BtcTestNetChain().ChainId, ConfirmationCount: 2, ConfirmationTiers: customTiers},
+			{ChainId: common.EthChain().ChainId, ConfirmationCount: 12},
+		},
+	})
+
+	require.NoError(t, v6.MigrateStore(ctx, k))
+
+	coreParams, found := k.GetCoreParamsByChainID(ctx, common.BtcMainnetChain().ChainId)
+	require.True(t, found)
+	require.Equal(t, types.BitcoinConfirmationTiers(), coreParams.ConfirmationTiers)
+
+	// the tiers set by governance are kept
+	coreParams, found = k.GetCoreParamsByChainID(ctx, common.BtcTestNetChain().ChainId)
+	require.True(t, found)
+	require.Equal(t, customTiers, coreParams.ConfirmationTiers)
+
+	// no tier is set for the other chains
+	coreParams, found = k.GetCoreParamsByChainID(ctx, common.EthChain().ChainId)
+	require.True(t, found)
+	require.Empty(t, coreParams.ConfirmationTiers)
+}
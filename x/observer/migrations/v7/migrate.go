@@ -0,0 +1,65 @@
+package v7
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	StoreKey() storetypes.StoreKey
+	Codec() codec.BinaryCodec
+	GetBlockHeaderState(ctx sdk.Context, chainID int64) (types.BlockHeaderState, bool)
+	SetBlockHeaderState(ctx sdk.Context, blockHeaderState types.BlockHeaderState)
+	AddBitcoinHeaderToBestChain(ctx sdk.Context, bhs *types.BlockHeaderState, header common.BlockHeader) error
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 6 to 7
+// This migration sets the cumulative work and the best chain index of the stored Bitcoin block headers, the block
+// headers are added in height order and the tip of each chain is the block header with the most cumulative work
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	store := prefix.NewStore(ctx.KVStore(k.StoreKey()), types.KeyPrefix(types.BlockHeaderKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	headers := make(map[int64][]common.BlockHeader)
+	for ; iterator.Valid(); iterator.Next() {
+		var header common.BlockHeader
+		if err := k.Codec().Unmarshal(iterator.Value(), &header); err != nil {
+			return err
+		}
+		if common.IsBitcoinChain(header.ChainId) {
+			headers[header.ChainId] = append(headers[header.ChainId], header)
+		}
+	}
+
+	chainIDs := make([]int64, 0, len(headers))
+	for chainID := range headers {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	for _, chainID := range chainIDs {
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		if !found {
+			continue
+		}
+		bhs.LatestHeight = 0
+		bhs.LatestBlockHash = nil
+
+		chainHeaders := headers[chainID]
+		sort.SliceStable(chainHeaders, func(i, j int) bool { return chainHeaders[i].Height < chainHeaders[j].Height })
+		for _, header := range chainHeaders {
+			if err := k.AddBitcoinHeaderToBestChain(ctx, &bhs, header); err != nil {
+				return err
+			}
+		}
+		k.SetBlockHeaderState(ctx, bhs)
+	}
+	return nil
+}
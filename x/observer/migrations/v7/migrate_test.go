@@ -0,0 +1,85 @@
+package v7_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v7 "github.com/zeta-chain/zetacore/x/observer/migrations/v7"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// regtestHeader returns a block header of the regtest chain extending the parent block header
+func regtestHeader(t *testing.T, parent common.BlockHeader, nonce uint32) common.BlockHeader {
+	prevBlock, err := chainhash.NewHash(parent.Hash)
+	require.NoError(t, err)
+	header := wire.BlockHeader{
+		Version:   1,
+		PrevBlock: *prevBlock,
+		Bits:      0x207fffff,
+		Timestamp: time.Unix(1700000000+600*parent.Height, 0),
+		Nonce:     nonce,
+	}
+	var buf bytes.Buffer
+	require.NoError(t, header.Serialize(&buf))
+	hash := header.BlockHash()
+	return common.BlockHeader{
+		Header:     common.NewBitcoinHeader(buf.Bytes()),
+		Height:     parent.Height + 1,
+		Hash:       hash[:],
+		ParentHash: parent.Hash,
+		ChainId:    common.BtcRegtestChain().ChainId,
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	chainID := common.BtcRegtestChain().ChainId
+
+	// a main chain of 4 block headers and a fork of 2 block headers from the first one
+	first := common.BlockHeader{Height: 0, Hash: bytes.Repeat([]byte{1}, 32)}
+	first = regtestHeader(t, first, 0)
+	main := []common.BlockHeader{first}
+	for i := 0; i < 3; i++ {
+		main = append(main, regtestHeader(t, main[len(main)-1], 0))
+	}
+	fork := []common.BlockHeader{regtestHeader(t, first, 1)}
+	fork = append(fork, regtestHeader(t, fork[0], 1))
+	for _, header := range append(main, fork...) {
+		k.SetBlockHeader(ctx, header)
+	}
+	k.SetBlockHeaderState(ctx, types.BlockHeaderState{
+		ChainId:         chainID,
+		EarliestHeight:  1,
+		LatestHeight:    4,
+		LatestBlockHash: main[3].Hash,
+	})
+
+	require.NoError(t, v7.MigrateStore(ctx, k))
+
+	work := blockchain.CalcWork(0x207fffff).Int64()
+	for i, header := range main {
+		chainWork, found := k.GetBlockChainWork(ctx, header.Hash)
+		require.True(t, found)
+		require.EqualValues(t, int64(i+1)*work, chainWork.ChainWork.Uint64())
+
+		hash, found := k.GetBestChainBlockHash(ctx, chainID, header.Height)
+		require.True(t, found)
+		require.Equal(t, header.Hash, hash)
+	}
+	chainWork, found := k.GetBlockChainWork(ctx, fork[1].Hash)
+	require.True(t, found)
+	require.EqualValues(t, 3*work, chainWork.ChainWork.Uint64())
+
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	require.True(t, found)
+	require.EqualValues(t, 4, bhs.LatestHeight)
+	require.Equal(t, main[3].Hash, bhs.LatestBlockHash)
+	require.EqualValues(t, 1, bhs.EarliestHeight)
+}
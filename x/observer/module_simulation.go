@@ -6,26 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/zeta-chain/zetacore/x/observer/simulation"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
-const (
-	// #nosec G101 not a hardcoded credential
-	opWeightMsgUpdateClientParams          = "op_weight_msg_update_client_params"
-	defaultWeightMsgUpdateClientParams int = 100
-)
-
 // GenerateGenesisState creates a randomized GenState of the module
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	defaultParams := types.DefaultParams()
-	observerGenesis := types.GenesisState{
-		Params: &defaultParams,
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&observerGenesis)
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -40,18 +27,11 @@ func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 }
 
 // RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the observer module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	operations := make([]simtypes.WeightedOperation, 0)
-
-	var weightMsgUpdateClientParams int
-	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateClientParams, &weightMsgUpdateClientParams, nil,
-		func(_ *rand.Rand) {
-			weightMsgUpdateClientParams = defaultWeightMsgUpdateClientParams
-		},
-	)
-
-	return operations
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, am.accountKeeper, am.bankKeeper)
 }
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding observer type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		var a, b codec.ProtoMarshaler
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.VoterKey)):
+			a, b = &types.Ballot{}, &types.Ballot{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BallotListKey)):
+			a, b = &types.BallotListForHeight{}, &types.BallotListForHeight{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BlameKey)):
+			a, b = &types.Blame{}, &types.Blame{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.AllCoreParams)):
+			a, b = &types.CoreParamsList{}, &types.CoreParamsList{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ObserverMapperKey)):
+			a, b = &types.ObserverMapper{}, &types.ObserverMapper{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.CrosschainFlagsKey)):
+			a, b = &types.CrosschainFlags{}, &types.CrosschainFlags{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.LastBlockObserverCountKey)):
+			a, b = &types.LastObserverCount{}, &types.LastObserverCount{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.NodeAccountKey)):
+			a, b = &types.NodeAccount{}, &types.NodeAccount{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.KeygenKey)):
+			a, b = &types.Keygen{}, &types.Keygen{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.KeygenAttemptKey)):
+			a, b = &types.KeygenAttempt{}, &types.KeygenAttempt{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.KeygenAttemptCountKey)):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.LivenessParamsKey)):
+			a, b = &types.LivenessParams{}, &types.LivenessParams{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ObserverLivenessKey)):
+			a, b = &types.ObserverLiveness{}, &types.ObserverLiveness{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BlockHeaderKey)):
+			a, b = &common.BlockHeader{}, &common.BlockHeader{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BlockHeaderStateKey)):
+			a, b = &types.BlockHeaderState{}, &types.BlockHeaderState{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TSSKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TSSHistoryKey)):
+			a, b = &types.TSS{}, &types.TSS{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TssFundMigratorKey)):
+			a, b = &types.TssFundMigratorInfo{}, &types.TssFundMigratorInfo{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PendingNoncesKeyPrefix)):
+			a, b = &types.PendingNonces{}, &types.PendingNonces{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ChainNoncesKey)):
+			a, b = &types.ChainNonces{}, &types.ChainNonces{}
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.NonceToCctxKeyPrefix)):
+			a, b = &types.NonceToCctx{}, &types.NonceToCctx{}
+		default:
+			panic(fmt.Sprintf("invalid observer key prefix %X", kvA.Key))
+		}
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+}
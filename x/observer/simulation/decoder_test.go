@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/simulation"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := keepertest.NewCodec()
+	dec := simulation.NewDecodeStore(cdc)
+
+	ballot := sample.Ballot(t, "ballot")
+	crosschainFlags := sample.CrosschainFlags()
+	tss := sample.Tss()
+	nonces := sample.ChainNonces(t, "nonces")
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefix(types.VoterKey), Value: cdc.MustMarshal(ballot)},
+			{Key: types.KeyPrefix(types.CrosschainFlagsKey), Value: cdc.MustMarshal(crosschainFlags)},
+			{Key: types.KeyPrefix(types.TSSKey), Value: cdc.MustMarshal(&tss)},
+			{Key: types.KeyPrefix(types.ChainNoncesKey), Value: cdc.MustMarshal(&nonces)},
+			{Key: types.KeyPrefix(types.KeygenAttemptCountKey), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Ballot", fmt.Sprintf("%v\n%v", ballot, ballot)},
+		{"CrosschainFlags", fmt.Sprintf("%v\n%v", crosschainFlags, crosschainFlags)},
+		{"TSS", fmt.Sprintf("%v\n%v", &tss, &tss)},
+		{"ChainNonces", fmt.Sprintf("%v\n%v", &nonces, &nonces)},
+		{"KeygenAttemptCount", "3\n3"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedLog == "" {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/cosmos"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// Simulation parameter constants
+const (
+	BallotThreshold      = "ballot_threshold"
+	BallotMaturityBlocks = "ballot_maturity_blocks"
+	InboundEnabled       = "inbound_enabled"
+	OutboundEnabled      = "outbound_enabled"
+	LivenessParams       = "liveness_params"
+)
+
+// GenBallotThreshold returns a randomized ballot threshold between 0.51 and 1
+func GenBallotThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 51, 101)), 2)
+}
+
+// GenBallotMaturityBlocks returns a randomized number of blocks for a ballot to mature
+func GenBallotMaturityBlocks(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenLivenessParams returns randomized liveness params, the liveness tracking is disabled one time out of five
+func GenLivenessParams(r *rand.Rand) types.LivenessParams {
+	if r.Intn(5) == 0 {
+		return types.LivenessParams{}
+	}
+	windowSize := uint64(simtypes.RandIntBetween(r, 10, 100))
+	maxMissedVotes := uint64(simtypes.RandIntBetween(r, int(windowSize/2), int(windowSize)+1))
+	return types.LivenessParams{
+		WindowSize:         windowSize,
+		WarningMissedVotes: uint64(r.Int63n(int64(maxMissedVotes) + 1)),
+		MaxMissedVotes:     maxMissedVotes,
+		JailDuration:       int64(simtypes.RandIntBetween(r, 1, 100)),
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for the observer module
+// The bonded validators of the simulation are the observers of all privnet chains and the TSS participants,
+// the admin policies are given to random simulation accounts
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		ballotThreshold      sdk.Dec
+		ballotMaturityBlocks int64
+		inboundEnabled       bool
+		outboundEnabled      bool
+		livenessParams       types.LivenessParams
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BallotThreshold, &ballotThreshold, simState.Rand,
+		func(r *rand.Rand) { ballotThreshold = GenBallotThreshold(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BallotMaturityBlocks, &ballotMaturityBlocks, simState.Rand,
+		func(r *rand.Rand) { ballotMaturityBlocks = GenBallotMaturityBlocks(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InboundEnabled, &inboundEnabled, simState.Rand,
+		func(r *rand.Rand) { inboundEnabled = r.Intn(10) != 0 },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OutboundEnabled, &outboundEnabled, simState.Rand,
+		func(r *rand.Rand) { outboundEnabled = r.Intn(10) != 0 },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LivenessParams, &livenessParams, simState.Rand,
+		func(r *rand.Rand) { livenessParams = GenLivenessParams(r) },
+	)
+
+	// the staking module bonds the first accounts of the simulation
+	numObservers := int(simState.NumBonded)
+	if numObservers > len(simState.Accounts) {
+		numObservers = len(simState.Accounts)
+	}
+	observers := make([]string, numObservers)
+	pubkeys := make([]string, numObservers)
+	nodeAccounts := make([]*types.NodeAccount, numObservers)
+	for i, acc := range simState.Accounts[:numObservers] {
+		pubkey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, acc.PubKey)
+		if err != nil {
+			panic(err)
+		}
+		observers[i] = acc.Address.String()
+		pubkeys[i] = pubkey
+		nodeAccounts[i] = &types.NodeAccount{
+			Operator:       observers[i],
+			GranteeAddress: observers[i],
+			GranteePubkey:  &common.PubKeySet{Secp256k1: common.PubKey(pubkey)},
+			NodeStatus:     types.NodeStatus_Active,
+		}
+	}
+
+	chains := common.PrivnetChainList()
+	observerParams := make([]*types.ObserverParams, len(chains))
+	observerMappers := make([]*types.ObserverMapper, len(chains))
+	var chainNonces []types.ChainNonces
+	for i, chain := range chains {
+		observerParams[i] = &types.ObserverParams{
+			IsSupported:           true,
+			Chain:                 chain,
+			BallotThreshold:       ballotThreshold,
+			MinObserverDelegation: sdk.MustNewDecFromStr("1000000000000000000000"),
+		}
+		observerMappers[i] = &types.ObserverMapper{
+			ObserverChain: chain,
+			ObserverList:  observers,
+		}
+		if chain.IsExternalChain() {
+			chainNonces = append(chainNonces, types.ChainNonces{
+				Creator: types.GroupID1Address,
+				Index:   chain.ChainName.String(),
+				ChainId: chain.ChainId,
+			})
+		}
+	}
+
+	tssPubkey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, simtypes.RandomAccounts(simState.Rand, 1)[0].PubKey)
+	if err != nil {
+		panic(err)
+	}
+	tss := types.TSS{
+		TssPubkey:           tssPubkey,
+		TssParticipantList:  pubkeys,
+		OperatorAddressList: observers,
+	}
+
+	crosschainFlags := types.DefaultCrosschainFlags()
+	crosschainFlags.IsInboundEnabled = inboundEnabled
+	crosschainFlags.IsOutboundEnabled = outboundEnabled
+
+	params := types.NewParams(observerParams, randomAdminPolicies(simState.Rand, simState.Accounts), ballotMaturityBlocks)
+	observerGenesis := types.GenesisState{
+		Params:          &params,
+		Observers:       observerMappers,
+		NodeAccountList: nodeAccounts,
+		CrosschainFlags: crosschainFlags,
+		Keygen: &types.Keygen{
+			Status:         types.KeygenStatus_KeyGenSuccess,
+			GranteePubkeys: pubkeys,
+		},
+		Tss:            &tss,
+		TssHistory:     []types.TSS{tss},
+		ChainNonces:    chainNonces,
+		LivenessParams: &livenessParams,
+	}
+
+	fmt.Printf("Selected randomly generated observer parameters:\n%s\n", params.String())
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&observerGenesis)
+}
+
+// randomAdminPolicies gives each admin policy to a random simulation account
+func randomAdminPolicies(r *rand.Rand, accs []simtypes.Account) []*types.Admin_Policy {
+	group1, _ := simtypes.RandomAcc(r, accs)
+	group2, _ := simtypes.RandomAcc(r, accs)
+	return []*types.Admin_Policy{
+		{
+			PolicyType: types.Policy_Type_group1,
+			Address:    group1.Address.String(),
+		},
+		{
+			PolicyType: types.Policy_Type_group2,
+			Address:    group2.Address.String(),
+		},
+	}
+}
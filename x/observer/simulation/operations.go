@@ -0,0 +1,84 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// Simulation operation weights constants
+// #nosec G101 not hardcoded credentials
+const (
+	OpWeightMsgUpdateCrosschainFlags = "op_weight_msg_update_crosschain_flags"
+
+	DefaultWeightMsgUpdateCrosschainFlags = 5
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgUpdateCrosschainFlags int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateCrosschainFlags, &weightMsgUpdateCrosschainFlags, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateCrosschainFlags = DefaultWeightMsgUpdateCrosschainFlags
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgUpdateCrosschainFlags,
+			SimulateMsgUpdateCrosschainFlags(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgUpdateCrosschainFlags generates a MsgUpdateCrosschainFlags with random values
+// The flags are enabled most of the time to let the crosschain operations go through
+func SimulateMsgUpdateCrosschainFlags(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		isInboundEnabled := r.Intn(5) != 0
+		isOutboundEnabled := r.Intn(5) != 0
+
+		// disabling all the flags is done by the emergency group, enabling any of them requires the operational group
+		policyType := types.Policy_Type_group1
+		if isInboundEnabled || isOutboundEnabled {
+			policyType = types.Policy_Type_group2
+		}
+		simAccount, found := FindAccount(accs, k.GetParams(ctx).GetAdminPolicyAccount(policyType))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateCrosschainFlags, "admin policy account not found"), nil, nil
+		}
+
+		msg := types.NewMsgUpdateCrosschainFlags(simAccount.Address.String(), isInboundEnabled, isOutboundEnabled)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
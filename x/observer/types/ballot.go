@@ -8,6 +8,9 @@ import (
 )
 
 func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
+	if m.GetVoterIndex(address) == -1 {
+		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Not in voter list", address, m.String()))
+	}
 	if m.HasVoted(address) {
 		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Already Voted", address, m.String()))
 	}
@@ -20,6 +23,9 @@ func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
 
 func (m Ballot) HasVoted(address string) bool {
 	index := m.GetVoterIndex(address)
+	if index == -1 {
+		return false
+	}
 	return m.Votes[index] != VoteType_NotYetVoted
 }
 
@@ -90,6 +90,7 @@ type Ballot struct {
 	BallotThreshold      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
 	BallotStatus         BallotStatus                           `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
 	BallotCreationHeight int64                                  `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	ChainId              int64                                  `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -174,6 +175,13 @@ func (m *Ballot) GetBallotCreationHeight() int64 {
 	return 0
 }
 
+func (m *Ballot) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 type BallotListForHeight struct {
 	Height           int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BallotsIndexList []string `protobuf:"bytes,2,rep,name=ballots_index_list,json=ballotsIndexList,proto3" json:"ballots_index_list,omitempty"`
@@ -236,41 +244,42 @@ func init() {
 func init() { proto.RegisterFile("observer/ballot.proto", fileDescriptor_9eac86b249c97b5b) }
 
 var fileDescriptor_9eac86b249c97b5b = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0x26, 0x34, 0x43, 0x69, 0xc2, 0x12, 0x82, 0x15, 0x84, 0x1b, 0x45, 0xa2, 0x0a,
-	0xa5, 0xb5, 0xa5, 0xc2, 0x8d, 0x5b, 0x40, 0x11, 0x91, 0x50, 0x01, 0xb7, 0x02, 0x15, 0x0e, 0x96,
-	0x63, 0x0f, 0xf1, 0x0a, 0xd7, 0x1b, 0xed, 0x6e, 0xaa, 0x36, 0x5f, 0xc1, 0x47, 0x70, 0xe0, 0x53,
-	0x7a, 0xec, 0x11, 0x71, 0xa8, 0x20, 0xf9, 0x11, 0xe4, 0x5d, 0x3b, 0x04, 0x29, 0xca, 0xc9, 0x3b,
-	0xf3, 0xe6, 0xbd, 0xd9, 0x9d, 0x37, 0x86, 0xfb, 0x6c, 0x28, 0x90, 0x9f, 0x23, 0x77, 0x86, 0x7e,
-	0x1c, 0x33, 0x69, 0x8f, 0x39, 0x93, 0x8c, 0x3c, 0x9c, 0xa2, 0xf4, 0x83, 0xc8, 0xa7, 0x89, 0xad,
-	0x4e, 0x8c, 0xa3, 0x9d, 0x57, 0xb6, 0x1a, 0x23, 0x36, 0x62, 0xaa, 0xce, 0x49, 0x4f, 0x9a, 0xd2,
-	0x7a, 0xb0, 0x50, 0xca, 0x0f, 0x1a, 0xe8, 0xfc, 0x29, 0x41, 0xa5, 0xa7, 0xc4, 0x49, 0x03, 0xca,
-	0x34, 0x09, 0xf1, 0xc2, 0x34, 0xda, 0x46, 0xb7, 0xea, 0xea, 0x80, 0x3c, 0x85, 0xbb, 0xba, 0xb9,
-	0x47, 0x43, 0x4c, 0x24, 0xfd, 0x42, 0x91, 0x9b, 0x45, 0x55, 0x51, 0xd7, 0xc0, 0x60, 0x91, 0x27,
-	0x8f, 0x00, 0xce, 0x99, 0x44, 0xee, 0xc5, 0x54, 0x48, 0xb3, 0xd4, 0x2e, 0x75, 0xab, 0x6e, 0x55,
-	0x65, 0xde, 0x50, 0x21, 0xc9, 0x0b, 0x28, 0xa7, 0x81, 0x30, 0x37, 0xda, 0xa5, 0xee, 0xf6, 0xe1,
-	0x63, 0x7b, 0xcd, 0x43, 0xec, 0x0f, 0x4c, 0xe2, 0xc9, 0xe5, 0x18, 0x5d, 0xcd, 0x21, 0x1f, 0xa1,
-	0xae, 0x31, 0x5f, 0x52, 0x96, 0x78, 0xf2, 0x72, 0x8c, 0x66, 0xb9, 0x6d, 0x74, 0xb7, 0x0f, 0xf7,
-	0xd7, 0xea, 0xbc, 0xfd, 0x47, 0x52, 0x72, 0x35, 0xf6, 0x7f, 0x82, 0x9c, 0x42, 0xf6, 0x10, 0x4f,
-	0x46, 0x1c, 0x45, 0xc4, 0xe2, 0xd0, 0xac, 0xa4, 0x0f, 0xec, 0xd9, 0x57, 0x37, 0x3b, 0x85, 0x5f,
-	0x37, 0x3b, 0xbb, 0x23, 0x2a, 0xa3, 0xc9, 0xd0, 0x0e, 0xd8, 0x99, 0x13, 0x30, 0x71, 0xc6, 0x44,
-	0xf6, 0x39, 0x10, 0xe1, 0x57, 0x27, 0xbd, 0x89, 0xb0, 0x5f, 0x61, 0xe0, 0xd6, 0xb4, 0xce, 0x49,
-	0x2e, 0x43, 0x8e, 0xe0, 0x4e, 0x26, 0x2d, 0xa4, 0x2f, 0x27, 0xc2, 0xbc, 0xa5, 0x2e, 0xfc, 0x64,
-	0xed, 0x85, 0xb5, 0x1d, 0xc7, 0x8a, 0xe0, 0x6e, 0x0d, 0x97, 0x22, 0xf2, 0x1c, 0x9a, 0x99, 0x5e,
-	0xc0, 0x51, 0xcf, 0x21, 0x42, 0x3a, 0x8a, 0xa4, 0xb9, 0xd9, 0x36, 0xba, 0x25, 0xb7, 0xa1, 0xd1,
-	0x97, 0x19, 0xf8, 0x5a, 0x61, 0x9d, 0xcf, 0x70, 0x4f, 0x6b, 0xa6, 0x26, 0xf4, 0x19, 0xd7, 0x69,
-	0xd2, 0x84, 0x4a, 0x46, 0x36, 0x14, 0x39, 0x8b, 0xc8, 0x3e, 0x10, 0x2d, 0x23, 0x3c, 0xb5, 0x02,
-	0xda, 0xcc, 0xa2, 0x32, 0x33, 0x9b, 0x94, 0x18, 0xa4, 0x40, 0x2a, 0xb7, 0xf7, 0x1e, 0x36, 0x73,
-	0xa7, 0x48, 0x13, 0xc8, 0xf1, 0x24, 0x08, 0x50, 0x88, 0xa5, 0xa1, 0xd7, 0x0b, 0x69, 0xbe, 0xef,
-	0xd3, 0x78, 0xc2, 0x71, 0x39, 0x6f, 0x90, 0x1a, 0xdc, 0x3e, 0x62, 0xf2, 0x14, 0x65, 0xaa, 0x10,
-	0xd6, 0x8b, 0xad, 0x8d, 0x1f, 0xdf, 0x2d, 0x63, 0x6f, 0x0a, 0x5b, 0xcb, 0x33, 0x20, 0xbb, 0xd0,
-	0xd1, 0x71, 0x9f, 0x26, 0x7e, 0x4c, 0xa7, 0x18, 0x7a, 0x2b, 0xdb, 0xac, 0xa8, 0x5b, 0xd9, 0xb6,
-	0x01, 0x75, 0x5d, 0x37, 0x48, 0xde, 0x71, 0x36, 0xe2, 0x28, 0x44, 0xde, 0xbb, 0x37, 0xb8, 0x9a,
-	0x59, 0xc6, 0xf5, 0xcc, 0x32, 0x7e, 0xcf, 0x2c, 0xe3, 0xdb, 0xdc, 0x2a, 0x5c, 0xcf, 0xad, 0xc2,
-	0xcf, 0xb9, 0x55, 0xf8, 0xe4, 0x2c, 0x2d, 0x41, 0x6a, 0xda, 0x81, 0xf2, 0xcf, 0xc9, 0xfd, 0x73,
-	0x2e, 0x16, 0xbf, 0x96, 0xde, 0x88, 0x61, 0x45, 0xfd, 0x61, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0xe7, 0x98, 0x67, 0xc6, 0x03, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xda, 0xb5, 0x6b, 0x3f, 0xc6, 0x5a, 0x4c, 0x29, 0xa1, 0x88, 0xac, 0xaa, 0xc4, 0x54,
+	0xc6, 0x96, 0x48, 0x83, 0x1b, 0xb7, 0x82, 0x2a, 0x2a, 0xa1, 0x01, 0xd9, 0x04, 0x1a, 0x1c, 0xa2,
+	0x34, 0x31, 0x8d, 0x45, 0x16, 0x57, 0xb6, 0x3b, 0x6d, 0xfd, 0x15, 0xfc, 0x08, 0x0e, 0xfc, 0x94,
+	0x1d, 0x77, 0x44, 0x1c, 0x26, 0xd4, 0xfe, 0x0d, 0x0e, 0xc8, 0x76, 0x52, 0x82, 0x54, 0xf5, 0x14,
+	0x7f, 0xdf, 0x7b, 0xdf, 0x7b, 0xb6, 0x9f, 0x03, 0xf7, 0xe8, 0x88, 0x63, 0x76, 0x8e, 0x99, 0x33,
+	0xf2, 0xe3, 0x98, 0x0a, 0x7b, 0xc2, 0xa8, 0xa0, 0xe8, 0xe1, 0x0c, 0x0b, 0x3f, 0x88, 0x7c, 0x92,
+	0xd8, 0x6a, 0x45, 0x19, 0xb6, 0x33, 0x66, 0xbb, 0x39, 0xa6, 0x63, 0xaa, 0x78, 0x8e, 0x5c, 0xe9,
+	0x91, 0xf6, 0xfd, 0xa5, 0x52, 0xb6, 0xd0, 0x40, 0xf7, 0x4f, 0x09, 0x2a, 0x7d, 0x25, 0x8e, 0x9a,
+	0x50, 0x26, 0x49, 0x88, 0x2f, 0x4c, 0xa3, 0x63, 0xf4, 0x6a, 0xae, 0x2e, 0xd0, 0x53, 0xb8, 0xa3,
+	0xcd, 0x3d, 0x12, 0xe2, 0x44, 0x90, 0x2f, 0x04, 0x33, 0xb3, 0xa8, 0x18, 0x0d, 0x0d, 0x0c, 0x97,
+	0x7d, 0xf4, 0x08, 0xe0, 0x9c, 0x0a, 0xcc, 0xbc, 0x98, 0x70, 0x61, 0x96, 0x3a, 0xa5, 0x5e, 0xcd,
+	0xad, 0xa9, 0xce, 0x1b, 0xc2, 0x05, 0x7a, 0x01, 0x65, 0x59, 0x70, 0x73, 0xa3, 0x53, 0xea, 0x6d,
+	0x1f, 0x3e, 0xb6, 0xd7, 0x1c, 0xc4, 0xfe, 0x40, 0x05, 0x3e, 0xb9, 0x9c, 0x60, 0x57, 0xcf, 0xa0,
+	0x8f, 0xd0, 0xd0, 0x98, 0x2f, 0x08, 0x4d, 0x3c, 0x71, 0x39, 0xc1, 0x66, 0xb9, 0x63, 0xf4, 0xb6,
+	0x0f, 0xf7, 0xd7, 0xea, 0xbc, 0xfd, 0x37, 0xa4, 0xe4, 0xea, 0xf4, 0xff, 0x06, 0x3a, 0x85, 0xf4,
+	0x20, 0x9e, 0x88, 0x18, 0xe6, 0x11, 0x8d, 0x43, 0xb3, 0x22, 0x0f, 0xd8, 0xb7, 0xaf, 0x6e, 0x76,
+	0x0a, 0xbf, 0x6e, 0x76, 0x76, 0xc7, 0x44, 0x44, 0xd3, 0x91, 0x1d, 0xd0, 0x33, 0x27, 0xa0, 0xfc,
+	0x8c, 0xf2, 0xf4, 0x73, 0xc0, 0xc3, 0xaf, 0x8e, 0xdc, 0x09, 0xb7, 0x5f, 0xe1, 0xc0, 0xad, 0x6b,
+	0x9d, 0x93, 0x4c, 0x06, 0x1d, 0xc1, 0xed, 0x54, 0x9a, 0x0b, 0x5f, 0x4c, 0xb9, 0xb9, 0xa9, 0x36,
+	0xfc, 0x64, 0xed, 0x86, 0x75, 0x1c, 0xc7, 0x6a, 0xc0, 0xdd, 0x1a, 0xe5, 0x2a, 0xf4, 0x1c, 0x5a,
+	0xa9, 0x5e, 0xc0, 0xb0, 0xbe, 0x87, 0x08, 0x93, 0x71, 0x24, 0xcc, 0x6a, 0xc7, 0xe8, 0x95, 0xdc,
+	0xa6, 0x46, 0x5f, 0xa6, 0xe0, 0x6b, 0x85, 0xa1, 0x07, 0x50, 0x55, 0x5e, 0x1e, 0x09, 0xcd, 0x9a,
+	0xe2, 0x6d, 0xaa, 0x7a, 0x18, 0x76, 0x3f, 0xc3, 0x5d, 0x6d, 0x27, 0xf3, 0x19, 0x50, 0x96, 0x4e,
+	0xb4, 0xa0, 0x92, 0xea, 0x1a, 0x8a, 0x9f, 0x56, 0x68, 0x1f, 0x90, 0x76, 0xe0, 0x9e, 0x7a, 0x1d,
+	0x3a, 0xe7, 0xa2, 0xca, 0x39, 0xbd, 0x44, 0x3e, 0x94, 0x80, 0x94, 0xdb, 0x7b, 0x0f, 0xd5, 0x2c,
+	0x44, 0xd4, 0x02, 0x74, 0x3c, 0x0d, 0x02, 0xcc, 0x79, 0x2e, 0x8f, 0x46, 0x41, 0xf6, 0x07, 0x3e,
+	0x89, 0xa7, 0x0c, 0xe7, 0xfb, 0x06, 0xaa, 0xc3, 0xad, 0x23, 0x2a, 0x4e, 0xb1, 0x90, 0x0a, 0x61,
+	0xa3, 0xd8, 0xde, 0xf8, 0xf1, 0xdd, 0x32, 0xf6, 0x66, 0xb0, 0x95, 0xbf, 0x1e, 0xb4, 0x0b, 0x5d,
+	0x5d, 0x0f, 0x48, 0xe2, 0xc7, 0x64, 0x86, 0x43, 0x6f, 0xa5, 0xcd, 0x0a, 0xde, 0x4a, 0xdb, 0x26,
+	0x34, 0x34, 0x6f, 0x98, 0xbc, 0x63, 0x74, 0xcc, 0x30, 0xe7, 0x99, 0x77, 0x7f, 0x78, 0x35, 0xb7,
+	0x8c, 0xeb, 0xb9, 0x65, 0xfc, 0x9e, 0x5b, 0xc6, 0xb7, 0x85, 0x55, 0xb8, 0x5e, 0x58, 0x85, 0x9f,
+	0x0b, 0xab, 0xf0, 0xc9, 0xc9, 0xbd, 0x0f, 0x99, 0xe7, 0x81, 0xba, 0x5e, 0x27, 0x8b, 0xd6, 0xb9,
+	0x58, 0xfe, 0x75, 0xfa, 0xb1, 0x8c, 0x2a, 0xea, 0xe7, 0x7b, 0xf6, 0x77, 0x00, 0xf6, 0xf0, 0x09,
+	0x95, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -293,6 +302,11 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintBallot(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.BallotCreationHeight != 0 {
 		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
 		i--
@@ -448,6 +462,9 @@ func (m *Ballot) Size() (n int) {
 	if m.BallotCreationHeight != 0 {
 		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovBallot(uint64(m.ChainId))
+	}
 	return n
 }
 
@@ -760,6 +777,25 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBallot(dAtA[iNdEx:])
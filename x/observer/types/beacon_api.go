@@ -0,0 +1,181 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The light client objects are served by the beacon node API in JSON, with the bytes in hex and the integers in decimal
+// strings: https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientBootstrap
+
+type beaconAPIBlockHeader struct {
+	Slot          uint64        `json:"slot,string"`
+	ProposerIndex uint64        `json:"proposer_index,string"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	BodyRoot      hexutil.Bytes `json:"body_root"`
+}
+
+type beaconAPIExecutionPayloadHeader struct {
+	ParentHash       hexutil.Bytes `json:"parent_hash"`
+	FeeRecipient     hexutil.Bytes `json:"fee_recipient"`
+	StateRoot        hexutil.Bytes `json:"state_root"`
+	ReceiptsRoot     hexutil.Bytes `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes `json:"logs_bloom"`
+	PrevRandao       hexutil.Bytes `json:"prev_randao"`
+	BlockNumber      uint64        `json:"block_number,string"`
+	GasLimit         uint64        `json:"gas_limit,string"`
+	GasUsed          uint64        `json:"gas_used,string"`
+	Timestamp        uint64        `json:"timestamp,string"`
+	ExtraData        hexutil.Bytes `json:"extra_data"`
+	BaseFeePerGas    string        `json:"base_fee_per_gas"`
+	BlockHash        hexutil.Bytes `json:"block_hash"`
+	TransactionsRoot hexutil.Bytes `json:"transactions_root"`
+	WithdrawalsRoot  hexutil.Bytes `json:"withdrawals_root"`
+	BlobGasUsed      uint64        `json:"blob_gas_used,string,omitempty"`
+	ExcessBlobGas    uint64        `json:"excess_blob_gas,string,omitempty"`
+}
+
+type beaconAPILightClientHeader struct {
+	Beacon          beaconAPIBlockHeader            `json:"beacon"`
+	Execution       beaconAPIExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []hexutil.Bytes                 `json:"execution_branch"`
+}
+
+type beaconAPISyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+type beaconAPISyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+type beaconAPILightClientBootstrap struct {
+	Header                     beaconAPILightClientHeader `json:"header"`
+	CurrentSyncCommittee       beaconAPISyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []hexutil.Bytes            `json:"current_sync_committee_branch"`
+}
+
+type beaconAPILightClientUpdate struct {
+	AttestedHeader          beaconAPILightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *beaconAPISyncCommittee    `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []hexutil.Bytes            `json:"next_sync_committee_branch"`
+	FinalizedHeader         beaconAPILightClientHeader `json:"finalized_header"`
+	FinalityBranch          []hexutil.Bytes            `json:"finality_branch"`
+	SyncAggregate           beaconAPISyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64                     `json:"signature_slot,string"`
+}
+
+// ParseBeaconAPILightClientBootstrap parses a light client bootstrap served by the beacon node API
+func ParseBeaconAPILightClientBootstrap(bz []byte) (LightClientBootstrap, error) {
+	var bootstrap beaconAPILightClientBootstrap
+	if err := unmarshalBeaconAPIData(bz, &bootstrap); err != nil {
+		return LightClientBootstrap{}, err
+	}
+	return LightClientBootstrap{
+		Header:                     bootstrap.Header.toLightClientHeader(),
+		CurrentSyncCommittee:       bootstrap.CurrentSyncCommittee.toSyncCommittee(),
+		CurrentSyncCommitteeBranch: toBytesList(bootstrap.CurrentSyncCommitteeBranch),
+	}, nil
+}
+
+// ParseBeaconAPILightClientUpdate parses a light client update or finality update served by the beacon node API
+func ParseBeaconAPILightClientUpdate(bz []byte) (LightClientUpdate, error) {
+	var update beaconAPILightClientUpdate
+	if err := unmarshalBeaconAPIData(bz, &update); err != nil {
+		return LightClientUpdate{}, err
+	}
+	res := LightClientUpdate{
+		AttestedHeader:  update.AttestedHeader.toLightClientHeader(),
+		FinalizedHeader: update.FinalizedHeader.toLightClientHeader(),
+		FinalityBranch:  toBytesList(update.FinalityBranch),
+		SyncAggregate: SyncAggregate{
+			SyncCommitteeBits:      update.SyncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature: update.SyncAggregate.SyncCommitteeSignature,
+		},
+		SignatureSlot: update.SignatureSlot,
+	}
+	if update.NextSyncCommittee != nil && len(update.NextSyncCommittee.Pubkeys) > 0 {
+		nextSyncCommittee := update.NextSyncCommittee.toSyncCommittee()
+		res.NextSyncCommittee = &nextSyncCommittee
+		res.NextSyncCommitteeBranch = toBytesList(update.NextSyncCommitteeBranch)
+	}
+	return res, nil
+}
+
+// unmarshalBeaconAPIData unmarshals an object served by the beacon node API, with or without the versioned envelope
+// The updates endpoint serves a list of updates, a list is accepted if it has a single object
+func unmarshalBeaconAPIData(bz []byte, v interface{}) error {
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) > 0 && trimmed[0] == '[' {
+		var list []json.RawMessage
+		if err := json.Unmarshal(trimmed, &list); err != nil {
+			return err
+		}
+		if len(list) != 1 {
+			return fmt.Errorf("expected a single object, got %d objects", len(list))
+		}
+		bz = list[0]
+	}
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(bz, &envelope); err != nil {
+		return err
+	}
+	if len(envelope.Data) > 0 {
+		bz = envelope.Data
+	}
+	return json.Unmarshal(bz, v)
+}
+
+func (h beaconAPILightClientHeader) toLightClientHeader() LightClientHeader {
+	return LightClientHeader{
+		Beacon: BeaconBlockHeader{
+			Slot:          h.Beacon.Slot,
+			ProposerIndex: h.Beacon.ProposerIndex,
+			ParentRoot:    h.Beacon.ParentRoot,
+			StateRoot:     h.Beacon.StateRoot,
+			BodyRoot:      h.Beacon.BodyRoot,
+		},
+		Execution: ExecutionPayloadHeader{
+			ParentHash:       h.Execution.ParentHash,
+			FeeRecipient:     h.Execution.FeeRecipient,
+			StateRoot:        h.Execution.StateRoot,
+			ReceiptsRoot:     h.Execution.ReceiptsRoot,
+			LogsBloom:        h.Execution.LogsBloom,
+			PrevRandao:       h.Execution.PrevRandao,
+			BlockNumber:      h.Execution.BlockNumber,
+			GasLimit:         h.Execution.GasLimit,
+			GasUsed:          h.Execution.GasUsed,
+			Timestamp:        h.Execution.Timestamp,
+			ExtraData:        h.Execution.ExtraData,
+			BaseFeePerGas:    h.Execution.BaseFeePerGas,
+			BlockHash:        h.Execution.BlockHash,
+			TransactionsRoot: h.Execution.TransactionsRoot,
+			WithdrawalsRoot:  h.Execution.WithdrawalsRoot,
+			BlobGasUsed:      h.Execution.BlobGasUsed,
+			ExcessBlobGas:    h.Execution.ExcessBlobGas,
+		},
+		ExecutionBranch: toBytesList(h.ExecutionBranch),
+	}
+}
+
+func (c beaconAPISyncCommittee) toSyncCommittee() SyncCommittee {
+	return SyncCommittee{
+		Pubkeys:         toBytesList(c.Pubkeys),
+		AggregatePubkey: c.AggregatePubkey,
+	}
+}
+
+func toBytesList(list []hexutil.Bytes) [][]byte {
+	res := make([][]byte, len(list))
+	for i, b := range list {
+		res[i] = b
+	}
+	return res
+}
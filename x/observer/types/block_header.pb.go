@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -29,6 +30,8 @@ type BlockHeaderState struct {
 	LatestHeight    int64  `protobuf:"varint,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
 	EarliestHeight  int64  `protobuf:"varint,3,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
 	LatestBlockHash []byte `protobuf:"bytes,4,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	// the block headers below this height are pruned, except the ones referenced by the trackers
+	PrunedHeight int64 `protobuf:"varint,5,opt,name=pruned_height,json=prunedHeight,proto3" json:"pruned_height,omitempty"`
 }
 
 func (m *BlockHeaderState) Reset()         { *m = BlockHeaderState{} }
@@ -92,31 +95,155 @@ func (m *BlockHeaderState) GetLatestBlockHash() []byte {
 	return nil
 }
 
+func (m *BlockHeaderState) GetPrunedHeight() int64 {
+	if m != nil {
+		return m.PrunedHeight
+	}
+	return 0
+}
+
+// HeightRange is an inclusive range of block heights
+type HeightRange struct {
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *HeightRange) Reset()         { *m = HeightRange{} }
+func (m *HeightRange) String() string { return proto.CompactTextString(m) }
+func (*HeightRange) ProtoMessage()    {}
+func (*HeightRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fad6da3aeeeaa45, []int{1}
+}
+func (m *HeightRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightRange.Merge(m, src)
+}
+func (m *HeightRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightRange proto.InternalMessageInfo
+
+func (m *HeightRange) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *HeightRange) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// BlockChainWork is the cumulative proof-of-work of the Bitcoin header chain ending at a block header
+type BlockChainWork struct {
+	ChainId   int64                                   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockHash []byte                                  `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ChainWork github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=chain_work,json=chainWork,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"chain_work"`
+}
+
+func (m *BlockChainWork) Reset()         { *m = BlockChainWork{} }
+func (m *BlockChainWork) String() string { return proto.CompactTextString(m) }
+func (*BlockChainWork) ProtoMessage()    {}
+func (*BlockChainWork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fad6da3aeeeaa45, []int{2}
+}
+func (m *BlockChainWork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockChainWork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockChainWork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockChainWork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockChainWork.Merge(m, src)
+}
+func (m *BlockChainWork) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockChainWork) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockChainWork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockChainWork proto.InternalMessageInfo
+
+func (m *BlockChainWork) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlockChainWork) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockHeaderState)(nil), "zetachain.zetacore.observer.BlockHeaderState")
+	proto.RegisterType((*HeightRange)(nil), "zetachain.zetacore.observer.HeightRange")
+	proto.RegisterType((*BlockChainWork)(nil), "zetachain.zetacore.observer.BlockChainWork")
 }
 
 func init() { proto.RegisterFile("observer/block_header.proto", fileDescriptor_9fad6da3aeeeaa45) }
 
 var fileDescriptor_9fad6da3aeeeaa45 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4f, 0x2a, 0x4e,
-	0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x8e, 0xcf, 0x48, 0x4d, 0x4c, 0x49,
-	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xae, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48,
-	0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x60, 0xea, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c, 0x88, 0x16, 0x29, 0x71, 0xb8, 0x79, 0x30, 0x06, 0x44, 0x42,
-	0x69, 0x39, 0x23, 0x97, 0x80, 0x13, 0xc8, 0x0a, 0x0f, 0xb0, 0x0d, 0xc1, 0x25, 0x89, 0x25, 0xa9,
-	0x42, 0x92, 0x5c, 0x1c, 0x60, 0xe3, 0xe3, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83,
-	0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x65, 0x2e, 0xde, 0x9c, 0xc4, 0x92, 0xd4, 0xe2, 0x92, 0xf8,
-	0x8c, 0xd4, 0xcc, 0xf4, 0x8c, 0x12, 0x09, 0x26, 0xb0, 0x3c, 0x0f, 0x44, 0xd0, 0x03, 0x2c, 0x26,
-	0xa4, 0xce, 0xc5, 0x9f, 0x9a, 0x58, 0x94, 0x93, 0x89, 0xa4, 0x8c, 0x19, 0xac, 0x8c, 0x0f, 0x26,
-	0x0c, 0x55, 0xa8, 0xc5, 0x25, 0x08, 0x35, 0x0d, 0xea, 0xcd, 0xc4, 0xe2, 0x0c, 0x09, 0x16, 0x05,
-	0x46, 0x0d, 0x9e, 0x20, 0x7e, 0x88, 0x04, 0xc4, 0x6d, 0x89, 0xc5, 0x19, 0x4e, 0x9e, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0a, 0x10, 0x5d, 0xb0, 0x63, 0xf5, 0x61, 0x61, 0xa3, 0x5f, 0x01,
-	0xf7, 0xb4, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa7, 0xf5, 0x45, 0x4d, 0x66, 0x01, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcb, 0x4e, 0xc2, 0x40,
+	0x14, 0xed, 0x80, 0xaf, 0x0e, 0x08, 0xda, 0x98, 0x88, 0x10, 0x0a, 0xe2, 0x02, 0x62, 0x42, 0x67,
+	0xe1, 0x1f, 0xe0, 0x06, 0x36, 0x9a, 0xd4, 0x18, 0x13, 0x37, 0x64, 0xda, 0x4e, 0xda, 0xa6, 0xd0,
+	0x21, 0x33, 0x83, 0xaf, 0xaf, 0x70, 0xed, 0x17, 0xb1, 0x70, 0xc1, 0xd2, 0xb8, 0x20, 0x06, 0x7e,
+	0xc4, 0x74, 0xa6, 0x6d, 0x70, 0xe3, 0xaa, 0xb7, 0x67, 0xce, 0x3d, 0xf7, 0x9c, 0x9b, 0x0b, 0x1b,
+	0xd4, 0xe1, 0x84, 0x3d, 0x11, 0x86, 0x9c, 0x09, 0x75, 0xa3, 0x71, 0x40, 0xb0, 0x47, 0x98, 0x35,
+	0x63, 0x54, 0x50, 0xa3, 0xf1, 0x46, 0x04, 0x76, 0x03, 0x1c, 0xc6, 0x96, 0xac, 0x28, 0x23, 0x56,
+	0xc6, 0xaf, 0x9f, 0xf8, 0xd4, 0xa7, 0x92, 0x87, 0x92, 0x4a, 0xb5, 0xd4, 0x4f, 0x73, 0xbd, 0xac,
+	0x50, 0x0f, 0x9d, 0x4f, 0x00, 0x8f, 0x06, 0xc9, 0x88, 0xa1, 0x9c, 0x70, 0x27, 0xb0, 0x20, 0xc6,
+	0x19, 0x3c, 0x90, 0xf2, 0xe3, 0xd0, 0xab, 0x81, 0x36, 0xe8, 0x15, 0xed, 0x7d, 0xf9, 0x3f, 0xf2,
+	0x8c, 0x0b, 0x78, 0x38, 0xc1, 0x82, 0x70, 0x31, 0x0e, 0x48, 0xe8, 0x07, 0xa2, 0x56, 0x90, 0xef,
+	0x65, 0x05, 0x0e, 0x25, 0x66, 0x74, 0x61, 0x95, 0x60, 0x36, 0x09, 0xb7, 0x68, 0x45, 0x49, 0xab,
+	0x64, 0x70, 0x4a, 0xbc, 0x84, 0xc7, 0xa9, 0x5a, 0x1a, 0x13, 0xf3, 0xa0, 0xb6, 0xd3, 0x06, 0xbd,
+	0xb2, 0x5d, 0x55, 0x0f, 0xca, 0x1b, 0xe6, 0x41, 0x32, 0x79, 0xc6, 0xe6, 0x31, 0xf1, 0x32, 0xc9,
+	0x5d, 0x35, 0x59, 0x81, 0x4a, 0xb0, 0x73, 0x0b, 0x4b, 0xaa, 0xb2, 0x71, 0xec, 0x13, 0xe3, 0x1c,
+	0x96, 0xb9, 0xc0, 0x2c, 0x77, 0xa1, 0xc2, 0x94, 0x24, 0x96, 0x5a, 0x68, 0x42, 0x48, 0x62, 0xef,
+	0x6f, 0x1a, 0x9d, 0xc4, 0x99, 0xe0, 0x07, 0x80, 0x15, 0xe9, 0xe1, 0x3a, 0x59, 0xc0, 0x03, 0x65,
+	0xd1, 0x7f, 0xdb, 0x69, 0x42, 0xb8, 0x15, 0xa4, 0x20, 0x83, 0xe8, 0x4e, 0x1e, 0xe1, 0x06, 0x42,
+	0xd5, 0xf9, 0x4c, 0x59, 0x24, 0x57, 0xa2, 0x0f, 0xd0, 0x62, 0xd5, 0xd2, 0xbe, 0x57, 0xad, 0xae,
+	0x1f, 0x8a, 0x60, 0xee, 0x58, 0x2e, 0x9d, 0x22, 0x97, 0xf2, 0x29, 0xe5, 0xe9, 0xa7, 0xcf, 0xbd,
+	0x08, 0x89, 0xd7, 0x19, 0xe1, 0xd6, 0x7d, 0x18, 0x0b, 0x5b, 0x77, 0x33, 0x27, 0x83, 0xd1, 0x62,
+	0x6d, 0x82, 0xe5, 0xda, 0x04, 0x3f, 0x6b, 0x13, 0xbc, 0x6f, 0x4c, 0x6d, 0xb9, 0x31, 0xb5, 0xaf,
+	0x8d, 0xa9, 0x3d, 0xa2, 0x2d, 0xb5, 0xe4, 0x46, 0xfa, 0xb2, 0x09, 0x65, 0xe7, 0x82, 0x5e, 0xf2,
+	0x3b, 0x50, 0xd2, 0xce, 0x9e, 0x3c, 0x87, 0xab, 0xdf, 0x01, 0x00, 0x58, 0x88, 0x21, 0xf5, 0x79,
+	0x02, 0x00, 0x00,
 }
 
 func (m *BlockHeaderState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +266,11 @@ func (m *BlockHeaderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PrunedHeight != 0 {
+		i = encodeVarintBlockHeader(dAtA, i, uint64(m.PrunedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.LatestBlockHash) > 0 {
 		i -= len(m.LatestBlockHash)
 		copy(dAtA[i:], m.LatestBlockHash)
@@ -164,6 +296,84 @@ func (m *BlockHeaderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeightRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintBlockHeader(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBlockHeader(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockChainWork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockChainWork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockChainWork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChainWork.Size()
+		i -= size
+		if _, err := m.ChainWork.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBlockHeader(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBlockHeader(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintBlockHeader(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlockHeader(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlockHeader(v)
 	base := offset
@@ -194,6 +404,42 @@ func (m *BlockHeaderState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlockHeader(uint64(l))
 	}
+	if m.PrunedHeight != 0 {
+		n += 1 + sovBlockHeader(uint64(m.PrunedHeight))
+	}
+	return n
+}
+
+func (m *HeightRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovBlockHeader(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBlockHeader(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *BlockChainWork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovBlockHeader(uint64(m.ChainId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBlockHeader(uint64(l))
+	}
+	l = m.ChainWork.Size()
+	n += 1 + l + sovBlockHeader(uint64(l))
 	return n
 }
 
@@ -323,6 +569,250 @@ func (m *BlockHeaderState) Unmarshal(dAtA []byte) error {
 				m.LatestBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedHeight", wireType)
+			}
+			m.PrunedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockChainWork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockChainWork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockChainWork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainWork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainWork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlockHeader(dAtA[iNdEx:])
@@ -15,6 +15,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateKeygen{}, "crosschain/UpdateKeygen", nil)
 	cdc.RegisterConcrete(&MsgAddBlockHeader{}, "crosschain/AddBlockHeader", nil)
 	cdc.RegisterConcrete(&MsgUpdateObserver{}, "observer/UpdateObserver", nil)
+	cdc.RegisterConcrete(&MsgApproveKeygenRetry{}, "observer/ApproveKeygenRetry", nil)
+	cdc.RegisterConcrete(&MsgUpdateLivenessParams{}, "observer/UpdateLivenessParams", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "observer/UnjailObserver", nil)
+	cdc.RegisterConcrete(&MsgInitLightClient{}, "observer/InitLightClient", nil)
+	cdc.RegisterConcrete(&MsgSubmitLightClientUpdate{}, "observer/SubmitLightClientUpdate", nil)
+	cdc.RegisterConcrete(&MsgUpdateOutboundPause{}, "observer/UpdateOutboundPause", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +32,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateKeygen{},
 		&MsgAddBlockHeader{},
 		&MsgUpdateObserver{},
+		&MsgApproveKeygenRetry{},
+		&MsgUpdateLivenessParams{},
+		&MsgUnjailObserver{},
+		&MsgInitLightClient{},
+		&MsgSubmitLightClientUpdate{},
+		&MsgUpdateOutboundPause{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+// NewConfirmationTier returns a confirmation tier for the amount in units of the asset
+func NewConfirmationTier(coinType common.CoinType, asset string, amountThreshold sdk.Dec, confirmationCount uint64) ConfirmationTier {
+	return ConfirmationTier{
+		CoinType:          coinType,
+		Asset:             asset,
+		AmountThreshold:   amountThreshold,
+		ConfirmationCount: confirmationCount,
+	}
+}
+
+// Validate checks the coin type and the asset of the tier, the amount and the confirmation count must be positive
+func (t ConfirmationTier) Validate() error {
+	switch t.CoinType {
+	case common.CoinType_Zeta, common.CoinType_Gas:
+		if t.Asset != "" {
+			return fmt.Errorf("asset must be empty for coin type %s", t.CoinType)
+		}
+	case common.CoinType_ERC20:
+		if !ethcommon.IsHexAddress(t.Asset) {
+			return fmt.Errorf("invalid ERC20 asset %s", t.Asset)
+		}
+	default:
+		return fmt.Errorf("invalid coin type %s", t.CoinType)
+	}
+	if t.AmountThreshold.IsNil() || !t.AmountThreshold.IsPositive() {
+		return fmt.Errorf("amount threshold must be positive")
+	}
+	if t.ConfirmationCount == 0 {
+		return fmt.Errorf("confirmation count must be greater than 0")
+	}
+	return nil
+}
+
+// appliesTo returns true if the tier is a tier of the asset
+func (t ConfirmationTier) appliesTo(coinType common.CoinType, asset string) bool {
+	return t.CoinType == coinType && strings.EqualFold(t.Asset, asset)
+}
+
+// ValidateConfirmationTiers validates the tiers and checks there is no duplicated amount threshold for an asset
+func ValidateConfirmationTiers(tiers []ConfirmationTier) error {
+	existing := make(map[string]struct{})
+	for _, tier := range tiers {
+		if err := tier.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%s", tier.CoinType, strings.ToLower(tier.Asset), tier.AmountThreshold)
+		if _, found := existing[key]; found {
+			return fmt.Errorf("duplicated amount threshold %s for coin type %s asset %s", tier.AmountThreshold, tier.CoinType, tier.Asset)
+		}
+		existing[key] = struct{}{}
+	}
+	return nil
+}
+
+// ConfirmationCountForAmount returns the confirmation count required for the amount of the asset in its smallest unit,
+// decimals is the number of decimals of the ZRC20 of the asset
+// The confirmation count of the tier with the highest amount threshold not above the amount is returned, or
+// ConfirmationCount if there is none
+func (cp CoreParams) ConfirmationCountForAmount(coinType common.CoinType, asset string, amount sdkmath.Uint, decimals uint32) uint64 {
+	unit := sdkmath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	amountDec := sdk.NewDecFromBigInt(amount.BigInt())
+
+	confirmationCount := cp.ConfirmationCount
+	var highest *sdk.Dec
+	for i, tier := range cp.ConfirmationTiers {
+		if !tier.appliesTo(coinType, asset) {
+			continue
+		}
+		if amountDec.LT(tier.AmountThreshold.MulInt(unit)) {
+			continue
+		}
+		if highest == nil || tier.AmountThreshold.GT(*highest) {
+			highest = &cp.ConfirmationTiers[i].AmountThreshold
+			confirmationCount = tier.ConfirmationCount
+		}
+	}
+	return confirmationCount
+}
+
+// MaxConfirmationCountForAsset returns the highest confirmation count that can be required for the asset
+func (cp CoreParams) MaxConfirmationCountForAsset(coinType common.CoinType, asset string) uint64 {
+	confirmationCount := cp.ConfirmationCount
+	for _, tier := range cp.ConfirmationTiers {
+		if tier.appliesTo(coinType, asset) && tier.ConfirmationCount > confirmationCount {
+			confirmationCount = tier.ConfirmationCount
+		}
+	}
+	return confirmationCount
+}
+
+// MinConfirmationCount returns the lowest confirmation count that can be required for a transfer
+func (cp CoreParams) MinConfirmationCount() uint64 {
+	confirmationCount := cp.ConfirmationCount
+	for _, tier := range cp.ConfirmationTiers {
+		if tier.ConfirmationCount < confirmationCount {
+			confirmationCount = tier.ConfirmationCount
+		}
+	}
+	return confirmationCount
+}
+
+// MaxConfirmationCount returns the highest confirmation count that can be required for a transfer
+func (cp CoreParams) MaxConfirmationCount() uint64 {
+	confirmationCount := cp.ConfirmationCount
+	for _, tier := range cp.ConfirmationTiers {
+		if tier.ConfirmationCount > confirmationCount {
+			confirmationCount = tier.ConfirmationCount
+		}
+	}
+	return confirmationCount
+}
+
+// CoreParamsEqual returns true if the core params and their confirmation tiers are equal
+func CoreParamsEqual(params1, params2 CoreParams) bool {
+	bz1, err1 := params1.Marshal()
+	bz2, err2 := params2.Marshal()
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return bytes.Equal(bz1, bz2)
+}
@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestConfirmationTier_Validate(t *testing.T) {
+	asset := "0xD28D6A0b8189305551a0A8bd247a6ECa9CE781Ca"
+	tests := []struct {
+		name    string
+		tier    types.ConfirmationTier
+		wantErr bool
+	}{
+		{"valid gas tier", types.NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(2), 6), false},
+		{"valid zeta tier", types.NewConfirmationTier(common.CoinType_Zeta, "", sdk.MustNewDecFromStr("0.5"), 6), false},
+		{"valid erc20 tier", types.NewConfirmationTier(common.CoinType_ERC20, asset, sdk.NewDec(1000), 6), false},
+		{"asset for gas", types.NewConfirmationTier(common.CoinType_Gas, asset, sdk.NewDec(2), 6), true},
+		{"invalid erc20 asset", types.NewConfirmationTier(common.CoinType_ERC20, "foo", sdk.NewDec(2), 6), true},
+		{"invalid coin type", types.NewConfirmationTier(common.CoinType_Cmd, "", sdk.NewDec(2), 6), true},
+		{"nil amount", types.ConfirmationTier{CoinType: common.CoinType_Gas, ConfirmationCount: 6}, true},
+		{"zero amount", types.NewConfirmationTier(common.CoinType_Gas, "", sdk.ZeroDec(), 6), true},
+		{"zero confirmation count", types.NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(2), 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tier.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCoreParams_ConfirmationCountForAmount(t *testing.T) {
+	asset := "0xD28D6A0b8189305551a0A8bd247a6ECa9CE781Ca"
+	params := types.CoreParams{
+		ConfirmationCount: 2,
+		ConfirmationTiers: []types.ConfirmationTier{
+			types.NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(10), 12),
+			types.NewConfirmationTier(common.CoinType_Gas, "", sdk.MustNewDecFromStr("0.5"), 6),
+			types.NewConfirmationTier(common.CoinType_ERC20, asset, sdk.NewDec(1000), 20),
+		},
+	}
+
+	btc := func(amount int64) sdkmath.Uint { return sdkmath.NewUint(uint64(amount)) }
+	require.EqualValues(t, 2, params.ConfirmationCountForAmount(common.CoinType_Gas, "", btc(49_999_999), 8))
+	require.EqualValues(t, 6, params.ConfirmationCountForAmount(common.CoinType_Gas, "", btc(50_000_000), 8))
+	require.EqualValues(t, 6, params.ConfirmationCountForAmount(common.CoinType_Gas, "", btc(999_999_999), 8))
+	require.EqualValues(t, 12, params.ConfirmationCountForAmount(common.CoinType_Gas, "", btc(1_000_000_000), 8))
+
+	// the amounts are normalized with the decimals of the asset
+	require.EqualValues(t, 2, params.ConfirmationCountForAmount(common.CoinType_Gas, "", btc(1_000_000_000), 18))
+
+	// the tiers of the other assets are not used
+	require.EqualValues(t, 2, params.ConfirmationCountForAmount(common.CoinType_Zeta, "", btc(1_000_000_000), 8))
+	erc20Amount := sdkmath.NewUintFromString("1000000000000000000000")
+	require.EqualValues(t, 20, params.ConfirmationCountForAmount(common.CoinType_ERC20, "0xd28d6a0b8189305551a0a8bd247a6eca9ce781ca", erc20Amount, 18))
+	require.EqualValues(t, 2, params.ConfirmationCountForAmount(common.CoinType_ERC20, "0xA8D5060feb6B456e886F023709A2795373691E63", erc20Amount, 18))
+
+	require.EqualValues(t, 2, params.MinConfirmationCount())
+	require.EqualValues(t, 20, params.MaxConfirmationCount())
+	require.EqualValues(t, 12, params.MaxConfirmationCountForAsset(common.CoinType_Gas, ""))
+	require.EqualValues(t, 2, params.MaxConfirmationCountForAsset(common.CoinType_Zeta, ""))
+
+	params.ConfirmationTiers = append(params.ConfirmationTiers, types.NewConfirmationTier(common.CoinType_Zeta, "", sdk.NewDec(1), 1))
+	require.EqualValues(t, 1, params.MinConfirmationCount())
+}
+
+func TestCoreParamsEqual(t *testing.T) {
+	params := types.GetCoreParams().CoreParams[2]
+	copied := *params
+	copied.ConfirmationTiers = []types.ConfirmationTier{
+		types.NewConfirmationTier(common.CoinType_Gas, "", sdk.MustNewDecFromStr("2.0"), 6),
+	}
+	require.True(t, types.CoreParamsEqual(*params, copied))
+
+	copied.ConfirmationTiers = []types.ConfirmationTier{
+		types.NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(3), 6),
+	}
+	require.False(t, types.CoreParamsEqual(*params, copied))
+
+	copied = *params
+	copied.InTxTicker++
+	require.False(t, types.CoreParamsEqual(*params, copied))
+}
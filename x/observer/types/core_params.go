@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/zeta-chain/zetacore/common"
 )
 
@@ -48,6 +50,7 @@ func GetCoreParams() CoreParamsList {
 				GasPriceTicker:              30,
 				OutboundTxScheduleInterval:  30,
 				OutboundTxScheduleLookahead: 60,
+				ConfirmationTiers:           BitcoinConfirmationTiers(),
 			},
 			{
 				ChainId:           common.GoerliChain().ChainId,
@@ -101,6 +104,7 @@ func GetCoreParams() CoreParamsList {
 				GasPriceTicker:              30,
 				OutboundTxScheduleInterval:  30,
 				OutboundTxScheduleLookahead: 100,
+				ConfirmationTiers:           BitcoinConfirmationTiers(),
 			},
 			{
 				ChainId:                     common.BtcRegtestChain().ChainId,
@@ -114,6 +118,7 @@ func GetCoreParams() CoreParamsList {
 				OutTxTicker:                 2,
 				OutboundTxScheduleInterval:  2,
 				OutboundTxScheduleLookahead: 5,
+				ConfirmationTiers:           BitcoinConfirmationTiers(),
 			},
 			{
 				ChainId:                     common.GoerliLocalnetChain().ChainId,
@@ -132,6 +137,14 @@ func GetCoreParams() CoreParamsList {
 	}
 }
 
+// BitcoinConfirmationTiers returns the default confirmation tiers of the bitcoin chains, 6 confirmations are required
+// from 2 BTC
+func BitcoinConfirmationTiers() []ConfirmationTier {
+	return []ConfirmationTier{
+		NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(2), 6),
+	}
+}
+
 // Validate checks all core params correspond to a chain and there is no duplicate chain id
 func (cpl CoreParamsList) Validate() error {
 	// check all core params correspond to a chain
@@ -35,3 +35,44 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		BlockHeaderVerificationFlags: &DefaultBlockHeaderVerificationFlags,
 	}
 }
+
+// IsPermissionlessInboundEnabled returns true if the inbound txs of the chain proven against a block header create a cctx
+// without observer ballot
+func (f *BlockHeaderVerificationFlags) IsPermissionlessInboundEnabled(chainID int64) bool {
+	if f == nil {
+		return false
+	}
+	for _, id := range f.PermissionlessInboundChainIds {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOutboundPause returns the pause of the outbound of the chain if the outbound is paused
+func (f CrosschainFlags) GetOutboundPause(chainID int64) (OutboundPause, bool) {
+	for _, pause := range f.OutboundPauses {
+		if pause.ChainId == chainID {
+			return pause, true
+		}
+	}
+	return OutboundPause{}, false
+}
+
+// SetOutboundPause pauses the outbound of the chain of the pause, the pause replaces the previous pause of the chain
+func (f *CrosschainFlags) SetOutboundPause(pause OutboundPause) {
+	f.RemoveOutboundPause(pause.ChainId)
+	f.OutboundPauses = append(f.OutboundPauses, pause)
+}
+
+// RemoveOutboundPause resumes the outbound of the chain
+func (f *CrosschainFlags) RemoveOutboundPause(chainID int64) {
+	pauses := make([]OutboundPause, 0, len(f.OutboundPauses))
+	for _, pause := range f.OutboundPauses {
+		if pause.ChainId != chainID {
+			pauses = append(pauses, pause)
+		}
+	}
+	f.OutboundPauses = pauses
+}
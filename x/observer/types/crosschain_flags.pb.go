@@ -110,6 +110,8 @@ func (m *GasPriceIncreaseFlags) GetMaxPendingCctxs() uint32 {
 type BlockHeaderVerificationFlags struct {
 	IsEthTypeChainEnabled bool `protobuf:"varint,1,opt,name=isEthTypeChainEnabled,proto3" json:"isEthTypeChainEnabled,omitempty"`
 	IsBtcTypeChainEnabled bool `protobuf:"varint,2,opt,name=isBtcTypeChainEnabled,proto3" json:"isBtcTypeChainEnabled,omitempty"`
+	// chains whose inbound txs proven against a block header create a cctx without observer ballot
+	PermissionlessInboundChainIds []int64 `protobuf:"varint,3,rep,packed,name=permissionlessInboundChainIds,proto3" json:"permissionlessInboundChainIds,omitempty"`
 }
 
 func (m *BlockHeaderVerificationFlags) Reset()         { *m = BlockHeaderVerificationFlags{} }
@@ -159,18 +161,96 @@ func (m *BlockHeaderVerificationFlags) GetIsBtcTypeChainEnabled() bool {
 	return false
 }
 
+func (m *BlockHeaderVerificationFlags) GetPermissionlessInboundChainIds() []int64 {
+	if m != nil {
+		return m.PermissionlessInboundChainIds
+	}
+	return nil
+}
+
+// OutboundPause is the pause of the outbound of a chain, the observers don't schedule the outbound txs of a paused chain
+type OutboundPause struct {
+	ChainId int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// admin policy account or observer that paused the outbound
+	Creator     string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	BlockHeight int64  `protobuf:"varint,4,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (m *OutboundPause) Reset()         { *m = OutboundPause{} }
+func (m *OutboundPause) String() string { return proto.CompactTextString(m) }
+func (*OutboundPause) ProtoMessage()    {}
+func (*OutboundPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{2}
+}
+func (m *OutboundPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundPause.Merge(m, src)
+}
+func (m *OutboundPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundPause proto.InternalMessageInfo
+
+func (m *OutboundPause) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *OutboundPause) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OutboundPause) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *OutboundPause) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 type CrosschainFlags struct {
 	IsInboundEnabled             bool                          `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled            bool                          `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,4,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	OutboundPauses               []OutboundPause               `protobuf:"bytes,5,rep,name=outboundPauses,proto3" json:"outboundPauses"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
 func (m *CrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*CrosschainFlags) ProtoMessage()    {}
 func (*CrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{2}
+	return fileDescriptor_b948b59e4d986f49, []int{3}
 }
 func (m *CrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +307,13 @@ func (m *CrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeaderVerifica
 	return nil
 }
 
+func (m *CrosschainFlags) GetOutboundPauses() []OutboundPause {
+	if m != nil {
+		return m.OutboundPauses
+	}
+	return nil
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -237,7 +324,7 @@ func (m *LegacyCrosschainFlags) Reset()         { *m = LegacyCrosschainFlags{} }
 func (m *LegacyCrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*LegacyCrosschainFlags) ProtoMessage()    {}
 func (*LegacyCrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{3}
+	return fileDescriptor_b948b59e4d986f49, []int{4}
 }
 func (m *LegacyCrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +377,7 @@ func (m *LegacyCrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlag
 func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.GasPriceIncreaseFlags")
 	proto.RegisterType((*BlockHeaderVerificationFlags)(nil), "zetachain.zetacore.observer.BlockHeaderVerificationFlags")
+	proto.RegisterType((*OutboundPause)(nil), "zetachain.zetacore.observer.OutboundPause")
 	proto.RegisterType((*CrosschainFlags)(nil), "zetachain.zetacore.observer.CrosschainFlags")
 	proto.RegisterType((*LegacyCrosschainFlags)(nil), "zetachain.zetacore.observer.LegacyCrosschainFlags")
 }
@@ -297,38 +385,45 @@ func init() {
 func init() { proto.RegisterFile("observer/crosschain_flags.proto", fileDescriptor_b948b59e4d986f49) }
 
 var fileDescriptor_b948b59e4d986f49 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x5d, 0x95, 0x65, 0xca, 0xb2, 0x1a, 0x2d, 0xd6, 0x75, 0x49, 0x4b, 0x4f, 0x45,
-	0x34, 0x23, 0xd5, 0x83, 0x5e, 0x5b, 0x57, 0x0d, 0xac, 0x58, 0x82, 0x78, 0xf0, 0x22, 0x93, 0xc9,
-	0xeb, 0x64, 0x30, 0x3b, 0x53, 0x66, 0x26, 0x4b, 0x2b, 0xf8, 0x05, 0x3c, 0x79, 0x14, 0x3f, 0xd1,
-	0x1e, 0xf7, 0xe0, 0x41, 0x10, 0x54, 0xda, 0x2f, 0x22, 0x9d, 0xd8, 0xc5, 0xb6, 0x31, 0x1f, 0xc0,
-	0xdb, 0xe4, 0xfd, 0xdf, 0xff, 0xfd, 0x92, 0xf7, 0x5e, 0x06, 0xb7, 0x55, 0x6c, 0x40, 0x9f, 0x82,
-	0x26, 0x4c, 0x2b, 0x63, 0x58, 0x4a, 0x85, 0x7c, 0x3b, 0xce, 0x28, 0x37, 0xc1, 0x44, 0x2b, 0xab,
-	0xbc, 0xdb, 0xef, 0xc1, 0x52, 0x17, 0x0e, 0xdc, 0x49, 0x69, 0x08, 0x56, 0x9e, 0x83, 0x1b, 0x5c,
-	0x71, 0xe5, 0xf2, 0xc8, 0xf2, 0x54, 0x58, 0x0e, 0x7c, 0xae, 0x14, 0xcf, 0x80, 0xb8, 0xa7, 0x38,
-	0x1f, 0x93, 0x24, 0xd7, 0xd4, 0x0a, 0x25, 0x0b, 0xbd, 0xfb, 0xa5, 0x8e, 0x9b, 0xcf, 0xa8, 0x19,
-	0x69, 0xc1, 0x20, 0x94, 0x4c, 0x03, 0x35, 0xf0, 0x74, 0x89, 0xf4, 0x3a, 0xb8, 0x01, 0x13, 0xc5,
-	0xd2, 0x63, 0x90, 0xdc, 0xa6, 0x2d, 0xd4, 0x41, 0xbd, 0x9d, 0xe8, 0xef, 0x90, 0x17, 0xe2, 0x3d,
-	0x0d, 0x56, 0xcf, 0x42, 0x69, 0x41, 0x9f, 0xd2, 0xac, 0x55, 0xef, 0xa0, 0x5e, 0xa3, 0x7f, 0x2b,
-	0x28, 0x98, 0xc1, 0x8a, 0x19, 0x3c, 0xf9, 0xc3, 0x1c, 0xec, 0x9e, 0xfd, 0x68, 0xd7, 0x3e, 0xff,
-	0x6c, 0xa3, 0x68, 0xdd, 0xe9, 0x3d, 0xc2, 0x37, 0xf9, 0xc6, 0x5b, 0x8c, 0x40, 0x33, 0x90, 0xb6,
-	0xb5, 0xd3, 0x41, 0xbd, 0xbd, 0xe8, 0x5f, 0xb2, 0x77, 0x1f, 0x5f, 0xdf, 0x94, 0x5e, 0xd0, 0x69,
-	0xeb, 0x92, 0x73, 0x95, 0x49, 0x5e, 0x0f, 0xef, 0x9f, 0xd0, 0xe9, 0x08, 0x64, 0x22, 0x24, 0x1f,
-	0x32, 0x3b, 0x35, 0xad, 0xcb, 0x2e, 0x7b, 0x33, 0xdc, 0xfd, 0x88, 0xf0, 0xe1, 0x20, 0x53, 0xec,
-	0xdd, 0x73, 0xa0, 0x09, 0xe8, 0xd7, 0xa0, 0xc5, 0x58, 0x30, 0xf7, 0x29, 0x45, 0x8f, 0x1e, 0xe2,
-	0xa6, 0x30, 0x47, 0x36, 0x7d, 0x35, 0x9b, 0xc0, 0x70, 0x39, 0x97, 0x23, 0x49, 0xe3, 0x0c, 0x12,
-	0xd7, 0xad, 0xdd, 0xa8, 0x5c, 0x2c, 0x5c, 0x03, 0xcb, 0xb6, 0x5c, 0xf5, 0x95, 0xab, 0x44, 0xec,
-	0x7e, 0xad, 0xe3, 0xfd, 0xe1, 0xc5, 0x5e, 0x14, 0xfc, 0x3b, 0xf8, 0xaa, 0x30, 0xa1, 0x8c, 0x55,
-	0x2e, 0x93, 0x75, 0xf4, 0x56, 0xdc, 0xbb, 0x8b, 0xaf, 0x09, 0xf3, 0x32, 0xb7, 0x6b, 0xc9, 0x05,
-	0x71, 0x5b, 0xf0, 0x52, 0xdc, 0xe4, 0x65, 0x6b, 0xe1, 0xc6, 0xd1, 0xe8, 0xf7, 0x83, 0x8a, 0x55,
-	0x0c, 0x4a, 0x17, 0x2a, 0x2a, 0x2f, 0xe8, 0x7d, 0xc0, 0x87, 0x71, 0x45, 0x8f, 0xdd, 0x24, 0x1b,
-	0xfd, 0xc7, 0x95, 0xc0, 0xaa, 0x21, 0x45, 0x95, 0xe5, 0xbb, 0xdf, 0x11, 0x6e, 0x1e, 0x03, 0xa7,
-	0x6c, 0xf6, 0x1f, 0x36, 0x77, 0x10, 0x9e, 0xcd, 0x7d, 0x74, 0x3e, 0xf7, 0xd1, 0xaf, 0xb9, 0x8f,
-	0x3e, 0x2d, 0xfc, 0xda, 0xf9, 0xc2, 0xaf, 0x7d, 0x5b, 0xf8, 0xb5, 0x37, 0x84, 0x0b, 0x9b, 0xe6,
-	0x71, 0xc0, 0xd4, 0x09, 0x59, 0x42, 0xee, 0x39, 0x1e, 0x59, 0xf1, 0xc8, 0x94, 0x5c, 0xdc, 0x46,
-	0x76, 0x36, 0x01, 0x13, 0x5f, 0x71, 0xbf, 0xf3, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0xd8, 0x41, 0xc9, 0xa6, 0x04, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xd1, 0x6e, 0xd3, 0x3c,
+	0x14, 0x6e, 0x96, 0xfd, 0xfb, 0x37, 0x57, 0x63, 0x60, 0x28, 0x84, 0x31, 0xb2, 0xaa, 0x57, 0xd5,
+	0x04, 0x09, 0x2a, 0x5c, 0xc0, 0x6d, 0xb7, 0x01, 0x95, 0x86, 0xa8, 0x22, 0x84, 0x10, 0x37, 0xc8,
+	0x71, 0xcf, 0x1c, 0x8b, 0xcc, 0xae, 0x6c, 0x67, 0x6a, 0x91, 0xf6, 0x0e, 0x5c, 0x22, 0x1e, 0x82,
+	0xe7, 0xd8, 0xe5, 0x2e, 0x91, 0x90, 0x00, 0x6d, 0x2f, 0x82, 0xe2, 0x2c, 0xd3, 0xda, 0x85, 0x3c,
+	0x00, 0x77, 0x39, 0xe7, 0x3b, 0x9f, 0xbf, 0x93, 0xef, 0x1c, 0x1b, 0x6d, 0xca, 0x58, 0x83, 0x3a,
+	0x04, 0x15, 0x52, 0x25, 0xb5, 0xa6, 0x09, 0xe1, 0xe2, 0xc3, 0x7e, 0x4a, 0x98, 0x0e, 0xc6, 0x4a,
+	0x1a, 0x89, 0xef, 0x7d, 0x02, 0x43, 0x6c, 0x3a, 0xb0, 0x5f, 0x52, 0x41, 0x50, 0x72, 0xd6, 0x6f,
+	0x31, 0xc9, 0xa4, 0xad, 0x0b, 0xf3, 0xaf, 0x82, 0xb2, 0xee, 0x33, 0x29, 0x59, 0x0a, 0xa1, 0x8d,
+	0xe2, 0x6c, 0x3f, 0x1c, 0x65, 0x8a, 0x18, 0x2e, 0x45, 0x81, 0x77, 0xbe, 0x2e, 0xa0, 0xd6, 0x0b,
+	0xa2, 0x87, 0x8a, 0x53, 0x18, 0x08, 0xaa, 0x80, 0x68, 0x78, 0x9e, 0x4b, 0xe2, 0x36, 0x6a, 0xc2,
+	0x58, 0xd2, 0x64, 0x0f, 0x04, 0x33, 0x89, 0xe7, 0xb4, 0x9d, 0xae, 0x1b, 0x5d, 0x4e, 0xe1, 0x01,
+	0x5a, 0x55, 0x60, 0xd4, 0x74, 0x20, 0x0c, 0xa8, 0x43, 0x92, 0x7a, 0x0b, 0x6d, 0xa7, 0xdb, 0xec,
+	0xdd, 0x0d, 0x0a, 0xcd, 0xa0, 0xd4, 0x0c, 0x76, 0xce, 0x35, 0xfb, 0xcb, 0xc7, 0x3f, 0x37, 0x1b,
+	0x5f, 0x7e, 0x6d, 0x3a, 0xd1, 0x2c, 0x13, 0x3f, 0x45, 0x77, 0xd8, 0x5c, 0x17, 0x43, 0x50, 0x14,
+	0x84, 0xf1, 0xdc, 0xb6, 0xd3, 0x5d, 0x8d, 0xfe, 0x06, 0xe3, 0x47, 0xe8, 0xe6, 0x3c, 0xf4, 0x8a,
+	0x4c, 0xbc, 0x45, 0xcb, 0xaa, 0x82, 0x70, 0x17, 0xad, 0x1d, 0x90, 0xc9, 0x10, 0xc4, 0x88, 0x0b,
+	0xb6, 0x4d, 0xcd, 0x44, 0x7b, 0xff, 0xd9, 0xea, 0xf9, 0x74, 0xe7, 0xc4, 0x41, 0x1b, 0xfd, 0x54,
+	0xd2, 0x8f, 0x2f, 0x81, 0x8c, 0x40, 0xbd, 0x05, 0xc5, 0xf7, 0x39, 0xb5, 0xbf, 0x52, 0x78, 0xf4,
+	0x04, 0xb5, 0xb8, 0xde, 0x35, 0xc9, 0x9b, 0xe9, 0x18, 0xb6, 0xf3, 0xb9, 0xec, 0x0a, 0x12, 0xa7,
+	0x30, 0xb2, 0x6e, 0x2d, 0x47, 0xd5, 0x60, 0xc1, 0xea, 0x1b, 0x7a, 0x85, 0xb5, 0x50, 0xb2, 0x2a,
+	0x40, 0xbc, 0x83, 0xee, 0x8f, 0x41, 0x1d, 0x70, 0xad, 0xb9, 0x14, 0x29, 0x68, 0x3d, 0x10, 0xb1,
+	0xcc, 0xc4, 0xc8, 0x16, 0x0d, 0x46, 0xda, 0x73, 0xdb, 0x6e, 0xd7, 0x8d, 0xea, 0x8b, 0x3a, 0x47,
+	0x68, 0xf5, 0x75, 0x66, 0x6c, 0x6e, 0x48, 0x32, 0x0d, 0xd8, 0x43, 0xff, 0xd3, 0x02, 0x3c, 0x1f,
+	0x71, 0x19, 0xe2, 0xdb, 0x68, 0x29, 0xf7, 0x4c, 0x0a, 0xdb, 0xd7, 0x4a, 0x74, 0x1e, 0x59, 0x86,
+	0x02, 0x62, 0xa4, 0xb2, 0xb3, 0x59, 0x89, 0xca, 0x30, 0x5f, 0x99, 0xb8, 0xb0, 0x8b, 0xb3, 0xc4,
+	0xd8, 0x19, 0xb8, 0xd1, 0xe5, 0x54, 0xe7, 0x9b, 0x8b, 0xd6, 0xb6, 0x2f, 0x96, 0xbb, 0x30, 0x71,
+	0x0b, 0x5d, 0xe7, 0x65, 0x9f, 0xb3, 0xfe, 0x5d, 0xc9, 0xe3, 0x07, 0xe8, 0x06, 0xd7, 0xe5, 0x0f,
+	0xcc, 0xda, 0x76, 0x15, 0xc0, 0x09, 0x6a, 0xb1, 0xaa, 0xdd, 0xb6, 0x7d, 0x37, 0x7b, 0xbd, 0xa0,
+	0xe6, 0x3e, 0x05, 0x95, 0xb7, 0x22, 0xaa, 0x3e, 0x10, 0x1f, 0xa1, 0x8d, 0xb8, 0x66, 0x51, 0xac,
+	0x15, 0xcd, 0xde, 0xb3, 0x5a, 0xc1, 0xba, 0x4d, 0x8b, 0x6a, 0x8f, 0xc7, 0xef, 0xd0, 0x35, 0x79,
+	0x79, 0xaa, 0xf9, 0x46, 0xbb, 0xdd, 0x66, 0x6f, 0xab, 0x56, 0x70, 0x66, 0x11, 0xfa, 0x8b, 0xf9,
+	0xdd, 0x8c, 0xe6, 0xce, 0xe9, 0xfc, 0x70, 0x50, 0x6b, 0x0f, 0x18, 0xa1, 0xd3, 0x7f, 0x70, 0x6c,
+	0xfd, 0xc1, 0xf1, 0xa9, 0xef, 0x9c, 0x9c, 0xfa, 0xce, 0xef, 0x53, 0xdf, 0xf9, 0x7c, 0xe6, 0x37,
+	0x4e, 0xce, 0xfc, 0xc6, 0xf7, 0x33, 0xbf, 0xf1, 0x3e, 0x64, 0xdc, 0x24, 0x59, 0x1c, 0x50, 0x79,
+	0x10, 0xe6, 0x22, 0x0f, 0xad, 0x5e, 0x58, 0xea, 0x85, 0x93, 0xf0, 0xe2, 0xb1, 0x36, 0xd3, 0x31,
+	0xe8, 0x78, 0xc9, 0xbe, 0x76, 0x8f, 0xff, 0x0c, 0x00, 0x43, 0x40, 0x29, 0xaa, 0xc5, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -402,6 +497,25 @@ func (m *BlockHeaderVerificationFlags) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.PermissionlessInboundChainIds) > 0 {
+		dAtA3 := make([]byte, len(m.PermissionlessInboundChainIds)*10)
+		var j2 int
+		for _, num1 := range m.PermissionlessInboundChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.IsBtcTypeChainEnabled {
 		i--
 		if m.IsBtcTypeChainEnabled {
@@ -425,6 +539,53 @@ func (m *BlockHeaderVerificationFlags) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *OutboundPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -445,6 +606,20 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundPauses) > 0 {
+		for iNdEx := len(m.OutboundPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -593,6 +768,36 @@ func (m *BlockHeaderVerificationFlags) Size() (n int) {
 	if m.IsBtcTypeChainEnabled {
 		n += 2
 	}
+	if len(m.PermissionlessInboundChainIds) > 0 {
+		l = 0
+		for _, e := range m.PermissionlessInboundChainIds {
+			l += sovCrosschainFlags(uint64(e))
+		}
+		n += 1 + sovCrosschainFlags(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *OutboundPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.BlockHeight))
+	}
 	return n
 }
 
@@ -616,6 +821,12 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if len(m.OutboundPauses) > 0 {
+		for _, e := range m.OutboundPauses {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -872,6 +1083,234 @@ func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsBtcTypeChainEnabled = bool(v != 0)
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrosschainFlags
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PermissionlessInboundChainIds = append(m.PermissionlessInboundChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCrosschainFlags
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCrosschainFlags
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCrosschainFlags
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PermissionlessInboundChainIds) == 0 {
+					m.PermissionlessInboundChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCrosschainFlags
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PermissionlessInboundChainIds = append(m.PermissionlessInboundChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessInboundChainIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -1034,6 +1473,40 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPauses = append(m.OutboundPauses, OutboundPause{})
+			if err := m.OutboundPauses[len(m.OutboundPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -34,4 +34,14 @@ var (
 	ErrLastObserverCountNotFound       = errorsmod.Register(ModuleName, 1123, "last observer count not found")
 	ErrUpdateObserver                  = errorsmod.Register(ModuleName, 1124, "unable to update observer")
 	ErrNodeAccountNotFound             = errorsmod.Register(ModuleName, 1125, "node account not found")
+	ErrKeygenAttemptNotFound           = errorsmod.Register(ModuleName, 1126, "keygen attempt not found")
+	ErrKeygenRetryNotAllowed           = errorsmod.Register(ModuleName, 1127, "keygen attempt cannot be retried")
+	ErrInvalidLivenessParams           = errorsmod.Register(ModuleName, 1128, "invalid liveness params")
+	ErrObserverNotJailed               = errorsmod.Register(ModuleName, 1129, "observer not jailed")
+	ErrObserverJailed                  = errorsmod.Register(ModuleName, 1130, "observer still jailed")
+	ErrInvalidBlockHeaderDifficulty    = errorsmod.Register(ModuleName, 1131, "invalid block header difficulty")
+	ErrBlockHeaderNotConfirmed         = errorsmod.Register(ModuleName, 1132, "block header not confirmed on the best chain")
+	ErrInvalidLightClientUpdate        = errorsmod.Register(ModuleName, 1133, "invalid light client update")
+	ErrLightClientNotInitialized       = errorsmod.Register(ModuleName, 1134, "light client not initialized")
+	ErrLightClientAlreadyInitialized   = errorsmod.Register(ModuleName, 1135, "light client already initialized")
 )
@@ -55,6 +55,33 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated index in keygenAttempts
+	keygenAttemptIndexMap := make(map[uint64]bool)
+
+	for _, elem := range gs.KeygenAttempts {
+		if _, ok := keygenAttemptIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for keygenAttempt")
+		}
+		keygenAttemptIndexMap[elem.Index] = true
+	}
+
+	if gs.LivenessParams != nil {
+		if err := gs.LivenessParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in observerLiveness
+	observerLivenessIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserverLiveness {
+		index := GetObserverLivenessIndex(elem.ChainId, elem.ObserverAddress)
+		if _, ok := observerLivenessIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for observerLiveness")
+		}
+		observerLivenessIndexMap[index] = true
+	}
+
 	return VerifyObserverMapper(gs.Observers)
 }
 
@@ -40,6 +40,9 @@ type GenesisState struct {
 	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
 	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
 	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	KeygenAttempts    []KeygenAttempt       `protobuf:"bytes,16,rep,name=keygen_attempts,json=keygenAttempts,proto3" json:"keygen_attempts"`
+	LivenessParams    *LivenessParams       `protobuf:"bytes,17,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params,omitempty"`
+	ObserverLiveness  []ObserverLiveness    `protobuf:"bytes,18,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -180,6 +183,27 @@ func (m *GenesisState) GetNonceToCctx() []NonceToCctx {
 	return nil
 }
 
+func (m *GenesisState) GetKeygenAttempts() []KeygenAttempt {
+	if m != nil {
+		return m.KeygenAttempts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLivenessParams() *LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObserverLiveness() []ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -187,48 +211,52 @@ func init() {
 func init() { proto.RegisterFile("observer/genesis.proto", fileDescriptor_15ea8c9d44da7399) }
 
 var fileDescriptor_15ea8c9d44da7399 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x4e, 0x14, 0x31,
-	0x14, 0xc7, 0x77, 0x05, 0xc1, 0xed, 0xf2, 0x65, 0x45, 0x6d, 0x40, 0x07, 0xc4, 0x1b, 0xe2, 0xc7,
-	0x8c, 0xc1, 0x4b, 0xe3, 0x85, 0x6c, 0x02, 0x12, 0x01, 0x75, 0x20, 0x31, 0xd1, 0xc4, 0x49, 0xb7,
-	0x5b, 0x86, 0x89, 0xb3, 0xed, 0x64, 0xda, 0x35, 0xe0, 0x53, 0xf8, 0x18, 0x3e, 0x0a, 0x97, 0x5c,
-	0x7a, 0x65, 0x0c, 0xbc, 0x88, 0xe9, 0xd7, 0xcc, 0xce, 0x6e, 0x32, 0xee, 0x5d, 0xf3, 0x3f, 0xe7,
-	0xff, 0x3b, 0xed, 0x69, 0x4f, 0xc1, 0x3d, 0xde, 0x15, 0x34, 0xff, 0x4e, 0xf3, 0x20, 0xa6, 0x8c,
-	0x8a, 0x44, 0xf8, 0x59, 0xce, 0x25, 0x87, 0xab, 0x3f, 0xa8, 0xc4, 0xe4, 0x14, 0x27, 0xcc, 0xd7,
-	0x2b, 0x9e, 0x53, 0xdf, 0xa5, 0xae, 0x2c, 0xc7, 0x3c, 0xe6, 0x3a, 0x2f, 0x50, 0x2b, 0x63, 0x59,
-	0xb9, 0x5b, 0xa0, 0xba, 0x38, 0x4d, 0xb9, 0xb4, 0xf2, 0x72, 0x29, 0xa7, 0xb8, 0x4f, 0xad, 0xba,
-	0x5a, 0xa8, 0xba, 0x48, 0xc4, 0x38, 0x23, 0xd4, 0x16, 0x5f, 0x59, 0x2b, 0x83, 0x39, 0x17, 0xc2,
-	0x64, 0x9c, 0xa4, 0x38, 0x16, 0x63, 0xa5, 0xbe, 0xd1, 0xf3, 0x98, 0xb2, 0x31, 0x28, 0xe3, 0x3d,
-	0x1a, 0x61, 0x42, 0xf8, 0x80, 0xb9, 0x7d, 0x3c, 0x18, 0x0a, 0x32, 0x42, 0x23, 0xc9, 0x23, 0x42,
-	0xe4, 0x99, 0x8d, 0xde, 0x2f, 0xa2, 0x6e, 0x31, 0x56, 0x2a, 0xc3, 0x39, 0xee, 0xbb, 0x1d, 0x3c,
-	0x2c, 0x65, 0xca, 0x7a, 0x09, 0x8b, 0xab, 0x27, 0x80, 0x45, 0x58, 0x0a, 0xa7, 0x3d, 0x1a, 0xd6,
-	0xa2, 0x93, 0x01, 0xeb, 0x89, 0xa8, 0x9f, 0xc4, 0x39, 0x96, 0xdc, 0x16, 0xdb, 0xf8, 0xd5, 0x02,
-	0x73, 0xbb, 0xe6, 0x1e, 0x8e, 0x24, 0x96, 0x14, 0xbe, 0x06, 0xb3, 0xa6, 0x99, 0x02, 0x35, 0xd7,
-	0xa7, 0x36, 0xdb, 0x5b, 0x8f, 0xfd, 0x9a, 0x8b, 0xf1, 0xb7, 0x75, 0x6e, 0xe8, 0x3c, 0x70, 0x0f,
-	0xb4, 0x5c, 0x4c, 0xa0, 0x1b, 0x1a, 0xf0, 0xb4, 0x16, 0xf0, 0xde, 0x2e, 0x0e, 0x70, 0x96, 0xd1,
-	0x3c, 0x2c, 0xdd, 0x30, 0x04, 0x8b, 0xaa, 0xa9, 0x6f, 0x4c, 0x4f, 0xf7, 0x13, 0x21, 0xd1, 0x94,
-	0x06, 0x6e, 0xd6, 0x02, 0x0f, 0x4b, 0x4f, 0x38, 0x0a, 0x80, 0x9f, 0xc0, 0xd2, 0xe8, 0x05, 0xa3,
-	0xe9, 0xf5, 0xe6, 0x66, 0x7b, 0xeb, 0x59, 0x2d, 0xb4, 0x53, 0x98, 0x76, 0x94, 0x27, 0x5c, 0x24,
-	0x55, 0x01, 0xbe, 0x02, 0x33, 0xe6, 0xb6, 0xd0, 0x4d, 0x8d, 0xab, 0xef, 0xda, 0x07, 0x9d, 0x1a,
-	0x5a, 0x8b, 0x32, 0x9b, 0x57, 0x85, 0x66, 0x26, 0x30, 0xbf, 0xd3, 0xa9, 0xa1, 0xb5, 0xc0, 0xaf,
-	0xe0, 0x4e, 0x8a, 0x85, 0x8c, 0x5c, 0x3c, 0xd2, 0xa7, 0x45, 0xb3, 0x9a, 0xe4, 0xd7, 0x92, 0xf6,
-	0xb1, 0x90, 0xae, 0xff, 0x1d, 0xdd, 0xb0, 0xdb, 0xe9, 0xa8, 0x04, 0xbf, 0x80, 0x25, 0xe5, 0x8a,
-	0xcc, 0x5e, 0xa3, 0x54, 0xdd, 0xc3, 0x2d, 0x0d, 0xaf, 0xbf, 0xd8, 0x0e, 0xcf, 0xa9, 0x39, 0xa7,
-	0xea, 0xfc, 0xf6, 0xf4, 0xc5, 0x9f, 0xb5, 0x46, 0xb8, 0x40, 0x2a, 0x2a, 0xdc, 0x02, 0x53, 0x52,
-	0x08, 0xd4, 0xd2, 0xbc, 0xf5, 0x5a, 0xde, 0xf1, 0xd1, 0x51, 0xa8, 0x92, 0xe1, 0x2e, 0x68, 0xab,
-	0xe7, 0x7c, 0x9a, 0x08, 0xc9, 0xf3, 0x73, 0x04, 0xf4, 0x9b, 0xf8, 0xaf, 0xd7, 0x6e, 0x00, 0x48,
-	0x21, 0xde, 0x1a, 0x27, 0xec, 0x01, 0xe8, 0xe6, 0xa2, 0x18, 0x0b, 0x81, 0xda, 0x9a, 0xf7, 0xa2,
-	0x9e, 0x27, 0xc4, 0xce, 0x80, 0xf5, 0x0e, 0xac, 0x69, 0x8f, 0x9d, 0x70, 0xcb, 0x5f, 0x92, 0xd5,
-	0x90, 0xda, 0x2e, 0xd0, 0xdf, 0x90, 0xe9, 0xdc, 0x9c, 0xa6, 0x6f, 0xd4, 0xcf, 0x94, 0x4a, 0xb7,
-	0xbc, 0x96, 0xf6, 0xda, 0xb7, 0xbb, 0x50, 0x9d, 0x7c, 0x34, 0xaf, 0x61, 0x4f, 0xea, 0x9f, 0x9a,
-	0xb1, 0x1c, 0x6a, 0x87, 0x85, 0xce, 0x67, 0xc3, 0x22, 0xfc, 0x08, 0xe6, 0x86, 0xbf, 0x44, 0xb4,
-	0x30, 0xc1, 0x94, 0x75, 0x94, 0x5e, 0x81, 0xb6, 0x49, 0x29, 0xc1, 0x10, 0xcc, 0x57, 0xfe, 0x3c,
-	0xb4, 0x38, 0xd1, 0xe4, 0x32, 0x42, 0x8f, 0x79, 0x87, 0xc8, 0x33, 0xc7, 0x64, 0x43, 0xd2, 0xde,
-	0xc5, 0x95, 0xd7, 0xbc, 0xbc, 0xf2, 0x9a, 0x7f, 0xaf, 0xbc, 0xe6, 0xcf, 0x6b, 0xaf, 0x71, 0x79,
-	0xed, 0x35, 0x7e, 0x5f, 0x7b, 0x8d, 0xcf, 0x41, 0x9c, 0xc8, 0xd3, 0x41, 0xd7, 0x27, 0xbc, 0x1f,
-	0x28, 0xec, 0x73, 0x5d, 0x21, 0x70, 0x15, 0x82, 0xb3, 0xa0, 0xfc, 0x08, 0xcf, 0x33, 0x2a, 0xba,
-	0x33, 0xfa, 0xf3, 0x7b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xee, 0x86, 0x37, 0x94, 0x8c, 0x06,
-	0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0x6e, 0x2d, 0x82, 0x4c, 0xa1, 0x2d, 0x23, 0xea, 0x04, 0xb4, 0x54, 0xbc, 0x21, 0x2a, 0xad,
+	0xc1, 0x4b, 0xe3, 0x05, 0x34, 0x01, 0x89, 0x80, 0xba, 0x90, 0x18, 0x35, 0x71, 0x9d, 0x6e, 0x87,
+	0x65, 0xc3, 0x76, 0x66, 0xb3, 0x33, 0x25, 0xe0, 0x53, 0xf8, 0x3e, 0xbe, 0x00, 0x97, 0x5c, 0x7a,
+	0x65, 0x0c, 0xbc, 0x88, 0x99, 0xbf, 0xdd, 0x6e, 0x9b, 0x2c, 0x7b, 0x37, 0xfb, 0x9d, 0xf3, 0x7d,
+	0xe7, 0xcc, 0xf9, 0x99, 0x05, 0x0f, 0x59, 0x8f, 0x93, 0xf8, 0x8c, 0xc4, 0x1d, 0x9f, 0x50, 0xc2,
+	0x03, 0xde, 0x8e, 0x62, 0x26, 0x18, 0x5c, 0xfe, 0x49, 0x04, 0xf6, 0x4e, 0x70, 0x40, 0xdb, 0xea,
+	0xc4, 0x62, 0xd2, 0xb6, 0xae, 0x4b, 0x8b, 0x3e, 0xf3, 0x99, 0xf2, 0xeb, 0xc8, 0x93, 0xa6, 0x2c,
+	0x3d, 0x48, 0xa4, 0x7a, 0x38, 0x0c, 0x99, 0x30, 0xf0, 0x62, 0x0a, 0x87, 0x78, 0x40, 0x0c, 0xba,
+	0x9c, 0xa0, 0x2a, 0x88, 0x4b, 0x19, 0xf5, 0x88, 0x09, 0xbe, 0xb4, 0x92, 0x1a, 0x63, 0xc6, 0xb9,
+	0xf6, 0x38, 0x0e, 0xb1, 0xcf, 0x27, 0x42, 0x9d, 0x92, 0x0b, 0x9f, 0x50, 0x03, 0x3f, 0x4a, 0xe0,
+	0x30, 0x38, 0x93, 0xd7, 0xe1, 0x13, 0xd1, 0x28, 0xeb, 0x13, 0x17, 0x7b, 0x1e, 0x1b, 0x52, 0x9b,
+	0xe0, 0xe3, 0x11, 0x23, 0xf5, 0x88, 0x2b, 0x98, 0xeb, 0x79, 0xe2, 0x7c, 0x42, 0xd3, 0x1e, 0x26,
+	0x72, 0x88, 0x70, 0x8c, 0x07, 0x36, 0xd4, 0x93, 0x14, 0x26, 0xb4, 0x1f, 0x50, 0x3f, 0x7b, 0x35,
+	0x98, 0x98, 0x45, 0x92, 0xdd, 0xd3, 0x51, 0xcc, 0x3d, 0x1e, 0xd2, 0x3e, 0x77, 0x07, 0x81, 0x1f,
+	0x63, 0xc1, 0x4c, 0xb0, 0xd5, 0xdf, 0x55, 0x30, 0xb7, 0xa3, 0x1b, 0x74, 0x28, 0xb0, 0x20, 0xf0,
+	0x2d, 0x98, 0xd1, 0x55, 0xe6, 0xa8, 0xdc, 0xaa, 0xac, 0x55, 0x37, 0x9e, 0xb5, 0x73, 0x3a, 0xd6,
+	0xde, 0x52, 0xbe, 0x8e, 0xe5, 0xc0, 0x5d, 0x30, 0x6b, 0x6d, 0x1c, 0xdd, 0x51, 0x02, 0x2f, 0x72,
+	0x05, 0x3e, 0x98, 0xc3, 0x3e, 0x8e, 0x22, 0x12, 0x3b, 0x29, 0x1b, 0x3a, 0xa0, 0x2e, 0x8b, 0xba,
+	0xa9, 0x6b, 0xba, 0x17, 0x70, 0x81, 0x2a, 0x4a, 0x70, 0x2d, 0x57, 0xf0, 0x20, 0xe5, 0x38, 0xe3,
+	0x02, 0xf0, 0x33, 0x68, 0x8c, 0x77, 0x1e, 0x4d, 0xb5, 0xca, 0x6b, 0xd5, 0x8d, 0x97, 0xb9, 0xa2,
+	0xdd, 0x84, 0xb4, 0x2d, 0x39, 0x4e, 0xdd, 0xcb, 0x02, 0xf0, 0x0d, 0x98, 0xd6, 0xdd, 0x42, 0x77,
+	0x5b, 0xe5, 0x5b, 0xab, 0xf6, 0x51, 0xb9, 0x3a, 0x86, 0x22, 0xc9, 0x7a, 0xdc, 0xd0, 0x74, 0x01,
+	0xf2, 0x7b, 0xe5, 0xea, 0x18, 0x0a, 0xfc, 0x0e, 0xee, 0x87, 0x98, 0x0b, 0xd7, 0xda, 0x5d, 0x75,
+	0x5b, 0x34, 0xa3, 0x94, 0xda, 0xb9, 0x4a, 0x7b, 0x98, 0x0b, 0x5b, 0xff, 0xae, 0x2a, 0xd8, 0x42,
+	0x38, 0x0e, 0xc1, 0x6f, 0xa0, 0x21, 0x59, 0xae, 0xce, 0xd5, 0x0d, 0x65, 0x1f, 0xee, 0xb5, 0xca,
+	0xb7, 0x36, 0xb6, 0xcb, 0x62, 0xa2, 0xef, 0x29, 0x2b, 0xbf, 0x35, 0x75, 0xf9, 0x77, 0xa5, 0xe4,
+	0xd4, 0xbc, 0x0c, 0x0a, 0x37, 0x40, 0x45, 0x70, 0x8e, 0x66, 0x95, 0x5e, 0x2b, 0x57, 0xef, 0xe8,
+	0xf0, 0xd0, 0x91, 0xce, 0x70, 0x07, 0x54, 0xe5, 0x38, 0x9f, 0x04, 0x5c, 0xb0, 0xf8, 0x02, 0x81,
+	0x56, 0xa5, 0x08, 0xd7, 0x24, 0x00, 0x04, 0xe7, 0xef, 0x34, 0x13, 0xf6, 0x01, 0xb4, 0x7b, 0x91,
+	0xac, 0x05, 0x47, 0x55, 0xa5, 0xf7, 0x2a, 0x5f, 0x8f, 0xf3, 0xed, 0x21, 0xed, 0xef, 0x1b, 0xd2,
+	0x2e, 0x3d, 0x66, 0x46, 0xbf, 0x21, 0xb2, 0x26, 0x99, 0x2e, 0x50, 0xef, 0x93, 0xae, 0xdc, 0x9c,
+	0x52, 0x5f, 0xcd, 0xdf, 0x29, 0xe9, 0x6e, 0xf4, 0x66, 0x15, 0xd7, 0xcc, 0x6e, 0x2d, 0xbb, 0xf9,
+	0x68, 0x5e, 0x89, 0x3d, 0xcf, 0x1f, 0x35, 0x4d, 0x39, 0x50, 0x0c, 0x23, 0x3a, 0x1f, 0x8d, 0x82,
+	0xf0, 0x13, 0x98, 0x1b, 0x7d, 0x2b, 0x51, 0xad, 0xc0, 0x96, 0x75, 0x25, 0x9e, 0x11, 0xad, 0x7a,
+	0x29, 0x04, 0x1d, 0x30, 0x9f, 0x79, 0xf3, 0x50, 0xbd, 0xd0, 0xe6, 0x52, 0x8f, 0x1c, 0xb1, 0xae,
+	0x27, 0xce, 0xad, 0x26, 0x4d, 0x21, 0xf8, 0x05, 0xd4, 0xf5, 0xc8, 0xbb, 0x58, 0x08, 0x32, 0x88,
+	0x04, 0x47, 0x8d, 0x02, 0x05, 0xd0, 0xeb, 0xb2, 0xa9, 0x29, 0x76, 0x0c, 0x4f, 0x47, 0x41, 0x0e,
+	0x8f, 0x40, 0xdd, 0x3e, 0xec, 0x66, 0xce, 0xd1, 0x42, 0x81, 0x11, 0xdf, 0x33, 0x1c, 0xb3, 0xce,
+	0xb5, 0x30, 0xf3, 0x0d, 0x7f, 0x80, 0x85, 0x64, 0x29, 0xad, 0x09, 0x41, 0x95, 0xf2, 0x7a, 0xa1,
+	0x37, 0xd1, 0xea, 0xdb, 0xd9, 0x62, 0xe3, 0xf8, 0xee, 0xe5, 0x75, 0xb3, 0x7c, 0x75, 0xdd, 0x2c,
+	0xff, 0xbb, 0x6e, 0x96, 0x7f, 0xdd, 0x34, 0x4b, 0x57, 0x37, 0xcd, 0xd2, 0x9f, 0x9b, 0x66, 0xe9,
+	0x6b, 0xc7, 0x0f, 0xc4, 0xc9, 0xb0, 0xd7, 0xf6, 0xd8, 0xa0, 0x23, 0x03, 0xac, 0xab, 0x58, 0x1d,
+	0x1b, 0xab, 0x73, 0xde, 0x49, 0xff, 0x0d, 0x17, 0x11, 0xe1, 0xbd, 0x69, 0xf5, 0x3f, 0x78, 0xfd,
+	0x7f, 0x00, 0x23, 0xde, 0x44, 0x88, 0xb8, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -251,6 +279,52 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.LivenessParams != nil {
+		{
+			size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.KeygenAttempts) > 0 {
+		for iNdEx := len(m.KeygenAttempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeygenAttempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.NonceToCctx) > 0 {
 		for iNdEx := len(m.NonceToCctx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +617,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.KeygenAttempts) > 0 {
+		for _, e := range m.KeygenAttempts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LivenessParams != nil {
+		l = m.LivenessParams.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1100,6 +1190,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenAttempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenAttempts = append(m.KeygenAttempts, KeygenAttempt{})
+			if err := m.KeygenAttempts[len(m.KeygenAttempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessParams == nil {
+				m.LivenessParams = &LivenessParams{}
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
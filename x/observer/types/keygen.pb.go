@@ -52,6 +52,65 @@ func (KeygenStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4efb2de738775c96, []int{0}
 }
 
+type KeygenAttemptStatus int32
+
+const (
+	KeygenAttemptStatus_AttemptPending   KeygenAttemptStatus = 0
+	KeygenAttemptStatus_AttemptSucceeded KeygenAttemptStatus = 1
+	KeygenAttemptStatus_AttemptFailed    KeygenAttemptStatus = 2
+	KeygenAttemptStatus_AttemptAborted   KeygenAttemptStatus = 3
+)
+
+var KeygenAttemptStatus_name = map[int32]string{
+	0: "AttemptPending",
+	1: "AttemptSucceeded",
+	2: "AttemptFailed",
+	3: "AttemptAborted",
+}
+
+var KeygenAttemptStatus_value = map[string]int32{
+	"AttemptPending":   0,
+	"AttemptSucceeded": 1,
+	"AttemptFailed":    2,
+	"AttemptAborted":   3,
+}
+
+func (x KeygenAttemptStatus) String() string {
+	return proto.EnumName(KeygenAttemptStatus_name, int32(x))
+}
+
+func (KeygenAttemptStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4efb2de738775c96, []int{1}
+}
+
+type KeygenOutcome int32
+
+const (
+	KeygenOutcome_NotReported     KeygenOutcome = 0
+	KeygenOutcome_ReportedSuccess KeygenOutcome = 1
+	KeygenOutcome_ReportedFailure KeygenOutcome = 2
+)
+
+var KeygenOutcome_name = map[int32]string{
+	0: "NotReported",
+	1: "ReportedSuccess",
+	2: "ReportedFailure",
+}
+
+var KeygenOutcome_value = map[string]int32{
+	"NotReported":     0,
+	"ReportedSuccess": 1,
+	"ReportedFailure": 2,
+}
+
+func (x KeygenOutcome) String() string {
+	return proto.EnumName(KeygenOutcome_name, int32(x))
+}
+
+func (KeygenOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4efb2de738775c96, []int{2}
+}
+
 type Keygen struct {
 	Status         KeygenStatus `protobuf:"varint,2,opt,name=status,proto3,enum=zetachain.zetacore.observer.KeygenStatus" json:"status,omitempty"`
 	GranteePubkeys []string     `protobuf:"bytes,3,rep,name=granteePubkeys,proto3" json:"granteePubkeys,omitempty"`
@@ -112,34 +171,263 @@ func (m *Keygen) GetBlockNumber() int64 {
 	return 0
 }
 
+// KeygenGranteeReport is the outcome of a keygen attempt reported by a grantee
+type KeygenGranteeReport struct {
+	GranteePubkey  string        `protobuf:"bytes,1,opt,name=grantee_pubkey,json=granteePubkey,proto3" json:"grantee_pubkey,omitempty"`
+	Operator       string        `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Outcome        KeygenOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=zetachain.zetacore.observer.KeygenOutcome" json:"outcome,omitempty"`
+	TssPubkey      string        `protobuf:"bytes,4,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+	ReportedHeight int64         `protobuf:"varint,5,opt,name=reported_height,json=reportedHeight,proto3" json:"reported_height,omitempty"`
+}
+
+func (m *KeygenGranteeReport) Reset()         { *m = KeygenGranteeReport{} }
+func (m *KeygenGranteeReport) String() string { return proto.CompactTextString(m) }
+func (*KeygenGranteeReport) ProtoMessage()    {}
+func (*KeygenGranteeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4efb2de738775c96, []int{1}
+}
+func (m *KeygenGranteeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeygenGranteeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeygenGranteeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeygenGranteeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeygenGranteeReport.Merge(m, src)
+}
+func (m *KeygenGranteeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeygenGranteeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeygenGranteeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeygenGranteeReport proto.InternalMessageInfo
+
+func (m *KeygenGranteeReport) GetGranteePubkey() string {
+	if m != nil {
+		return m.GranteePubkey
+	}
+	return ""
+}
+
+func (m *KeygenGranteeReport) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *KeygenGranteeReport) GetOutcome() KeygenOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return KeygenOutcome_NotReported
+}
+
+func (m *KeygenGranteeReport) GetTssPubkey() string {
+	if m != nil {
+		return m.TssPubkey
+	}
+	return ""
+}
+
+func (m *KeygenGranteeReport) GetReportedHeight() int64 {
+	if m != nil {
+		return m.ReportedHeight
+	}
+	return 0
+}
+
+// KeygenAttempt is the record of a keygen ceremony scheduled at a given block
+type KeygenAttempt struct {
+	Index              uint64                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	BlockNumber        int64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	GranteePubkeys     []string              `protobuf:"bytes,3,rep,name=grantee_pubkeys,json=granteePubkeys,proto3" json:"grantee_pubkeys,omitempty"`
+	Status             KeygenAttemptStatus   `protobuf:"varint,4,opt,name=status,proto3,enum=zetachain.zetacore.observer.KeygenAttemptStatus" json:"status,omitempty"`
+	Reports            []KeygenGranteeReport `protobuf:"bytes,5,rep,name=reports,proto3" json:"reports"`
+	BlamedPubkeys      []string              `protobuf:"bytes,6,rep,name=blamed_pubkeys,json=blamedPubkeys,proto3" json:"blamed_pubkeys,omitempty"`
+	CreatedHeight      int64                 `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	FinalizedHeight    int64                 `protobuf:"varint,8,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	RetryOf            uint64                `protobuf:"varint,9,opt,name=retry_of,json=retryOf,proto3" json:"retry_of,omitempty"`
+	ProposedRetryBlock int64                 `protobuf:"varint,10,opt,name=proposed_retry_block,json=proposedRetryBlock,proto3" json:"proposed_retry_block,omitempty"`
+	RetryApproved      bool                  `protobuf:"varint,11,opt,name=retry_approved,json=retryApproved,proto3" json:"retry_approved,omitempty"`
+}
+
+func (m *KeygenAttempt) Reset()         { *m = KeygenAttempt{} }
+func (m *KeygenAttempt) String() string { return proto.CompactTextString(m) }
+func (*KeygenAttempt) ProtoMessage()    {}
+func (*KeygenAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4efb2de738775c96, []int{2}
+}
+func (m *KeygenAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeygenAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeygenAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeygenAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeygenAttempt.Merge(m, src)
+}
+func (m *KeygenAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeygenAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeygenAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeygenAttempt proto.InternalMessageInfo
+
+func (m *KeygenAttempt) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetGranteePubkeys() []string {
+	if m != nil {
+		return m.GranteePubkeys
+	}
+	return nil
+}
+
+func (m *KeygenAttempt) GetStatus() KeygenAttemptStatus {
+	if m != nil {
+		return m.Status
+	}
+	return KeygenAttemptStatus_AttemptPending
+}
+
+func (m *KeygenAttempt) GetReports() []KeygenGranteeReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *KeygenAttempt) GetBlamedPubkeys() []string {
+	if m != nil {
+		return m.BlamedPubkeys
+	}
+	return nil
+}
+
+func (m *KeygenAttempt) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetFinalizedHeight() int64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetRetryOf() uint64 {
+	if m != nil {
+		return m.RetryOf
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetProposedRetryBlock() int64 {
+	if m != nil {
+		return m.ProposedRetryBlock
+	}
+	return 0
+}
+
+func (m *KeygenAttempt) GetRetryApproved() bool {
+	if m != nil {
+		return m.RetryApproved
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.KeygenStatus", KeygenStatus_name, KeygenStatus_value)
+	proto.RegisterEnum("zetachain.zetacore.observer.KeygenAttemptStatus", KeygenAttemptStatus_name, KeygenAttemptStatus_value)
+	proto.RegisterEnum("zetachain.zetacore.observer.KeygenOutcome", KeygenOutcome_name, KeygenOutcome_value)
 	proto.RegisterType((*Keygen)(nil), "zetachain.zetacore.observer.Keygen")
+	proto.RegisterType((*KeygenGranteeReport)(nil), "zetachain.zetacore.observer.KeygenGranteeReport")
+	proto.RegisterType((*KeygenAttempt)(nil), "zetachain.zetacore.observer.KeygenAttempt")
 }
 
 func init() { proto.RegisterFile("observer/keygen.proto", fileDescriptor_4efb2de738775c96) }
 
 var fileDescriptor_4efb2de738775c96 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcd, 0x4f, 0x2a, 0x4e,
-	0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0xcf, 0x4e, 0xad, 0x4c, 0x4f, 0xcd, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0xae, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2,
-	0x8b, 0x52, 0xf5, 0x60, 0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c,
-	0x88, 0x16, 0xa5, 0xa9, 0x8c, 0x5c, 0x6c, 0xde, 0x60, 0x33, 0x84, 0x1c, 0xb9, 0xd8, 0x8a, 0x4b,
-	0x12, 0x4b, 0x4a, 0x8b, 0x25, 0x98, 0x14, 0x18, 0x35, 0xf8, 0x8c, 0x34, 0xf5, 0xf0, 0x18, 0xa7,
-	0x07, 0xd1, 0x14, 0x0c, 0xd6, 0x10, 0x04, 0xd5, 0x28, 0xa4, 0xc6, 0xc5, 0x97, 0x5e, 0x94, 0x98,
-	0x57, 0x92, 0x9a, 0x1a, 0x50, 0x9a, 0x94, 0x9d, 0x5a, 0x59, 0x2c, 0xc1, 0xac, 0xc0, 0xac, 0xc1,
-	0x19, 0x84, 0x26, 0x2a, 0xa4, 0xc0, 0xc5, 0x9d, 0x94, 0x93, 0x9f, 0x9c, 0xed, 0x57, 0x9a, 0x9b,
-	0x94, 0x5a, 0x24, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x1c, 0x84, 0x2c, 0xa4, 0xe5, 0xc3, 0xc5, 0x83,
-	0x6c, 0x83, 0x90, 0x20, 0x17, 0x6f, 0x40, 0x6a, 0x5e, 0x4a, 0x66, 0x5e, 0x3a, 0x44, 0x58, 0x80,
-	0x01, 0x24, 0xe4, 0x9d, 0x5a, 0xe9, 0x9e, 0x9a, 0x17, 0x5c, 0x9a, 0x9c, 0x9c, 0x5a, 0x5c, 0x2c,
-	0xc0, 0x28, 0x24, 0x00, 0xd6, 0xe5, 0x9e, 0x9a, 0xe7, 0x96, 0x98, 0x99, 0x93, 0x9a, 0x22, 0xc0,
-	0x2c, 0xc5, 0xb2, 0x62, 0x89, 0x1c, 0xa3, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83,
-	0x3c, 0xa9, 0x0b, 0xf6, 0xaf, 0x3e, 0xcc, 0xbf, 0xfa, 0x15, 0xfa, 0xf0, 0xa0, 0x2e, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x9b, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xf7, 0xb2,
-	0x79, 0x83, 0x01, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x93, 0x90, 0x9f, 0x13, 0x9c, 0xf8, 0x0e, 0xb9, 0x92, 0x2f, 0x57, 0xd7, 0xd7, 0x45,
+	0xa2, 0x0d, 0x48, 0x8d, 0x51, 0xfb, 0x04, 0x41, 0x55, 0xa1, 0xa2, 0x82, 0xc8, 0xec, 0xba, 0x89,
+	0xfc, 0x73, 0x70, 0x2c, 0x12, 0x8f, 0x35, 0x1e, 0x23, 0xc2, 0x53, 0x74, 0xd3, 0x37, 0xe8, 0xa2,
+	0x8f, 0xc2, 0x92, 0x65, 0x57, 0x55, 0x05, 0x52, 0x1f, 0xa2, 0xab, 0xca, 0x33, 0xb6, 0x49, 0xe8,
+	0x8f, 0xd8, 0x79, 0xbe, 0x39, 0xe7, 0x3b, 0xe7, 0xfb, 0xce, 0xf1, 0xc0, 0xdf, 0xd4, 0x4d, 0x90,
+	0x5d, 0x20, 0xb3, 0xce, 0x71, 0x11, 0x60, 0x34, 0x8c, 0x19, 0xe5, 0x94, 0xfc, 0x7b, 0x85, 0xdc,
+	0xf1, 0xa6, 0x4e, 0x18, 0x0d, 0xc5, 0x17, 0x65, 0x38, 0x2c, 0x22, 0x37, 0xfb, 0x01, 0x0d, 0xa8,
+	0x88, 0xb3, 0xb2, 0x2f, 0x99, 0xb2, 0xf5, 0x41, 0x81, 0xc6, 0x91, 0xe0, 0x20, 0x23, 0x68, 0x24,
+	0xdc, 0xe1, 0x69, 0xa2, 0x57, 0x4d, 0x65, 0xd0, 0x7d, 0xb1, 0x33, 0xfc, 0x03, 0xdd, 0x50, 0x26,
+	0x9d, 0x8a, 0x04, 0x3b, 0x4f, 0x24, 0x4f, 0xa1, 0x1b, 0x30, 0x27, 0xe2, 0x88, 0xe3, 0xd4, 0x3d,
+	0xc7, 0x45, 0xa2, 0xd7, 0xcc, 0xda, 0xa0, 0x6d, 0x3f, 0x40, 0x89, 0x09, 0x1d, 0x77, 0x46, 0xbd,
+	0xf3, 0xe3, 0x74, 0xee, 0x22, 0xd3, 0xeb, 0xa6, 0x32, 0xa8, 0xd9, 0xcb, 0xd0, 0xd6, 0x37, 0x05,
+	0x36, 0x64, 0x89, 0x03, 0x99, 0x6a, 0x63, 0x4c, 0x19, 0x27, 0xdb, 0x65, 0x85, 0x49, 0x2c, 0xc8,
+	0x74, 0xc5, 0x54, 0x06, 0x6d, 0x5b, 0x5d, 0xa9, 0x40, 0x36, 0xa1, 0x45, 0x63, 0x64, 0x0e, 0xa7,
+	0x4c, 0xa8, 0x69, 0xdb, 0xe5, 0x99, 0xbc, 0x82, 0x26, 0x4d, 0xb9, 0x47, 0xe7, 0xa8, 0xd7, 0x84,
+	0xd0, 0xdd, 0x47, 0x08, 0x3d, 0x91, 0x19, 0x76, 0x91, 0x4a, 0xfe, 0x03, 0xe0, 0x49, 0x52, 0x34,
+	0x51, 0x17, 0x35, 0xda, 0x3c, 0x49, 0xf2, 0x06, 0x9e, 0x41, 0x8f, 0x89, 0x8e, 0xd1, 0x9f, 0x4c,
+	0x31, 0x0c, 0xa6, 0x5c, 0x5f, 0x13, 0x2a, 0xbb, 0x05, 0x7c, 0x28, 0xd0, 0xad, 0xef, 0x35, 0x50,
+	0x65, 0x89, 0x11, 0xe7, 0x38, 0x8f, 0x39, 0xe9, 0xc3, 0x5a, 0x18, 0xf9, 0x78, 0x29, 0x94, 0xd5,
+	0x6d, 0x79, 0x20, 0x4f, 0x60, 0x5d, 0xf8, 0x33, 0x89, 0xa4, 0x67, 0xd5, 0x9f, 0x3c, 0xcb, 0x6a,
+	0xae, 0x7a, 0xf3, 0x3b, 0xfb, 0x0f, 0xcb, 0x49, 0xd7, 0x85, 0x01, 0x7b, 0x8f, 0x30, 0x20, 0xef,
+	0xee, 0xc1, 0xc0, 0xc7, 0xd0, 0x94, 0x7a, 0x12, 0x7d, 0xcd, 0xac, 0x0d, 0x3a, 0x8f, 0xa2, 0x5a,
+	0x99, 0xe8, 0x7e, 0xfd, 0xfa, 0xcb, 0xff, 0x15, 0xbb, 0xa0, 0xc9, 0x06, 0xec, 0xce, 0x9c, 0x39,
+	0xfa, 0xa5, 0x86, 0x86, 0xd0, 0xa0, 0x4a, 0xb4, 0x90, 0xb0, 0x0d, 0x5d, 0x8f, 0xa1, 0xb3, 0x64,
+	0x6f, 0x53, 0x18, 0xa2, 0xe6, 0xa8, 0x74, 0x97, 0xec, 0x80, 0x76, 0x16, 0x46, 0xce, 0x2c, 0xbc,
+	0xba, 0x0f, 0x6c, 0x89, 0xc0, 0x5e, 0x89, 0xe7, 0xa1, 0xff, 0x40, 0x8b, 0x21, 0x67, 0x8b, 0x09,
+	0x3d, 0xd3, 0xdb, 0xc2, 0xf9, 0xa6, 0x38, 0x9f, 0x9c, 0x91, 0x3d, 0xe8, 0xc7, 0x8c, 0xc6, 0x34,
+	0x41, 0x7f, 0x22, 0x63, 0x84, 0xed, 0x3a, 0x08, 0x26, 0x52, 0xdc, 0xd9, 0xd9, 0xd5, 0x7e, 0x76,
+	0x93, 0xb5, 0x27, 0x03, 0x9d, 0x38, 0x66, 0xf4, 0x02, 0x7d, 0xbd, 0x63, 0x2a, 0x83, 0x96, 0xad,
+	0x0a, 0x74, 0x94, 0x83, 0xbb, 0x6f, 0x61, 0x7d, 0xf9, 0x3f, 0x22, 0x7f, 0x81, 0x3a, 0xc6, 0xc8,
+	0x0f, 0xa3, 0x40, 0xc2, 0x5a, 0x25, 0x83, 0x8e, 0x70, 0x71, 0x80, 0xd1, 0x69, 0xea, 0x79, 0x98,
+	0x24, 0x9a, 0x42, 0x34, 0x91, 0x75, 0x80, 0xd1, 0x6b, 0x27, 0x9c, 0xa1, 0xaf, 0xd5, 0x36, 0xeb,
+	0x9f, 0x3e, 0x1a, 0xca, 0xee, 0x0c, 0x36, 0x7e, 0x31, 0x2b, 0x42, 0xa0, 0x9b, 0x03, 0x39, 0xb7,
+	0x56, 0x21, 0x7d, 0xd0, 0x8a, 0xa0, 0x8c, 0x16, 0x7d, 0xf4, 0x35, 0x25, 0xab, 0x95, 0xa3, 0x39,
+	0x73, 0x75, 0x29, 0x79, 0xe4, 0x8a, 0xb5, 0x2d, 0xab, 0x8d, 0x41, 0x5d, 0xf9, 0x35, 0x48, 0x0f,
+	0x3a, 0xc7, 0x94, 0xdb, 0xf9, 0x7a, 0x6b, 0x15, 0xb2, 0x01, 0xbd, 0xe2, 0x74, 0xdf, 0xfc, 0x12,
+	0x98, 0x15, 0x49, 0x19, 0x6a, 0x55, 0xc9, 0xb8, 0xff, 0xe6, 0xfa, 0xd6, 0x50, 0x6e, 0x6e, 0x0d,
+	0xe5, 0xeb, 0xad, 0xa1, 0xbc, 0xbf, 0x33, 0x2a, 0x37, 0x77, 0x46, 0xe5, 0xf3, 0x9d, 0x51, 0x79,
+	0x67, 0x05, 0x21, 0x9f, 0xa6, 0xee, 0xd0, 0xa3, 0x73, 0x2b, 0xdb, 0xaa, 0xe7, 0x62, 0xc1, 0xac,
+	0x62, 0xc1, 0xac, 0x4b, 0xab, 0x7c, 0x10, 0xf9, 0x22, 0xc6, 0xc4, 0x6d, 0x88, 0xd7, 0xed, 0xe5,
+	0x8f, 0x01, 0x00, 0x02, 0x4e, 0x10, 0x81, 0x29, 0x05, 0x00, 0x00,
 }
 
 func (m *Keygen) Marshal() (dAtA []byte, err error) {
@@ -184,6 +472,160 @@ func (m *Keygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeygenGranteeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeygenGranteeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeygenGranteeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReportedHeight != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.ReportedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintKeygen(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintKeygen(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GranteePubkey) > 0 {
+		i -= len(m.GranteePubkey)
+		copy(dAtA[i:], m.GranteePubkey)
+		i = encodeVarintKeygen(dAtA, i, uint64(len(m.GranteePubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeygenAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeygenAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeygenAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryApproved {
+		i--
+		if m.RetryApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProposedRetryBlock != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.ProposedRetryBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RetryOf != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.RetryOf))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BlamedPubkeys) > 0 {
+		for iNdEx := len(m.BlamedPubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlamedPubkeys[iNdEx])
+			copy(dAtA[i:], m.BlamedPubkeys[iNdEx])
+			i = encodeVarintKeygen(dAtA, i, uint64(len(m.BlamedPubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeygen(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.GranteePubkeys) > 0 {
+		for iNdEx := len(m.GranteePubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GranteePubkeys[iNdEx])
+			copy(dAtA[i:], m.GranteePubkeys[iNdEx])
+			i = encodeVarintKeygen(dAtA, i, uint64(len(m.GranteePubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintKeygen(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeygen(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeygen(v)
 	base := offset
@@ -216,18 +658,96 @@ func (m *Keygen) Size() (n int) {
 	return n
 }
 
-func sovKeygen(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozKeygen(x uint64) (n int) {
-	return sovKeygen(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *KeygenGranteeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovKeygen(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovKeygen(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovKeygen(uint64(m.Outcome))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovKeygen(uint64(l))
+	}
+	if m.ReportedHeight != 0 {
+		n += 1 + sovKeygen(uint64(m.ReportedHeight))
+	}
+	return n
 }
-func (m *Keygen) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *KeygenAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovKeygen(uint64(m.Index))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovKeygen(uint64(m.BlockNumber))
+	}
+	if len(m.GranteePubkeys) > 0 {
+		for _, s := range m.GranteePubkeys {
+			l = len(s)
+			n += 1 + l + sovKeygen(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeygen(uint64(m.Status))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovKeygen(uint64(l))
+		}
+	}
+	if len(m.BlamedPubkeys) > 0 {
+		for _, s := range m.BlamedPubkeys {
+			l = len(s)
+			n += 1 + l + sovKeygen(uint64(l))
+		}
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovKeygen(uint64(m.CreatedHeight))
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovKeygen(uint64(m.FinalizedHeight))
+	}
+	if m.RetryOf != 0 {
+		n += 1 + sovKeygen(uint64(m.RetryOf))
+	}
+	if m.ProposedRetryBlock != 0 {
+		n += 1 + sovKeygen(uint64(m.ProposedRetryBlock))
+	}
+	if m.RetryApproved {
+		n += 2
+	}
+	return n
+}
+
+func sovKeygen(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeygen(x uint64) (n int) {
+	return sovKeygen(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Keygen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowKeygen
@@ -342,6 +862,491 @@ func (m *Keygen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *KeygenGranteeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeygen
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenGranteeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenGranteeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= KeygenOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedHeight", wireType)
+			}
+			m.ReportedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeygen(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeygenAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeygen
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranteePubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GranteePubkeys = append(m.GranteePubkeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= KeygenAttemptStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, KeygenGranteeReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlamedPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlamedPubkeys = append(m.BlamedPubkeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOf", wireType)
+			}
+			m.RetryOf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryOf |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedRetryBlock", wireType)
+			}
+			m.ProposedRetryBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedRetryBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeygen(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeygen
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeygen(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
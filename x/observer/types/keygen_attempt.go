@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KeygenRetryBlockDelay is the number of blocks after the failure of a keygen attempt at which the retry is proposed
+	KeygenRetryBlockDelay = 100
+
+	// keygenBlamePrefix is the prefix of the index of the blame records posted for a failed keygen
+	keygenBlamePrefix = "keygen-"
+)
+
+// GetKeygenBlameIndex returns the index of the blame record for a failed keygen at the given block
+func GetKeygenBlameIndex(digest string, keygenBlock int64) string {
+	return fmt.Sprintf("%s%s-%d", keygenBlamePrefix, digest, keygenBlock)
+}
+
+// ParseKeygenBlameIndex returns the keygen block of a keygen blame record index
+// the boolean is false if the index is not a keygen blame index
+func ParseKeygenBlameIndex(index string) (int64, bool) {
+	if !strings.HasPrefix(index, keygenBlamePrefix) {
+		return 0, false
+	}
+	sep := strings.LastIndex(index, "-")
+	if sep < len(keygenBlamePrefix) {
+		return 0, false
+	}
+	block, err := strconv.ParseInt(index[sep+1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return block, true
+}
+
+// IsFinalized returns true if the attempt is no longer pending
+func (m KeygenAttempt) IsFinalized() bool {
+	return m.Status != KeygenAttemptStatus_AttemptPending
+}
+
+// AddBlamedPubkey adds a blamed grantee pubkey to the attempt, if not already present
+func (m *KeygenAttempt) AddBlamedPubkey(pubkey string) {
+	for _, blamed := range m.BlamedPubkeys {
+		if blamed == pubkey {
+			return
+		}
+	}
+	m.BlamedPubkeys = append(m.BlamedPubkeys, pubkey)
+}
+
+// RetryGranteePubkeys returns the grantees of the attempt excluding the blamed ones
+func (m KeygenAttempt) RetryGranteePubkeys() []string {
+	blamed := make(map[string]bool, len(m.BlamedPubkeys))
+	for _, pubkey := range m.BlamedPubkeys {
+		blamed[pubkey] = true
+	}
+	grantees := make([]string, 0, len(m.GranteePubkeys))
+	for _, pubkey := range m.GranteePubkeys {
+		if !blamed[pubkey] {
+			grantees = append(grantees, pubkey)
+		}
+	}
+	return grantees
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestParseKeygenBlameIndex(t *testing.T) {
+	block, ok := types.ParseKeygenBlameIndex(types.GetKeygenBlameIndex("abcdef", 42))
+	require.True(t, ok)
+	require.EqualValues(t, 42, block)
+
+	_, ok = types.ParseKeygenBlameIndex("1337-0xabcdef-42")
+	require.False(t, ok)
+	_, ok = types.ParseKeygenBlameIndex("keygen-abcdef-foo")
+	require.False(t, ok)
+	_, ok = types.ParseKeygenBlameIndex("keygen-")
+	require.False(t, ok)
+}
+
+func TestKeygenAttempt_RetryGranteePubkeys(t *testing.T) {
+	attempt := types.KeygenAttempt{
+		GranteePubkeys: []string{"a", "b", "c"},
+	}
+	require.Equal(t, []string{"a", "b", "c"}, attempt.RetryGranteePubkeys())
+
+	attempt.AddBlamedPubkey("b")
+	attempt.AddBlamedPubkey("b")
+	require.Equal(t, []string{"b"}, attempt.BlamedPubkeys)
+	require.Equal(t, []string{"a", "c"}, attempt.RetryGranteePubkeys())
+}
@@ -46,8 +46,32 @@ const (
 	LastBlockObserverCountKey = "ObserverCount-value-"
 	NodeAccountKey            = "NodeAccount-value-"
 	KeygenKey                 = "Keygen-value-"
+	KeygenAttemptKey          = "KeygenAttempt-value-"
+	KeygenAttemptCountKey     = "KeygenAttemptCount-value-"
+	LivenessParamsKey         = "LivenessParams-value-"
+	ObserverLivenessKey       = "ObserverLiveness-value-"
 	BlockHeaderKey            = "BlockHeader-value-"
 	BlockHeaderStateKey       = "BlockHeaderState-value-"
+	BlockChainWorkKey         = "BlockChainWork-value-"
+
+	// BestChainBlockHashKey is the key for the hashes of the block headers of the best chain, indexed by chain and height
+	BestChainBlockHashKey = "BestChainBlockHash-value-"
+
+	LightClientStateKey = "LightClientState-value-"
+
+	// SyncCommitteePubkeysKey is the key for the uncompressed public keys of the sync committees of the light clients,
+	// indexed by chain and period
+	SyncCommitteePubkeysKey = "SyncCommitteePubkeys-value-"
+
+	// FinalizedExecutionBlockKey is the key for the numbers of the execution blocks finalized by the light client
+	FinalizedExecutionBlockKey = "FinalizedExecutionBlock-value-"
+
+	// BlockHeaderHeightKey is the key for the index of the block headers by chain and height
+	BlockHeaderHeightKey = "BlockHeaderHeight-value-"
+
+	// BlockHeaderReferenceKey is the key for the number of trackers referencing a block header, the referenced block
+	// headers are not pruned
+	BlockHeaderReferenceKey = "BlockHeaderReference-value-"
 
 	BallotListKey      = "BallotList-value-"
 	TSSKey             = "TSS-value-"
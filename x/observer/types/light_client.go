@@ -0,0 +1,250 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/zeta-chain/zetacore/common/beacon"
+)
+
+const (
+	rootLength             = 32
+	executionAddressLength = 20
+	logsBloomLength        = 256
+	maxExtraDataLength     = 32
+)
+
+// HashTreeRoot returns the SSZ root of the beacon block header
+func (h BeaconBlockHeader) HashTreeRoot() (beacon.Root, error) {
+	parentRoot, err := beacon.RootFromBytes(h.ParentRoot)
+	if err != nil {
+		return beacon.Root{}, fmt.Errorf("invalid parent root: %w", err)
+	}
+	stateRoot, err := beacon.RootFromBytes(h.StateRoot)
+	if err != nil {
+		return beacon.Root{}, fmt.Errorf("invalid state root: %w", err)
+	}
+	bodyRoot, err := beacon.RootFromBytes(h.BodyRoot)
+	if err != nil {
+		return beacon.Root{}, fmt.Errorf("invalid body root: %w", err)
+	}
+	return beacon.Merkleize([]beacon.Root{
+		beacon.Uint64Root(h.Slot),
+		beacon.Uint64Root(h.ProposerIndex),
+		parentRoot,
+		stateRoot,
+		bodyRoot,
+	}), nil
+}
+
+// HashTreeRoot returns the SSZ root of the execution payload header, the blob gas fields are only part of the header
+// from Deneb
+func (h ExecutionPayloadHeader) HashTreeRoot(deneb bool) (beacon.Root, error) {
+	if !deneb && (h.BlobGasUsed != 0 || h.ExcessBlobGas != 0) {
+		return beacon.Root{}, errors.New("blob gas fields set before Deneb")
+	}
+	roots := make([]beacon.Root, 0, 17)
+	for _, field := range []bytesField{
+		{"parent hash", h.ParentHash, rootLength},
+		{"fee recipient", h.FeeRecipient, executionAddressLength},
+		{"state root", h.StateRoot, rootLength},
+		{"receipts root", h.ReceiptsRoot, rootLength},
+		{"logs bloom", h.LogsBloom, logsBloomLength},
+		{"prev randao", h.PrevRandao, rootLength},
+	} {
+		root, err := field.root()
+		if err != nil {
+			return beacon.Root{}, err
+		}
+		roots = append(roots, root)
+	}
+	roots = append(roots,
+		beacon.Uint64Root(h.BlockNumber),
+		beacon.Uint64Root(h.GasLimit),
+		beacon.Uint64Root(h.GasUsed),
+		beacon.Uint64Root(h.Timestamp),
+	)
+
+	extraDataRoot, err := beacon.BytesListRoot(h.ExtraData, maxExtraDataLength)
+	if err != nil {
+		return beacon.Root{}, fmt.Errorf("invalid extra data: %w", err)
+	}
+	baseFee, ok := new(big.Int).SetString(h.BaseFeePerGas, 10)
+	if !ok {
+		return beacon.Root{}, fmt.Errorf("invalid base fee per gas %s", h.BaseFeePerGas)
+	}
+	baseFeeRoot, err := beacon.Uint256Root(baseFee)
+	if err != nil {
+		return beacon.Root{}, fmt.Errorf("invalid base fee per gas: %w", err)
+	}
+	roots = append(roots, extraDataRoot, baseFeeRoot)
+
+	for _, field := range []bytesField{
+		{"block hash", h.BlockHash, rootLength},
+		{"transactions root", h.TransactionsRoot, rootLength},
+		{"withdrawals root", h.WithdrawalsRoot, rootLength},
+	} {
+		root, err := field.root()
+		if err != nil {
+			return beacon.Root{}, err
+		}
+		roots = append(roots, root)
+	}
+	if deneb {
+		roots = append(roots, beacon.Uint64Root(h.BlobGasUsed), beacon.Uint64Root(h.ExcessBlobGas))
+	}
+	return beacon.Merkleize(roots), nil
+}
+
+// bytesField is a fixed size byte vector field of a SSZ container
+type bytesField struct {
+	name   string
+	value  []byte
+	length int
+}
+
+func (f bytesField) root() (beacon.Root, error) {
+	if len(f.value) != f.length {
+		return beacon.Root{}, fmt.Errorf("invalid %s length %d", f.name, len(f.value))
+	}
+	return beacon.BytesRoot(f.value), nil
+}
+
+// Validate checks the execution block header of the light client header is proven against the body of the beacon block
+func (h LightClientHeader) Validate(config *beacon.Config) error {
+	if !config.IsCapella(h.Beacon.Slot) {
+		return fmt.Errorf("header of slot %d before Capella", h.Beacon.Slot)
+	}
+	executionRoot, err := h.Execution.HashTreeRoot(config.IsDeneb(h.Beacon.Slot))
+	if err != nil {
+		return fmt.Errorf("invalid execution header: %w", err)
+	}
+	bodyRoot, err := beacon.RootFromBytes(h.Beacon.BodyRoot)
+	if err != nil {
+		return fmt.Errorf("invalid body root: %w", err)
+	}
+	if err := beacon.VerifyMerkleBranch(executionRoot, h.ExecutionBranch, beacon.ExecutionPayloadGIndex, bodyRoot); err != nil {
+		return fmt.Errorf("invalid execution branch: %w", err)
+	}
+	return nil
+}
+
+// HashTreeRoot returns the SSZ root of the sync committee
+func (c SyncCommittee) HashTreeRoot() (beacon.Root, error) {
+	if len(c.Pubkeys) != beacon.SyncCommitteeSize {
+		return beacon.Root{}, fmt.Errorf("invalid sync committee size %d", len(c.Pubkeys))
+	}
+	roots := make([]beacon.Root, len(c.Pubkeys))
+	for i, pubkey := range c.Pubkeys {
+		if len(pubkey) != beacon.PublicKeyLength {
+			return beacon.Root{}, fmt.Errorf("invalid public key length %d", len(pubkey))
+		}
+		roots[i] = beacon.BytesRoot(pubkey)
+	}
+	if len(c.AggregatePubkey) != beacon.PublicKeyLength {
+		return beacon.Root{}, fmt.Errorf("invalid aggregate public key length %d", len(c.AggregatePubkey))
+	}
+	return beacon.Merkleize([]beacon.Root{beacon.Merkleize(roots), beacon.BytesRoot(c.AggregatePubkey)}), nil
+}
+
+// Validate checks the public keys of the sync committee are valid BLS public keys
+func (c SyncCommittee) Validate() error {
+	if _, err := c.HashTreeRoot(); err != nil {
+		return err
+	}
+	for i, pubkey := range c.Pubkeys {
+		if _, err := beacon.DecompressPublicKey(pubkey); err != nil {
+			return fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic checks the length of the participation bits and the signature of the sync aggregate
+func (a SyncAggregate) ValidateBasic() error {
+	if len(a.SyncCommitteeBits) != beacon.SyncCommitteeSize/8 {
+		return fmt.Errorf("invalid sync committee bits length %d", len(a.SyncCommitteeBits))
+	}
+	if len(a.SyncCommitteeSignature) != beacon.SignatureLength {
+		return fmt.Errorf("invalid sync committee signature length %d", len(a.SyncCommitteeSignature))
+	}
+	return nil
+}
+
+// ParticipantCount returns the number of members of the sync committee who signed
+func (a SyncAggregate) ParticipantCount() int {
+	count := 0
+	for _, b := range a.SyncCommitteeBits {
+		count += bits.OnesCount8(b)
+	}
+	return count
+}
+
+// IsParticipant returns true if the member of the sync committee at the index signed, the bits are little endian
+func (a SyncAggregate) IsParticipant(index int) bool {
+	return a.SyncCommitteeBits[index/8]&(1<<(index%8)) != 0
+}
+
+// HasSupermajority returns true if at least two thirds of the sync committee signed
+func (a SyncAggregate) HasSupermajority() bool {
+	return a.ParticipantCount()*3 >= beacon.SyncCommitteeSize*2
+}
+
+// IsSyncCommitteeUpdate returns true if the update includes the next sync committee
+func (u LightClientUpdate) IsSyncCommitteeUpdate() bool {
+	return u.NextSyncCommittee != nil
+}
+
+// ValidateBasic checks the lengths of the fields of the update
+func (u LightClientUpdate) ValidateBasic() error {
+	if err := u.SyncAggregate.ValidateBasic(); err != nil {
+		return err
+	}
+	if u.IsSyncCommitteeUpdate() {
+		if _, err := u.NextSyncCommittee.HashTreeRoot(); err != nil {
+			return fmt.Errorf("invalid next sync committee: %w", err)
+		}
+	} else if len(u.NextSyncCommitteeBranch) > 0 {
+		return errors.New("next sync committee branch without next sync committee")
+	}
+	if u.SignatureSlot <= u.AttestedHeader.Beacon.Slot {
+		return fmt.Errorf("signature slot %d not after attested slot %d", u.SignatureSlot, u.AttestedHeader.Beacon.Slot)
+	}
+	if u.AttestedHeader.Beacon.Slot < u.FinalizedHeader.Beacon.Slot {
+		return fmt.Errorf("attested slot %d before finalized slot %d", u.AttestedHeader.Beacon.Slot, u.FinalizedHeader.Beacon.Slot)
+	}
+	return nil
+}
+
+// ValidateBasic checks the lengths of the fields of the bootstrap
+func (b LightClientBootstrap) ValidateBasic() error {
+	if _, err := b.CurrentSyncCommittee.HashTreeRoot(); err != nil {
+		return fmt.Errorf("invalid current sync committee: %w", err)
+	}
+	if _, err := b.Header.Beacon.HashTreeRoot(); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the header of the bootstrap and its sync committee proven against its state
+func (b LightClientBootstrap) Validate(config *beacon.Config) error {
+	if err := b.Header.Validate(config); err != nil {
+		return err
+	}
+	root, err := b.CurrentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("invalid current sync committee: %w", err)
+	}
+	stateRoot, err := beacon.RootFromBytes(b.Header.Beacon.StateRoot)
+	if err != nil {
+		return fmt.Errorf("invalid state root: %w", err)
+	}
+	gindex := config.CurrentSyncCommitteeGIndexAtSlot(b.Header.Beacon.Slot)
+	if err := beacon.VerifyMerkleBranch(root, b.CurrentSyncCommitteeBranch, gindex, stateRoot); err != nil {
+		return fmt.Errorf("invalid current sync committee branch: %w", err)
+	}
+	return b.CurrentSyncCommittee.Validate()
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestParseBeaconAPILightClientUpdate(t *testing.T) {
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+	finalized := fixture.Header(t, lightClientSlot+64, 1064, sample.Hash().Bytes())
+
+	t.Run("should parse a finality update in the versioned envelope", func(t *testing.T) {
+		update := fixture.Update(t, lightClientSlot+128, finalized, false, beacon.SyncCommitteeSize)
+		bz, err := json.Marshal(sample.BeaconAPIEnvelope("electra", sample.BeaconAPILightClientUpdate(update)))
+		require.NoError(t, err)
+
+		parsed, err := types.ParseBeaconAPILightClientUpdate(bz)
+		require.NoError(t, err)
+		require.Equal(t, update, parsed)
+	})
+
+	t.Run("should parse a sync committee update", func(t *testing.T) {
+		update := fixture.Update(t, lightClientSlot+128, finalized, true, beacon.SyncCommitteeSize)
+		bz, err := json.Marshal(sample.BeaconAPILightClientUpdate(update))
+		require.NoError(t, err)
+
+		parsed, err := types.ParseBeaconAPILightClientUpdate(bz)
+		require.NoError(t, err)
+		require.Equal(t, update, parsed)
+	})
+
+	t.Run("should parse a single update of the updates endpoint", func(t *testing.T) {
+		update := fixture.Update(t, lightClientSlot+128, finalized, true, beacon.SyncCommitteeSize)
+		bz, err := json.Marshal([]interface{}{sample.BeaconAPIEnvelope("electra", sample.BeaconAPILightClientUpdate(update))})
+		require.NoError(t, err)
+
+		parsed, err := types.ParseBeaconAPILightClientUpdate(bz)
+		require.NoError(t, err)
+		require.Equal(t, update, parsed)
+	})
+
+	t.Run("should fail on several updates of the updates endpoint", func(t *testing.T) {
+		update := sample.BeaconAPIEnvelope("electra", sample.BeaconAPILightClientUpdate(
+			fixture.Update(t, lightClientSlot+128, finalized, true, beacon.SyncCommitteeSize),
+		))
+		bz, err := json.Marshal([]interface{}{update, update})
+		require.NoError(t, err)
+
+		_, err = types.ParseBeaconAPILightClientUpdate(bz)
+		require.ErrorContains(t, err, "2 objects")
+	})
+
+	t.Run("should fail on invalid JSON", func(t *testing.T) {
+		_, err := types.ParseBeaconAPILightClientUpdate([]byte(`{"data": {"signature_slot": 1}}`))
+		require.Error(t, err)
+	})
+}
+
+func TestSyncAggregate(t *testing.T) {
+	aggregate := types.SyncAggregate{
+		SyncCommitteeBits:      make([]byte, beacon.SyncCommitteeSize/8),
+		SyncCommitteeSignature: make([]byte, beacon.SignatureLength),
+	}
+	require.NoError(t, aggregate.ValidateBasic())
+	aggregate.SyncCommitteeBits[0] = 0x81
+	require.True(t, aggregate.IsParticipant(0))
+	require.False(t, aggregate.IsParticipant(1))
+	require.True(t, aggregate.IsParticipant(7))
+	require.Equal(t, 2, aggregate.ParticipantCount())
+	require.False(t, aggregate.HasSupermajority())
+
+	for i := range aggregate.SyncCommitteeBits[:43] {
+		aggregate.SyncCommitteeBits[i] = 0xff
+	}
+	require.Equal(t, 344, aggregate.ParticipantCount())
+	require.True(t, aggregate.HasSupermajority())
+
+	aggregate.SyncCommitteeSignature = aggregate.SyncCommitteeSignature[1:]
+	require.Error(t, aggregate.ValidateBasic())
+}
+
+func TestLightClientHeader_Validate(t *testing.T) {
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+
+	t.Run("should validate the execution branch", func(t *testing.T) {
+		header := fixture.Header(t, lightClientSlot, 1000, sample.Hash().Bytes())
+		require.NoError(t, header.Validate(&beacon.SepoliaConfig))
+
+		header.Execution.BlockNumber++
+		require.Error(t, header.Validate(&beacon.SepoliaConfig))
+	})
+
+	t.Run("should fail before Capella", func(t *testing.T) {
+		header := fixture.Header(t, lightClientSlot, 1000, sample.Hash().Bytes())
+		header.Beacon.Slot = 1
+		require.Error(t, header.Validate(&beacon.SepoliaConfig))
+	})
+}
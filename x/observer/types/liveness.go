@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultLivenessParams returns the default liveness params used when not defined
+// The missed votes are tracked but the jailing is disabled until enabled by the admin policy,
+// so that the observers of an upgraded chain are not jailed for the votes missed before the upgrade
+func DefaultLivenessParams() LivenessParams {
+	return LivenessParams{
+		WindowSize:         100,
+		WarningMissedVotes: 25,
+		MaxMissedVotes:     0,
+		JailDuration:       14400,
+	}
+}
+
+// Validate checks the thresholds fit in the window
+func (m LivenessParams) Validate() error {
+	if m.WarningMissedVotes > m.WindowSize {
+		return fmt.Errorf("warning missed votes %d greater than window size %d", m.WarningMissedVotes, m.WindowSize)
+	}
+	if m.MaxMissedVotes > m.WindowSize {
+		return fmt.Errorf("max missed votes %d greater than window size %d", m.MaxMissedVotes, m.WindowSize)
+	}
+	if m.JailDuration < 0 {
+		return errors.New("jail duration cannot be negative")
+	}
+	return nil
+}
+
+// GetObserverLivenessIndex returns the index of the liveness of an observer for a chain
+func GetObserverLivenessIndex(chainID int64, observerAddress string) string {
+	return fmt.Sprintf("%d-%s", chainID, observerAddress)
+}
+
+// ResetWindow clears the missed votes and starts a new window of the given size
+func (m *ObserverLiveness) ResetWindow(windowSize uint64) {
+	m.WindowSize = windowSize
+	m.IndexOffset = 0
+	m.MissedVotesCounter = 0
+	m.MissedVotes = make([]byte, (windowSize+7)/8)
+}
+
+// RecordBallot records whether the observer missed the vote of a ballot in the window
+// the window is reset if its size changed
+func (m *ObserverLiveness) RecordBallot(missed bool, windowSize uint64) {
+	if windowSize == 0 {
+		return
+	}
+	// #nosec G701 always positive
+	if m.WindowSize != windowSize || uint64(len(m.MissedVotes)) != (windowSize+7)/8 {
+		m.ResetWindow(windowSize)
+	}
+
+	// the vote replaces the oldest vote of the window
+	i := m.IndexOffset % windowSize
+	m.IndexOffset++
+	byteIndex, bit := i/8, byte(1)<<(i%8)
+	previous := m.MissedVotes[byteIndex]&bit != 0
+	switch {
+	case missed && !previous:
+		m.MissedVotes[byteIndex] |= bit
+		m.MissedVotesCounter++
+	case !missed && previous:
+		m.MissedVotes[byteIndex] &^= bit
+		m.MissedVotesCounter--
+	}
+}
+
+// Jail jails the observer until the given height, the window is reset to start over once unjailed
+func (m *ObserverLiveness) Jail(height, until int64) {
+	m.Jailed = true
+	m.JailedHeight = height
+	m.JailedUntil = until
+	m.ResetWindow(m.WindowSize)
+}
+
+// Unjail unjails the observer
+func (m *ObserverLiveness) Unjail() {
+	m.Jailed = false
+}
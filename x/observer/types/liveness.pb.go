@@ -0,0 +1,896 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: observer/liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LivenessParams defines how the participation of the observers in the ballots is tracked
+type LivenessParams struct {
+	WindowSize         uint64 `protobuf:"varint,1,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	WarningMissedVotes uint64 `protobuf:"varint,2,opt,name=warning_missed_votes,json=warningMissedVotes,proto3" json:"warning_missed_votes,omitempty"`
+	MaxMissedVotes     uint64 `protobuf:"varint,3,opt,name=max_missed_votes,json=maxMissedVotes,proto3" json:"max_missed_votes,omitempty"`
+	JailDuration       int64  `protobuf:"varint,4,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+}
+
+func (m *LivenessParams) Reset()         { *m = LivenessParams{} }
+func (m *LivenessParams) String() string { return proto.CompactTextString(m) }
+func (*LivenessParams) ProtoMessage()    {}
+func (*LivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9843f85f01c4e836, []int{0}
+}
+func (m *LivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessParams.Merge(m, src)
+}
+func (m *LivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessParams proto.InternalMessageInfo
+
+func (m *LivenessParams) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetWarningMissedVotes() uint64 {
+	if m != nil {
+		return m.WarningMissedVotes
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetMaxMissedVotes() uint64 {
+	if m != nil {
+		return m.MaxMissedVotes
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetJailDuration() int64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// ObserverLiveness tracks the missed votes of an observer over the last matured ballots of a chain
+type ObserverLiveness struct {
+	ObserverAddress    string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	ChainId            int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	WindowSize         uint64 `protobuf:"varint,3,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	IndexOffset        uint64 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedVotesCounter uint64 `protobuf:"varint,5,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+	MissedVotes        []byte `protobuf:"bytes,6,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	Jailed             bool   `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedHeight       int64  `protobuf:"varint,8,opt,name=jailed_height,json=jailedHeight,proto3" json:"jailed_height,omitempty"`
+	JailedUntil        int64  `protobuf:"varint,9,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ObserverLiveness) Reset()         { *m = ObserverLiveness{} }
+func (m *ObserverLiveness) String() string { return proto.CompactTextString(m) }
+func (*ObserverLiveness) ProtoMessage()    {}
+func (*ObserverLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9843f85f01c4e836, []int{1}
+}
+func (m *ObserverLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLiveness.Merge(m, src)
+}
+func (m *ObserverLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLiveness proto.InternalMessageInfo
+
+func (m *ObserverLiveness) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverLiveness) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotesCounter() uint64 {
+	if m != nil {
+		return m.MissedVotesCounter
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotes() []byte {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return nil
+}
+
+func (m *ObserverLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverLiveness) GetJailedHeight() int64 {
+	if m != nil {
+		return m.JailedHeight
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LivenessParams)(nil), "zetachain.zetacore.observer.LivenessParams")
+	proto.RegisterType((*ObserverLiveness)(nil), "zetachain.zetacore.observer.ObserverLiveness")
+}
+
+func init() { proto.RegisterFile("observer/liveness.proto", fileDescriptor_9843f85f01c4e836) }
+
+var fileDescriptor_9843f85f01c4e836 = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
+	0x14, 0xc6, 0xeb, 0x49, 0xe9, 0x74, 0x9c, 0x32, 0x54, 0x16, 0x82, 0x20, 0xa4, 0x10, 0x86, 0x4d,
+	0x58, 0x90, 0x20, 0x71, 0x02, 0xfe, 0x2c, 0x18, 0x09, 0x34, 0x28, 0x08, 0x16, 0x6c, 0x2c, 0xb7,
+	0x7e, 0xd3, 0x18, 0x35, 0xf6, 0xc8, 0x76, 0xda, 0xd0, 0x53, 0x70, 0x16, 0x4e, 0xc1, 0x72, 0xd8,
+	0xb1, 0x44, 0xed, 0x45, 0x90, 0x9d, 0x84, 0xe9, 0x30, 0xbb, 0xe4, 0xf7, 0x7e, 0xb2, 0xde, 0xf7,
+	0xe9, 0xe1, 0xfb, 0x6a, 0x66, 0x40, 0xaf, 0x40, 0xe7, 0x4b, 0xb1, 0x02, 0x09, 0xc6, 0x64, 0x17,
+	0x5a, 0x59, 0x45, 0x1e, 0x6e, 0xc0, 0xb2, 0x79, 0xc9, 0x84, 0xcc, 0xfc, 0x97, 0xd2, 0x90, 0xf5,
+	0xee, 0xc9, 0x0f, 0x84, 0x8f, 0xdf, 0x75, 0xfe, 0x07, 0xa6, 0x59, 0x65, 0xc8, 0x23, 0x1c, 0xae,
+	0x85, 0xe4, 0x6a, 0x4d, 0x8d, 0xd8, 0x40, 0x84, 0x12, 0x94, 0x0e, 0x0b, 0xdc, 0xa2, 0x8f, 0x62,
+	0x03, 0xe4, 0x39, 0xbe, 0xbb, 0x66, 0x5a, 0x0a, 0xb9, 0xa0, 0x95, 0x30, 0x06, 0x38, 0x5d, 0x29,
+	0x0b, 0x26, 0x3a, 0xf0, 0x26, 0xe9, 0x66, 0xef, 0xfd, 0xe8, 0xb3, 0x9b, 0x90, 0x14, 0x4f, 0x2b,
+	0xd6, 0x5c, 0xb7, 0x03, 0x6f, 0x1f, 0x57, 0xac, 0xd9, 0x37, 0x9f, 0xe0, 0xdb, 0x5f, 0x99, 0x58,
+	0x52, 0x5e, 0x6b, 0x66, 0x85, 0x92, 0xd1, 0x30, 0x41, 0x69, 0x50, 0x4c, 0x1c, 0x7c, 0xd3, 0xb1,
+	0x93, 0x5f, 0x07, 0x78, 0x7a, 0xd6, 0x25, 0xe8, 0x97, 0x27, 0x4f, 0xf1, 0xb4, 0x4f, 0x45, 0x19,
+	0xe7, 0x1a, 0x8c, 0xf1, 0xbb, 0x1f, 0x15, 0x77, 0x7a, 0xfe, 0xb2, 0xc5, 0xe4, 0x01, 0x1e, 0xfb,
+	0x3e, 0xa8, 0xe0, 0x7e, 0xe9, 0xa0, 0x38, 0xf4, 0xff, 0xa7, 0xfc, 0xff, 0xf0, 0xc1, 0x8d, 0xf0,
+	0x8f, 0xf1, 0x44, 0x48, 0x0e, 0x0d, 0x55, 0xe7, 0xe7, 0x06, 0xac, 0xdf, 0x6f, 0x58, 0x84, 0x9e,
+	0x9d, 0x79, 0xe4, 0xfa, 0xd9, 0x4f, 0x4a, 0xe7, 0xaa, 0x96, 0x16, 0x74, 0x74, 0xab, 0xed, 0xa7,
+	0xba, 0x8a, 0xfb, 0xba, 0x9d, 0xb8, 0x47, 0xaf, 0x75, 0x33, 0x4a, 0x50, 0x3a, 0x29, 0xc2, 0x3d,
+	0x93, 0xdc, 0xc3, 0x23, 0xd7, 0x01, 0xf0, 0xe8, 0x30, 0x41, 0xe9, 0xb8, 0xe8, 0xfe, 0xfa, 0xc2,
+	0x80, 0xd3, 0x12, 0xc4, 0xa2, 0xb4, 0xd1, 0xf8, 0xaa, 0x30, 0xe0, 0x6f, 0x3d, 0x73, 0xef, 0x77,
+	0x52, 0x2d, 0xad, 0x58, 0x46, 0x47, 0xde, 0x09, 0x5b, 0xf6, 0xc9, 0xa1, 0x57, 0xa7, 0x3f, 0xb7,
+	0x31, 0xba, 0xdc, 0xc6, 0xe8, 0xcf, 0x36, 0x46, 0xdf, 0x77, 0xf1, 0xe0, 0x72, 0x17, 0x0f, 0x7e,
+	0xef, 0xe2, 0xc1, 0x97, 0x7c, 0x21, 0x6c, 0x59, 0xcf, 0xb2, 0xb9, 0xaa, 0x72, 0x77, 0x40, 0xcf,
+	0x7c, 0x57, 0x79, 0x7f, 0x4b, 0x79, 0x93, 0xff, 0xbb, 0x3c, 0xfb, 0xed, 0x02, 0xcc, 0x6c, 0xe4,
+	0xef, 0xee, 0xc5, 0xdf, 0x01, 0x00, 0xa0, 0xf5, 0x41, 0x3f, 0x92, 0x02, 0x00, 0x00,
+}
+
+func (m *LivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailDuration != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMissedVotes != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MaxMissedVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WarningMissedVotes != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.WarningMissedVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObserverLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.JailedHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MissedVotes) > 0 {
+		i -= len(m.MissedVotes)
+		copy(dAtA[i:], m.MissedVotes)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.MissedVotes)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowSize != 0 {
+		n += 1 + sovLiveness(uint64(m.WindowSize))
+	}
+	if m.WarningMissedVotes != 0 {
+		n += 1 + sovLiveness(uint64(m.WarningMissedVotes))
+	}
+	if m.MaxMissedVotes != 0 {
+		n += 1 + sovLiveness(uint64(m.MaxMissedVotes))
+	}
+	if m.JailDuration != 0 {
+		n += 1 + sovLiveness(uint64(m.JailDuration))
+	}
+	return n
+}
+
+func (m *ObserverLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovLiveness(uint64(m.ChainId))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovLiveness(uint64(m.WindowSize))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovLiveness(uint64(m.IndexOffset))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovLiveness(uint64(m.MissedVotesCounter))
+	}
+	l = len(m.MissedVotes)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedHeight))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func sovLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiveness(x uint64) (n int) {
+	return sovLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LivenessParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningMissedVotes", wireType)
+			}
+			m.WarningMissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningMissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedVotes", wireType)
+			}
+			m.MaxMissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObserverLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
+			}
+			m.MissedVotesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotesCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedVotes = append(m.MissedVotes[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedVotes == nil {
+				m.MissedVotes = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedHeight", wireType)
+			}
+			m.JailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiveness = fmt.Errorf("proto: unexpected end of group")
+)
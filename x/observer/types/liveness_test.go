@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestObserverLiveness_RecordBallot(t *testing.T) {
+	t.Run("should count the missed votes in the window", func(t *testing.T) {
+		liveness := types.ObserverLiveness{}
+		liveness.RecordBallot(true, 10)
+		liveness.RecordBallot(false, 10)
+		liveness.RecordBallot(true, 10)
+		require.EqualValues(t, 2, liveness.MissedVotesCounter)
+		require.EqualValues(t, 3, liveness.IndexOffset)
+		require.Len(t, liveness.MissedVotes, 2)
+	})
+
+	t.Run("should replace the oldest votes of the window", func(t *testing.T) {
+		liveness := types.ObserverLiveness{}
+		for i := 0; i < 10; i++ {
+			liveness.RecordBallot(true, 10)
+		}
+		require.EqualValues(t, 10, liveness.MissedVotesCounter)
+
+		for i := 0; i < 4; i++ {
+			liveness.RecordBallot(false, 10)
+		}
+		require.EqualValues(t, 6, liveness.MissedVotesCounter)
+
+		// a missed vote replacing a missed vote doesn't change the counter
+		for i := 0; i < 6; i++ {
+			liveness.RecordBallot(true, 10)
+		}
+		require.EqualValues(t, 6, liveness.MissedVotesCounter)
+	})
+
+	t.Run("should reset the window if the size changes", func(t *testing.T) {
+		liveness := types.ObserverLiveness{}
+		liveness.RecordBallot(true, 10)
+		liveness.RecordBallot(true, 10)
+		liveness.RecordBallot(true, 20)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+		require.EqualValues(t, 1, liveness.IndexOffset)
+		require.EqualValues(t, 20, liveness.WindowSize)
+	})
+
+	t.Run("should reset the window when jailed", func(t *testing.T) {
+		liveness := types.ObserverLiveness{}
+		liveness.RecordBallot(true, 10)
+		liveness.Jail(100, 200)
+		require.True(t, liveness.Jailed)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+		require.EqualValues(t, 200, liveness.JailedUntil)
+
+		liveness.Unjail()
+		require.False(t, liveness.Jailed)
+	})
+}
+
+func TestLivenessParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultLivenessParams().Validate())
+	require.NoError(t, types.LivenessParams{}.Validate())
+	require.Error(t, types.LivenessParams{WindowSize: 10, WarningMissedVotes: 11}.Validate())
+	require.Error(t, types.LivenessParams{WindowSize: 10, MaxMissedVotes: 11}.Validate())
+	require.Error(t, types.LivenessParams{WindowSize: 10, JailDuration: -1}.Validate())
+}
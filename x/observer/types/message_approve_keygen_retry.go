@@ -0,0 +1,54 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveKeygenRetry = "approve_keygen_retry"
+
+var _ sdk.Msg = &MsgApproveKeygenRetry{}
+
+func NewMsgApproveKeygenRetry(creator string, attemptIndex uint64, block int64) *MsgApproveKeygenRetry {
+	return &MsgApproveKeygenRetry{
+		Creator:      creator,
+		AttemptIndex: attemptIndex,
+		Block:        block,
+	}
+}
+
+func (msg *MsgApproveKeygenRetry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveKeygenRetry) Type() string {
+	return TypeMsgApproveKeygenRetry
+}
+
+func (msg *MsgApproveKeygenRetry) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveKeygenRetry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveKeygenRetry) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.AttemptIndex == 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "attempt index must be positive")
+	}
+	if msg.Block < 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "block cannot be negative")
+	}
+	return nil
+}
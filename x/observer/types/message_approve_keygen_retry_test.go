@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgApproveKeygenRetry_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgApproveKeygenRetry
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgApproveKeygenRetry{
+				Creator:      "invalid_address",
+				AttemptIndex: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid attempt index",
+			msg: types.MsgApproveKeygenRetry{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid block",
+			msg: types.MsgApproveKeygenRetry{
+				Creator:      sample.AccAddress(),
+				AttemptIndex: 1,
+				Block:        -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgApproveKeygenRetry{
+				Creator:      sample.AccAddress(),
+				AttemptIndex: 1,
+				Block:        100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
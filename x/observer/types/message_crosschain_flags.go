@@ -2,11 +2,13 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	cosmoserrors "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
 )
 
 const (
@@ -55,6 +57,12 @@ func (msg *MsgUpdateCrosschainFlags) ValidateBasic() error {
 		}
 	}
 
+	if msg.BlockHeaderVerificationFlags != nil {
+		if err := msg.BlockHeaderVerificationFlags.Validate(); err != nil {
+			return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -67,3 +75,13 @@ func (gpf GasPriceIncreaseFlags) Validate() error {
 	}
 	return nil
 }
+
+func (bhf BlockHeaderVerificationFlags) Validate() error {
+	for _, chainID := range bhf.PermissionlessInboundChainIds {
+		chain := common.GetChainFromChainID(chainID)
+		if chain == nil || !chain.SupportMerkleProof() {
+			return fmt.Errorf("chain %d does not support block header-based verification", chainID)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -51,6 +52,26 @@ func TestMsgUpdateCrosschainFlags_ValidateBasic(t *testing.T) {
 				Creator: sample.AccAddress(),
 			},
 		},
+		{
+			name: "invalid permissionless inbound chain",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					PermissionlessInboundChainIds: []int64{common.ZetaPrivnetChain().ChainId},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid permissionless inbound chains",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					IsEthTypeChainEnabled:         true,
+					PermissionlessInboundChainIds: []int64{common.GoerliChain().ChainId, common.BtcRegtestChain().ChainId},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -116,3 +137,34 @@ func TestGasPriceIncreaseFlags_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockHeaderVerificationFlags_IsPermissionlessInboundEnabled(t *testing.T) {
+	var nilFlags *types.BlockHeaderVerificationFlags
+	require.False(t, nilFlags.IsPermissionlessInboundEnabled(common.GoerliChain().ChainId))
+
+	flags := &types.BlockHeaderVerificationFlags{
+		PermissionlessInboundChainIds: []int64{common.GoerliChain().ChainId},
+	}
+	require.True(t, flags.IsPermissionlessInboundEnabled(common.GoerliChain().ChainId))
+	require.False(t, flags.IsPermissionlessInboundEnabled(common.BtcRegtestChain().ChainId))
+}
+
+func TestCrosschainFlags_OutboundPause(t *testing.T) {
+	flags := types.DefaultCrosschainFlags()
+	_, paused := flags.GetOutboundPause(common.GoerliChain().ChainId)
+	require.False(t, paused)
+
+	flags.SetOutboundPause(types.OutboundPause{ChainId: common.GoerliChain().ChainId, Reason: "first"})
+	flags.SetOutboundPause(types.OutboundPause{ChainId: common.BtcRegtestChain().ChainId, Reason: "btc"})
+	flags.SetOutboundPause(types.OutboundPause{ChainId: common.GoerliChain().ChainId, Reason: "second"})
+	require.Len(t, flags.OutboundPauses, 2)
+	pause, paused := flags.GetOutboundPause(common.GoerliChain().ChainId)
+	require.True(t, paused)
+	require.Equal(t, "second", pause.Reason)
+
+	flags.RemoveOutboundPause(common.GoerliChain().ChainId)
+	_, paused = flags.GetOutboundPause(common.GoerliChain().ChainId)
+	require.False(t, paused)
+	_, paused = flags.GetOutboundPause(common.BtcRegtestChain().ChainId)
+	require.True(t, paused)
+}
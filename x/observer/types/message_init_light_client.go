@@ -0,0 +1,55 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgInitLightClient = "init_light_client"
+
+var _ sdk.Msg = &MsgInitLightClient{}
+
+func NewMsgInitLightClient(creator string, chainID int64, bootstrap LightClientBootstrap) *MsgInitLightClient {
+	return &MsgInitLightClient{
+		Creator:   creator,
+		ChainId:   chainID,
+		Bootstrap: bootstrap,
+	}
+}
+
+func (msg *MsgInitLightClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgInitLightClient) Type() string {
+	return TypeMsgInitLightClient
+}
+
+func (msg *MsgInitLightClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgInitLightClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgInitLightClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := common.BeaconConfigFromChainID(msg.ChainId); err != nil {
+		return cosmoserrors.Wrapf(ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+	if err := msg.Bootstrap.ValidateBasic(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	return nil
+}
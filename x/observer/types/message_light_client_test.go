@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/beacon"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// lightClientSlot is the first slot of the sync committee period 869 of Sepolia
+const lightClientSlot = 869 * beacon.SlotsPerEpoch * beacon.EpochsPerSyncCommitteePeriod
+
+func TestMsgInitLightClient_ValidateBasic(t *testing.T) {
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+	bootstrap := fixture.Bootstrap(t, fixture.Header(t, lightClientSlot, 1000, sample.Hash().Bytes()))
+	invalidBootstrap := bootstrap
+	invalidBootstrap.CurrentSyncCommittee.Pubkeys = bootstrap.CurrentSyncCommittee.Pubkeys[1:]
+
+	tests := []struct {
+		name string
+		msg  *types.MsgInitLightClient
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgInitLightClient("invalid_address", common.SepoliaChain().ChainId, bootstrap),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "chain without beacon chain",
+			msg:  types.NewMsgInitLightClient(sample.AccAddress(), common.BtcMainnetChain().ChainId, bootstrap),
+			err:  types.ErrSupportedChains,
+		},
+		{
+			name: "invalid sync committee size",
+			msg:  types.NewMsgInitLightClient(sample.AccAddress(), common.SepoliaChain().ChainId, invalidBootstrap),
+			err:  types.ErrInvalidLightClientUpdate,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgInitLightClient(sample.AccAddress(), common.SepoliaChain().ChainId, bootstrap),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitLightClientUpdate_ValidateBasic(t *testing.T) {
+	fixture := sample.NewLightClientFixture(&beacon.SepoliaConfig)
+	finalized := fixture.Header(t, lightClientSlot+64, 1064, sample.Hash().Bytes())
+	update := fixture.Update(t, lightClientSlot+128, finalized, false, beacon.SyncCommitteeSize)
+	invalidSignatureSlot := update
+	invalidSignatureSlot.SignatureSlot = update.AttestedHeader.Beacon.Slot
+	invalidBits := update
+	invalidBits.SyncAggregate.SyncCommitteeBits = update.SyncAggregate.SyncCommitteeBits[1:]
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSubmitLightClientUpdate
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgSubmitLightClientUpdate("invalid_address", common.SepoliaChain().ChainId, update),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "chain without beacon chain",
+			msg:  types.NewMsgSubmitLightClientUpdate(sample.AccAddress(), common.BtcMainnetChain().ChainId, update),
+			err:  types.ErrSupportedChains,
+		},
+		{
+			name: "signature slot not after attested slot",
+			msg:  types.NewMsgSubmitLightClientUpdate(sample.AccAddress(), common.SepoliaChain().ChainId, invalidSignatureSlot),
+			err:  types.ErrInvalidLightClientUpdate,
+		},
+		{
+			name: "invalid sync committee bits",
+			msg:  types.NewMsgSubmitLightClientUpdate(sample.AccAddress(), common.SepoliaChain().ChainId, invalidBits),
+			err:  types.ErrInvalidLightClientUpdate,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgSubmitLightClientUpdate(sample.AccAddress(), common.SepoliaChain().ChainId, update),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgSubmitLightClientUpdate = "submit_light_client_update"
+
+var _ sdk.Msg = &MsgSubmitLightClientUpdate{}
+
+func NewMsgSubmitLightClientUpdate(creator string, chainID int64, update LightClientUpdate) *MsgSubmitLightClientUpdate {
+	return &MsgSubmitLightClientUpdate{
+		Creator: creator,
+		ChainId: chainID,
+		Update:  update,
+	}
+}
+
+func (msg *MsgSubmitLightClientUpdate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitLightClientUpdate) Type() string {
+	return TypeMsgSubmitLightClientUpdate
+}
+
+func (msg *MsgSubmitLightClientUpdate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitLightClientUpdate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitLightClientUpdate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := common.BeaconConfigFromChainID(msg.ChainId); err != nil {
+		return cosmoserrors.Wrapf(ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+	if err := msg.Update.ValidateBasic(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLightClientUpdate, err.Error())
+	}
+	return nil
+}
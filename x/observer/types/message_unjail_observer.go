@@ -0,0 +1,51 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgUnjailObserver = "unjail_observer"
+
+var _ sdk.Msg = &MsgUnjailObserver{}
+
+func NewMsgUnjailObserver(creator string, chainID int64) *MsgUnjailObserver {
+	return &MsgUnjailObserver{
+		Creator: creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgUnjailObserver) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnjailObserver) Type() string {
+	return TypeMsgUnjailObserver
+}
+
+func (msg *MsgUnjailObserver) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnjailObserver) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnjailObserver) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if common.GetChainFromChainID(msg.ChainId) == nil {
+		return cosmoserrors.Wrapf(ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+	return nil
+}
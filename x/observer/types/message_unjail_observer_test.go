@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgUnjailObserver_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgUnjailObserver
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgUnjailObserver{
+				Creator: "invalid_address",
+				ChainId: common.GoerliLocalnetChain().ChainId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg: types.MsgUnjailObserver{
+				Creator: sample.AccAddress(),
+				ChainId: 42,
+			},
+			err: types.ErrSupportedChains,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgUnjailObserver{
+				Creator: sample.AccAddress(),
+				ChainId: common.GoerliLocalnetChain().ChainId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
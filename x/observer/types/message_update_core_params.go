@@ -84,11 +84,35 @@ func ValidateCoreParams(params *CoreParams) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "OutboundTxScheduleLookahead %d out of range", params.OutboundTxScheduleLookahead)
 	}
 
+	if err := ValidateConfirmationTiers(params.ConfirmationTiers); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ConfirmationTiers: %s", err)
+	}
+
 	// chain type specific checks
 	if common.IsBitcoinChain(params.ChainId) {
 		if params.WatchUtxoTicker == 0 || params.WatchUtxoTicker > 300 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "WatchUtxoTicker %d out of range", params.WatchUtxoTicker)
 		}
+		for _, tier := range params.ConfirmationTiers {
+			if tier.CoinType != common.CoinType_Gas {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ConfirmationTiers: coin type %s not supported by bitcoin", tier.CoinType)
+			}
+		}
+		// the difficulty of a block header is validated against the block headers of the last retarget interval
+		netParams, err := common.BitcoinNetParamsFromChainID(params.ChainId)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ChainId: %s", err)
+		}
+		// #nosec G701 always positive
+		blocksPerRetarget := uint64(common.BitcoinBlocksPerRetarget(netParams))
+		if params.BlockHeaderRetention != 0 && params.BlockHeaderRetention < blocksPerRetarget {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"BlockHeaderRetention %d below the %d blocks of a retarget interval",
+				params.BlockHeaderRetention,
+				blocksPerRetarget,
+			)
+		}
 	}
 	if common.IsEVMChain(params.ChainId) {
 		if !validCoreContractAddress(params.ZetaTokenContractAddress) {
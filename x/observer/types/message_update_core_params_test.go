@@ -3,8 +3,10 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/zeta-chain/zetacore/common"
 	. "gopkg.in/check.v1"
 )
 
@@ -68,6 +70,45 @@ func (s *UpdateCoreParamsSuite) TestBTCParams() {
 	require.NotNil(s.T(), err)
 }
 
+func (s *UpdateCoreParamsSuite) TestBlockHeaderRetention() {
+	copy := *s.evmParams
+	copy.BlockHeaderRetention = 100
+	err := ValidateCoreParams(&copy)
+	require.Nil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.BlockHeaderRetention = 2016
+	err = ValidateCoreParams(&copy)
+	require.Nil(s.T(), err)
+
+	copy.BlockHeaderRetention = 2015
+	err = ValidateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
+func (s *UpdateCoreParamsSuite) TestConfirmationTiers() {
+	copy := *s.evmParams
+	copy.ConfirmationTiers = []ConfirmationTier{
+		NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(10), 12),
+		NewConfirmationTier(common.CoinType_ERC20, "0xD28D6A0b8189305551a0A8bd247a6ECa9CE781Ca", sdk.NewDec(10000), 12),
+	}
+	err := ValidateCoreParams(&copy)
+	require.Nil(s.T(), err)
+
+	copy.ConfirmationTiers = append(copy.ConfirmationTiers, NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(10), 20))
+	err = ValidateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.ConfirmationTiers = []ConfirmationTier{NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(2), 6)}
+	err = ValidateCoreParams(&copy)
+	require.Nil(s.T(), err)
+
+	copy.ConfirmationTiers = []ConfirmationTier{NewConfirmationTier(common.CoinType_Zeta, "", sdk.NewDec(2), 6)}
+	err = ValidateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
 func (s *UpdateCoreParamsSuite) TestCoreContractAddresses() {
 	copy := *s.evmParams
 	copy.ZetaTokenContractAddress = "0x123"
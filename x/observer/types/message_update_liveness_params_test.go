@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgUpdateLivenessParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateLivenessParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateLivenessParams{
+				Creator:        "invalid_address",
+				LivenessParams: types.DefaultLivenessParams(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "max missed votes greater than window",
+			msg: types.MsgUpdateLivenessParams{
+				Creator: sample.AccAddress(),
+				LivenessParams: types.LivenessParams{
+					WindowSize:     10,
+					MaxMissedVotes: 11,
+				},
+			},
+			err: types.ErrInvalidLivenessParams,
+		},
+		{
+			name: "negative jail duration",
+			msg: types.MsgUpdateLivenessParams{
+				Creator: sample.AccAddress(),
+				LivenessParams: types.LivenessParams{
+					WindowSize:   10,
+					JailDuration: -1,
+				},
+			},
+			err: types.ErrInvalidLivenessParams,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgUpdateLivenessParams{
+				Creator:        sample.AccAddress(),
+				LivenessParams: types.DefaultLivenessParams(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const (
+	TypeMsgUpdateOutboundPause = "update_outbound_pause"
+
+	// MaxOutboundPauseReasonLength is the maximum length of the reason of an outbound pause
+	MaxOutboundPauseReasonLength = 256
+)
+
+var _ sdk.Msg = &MsgUpdateOutboundPause{}
+
+func NewMsgUpdateOutboundPause(creator string, chainID int64, paused bool, reason string) *MsgUpdateOutboundPause {
+	return &MsgUpdateOutboundPause{
+		Creator: creator,
+		ChainId: chainID,
+		Paused:  paused,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgUpdateOutboundPause) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateOutboundPause) Type() string {
+	return TypeMsgUpdateOutboundPause
+}
+
+func (msg *MsgUpdateOutboundPause) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateOutboundPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateOutboundPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	chain := common.GetChainFromChainID(msg.ChainId)
+	if chain == nil || chain.IsZetaChain() {
+		return cosmoserrors.Wrapf(ErrSupportedChains, "chain id (%d)", msg.ChainId)
+	}
+	if msg.Paused && msg.Reason == "" {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "pause reason is empty")
+	}
+	if len(msg.Reason) > MaxOutboundPauseReasonLength {
+		return cosmoserrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("pause reason longer than %d bytes", MaxOutboundPauseReasonLength),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgUpdateOutboundPause_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateOutboundPause
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateOutboundPause("invalid_address", common.GoerliChain().ChainId, true, "reason"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgUpdateOutboundPause(sample.AccAddress(), 42, true, "reason"),
+			err:  types.ErrSupportedChains,
+		},
+		{
+			name: "zeta chain",
+			msg:  types.NewMsgUpdateOutboundPause(sample.AccAddress(), common.ZetaPrivnetChain().ChainId, true, "reason"),
+			err:  types.ErrSupportedChains,
+		},
+		{
+			name: "pause without reason",
+			msg:  types.NewMsgUpdateOutboundPause(sample.AccAddress(), common.GoerliChain().ChainId, true, ""),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "reason too long",
+			msg: types.NewMsgUpdateOutboundPause(
+				sample.AccAddress(),
+				common.GoerliChain().ChainId,
+				true,
+				strings.Repeat("a", types.MaxOutboundPauseReasonLength+1),
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid pause",
+			msg:  types.NewMsgUpdateOutboundPause(sample.AccAddress(), common.GoerliChain().ChainId, true, "reason"),
+		},
+		{
+			name: "valid resume without reason",
+			msg:  types.NewMsgUpdateOutboundPause(sample.AccAddress(), common.BtcMainnetChain().ChainId, false, ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -107,6 +107,10 @@ type CoreParams struct {
 	ChainId                     int64  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	OutboundTxScheduleInterval  int64  `protobuf:"varint,12,opt,name=outbound_tx_schedule_interval,json=outboundTxScheduleInterval,proto3" json:"outbound_tx_schedule_interval,omitempty"`
 	OutboundTxScheduleLookahead int64  `protobuf:"varint,13,opt,name=outbound_tx_schedule_lookahead,json=outboundTxScheduleLookahead,proto3" json:"outbound_tx_schedule_lookahead,omitempty"`
+	// confirmation counts of the transfers from an amount, the confirmation_count is used below the lowest amount
+	ConfirmationTiers []ConfirmationTier `protobuf:"bytes,14,rep,name=confirmation_tiers,json=confirmationTiers,proto3" json:"confirmation_tiers"`
+	// number of the latest block heights of the chain whose block headers are kept, 0 keeps all the block headers
+	BlockHeaderRetention uint64 `protobuf:"varint,15,opt,name=block_header_retention,json=blockHeaderRetention,proto3" json:"block_header_retention,omitempty"`
 }
 
 func (m *CoreParams) Reset()         { *m = CoreParams{} }
@@ -219,6 +223,83 @@ func (m *CoreParams) GetOutboundTxScheduleLookahead() int64 {
 	return 0
 }
 
+func (m *CoreParams) GetConfirmationTiers() []ConfirmationTier {
+	if m != nil {
+		return m.ConfirmationTiers
+	}
+	return nil
+}
+
+func (m *CoreParams) GetBlockHeaderRetention() uint64 {
+	if m != nil {
+		return m.BlockHeaderRetention
+	}
+	return 0
+}
+
+type ConfirmationTier struct {
+	CoinType common.CoinType `protobuf:"varint,1,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	// ERC20 contract address of the asset for ERC20, empty for Gas and Zeta
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// amount in units of the asset, normalized with the decimals of its ZRC20
+	AmountThreshold   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=amount_threshold,json=amountThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount_threshold"`
+	ConfirmationCount uint64                                 `protobuf:"varint,4,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
+}
+
+func (m *ConfirmationTier) Reset()         { *m = ConfirmationTier{} }
+func (m *ConfirmationTier) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationTier) ProtoMessage()    {}
+func (*ConfirmationTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4542fa62877488a1, []int{2}
+}
+func (m *ConfirmationTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmationTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationTier.Merge(m, src)
+}
+func (m *ConfirmationTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationTier proto.InternalMessageInfo
+
+func (m *ConfirmationTier) GetCoinType() common.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return common.CoinType_Zeta
+}
+
+func (m *ConfirmationTier) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *ConfirmationTier) GetConfirmationCount() uint64 {
+	if m != nil {
+		return m.ConfirmationCount
+	}
+	return 0
+}
+
 type ObserverParams struct {
 	Chain                 *common.Chain                          `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 	BallotThreshold       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
@@ -230,7 +311,7 @@ func (m *ObserverParams) Reset()         { *m = ObserverParams{} }
 func (m *ObserverParams) String() string { return proto.CompactTextString(m) }
 func (*ObserverParams) ProtoMessage()    {}
 func (*ObserverParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{2}
+	return fileDescriptor_4542fa62877488a1, []int{3}
 }
 func (m *ObserverParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +363,7 @@ func (m *Admin_Policy) Reset()         { *m = Admin_Policy{} }
 func (m *Admin_Policy) String() string { return proto.CompactTextString(m) }
 func (*Admin_Policy) ProtoMessage()    {}
 func (*Admin_Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{3}
+	return fileDescriptor_4542fa62877488a1, []int{4}
 }
 func (m *Admin_Policy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +416,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{4}
+	return fileDescriptor_4542fa62877488a1, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +470,7 @@ func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.Policy_Type", Policy_Type_name, Policy_Type_value)
 	proto.RegisterType((*CoreParamsList)(nil), "zetachain.zetacore.observer.CoreParamsList")
 	proto.RegisterType((*CoreParams)(nil), "zetachain.zetacore.observer.CoreParams")
+	proto.RegisterType((*ConfirmationTier)(nil), "zetachain.zetacore.observer.ConfirmationTier")
 	proto.RegisterType((*ObserverParams)(nil), "zetachain.zetacore.observer.ObserverParams")
 	proto.RegisterType((*Admin_Policy)(nil), "zetachain.zetacore.observer.Admin_Policy")
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.observer.Params")
@@ -397,57 +479,63 @@ func init() {
 func init() { proto.RegisterFile("observer/params.proto", fileDescriptor_4542fa62877488a1) }
 
 var fileDescriptor_4542fa62877488a1 = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x89, 0x9b, 0x26, 0x6f, 0x1d, 0x27, 0x5d, 0x5a, 0xba, 0x38, 0xc2, 0x31, 0x46,
-	0x02, 0xd3, 0x2a, 0x36, 0x18, 0x4e, 0x08, 0x0e, 0x89, 0x7b, 0x20, 0x52, 0x10, 0xd1, 0xd6, 0x1c,
-	0xe8, 0x65, 0x34, 0x9e, 0x9d, 0xda, 0x23, 0xaf, 0xf7, 0xad, 0x66, 0x66, 0x8b, 0xcd, 0x5f, 0xc1,
-	0x11, 0x89, 0x0b, 0x07, 0x0e, 0xfc, 0x11, 0xfc, 0x01, 0x3d, 0xf6, 0x88, 0x38, 0x54, 0x28, 0xb9,
-	0xf0, 0x67, 0xa0, 0x7d, 0xfb, 0xa3, 0x4e, 0x82, 0x22, 0xf5, 0xe4, 0xb7, 0xf3, 0x3e, 0xef, 0x3b,
-	0x6f, 0xde, 0x7c, 0x3d, 0xf0, 0x00, 0x27, 0x46, 0xea, 0x17, 0x52, 0x0f, 0x12, 0xae, 0xf9, 0xc2,
-	0xf4, 0x13, 0x8d, 0x16, 0xbd, 0x83, 0x9f, 0xa4, 0xe5, 0x62, 0xc6, 0x55, 0xdc, 0xa7, 0x08, 0xb5,
-	0xec, 0x97, 0x64, 0xeb, 0x1d, 0x81, 0x8b, 0x05, 0xc6, 0x83, 0xfc, 0x27, 0xaf, 0x68, 0xdd, 0x9f,
-	0xe2, 0x14, 0x29, 0x1c, 0x64, 0x51, 0xb1, 0xfa, 0xb0, 0x92, 0x2f, 0x83, 0x3c, 0xd1, 0x7d, 0x06,
-	0xcd, 0x11, 0x6a, 0x79, 0x4e, 0x9b, 0x9e, 0x29, 0x63, 0xbd, 0x6f, 0xc0, 0xcd, 0xb6, 0x61, 0x79,
-	0x1f, 0xbe, 0xd3, 0xd9, 0xec, 0xb9, 0xc3, 0x8f, 0xfb, 0xb7, 0x34, 0xd2, 0x7f, 0xa3, 0x10, 0x80,
-	0xa8, 0xe2, 0xee, 0x9f, 0x75, 0x80, 0x37, 0x29, 0xef, 0x08, 0x3c, 0x81, 0xf1, 0x73, 0xa5, 0x17,
-	0xdc, 0x2a, 0x8c, 0x99, 0xc0, 0x34, 0xb6, 0xbe, 0xd3, 0x71, 0x7a, 0xf5, 0xe0, 0xde, 0x7a, 0x66,
-	0x94, 0x25, 0xbc, 0x1e, 0xec, 0x4f, 0xb9, 0x61, 0x89, 0x56, 0x42, 0x32, 0xab, 0xc4, 0x5c, 0x6a,
-	0x7f, 0x83, 0xe0, 0xe6, 0x94, 0x9b, 0xf3, 0x6c, 0x79, 0x4c, 0xab, 0x5e, 0x07, 0x1a, 0x2a, 0x66,
-	0x76, 0x59, 0x52, 0x9b, 0x44, 0x81, 0x8a, 0xc7, 0xcb, 0x82, 0xe8, 0xc2, 0x2e, 0xa6, 0x76, 0x0d,
-	0xa9, 0x13, 0xe2, 0x62, 0x6a, 0x2b, 0xe6, 0x11, 0xdc, 0xfb, 0x91, 0x5b, 0x31, 0x63, 0xa9, 0x5d,
-	0x62, 0xc9, 0xdd, 0x21, 0x6e, 0x8f, 0x12, 0xdf, 0xdb, 0x25, 0x16, 0xec, 0xd7, 0x40, 0x17, 0xc3,
-	0x2c, 0xce, 0x65, 0x76, 0x90, 0xd8, 0x6a, 0x2e, 0x2c, 0xe3, 0x61, 0xa8, 0xa5, 0x31, 0xfe, 0x76,
-	0xc7, 0xe9, 0xed, 0x04, 0x7e, 0x86, 0x8c, 0x33, 0x62, 0x54, 0x00, 0xc7, 0x79, 0xde, 0xfb, 0x0a,
-	0x5a, 0x02, 0xe3, 0x58, 0x0a, 0x8b, 0xfa, 0x66, 0xf5, 0x4e, 0x5e, 0x5d, 0x11, 0xd7, 0xab, 0x47,
-	0xd0, 0x96, 0x5a, 0x0c, 0x3f, 0x65, 0x22, 0x35, 0x16, 0xc3, 0xd5, 0x4d, 0x05, 0x20, 0x85, 0x03,
-	0xa2, 0x46, 0x39, 0x74, 0x5d, 0xe4, 0x3d, 0xd8, 0xa6, 0xdb, 0x64, 0x2a, 0xf4, 0xdd, 0x8e, 0xd3,
-	0xdb, 0x0c, 0xee, 0xd2, 0xf7, 0x69, 0xe8, 0x1d, 0xc3, 0xfb, 0x98, 0xda, 0x09, 0xa6, 0x71, 0x98,
-	0x4d, 0xcc, 0x88, 0x99, 0x0c, 0xd3, 0x48, 0x32, 0x15, 0x5b, 0xa9, 0x5f, 0xf0, 0xc8, 0x6f, 0x10,
-	0xdf, 0x2a, 0xa1, 0xf1, 0xf2, 0x69, 0x81, 0x9c, 0x16, 0x44, 0xd6, 0xe2, 0xff, 0x4a, 0x44, 0x88,
-	0x73, 0x3e, 0x93, 0x3c, 0xf4, 0x77, 0x49, 0xe3, 0xe0, 0xa6, 0xc6, 0x59, 0x89, 0x74, 0x7f, 0xdd,
-	0x80, 0xe6, 0x77, 0x85, 0xc5, 0x0a, 0x0b, 0x7d, 0x08, 0x77, 0xa8, 0x4b, 0x72, 0x8d, 0x3b, 0xdc,
-	0xed, 0x17, 0xd6, 0x1f, 0x65, 0x8b, 0x41, 0x9e, 0xf3, 0x7e, 0x80, 0xfd, 0x09, 0x8f, 0x22, 0xb4,
-	0xcc, 0xce, 0xb4, 0x34, 0x33, 0x8c, 0x42, 0xb2, 0xc4, 0xce, 0x49, 0xff, 0xe5, 0xeb, 0xc3, 0xda,
-	0xdf, 0xaf, 0x0f, 0x3f, 0x9a, 0x2a, 0x3b, 0x4b, 0x27, 0x59, 0xf5, 0x40, 0xa0, 0x59, 0xa0, 0x29,
-	0x7e, 0x8e, 0x4c, 0x38, 0x1f, 0xd8, 0x55, 0x22, 0x4d, 0xff, 0x89, 0x14, 0xc1, 0x5e, 0xae, 0x33,
-	0x2e, 0x65, 0xbc, 0xe7, 0xf0, 0x70, 0xa1, 0x62, 0x56, 0x1a, 0x9f, 0x85, 0x32, 0x92, 0x53, 0xf2,
-	0x2c, 0x39, 0xea, 0xed, 0x77, 0x78, 0xb0, 0x50, 0x71, 0x79, 0xc6, 0x27, 0x95, 0x98, 0xf7, 0x01,
-	0x34, 0x94, 0x61, 0x26, 0x4d, 0x12, 0xd4, 0x56, 0x86, 0x64, 0xc3, 0xed, 0xc0, 0x55, 0xe6, 0x69,
-	0xb9, 0xd4, 0x35, 0xd0, 0x38, 0x0e, 0xb3, 0x66, 0xce, 0x31, 0x52, 0x62, 0xe5, 0x9d, 0x82, 0x9b,
-	0x50, 0xc4, 0x32, 0x75, 0x1a, 0x50, 0x73, 0xd8, 0xbb, 0xf5, 0x6f, 0x9b, 0x57, 0xb2, 0xf1, 0x2a,
-	0x91, 0x01, 0xe4, 0xc5, 0x59, 0xec, 0xf9, 0x70, 0xb7, 0x74, 0xd2, 0x06, 0x39, 0xa9, 0xfc, 0xec,
-	0xfe, 0xeb, 0xc0, 0x56, 0x71, 0x15, 0x63, 0xd8, 0xab, 0xc6, 0x70, 0xe5, 0xa9, 0x78, 0x7c, 0xeb,
-	0x9e, 0x57, 0x2f, 0x34, 0x68, 0xe2, 0xd5, 0x0b, 0x3e, 0x83, 0x06, 0xa7, 0x53, 0xe5, 0xed, 0xf8,
-	0x1b, 0x24, 0xf9, 0xc9, 0xad, 0x92, 0xeb, 0x63, 0x08, 0x5c, 0x2a, 0x2f, 0x66, 0xf2, 0x05, 0xbc,
-	0x5b, 0x38, 0x61, 0xc1, 0x6d, 0xaa, 0x95, 0x5d, 0xb1, 0x49, 0x84, 0x62, 0x6e, 0xc8, 0x0f, 0x9b,
-	0xc1, 0xfd, 0x3c, 0xfb, 0x6d, 0x91, 0x3c, 0xa1, 0xdc, 0x97, 0xf5, 0x5f, 0x7e, 0x3b, 0xac, 0x3d,
-	0x7a, 0x0c, 0xee, 0xda, 0x7c, 0x3c, 0x80, 0xad, 0xa9, 0xc6, 0x34, 0xf9, 0x6c, 0xbf, 0x56, 0xc5,
-	0xc3, 0x7d, 0xa7, 0x55, 0xff, 0xe3, 0xf7, 0xb6, 0x73, 0x72, 0xfa, 0xf2, 0xa2, 0xed, 0xbc, 0xba,
-	0x68, 0x3b, 0xff, 0x5c, 0xb4, 0x9d, 0x9f, 0x2f, 0xdb, 0xb5, 0x57, 0x97, 0xed, 0xda, 0x5f, 0x97,
-	0xed, 0xda, 0xb3, 0xc1, 0x9a, 0x11, 0xb2, 0xd6, 0x8f, 0xe8, 0x14, 0x83, 0xf2, 0x14, 0x83, 0x65,
-	0xf5, 0x20, 0xe7, 0xae, 0x98, 0x6c, 0xd1, 0xbb, 0xfc, 0xf9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0x57, 0xd8, 0xba, 0x11, 0x06, 0x00, 0x00,
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x4e, 0x1a, 0x3f, 0x3b, 0x8e, 0xbb, 0xa4, 0x74, 0x71, 0x84, 0x63, 0x8c, 0x04,
+	0xa6, 0x95, 0x6d, 0x30, 0x9c, 0x10, 0x1c, 0x12, 0xf7, 0xd0, 0x48, 0x41, 0x44, 0x5b, 0x73, 0xa0,
+	0x97, 0xd1, 0x78, 0x76, 0x6a, 0x8f, 0x6c, 0xef, 0x58, 0x33, 0xb3, 0xc5, 0xe6, 0x57, 0x70, 0x44,
+	0xe2, 0xc2, 0x81, 0x03, 0x3f, 0xa5, 0xc7, 0x9e, 0x10, 0xe2, 0x50, 0x41, 0x72, 0xe1, 0x67, 0xa0,
+	0x79, 0xb3, 0xbb, 0x71, 0x12, 0x1a, 0x24, 0xd4, 0xd3, 0xbe, 0x9d, 0xef, 0x7b, 0xdf, 0xcc, 0x9b,
+	0xf7, 0xbd, 0x5d, 0xb8, 0x27, 0x47, 0x9a, 0xab, 0xe7, 0x5c, 0xf5, 0x16, 0x54, 0xd1, 0xb9, 0xee,
+	0x2e, 0x94, 0x34, 0xd2, 0x3f, 0xf8, 0x9e, 0x1b, 0xca, 0x26, 0x54, 0xc4, 0x5d, 0x8c, 0xa4, 0xe2,
+	0xdd, 0x8c, 0x59, 0x7f, 0x8b, 0xc9, 0xf9, 0x5c, 0xc6, 0x3d, 0xf7, 0x70, 0x19, 0xf5, 0xfd, 0xb1,
+	0x1c, 0x4b, 0x0c, 0x7b, 0x36, 0x4a, 0x57, 0xef, 0xe7, 0xf2, 0x59, 0xe0, 0x80, 0xd6, 0x53, 0xa8,
+	0x0e, 0xa4, 0xe2, 0x67, 0xb8, 0xe9, 0xa9, 0xd0, 0xc6, 0x7f, 0x0c, 0x65, 0xbb, 0x0d, 0x71, 0xe7,
+	0x08, 0xbc, 0xe6, 0x66, 0xbb, 0xdc, 0xff, 0xb0, 0x7b, 0xcb, 0x41, 0xba, 0x97, 0x0a, 0x21, 0xb0,
+	0x3c, 0x6e, 0xfd, 0xb6, 0x05, 0x70, 0x09, 0xf9, 0x1d, 0xf0, 0x99, 0x8c, 0x9f, 0x09, 0x35, 0xa7,
+	0x46, 0xc8, 0x98, 0x30, 0x99, 0xc4, 0x26, 0xf0, 0x9a, 0x5e, 0xbb, 0x18, 0xde, 0x5d, 0x47, 0x06,
+	0x16, 0xf0, 0xdb, 0x50, 0x1b, 0x53, 0x4d, 0x16, 0x4a, 0x30, 0x4e, 0x8c, 0x60, 0x53, 0xae, 0x82,
+	0x0d, 0x24, 0x57, 0xc7, 0x54, 0x9f, 0xd9, 0xe5, 0x21, 0xae, 0xfa, 0x4d, 0xa8, 0x88, 0x98, 0x98,
+	0x65, 0xc6, 0xda, 0x44, 0x16, 0x88, 0x78, 0xb8, 0x4c, 0x19, 0x2d, 0xd8, 0x95, 0x89, 0x59, 0xa3,
+	0x14, 0x91, 0x52, 0x96, 0x89, 0xc9, 0x39, 0x0f, 0xe0, 0xee, 0x77, 0xd4, 0xb0, 0x09, 0x49, 0xcc,
+	0x52, 0x66, 0xbc, 0x2d, 0xe4, 0xed, 0x21, 0xf0, 0x8d, 0x59, 0xca, 0x94, 0xfb, 0x25, 0x60, 0x63,
+	0x88, 0x91, 0x53, 0x6e, 0x0b, 0x89, 0x8d, 0xa2, 0xcc, 0x10, 0x1a, 0x45, 0x8a, 0x6b, 0x1d, 0xec,
+	0x34, 0xbd, 0x76, 0x29, 0x0c, 0x2c, 0x65, 0x68, 0x19, 0x83, 0x94, 0x70, 0xe4, 0x70, 0xff, 0x0b,
+	0xa8, 0x33, 0x19, 0xc7, 0x9c, 0x19, 0xa9, 0x6e, 0x66, 0x97, 0x5c, 0x76, 0xce, 0xb8, 0x9e, 0x3d,
+	0x80, 0x06, 0x57, 0xac, 0xff, 0x31, 0x61, 0x89, 0x36, 0x32, 0x5a, 0xdd, 0x54, 0x00, 0x54, 0x38,
+	0x40, 0xd6, 0xc0, 0x91, 0xae, 0x8b, 0xbc, 0x03, 0x3b, 0xd8, 0x4d, 0x22, 0xa2, 0xa0, 0xdc, 0xf4,
+	0xda, 0x9b, 0xe1, 0x1d, 0x7c, 0x3f, 0x89, 0xfc, 0x23, 0x78, 0x57, 0x26, 0x66, 0x24, 0x93, 0x38,
+	0xb2, 0x37, 0xa6, 0xd9, 0x84, 0x47, 0xc9, 0x8c, 0x13, 0x11, 0x1b, 0xae, 0x9e, 0xd3, 0x59, 0x50,
+	0x41, 0x7e, 0x3d, 0x23, 0x0d, 0x97, 0x4f, 0x52, 0xca, 0x49, 0xca, 0xb0, 0x47, 0xfc, 0x57, 0x89,
+	0x99, 0x94, 0x53, 0x3a, 0xe1, 0x34, 0x0a, 0x76, 0x51, 0xe3, 0xe0, 0xa6, 0xc6, 0x69, 0x46, 0xf1,
+	0x47, 0xd7, 0xfc, 0x62, 0x04, 0x57, 0x3a, 0xa8, 0xa2, 0x1f, 0x3b, 0xff, 0xe1, 0xc7, 0xcb, 0xb4,
+	0xa1, 0xe0, 0xea, 0xb8, 0xf8, 0xe2, 0xd5, 0x61, 0xe1, 0xaa, 0xc9, 0xec, 0xba, 0xf6, 0x3f, 0x83,
+	0xb7, 0x47, 0x33, 0xc9, 0xa6, 0xc4, 0xee, 0xc8, 0x15, 0x51, 0xdc, 0xf0, 0xd8, 0xc2, 0xc1, 0x1e,
+	0x76, 0x7e, 0x1f, 0xd1, 0xc7, 0x08, 0x86, 0x19, 0xd6, 0xfa, 0xcb, 0x83, 0xda, 0xf5, 0x3d, 0xfc,
+	0x0e, 0x94, 0x98, 0xb4, 0x3e, 0x5c, 0x2d, 0x38, 0xba, 0xba, 0xda, 0xaf, 0x75, 0xd3, 0xd1, 0x1c,
+	0x48, 0x11, 0x0f, 0x57, 0x0b, 0x1e, 0xee, 0xb0, 0x34, 0xf2, 0xf7, 0x61, 0x8b, 0x6a, 0xcd, 0x0d,
+	0x7a, 0xba, 0x14, 0xba, 0x17, 0xff, 0x5b, 0xa8, 0xd1, 0xb9, 0xb5, 0x3f, 0x31, 0x13, 0xc5, 0xf5,
+	0x44, 0xce, 0x22, 0xb4, 0x73, 0xe9, 0xb8, 0x6b, 0x4b, 0xf8, 0xe3, 0xd5, 0xe1, 0x07, 0x63, 0x61,
+	0x26, 0xc9, 0xc8, 0x2a, 0xf7, 0x98, 0xd4, 0x73, 0xa9, 0xd3, 0x47, 0x47, 0x47, 0xd3, 0x9e, 0xdd,
+	0x5c, 0x77, 0x1f, 0x71, 0x16, 0xee, 0x39, 0x9d, 0x61, 0x26, 0xf3, 0x9a, 0xf1, 0x2b, 0xbe, 0x66,
+	0xfc, 0x5a, 0x3f, 0x6d, 0x40, 0xf5, 0xeb, 0xf4, 0x42, 0xd3, 0x01, 0x7e, 0x1f, 0xb6, 0xf0, 0xc6,
+	0xb1, 0xba, 0x72, 0x7f, 0x37, 0xaf, 0xce, 0x2e, 0x86, 0x0e, 0xb3, 0x15, 0x8c, 0xe8, 0x6c, 0x26,
+	0xdf, 0x40, 0x05, 0x4e, 0xe7, 0xb2, 0x82, 0x67, 0x70, 0x7f, 0x2e, 0x62, 0x92, 0xb5, 0x99, 0x44,
+	0x7c, 0xc6, 0xc7, 0x78, 0xe4, 0xa0, 0xf8, 0xbf, 0x76, 0xb8, 0x37, 0x17, 0x71, 0x56, 0xe3, 0xa3,
+	0x5c, 0xcc, 0x7f, 0x0f, 0x2a, 0x42, 0x13, 0x9d, 0x2c, 0x16, 0x52, 0x19, 0x1e, 0xe1, 0x47, 0x60,
+	0x27, 0x2c, 0x0b, 0xfd, 0x24, 0x5b, 0x6a, 0x69, 0xa8, 0x1c, 0x45, 0xf6, 0x30, 0x67, 0x72, 0x26,
+	0xd8, 0xca, 0x3f, 0x81, 0xf2, 0x02, 0xa3, 0xf5, 0xf6, 0xb7, 0x6f, 0x35, 0xa9, 0xcb, 0x24, 0x68,
+	0x0b, 0x70, 0xc9, 0x36, 0xf6, 0x03, 0xb8, 0x93, 0xcd, 0xb1, 0xb3, 0x46, 0xf6, 0xda, 0xfa, 0xdb,
+	0x83, 0xed, 0xb4, 0x15, 0x43, 0xd8, 0xcb, 0xaf, 0xe1, 0xca, 0x87, 0xfa, 0xe1, 0xad, 0x7b, 0x5e,
+	0x6d, 0x68, 0x58, 0x95, 0x57, 0x1b, 0x7c, 0x0a, 0x15, 0x8a, 0x55, 0xb9, 0xe3, 0x04, 0x1b, 0x28,
+	0xf9, 0xd1, 0xad, 0x92, 0xeb, 0xd7, 0x10, 0x96, 0x31, 0x3d, 0xbd, 0x13, 0x3b, 0x5b, 0xce, 0x09,
+	0x73, 0x6a, 0x12, 0x25, 0xcc, 0x8a, 0xe0, 0x34, 0x69, 0xf4, 0xc3, 0x66, 0xb8, 0xef, 0xd0, 0xaf,
+	0x52, 0xf0, 0x18, 0xb1, 0xcf, 0x8b, 0x3f, 0xfe, 0x7c, 0x58, 0x78, 0xf0, 0x10, 0xca, 0x6b, 0xf7,
+	0xe3, 0x03, 0x6c, 0x8f, 0x95, 0x4c, 0x16, 0x9f, 0xd4, 0x0a, 0x79, 0xdc, 0xaf, 0x79, 0xf5, 0xe2,
+	0xaf, 0xbf, 0x34, 0xbc, 0xe3, 0x93, 0x17, 0xe7, 0x0d, 0xef, 0xe5, 0x79, 0xc3, 0xfb, 0xf3, 0xbc,
+	0xe1, 0xfd, 0x70, 0xd1, 0x28, 0xbc, 0xbc, 0x68, 0x14, 0x7e, 0xbf, 0x68, 0x14, 0x9e, 0xf6, 0xd6,
+	0x8c, 0x60, 0x8f, 0xde, 0xc1, 0x2a, 0x7a, 0x59, 0x15, 0xbd, 0x65, 0xfe, 0x3b, 0x74, 0xae, 0x18,
+	0x6d, 0xe3, 0x5f, 0xf1, 0xd3, 0x7f, 0x06, 0x00, 0xfe, 0x2d, 0x58, 0x1d, 0x8f, 0x07, 0x00, 0x00,
 }
 
 func (m *CoreParamsList) Marshal() (dAtA []byte, err error) {
@@ -507,6 +595,25 @@ func (m *CoreParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockHeaderRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockHeaderRetention))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for iNdEx := len(m.ConfirmationTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmationTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.OutboundTxScheduleLookahead != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.OutboundTxScheduleLookahead))
 		i--
@@ -571,6 +678,56 @@ func (m *CoreParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfirmationTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmationTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmationCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmationCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AmountThreshold.Size()
+		i -= size
+		if _, err := m.AmountThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObserverParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -795,6 +952,36 @@ func (m *CoreParams) Size() (n int) {
 	if m.OutboundTxScheduleLookahead != 0 {
 		n += 1 + sovParams(uint64(m.OutboundTxScheduleLookahead))
 	}
+	if len(m.ConfirmationTiers) > 0 {
+		for _, e := range m.ConfirmationTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BlockHeaderRetention != 0 {
+		n += 1 + sovParams(uint64(m.BlockHeaderRetention))
+	}
+	return n
+}
+
+func (m *ConfirmationTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoinType != 0 {
+		n += 1 + sovParams(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.AmountThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ConfirmationCount != 0 {
+		n += 1 + sovParams(uint64(m.ConfirmationCount))
+	}
 	return n
 }
 
@@ -1225,6 +1412,213 @@ func (m *CoreParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationTiers = append(m.ConfirmationTiers, ConfirmationTier{})
+			if err := m.ConfirmationTiers[len(m.ConfirmationTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderRetention", wireType)
+			}
+			m.BlockHeaderRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeaderRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmationTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= common.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationCount", wireType)
+			}
+			m.ConfirmationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
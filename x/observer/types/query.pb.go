@@ -2006,21 +2006,22 @@ func (m *QueryGetKeygenResponse) GetKeygen() *Keygen {
 	return nil
 }
 
-type QueryShowObserverCountRequest struct {
+type QueryGetKeygenAttemptRequest struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
-func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountRequest) ProtoMessage()    {}
-func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetKeygenAttemptRequest) Reset()         { *m = QueryGetKeygenAttemptRequest{} }
+func (m *QueryGetKeygenAttemptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeygenAttemptRequest) ProtoMessage()    {}
+func (*QueryGetKeygenAttemptRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{43}
 }
-func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetKeygenAttemptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetKeygenAttemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetKeygenAttemptRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2030,34 +2031,41 @@ func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
+func (m *QueryGetKeygenAttemptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeygenAttemptRequest.Merge(m, src)
 }
-func (m *QueryShowObserverCountRequest) XXX_Size() int {
+func (m *QueryGetKeygenAttemptRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
+func (m *QueryGetKeygenAttemptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeygenAttemptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetKeygenAttemptRequest proto.InternalMessageInfo
 
-type QueryShowObserverCountResponse struct {
-	LastObserverCount *LastObserverCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+func (m *QueryGetKeygenAttemptRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
-func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountResponse) ProtoMessage()    {}
-func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
+type QueryGetKeygenAttemptResponse struct {
+	KeygenAttempt KeygenAttempt `protobuf:"bytes,1,opt,name=keygen_attempt,json=keygenAttempt,proto3" json:"keygen_attempt"`
+}
+
+func (m *QueryGetKeygenAttemptResponse) Reset()         { *m = QueryGetKeygenAttemptResponse{} }
+func (m *QueryGetKeygenAttemptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeygenAttemptResponse) ProtoMessage()    {}
+func (*QueryGetKeygenAttemptResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{44}
 }
-func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetKeygenAttemptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetKeygenAttemptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetKeygenAttemptResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2067,41 +2075,41 @@ func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
+func (m *QueryGetKeygenAttemptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeygenAttemptResponse.Merge(m, src)
 }
-func (m *QueryShowObserverCountResponse) XXX_Size() int {
+func (m *QueryGetKeygenAttemptResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
+func (m *QueryGetKeygenAttemptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeygenAttemptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetKeygenAttemptResponse proto.InternalMessageInfo
 
-func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastObserverCount {
+func (m *QueryGetKeygenAttemptResponse) GetKeygenAttempt() KeygenAttempt {
 	if m != nil {
-		return m.LastObserverCount
+		return m.KeygenAttempt
 	}
-	return nil
+	return KeygenAttempt{}
 }
 
-type QueryBlameByIdentifierRequest struct {
-	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
+type QueryAllKeygenAttemptRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
-func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
-func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeygenAttemptRequest) Reset()         { *m = QueryAllKeygenAttemptRequest{} }
+func (m *QueryAllKeygenAttemptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeygenAttemptRequest) ProtoMessage()    {}
+func (*QueryAllKeygenAttemptRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{45}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeygenAttemptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeygenAttemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeygenAttemptRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2111,41 +2119,42 @@ func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
+func (m *QueryAllKeygenAttemptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeygenAttemptRequest.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
+func (m *QueryAllKeygenAttemptRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
+func (m *QueryAllKeygenAttemptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeygenAttemptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllKeygenAttemptRequest proto.InternalMessageInfo
 
-func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
+func (m *QueryAllKeygenAttemptRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.BlameIdentifier
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryBlameByIdentifierResponse struct {
-	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+type QueryAllKeygenAttemptResponse struct {
+	KeygenAttempt []KeygenAttempt     `protobuf:"bytes,1,rep,name=keygen_attempt,json=keygenAttempt,proto3" json:"keygen_attempt"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
-func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
-func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllKeygenAttemptResponse) Reset()         { *m = QueryAllKeygenAttemptResponse{} }
+func (m *QueryAllKeygenAttemptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeygenAttemptResponse) ProtoMessage()    {}
+func (*QueryAllKeygenAttemptResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{46}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllKeygenAttemptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllKeygenAttemptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllKeygenAttemptResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2155,41 +2164,47 @@ func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
+func (m *QueryAllKeygenAttemptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeygenAttemptResponse.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
+func (m *QueryAllKeygenAttemptResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
+func (m *QueryAllKeygenAttemptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeygenAttemptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllKeygenAttemptResponse proto.InternalMessageInfo
 
-func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
+func (m *QueryAllKeygenAttemptResponse) GetKeygenAttempt() []KeygenAttempt {
 	if m != nil {
-		return m.BlameInfo
+		return m.KeygenAttempt
 	}
 	return nil
 }
 
-type QueryAllBlameRecordsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryAllKeygenAttemptResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
-func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
-func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
+type QueryGetLivenessParamsRequest struct {
+}
+
+func (m *QueryGetLivenessParamsRequest) Reset()         { *m = QueryGetLivenessParamsRequest{} }
+func (m *QueryGetLivenessParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLivenessParamsRequest) ProtoMessage()    {}
+func (*QueryGetLivenessParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{47}
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLivenessParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLivenessParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLivenessParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2199,42 +2214,34 @@ func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+func (m *QueryGetLivenessParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLivenessParamsRequest.Merge(m, src)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+func (m *QueryGetLivenessParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
+func (m *QueryGetLivenessParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLivenessParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
-
-func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetLivenessParamsRequest proto.InternalMessageInfo
 
-type QueryAllBlameRecordsResponse struct {
-	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetLivenessParamsResponse struct {
+	LivenessParams LivenessParams `protobuf:"bytes,1,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
 }
 
-func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecordsResponse{} }
-func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
-func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetLivenessParamsResponse) Reset()         { *m = QueryGetLivenessParamsResponse{} }
+func (m *QueryGetLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLivenessParamsResponse) ProtoMessage()    {}
+func (*QueryGetLivenessParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{48}
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlameRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetLivenessParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2244,49 +2251,42 @@ func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlameRecordsResponse.Merge(m, src)
+func (m *QueryGetLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLivenessParamsResponse.Merge(m, src)
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Size() int {
+func (m *QueryGetLivenessParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlameRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlameRecordsResponse.DiscardUnknown(m)
+func (m *QueryGetLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLivenessParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlameRecordsResponse proto.InternalMessageInfo
-
-func (m *QueryAllBlameRecordsResponse) GetBlameInfo() []Blame {
-	if m != nil {
-		return m.BlameInfo
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetLivenessParamsResponse proto.InternalMessageInfo
 
-func (m *QueryAllBlameRecordsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetLivenessParamsResponse) GetLivenessParams() LivenessParams {
 	if m != nil {
-		return m.Pagination
+		return m.LivenessParams
 	}
-	return nil
+	return LivenessParams{}
 }
 
-type QueryBlameByChainAndNonceRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+type QueryGetObserverLivenessRequest struct {
+	ChainId         int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
-func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
-func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetObserverLivenessRequest) Reset()         { *m = QueryGetObserverLivenessRequest{} }
+func (m *QueryGetObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryGetObserverLivenessRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{49}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetObserverLivenessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2296,48 +2296,48 @@ func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
+func (m *QueryGetObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.Merge(m, src)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+func (m *QueryGetObserverLivenessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
+func (m *QueryGetObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetObserverLivenessRequest proto.InternalMessageInfo
 
-func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+func (m *QueryGetObserverLivenessRequest) GetChainId() int64 {
 	if m != nil {
 		return m.ChainId
 	}
 	return 0
 }
 
-func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
+func (m *QueryGetObserverLivenessRequest) GetObserverAddress() string {
 	if m != nil {
-		return m.Nonce
+		return m.ObserverAddress
 	}
-	return 0
+	return ""
 }
 
-type QueryBlameByChainAndNonceResponse struct {
-	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+type QueryGetObserverLivenessResponse struct {
+	ObserverLiveness ObserverLiveness `protobuf:"bytes,1,opt,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByChainAndNonceResponse{} }
-func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
-func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetObserverLivenessResponse) Reset()         { *m = QueryGetObserverLivenessResponse{} }
+func (m *QueryGetObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryGetObserverLivenessResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{50}
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByChainAndNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetObserverLivenessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2347,41 +2347,41 @@ func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByChainAndNonceResponse.Merge(m, src)
+func (m *QueryGetObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.Merge(m, src)
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Size() int {
+func (m *QueryGetObserverLivenessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByChainAndNonceResponse.DiscardUnknown(m)
+func (m *QueryGetObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByChainAndNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetObserverLivenessResponse proto.InternalMessageInfo
 
-func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
+func (m *QueryGetObserverLivenessResponse) GetObserverLiveness() ObserverLiveness {
 	if m != nil {
-		return m.BlameInfo
+		return m.ObserverLiveness
 	}
-	return nil
+	return ObserverLiveness{}
 }
 
-type QueryAllBlockHeaderRequest struct {
+type QueryAllObserverLivenessRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlockHeaderRequest) Reset()         { *m = QueryAllBlockHeaderRequest{} }
-func (m *QueryAllBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlockHeaderRequest) ProtoMessage()    {}
-func (*QueryAllBlockHeaderRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllObserverLivenessRequest) Reset()         { *m = QueryAllObserverLivenessRequest{} }
+func (m *QueryAllObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryAllObserverLivenessRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{51}
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlockHeaderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllObserverLivenessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2391,42 +2391,42 @@ func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlockHeaderRequest.Merge(m, src)
+func (m *QueryAllObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.Merge(m, src)
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Size() int {
+func (m *QueryAllObserverLivenessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlockHeaderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlockHeaderRequest.DiscardUnknown(m)
+func (m *QueryAllObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlockHeaderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllObserverLivenessRequest proto.InternalMessageInfo
 
-func (m *QueryAllBlockHeaderRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllObserverLivenessRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllBlockHeaderResponse struct {
-	BlockHeaders []*common.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
-	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryAllObserverLivenessResponse struct {
+	ObserverLiveness []ObserverLiveness  `protobuf:"bytes,1,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlockHeaderResponse) Reset()         { *m = QueryAllBlockHeaderResponse{} }
-func (m *QueryAllBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlockHeaderResponse) ProtoMessage()    {}
-func (*QueryAllBlockHeaderResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllObserverLivenessResponse) Reset()         { *m = QueryAllObserverLivenessResponse{} }
+func (m *QueryAllObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryAllObserverLivenessResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{52}
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlockHeaderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllObserverLivenessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2436,48 +2436,47 @@ func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlockHeaderResponse.Merge(m, src)
+func (m *QueryAllObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.Merge(m, src)
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Size() int {
+func (m *QueryAllObserverLivenessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlockHeaderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlockHeaderResponse.DiscardUnknown(m)
+func (m *QueryAllObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlockHeaderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllObserverLivenessResponse proto.InternalMessageInfo
 
-func (m *QueryAllBlockHeaderResponse) GetBlockHeaders() []*common.BlockHeader {
+func (m *QueryAllObserverLivenessResponse) GetObserverLiveness() []ObserverLiveness {
 	if m != nil {
-		return m.BlockHeaders
+		return m.ObserverLiveness
 	}
 	return nil
 }
 
-func (m *QueryAllBlockHeaderResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllObserverLivenessResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetBlockHeaderByHashRequest struct {
-	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+type QueryShowObserverCountRequest struct {
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Reset()         { *m = QueryGetBlockHeaderByHashRequest{} }
-func (m *QueryGetBlockHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderByHashRequest) ProtoMessage()    {}
-func (*QueryGetBlockHeaderByHashRequest) Descriptor() ([]byte, []int) {
+func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
+func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountRequest) ProtoMessage()    {}
+func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{53}
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2487,41 +2486,34 @@ func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Merge(m, src)
+func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Size() int {
+func (m *QueryShowObserverCountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.DiscardUnknown(m)
+func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderByHashRequest proto.InternalMessageInfo
-
-func (m *QueryGetBlockHeaderByHashRequest) GetBlockHash() []byte {
-	if m != nil {
-		return m.BlockHash
-	}
-	return nil
-}
+var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
 
-type QueryGetBlockHeaderByHashResponse struct {
-	BlockHeader *common.BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+type QueryShowObserverCountResponse struct {
+	LastObserverCount *LastObserverCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Reset()         { *m = QueryGetBlockHeaderByHashResponse{} }
-func (m *QueryGetBlockHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderByHashResponse) ProtoMessage()    {}
-func (*QueryGetBlockHeaderByHashResponse) Descriptor() ([]byte, []int) {
+func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
+func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountResponse) ProtoMessage()    {}
+func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{54}
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2531,41 +2523,41 @@ func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Merge(m, src)
+func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Size() int {
+func (m *QueryShowObserverCountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.DiscardUnknown(m)
+func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderByHashResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderByHashResponse) GetBlockHeader() *common.BlockHeader {
+func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastObserverCount {
 	if m != nil {
-		return m.BlockHeader
+		return m.LastObserverCount
 	}
 	return nil
 }
 
-type QueryGetBlockHeaderStateRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type QueryBlameByIdentifierRequest struct {
+	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
 }
 
-func (m *QueryGetBlockHeaderStateRequest) Reset()         { *m = QueryGetBlockHeaderStateRequest{} }
-func (m *QueryGetBlockHeaderStateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderStateRequest) ProtoMessage()    {}
-func (*QueryGetBlockHeaderStateRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
+func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
+func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{55}
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2575,41 +2567,41 @@ func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderStateRequest.Merge(m, src)
+func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Size() int {
+func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderStateRequest.DiscardUnknown(m)
+func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderStateRequest) GetChainId() int64 {
+func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
 	if m != nil {
-		return m.ChainId
+		return m.BlameIdentifier
 	}
-	return 0
+	return ""
 }
 
-type QueryGetBlockHeaderStateResponse struct {
-	BlockHeaderState *BlockHeaderState `protobuf:"bytes,1,opt,name=block_header_state,json=blockHeaderState,proto3" json:"block_header_state,omitempty"`
+type QueryBlameByIdentifierResponse struct {
+	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
 }
 
-func (m *QueryGetBlockHeaderStateResponse) Reset()         { *m = QueryGetBlockHeaderStateResponse{} }
-func (m *QueryGetBlockHeaderStateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderStateResponse) ProtoMessage()    {}
-func (*QueryGetBlockHeaderStateResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
+func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
+func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dcb801e455adaee4, []int{56}
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2619,2332 +2611,2299 @@ func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderStateResponse.Merge(m, src)
+func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Size() int {
+func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderStateResponse.DiscardUnknown(m)
+func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderStateResponse) GetBlockHeaderState() *BlockHeaderState {
+func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
 	if m != nil {
-		return m.BlockHeaderState
+		return m.BlameInfo
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
-	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
-	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
-	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
-	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
-	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
-	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
-	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
-	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
-	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
-	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
-	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
-	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
-	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
-	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
-	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
-	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
-	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
-	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
-	proto.RegisterType((*QueryObserversByChainRequest)(nil), "zetachain.zetacore.observer.QueryObserversByChainRequest")
-	proto.RegisterType((*QueryObserversByChainResponse)(nil), "zetachain.zetacore.observer.QueryObserversByChainResponse")
-	proto.RegisterType((*QueryAllObserverMappersRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersRequest")
-	proto.RegisterType((*QueryAllObserverMappersResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersResponse")
-	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
-	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
-	proto.RegisterType((*QueryGetCoreParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainRequest")
-	proto.RegisterType((*QueryGetCoreParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainResponse")
-	proto.RegisterType((*QueryGetCoreParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsRequest")
-	proto.RegisterType((*QueryGetCoreParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsResponse")
-	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
-	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
-	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
-	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
-	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
-	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
-	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
-	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
-	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
-	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
-	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
-	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
-	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
-	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
-	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
-	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
-	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
-	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
-	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
-	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
-	proto.RegisterType((*QueryGetBlockHeaderStateRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateRequest")
-	proto.RegisterType((*QueryGetBlockHeaderStateResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateResponse")
+type QueryAllBlameRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
-
-var fileDescriptor_dcb801e455adaee4 = []byte{
-	// 2593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0xc4, 0xb5, 0x63, 0x1f, 0xdb, 0x89, 0x7d, 0xe3, 0x34, 0xc9, 0x38, 0x71, 0xdc, 0x9b,
-	0xa6, 0x49, 0xec, 0x64, 0xb7, 0x71, 0x4a, 0x9b, 0x34, 0x71, 0x5a, 0x6f, 0x48, 0xec, 0x7c, 0x34,
-	0x49, 0xd7, 0xa6, 0x45, 0x29, 0xb0, 0xcc, 0xee, 0x5e, 0xef, 0x4e, 0x3b, 0x9e, 0xd9, 0xce, 0x8c,
-	0x5d, 0x6f, 0x8d, 0x05, 0xe2, 0xb1, 0xe2, 0xa1, 0x12, 0x12, 0xbc, 0xf6, 0x05, 0xde, 0x40, 0xa8,
-	0x12, 0x02, 0x09, 0xf1, 0x00, 0x2f, 0xf4, 0x01, 0xa1, 0x22, 0x24, 0x04, 0x0f, 0xa0, 0x2a, 0x81,
-	0xbf, 0x03, 0x34, 0x77, 0xce, 0xcc, 0xdc, 0xf9, 0xd8, 0xd9, 0xbb, 0xee, 0xf6, 0x69, 0x67, 0xee,
-	0xbd, 0xe7, 0xdc, 0xdf, 0xef, 0xdc, 0xaf, 0xf3, 0x9b, 0xbd, 0x30, 0x65, 0x55, 0x1d, 0x66, 0x6f,
-	0x31, 0xbb, 0xf8, 0xfe, 0x26, 0xb3, 0xdb, 0x85, 0x96, 0x6d, 0xb9, 0x16, 0x99, 0xfe, 0x90, 0xb9,
-	0x5a, 0xad, 0xa9, 0xe9, 0x66, 0x81, 0x3f, 0x59, 0x36, 0x2b, 0x04, 0x0d, 0xd5, 0xc3, 0x35, 0x6b,
-	0x63, 0xc3, 0x32, 0x8b, 0xfe, 0x8f, 0x6f, 0xa1, 0xce, 0xd5, 0x2c, 0x67, 0xc3, 0x72, 0x8a, 0x55,
-	0xcd, 0x61, 0xbe, 0xab, 0xe2, 0xd6, 0xa5, 0x2a, 0x73, 0xb5, 0x4b, 0xc5, 0x96, 0xd6, 0xd0, 0x4d,
-	0xcd, 0xd5, 0xc3, 0xb6, 0x53, 0x0d, 0xab, 0x61, 0xf1, 0xc7, 0xa2, 0xf7, 0x84, 0xa5, 0x27, 0x1a,
-	0x96, 0xd5, 0x30, 0x58, 0x51, 0x6b, 0xe9, 0x45, 0xcd, 0x34, 0x2d, 0x97, 0x9b, 0x38, 0x58, 0x7b,
-	0x24, 0xc4, 0x59, 0xd5, 0x0c, 0xc3, 0x72, 0x03, 0x57, 0x51, 0xb1, 0xa1, 0x6d, 0x30, 0x2c, 0x9d,
-	0x16, 0x4a, 0xad, 0xda, 0x7b, 0x95, 0x26, 0xd3, 0xea, 0xcc, 0x4e, 0x55, 0x72, 0x82, 0x15, 0xd3,
-	0x32, 0x6b, 0x2c, 0xe8, 0xe6, 0x54, 0x54, 0x69, 0x5b, 0x8e, 0xe3, 0xb7, 0x58, 0x37, 0xb4, 0x46,
-	0x1a, 0xc7, 0x7b, 0xac, 0xdd, 0x60, 0x66, 0xca, 0xa9, 0x69, 0xd5, 0x59, 0x45, 0xab, 0xd5, 0xac,
-	0x4d, 0x33, 0x00, 0x79, 0x34, 0xac, 0x0c, 0x1e, 0x52, 0xce, 0x5a, 0x9a, 0xad, 0x6d, 0x04, 0x7d,
-	0x9c, 0x8c, 0x8a, 0x99, 0x59, 0xd7, 0xcd, 0x46, 0x1c, 0x23, 0x09, 0xab, 0x5d, 0x07, 0xcb, 0xe8,
-	0x02, 0xa8, 0x6f, 0x7a, 0x41, 0x5f, 0x66, 0xee, 0x4d, 0x0f, 0xf3, 0x03, 0x6e, 0x50, 0x66, 0xef,
-	0x6f, 0x32, 0xc7, 0x25, 0x53, 0x30, 0xa8, 0x9b, 0x75, 0xb6, 0x7d, 0x4c, 0x99, 0x55, 0xce, 0x8d,
-	0x94, 0xfd, 0x17, 0x6a, 0xc1, 0x74, 0xa6, 0x8d, 0xd3, 0xb2, 0x4c, 0x87, 0x91, 0x47, 0x30, 0x2a,
-	0x14, 0x73, 0xd3, 0xd1, 0x85, 0x73, 0x85, 0x9c, 0x99, 0x51, 0x10, 0xda, 0x97, 0x9e, 0xf9, 0xec,
-	0xdf, 0xa7, 0xf6, 0x95, 0x45, 0x17, 0xb4, 0x8e, 0x20, 0x97, 0x0c, 0x23, 0x03, 0xe4, 0x6d, 0x80,
-	0x68, 0xa6, 0x60, 0x77, 0x2f, 0x14, 0xfc, 0x69, 0x55, 0xf0, 0xa6, 0x55, 0xc1, 0x9f, 0xa1, 0x38,
-	0xad, 0x0a, 0x8f, 0xb4, 0x06, 0x43, 0xdb, 0xb2, 0x60, 0x49, 0x7f, 0xab, 0x20, 0xaf, 0x64, 0x37,
-	0x9d, 0x78, 0x0d, 0x7c, 0x49, 0x5e, 0x64, 0x39, 0x86, 0x7c, 0x3f, 0x47, 0x7e, 0xb6, 0x2b, 0x72,
-	0x1f, 0x4e, 0x0c, 0xfa, 0x3a, 0x9c, 0x08, 0x90, 0x3f, 0xf2, 0x47, 0xfe, 0xab, 0x09, 0xd1, 0x1f,
-	0x14, 0x38, 0xd9, 0xa1, 0x23, 0x0c, 0xd2, 0xdb, 0x70, 0x30, 0x3e, 0xf7, 0x30, 0x4e, 0x73, 0xb9,
-	0x71, 0x8a, 0xf9, 0xc2, 0x48, 0x8d, 0xb7, 0xc4, 0xc2, 0xfe, 0xc5, 0x6a, 0x11, 0x66, 0x39, 0x85,
-	0x78, 0x9f, 0x6d, 0x3e, 0x2e, 0x41, 0xbc, 0x8e, 0xc3, 0xb0, 0xbf, 0x82, 0xf5, 0x3a, 0x8f, 0xd6,
-	0x40, 0xf9, 0x00, 0x7f, 0xbf, 0x53, 0xa7, 0xdf, 0x83, 0xe7, 0x72, 0xcc, 0x73, 0xa2, 0xa0, 0xf4,
-	0x21, 0x0a, 0x74, 0x0a, 0x48, 0xb0, 0xf4, 0xd6, 0x56, 0x57, 0x11, 0x2e, 0x7d, 0x08, 0x87, 0x63,
-	0xa5, 0x88, 0xe2, 0x0a, 0x0c, 0xac, 0xad, 0xae, 0x62, 0xd7, 0xb3, 0xb9, 0x5d, 0xaf, 0xad, 0xae,
-	0x62, 0x87, 0x9e, 0x09, 0xbd, 0x05, 0xc7, 0x43, 0x87, 0x8e, 0xb3, 0x54, 0xaf, 0xdb, 0xcc, 0x09,
-	0x27, 0xd3, 0x39, 0x98, 0xa8, 0xea, 0x6e, 0xcd, 0xd2, 0xcd, 0x4a, 0x18, 0xa4, 0xfd, 0x3c, 0x48,
-	0x07, 0xb1, 0xfc, 0x26, 0xc6, 0xea, 0xf5, 0x68, 0x73, 0x11, 0xdd, 0x20, 0xbc, 0x09, 0x18, 0x60,
-	0x6e, 0x13, 0xb7, 0x16, 0xef, 0xd1, 0x2b, 0xa9, 0xba, 0x35, 0xee, 0x6c, 0xa4, 0xec, 0x3d, 0xd2,
-	0x8f, 0x14, 0x98, 0x4b, 0xbb, 0x28, 0xb5, 0x6f, 0xeb, 0xa6, 0x66, 0xe8, 0x1f, 0xb2, 0xfa, 0x0a,
-	0xd3, 0x1b, 0x4d, 0x37, 0x80, 0xb6, 0x00, 0x47, 0xd6, 0x83, 0x9a, 0x8a, 0xc7, 0xb2, 0xd2, 0xe4,
-	0xf5, 0x38, 0x88, 0x87, 0xc3, 0xca, 0xc7, 0xcc, 0xd5, 0x7c, 0xd3, 0x1e, 0xe8, 0xbc, 0x09, 0xf3,
-	0x52, 0x58, 0x7a, 0xe0, 0xf7, 0x5d, 0x78, 0x96, 0xbb, 0x5c, 0x73, 0x9c, 0x15, 0xdd, 0x71, 0x2d,
-	0xbb, 0xdd, 0xef, 0x25, 0xfb, 0x33, 0x05, 0x8e, 0xa6, 0xba, 0x40, 0x84, 0x4b, 0x30, 0xec, 0x3a,
-	0x4e, 0xc5, 0xd0, 0x1d, 0x17, 0x97, 0xa9, 0xec, 0x2c, 0x39, 0xe0, 0x3a, 0xce, 0x7d, 0xdd, 0x71,
-	0xfb, 0xb7, 0x2c, 0x7f, 0xae, 0xc0, 0xa4, 0xbf, 0xb0, 0x6c, 0x6b, 0x8b, 0x75, 0x5f, 0x88, 0xe4,
-	0x28, 0x1c, 0x70, 0xb7, 0x2b, 0x4d, 0xcd, 0x69, 0x62, 0x40, 0x87, 0xdc, 0xed, 0x15, 0xcd, 0x69,
-	0x92, 0xd3, 0x30, 0xd8, 0xb2, 0x2d, 0x6b, 0xfd, 0xd8, 0x00, 0x47, 0x33, 0x5e, 0xc0, 0x7c, 0xe3,
-	0x91, 0x57, 0x58, 0xf6, 0xeb, 0xc8, 0x49, 0x00, 0x3c, 0xe2, 0x3d, 0x07, 0xcf, 0x70, 0x07, 0x23,
-	0xbc, 0x84, 0xfb, 0x38, 0x0e, 0xc3, 0xee, 0x76, 0xc5, 0x3f, 0xfb, 0x06, 0xfd, 0x7e, 0xdd, 0xed,
-	0x3b, 0xfc, 0xf4, 0x9b, 0xc3, 0x25, 0x88, 0x38, 0x31, 0x94, 0x53, 0x30, 0xb8, 0xa5, 0x19, 0x88,
-	0x72, 0xb8, 0xec, 0xbf, 0x84, 0xcb, 0xf5, 0x11, 0x3f, 0xa5, 0x83, 0xe5, 0xfa, 0x4d, 0x5c, 0xae,
-	0x41, 0x69, 0x38, 0x1a, 0x43, 0xfe, 0x69, 0x8e, 0xa3, 0x7d, 0x3a, 0x7f, 0xb3, 0xe0, 0x4d, 0x71,
-	0x38, 0xd0, 0x90, 0x36, 0x61, 0x8a, 0x7b, 0x5e, 0xd1, 0x9c, 0xb7, 0x2c, 0x97, 0xd5, 0x83, 0x30,
-	0xce, 0xc3, 0xa4, 0x9f, 0xfd, 0x54, 0xf4, 0x3a, 0x33, 0x5d, 0x7d, 0x5d, 0x67, 0x36, 0x4e, 0xcc,
-	0x09, 0xbf, 0xe2, 0x4e, 0x58, 0x4e, 0x4e, 0xc3, 0xf8, 0x96, 0xe5, 0x32, 0xbb, 0xa2, 0xf9, 0x33,
-	0x1c, 0xc3, 0x3b, 0xc6, 0x0b, 0x71, 0xd6, 0xd3, 0x97, 0xe0, 0x48, 0xa2, 0x27, 0x64, 0x31, 0x0d,
-	0x23, 0x4d, 0xcd, 0xa9, 0x78, 0x8d, 0x83, 0x60, 0x0c, 0x37, 0xb1, 0x11, 0x7d, 0x03, 0x66, 0xb8,
-	0x55, 0x89, 0xf7, 0x59, 0x6a, 0x47, 0xbd, 0xee, 0x05, 0x29, 0x75, 0x61, 0xc4, 0xf3, 0x6b, 0xf3,
-	0x99, 0x98, 0x82, 0xad, 0xa4, 0x61, 0x93, 0x12, 0x8c, 0x78, 0xef, 0x15, 0xb7, 0xdd, 0x62, 0x9c,
-	0xd7, 0xc1, 0x85, 0x33, 0xb9, 0x61, 0xf6, 0xfc, 0xaf, 0xb5, 0x5b, 0xac, 0x3c, 0xbc, 0x85, 0x4f,
-	0xf4, 0x37, 0xfb, 0xe1, 0x54, 0x47, 0x16, 0x18, 0x85, 0x9e, 0x02, 0x7e, 0x03, 0x86, 0x38, 0x48,
-	0x2f, 0xd2, 0x03, 0x7c, 0x99, 0x77, 0x43, 0xc4, 0x19, 0x97, 0xd1, 0x8a, 0xbc, 0x0d, 0x13, 0x7e,
-	0x2d, 0x5f, 0x49, 0x3e, 0xb7, 0x01, 0xce, 0xed, 0x42, 0xae, 0xa7, 0x87, 0x91, 0x11, 0xa7, 0x78,
-	0xc8, 0x8a, 0x17, 0x90, 0x07, 0x30, 0x8e, 0x2c, 0x1c, 0x57, 0x73, 0x37, 0x1d, 0xbe, 0x4e, 0x0e,
-	0x2e, 0x9c, 0xcf, 0xf5, 0xea, 0x47, 0x65, 0x95, 0x1b, 0x94, 0xc7, 0xaa, 0xc2, 0x1b, 0xbd, 0x87,
-	0x69, 0xca, 0x43, 0x6c, 0x9b, 0x3c, 0x76, 0xe7, 0x61, 0x52, 0x24, 0xc2, 0x7b, 0x08, 0xa2, 0x26,
-	0x54, 0x70, 0x1b, 0xba, 0x88, 0xa9, 0x48, 0xda, 0x19, 0x8e, 0xc1, 0x09, 0x18, 0x09, 0x40, 0xf9,
-	0x59, 0xc8, 0x48, 0x39, 0x2a, 0xa0, 0xb3, 0x38, 0x15, 0x97, 0x0c, 0x23, 0xf0, 0xf0, 0x86, 0xd6,
-	0x6a, 0x31, 0x3b, 0x5c, 0xa6, 0x6d, 0x1c, 0xe6, 0xac, 0x16, 0xd8, 0xc5, 0x5b, 0x41, 0xe4, 0x99,
-	0x5d, 0xd9, 0xf0, 0xeb, 0x70, 0x23, 0x9d, 0x97, 0x88, 0x7c, 0xe0, 0x2f, 0x08, 0x7c, 0xe8, 0x9f,
-	0x3e, 0x8b, 0xeb, 0x78, 0x75, 0xb3, 0xd5, 0xb2, 0x6c, 0x97, 0xd5, 0x39, 0x33, 0x87, 0xde, 0xc2,
-	0x00, 0x26, 0xca, 0x43, 0x3c, 0x67, 0x60, 0x88, 0x77, 0x19, 0xa0, 0x08, 0xf7, 0x3e, 0x3f, 0x32,
-	0x58, 0x49, 0x6f, 0x60, 0x0e, 0xe3, 0x25, 0xf0, 0x96, 0xcd, 0xfc, 0xad, 0xe4, 0xb6, 0x65, 0xcb,
-	0xe6, 0x40, 0x26, 0xd0, 0x3c, 0x7b, 0x04, 0xb3, 0x02, 0xa3, 0x1e, 0xeb, 0x4a, 0x6c, 0x53, 0x3b,
-	0x9b, 0x9f, 0x2f, 0x87, 0xde, 0xca, 0x50, 0x0b, 0x9f, 0xe9, 0x74, 0x94, 0x8e, 0x08, 0x2d, 0x70,
-	0x98, 0xde, 0x15, 0x14, 0x8c, 0x50, 0x89, 0x20, 0xee, 0x67, 0x81, 0x98, 0x97, 0x04, 0xc1, 0x57,
-	0x99, 0x08, 0x44, 0x50, 0x4b, 0x0f, 0xac, 0x3a, 0x5b, 0xf2, 0xd5, 0x5a, 0xbe, 0x5a, 0x7a, 0x37,
-	0x52, 0x4b, 0x31, 0x1b, 0x04, 0x78, 0x0f, 0xc6, 0x44, 0xe5, 0x27, 0x25, 0x97, 0x44, 0x3f, 0xa3,
-	0x66, 0xf4, 0x22, 0x0a, 0xa5, 0x0c, 0x7c, 0xfd, 0x4a, 0x29, 0x3e, 0x15, 0x84, 0x52, 0x16, 0xa5,
-	0xbb, 0x30, 0x2a, 0x14, 0x4b, 0x09, 0xa5, 0x18, 0x23, 0xe1, 0xa5, 0x7f, 0xf9, 0x45, 0xb0, 0xde,
-	0xbd, 0x69, 0x12, 0x2a, 0xf4, 0xdb, 0x9e, 0x40, 0x0f, 0x26, 0xd2, 0x0f, 0x14, 0x5c, 0xf0, 0x59,
-	0x4d, 0x90, 0xda, 0xb7, 0x61, 0x22, 0xa9, 0xef, 0x31, 0x90, 0xf9, 0x5b, 0x6d, 0xc2, 0x1f, 0x1e,
-	0xdb, 0x87, 0x6a, 0xf1, 0x62, 0x7a, 0x14, 0x4f, 0xd5, 0x65, 0xe6, 0xde, 0xe3, 0x5f, 0x09, 0x02,
-	0x6c, 0xdf, 0xc0, 0x3c, 0x51, 0xa8, 0x40, 0x44, 0xd7, 0x60, 0xc8, 0xff, 0xa0, 0x20, 0x95, 0x35,
-	0xa0, 0x31, 0x9a, 0xd0, 0x53, 0xb8, 0x87, 0xae, 0x36, 0xad, 0x0f, 0x82, 0x3d, 0xe9, 0xa6, 0x30,
-	0x65, 0xbc, 0x98, 0xcc, 0x74, 0x6a, 0x81, 0x00, 0xbe, 0x03, 0x87, 0x0d, 0xcd, 0x71, 0x2b, 0xe1,
-	0x46, 0x28, 0xce, 0xe3, 0x42, 0x2e, 0x9a, 0xfb, 0x9a, 0xe3, 0xc6, 0x9d, 0x4e, 0x1a, 0xc9, 0x22,
-	0x7a, 0x17, 0x31, 0x96, 0x0c, 0x6d, 0x83, 0x65, 0xa5, 0x0c, 0xe7, 0x61, 0x82, 0x7f, 0xc3, 0x49,
-	0x1f, 0xb5, 0x87, 0x78, 0xb9, 0x90, 0x30, 0xd4, 0x82, 0xfc, 0x23, 0xed, 0x2b, 0x4c, 0xc2, 0x00,
-	0x9d, 0x99, 0xeb, 0x16, 0x92, 0xa0, 0xf9, 0xe7, 0x9d, 0xd7, 0xdc, 0xcb, 0x1d, 0xbd, 0xae, 0xcc,
-	0x75, 0x8b, 0xb2, 0x68, 0x75, 0xf8, 0x75, 0xac, 0x66, 0xd9, 0xf5, 0xbe, 0x6b, 0xf1, 0x5f, 0x29,
-	0x91, 0xe8, 0x8f, 0xf7, 0x83, 0x54, 0x96, 0x13, 0x54, 0x06, 0xe4, 0xa8, 0xe0, 0xdc, 0x8c, 0x08,
-	0xf5, 0x6f, 0x0d, 0xae, 0xa2, 0xf4, 0xc6, 0xf0, 0xf3, 0xe3, 0x62, 0xc9, 0xac, 0x73, 0x6d, 0x2b,
-	0x91, 0xf1, 0x4f, 0xc1, 0x20, 0x57, 0xd3, 0x28, 0xcf, 0xfc, 0x17, 0xba, 0x8e, 0x87, 0x59, 0xb6,
-	0xd3, 0x0e, 0xc3, 0x3a, 0xd0, 0xfb, 0xb0, 0x0a, 0x7b, 0x6b, 0x89, 0xeb, 0x04, 0xfe, 0x6d, 0xb0,
-	0xdf, 0xa3, 0xfa, 0x89, 0x22, 0xce, 0x1e, 0xa1, 0x9b, 0x50, 0xd3, 0x8f, 0x8b, 0x9f, 0x26, 0x83,
-	0x83, 0xfe, 0x70, 0x70, 0xd0, 0x8b, 0x36, 0x63, 0xd5, 0xe8, 0xa5, 0x8f, 0x1f, 0x50, 0x96, 0x70,
-	0x14, 0x97, 0x99, 0x2b, 0xf4, 0x56, 0xf2, 0xa4, 0x40, 0x33, 0x08, 0x47, 0x5c, 0x5e, 0x79, 0xe1,
-	0x18, 0x13, 0xe4, 0x15, 0x7d, 0x27, 0x4a, 0x40, 0x32, 0x5c, 0x20, 0xd5, 0x97, 0x61, 0x4c, 0xa4,
-	0x8a, 0x41, 0xcd, 0x64, 0x3a, 0x2a, 0x30, 0xa5, 0xd7, 0xa3, 0x6d, 0x5c, 0x68, 0xe3, 0xa5, 0xa0,
-	0x12, 0x93, 0x8c, 0x7e, 0x3f, 0x93, 0x1d, 0x5a, 0x23, 0xb2, 0x77, 0x80, 0x88, 0xc8, 0x78, 0x76,
-	0xcc, 0x10, 0xdf, 0xc5, 0x2e, 0xb3, 0x2a, 0xe1, 0x72, 0xa2, 0x9a, 0x28, 0x59, 0xf8, 0xdf, 0x79,
-	0x18, 0xe4, 0x08, 0xc8, 0xc7, 0x0a, 0x0c, 0xf9, 0x89, 0x07, 0x29, 0xe6, 0x7a, 0x4d, 0x6b, 0x4c,
-	0xf5, 0x45, 0x79, 0x03, 0x9f, 0x14, 0x3d, 0xfd, 0xc3, 0xbf, 0xfd, 0xe7, 0xc7, 0xfb, 0x4f, 0x92,
-	0xe9, 0xa2, 0xd7, 0xfe, 0x22, 0x37, 0x2d, 0x26, 0xbe, 0x33, 0x93, 0xdf, 0x2b, 0x30, 0x1c, 0x48,
-	0x3e, 0x72, 0xa9, 0x7b, 0x1f, 0x09, 0x21, 0xaa, 0x2e, 0xf4, 0x62, 0x82, 0xc0, 0xee, 0x72, 0x60,
-	0x5f, 0x27, 0xa5, 0x4c, 0x60, 0xa1, 0xd8, 0x2c, 0xee, 0xa4, 0x14, 0xd7, 0x6e, 0x71, 0x27, 0x26,
-	0x09, 0x77, 0xc9, 0xdf, 0x15, 0x20, 0x69, 0xd9, 0x46, 0xae, 0x75, 0x87, 0xd5, 0x51, 0xb2, 0xaa,
-	0xd7, 0xf7, 0x66, 0x8c, 0xec, 0x6e, 0x71, 0x76, 0xaf, 0x91, 0xc5, 0x4c, 0x76, 0x48, 0xa9, 0xda,
-	0x16, 0x58, 0x65, 0x11, 0x25, 0x7f, 0x51, 0x60, 0x22, 0xa9, 0x84, 0xc8, 0xd5, 0xee, 0xc8, 0x3a,
-	0x48, 0x31, 0xf5, 0xd5, 0xbd, 0x98, 0x22, 0xa5, 0x9b, 0x9c, 0xd2, 0x22, 0xb9, 0x96, 0x49, 0x29,
-	0x94, 0x60, 0x1e, 0x2b, 0xbf, 0x6e, 0x27, 0xa5, 0xfa, 0x76, 0xc9, 0x1f, 0x15, 0x20, 0x69, 0xe5,
-	0x25, 0x33, 0x52, 0x1d, 0x15, 0x9d, 0xcc, 0x48, 0x75, 0x16, 0x7b, 0xf4, 0x12, 0xa7, 0x35, 0x4f,
-	0xce, 0x67, 0xd2, 0xd2, 0x0c, 0xa3, 0x92, 0xd4, 0x82, 0xe4, 0x17, 0x0a, 0x1c, 0x4a, 0x68, 0x35,
-	0x99, 0x55, 0x93, 0x30, 0x51, 0xaf, 0xf6, 0x6c, 0x12, 0x82, 0xbe, 0xc0, 0x41, 0xbf, 0x40, 0x9e,
-	0xcf, 0x04, 0xed, 0x24, 0xb0, 0xfd, 0x4b, 0x81, 0x23, 0x99, 0xa2, 0x8e, 0xdc, 0xe8, 0x0e, 0x21,
-	0x4f, 0x4d, 0xaa, 0xaf, 0xed, 0xd9, 0x5e, 0x6a, 0x52, 0x35, 0x98, 0x5b, 0xa9, 0x19, 0x3a, 0x33,
-	0x5d, 0x54, 0x7a, 0x95, 0x75, 0xcb, 0x0e, 0x66, 0x57, 0xb0, 0xd5, 0xef, 0x92, 0x5f, 0x2a, 0x30,
-	0x1e, 0xeb, 0x86, 0xbc, 0xdc, 0x23, 0xae, 0x80, 0xcf, 0x2b, 0x3d, 0xdb, 0x49, 0x0d, 0x08, 0xe7,
-	0x11, 0xe9, 0x55, 0xf2, 0xa9, 0x12, 0xd3, 0x52, 0x44, 0xae, 0xdb, 0xb4, 0xf6, 0x53, 0xaf, 0xf4,
-	0x6e, 0x88, 0x80, 0x5f, 0xe4, 0x80, 0xe7, 0xc8, 0xb9, 0x4c, 0xc0, 0x82, 0xfa, 0x2c, 0xee, 0x70,
-	0xc1, 0xbb, 0xeb, 0xcd, 0xfa, 0x83, 0x82, 0xa7, 0x25, 0xc3, 0x90, 0xc1, 0x9d, 0xa9, 0x59, 0x65,
-	0x70, 0x67, 0xab, 0x50, 0x7a, 0x8e, 0xe3, 0xa6, 0x64, 0xb6, 0x1b, 0x6e, 0xf2, 0x3b, 0x05, 0x0e,
-	0x25, 0x04, 0x9a, 0xcc, 0x3e, 0xd3, 0x51, 0x49, 0xca, 0xec, 0x33, 0x9d, 0x35, 0x26, 0xbd, 0xc8,
-	0x81, 0x9f, 0x25, 0x67, 0x32, 0x81, 0x27, 0xe5, 0x27, 0xf9, 0x89, 0x02, 0x43, 0xbe, 0xac, 0x23,
-	0x0b, 0x52, 0xfd, 0xc6, 0x94, 0xa5, 0x7a, 0xb9, 0x27, 0x1b, 0xa9, 0x5c, 0xc1, 0x17, 0x97, 0xe4,
-	0x4f, 0x0a, 0x4c, 0xa6, 0x64, 0x23, 0x91, 0x38, 0x58, 0x3a, 0xa9, 0x51, 0xf5, 0xda, 0x9e, 0x6c,
-	0x11, 0xf3, 0x55, 0x8e, 0xf9, 0x32, 0xb9, 0x24, 0x62, 0x0e, 0xbc, 0x08, 0x5b, 0x62, 0xd3, 0xfa,
-	0x20, 0xa1, 0x65, 0xc9, 0x5f, 0x15, 0x98, 0x4c, 0x49, 0x46, 0x19, 0x26, 0x9d, 0x34, 0xab, 0x0c,
-	0x93, 0x8e, 0x1a, 0xb5, 0xcb, 0x56, 0xe8, 0xeb, 0x9c, 0x64, 0xc6, 0x90, 0x10, 0xc8, 0xbb, 0x5e,
-	0x26, 0x47, 0x96, 0x99, 0x9b, 0x10, 0x8f, 0x44, 0x6e, 0xbd, 0x65, 0xe8, 0x5a, 0x99, 0x43, 0xaa,
-	0x83, 0x52, 0xa5, 0x0b, 0x9c, 0xd0, 0x05, 0x32, 0xd7, 0x71, 0x4f, 0xf4, 0x4e, 0x57, 0x9f, 0x83,
-	0x8d, 0x40, 0xbf, 0x50, 0xe0, 0x08, 0x77, 0xe6, 0x24, 0x34, 0x1f, 0x59, 0x94, 0x8e, 0x6d, 0x96,
-	0x00, 0x55, 0x6f, 0xec, 0xd5, 0x1c, 0xc9, 0xac, 0x70, 0x32, 0x25, 0xf2, 0x7a, 0xfe, 0xe8, 0xf8,
-	0x4b, 0x58, 0x33, 0xeb, 0xfe, 0x3f, 0xc4, 0xc2, 0x29, 0x55, 0xdc, 0xe1, 0x25, 0xbb, 0xde, 0xbe,
-	0x14, 0x0e, 0x91, 0x20, 0xe4, 0x5e, 0x91, 0x0c, 0x74, 0x52, 0xa3, 0xaa, 0x57, 0x7a, 0x37, 0xec,
-	0x71, 0x80, 0x04, 0x61, 0x4a, 0xfe, 0xa9, 0xc0, 0x54, 0x96, 0xbe, 0x93, 0x19, 0x9f, 0x1c, 0x69,
-	0xa9, 0xde, 0xd8, 0xab, 0x39, 0x72, 0x29, 0x71, 0x2e, 0xd7, 0xc9, 0xab, 0x1d, 0xb9, 0xc4, 0xb4,
-	0x5d, 0xb5, 0xcd, 0x35, 0xac, 0xb7, 0x84, 0x02, 0x3d, 0xbb, 0x4b, 0xfe, 0xab, 0x80, 0x9a, 0x21,
-	0x10, 0x83, 0xbc, 0xfb, 0x7a, 0xaf, 0x10, 0x45, 0x71, 0xaa, 0x2e, 0xee, 0xd1, 0x5a, 0x4a, 0x2e,
-	0xa5, 0xf8, 0x71, 0xed, 0x1a, 0x4d, 0x48, 0xbd, 0x2e, 0xe6, 0x4b, 0x3f, 0x52, 0x60, 0x90, 0xff,
-	0xcf, 0x49, 0x0a, 0x12, 0x7a, 0x52, 0xf8, 0xe3, 0x56, 0x2d, 0x4a, 0xb7, 0x47, 0xd8, 0x94, 0xc3,
-	0x3e, 0x41, 0xd4, 0x6c, 0xf9, 0xc9, 0x41, 0x60, 0xfa, 0x16, 0xfd, 0xf9, 0x2e, 0x99, 0xbe, 0xa5,
-	0xee, 0x30, 0x48, 0xa6, 0x6f, 0xe9, 0x4b, 0x0b, 0x12, 0xe9, 0x9b, 0xeb, 0x38, 0x81, 0xde, 0x24,
-	0x3f, 0xdd, 0x0f, 0x33, 0xf9, 0xb7, 0x05, 0xc8, 0x72, 0x8f, 0x48, 0x3a, 0xdd, 0x7d, 0x50, 0x57,
-	0xbe, 0xbc, 0x23, 0xe4, 0x58, 0xe5, 0x1c, 0xbf, 0x45, 0x1e, 0xcb, 0x70, 0xac, 0x34, 0xf9, 0xa5,
-	0x02, 0xbd, 0xa6, 0x19, 0xc5, 0x9d, 0xcc, 0xcb, 0x17, 0xbb, 0xc5, 0x9d, 0xe4, 0x05, 0x8b, 0x5d,
-	0xf2, 0x91, 0xc2, 0x2f, 0xa7, 0xc8, 0x7c, 0xd8, 0x88, 0xdd, 0x75, 0x91, 0xf9, 0xb0, 0x11, 0xbf,
-	0x06, 0x43, 0x67, 0x39, 0x1d, 0x95, 0x1c, 0xcb, 0xa4, 0xe3, 0x81, 0xf8, 0x44, 0x01, 0x88, 0xae,
-	0x47, 0x10, 0x89, 0x94, 0x28, 0x75, 0x5f, 0x43, 0x7d, 0xa9, 0x37, 0x23, 0xc4, 0x76, 0x96, 0x63,
-	0x7b, 0x8e, 0x9c, 0xca, 0xc4, 0xe6, 0x46, 0x98, 0x7e, 0xad, 0xc0, 0x44, 0xec, 0x7e, 0x90, 0x97,
-	0x55, 0xcb, 0x1d, 0xb9, 0x59, 0x37, 0xc2, 0x64, 0xf4, 0x7d, 0xa7, 0x3b, 0x5e, 0x74, 0x8e, 0x83,
-	0x7e, 0x9e, 0xd0, 0xec, 0xa5, 0x1a, 0xbb, 0xb6, 0xf5, 0x67, 0x05, 0xa6, 0xb2, 0xae, 0x4a, 0xc9,
-	0x9c, 0x02, 0x39, 0x37, 0xb4, 0x64, 0x4e, 0x81, 0xbc, 0x1b, 0x5a, 0xf4, 0x6b, 0x9c, 0x43, 0x91,
-	0x5c, 0xec, 0xce, 0x41, 0xdc, 0x10, 0x3d, 0x3d, 0x26, 0xde, 0xe0, 0x93, 0x94, 0x81, 0xa9, 0x4b,
-	0x8b, 0x92, 0x7a, 0x2c, 0xe3, 0x1a, 0x62, 0x17, 0x3d, 0x56, 0x8b, 0x2c, 0x62, 0x7a, 0x4c, 0xf0,
-	0x24, 0xaf, 0xc7, 0xf6, 0x86, 0x3b, 0xfb, 0xfa, 0x64, 0x17, 0x3d, 0x26, 0xe0, 0x2e, 0xdd, 0xf9,
-	0xec, 0xc9, 0x8c, 0xf2, 0xf9, 0x93, 0x19, 0xe5, 0x8b, 0x27, 0x33, 0xca, 0xc7, 0x4f, 0x67, 0xf6,
-	0x7d, 0xfe, 0x74, 0x66, 0xdf, 0x3f, 0x9e, 0xce, 0xec, 0x7b, 0x5c, 0x6c, 0xe8, 0x6e, 0x73, 0xb3,
-	0x5a, 0xa8, 0x59, 0x1b, 0x99, 0x59, 0xfc, 0xb6, 0xb0, 0x76, 0xda, 0x2d, 0xe6, 0x54, 0x87, 0xf8,
-	0x2d, 0xd7, 0xcb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x98, 0x37, 0x26, 0xae, 0x2c, 0x00,
-	0x00,
+func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
+func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
+func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{57}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error)
-	AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error)
-	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetClientParamsForChain items.
-	GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error)
-	// Queries a list of GetCoreParams items.
-	GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
-	GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error)
-	// merkle proof verification
-	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
-	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
+func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
-	out := new(QueryHasVotedResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllBlameRecordsResponse struct {
+	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
-	out := new(QueryBallotByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecordsResponse{} }
+func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
+func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{58}
 }
-
-func (c *queryClient) ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error) {
-	out := new(QueryObserversByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserversByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error) {
-	out := new(QueryAllObserverMappersResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AllObserverMappers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlameRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
-	out := new(QuerySupportedChainsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlameRecordsResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error) {
-	out := new(QueryGetCoreParamsForChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParamsForChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error) {
-	out := new(QueryGetCoreParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlameRecordsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
-	out := new(QueryGetNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryAllBlameRecordsResponse proto.InternalMessageInfo
 
-func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
-	out := new(QueryAllNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlameRecordsResponse) GetBlameInfo() []Blame {
+	if m != nil {
+		return m.BlameInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
-	out := new(QueryGetCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlameRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
-	out := new(QueryGetKeygenResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBlameByChainAndNonceRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
-	out := new(QueryShowObserverCountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
+func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
+func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{59}
 }
-
-func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
-	out := new(QueryBlameByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
-	out := new(QueryAllBlameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
-	out := new(QueryBlameByChainAndNonceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
+}
+func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
-	out := new(QueryAllBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+
+func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
-	out := new(QueryGetBlockHeaderByHashResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error) {
-	out := new(QueryGetBlockHeaderStateResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBlameByChainAndNonceResponse struct {
+	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
 }
 
-func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
-	out := new(QueryProveResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByChainAndNonceResponse{} }
+func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
+func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{60}
+}
+func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByChainAndNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlameByChainAndNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByChainAndNonceResponse.Merge(m, src)
+}
+func (m *QueryBlameByChainAndNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByChainAndNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByChainAndNonceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByChainAndNonceResponse proto.InternalMessageInfo
+
+func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
+	if m != nil {
+		return m.BlameInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	out := new(QueryGetTssAddressByFinalizedHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllBlockHeaderRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
-	out := new(QueryGetTSSResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlockHeaderRequest) Reset()         { *m = QueryAllBlockHeaderRequest{} }
+func (m *QueryAllBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlockHeaderRequest) ProtoMessage()    {}
+func (*QueryAllBlockHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{61}
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlockHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlockHeaderRequest.Merge(m, src)
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlockHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlockHeaderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
-	out := new(QueryTssHistoryResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBlockHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlockHeaderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
-	out := new(QueryAllPendingNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllBlockHeaderResponse struct {
+	BlockHeaders []*common.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
-	out := new(QueryPendingNoncesByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlockHeaderResponse) Reset()         { *m = QueryAllBlockHeaderResponse{} }
+func (m *QueryAllBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlockHeaderResponse) ProtoMessage()    {}
+func (*QueryAllBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{62}
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlockHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlockHeaderResponse.Merge(m, src)
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlockHeaderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
-	out := new(QueryGetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBlockHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryAllBlockHeaderResponse) GetBlockHeaders() []*common.BlockHeader {
+	if m != nil {
+		return m.BlockHeaders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
-	out := new(QueryAllChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlockHeaderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserversByChain(context.Context, *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error)
-	AllObserverMappers(context.Context, *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error)
-	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetClientParamsForChain items.
-	GetCoreParamsForChain(context.Context, *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error)
-	// Queries a list of GetCoreParams items.
-	GetCoreParams(context.Context, *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
-	GetBlockHeaderStateByChain(context.Context, *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error)
-	// merkle proof verification
-	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
-	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+type QueryGetBlockHeaderByHashRequest struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetBlockHeaderByHashRequest) Reset()         { *m = QueryGetBlockHeaderByHashRequest{} }
+func (m *QueryGetBlockHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderByHashRequest) ProtoMessage()    {}
+func (*QueryGetBlockHeaderByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{63}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ObserversByChain(ctx context.Context, req *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ObserversByChain not implemented")
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllObserverMappers(ctx context.Context, req *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllObserverMappers not implemented")
+func (m *QueryGetBlockHeaderByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+
+var xxx_messageInfo_QueryGetBlockHeaderByHashRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderByHashRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetCoreParamsForChain(ctx context.Context, req *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParamsForChain not implemented")
+
+type QueryGetBlockHeaderByHashResponse struct {
+	BlockHeader *common.BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
 }
-func (*UnimplementedQueryServer) GetCoreParams(ctx context.Context, req *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParams not implemented")
+
+func (m *QueryGetBlockHeaderByHashResponse) Reset()         { *m = QueryGetBlockHeaderByHashResponse{} }
+func (m *QueryGetBlockHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderByHashResponse) ProtoMessage()    {}
+func (*QueryGetBlockHeaderByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{64}
 }
-func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+func (m *QueryGetBlockHeaderByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+
+var xxx_messageInfo_QueryGetBlockHeaderByHashResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderByHashResponse) GetBlockHeader() *common.BlockHeader {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+
+type QueryGetBlockHeaderStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
-func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+
+func (m *QueryGetBlockHeaderStateRequest) Reset()         { *m = QueryGetBlockHeaderStateRequest{} }
+func (m *QueryGetBlockHeaderStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderStateRequest) ProtoMessage()    {}
+func (*QueryGetBlockHeaderStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{65}
 }
-func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+func (m *QueryGetBlockHeaderStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetBlockHeaderStateByChain(ctx context.Context, req *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderStateByChain not implemented")
+func (m *QueryGetBlockHeaderStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderStateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+func (m *QueryGetBlockHeaderStateRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+func (m *QueryGetBlockHeaderStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderStateRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+
+var xxx_messageInfo_QueryGetBlockHeaderStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+
+type QueryGetBlockHeaderStateResponse struct {
+	BlockHeaderState *BlockHeaderState `protobuf:"bytes,1,opt,name=block_header_state,json=blockHeaderState,proto3" json:"block_header_state,omitempty"`
 }
-func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+
+func (m *QueryGetBlockHeaderStateResponse) Reset()         { *m = QueryGetBlockHeaderStateResponse{} }
+func (m *QueryGetBlockHeaderStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderStateResponse) ProtoMessage()    {}
+func (*QueryGetBlockHeaderStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{66}
 }
-func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+func (m *QueryGetBlockHeaderStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+func (m *QueryGetBlockHeaderStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderStateResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+func (m *QueryGetBlockHeaderStateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetBlockHeaderStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderStateResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetBlockHeaderStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderStateResponse) GetBlockHeaderState() *BlockHeaderState {
+	if m != nil {
+		return m.BlockHeaderState
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasVotedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasVoted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPrunedBlockHeaderRangesRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
+func (m *QueryPrunedBlockHeaderRangesRequest) Reset()         { *m = QueryPrunedBlockHeaderRangesRequest{} }
+func (m *QueryPrunedBlockHeaderRangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedBlockHeaderRangesRequest) ProtoMessage()    {}
+func (*QueryPrunedBlockHeaderRangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{67}
+}
+func (m *QueryPrunedBlockHeaderRangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedBlockHeaderRangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedBlockHeaderRangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPrunedBlockHeaderRangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedBlockHeaderRangesRequest.Merge(m, src)
+}
+func (m *QueryPrunedBlockHeaderRangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedBlockHeaderRangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedBlockHeaderRangesRequest.DiscardUnknown(m)
 }
 
-func _Query_ObserversByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryObserversByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ObserversByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ObserversByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ObserversByChain(ctx, req.(*QueryObserversByChainRequest))
+var xxx_messageInfo_QueryPrunedBlockHeaderRangesRequest proto.InternalMessageInfo
+
+func (m *QueryPrunedBlockHeaderRangesRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AllObserverMappers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllObserverMappersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllObserverMappers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/AllObserverMappers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllObserverMappers(ctx, req.(*QueryAllObserverMappersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPrunedBlockHeaderRangesResponse struct {
+	PrunedRanges []HeightRange `protobuf:"bytes,1,rep,name=pruned_ranges,json=prunedRanges,proto3" json:"pruned_ranges"`
+	PrunedHeight int64         `protobuf:"varint,2,opt,name=pruned_height,json=prunedHeight,proto3" json:"pruned_height,omitempty"`
 }
 
-func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupportedChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SupportedChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+func (m *QueryPrunedBlockHeaderRangesResponse) Reset()         { *m = QueryPrunedBlockHeaderRangesResponse{} }
+func (m *QueryPrunedBlockHeaderRangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedBlockHeaderRangesResponse) ProtoMessage()    {}
+func (*QueryPrunedBlockHeaderRangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{68}
+}
+func (m *QueryPrunedBlockHeaderRangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedBlockHeaderRangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedBlockHeaderRangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPrunedBlockHeaderRangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedBlockHeaderRangesResponse.Merge(m, src)
+}
+func (m *QueryPrunedBlockHeaderRangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedBlockHeaderRangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedBlockHeaderRangesResponse.DiscardUnknown(m)
 }
 
-func _Query_GetCoreParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCoreParamsForChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCoreParamsForChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParamsForChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCoreParamsForChain(ctx, req.(*QueryGetCoreParamsForChainRequest))
+var xxx_messageInfo_QueryPrunedBlockHeaderRangesResponse proto.InternalMessageInfo
+
+func (m *QueryPrunedBlockHeaderRangesResponse) GetPrunedRanges() []HeightRange {
+	if m != nil {
+		return m.PrunedRanges
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetCoreParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCoreParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCoreParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParams",
+func (m *QueryPrunedBlockHeaderRangesResponse) GetPrunedHeight() int64 {
+	if m != nil {
+		return m.PrunedHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCoreParams(ctx, req.(*QueryGetCoreParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetLightClientStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateRequest) Reset()         { *m = QueryGetLightClientStateRequest{} }
+func (m *QueryGetLightClientStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLightClientStateRequest) ProtoMessage()    {}
+func (*QueryGetLightClientStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{69}
 }
-
-func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCrosschainFlagsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrosschainFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeygenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+func (m *QueryGetLightClientStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLightClientStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowObserverCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowObserverCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLightClientStateRequest.Merge(m, src)
 }
-
-func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlameByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlameRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLightClientStateRequest.DiscardUnknown(m)
 }
 
-func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByChainAndNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryGetLightClientStateRequest proto.InternalMessageInfo
 
-func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlockHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+func (m *QueryGetLightClientStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlockHeaderByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetLightClientStateResponse struct {
+	LightClientState LightClientState `protobuf:"bytes,1,opt,name=light_client_state,json=lightClientState,proto3" json:"light_client_state"`
 }
 
-func _Query_GetBlockHeaderStateByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlockHeaderStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, req.(*QueryGetBlockHeaderStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetLightClientStateResponse) Reset()         { *m = QueryGetLightClientStateResponse{} }
+func (m *QueryGetLightClientStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLightClientStateResponse) ProtoMessage()    {}
+func (*QueryGetLightClientStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{70}
 }
-
-func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+func (m *QueryGetLightClientStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLightClientStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLightClientStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetLightClientStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLightClientStateResponse.Merge(m, src)
+}
+func (m *QueryGetLightClientStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLightClientStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLightClientStateResponse.DiscardUnknown(m)
 }
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+var xxx_messageInfo_QueryGetLightClientStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetLightClientStateResponse) GetLightClientState() LightClientState {
+	if m != nil {
+		return m.LightClientState
 	}
-	return interceptor(ctx, in, info, handler)
+	return LightClientState{}
 }
 
-func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressByFinalizedHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
+	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
+	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
+	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
+	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
+	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
+	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
+	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
+	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
+	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
+	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
+	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
+	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
+	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
+	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
+	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
+	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
+	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
+	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
+	proto.RegisterType((*QueryObserversByChainRequest)(nil), "zetachain.zetacore.observer.QueryObserversByChainRequest")
+	proto.RegisterType((*QueryObserversByChainResponse)(nil), "zetachain.zetacore.observer.QueryObserversByChainResponse")
+	proto.RegisterType((*QueryAllObserverMappersRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersRequest")
+	proto.RegisterType((*QueryAllObserverMappersResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersResponse")
+	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
+	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
+	proto.RegisterType((*QueryGetCoreParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainRequest")
+	proto.RegisterType((*QueryGetCoreParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainResponse")
+	proto.RegisterType((*QueryGetCoreParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsRequest")
+	proto.RegisterType((*QueryGetCoreParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsResponse")
+	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
+	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
+	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
+	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
+	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
+	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
+	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
+	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
+	proto.RegisterType((*QueryGetKeygenAttemptRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenAttemptRequest")
+	proto.RegisterType((*QueryGetKeygenAttemptResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenAttemptResponse")
+	proto.RegisterType((*QueryAllKeygenAttemptRequest)(nil), "zetachain.zetacore.observer.QueryAllKeygenAttemptRequest")
+	proto.RegisterType((*QueryAllKeygenAttemptResponse)(nil), "zetachain.zetacore.observer.QueryAllKeygenAttemptResponse")
+	proto.RegisterType((*QueryGetLivenessParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetLivenessParamsRequest")
+	proto.RegisterType((*QueryGetLivenessParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetLivenessParamsResponse")
+	proto.RegisterType((*QueryGetObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessRequest")
+	proto.RegisterType((*QueryGetObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessResponse")
+	proto.RegisterType((*QueryAllObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessRequest")
+	proto.RegisterType((*QueryAllObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessResponse")
+	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
+	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
+	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
+	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
+	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
+	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
+	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
+	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
+	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
+	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
+	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
+	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
+	proto.RegisterType((*QueryGetBlockHeaderStateRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateRequest")
+	proto.RegisterType((*QueryGetBlockHeaderStateResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateResponse")
+	proto.RegisterType((*QueryPrunedBlockHeaderRangesRequest)(nil), "zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesRequest")
+	proto.RegisterType((*QueryPrunedBlockHeaderRangesResponse)(nil), "zetachain.zetacore.observer.QueryPrunedBlockHeaderRangesResponse")
+	proto.RegisterType((*QueryGetLightClientStateRequest)(nil), "zetachain.zetacore.observer.QueryGetLightClientStateRequest")
+	proto.RegisterType((*QueryGetLightClientStateResponse)(nil), "zetachain.zetacore.observer.QueryGetLightClientStateResponse")
 }
 
-func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTSSRequest)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
+
+var fileDescriptor_dcb801e455adaee4 = []byte{
+	// 3043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xdf, 0x6f, 0x1c, 0xd5,
+	0xf5, 0xcf, 0xc4, 0xd8, 0xd8, 0x27, 0xb6, 0xe3, 0x5c, 0x3b, 0x04, 0xc6, 0x8e, 0xed, 0x4c, 0x08,
+	0x49, 0x6c, 0xb2, 0x4b, 0x9c, 0x00, 0x49, 0x1c, 0x87, 0xd8, 0x81, 0xd8, 0x81, 0x00, 0x61, 0x37,
+	0x5f, 0xf8, 0x2a, 0xb4, 0x5d, 0x66, 0x77, 0xaf, 0x77, 0x17, 0xd6, 0x33, 0xcb, 0xce, 0xd8, 0xd8,
+	0x18, 0xab, 0x55, 0xd5, 0x4a, 0x15, 0xea, 0x03, 0x52, 0xa5, 0xf6, 0x95, 0x97, 0xf6, 0xad, 0x55,
+	0x85, 0xd4, 0x52, 0x09, 0xf5, 0xa1, 0xbc, 0x14, 0xa9, 0x3f, 0x44, 0x55, 0xa9, 0x6a, 0x1f, 0xa8,
+	0x50, 0x68, 0xff, 0x8f, 0x6a, 0xee, 0x3d, 0x77, 0xe6, 0xce, 0x4f, 0xdf, 0x5d, 0x96, 0x27, 0xcf,
+	0xdc, 0x7b, 0xcf, 0xb9, 0x9f, 0xcf, 0xb9, 0xe7, 0xfe, 0xfa, 0x78, 0x16, 0x26, 0xec, 0xb2, 0x43,
+	0xdb, 0x5b, 0xb4, 0x9d, 0x7f, 0x7b, 0x93, 0xb6, 0x77, 0x72, 0xad, 0xb6, 0xed, 0xda, 0x64, 0xf2,
+	0x5d, 0xea, 0x9a, 0x95, 0xba, 0xd9, 0xb0, 0x72, 0xec, 0xc9, 0x6e, 0xd3, 0x9c, 0x68, 0xa8, 0x8f,
+	0x57, 0xec, 0x8d, 0x0d, 0xdb, 0xca, 0xf3, 0x3f, 0xdc, 0x42, 0x9f, 0xab, 0xd8, 0xce, 0x86, 0xed,
+	0xe4, 0xcb, 0xa6, 0x43, 0xb9, 0xab, 0xfc, 0xd6, 0xf9, 0x32, 0x75, 0xcd, 0xf3, 0xf9, 0x96, 0x59,
+	0x6b, 0x58, 0xa6, 0xdb, 0xf0, 0xdb, 0x4e, 0xd4, 0xec, 0x9a, 0xcd, 0x1e, 0xf3, 0xde, 0x13, 0x96,
+	0x4e, 0xd5, 0x6c, 0xbb, 0xd6, 0xa4, 0x79, 0xb3, 0xd5, 0xc8, 0x9b, 0x96, 0x65, 0xbb, 0xcc, 0xc4,
+	0xc1, 0xda, 0xa3, 0x3e, 0xce, 0xb2, 0xd9, 0x6c, 0xda, 0xae, 0x70, 0x15, 0x14, 0x37, 0xcd, 0x0d,
+	0x8a, 0xa5, 0x93, 0x52, 0xa9, 0x5d, 0x79, 0xab, 0x54, 0xa7, 0x66, 0x95, 0xb6, 0x63, 0x95, 0x8c,
+	0x60, 0xc9, 0xb2, 0xad, 0x0a, 0x15, 0xdd, 0xcc, 0x04, 0x95, 0x6d, 0xdb, 0x71, 0x78, 0x8b, 0xf5,
+	0xa6, 0x59, 0x8b, 0xe3, 0x78, 0x8b, 0xee, 0xd4, 0xa8, 0x15, 0x73, 0xda, 0x6c, 0xd4, 0xea, 0x6e,
+	0xa9, 0xd2, 0x6c, 0x50, 0x4b, 0x80, 0x3c, 0x26, 0x55, 0x6e, 0x51, 0x8b, 0x3a, 0x4e, 0xcc, 0xca,
+	0xb2, 0xab, 0xb4, 0x64, 0x56, 0x2a, 0xf6, 0x66, 0x82, 0x95, 0x78, 0x88, 0x41, 0x68, 0x99, 0x6d,
+	0x73, 0x43, 0x38, 0x3b, 0x1e, 0x14, 0x53, 0xab, 0xda, 0xb0, 0x6a, 0x61, 0x66, 0xc4, 0xaf, 0x76,
+	0x45, 0xff, 0xc6, 0x02, 0xe8, 0xaf, 0x78, 0x43, 0xb5, 0x4a, 0xdd, 0x1b, 0x1e, 0xd3, 0x97, 0x98,
+	0x41, 0x81, 0xbe, 0xbd, 0x49, 0x1d, 0x97, 0x4c, 0x40, 0x7f, 0xc3, 0xaa, 0xd2, 0xed, 0x87, 0xb5,
+	0x59, 0xed, 0xcc, 0x50, 0x81, 0xbf, 0x18, 0x36, 0x4c, 0x26, 0xda, 0x38, 0x2d, 0xdb, 0x72, 0x28,
+	0xb9, 0x03, 0x87, 0xa4, 0x62, 0x66, 0x7a, 0x68, 0xe1, 0x4c, 0x2e, 0x23, 0x9f, 0x72, 0x52, 0xfb,
+	0x95, 0x07, 0x3e, 0xfb, 0xf7, 0xcc, 0x81, 0x82, 0xec, 0xc2, 0xa8, 0x22, 0xc8, 0xe5, 0x66, 0x33,
+	0x01, 0xe4, 0x4d, 0x80, 0x20, 0xbf, 0xb0, 0xbb, 0xc7, 0x72, 0x3c, 0x19, 0x73, 0x5e, 0x32, 0xe6,
+	0x78, 0x5e, 0x63, 0x32, 0xe6, 0xee, 0x98, 0x35, 0x8a, 0xb6, 0x05, 0xc9, 0xd2, 0xf8, 0x9d, 0x06,
+	0x93, 0x89, 0xdd, 0xa4, 0xf1, 0xea, 0xfb, 0x9a, 0xbc, 0xc8, 0x6a, 0x08, 0xf9, 0x41, 0x86, 0xfc,
+	0xf4, 0xbe, 0xc8, 0x39, 0x9c, 0x10, 0xf4, 0x75, 0x98, 0x12, 0xc8, 0xef, 0xf0, 0x91, 0xff, 0x66,
+	0x42, 0xf4, 0x07, 0x0d, 0x8e, 0xa7, 0x74, 0x84, 0x41, 0x7a, 0x0d, 0x46, 0xc3, 0xb9, 0x87, 0x71,
+	0x9a, 0xcb, 0x8c, 0x53, 0xc8, 0x17, 0x46, 0x6a, 0xa4, 0x25, 0x17, 0xf6, 0x2e, 0x56, 0x4b, 0x30,
+	0xcb, 0x28, 0x84, 0xfb, 0xdc, 0x61, 0xe3, 0x22, 0xe2, 0xf5, 0x08, 0x0c, 0xf2, 0x79, 0xdf, 0xa8,
+	0xb2, 0x68, 0xf5, 0x15, 0x1e, 0x64, 0xef, 0xb7, 0xaa, 0xc6, 0x7b, 0x70, 0x22, 0xc3, 0x3c, 0x23,
+	0x0a, 0x5a, 0x0f, 0xa2, 0x60, 0x4c, 0x00, 0x11, 0x53, 0xef, 0x6e, 0xb1, 0x88, 0x70, 0x8d, 0x97,
+	0x61, 0x3c, 0x54, 0x8a, 0x28, 0x2e, 0x41, 0xdf, 0xdd, 0x62, 0x11, 0xbb, 0x9e, 0xcd, 0xec, 0xfa,
+	0x6e, 0xb1, 0x88, 0x1d, 0x7a, 0x26, 0xc6, 0x73, 0xf0, 0x88, 0xef, 0xd0, 0x71, 0x96, 0xab, 0xd5,
+	0x36, 0x75, 0xfc, 0x64, 0x3a, 0x03, 0x63, 0xe5, 0x86, 0x5b, 0xb1, 0x1b, 0x56, 0xc9, 0x0f, 0xd2,
+	0x41, 0x16, 0xa4, 0x51, 0x2c, 0xbf, 0x81, 0xb1, 0xba, 0x0e, 0x7a, 0x92, 0x1b, 0x84, 0x37, 0x06,
+	0x7d, 0xd4, 0xad, 0xe3, 0xd2, 0xe2, 0x3d, 0x7a, 0x25, 0x65, 0xb7, 0xc2, 0x9c, 0x0d, 0x15, 0xbc,
+	0x47, 0xe3, 0x7d, 0x0d, 0xe6, 0xe2, 0x2e, 0x56, 0x76, 0x6e, 0x36, 0x2c, 0xb3, 0xd9, 0x78, 0x97,
+	0x56, 0xd7, 0xa8, 0xb7, 0xd8, 0x0a, 0x68, 0x0b, 0x70, 0x74, 0x5d, 0xd4, 0x94, 0x3c, 0x96, 0xa5,
+	0x3a, 0xab, 0xc7, 0x41, 0x1c, 0xf7, 0x2b, 0xef, 0x51, 0xd7, 0xe4, 0xa6, 0x1d, 0xd0, 0x79, 0x05,
+	0xe6, 0x95, 0xb0, 0x74, 0xc0, 0xef, 0x0d, 0x78, 0x88, 0xb9, 0xbc, 0xeb, 0x38, 0x6b, 0x0d, 0xc7,
+	0xb5, 0xdb, 0x3b, 0xbd, 0x9e, 0xb2, 0x3f, 0xd7, 0xe0, 0x58, 0xac, 0x0b, 0x44, 0xb8, 0x0c, 0x83,
+	0xae, 0xe3, 0x94, 0x9a, 0x0d, 0xc7, 0xc5, 0x69, 0xaa, 0x9a, 0x25, 0x0f, 0xba, 0x8e, 0x73, 0xbb,
+	0xe1, 0xb8, 0xbd, 0x9b, 0x96, 0xbf, 0xd0, 0xe0, 0x08, 0x9f, 0x58, 0x6d, 0x7b, 0x8b, 0xee, 0x3f,
+	0x11, 0xc9, 0x31, 0x78, 0xd0, 0xdd, 0x2e, 0xd5, 0x4d, 0xa7, 0x8e, 0x01, 0x1d, 0x70, 0xb7, 0xd7,
+	0x4c, 0xa7, 0x4e, 0x4e, 0x42, 0x7f, 0xab, 0x6d, 0xdb, 0xeb, 0x0f, 0xf7, 0x31, 0x34, 0x23, 0x39,
+	0x3c, 0xa5, 0xdc, 0xf1, 0x0a, 0x0b, 0xbc, 0x8e, 0x1c, 0x07, 0xc0, 0x83, 0x81, 0xe7, 0xe0, 0x01,
+	0xe6, 0x60, 0x88, 0x95, 0x30, 0x1f, 0x8f, 0xc0, 0xa0, 0xbb, 0x5d, 0xe2, 0x7b, 0x5f, 0x3f, 0xef,
+	0xd7, 0xdd, 0xbe, 0xe5, 0xbd, 0x1a, 0x73, 0x40, 0x64, 0x9c, 0x18, 0xca, 0x09, 0xe8, 0xdf, 0x32,
+	0x9b, 0x88, 0x72, 0xb0, 0xc0, 0x5f, 0xfc, 0xe9, 0x7a, 0x87, 0xed, 0xd2, 0x62, 0xba, 0xfe, 0x3f,
+	0x8c, 0x87, 0x4a, 0xfd, 0xd1, 0x18, 0xe0, 0xbb, 0x39, 0x8e, 0xf6, 0xc9, 0xec, 0xc5, 0x82, 0x35,
+	0xc5, 0xe1, 0x40, 0x43, 0xa3, 0x0e, 0x13, 0xcc, 0xf3, 0x9a, 0xe9, 0xbc, 0x6a, 0xbb, 0xb4, 0x2a,
+	0xc2, 0x38, 0x0f, 0x47, 0xf8, 0x99, 0xa9, 0xd4, 0xa8, 0x52, 0xcb, 0x6d, 0xac, 0x37, 0x68, 0x1b,
+	0x13, 0x73, 0x8c, 0x57, 0xdc, 0xf2, 0xcb, 0xc9, 0x49, 0x18, 0xd9, 0xb2, 0x5d, 0xda, 0x2e, 0x99,
+	0x3c, 0xc3, 0x31, 0xbc, 0xc3, 0xac, 0x10, 0xb3, 0xde, 0xb8, 0x08, 0x47, 0x23, 0x3d, 0x21, 0x8b,
+	0x49, 0x18, 0xaa, 0x9b, 0x4e, 0xc9, 0x6b, 0x2c, 0x82, 0x31, 0x58, 0xc7, 0x46, 0xc6, 0x8b, 0x30,
+	0xcd, 0xac, 0x56, 0x58, 0x9f, 0x2b, 0x3b, 0x41, 0xaf, 0xdd, 0x20, 0x35, 0x5c, 0x18, 0xf2, 0xfc,
+	0xb6, 0x59, 0x26, 0xc6, 0x60, 0x6b, 0x71, 0xd8, 0x64, 0x05, 0x86, 0xbc, 0xf7, 0x92, 0xbb, 0xd3,
+	0xa2, 0x8c, 0xd7, 0xe8, 0xc2, 0xa9, 0xcc, 0x30, 0x7b, 0xfe, 0xef, 0xee, 0xb4, 0x68, 0x61, 0x70,
+	0x0b, 0x9f, 0x8c, 0x8f, 0x0f, 0xc2, 0x4c, 0x2a, 0x0b, 0x8c, 0x42, 0x47, 0x01, 0xbf, 0x06, 0x03,
+	0x0c, 0xa4, 0x17, 0xe9, 0x3e, 0x36, 0xcd, 0xf7, 0x43, 0xc4, 0x18, 0x17, 0xd0, 0x8a, 0xbc, 0x06,
+	0x63, 0xbc, 0x96, 0xcd, 0x24, 0xce, 0xad, 0x8f, 0x71, 0x7b, 0x3c, 0xd3, 0xd3, 0xcb, 0x81, 0x11,
+	0xa3, 0x78, 0xd8, 0x0e, 0x17, 0x90, 0x97, 0x60, 0x04, 0x59, 0x38, 0xae, 0xe9, 0x6e, 0x3a, 0x6c,
+	0x9e, 0x8c, 0x2e, 0x9c, 0xcd, 0xf4, 0xca, 0xa3, 0x52, 0x64, 0x06, 0x85, 0xe1, 0xb2, 0xf4, 0x66,
+	0xbc, 0x80, 0xc7, 0x94, 0x97, 0xb1, 0x6d, 0x74, 0xdb, 0x9d, 0x87, 0x23, 0x32, 0x11, 0xd6, 0x83,
+	0x88, 0x9a, 0x54, 0xc1, 0x6c, 0x8c, 0x25, 0x38, 0x9e, 0xe2, 0x0c, 0xc7, 0x60, 0x0a, 0x86, 0x04,
+	0x28, 0x7e, 0x0a, 0x19, 0x2a, 0x04, 0x05, 0xc6, 0x2c, 0xa6, 0xe2, 0x72, 0xb3, 0x29, 0x3c, 0xbc,
+	0x68, 0xb6, 0x5a, 0xb4, 0xed, 0x4f, 0xd3, 0x1d, 0x98, 0x49, 0x6d, 0x81, 0x5d, 0xbc, 0x2a, 0x22,
+	0x4f, 0xdb, 0xa5, 0x0d, 0x5e, 0x87, 0x0b, 0xe9, 0xbc, 0x42, 0xe4, 0x85, 0x3f, 0x11, 0x78, 0xdf,
+	0xbf, 0xf1, 0x10, 0xce, 0xe3, 0xe2, 0x66, 0xab, 0x65, 0xb7, 0x5d, 0x5a, 0x65, 0xcc, 0x1c, 0xe3,
+	0x39, 0x98, 0x4a, 0x2a, 0xf7, 0xf1, 0x9c, 0x82, 0x01, 0xd6, 0xa5, 0x40, 0xe1, 0xaf, 0x7d, 0x3c,
+	0x32, 0x58, 0x69, 0x5c, 0x83, 0x13, 0xfe, 0x01, 0xde, 0x6e, 0x53, 0xbe, 0x94, 0xdc, 0xb4, 0xdb,
+	0xaa, 0x67, 0x20, 0x0b, 0x8c, 0x2c, 0x7b, 0x04, 0xb3, 0x06, 0x87, 0x3c, 0xd6, 0xa5, 0xd0, 0xa2,
+	0x76, 0x3a, 0xfb, 0xbc, 0xec, 0x7b, 0x2b, 0x40, 0xc5, 0x7f, 0x36, 0x26, 0x83, 0xe3, 0x88, 0xd4,
+	0x02, 0x87, 0xe9, 0x4d, 0xd0, 0x93, 0x2a, 0x11, 0xc4, 0xed, 0x24, 0x10, 0xf3, 0x8a, 0x20, 0xd8,
+	0x2c, 0x93, 0x81, 0x48, 0xb7, 0xa5, 0x97, 0xec, 0x2a, 0x5d, 0xe6, 0xb7, 0xb5, 0xec, 0xdb, 0xd2,
+	0x9b, 0x30, 0x99, 0x68, 0x83, 0x00, 0x5f, 0x80, 0x61, 0xf9, 0xe6, 0xa7, 0x74, 0x5d, 0x92, 0xfd,
+	0x1c, 0xb2, 0x82, 0x17, 0xf9, 0xa2, 0x94, 0x80, 0xaf, 0x57, 0x47, 0x8a, 0x8f, 0xa4, 0x8b, 0x52,
+	0x12, 0xa5, 0xe7, 0xe1, 0x90, 0x54, 0xac, 0x74, 0x51, 0x0a, 0x31, 0x92, 0x5e, 0x7a, 0x77, 0xbe,
+	0x10, 0xf3, 0xdd, 0x4b, 0x13, 0xff, 0x5e, 0x7f, 0xd3, 0xbb, 0xd6, 0x8b, 0x44, 0xfa, 0x9e, 0x06,
+	0x33, 0xa9, 0x4d, 0x90, 0xda, 0xb7, 0x61, 0x2c, 0xaa, 0x0a, 0x60, 0x20, 0xb3, 0x97, 0xda, 0x88,
+	0x3f, 0xdc, 0xb6, 0x0f, 0x57, 0xc2, 0xc5, 0xc6, 0x31, 0xdc, 0x55, 0x57, 0xa9, 0xfb, 0x02, 0xd3,
+	0x16, 0x04, 0xb6, 0xff, 0x83, 0x87, 0xa2, 0x15, 0x88, 0x68, 0x11, 0x06, 0xb8, 0x0c, 0xa1, 0x74,
+	0x6a, 0x40, 0x63, 0x34, 0x31, 0x2e, 0xc2, 0x54, 0xd8, 0xed, 0xb2, 0xeb, 0xd2, 0x8d, 0x56, 0x72,
+	0x46, 0x3f, 0x20, 0x32, 0x7a, 0x1b, 0x8e, 0xa7, 0x58, 0x05, 0xd7, 0x1f, 0xde, 0x41, 0xc9, 0xe4,
+	0x35, 0x4a, 0xd7, 0x9f, 0x90, 0x2f, 0x71, 0xfd, 0x79, 0x4b, 0x2e, 0x94, 0xef, 0xb9, 0x89, 0x78,
+	0xbf, 0x89, 0x7b, 0xae, 0x3a, 0xc5, 0xbe, 0x1e, 0x50, 0xec, 0x5d, 0xc2, 0xcf, 0x04, 0xa3, 0x74,
+	0x1b, 0x35, 0xa7, 0xf0, 0xc2, 0xf9, 0x1e, 0x4c, 0xa7, 0x35, 0x40, 0x92, 0xf7, 0xe0, 0xb0, 0x90,
+	0xab, 0x3a, 0x59, 0x40, 0xc3, 0xde, 0x90, 0xe6, 0x68, 0x33, 0x54, 0x6a, 0xd4, 0x82, 0xc9, 0x26,
+	0x76, 0x43, 0x61, 0xa7, 0x70, 0xf8, 0x3f, 0x2b, 0x6d, 0xbc, 0xe1, 0x63, 0xaa, 0xbf, 0x97, 0x8a,
+	0x93, 0xea, 0x0f, 0x34, 0x98, 0x4d, 0xef, 0x09, 0x99, 0xbe, 0x21, 0x4e, 0x1e, 0xb4, 0x5d, 0x12,
+	0x40, 0x91, 0xeb, 0x39, 0xa5, 0x9d, 0x5c, 0x78, 0x44, 0xb6, 0x63, 0x76, 0xa4, 0xdc, 0x68, 0xc4,
+	0x4f, 0x13, 0x51, 0xbe, 0xbd, 0xca, 0xde, 0xbf, 0x08, 0xc6, 0x89, 0x7d, 0x65, 0x33, 0xee, 0xeb,
+	0x19, 0xe3, 0xde, 0x67, 0x72, 0xb1, 0x6e, 0xbf, 0x23, 0x7a, 0xbf, 0x21, 0x6d, 0x6c, 0xde, 0xca,
+	0x3d, 0x9d, 0xd6, 0x02, 0xe9, 0x7e, 0x07, 0xc6, 0x9b, 0xa6, 0xe3, 0x96, 0x7c, 0xce, 0xf2, 0x6e,
+	0x9b, 0xcb, 0x4e, 0x67, 0xd3, 0x71, 0xc3, 0x4e, 0x8f, 0x34, 0xa3, 0x45, 0xc6, 0xf3, 0x88, 0x71,
+	0xc5, 0xd3, 0xa0, 0x93, 0x2e, 0x36, 0x67, 0x61, 0x8c, 0xe9, 0xd3, 0xf1, 0x0b, 0xc1, 0x61, 0x56,
+	0x1e, 0x58, 0x18, 0x15, 0x71, 0x4b, 0x8a, 0xfb, 0xf2, 0xaf, 0x8a, 0x80, 0xce, 0xac, 0x75, 0x1b,
+	0x49, 0x18, 0xd9, 0xa7, 0x72, 0xaf, 0x79, 0x61, 0x88, 0x77, 0x65, 0xad, 0xdb, 0x06, 0x0d, 0xf6,
+	0x70, 0x5e, 0x47, 0x2b, 0x76, 0xbb, 0xda, 0xf3, 0x5c, 0xfc, 0xb5, 0x06, 0x53, 0xc9, 0xfd, 0x20,
+	0x95, 0xd5, 0x08, 0x95, 0x3e, 0x35, 0x2a, 0x98, 0x75, 0x01, 0xa1, 0xde, 0xa5, 0x5b, 0x11, 0x67,
+	0x0f, 0x86, 0x9f, 0x1d, 0x6a, 0x97, 0xad, 0x2a, 0x53, 0xe0, 0x14, 0x96, 0xa6, 0x09, 0xe8, 0x67,
+	0x9a, 0x1f, 0x8a, 0x48, 0xfc, 0xc5, 0x58, 0x87, 0x13, 0x19, 0x4e, 0x53, 0x86, 0xb5, 0xaf, 0xf3,
+	0x61, 0x95, 0x4e, 0x80, 0x2b, 0x4c, 0xcd, 0x60, 0xff, 0xf7, 0xe8, 0xf5, 0xa8, 0x7e, 0xa8, 0xc1,
+	0x64, 0x62, 0x37, 0xbe, 0xf2, 0x38, 0x22, 0xff, 0xdb, 0x45, 0x2c, 0x2c, 0xe3, 0xe2, 0x3a, 0x22,
+	0xdb, 0x0c, 0x97, 0x83, 0x97, 0x1e, 0x2e, 0x1a, 0xcb, 0xc1, 0xaa, 0x2f, 0xf5, 0xb6, 0xe2, 0x09,
+	0x16, 0x75, 0x11, 0x8e, 0xb0, 0x08, 0xe4, 0x85, 0x63, 0x58, 0x12, 0x81, 0x8c, 0xd7, 0xe1, 0x44,
+	0x86, 0x0b, 0xa4, 0xfa, 0x14, 0x0c, 0xcb, 0x54, 0x31, 0xa8, 0x89, 0x4c, 0x0f, 0x49, 0x4c, 0x8d,
+	0xab, 0xc1, 0xfe, 0x27, 0xb5, 0xf1, 0x2e, 0xca, 0x0a, 0x49, 0x66, 0x7c, 0x17, 0x66, 0xd3, 0xad,
+	0x11, 0xd9, 0xeb, 0x40, 0x64, 0x64, 0xec, 0x0e, 0x4f, 0x95, 0x36, 0xb5, 0x98, 0xcb, 0xb1, 0x72,
+	0xa4, 0xc4, 0xb8, 0x0e, 0x27, 0x51, 0x05, 0xdb, 0xb4, 0x68, 0x55, 0x66, 0x69, 0x5a, 0x35, 0xaa,
+	0xb0, 0x85, 0x7b, 0x39, 0xf4, 0x68, 0xb6, 0x0b, 0xe4, 0x51, 0x84, 0x91, 0x16, 0x6b, 0x52, 0x6a,
+	0xb3, 0x0a, 0xa5, 0x0b, 0x05, 0x6a, 0xb1, 0x9e, 0x01, 0x2e, 0x15, 0xc3, 0xdc, 0x09, 0x77, 0xee,
+	0xa9, 0x45, 0xe8, 0x14, 0x15, 0x62, 0x3e, 0x5b, 0xb1, 0x11, 0xb7, 0x96, 0xc7, 0xe8, 0xb6, 0x57,
+	0x70, 0x83, 0xfd, 0x4b, 0x4f, 0x75, 0x8c, 0x7e, 0x28, 0x1d, 0x3c, 0xe2, 0xe6, 0x48, 0xce, 0x04,
+	0x22, 0xff, 0xbb, 0xb0, 0x83, 0x41, 0x8a, 0xba, 0x14, 0xfb, 0x70, 0x33, 0x52, 0xbe, 0xf0, 0xa3,
+	0x27, 0xa1, 0x9f, 0xe1, 0x20, 0x1f, 0x68, 0x30, 0xc0, 0x8f, 0x5f, 0x24, 0x9f, 0xe9, 0x3b, 0x2e,
+	0x5a, 0xea, 0x4f, 0xa8, 0x1b, 0x70, 0x6a, 0xc6, 0xc9, 0xef, 0xff, 0xfd, 0x3f, 0x3f, 0x39, 0x78,
+	0x9c, 0x4c, 0xe6, 0xbd, 0xf6, 0xe7, 0x98, 0x69, 0x3e, 0xf2, 0x8f, 0x4b, 0xf2, 0x7b, 0x0d, 0x06,
+	0x85, 0x86, 0x48, 0xce, 0xef, 0xdf, 0x47, 0x44, 0xd9, 0xd4, 0x17, 0x3a, 0x31, 0x41, 0x60, 0xcf,
+	0x33, 0x60, 0xcf, 0x92, 0x95, 0x44, 0x60, 0xbe, 0x7a, 0x99, 0xdf, 0x8d, 0x49, 0x78, 0x7b, 0xf9,
+	0xdd, 0x90, 0xc6, 0xb8, 0x47, 0xfe, 0xa1, 0x01, 0x89, 0xeb, 0x80, 0x64, 0x71, 0x7f, 0x58, 0xa9,
+	0x1a, 0xa8, 0x7e, 0xb5, 0x3b, 0x63, 0x64, 0xf7, 0x1c, 0x63, 0xf7, 0x0c, 0x59, 0x4a, 0x64, 0x87,
+	0x94, 0xca, 0x3b, 0x12, 0xab, 0x24, 0xa2, 0xe4, 0xaf, 0x1a, 0x8c, 0x45, 0xa5, 0x35, 0x72, 0x79,
+	0x7f, 0x64, 0x29, 0xda, 0x9e, 0x7e, 0xa5, 0x1b, 0x53, 0xa4, 0x74, 0x83, 0x51, 0x5a, 0x22, 0x8b,
+	0x89, 0x94, 0xc4, 0x83, 0xe3, 0xb1, 0xe2, 0x75, 0xbb, 0x31, 0x19, 0x71, 0x8f, 0x7c, 0xaa, 0x01,
+	0x89, 0x4b, 0x79, 0x2a, 0x23, 0x95, 0x2a, 0x11, 0xea, 0x57, 0xbb, 0x33, 0x46, 0x5a, 0xe7, 0x19,
+	0xad, 0x79, 0x72, 0x36, 0x91, 0x96, 0xd9, 0x6c, 0x96, 0xa2, 0xe2, 0x22, 0xf9, 0xa5, 0x06, 0x87,
+	0x23, 0xe2, 0x9f, 0xca, 0xac, 0x89, 0x98, 0xe8, 0x97, 0x3b, 0x36, 0xf1, 0x41, 0x3f, 0xce, 0x40,
+	0x3f, 0x46, 0x1e, 0x4d, 0x04, 0xed, 0x44, 0xb0, 0x7d, 0xa1, 0xc1, 0xd1, 0x44, 0x95, 0x90, 0x5c,
+	0xdb, 0x1f, 0x42, 0x96, 0x3c, 0xa9, 0x3f, 0xd3, 0xb5, 0xbd, 0x52, 0x52, 0xd5, 0xa8, 0xbf, 0x24,
+	0xf3, 0x95, 0xaa, 0xb4, 0x6e, 0xb7, 0x45, 0x76, 0x89, 0x15, 0x7f, 0x8f, 0xfc, 0x4a, 0x83, 0x91,
+	0x50, 0x37, 0xe4, 0xa9, 0x0e, 0x71, 0x09, 0x3e, 0x4f, 0x77, 0x6c, 0xa7, 0x34, 0x20, 0x8c, 0x47,
+	0x20, 0x80, 0x92, 0x8f, 0xb4, 0x90, 0x38, 0x47, 0xd4, 0xba, 0x8d, 0x8b, 0x89, 0xfa, 0xa5, 0xce,
+	0x0d, 0x11, 0xf0, 0x13, 0x0c, 0xf0, 0x1c, 0x39, 0x93, 0x08, 0x58, 0x92, 0x33, 0xf3, 0xbb, 0x4c,
+	0x6f, 0xda, 0xf3, 0xb2, 0x7e, 0x54, 0xf2, 0xb4, 0xdc, 0x6c, 0xaa, 0xe0, 0x4e, 0x14, 0x41, 0xf5,
+	0x4b, 0x9d, 0x1b, 0x22, 0xee, 0x33, 0x0c, 0xb7, 0x41, 0x66, 0xf7, 0xc3, 0x4d, 0x3e, 0xd1, 0xe0,
+	0x70, 0x44, 0xf1, 0x23, 0x8b, 0x6a, 0xe3, 0x9b, 0x28, 0x4d, 0xea, 0x57, 0xbb, 0x33, 0x46, 0xe0,
+	0xe7, 0x18, 0xf0, 0xd3, 0xe4, 0x54, 0x22, 0xf0, 0xa8, 0x9e, 0x49, 0x7e, 0xaa, 0xc1, 0x00, 0x17,
+	0xaa, 0xc8, 0x82, 0x52, 0xbf, 0x21, 0xa9, 0x52, 0xbf, 0xd0, 0x91, 0x8d, 0xd2, 0x59, 0x81, 0x2b,
+	0x64, 0x5e, 0x58, 0x47, 0x42, 0x0a, 0x1a, 0xb9, 0xdc, 0x41, 0x5f, 0x61, 0xa9, 0x50, 0xbf, 0xd2,
+	0x8d, 0x29, 0xa2, 0xbd, 0xc0, 0xd0, 0x9e, 0x23, 0xf3, 0x19, 0x68, 0x85, 0x2e, 0xe8, 0x27, 0xf1,
+	0x6f, 0x35, 0x18, 0x0b, 0xb9, 0xf3, 0xd2, 0xf8, 0xb2, 0x52, 0x36, 0x76, 0x4b, 0x20, 0x4d, 0xbd,
+	0x34, 0xe6, 0x19, 0x81, 0x53, 0xe4, 0xa4, 0x02, 0x01, 0xf2, 0xb1, 0x06, 0xa3, 0x61, 0x49, 0x8f,
+	0xa8, 0x05, 0x2f, 0x51, 0x76, 0xd4, 0x17, 0xbb, 0xb2, 0x55, 0x5a, 0xec, 0x22, 0x62, 0x25, 0xf9,
+	0x42, 0x3a, 0xc3, 0x08, 0x87, 0x44, 0x6d, 0x2e, 0xa5, 0x68, 0x74, 0xfa, 0x52, 0x97, 0xd6, 0x88,
+	0xff, 0x36, 0xc3, 0x7f, 0x93, 0x3c, 0x9b, 0x79, 0x92, 0xf1, 0x05, 0x39, 0x69, 0xab, 0x11, 0x67,
+	0x1a, 0xf9, 0xf0, 0xf9, 0xa9, 0x06, 0xe3, 0xd1, 0xae, 0xbc, 0xac, 0xea, 0xec, 0x58, 0xd2, 0x05,
+	0xc5, 0x0c, 0x61, 0xd1, 0xc8, 0x31, 0x8a, 0x67, 0xc8, 0x63, 0x6a, 0x14, 0xc9, 0x1f, 0x35, 0x38,
+	0x12, 0xd3, 0xed, 0x54, 0x32, 0x2c, 0x4d, 0x0e, 0xd4, 0x17, 0xbb, 0xb2, 0x45, 0xf8, 0x97, 0x19,
+	0xfc, 0x0b, 0xe4, 0xbc, 0x0c, 0x5f, 0x78, 0x09, 0x78, 0x38, 0x75, 0xfb, 0x9d, 0x88, 0x98, 0x48,
+	0xfe, 0xa6, 0xc1, 0x91, 0x98, 0x66, 0xa7, 0xc2, 0x24, 0x4d, 0x34, 0xd4, 0x17, 0xbb, 0xb2, 0x55,
+	0x3a, 0xe0, 0x70, 0xa1, 0x29, 0x7a, 0x0f, 0x88, 0x28, 0x94, 0x7b, 0xde, 0xfd, 0x8c, 0xac, 0x52,
+	0x37, 0xa2, 0xde, 0x11, 0xb5, 0x5d, 0x34, 0x41, 0x58, 0xd4, 0x2f, 0x77, 0x61, 0x89, 0x84, 0x16,
+	0x18, 0xa1, 0xc7, 0xc9, 0x5c, 0xea, 0x49, 0xc7, 0x3b, 0x33, 0x73, 0x0e, 0x6d, 0x04, 0xfa, 0xa5,
+	0x06, 0x47, 0x99, 0x33, 0x27, 0x22, 0xba, 0x91, 0x25, 0xe5, 0xd8, 0x26, 0x29, 0x80, 0xfa, 0xb5,
+	0x6e, 0xcd, 0x91, 0xcc, 0x1a, 0x23, 0xb3, 0x42, 0xae, 0x67, 0x8f, 0x0e, 0x9f, 0xff, 0xa6, 0x55,
+	0xe5, 0x1f, 0x12, 0x86, 0x16, 0x04, 0x56, 0xb2, 0x47, 0x3e, 0x91, 0x86, 0x48, 0x52, 0xd2, 0x9e,
+	0x56, 0x0c, 0x74, 0x54, 0x24, 0xd4, 0x2f, 0x75, 0x6e, 0xd8, 0xe1, 0x00, 0x49, 0xca, 0x20, 0xf9,
+	0x97, 0x06, 0x13, 0x49, 0x02, 0x1b, 0x51, 0x5b, 0x69, 0xd3, 0xb4, 0x3d, 0xfd, 0x5a, 0xb7, 0xe6,
+	0xc8, 0x65, 0x85, 0x71, 0xb9, 0x4a, 0xae, 0xa4, 0x72, 0x09, 0x89, 0x6b, 0xe5, 0x1d, 0x26, 0x22,
+	0xe6, 0x77, 0xb1, 0xd4, 0x74, 0xea, 0x7b, 0xe4, 0xbf, 0x1a, 0xe8, 0x09, 0x0a, 0x9d, 0xb8, 0x4d,
+	0x5f, 0xed, 0x14, 0xa2, 0xac, 0x3c, 0xe9, 0x4b, 0x5d, 0x5a, 0x2b, 0x89, 0x20, 0x31, 0x7e, 0x4c,
+	0x97, 0x0a, 0x12, 0xb2, 0x51, 0x95, 0x6f, 0x41, 0xf7, 0x35, 0x38, 0x96, 0xa2, 0xe2, 0x91, 0xeb,
+	0x0a, 0xba, 0x51, 0xa6, 0x86, 0xa8, 0x2f, 0x7f, 0x0d, 0x0f, 0x4a, 0x83, 0x89, 0x42, 0x60, 0x88,
+	0x2f, 0x97, 0x1a, 0x65, 0x92, 0x7f, 0xd2, 0x60, 0x2c, 0xaa, 0xb9, 0x29, 0x0e, 0x61, 0x8a, 0x78,
+	0xa8, 0x2f, 0x75, 0x69, 0x8d, 0xac, 0xae, 0x30, 0x56, 0x17, 0xc9, 0x42, 0xca, 0x61, 0x28, 0x2a,
+	0x2b, 0xca, 0x6c, 0x7e, 0xac, 0x41, 0x3f, 0xfb, 0x82, 0x91, 0xe4, 0x54, 0xc2, 0x1b, 0x7c, 0x92,
+	0xa9, 0xe7, 0x95, 0xdb, 0x23, 0x4c, 0x83, 0xc1, 0x9c, 0x22, 0x7a, 0x4a, 0xf0, 0x3d, 0x10, 0x78,
+	0x8f, 0x0e, 0x3e, 0xab, 0x55, 0xbc, 0x47, 0xc7, 0xbe, 0x4e, 0xd6, 0x9f, 0xee, 0xd8, 0x4e, 0xf9,
+	0x1e, 0xed, 0x3a, 0x8e, 0x38, 0x7b, 0x91, 0x9f, 0x1d, 0x84, 0xe9, 0xec, 0xef, 0x80, 0xc9, 0x6a,
+	0x87, 0x48, 0xd2, 0xbe, 0x6a, 0xd6, 0xd7, 0xbe, 0xbe, 0x23, 0xe4, 0x58, 0x66, 0x1c, 0xbf, 0x45,
+	0xee, 0xa9, 0x70, 0x2c, 0xd5, 0xd9, 0xe7, 0xc2, 0x8d, 0x8a, 0xd9, 0xcc, 0xef, 0x26, 0x7e, 0x56,
+	0xbd, 0x97, 0xdf, 0x8d, 0x7e, 0x3a, 0xbd, 0x47, 0xde, 0xd7, 0xd8, 0x67, 0xe7, 0x24, 0xaf, 0x86,
+	0xba, 0x58, 0xec, 0x40, 0x61, 0x0e, 0x7f, 0xe0, 0x6e, 0xcc, 0x32, 0x3a, 0x3a, 0x79, 0x38, 0x91,
+	0x8e, 0x07, 0xe2, 0x43, 0x0d, 0x20, 0xf8, 0xf0, 0x99, 0x28, 0xdc, 0x4d, 0x63, 0x5f, 0x62, 0xeb,
+	0x17, 0x3b, 0x33, 0x42, 0x6c, 0xa7, 0x19, 0xb6, 0x13, 0x64, 0x26, 0x11, 0x9b, 0x1b, 0x60, 0xfa,
+	0x8d, 0x06, 0x63, 0xa1, 0x2f, 0xff, 0xd5, 0xef, 0x85, 0x49, 0xbf, 0xf5, 0xd0, 0xaf, 0x74, 0x63,
+	0x8a, 0xa0, 0xe7, 0x18, 0xe8, 0x47, 0x89, 0x91, 0x3c, 0x55, 0x65, 0x1b, 0xf2, 0x67, 0x0d, 0x26,
+	0x92, 0x7e, 0x04, 0xa1, 0xb2, 0x71, 0x67, 0xfc, 0xf6, 0x42, 0xbf, 0xd6, 0xad, 0x39, 0x72, 0x78,
+	0x92, 0x71, 0xc8, 0x93, 0x73, 0xfb, 0x73, 0x90, 0x17, 0x44, 0x4f, 0x18, 0x93, 0x7f, 0x9b, 0xa3,
+	0xa8, 0xc7, 0xc5, 0x7e, 0x8e, 0xa4, 0x5f, 0xea, 0xdc, 0x50, 0x49, 0x18, 0xab, 0x04, 0x16, 0x21,
+	0x61, 0x4c, 0xf2, 0xa4, 0x2e, 0x8c, 0x75, 0x87, 0x3b, 0xf9, 0x87, 0x51, 0xfb, 0x08, 0x63, 0x12,
+	0xee, 0x95, 0x5b, 0x9f, 0xdd, 0x9f, 0xd6, 0x3e, 0xbf, 0x3f, 0xad, 0x7d, 0x79, 0x7f, 0x5a, 0xfb,
+	0xe0, 0xab, 0xe9, 0x03, 0x9f, 0x7f, 0x35, 0x7d, 0xe0, 0x9f, 0x5f, 0x4d, 0x1f, 0xb8, 0x97, 0xaf,
+	0x35, 0xdc, 0xfa, 0x66, 0xd9, 0xfb, 0xb7, 0x69, 0xe2, 0xc5, 0x6b, 0x3b, 0x70, 0xe8, 0xee, 0xb4,
+	0xa8, 0x53, 0x1e, 0x60, 0xbf, 0x5f, 0xbb, 0xf0, 0xbf, 0x01, 0x00, 0xd5, 0x11, 0x91, 0xba, 0xbe,
+	0x38, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error)
+	AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error)
+	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetClientParamsForChain items.
+	GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error)
+	// Queries a list of GetCoreParams items.
+	GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
+	// Queries a keygen attempt by index.
+	KeygenAttempt(ctx context.Context, in *QueryGetKeygenAttemptRequest, opts ...grpc.CallOption) (*QueryGetKeygenAttemptResponse, error)
+	// Queries the history of keygen attempts.
+	KeygenAttemptAll(ctx context.Context, in *QueryAllKeygenAttemptRequest, opts ...grpc.CallOption) (*QueryAllKeygenAttemptResponse, error)
+	// Queries the liveness params.
+	LivenessParams(ctx context.Context, in *QueryGetLivenessParamsRequest, opts ...grpc.CallOption) (*QueryGetLivenessParamsResponse, error)
+	// Queries the liveness of an observer for a chain.
+	ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error)
+	// Queries the liveness of all observers.
+	ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
+	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
+	GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error)
+	// Queries the height ranges of the pruned block headers of a chain, the proofs against them can't be verified.
+	PrunedBlockHeaderRanges(ctx context.Context, in *QueryPrunedBlockHeaderRangesRequest, opts ...grpc.CallOption) (*QueryPrunedBlockHeaderRangesResponse, error)
+	// Queries the state of the beacon chain light client of an Ethereum chain.
+	LightClientState(ctx context.Context, in *QueryGetLightClientStateRequest, opts ...grpc.CallOption) (*QueryGetLightClientStateResponse, error)
+	// merkle proof verification
+	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
+	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/TSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTssHistoryRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
+	out := new(QueryHasVotedResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TssHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/TssHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
+	out := new(QueryBallotByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingNoncesByChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error) {
+	out := new(QueryObserversByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserversByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error) {
+	out := new(QueryAllObserverMappersResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AllObserverMappers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
+	out := new(QuerySupportedChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ChainNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error) {
+	out := new(QueryGetCoreParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParamsForChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HasVoted",
-			Handler:    _Query_HasVoted_Handler,
-		},
-		{
-			MethodName: "BallotByIdentifier",
-			Handler:    _Query_BallotByIdentifier_Handler,
-		},
-		{
-			MethodName: "ObserversByChain",
-			Handler:    _Query_ObserversByChain_Handler,
-		},
-		{
-			MethodName: "AllObserverMappers",
-			Handler:    _Query_AllObserverMappers_Handler,
-		},
-		{
-			MethodName: "SupportedChains",
-			Handler:    _Query_SupportedChains_Handler,
-		},
-		{
-			MethodName: "GetCoreParamsForChain",
-			Handler:    _Query_GetCoreParamsForChain_Handler,
-		},
-		{
-			MethodName: "GetCoreParams",
-			Handler:    _Query_GetCoreParams_Handler,
-		},
-		{
-			MethodName: "NodeAccount",
-			Handler:    _Query_NodeAccount_Handler,
-		},
-		{
-			MethodName: "NodeAccountAll",
-			Handler:    _Query_NodeAccountAll_Handler,
-		},
-		{
-			MethodName: "CrosschainFlags",
-			Handler:    _Query_CrosschainFlags_Handler,
-		},
-		{
-			MethodName: "Keygen",
-			Handler:    _Query_Keygen_Handler,
-		},
-		{
-			MethodName: "ShowObserverCount",
-			Handler:    _Query_ShowObserverCount_Handler,
-		},
-		{
-			MethodName: "BlameByIdentifier",
-			Handler:    _Query_BlameByIdentifier_Handler,
-		},
-		{
-			MethodName: "GetAllBlameRecords",
-			Handler:    _Query_GetAllBlameRecords_Handler,
-		},
-		{
-			MethodName: "BlamesByChainAndNonce",
-			Handler:    _Query_BlamesByChainAndNonce_Handler,
-		},
-		{
-			MethodName: "GetAllBlockHeaders",
-			Handler:    _Query_GetAllBlockHeaders_Handler,
-		},
-		{
-			MethodName: "GetBlockHeaderByHash",
-			Handler:    _Query_GetBlockHeaderByHash_Handler,
-		},
-		{
-			MethodName: "GetBlockHeaderStateByChain",
-			Handler:    _Query_GetBlockHeaderStateByChain_Handler,
-		},
-		{
-			MethodName: "Prove",
-			Handler:    _Query_Prove_Handler,
-		},
-		{
-			MethodName: "GetTssAddress",
-			Handler:    _Query_GetTssAddress_Handler,
-		},
-		{
-			MethodName: "GetTssAddressByFinalizedHeight",
-			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
-		},
-		{
-			MethodName: "TSS",
-			Handler:    _Query_TSS_Handler,
-		},
-		{
-			MethodName: "TssHistory",
-			Handler:    _Query_TssHistory_Handler,
-		},
-		{
-			MethodName: "PendingNoncesAll",
-			Handler:    _Query_PendingNoncesAll_Handler,
-		},
-		{
-			MethodName: "PendingNoncesByChain",
-			Handler:    _Query_PendingNoncesByChain_Handler,
-		},
-		{
-			MethodName: "ChainNonces",
-			Handler:    _Query_ChainNonces_Handler,
-		},
-		{
-			MethodName: "ChainNoncesAll",
-			Handler:    _Query_ChainNoncesAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "observer/query.proto",
+func (c *queryClient) GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error) {
+	out := new(QueryGetCoreParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
+	out := new(QueryGetNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
+	out := new(QueryAllNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
+	out := new(QueryGetCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
+	out := new(QueryGetKeygenResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeygenAttempt(ctx context.Context, in *QueryGetKeygenAttemptRequest, opts ...grpc.CallOption) (*QueryGetKeygenAttemptResponse, error) {
+	out := new(QueryGetKeygenAttemptResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/KeygenAttempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) KeygenAttemptAll(ctx context.Context, in *QueryAllKeygenAttemptRequest, opts ...grpc.CallOption) (*QueryAllKeygenAttemptResponse, error) {
+	out := new(QueryAllKeygenAttemptResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/KeygenAttemptAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LivenessParams(ctx context.Context, in *QueryGetLivenessParamsRequest, opts ...grpc.CallOption) (*QueryGetLivenessParamsResponse, error) {
+	out := new(QueryGetLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/LivenessParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error) {
+	out := new(QueryGetObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error) {
+	out := new(QueryAllObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLivenessAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
+	out := new(QueryShowObserverCountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
+	out := new(QueryBlameByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
+	out := new(QueryAllBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
+	out := new(QueryBlameByChainAndNonceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
+	out := new(QueryAllBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
+	out := new(QueryGetBlockHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error) {
+	out := new(QueryGetBlockHeaderStateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PrunedBlockHeaderRanges(ctx context.Context, in *QueryPrunedBlockHeaderRangesRequest, opts ...grpc.CallOption) (*QueryPrunedBlockHeaderRangesResponse, error) {
+	out := new(QueryPrunedBlockHeaderRangesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PrunedBlockHeaderRanges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) LightClientState(ctx context.Context, in *QueryGetLightClientStateRequest, opts ...grpc.CallOption) (*QueryGetLightClientStateResponse, error) {
+	out := new(QueryGetLightClientStateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/LightClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
+	out := new(QueryProveResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	out := new(QueryGetTssAddressByFinalizedHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
+	out := new(QueryGetTSSResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
+	out := new(QueryTssHistoryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
+	out := new(QueryAllPendingNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
+	out := new(QueryPendingNoncesByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
+	out := new(QueryGetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
+	out := new(QueryAllChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserversByChain(context.Context, *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error)
+	AllObserverMappers(context.Context, *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error)
+	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetClientParamsForChain items.
+	GetCoreParamsForChain(context.Context, *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error)
+	// Queries a list of GetCoreParams items.
+	GetCoreParams(context.Context, *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
+	// Queries a keygen attempt by index.
+	KeygenAttempt(context.Context, *QueryGetKeygenAttemptRequest) (*QueryGetKeygenAttemptResponse, error)
+	// Queries the history of keygen attempts.
+	KeygenAttemptAll(context.Context, *QueryAllKeygenAttemptRequest) (*QueryAllKeygenAttemptResponse, error)
+	// Queries the liveness params.
+	LivenessParams(context.Context, *QueryGetLivenessParamsRequest) (*QueryGetLivenessParamsResponse, error)
+	// Queries the liveness of an observer for a chain.
+	ObserverLiveness(context.Context, *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error)
+	// Queries the liveness of all observers.
+	ObserverLivenessAll(context.Context, *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
+	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
+	GetBlockHeaderStateByChain(context.Context, *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error)
+	// Queries the height ranges of the pruned block headers of a chain, the proofs against them can't be verified.
+	PrunedBlockHeaderRanges(context.Context, *QueryPrunedBlockHeaderRangesRequest) (*QueryPrunedBlockHeaderRangesResponse, error)
+	// Queries the state of the beacon chain light client of an Ethereum chain.
+	LightClientState(context.Context, *QueryGetLightClientStateRequest) (*QueryGetLightClientStateResponse, error)
+	// merkle proof verification
+	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
+	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
+}
+func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) ObserversByChain(ctx context.Context, req *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserversByChain not implemented")
+}
+func (*UnimplementedQueryServer) AllObserverMappers(ctx context.Context, req *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllObserverMappers not implemented")
+}
+func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+}
+func (*UnimplementedQueryServer) GetCoreParamsForChain(ctx context.Context, req *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParamsForChain not implemented")
+}
+func (*UnimplementedQueryServer) GetCoreParams(ctx context.Context, req *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParams not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+}
+func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+}
+func (*UnimplementedQueryServer) KeygenAttempt(ctx context.Context, req *QueryGetKeygenAttemptRequest) (*QueryGetKeygenAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeygenAttempt not implemented")
+}
+func (*UnimplementedQueryServer) KeygenAttemptAll(ctx context.Context, req *QueryAllKeygenAttemptRequest) (*QueryAllKeygenAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeygenAttemptAll not implemented")
+}
+func (*UnimplementedQueryServer) LivenessParams(ctx context.Context, req *QueryGetLivenessParamsRequest) (*QueryGetLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessParams not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLiveness(ctx context.Context, req *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLiveness not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLivenessAll(ctx context.Context, req *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLivenessAll not implemented")
+}
+func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+}
+func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+}
+func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockHeaderStateByChain(ctx context.Context, req *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderStateByChain not implemented")
+}
+func (*UnimplementedQueryServer) PrunedBlockHeaderRanges(ctx context.Context, req *QueryPrunedBlockHeaderRangesRequest) (*QueryPrunedBlockHeaderRangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunedBlockHeaderRanges not implemented")
+}
+func (*UnimplementedQueryServer) LightClientState(ctx context.Context, req *QueryGetLightClientStateRequest) (*QueryGetLightClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightClientState not implemented")
+}
+func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+}
+func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+}
+func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+}
+func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+}
+func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FinalizedZetaHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasVotedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).HasVoted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
+func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).BallotByIdentifier(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ObserversByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserversByChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ObserversByChain(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserversByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserversByChain(ctx, req.(*QueryObserversByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllObserverMappers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverMappersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).AllObserverMappers(ctx, in)
 	}
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/AllObserverMappers",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllObserverMappers(ctx, req.(*QueryAllObserverMappersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupportedChains)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SupportedChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetCoreParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoreParamsForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCoreParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCoreParamsForChain(ctx, req.(*QueryGetCoreParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x22
+func _Query_GetCoreParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoreParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetCoreParams(ctx, in)
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParams",
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCoreParams(ctx, req.(*QueryGetCoreParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Keygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_KeygenAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenAttemptRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).KeygenAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/KeygenAttempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeygenAttempt(ctx, req.(*QueryGetKeygenAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_KeygenAttemptAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeygenAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).KeygenAttemptAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/KeygenAttemptAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeygenAttemptAll(ctx, req.(*QueryAllKeygenAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLivenessParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/LivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LivenessParams(ctx, req.(*QueryGetLivenessParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ObserverLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLiveness(ctx, req.(*QueryGetObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ObserverLivenessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLivenessAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, req.(*QueryAllObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowObserverCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ShowObserverCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasVoted {
-		i--
-		if m.HasVoted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByChainAndNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockHeaderByHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
 	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBlockHeaderStateByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockHeaderStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, req.(*QueryGetBlockHeaderStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
-		i--
-		dAtA[i] = 0x20
+func _Query_PrunedBlockHeaderRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunedBlockHeaderRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).PrunedBlockHeaderRanges(ctx, in)
 	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PrunedBlockHeaderRanges",
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunedBlockHeaderRanges(ctx, req.(*QueryPrunedBlockHeaderRangesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserversByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LightClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLightClientStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryObserversByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryObserversByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObservationChain) > 0 {
-		i -= len(m.ObservationChain)
-		copy(dAtA[i:], m.ObservationChain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObservationChain)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).LightClientState(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/LightClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LightClientState(ctx, req.(*QueryGetLightClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserversByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProveRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Prove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserversByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserversByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressByFinalizedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllObserverMappersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTSSRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TSS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/TSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllObserverMappersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TssHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/TssHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllObserverMappersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllObserverMappersResponse) Marshal() (dAtA []byte, err error) {
+func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNoncesByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HasVoted",
+			Handler:    _Query_HasVoted_Handler,
+		},
+		{
+			MethodName: "BallotByIdentifier",
+			Handler:    _Query_BallotByIdentifier_Handler,
+		},
+		{
+			MethodName: "ObserversByChain",
+			Handler:    _Query_ObserversByChain_Handler,
+		},
+		{
+			MethodName: "AllObserverMappers",
+			Handler:    _Query_AllObserverMappers_Handler,
+		},
+		{
+			MethodName: "SupportedChains",
+			Handler:    _Query_SupportedChains_Handler,
+		},
+		{
+			MethodName: "GetCoreParamsForChain",
+			Handler:    _Query_GetCoreParamsForChain_Handler,
+		},
+		{
+			MethodName: "GetCoreParams",
+			Handler:    _Query_GetCoreParams_Handler,
+		},
+		{
+			MethodName: "NodeAccount",
+			Handler:    _Query_NodeAccount_Handler,
+		},
+		{
+			MethodName: "NodeAccountAll",
+			Handler:    _Query_NodeAccountAll_Handler,
+		},
+		{
+			MethodName: "CrosschainFlags",
+			Handler:    _Query_CrosschainFlags_Handler,
+		},
+		{
+			MethodName: "Keygen",
+			Handler:    _Query_Keygen_Handler,
+		},
+		{
+			MethodName: "KeygenAttempt",
+			Handler:    _Query_KeygenAttempt_Handler,
+		},
+		{
+			MethodName: "KeygenAttemptAll",
+			Handler:    _Query_KeygenAttemptAll_Handler,
+		},
+		{
+			MethodName: "LivenessParams",
+			Handler:    _Query_LivenessParams_Handler,
+		},
+		{
+			MethodName: "ObserverLiveness",
+			Handler:    _Query_ObserverLiveness_Handler,
+		},
+		{
+			MethodName: "ObserverLivenessAll",
+			Handler:    _Query_ObserverLivenessAll_Handler,
+		},
+		{
+			MethodName: "ShowObserverCount",
+			Handler:    _Query_ShowObserverCount_Handler,
+		},
+		{
+			MethodName: "BlameByIdentifier",
+			Handler:    _Query_BlameByIdentifier_Handler,
+		},
+		{
+			MethodName: "GetAllBlameRecords",
+			Handler:    _Query_GetAllBlameRecords_Handler,
+		},
+		{
+			MethodName: "BlamesByChainAndNonce",
+			Handler:    _Query_BlamesByChainAndNonce_Handler,
+		},
+		{
+			MethodName: "GetAllBlockHeaders",
+			Handler:    _Query_GetAllBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderByHash",
+			Handler:    _Query_GetBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderStateByChain",
+			Handler:    _Query_GetBlockHeaderStateByChain_Handler,
+		},
+		{
+			MethodName: "PrunedBlockHeaderRanges",
+			Handler:    _Query_PrunedBlockHeaderRanges_Handler,
+		},
+		{
+			MethodName: "LightClientState",
+			Handler:    _Query_LightClientState_Handler,
+		},
+		{
+			MethodName: "Prove",
+			Handler:    _Query_Prove_Handler,
+		},
+		{
+			MethodName: "GetTssAddress",
+			Handler:    _Query_GetTssAddress_Handler,
+		},
+		{
+			MethodName: "GetTssAddressByFinalizedHeight",
+			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
+		},
+		{
+			MethodName: "TSS",
+			Handler:    _Query_TSS_Handler,
+		},
+		{
+			MethodName: "TssHistory",
+			Handler:    _Query_TssHistory_Handler,
+		},
+		{
+			MethodName: "PendingNoncesAll",
+			Handler:    _Query_PendingNoncesAll_Handler,
+		},
+		{
+			MethodName: "PendingNoncesByChain",
+			Handler:    _Query_PendingNoncesByChain_Handler,
+		},
+		{
+			MethodName: "ChainNonces",
+			Handler:    _Query_ChainNonces_Handler,
+		},
+		{
+			MethodName: "ChainNoncesAll",
+			Handler:    _Query_ChainNoncesAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "observer/query.proto",
+}
+
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4954,34 +4913,27 @@ func (m *QueryAllObserverMappersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllObserverMappersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllObserverMappersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObserverMappers) > 0 {
-		for iNdEx := len(m.ObserverMappers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObserverMappers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4991,20 +4943,30 @@ func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5014,34 +4976,32 @@ func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5051,25 +5011,46 @@ func (m *QueryGetCoreParamsForChainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCoreParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5079,19 +5060,19 @@ func (m *QueryGetCoreParamsForChainResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCoreParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CoreParams != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5104,7 +5085,7 @@ func (m *QueryGetCoreParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5114,20 +5095,46 @@ func (m *QueryGetCoreParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCoreParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5137,32 +5144,25 @@ func (m *QueryGetCoreParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCoreParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CoreParams != nil {
-		{
-			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5172,27 +5172,30 @@ func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5202,32 +5205,20 @@ func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5237,32 +5228,30 @@ func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5272,46 +5261,25 @@ func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5321,20 +5289,34 @@ func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5344,30 +5326,30 @@ func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinalizedZetaHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5377,20 +5359,34 @@ func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5400,19 +5396,19 @@ func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5425,7 +5421,7 @@ func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5435,20 +5431,46 @@ func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5458,19 +5480,31 @@ func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
 		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5478,12 +5512,24 @@ func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5493,27 +5539,30 @@ func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+	if m.Valid {
 		i--
-		dAtA[i] = 0xa
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5523,32 +5572,20 @@ func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
-		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5558,32 +5595,30 @@ func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5593,46 +5628,34 @@ func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5642,30 +5665,30 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.HasVoted {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5675,34 +5698,27 @@ func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5712,32 +5728,32 @@ func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5747,32 +5763,30 @@ func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5780,13 +5794,20 @@ func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserversByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5796,27 +5817,27 @@ func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserversByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserversByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if len(m.ObservationChain) > 0 {
+		i -= len(m.ObservationChain)
+		copy(dAtA[i:], m.ObservationChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObservationChain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserversByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5826,32 +5847,29 @@ func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserversByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserversByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverMappersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5861,25 +5879,20 @@ func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverMappersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverMappersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverMappersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5889,831 +5902,3570 @@ func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverMappersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverMappersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeaderState != nil {
-		{
-			size, err := m.BlockHeaderState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ObserverMappers) > 0 {
+		for iNdEx := len(m.ObserverMappers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverMappers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCoreParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCoreParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetCoreParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCoreParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TSS.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.CoreParams != nil {
+		{
+			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoreParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoreParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CoreParams != nil {
+		{
+			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FinalizedZetaHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
-	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NodeAccount != nil {
+		{
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TxIndex))
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Valid {
-		n += 2
-	}
-	return n
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHasVotedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasVoted {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VoterList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenAttemptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenAttemptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenAttemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenAttemptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenAttemptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenAttemptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.KeygenAttempt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserversByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllKeygenAttemptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryObserversByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllKeygenAttemptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeygenAttemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllObserverMappersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllKeygenAttemptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllObserverMappersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllKeygenAttemptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeygenAttemptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ObserverMappers) > 0 {
-		for _, e := range m.ObserverMappers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.KeygenAttempt) > 0 {
+		for iNdEx := len(m.KeygenAttempt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeygenAttempt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetLivenessParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLivenessParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLivenessParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CoreParams != nil {
-		l = m.CoreParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ObserverLiveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CoreParams != nil {
-		l = m.CoreParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CrosschainFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.BlameInfo != nil {
-		l = m.BlameInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
 	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) Size() (n int) {
+func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeaderState != nil {
+		{
+			size, err := m.BlockHeaderState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedBlockHeaderRangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedBlockHeaderRangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedBlockHeaderRangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedBlockHeaderRangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedBlockHeaderRangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedBlockHeaderRangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrunedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PrunedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrunedRanges) > 0 {
+		for iNdEx := len(m.PrunedRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLightClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLightClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LightClientState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTSSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TSS.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *QueryProveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHasVotedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasVotedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasVoted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoterList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	return n
+}
+
+func (m *QueryObserversByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserversByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllObserverMappersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllObserverMappersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverMappers) > 0 {
+		for _, e := range m.ObserverMappers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoreParams != nil {
+		l = m.CoreParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCoreParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoreParams != nil {
+		l = m.CoreParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeygenAttemptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGetKeygenAttemptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeygenAttempt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeygenAttemptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeygenAttemptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeygenAttempt) > 0 {
+		for _, e := range m.KeygenAttempt {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLivenessParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetLivenessParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetObserverLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetObserverLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObserverLiveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllObserverLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllObserverLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		l = m.BlameInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeaderState != nil {
+		l = m.BlockHeaderState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrunedBlockHeaderRangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPrunedBlockHeaderRangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PrunedRanges) > 0 {
+		for _, e := range m.PrunedRanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PrunedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.PrunedHeight))
+	}
+	return n
+}
+
+func (m *QueryGetLightClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetLightClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LightClientState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
+			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
+			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			}
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetBlockHeaderStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeaderState != nil {
-		l = m.BlockHeaderState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
+			}
+			m.FinalizedZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			}
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6736,15 +9488,15 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6772,7 +9524,39 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6795,7 +9579,7 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6818,15 +9602,15 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6853,7 +9637,10 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6878,7 +9665,7 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6901,13 +9688,47 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssList = append(m.TssList, TSS{})
+			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6937,7 +9758,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6964,7 +9785,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6974,28 +9795,79 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7022,16 +9894,18 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
-			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Proof == nil {
+				m.Proof = &common.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7041,28 +9915,43 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7084,7 +9973,7 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7107,17 +9996,17 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7127,28 +10016,12 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7170,7 +10043,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7193,82 +10066,12 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
-			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7290,7 +10093,7 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7313,17 +10116,17 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7333,11 +10136,25 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7359,7 +10176,7 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7382,17 +10199,49 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7402,24 +10251,23 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7442,7 +10290,7 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7465,12 +10313,32 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasVoted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7492,7 +10360,7 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7515,17 +10383,17 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7535,24 +10403,23 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7575,7 +10442,7 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *VoterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7598,17 +10465,49 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
 			}
-			m.BitcoinChainId = 0
+			m.VoteType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7618,7 +10517,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
+				m.VoteType |= VoteType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7644,7 +10543,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7667,15 +10566,15 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7703,13 +10602,13 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7719,24 +10618,64 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			m.Voters = append(m.Voters, &VoterList{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7758,7 +10697,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7781,17 +10720,17 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserversByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserversByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
 			}
-			m.FinalizedZetaHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7801,30 +10740,24 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BitcoinChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7846,7 +10779,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7869,47 +10802,15 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserversByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserversByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7937,7 +10838,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7960,7 +10861,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7983,48 +10884,12 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllObserverMappersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllObserverMappersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8046,7 +10911,7 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8069,49 +10934,15 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllObserverMappersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllObserverMappersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TssList = append(m.TssList, TSS{})
-			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverMappers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8138,10 +10969,8 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObserverMappers = append(m.ObserverMappers, &ObserverMapper{})
+			if err := m.ObserverMappers[len(m.ObserverMappers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8166,7 +10995,7 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8189,136 +11018,67 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &common.Proof{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
-			m.TxIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8328,11 +11088,26 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &common.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8354,7 +11129,7 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8377,17 +11152,17 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8397,12 +11172,11 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8424,7 +11198,7 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8447,12 +11221,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreParams == nil {
+				m.CoreParams = &CoreParams{}
+			}
+			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8474,7 +11284,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8497,45 +11307,12 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoreParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoreParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8557,7 +11334,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,17 +11357,17 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoreParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoreParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8600,55 +11377,27 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.CoreParams == nil {
+				m.CoreParams = &CoreParamsList{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8671,7 +11420,7 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8694,17 +11443,17 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8714,12 +11463,24 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasVoted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8741,7 +11502,7 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8764,17 +11525,17 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8784,23 +11545,27 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			if m.NodeAccount == nil {
+				m.NodeAccount = &NodeAccount{}
+			}
+			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8823,7 +11588,7 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterList) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8846,49 +11611,17 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.VoteType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8898,11 +11631,28 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8924,7 +11674,7 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8947,17 +11697,17 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8967,27 +11717,29 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
+			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9014,49 +11766,13 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, &VoterList{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
-			}
-			m.ObservationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationType |= ObservationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
-			}
-			m.BallotStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BallotStatus |= BallotStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9078,7 +11794,7 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9101,44 +11817,12 @@ func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserversByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserversByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9160,7 +11844,7 @@ func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9183,17 +11867,17 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserversByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserversByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9203,23 +11887,24 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9242,7 +11927,7 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9265,10 +11950,10 @@ func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllObserverMappersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllObserverMappersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9292,7 +11977,7 @@ func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9315,15 +12000,15 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllObserverMappersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllObserverMappersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverMappers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9350,8 +12035,10 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverMappers = append(m.ObserverMappers, &ObserverMapper{})
-			if err := m.ObserverMappers[len(m.ObserverMappers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Keygen == nil {
+				m.Keygen = &Keygen{}
+			}
+			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9376,7 +12063,7 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenAttemptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9399,12 +12086,31 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenAttemptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenAttemptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9426,7 +12132,7 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenAttemptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9449,15 +12155,15 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenAttemptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenAttemptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenAttempt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9484,8 +12190,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &common.Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeygenAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9510,7 +12215,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeygenAttemptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9533,17 +12238,17 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeygenAttemptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeygenAttemptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9553,11 +12258,28 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9579,7 +12301,7 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeygenAttemptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9598,19 +12320,53 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeygenAttemptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeygenAttemptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenAttempt = append(m.KeygenAttempt, KeygenAttempt{})
+			if err := m.KeygenAttempt[len(m.KeygenAttempt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9637,10 +12393,10 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreParams == nil {
-				m.CoreParams = &CoreParams{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9665,7 +12421,7 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLivenessParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9688,10 +12444,10 @@ func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLivenessParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLivenessParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9715,7 +12471,7 @@ func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLivenessParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9738,15 +12494,15 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLivenessParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9773,10 +12529,7 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreParams == nil {
-				m.CoreParams = &CoreParamsList{}
-			}
-			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9801,7 +12554,7 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetObserverLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9824,15 +12577,34 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9860,7 +12632,7 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9883,7 +12655,7 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetObserverLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9906,15 +12678,15 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9941,10 +12713,7 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAccount == nil {
-				m.NodeAccount = &NodeAccount{}
-			}
-			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObserverLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9969,7 +12738,7 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllObserverLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9992,10 +12761,10 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10055,7 +12824,7 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllObserverLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10078,15 +12847,15 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10113,8 +12882,8 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
-			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10175,7 +12944,7 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10198,10 +12967,10 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10225,7 +12994,7 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10248,15 +13017,15 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10283,7 +13052,10 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastObserverCount == nil {
+				m.LastObserverCount = &LastObserverCount{}
+			}
+			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10308,7 +13080,7 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10331,12 +13103,44 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10358,7 +13162,7 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10381,15 +13185,15 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10416,10 +13220,10 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Keygen == nil {
-				m.Keygen = &Keygen{}
+			if m.BlameInfo == nil {
+				m.BlameInfo = &Blame{}
 			}
-			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10444,7 +13248,7 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10467,12 +13271,48 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10494,7 +13334,7 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10517,15 +13357,15 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10552,10 +13392,44 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastObserverCount == nil {
-				m.LastObserverCount = &LastObserverCount{}
+			m.BlameInfo = append(m.BlameInfo, Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10580,7 +13454,7 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10603,17 +13477,36 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10623,24 +13516,11 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10662,7 +13542,7 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10685,10 +13565,10 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10720,10 +13600,8 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlameInfo == nil {
-				m.BlameInfo = &Blame{}
-			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlameInfo = append(m.BlameInfo, &Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10748,7 +13626,7 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10771,10 +13649,10 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10834,7 +13712,7 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10857,15 +13735,15 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10892,8 +13770,8 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockHeaders = append(m.BlockHeaders, &common.BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10954,7 +13832,7 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10977,17 +13855,17 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			m.ChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10997,30 +13875,26 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11042,7 +13916,7 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11065,15 +13939,15 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11100,8 +13974,10 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, &Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockHeader == nil {
+				m.BlockHeader = &common.BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11126,7 +14002,7 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11149,17 +14025,17 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11169,28 +14045,11 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11212,7 +14071,7 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11235,49 +14094,15 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHeaders = append(m.BlockHeaders, &common.BlockHeader{})
-			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11304,10 +14129,10 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.BlockHeaderState == nil {
+				m.BlockHeaderState = &BlockHeaderState{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockHeaderState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11332,7 +14157,7 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPrunedBlockHeaderRangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11355,17 +14180,17 @@ func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrunedBlockHeaderRangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrunedBlockHeaderRangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11375,26 +14200,11 @@ func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11416,7 +14226,7 @@ func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPrunedBlockHeaderRangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11439,15 +14249,15 @@ func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrunedBlockHeaderRangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrunedBlockHeaderRangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedRanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11474,13 +14284,30 @@ func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &common.BlockHeader{}
-			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PrunedRanges = append(m.PrunedRanges, HeightRange{})
+			if err := m.PrunedRanges[len(m.PrunedRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedHeight", wireType)
+			}
+			m.PrunedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11502,7 +14329,7 @@ func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLightClientStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11525,10 +14352,10 @@ func (m *QueryGetBlockHeaderStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLightClientStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLightClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11571,7 +14398,7 @@ func (m *QueryGetBlockHeaderStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLightClientStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11594,15 +14421,15 @@ func (m *QueryGetBlockHeaderStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLightClientStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLightClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11629,10 +14456,7 @@ func (m *QueryGetBlockHeaderStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeaderState == nil {
-				m.BlockHeaderState = &BlockHeaderState{}
-			}
-			if err := m.BlockHeaderState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LightClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
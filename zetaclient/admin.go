@@ -0,0 +1,470 @@
+package zetaclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/common"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// adminTokenLength is the length in bytes of the generated admin token
+	adminTokenLength = 32
+
+	// maxAdminRequestSize is the maximum size of the body of an admin request
+	maxAdminRequestSize = 1 << 20
+)
+
+// AdminServer provides the http endpoints of the operator actions on a running client
+// It only listens to a loopback address and the requests must carry the admin token as bearer token
+type AdminServer struct {
+	logger       zerolog.Logger
+	s            *http.Server
+	token        string
+	coreObserver *CoreObserver
+	logWriter    *ModuleLevelWriter
+}
+
+// AdminChainStatus is the status of a chain of the client returned by the admin API
+type AdminChainStatus struct {
+	ChainID             int64  `json:"chain_id"`
+	ChainName           string `json:"chain_name"`
+	InboundPaused       bool   `json:"inbound_paused"`
+	InboundPauseReason  string `json:"inbound_pause_reason,omitempty"`
+	OutboundPaused      bool   `json:"outbound_paused"`
+	OutboundPauseReason string `json:"outbound_pause_reason,omitempty"`
+
+	// ZetacoreOutboundPause is the pause of the outbound of the chain on zetacore, for all the observers
+	ZetacoreOutboundPause *observertypes.OutboundPause `json:"zetacore_outbound_pause,omitempty"`
+}
+
+// AdminLogLevels are the log levels of the client returned by the admin API
+type AdminLogLevels struct {
+	DefaultLevel string            `json:"default_level"`
+	ModuleLevels map[string]string `json:"module_levels"`
+}
+
+type adminPauseRequest struct {
+	Reason string `json:"reason"`
+}
+
+type adminRescanRequest struct {
+	FromBlock uint64 `json:"from_block"`
+}
+
+type adminRescanRangeRequest struct {
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+	DryRun     bool   `json:"dry_run"`
+}
+
+type adminVoteRequest struct {
+	TxHash   string `json:"tx_hash"`
+	CoinType string `json:"coin_type"`
+}
+
+type adminVoteResponse struct {
+	BallotIdentifier string `json:"ballot_identifier"`
+}
+
+type adminLogLevelRequest struct {
+	Module string `json:"module"`
+	Level  string `json:"level"`
+}
+
+type adminErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewAdminServer returns the admin server listening to the loopback address
+// The log level endpoints are disabled if logWriter is nil
+func NewAdminServer(
+	addr string,
+	token string,
+	coreObserver *CoreObserver,
+	logWriter *ModuleLevelWriter,
+	logger zerolog.Logger,
+) (*AdminServer, error) {
+	if err := validateLoopbackAddr(addr); err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, errors.New("admin token is empty")
+	}
+	as := &AdminServer{
+		logger:       logger.With().Str("module", "AdminServer").Logger(),
+		token:        token,
+		coreObserver: coreObserver,
+		logWriter:    logWriter,
+	}
+	as.s = &http.Server{
+		Addr:              addr,
+		Handler:           as.Handlers(),
+		ReadTimeout:       5 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return as, nil
+}
+
+// LoadOrCreateAdminToken returns the admin token of the token file, the token file is created with a random token
+// if it does not exist
+func LoadOrCreateAdminToken(path string) (string, error) {
+	path = filepath.Clean(path)
+	bz, err := os.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(bz))
+		if token == "" {
+			return "", fmt.Errorf("admin token file %s is empty", path)
+		}
+		return token, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	b := make([]byte, adminTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Handlers registers the admin routes and returns the HTTP handler
+func (as *AdminServer) Handlers() http.Handler {
+	router := mux.NewRouter()
+	router.Handle("/chains", http.HandlerFunc(as.chainsHandler)).Methods(http.MethodGet)
+	router.Handle("/chains/{chain_id}/inbound/pause", http.HandlerFunc(as.pauseInboundHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/inbound/resume", http.HandlerFunc(as.resumeInboundHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/outbound/pause", http.HandlerFunc(as.pauseOutboundHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/outbound/resume", http.HandlerFunc(as.resumeOutboundHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/rescan", http.HandlerFunc(as.rescanHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/rescan/range", http.HandlerFunc(as.rescanRangeHandler)).Methods(http.MethodPost)
+	router.Handle("/chains/{chain_id}/inbound/vote", http.HandlerFunc(as.voteInboundHandler)).Methods(http.MethodPost)
+	router.Handle("/outtx/active", http.HandlerFunc(as.activeOutTxHandler)).Methods(http.MethodGet)
+	router.Handle("/log/levels", http.HandlerFunc(as.logLevelsHandler)).Methods(http.MethodGet)
+	router.Handle("/log/levels", http.HandlerFunc(as.setLogLevelHandler)).Methods(http.MethodPut)
+	router.Handle("/log/levels/{module}", http.HandlerFunc(as.resetLogLevelHandler)).Methods(http.MethodDelete)
+	router.Use(logMiddleware(), as.authMiddleware())
+	return router
+}
+
+func (as *AdminServer) Start() error {
+	if as.s == nil {
+		return errors.New("invalid http server instance")
+	}
+	as.logger.Info().Msgf("admin API listening on %s", as.s.Addr)
+	if err := as.s.ListenAndServe(); err != nil {
+		if err != http.ErrServerClosed {
+			return fmt.Errorf("fail to start admin server: %w", err)
+		}
+	}
+	return nil
+}
+
+func (as *AdminServer) Stop() error {
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := as.s.Shutdown(c)
+	if err != nil {
+		as.logger.Error().Err(err).Msg("Failed to shutdown the admin server gracefully")
+	}
+	return err
+}
+
+// authMiddleware rejects the requests without the admin token
+func (as *AdminServer) authMiddleware() mux.MiddlewareFunc {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(token), []byte(as.token)) != 1 {
+				as.logger.Warn().Str("route", r.URL.Path).Msg("unauthorized admin request")
+				as.writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (as *AdminServer) chainsHandler(w http.ResponseWriter, _ *http.Request) {
+	cfg := as.coreObserver.Config()
+	flags, err := as.coreObserver.bridge.GetCrosschainFlags()
+	if err != nil {
+		as.writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	statuses := make([]AdminChainStatus, 0)
+	for _, chain := range cfg.GetEnabledChains() {
+		client, err := as.coreObserver.ChainClient(chain.ChainId)
+		if err != nil {
+			continue
+		}
+		status := AdminChainStatus{
+			ChainID:   chain.ChainId,
+			ChainName: chain.ChainName.String(),
+		}
+		status.InboundPauseReason, status.InboundPaused = client.IsInboundPaused()
+		status.OutboundPauseReason, status.OutboundPaused = cfg.IsChainPaused(chain.ChainId)
+		if pause, paused := flags.GetOutboundPause(chain.ChainId); paused {
+			status.ZetacoreOutboundPause = &pause
+		}
+		statuses = append(statuses, status)
+	}
+	as.writeJSON(w, http.StatusOK, statuses)
+}
+
+func (as *AdminServer) pauseInboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, client, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	var req adminPauseRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	reason := pauseReason(req.Reason)
+	client.PauseInbound(reason)
+	as.logger.Warn().Msgf("inbound observation of chain %d paused by the operator: %s", chainID, reason)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as *AdminServer) resumeInboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, client, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	client.UnpauseInbound()
+	as.logger.Info().Msgf("inbound observation of chain %d resumed by the operator", chainID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as *AdminServer) pauseOutboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, _, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	var req adminPauseRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	reason := pauseReason(req.Reason)
+	as.coreObserver.Config().PauseChain(chainID, reason)
+	as.logger.Warn().Msgf("outbound of chain %d paused by the operator: %s", chainID, reason)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as *AdminServer) resumeOutboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, _, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	as.coreObserver.Config().UnpauseChain(chainID)
+	as.logger.Info().Msgf("outbound of chain %d resumed by the operator", chainID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as *AdminServer) rescanHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, client, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	var req adminRescanRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	if err := client.RescanFrom(req.FromBlock); err != nil {
+		as.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	as.logger.Warn().Msgf("rescan of chain %d from block %d requested by the operator", chainID, req.FromBlock)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (as *AdminServer) rescanRangeHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, client, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	var req adminRescanRangeRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	as.logger.Info().Msgf("rescan of blocks %d to %d of chain %d requested by the operator", req.StartBlock, req.EndBlock, chainID)
+	result, err := client.RescanInTx(req.StartBlock, req.EndBlock, !req.DryRun)
+	if err != nil {
+		as.writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	as.writeJSON(w, http.StatusOK, result)
+}
+
+func (as *AdminServer) voteInboundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, client, ok := as.chainClient(w, r)
+	if !ok {
+		return
+	}
+	var req adminVoteRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	if req.TxHash == "" {
+		as.writeError(w, http.StatusBadRequest, errors.New("tx_hash is empty"))
+		return
+	}
+	coinType, found := common.CoinType_value[req.CoinType]
+	if !found {
+		as.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid coin type %s", req.CoinType))
+		return
+	}
+	as.logger.Info().Msgf("vote for inbound tx %s of chain %d requested by the operator", req.TxHash, chainID)
+	ballot, err := client.VoteInboundTx(req.TxHash, common.CoinType(coinType))
+	if err != nil {
+		as.writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	as.writeJSON(w, http.StatusOK, adminVoteResponse{BallotIdentifier: ballot})
+}
+
+func (as *AdminServer) activeOutTxHandler(w http.ResponseWriter, _ *http.Request) {
+	as.writeJSON(w, http.StatusOK, as.coreObserver.OutTxProcessorManager().ActiveOutTxs())
+}
+
+func (as *AdminServer) logLevelsHandler(w http.ResponseWriter, _ *http.Request) {
+	if !as.checkLogWriter(w) {
+		return
+	}
+	defaultLevel, moduleLevels := as.logWriter.Levels()
+	levels := AdminLogLevels{
+		DefaultLevel: defaultLevel.String(),
+		ModuleLevels: make(map[string]string, len(moduleLevels)),
+	}
+	for module, level := range moduleLevels {
+		levels.ModuleLevels[module] = level.String()
+	}
+	as.writeJSON(w, http.StatusOK, levels)
+}
+
+func (as *AdminServer) setLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	if !as.checkLogWriter(w) {
+		return
+	}
+	var req adminLogLevelRequest
+	if !as.decodeRequest(w, r, &req) {
+		return
+	}
+	level, err := zerolog.ParseLevel(req.Level)
+	if err != nil || req.Level == "" {
+		as.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid log level %q", req.Level))
+		return
+	}
+	if req.Module == "" {
+		as.logWriter.SetDefaultLevel(level)
+	} else {
+		as.logWriter.SetModuleLevel(req.Module, level)
+	}
+	as.logger.Info().Msgf("log level of module %q set to %s by the operator", req.Module, level)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as *AdminServer) resetLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	if !as.checkLogWriter(w) {
+		return
+	}
+	module := mux.Vars(r)["module"]
+	as.logWriter.ResetModuleLevel(module)
+	as.logger.Info().Msgf("log level of module %q reset by the operator", module)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// chainClient returns the chain id and the chain client of the request, it writes the error response if not found
+func (as *AdminServer) chainClient(w http.ResponseWriter, r *http.Request) (int64, ChainClient, bool) {
+	chainID, err := strconv.ParseInt(mux.Vars(r)["chain_id"], 10, 64)
+	if err != nil {
+		as.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid chain id: %w", err))
+		return 0, nil, false
+	}
+	client, err := as.coreObserver.ChainClient(chainID)
+	if err != nil {
+		as.writeError(w, http.StatusNotFound, err)
+		return 0, nil, false
+	}
+	return chainID, client, true
+}
+
+// decodeRequest decodes the JSON body of the request, an empty body is accepted
+func (as *AdminServer) decodeRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAdminRequestSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(req); err != nil && !errors.Is(err, io.EOF) {
+		as.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return false
+	}
+	return true
+}
+
+func (as *AdminServer) checkLogWriter(w http.ResponseWriter) bool {
+	if as.logWriter == nil {
+		as.writeError(w, http.StatusNotImplemented, errors.New("log levels can't be changed at runtime"))
+		return false
+	}
+	return true
+}
+
+func (as *AdminServer) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		as.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
+func (as *AdminServer) writeError(w http.ResponseWriter, status int, err error) {
+	as.writeJSON(w, status, adminErrorResponse{Error: err.Error()})
+}
+
+// pauseReason returns the reason of a pause by the operator
+func pauseReason(reason string) string {
+	if reason == "" {
+		return "paused by the operator"
+	}
+	return reason
+}
+
+// validateLoopbackAddr returns an error if the address is not a loopback address
+func validateLoopbackAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid admin API address %s: %w", addr, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("admin API address %s is not a loopback address", addr)
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package zetaclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+const testAdminToken = "secret"
+
+// adminTestChainClient is a chain client recording the operator actions
+type adminTestChainClient struct {
+	ChainClient
+	pauseReason *string
+	rescanFrom  uint64
+	votedTxHash string
+	rescanVote  bool
+}
+
+func (c *adminTestChainClient) PauseInbound(reason string) { c.pauseReason = &reason }
+
+func (c *adminTestChainClient) UnpauseInbound() { c.pauseReason = nil }
+
+func (c *adminTestChainClient) IsInboundPaused() (string, bool) {
+	if c.pauseReason == nil {
+		return "", false
+	}
+	return *c.pauseReason, true
+}
+
+func (c *adminTestChainClient) RescanFrom(height uint64) error {
+	if height == 0 {
+		return errors.New("invalid rescan height")
+	}
+	c.rescanFrom = height
+	return nil
+}
+
+func (c *adminTestChainClient) RescanInTx(startBlock, endBlock uint64, vote bool) (RescanResult, error) {
+	if err := validateRescanRange(startBlock, endBlock, 1000); err != nil {
+		return RescanResult{}, err
+	}
+	c.rescanVote = vote
+	return RescanResult{StartBlock: startBlock, EndBlock: endBlock, Found: 1, Missed: []string{"ballot"}}, nil
+}
+
+func (c *adminTestChainClient) VoteInboundTx(txHash string, coinType common.CoinType) (string, error) {
+	if coinType != common.CoinType_Gas {
+		return "", errors.New("unsupported coin type")
+	}
+	c.votedTxHash = txHash
+	return "ballot", nil
+}
+
+// adminTestBridge is a zetacore bridge with the crosschain flags
+type adminTestBridge struct {
+	ZetaCoreBridger
+	flags observertypes.CrosschainFlags
+}
+
+func (b *adminTestBridge) GetCrosschainFlags() (observertypes.CrosschainFlags, error) {
+	return b.flags, nil
+}
+
+func newAdminTestServer(t *testing.T) (http.Handler, *CoreObserver, *adminTestChainClient, *ModuleLevelWriter) {
+	cfg := config.NewConfig()
+	cfg.ChainsEnabled = []common.Chain{common.GoerliLocalnetChain(), common.BtcRegtestChain()}
+	client := &adminTestChainClient{}
+	co := &CoreObserver{
+		bridge: &adminTestBridge{flags: *observertypes.DefaultCrosschainFlags()},
+		cfg:    cfg,
+		clientMap: map[common.Chain]ChainClient{
+			common.GoerliLocalnetChain(): client,
+			common.BtcRegtestChain():     &adminTestChainClient{},
+		},
+		outTxMan: NewOutTxProcessorManager(zerolog.Nop()),
+	}
+	logWriter := NewModuleLevelWriter(&bytes.Buffer{}, zerolog.InfoLevel)
+	as, err := NewAdminServer("127.0.0.1:0", testAdminToken, co, logWriter, zerolog.Nop())
+	require.NoError(t, err)
+	return as.Handlers(), co, client, logWriter
+}
+
+func adminRequest(t *testing.T, handler http.Handler, method, path, body string, out interface{}) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if out != nil {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out), rec.Body.String())
+	}
+	return rec.Code
+}
+
+func TestAdminServer(t *testing.T) {
+	goerliID := common.GoerliLocalnetChain().ChainId
+
+	t.Run("reject requests without the admin token", func(t *testing.T) {
+		handler, _, _, _ := newAdminTestServer(t)
+		for _, auth := range []string{"", "Bearer", "Bearer foo", testAdminToken} {
+			req := httptest.NewRequest(http.MethodGet, "/chains", nil)
+			req.Header.Set("Authorization", auth)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusUnauthorized, rec.Code, auth)
+		}
+	})
+
+	t.Run("pause and resume the inbound and the outbound of a chain", func(t *testing.T) {
+		handler, co, client, _ := newAdminTestServer(t)
+
+		code := adminRequest(t, handler, http.MethodPost, "/chains/1337/inbound/pause", `{"reason":"rpc issue"}`, nil)
+		require.Equal(t, http.StatusNoContent, code)
+		reason, paused := client.IsInboundPaused()
+		require.True(t, paused)
+		require.Equal(t, "rpc issue", reason)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/outbound/pause", "", nil)
+		require.Equal(t, http.StatusNoContent, code)
+		reason, paused = co.Config().IsChainPaused(goerliID)
+		require.True(t, paused)
+		require.Equal(t, "paused by the operator", reason)
+
+		var statuses []AdminChainStatus
+		code = adminRequest(t, handler, http.MethodGet, "/chains", "", &statuses)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, statuses, 2)
+		require.Equal(t, AdminChainStatus{
+			ChainID:             goerliID,
+			ChainName:           common.GoerliLocalnetChain().ChainName.String(),
+			InboundPaused:       true,
+			InboundPauseReason:  "rpc issue",
+			OutboundPaused:      true,
+			OutboundPauseReason: "paused by the operator",
+		}, statuses[0])
+		require.False(t, statuses[1].InboundPaused)
+		require.False(t, statuses[1].OutboundPaused)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/inbound/resume", "", nil)
+		require.Equal(t, http.StatusNoContent, code)
+		_, paused = client.IsInboundPaused()
+		require.False(t, paused)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/outbound/resume", "", nil)
+		require.Equal(t, http.StatusNoContent, code)
+		_, paused = co.Config().IsChainPaused(goerliID)
+		require.False(t, paused)
+	})
+
+	t.Run("list the outbound pauses on zetacore", func(t *testing.T) {
+		handler, co, _, _ := newAdminTestServer(t)
+		pause := observertypes.OutboundPause{
+			ChainId:     common.BtcRegtestChain().ChainId,
+			Reason:      "zrc20 supply not backed",
+			Creator:     "zeta1",
+			BlockHeight: 100,
+		}
+		co.bridge.(*adminTestBridge).flags.SetOutboundPause(pause)
+
+		var statuses []AdminChainStatus
+		code := adminRequest(t, handler, http.MethodGet, "/chains", "", &statuses)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, statuses, 2)
+		require.Nil(t, statuses[0].ZetacoreOutboundPause)
+		require.False(t, statuses[1].OutboundPaused)
+		require.Equal(t, &pause, statuses[1].ZetacoreOutboundPause)
+	})
+
+	t.Run("unknown or invalid chain", func(t *testing.T) {
+		handler, _, _, _ := newAdminTestServer(t)
+		var res adminErrorResponse
+		code := adminRequest(t, handler, http.MethodPost, "/chains/5/inbound/pause", "", &res)
+		require.Equal(t, http.StatusNotFound, code)
+		require.Contains(t, res.Error, "5")
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/foo/inbound/pause", "", &res)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("rescan", func(t *testing.T) {
+		handler, _, client, _ := newAdminTestServer(t)
+		code := adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan", `{"from_block":100}`, nil)
+		require.Equal(t, http.StatusAccepted, code)
+		require.EqualValues(t, 100, client.rescanFrom)
+
+		var res adminErrorResponse
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan", `{"from_block":0}`, &res)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Equal(t, "invalid rescan height", res.Error)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan", `{"from":100}`, &res)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, res.Error, "unknown field")
+	})
+
+	t.Run("rescan range", func(t *testing.T) {
+		handler, _, client, _ := newAdminTestServer(t)
+		var result RescanResult
+		code := adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan/range", `{"start_block":10,"end_block":20,"dry_run":true}`, &result)
+		require.Equal(t, http.StatusOK, code)
+		require.EqualValues(t, 10, result.StartBlock)
+		require.EqualValues(t, 20, result.EndBlock)
+		require.Equal(t, []string{"ballot"}, result.Missed)
+		require.False(t, client.rescanVote)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan/range", `{"start_block":10,"end_block":20}`, &result)
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, client.rescanVote)
+
+		var res adminErrorResponse
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/rescan/range", `{"start_block":10,"end_block":2000}`, &res)
+		require.Equal(t, http.StatusUnprocessableEntity, code)
+		require.Contains(t, res.Error, "not confirmed")
+	})
+
+	t.Run("vote inbound tx", func(t *testing.T) {
+		handler, _, client, _ := newAdminTestServer(t)
+		var vote adminVoteResponse
+		code := adminRequest(t, handler, http.MethodPost, "/chains/1337/inbound/vote", `{"tx_hash":"0x123","coin_type":"Gas"}`, &vote)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "ballot", vote.BallotIdentifier)
+		require.Equal(t, "0x123", client.votedTxHash)
+
+		var res adminErrorResponse
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/inbound/vote", `{"tx_hash":"0x123","coin_type":"Foo"}`, &res)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Equal(t, "invalid coin type Foo", res.Error)
+
+		code = adminRequest(t, handler, http.MethodPost, "/chains/1337/inbound/vote", `{"tx_hash":"0x123","coin_type":"ERC20"}`, &res)
+		require.Equal(t, http.StatusUnprocessableEntity, code)
+		require.Equal(t, "unsupported coin type", res.Error)
+	})
+
+	t.Run("active outtxs", func(t *testing.T) {
+		handler, co, _, _ := newAdminTestServer(t)
+		co.OutTxProcessorManager().StartTryProcess("foo")
+		co.OutTxProcessorManager().StartTryProcess("bar")
+		co.OutTxProcessorManager().EndTryProcess("foo")
+
+		var active []OutTxProcess
+		code := adminRequest(t, handler, http.MethodGet, "/outtx/active", "", &active)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, active, 1)
+		require.Equal(t, "bar", active[0].OutTxID)
+		require.False(t, active[0].StartTime.IsZero())
+	})
+
+	t.Run("log levels", func(t *testing.T) {
+		handler, _, _, logWriter := newAdminTestServer(t)
+		code := adminRequest(t, handler, http.MethodPut, "/log/levels", `{"module":"ObserveOutTx","level":"debug"}`, nil)
+		require.Equal(t, http.StatusNoContent, code)
+		code = adminRequest(t, handler, http.MethodPut, "/log/levels", `{"level":"warn"}`, nil)
+		require.Equal(t, http.StatusNoContent, code)
+
+		var levels AdminLogLevels
+		code = adminRequest(t, handler, http.MethodGet, "/log/levels", "", &levels)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, AdminLogLevels{
+			DefaultLevel: "warn",
+			ModuleLevels: map[string]string{"ObserveOutTx": "debug"},
+		}, levels)
+
+		code = adminRequest(t, handler, http.MethodDelete, "/log/levels/ObserveOutTx", "", nil)
+		require.Equal(t, http.StatusNoContent, code)
+		_, moduleLevels := logWriter.Levels()
+		require.Empty(t, moduleLevels)
+
+		var res adminErrorResponse
+		code = adminRequest(t, handler, http.MethodPut, "/log/levels", `{"level":"foo"}`, &res)
+		require.Equal(t, http.StatusBadRequest, code)
+		code = adminRequest(t, handler, http.MethodPut, "/log/levels", `{"module":"ObserveOutTx"}`, &res)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestNewAdminServer(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1:8124", "localhost:8124", "[::1]:8124"} {
+		_, err := NewAdminServer(addr, testAdminToken, &CoreObserver{}, nil, zerolog.Nop())
+		require.NoError(t, err, addr)
+	}
+	for _, addr := range []string{":8124", "0.0.0.0:8124", "192.168.1.1:8124", "127.0.0.1"} {
+		_, err := NewAdminServer(addr, testAdminToken, &CoreObserver{}, nil, zerolog.Nop())
+		require.Error(t, err, addr)
+	}
+	_, err := NewAdminServer("127.0.0.1:8124", "", &CoreObserver{}, nil, zerolog.Nop())
+	require.Error(t, err)
+}
+
+func TestLoadOrCreateAdminToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "zetaclient_admin_token")
+
+	token, err := LoadOrCreateAdminToken(path)
+	require.NoError(t, err)
+	require.Len(t, token, 2*adminTokenLength)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadOrCreateAdminToken(path)
+	require.NoError(t, err)
+	require.Equal(t, token, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("\n"), 0600))
+	_, err = LoadOrCreateAdminToken(path)
+	require.ErrorContains(t, err, "is empty")
+}
+
+func TestChainClient_RescanFrom(t *testing.T) {
+	evmClient := &EVMChainClient{lastBlockScanned: 100}
+	require.Error(t, evmClient.RescanFrom(0))
+	require.Error(t, evmClient.RescanFrom(102))
+	require.NoError(t, evmClient.RescanFrom(101))
+	require.NoError(t, evmClient.RescanFrom(50))
+	require.EqualValues(t, 50, evmClient.rescanFrom)
+
+	btcClient := &BitcoinChainClient{lastBlockScanned: 100}
+	require.Error(t, btcClient.RescanFrom(0))
+	require.Error(t, btcClient.RescanFrom(102))
+	require.NoError(t, btcClient.RescanFrom(50))
+	require.EqualValues(t, 50, btcClient.rescanFrom)
+}
@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"os"
 	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	cosmosmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcjson"
@@ -26,10 +26,7 @@ import (
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
-	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
 )
 
 var _ ChainClient = &BitcoinChainClient{}
@@ -63,24 +60,35 @@ type BitcoinChainClient struct {
 	utxos             []btcjson.ListUnspentResult
 	params            observertypes.CoreParams
 
-	db     *gorm.DB
-	stop   chan struct{}
-	logger BTCLog
-	ts     *TelemetryServer
+	store          store.Store
+	storeRetention time.Duration
+	stop           chan struct{}
+	logger         BTCLog
+	ts             *TelemetryServer
+
+	inboundPauseReason *string // the inbound observation is paused by the operator if set
+	rescanFrom         int64   // the block from which the operator requested a rescan, 0 if none
+	assetDecimals      *AssetDecimals
+	pendingInbounds    *PendingInbounds
 
 	BlockCache *lru.Cache
 }
 
 const (
+	btcStoreName     = "btc_chain_client" // the name of the store of the client
 	minConfirmations = 0
 	maxHeightDiff    = 10000
 	btcBlocksPerDay  = 144
+
+	// maxForkSearchDepth is the maximum number of blocks searched back for the fork point with the best chain of zetacore
+	maxForkSearchDepth = 100
 )
 
 func (ob *BitcoinChainClient) WithZetaClient(bridge *ZetaCoreBridge) {
 	ob.Mu.Lock()
 	defer ob.Mu.Unlock()
 	ob.zetaClient = bridge
+	ob.assetDecimals = NewAssetDecimals(bridge, ob.chain.ChainId)
 }
 func (ob *BitcoinChainClient) WithLogger(logger zerolog.Logger) {
 	ob.Mu.Lock()
@@ -94,7 +102,7 @@ func (ob *BitcoinChainClient) WithLogger(logger zerolog.Logger) {
 	}
 }
 
-func (ob *BitcoinChainClient) WithBtcClient(client *rpcclient.Client) {
+func (ob *BitcoinChainClient) WithBtcClient(client BTCRPCClient) {
 	ob.Mu.Lock()
 	defer ob.Mu.Unlock()
 	ob.rpcClient = client
@@ -123,7 +131,41 @@ func NewBitcoinClient(
 	chain common.Chain,
 	bridge ZetaCoreBridger,
 	tss TSSSigner,
-	dbpath string,
+	storeOpts store.Options,
+	metrics *metricsPkg.Metrics,
+	logger zerolog.Logger,
+	btcCfg config.BTCConfig,
+	ts *TelemetryServer,
+) (*BitcoinChainClient, error) {
+	// initialize the Client
+	logger.Info().Str("chain", chain.ChainName.String()).Msgf("Chain %s endpoint %s", chain.String(), btcCfg.RPCHost)
+	connCfg := &rpcclient.ConnConfig{
+		Host:         btcCfg.RPCHost,
+		User:         btcCfg.RPCUsername,
+		Pass:         btcCfg.RPCPassword,
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Params:       btcCfg.RPCParams,
+	}
+	client, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating rpc client: %s", err)
+	}
+	err = client.Ping()
+	if err != nil {
+		return nil, fmt.Errorf("error ping the bitcoin server: %s", err)
+	}
+
+	return NewBitcoinClientWithRPCClient(chain, bridge, tss, client, storeOpts, metrics, logger, btcCfg, ts)
+}
+
+// NewBitcoinClientWithRPCClient returns a new configuration based on supplied target chain, the chain is observed through the given rpc client
+func NewBitcoinClientWithRPCClient(
+	chain common.Chain,
+	bridge ZetaCoreBridger,
+	tss TSSSigner,
+	rpcClient BTCRPCClient,
+	storeOpts store.Options,
 	metrics *metricsPkg.Metrics,
 	logger zerolog.Logger,
 	btcCfg config.BTCConfig,
@@ -146,32 +188,16 @@ func NewBitcoinClient(
 	}
 
 	ob.zetaClient = bridge
+	ob.assetDecimals = NewAssetDecimals(bridge, chain.ChainId)
+	ob.pendingInbounds = NewPendingInbounds()
 	ob.Tss = tss
 	ob.includedTxHashes = make(map[string]uint64)
 	ob.includedTxResults = make(map[string]btcjson.GetTransactionResult)
 	ob.broadcastedTx = make(map[string]string)
 	ob.params = btcCfg.CoreParams
+	ob.rpcClient = rpcClient
 
-	// initialize the Client
-	ob.logger.ChainLogger.Info().Msgf("Chain %s endpoint %s", ob.chain.String(), btcCfg.RPCHost)
-	connCfg := &rpcclient.ConnConfig{
-		Host:         btcCfg.RPCHost,
-		User:         btcCfg.RPCUsername,
-		Pass:         btcCfg.RPCPassword,
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       btcCfg.RPCParams,
-	}
-	client, err := rpcclient.New(connCfg, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating rpc client: %s", err)
-	}
-	ob.rpcClient = client
-	err = client.Ping()
-	if err != nil {
-		return nil, fmt.Errorf("error ping the bitcoin server: %s", err)
-	}
-
+	var err error
 	ob.BlockCache, err = lru.New(btcBlocksPerDay)
 	if err != nil {
 		ob.logger.ChainLogger.Error().Err(err).Msg("failed to create bitcoin block cache")
@@ -183,8 +209,8 @@ func NewBitcoinClient(
 		return nil, err
 	}
 
-	//Load btc chain client DB
-	err = ob.loadDB(dbpath)
+	//Load btc chain client store
+	err = ob.loadDB(storeOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -199,11 +225,17 @@ func (ob *BitcoinChainClient) Start() {
 	go ob.WatchUTXOS()
 	go ob.WatchGasPrice()
 	go ob.ExternalChainWatcherForNewInboundTrackerSuggestions()
+	if ob.storeRetention > 0 {
+		go runStorePruner(ob.PruneStore, ob.stop, ob.logger.ChainLogger)
+	}
 }
 
 func (ob *BitcoinChainClient) Stop() {
 	ob.logger.ChainLogger.Info().Msgf("ob %s is stopping", ob.chain.String())
 	close(ob.stop) // this notifies all goroutines to stop
+	if err := ob.store.Close(); err != nil {
+		ob.logger.ChainLogger.Error().Err(err).Msg("error closing store")
+	}
 	ob.logger.ChainLogger.Info().Msgf("%s observer stopped", ob.chain.String())
 }
 
@@ -239,6 +271,42 @@ func (ob *BitcoinChainClient) GetLastBlockHeightScanned() int64 {
 	return height
 }
 
+// PauseInbound stops the observation of inbound transactions of the chain by this client
+func (ob *BitcoinChainClient) PauseInbound(reason string) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.inboundPauseReason = &reason
+}
+
+// UnpauseInbound resumes the observation of inbound transactions of the chain by this client
+func (ob *BitcoinChainClient) UnpauseInbound() {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.inboundPauseReason = nil
+}
+
+// IsInboundPaused returns true and the reason if the observation of inbound transactions is paused
+func (ob *BitcoinChainClient) IsInboundPaused() (string, bool) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	if ob.inboundPauseReason == nil {
+		return "", false
+	}
+	return *ob.inboundPauseReason, true
+}
+
+// RescanFrom makes the inbound observation scan again the blocks from the height on its next tick
+func (ob *BitcoinChainClient) RescanFrom(height uint64) error {
+	lastScanned := ob.GetLastBlockHeightScanned()
+	// #nosec G701 always positive
+	if height == 0 || height > uint64(lastScanned)+1 {
+		return fmt.Errorf("invalid rescan height %d, last scanned block is %d", height, lastScanned)
+	}
+	// #nosec G701 checked as not above the last scanned block
+	atomic.StoreInt64(&ob.rescanFrom, int64(height))
+	return nil
+}
+
 func (ob *BitcoinChainClient) GetPendingNonce() uint64 {
 	ob.Mu.Lock()
 	defer ob.Mu.Unlock()
@@ -280,7 +348,10 @@ func (ob *BitcoinChainClient) postBlockHeader(tip int64) error {
 	bn := tip
 	res, err := ob.zetaClient.GetBlockHeaderStateByChain(ob.chain.ChainId)
 	if err == nil && res.BlockHeaderState != nil && res.BlockHeaderState.EarliestHeight > 0 {
-		bn = res.BlockHeaderState.LatestHeight + 1
+		bn, err = ob.nextBlockHeaderHeight(res.BlockHeaderState)
+		if err != nil {
+			return err
+		}
 	}
 	if bn > tip {
 		return fmt.Errorf("postBlockHeader: must post block confirmed block header: %d > %d", bn, tip)
@@ -310,7 +381,38 @@ func (ob *BitcoinChainClient) postBlockHeader(tip int64) error {
 	return err
 }
 
+// nextBlockHeaderHeight returns the height of the next block header to post after the best chain of zetacore
+// If the best chain of the node forked from the best chain of zetacore, the block headers of the node are posted from
+// the fork point so that zetacore can switch to the heavier chain
+func (ob *BitcoinChainClient) nextBlockHeaderHeight(bhs *observertypes.BlockHeaderState) (int64, error) {
+	for height := bhs.LatestHeight; height >= bhs.EarliestHeight && height > bhs.LatestHeight-maxForkSearchDepth; height-- {
+		hash, err := ob.rpcClient.GetBlockHash(height)
+		if err != nil {
+			return 0, fmt.Errorf("error getting bitcoin block hash %d: %s", height, err)
+		}
+		if height == bhs.LatestHeight && bytes.Equal(hash[:], bhs.LatestBlockHash) {
+			return height + 1, nil
+		}
+		if _, err := ob.zetaClient.GetBlockHeaderByHash(hash[:]); err == nil {
+			if height != bhs.LatestHeight {
+				ob.logger.WatchInTx.Warn().Msgf("nextBlockHeaderHeight: best chain forked at block %d", height)
+			}
+			return height + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("nextBlockHeaderHeight: fork point not found within %d blocks of block %d", maxForkSearchDepth, bhs.LatestHeight)
+}
+
 func (ob *BitcoinChainClient) observeInTx() error {
+	if reason, paused := ob.IsInboundPaused(); paused {
+		ob.logger.WatchInTx.Warn().Msgf("observeInTx: inbound observation is paused: %s", reason)
+		return nil
+	}
+	if height := atomic.SwapInt64(&ob.rescanFrom, 0); height > 0 {
+		ob.logger.WatchInTx.Warn().Msgf("observeInTx: rescanning from block %d", height)
+		ob.SetLastBlockHeightScanned(height - 1)
+	}
+
 	cnt, err := ob.rpcClient.GetBlockCount()
 	if err != nil {
 		return fmt.Errorf("error getting block count: %s", err)
@@ -319,9 +421,9 @@ func (ob *BitcoinChainClient) observeInTx() error {
 		return fmt.Errorf("block count is negative: %d", cnt)
 	}
 
-	// "confirmed" current block number
+	// "confirmed" current block number for the transfers requiring the fewest confirmations
 	// #nosec G701 always in range
-	confirmedBlockNum := cnt - int64(ob.GetCoreParams().ConfirmationCount)
+	confirmedBlockNum := cnt - int64(ob.GetCoreParams().MinConfirmationCount())
 	if confirmedBlockNum < 0 {
 		return fmt.Errorf("skipping observer , current block number %d is too small", cnt)
 	}
@@ -335,6 +437,10 @@ func (ob *BitcoinChainClient) observeInTx() error {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
 
+	// post the votes of the deferred inbound txs confirmed for their amount
+	// #nosec G701 always positive
+	postConfirmedInbounds(ob.zetaClient, ob.pendingInbounds, uint64(cnt), ob.getBlockHash, ob.logger.WatchInTx)
+
 	// query incoming gas asset
 	lastBN := ob.GetLastBlockHeightScanned()
 	if confirmedBlockNum > lastBN {
@@ -375,6 +481,11 @@ func (ob *BitcoinChainClient) observeInTx() error {
 
 		for _, inTx := range inTxs {
 			msg := ob.GetInboundVoteMessageFromBtcEvent(inTx)
+			traceInboundObserved(msg, InboundSourceObserver)
+			// #nosec G701 always positive
+			if deferInbound(ob.pendingInbounds, ob.GetCoreParams(), ob.assetDecimals, msg, PostSendEVMGasLimit, res.Block.Hash, uint64(cnt), ob.logger.WatchInTx) {
+				continue
+			}
 			zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
 			if err != nil {
 				ob.logger.WatchInTx.Error().Err(err).Msg("error posting to zeta core")
@@ -383,23 +494,34 @@ func (ob *BitcoinChainClient) observeInTx() error {
 			ob.logger.WatchInTx.Info().Msgf("ZetaSent event detected and reported: PostSend zeta tx: %s", zetaHash)
 		}
 
-		// Save LastBlockHeight
+		// Save LastBlockHeight, the blocks of the deferred inbound txs are scanned again after a restart
 		ob.SetLastBlockHeightScanned(bn)
 		// #nosec G701 always positive
-		if err := ob.db.Save(clienttypes.ToLastBlockSQLType(uint64(ob.GetLastBlockHeightScanned()))).Error; err != nil {
-			ob.logger.WatchInTx.Error().Err(err).Msg("error writing Block to db")
+		lastScanned := ob.pendingInbounds.LastScannedToSave(uint64(ob.GetLastBlockHeightScanned()))
+		if err := saveLastBlock(ob.store, lastScanned); err != nil {
+			ob.logger.WatchInTx.Error().Err(err).Msg("error writing Block to store")
 		}
 	}
 
 	return nil
 }
 
-// ConfirmationsThreshold returns number of required Bitcoin confirmations depending on sent BTC amount.
+// ConfirmationsThreshold returns number of required Bitcoin confirmations depending on sent BTC amount
+// The confirmation count is given by the confirmation tiers of the core params
 func (ob *BitcoinChainClient) ConfirmationsThreshold(amount *big.Int) int64 {
-	if amount.Cmp(big.NewInt(200000000)) >= 0 {
-		return 6
+	confirmations := requiredConfirmations(ob.GetCoreParams(), ob.assetDecimals, common.CoinType_Gas, "", cosmosmath.NewUintFromBigInt(amount))
+	// #nosec G701 always in range
+	return int64(confirmations)
+}
+
+// getBlockHash returns the hash of the block at the height
+func (ob *BitcoinChainClient) getBlockHash(height uint64) (string, error) {
+	// #nosec G701 always in range
+	hash, err := ob.rpcClient.GetBlockHash(int64(height))
+	if err != nil {
+		return "", err
 	}
-	return 2
+	return hash.String(), nil
 }
 
 // IsSendOutTxProcessed returns isIncluded(or inMempool), isConfirmed, Error
@@ -512,7 +634,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 			return err
 		}
 		// #nosec G701 always in range
-		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, "100", uint64(bn))
+		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, 0, "100", uint64(bn))
 		if err != nil {
 			ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 			return err
@@ -538,7 +660,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 		return err
 	}
 	// #nosec G701 always positive
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), "100", uint64(bn))
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), 0, "100", uint64(bn))
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 		return err
@@ -660,7 +782,7 @@ func GetBtcEvent(
 					logger.Warn().Err(err).Msgf("error hex decoding memo")
 					return nil, fmt.Errorf("error hex decoding memo: %s", err)
 				}
-				if bytes.Equal(memoBytes, []byte(DonationMessage)) {
+				if bytes.Equal(memoBytes, []byte(common.DonationMessage)) {
 					logger.Info().Msgf("donation tx: %s; value %f", tx.Txid, value)
 					return nil, fmt.Errorf("donation tx: %s; value %f", tx.Txid, value)
 				}
@@ -766,15 +888,7 @@ func (ob *BitcoinChainClient) FetchUTXOS() error {
 	}
 
 	// rigid sort to make utxo list deterministic
-	sort.SliceStable(utxos, func(i, j int) bool {
-		if utxos[i].Amount == utxos[j].Amount {
-			if utxos[i].TxID == utxos[j].TxID {
-				return utxos[i].Vout < utxos[j].Vout
-			}
-			return utxos[i].TxID < utxos[j].TxID
-		}
-		return utxos[i].Amount < utxos[j].Amount
-	})
+	sortUTXOs(utxos)
 
 	// filter UTXOs big enough to cover the cost of spending themselves
 	utxosFiltered := make([]btcjson.ListUnspentResult, 0)
@@ -791,6 +905,19 @@ func (ob *BitcoinChainClient) FetchUTXOS() error {
 	return nil
 }
 
+// sortUTXOs sorts the utxos by amount, txid and vout
+func sortUTXOs(utxos []btcjson.ListUnspentResult) {
+	sort.SliceStable(utxos, func(i, j int) bool {
+		if utxos[i].Amount == utxos[j].Amount {
+			if utxos[i].TxID == utxos[j].TxID {
+				return utxos[i].Vout < utxos[j].Vout
+			}
+			return utxos[i].TxID < utxos[j].TxID
+		}
+		return utxos[i].Amount < utxos[j].Amount
+	})
+}
+
 // refreshPendingNonce tries increasing the artificial pending nonce of outTx (if lagged behind).
 // There could be many (unpredictable) reasons for a pending nonce lagging behind, for example:
 // 1. The zetaclient gets restarted.
@@ -888,6 +1015,24 @@ func (ob *BitcoinChainClient) findNonceMarkUTXO(nonce uint64, txid string) (int,
 //   - the number of consolidated UTXOs.
 //   - the total value of the consolidated UTXOs.
 func (ob *BitcoinChainClient) SelectUTXOs(amount float64, utxosToSpend uint16, nonce uint64, consolidateRank uint16, test bool) ([]btcjson.ListUnspentResult, float64, uint16, float64, error) {
+	utxos, idx, err := ob.nonceMarkedUTXOs(nonce, test)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	selected, total, consolidatedUtxo, consolidatedValue, err := selectUTXOs(utxos, idx, amount, utxosToSpend, consolidateRank)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	results := make([]btcjson.ListUnspentResult, len(selected))
+	for i, j := range selected {
+		results[i] = utxos[j]
+	}
+	return results, total, consolidatedUtxo, consolidatedValue, nil
+}
+
+// nonceMarkedUTXOs returns a copy of the utxos and the index of the nonce-mark utxo of the previous nonce.
+// The index is -1 for nonce 0
+func (ob *BitcoinChainClient) nonceMarkedUTXOs(nonce uint64, test bool) ([]btcjson.ListUnspentResult, int, error) {
 	idx := -1
 	if nonce == 0 {
 		// for nonce = 0; make exception; no need to include nonce-mark utxo
@@ -897,39 +1042,48 @@ func (ob *BitcoinChainClient) SelectUTXOs(amount float64, utxosToSpend uint16, n
 		// for nonce > 0; we proceed only when we see the nonce-mark utxo
 		preTxid, err := ob.getOutTxidByNonce(nonce-1, test)
 		if err != nil {
-			return nil, 0, 0, 0, err
+			return nil, -1, err
 		}
 		ob.Mu.Lock()
 		defer ob.Mu.Unlock()
 		idx, err = ob.findNonceMarkUTXO(nonce-1, preTxid)
 		if err != nil {
-			return nil, 0, 0, 0, err
+			return nil, -1, err
 		}
 	}
+	utxos := make([]btcjson.ListUnspentResult, len(ob.utxos))
+	copy(utxos, ob.utxos)
+	return utxos, idx, nil
+}
 
+// selectUTXOs selects the indexes of a sublist of the sorted utxos to be used as inputs.
+// The nonce-mark utxo at index idx (if idx >= 0) is always selected as the 1st input.
+func selectUTXOs(utxos []btcjson.ListUnspentResult, idx int, amount float64, utxosToSpend uint16, consolidateRank uint16) ([]int, float64, uint16, float64, error) {
 	// select smallest possible UTXOs to make payment
 	total := 0.0
 	left, right := 0, 0
-	for total < amount && right < len(ob.utxos) {
+	for total < amount && right < len(utxos) {
 		if utxosToSpend > 0 { // expand sublist
-			total += ob.utxos[right].Amount
+			total += utxos[right].Amount
 			right++
 			utxosToSpend--
 		} else { // pop the smallest utxo and append the current one
-			total -= ob.utxos[left].Amount
-			total += ob.utxos[right].Amount
+			total -= utxos[left].Amount
+			total += utxos[right].Amount
 			left++
 			right++
 		}
 	}
-	results := make([]btcjson.ListUnspentResult, right-left)
-	copy(results, ob.utxos[left:right])
+	results := make([]int, 0, right-left+1)
+	for i := left; i < right; i++ {
+		results = append(results, i)
+	}
 
 	// include nonce-mark as the 1st input
 	if idx >= 0 { // for nonce > 0
 		if idx < left || idx >= right {
-			total += ob.utxos[idx].Amount
-			results = append([]btcjson.ListUnspentResult{ob.utxos[idx]}, results...)
+			total += utxos[idx].Amount
+			results = append([]int{idx}, results...)
 		} else { // move nonce-mark to left
 			for i := idx - left; i > 0; i-- {
 				results[i], results[i-1] = results[i-1], results[i]
@@ -943,15 +1097,15 @@ func (ob *BitcoinChainClient) SelectUTXOs(amount float64, utxosToSpend uint16, n
 	// consolidate biggest possible UTXOs to maximize consolidated value
 	// consolidation happens only when there are more than (or equal to) consolidateRank (10) UTXOs
 	utxoRank, consolidatedUtxo, consolidatedValue := uint16(0), uint16(0), 0.0
-	for i := len(ob.utxos) - 1; i >= 0 && utxosToSpend > 0; i-- { // iterate over UTXOs big-to-small
+	for i := len(utxos) - 1; i >= 0 && utxosToSpend > 0; i-- { // iterate over UTXOs big-to-small
 		if i != idx && (i < left || i >= right) { // exclude nonce-mark and already selected UTXOs
 			utxoRank++
 			if utxoRank >= consolidateRank { // consolication starts from the 10-ranked UTXO based on value
 				utxosToSpend--
 				consolidatedUtxo++
-				total += ob.utxos[i].Amount
-				consolidatedValue += ob.utxos[i].Amount
-				results = append(results, ob.utxos[i])
+				total += utxos[i].Amount
+				consolidatedValue += utxos[i].Amount
+				results = append(results, i)
 			}
 		}
 	}
@@ -966,8 +1120,11 @@ func (ob *BitcoinChainClient) SaveBroadcastedTx(txHash string, nonce uint64) {
 	ob.broadcastedTx[outTxID] = txHash
 	ob.Mu.Unlock()
 
-	broadcastEntry := clienttypes.ToOutTxHashSQLType(txHash, outTxID)
-	if err := ob.db.Save(&broadcastEntry).Error; err != nil {
+	value, err := store.EncodeTxHash(txHash)
+	if err == nil {
+		err = ob.store.Put(store.BucketBroadcastedTxs, outTxID, value)
+	}
+	if err != nil {
 		ob.logger.ObserveOutTx.Error().Err(err).Msgf("SaveBroadcastedTx: error saving broadcasted txHash %s for outTx %s", txHash, outTxID)
 	}
 	ob.logger.ObserveOutTx.Info().Msgf("SaveBroadcastedTx: saved broadcasted txHash %s for outTx %s", txHash, outTxID)
@@ -1057,6 +1214,9 @@ func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.O
 			if params.OutboundTxTssNonce >= ob.pendingNonce { // try increasing pending nonce on every newly included outTx
 				ob.pendingNonce = params.OutboundTxTssNonce + 1
 			}
+			if err := ob.saveTxResult(outTxID, *getTxResult); err != nil {
+				ob.logger.ObserveOutTx.Error().Err(err).Msgf("checkNSaveIncludedTx: error saving result of outTx %s outTxID %s", txHash, outTxID)
+			}
 			ob.logger.ObserveOutTx.Info().Msgf("checkNSaveIncludedTx: included new bitcoin outTx %s outTxID %s pending nonce %d", txHash, outTxID, ob.pendingNonce)
 		}
 		// update saved tx result as confirmations may increase
@@ -1235,14 +1395,65 @@ func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.Ou
 }
 
 func (ob *BitcoinChainClient) BuildBroadcastedTxMap() error {
-	var broadcastedTransactions []clienttypes.OutTxHashSQLType
-	if err := ob.db.Find(&broadcastedTransactions).Error; err != nil {
-		ob.logger.ChainLogger.Error().Err(err).Msg("error iterating over db")
+	return ob.store.Iterate(store.BucketBroadcastedTxs, func(entry store.Entry) error {
+		hash, err := store.DecodeTxHash(entry.Value)
+		if err != nil {
+			return errors.Wrapf(err, "BuildBroadcastedTxMap: error decoding hash of outTx %s", entry.Key)
+		}
+		ob.broadcastedTx[entry.Key] = hash
+		return nil
+	})
+}
+
+// BuildIncludedTxMaps loads the results of the included outTxs, their confirmations are refreshed by observeOutTx
+func (ob *BitcoinChainClient) BuildIncludedTxMaps() error {
+	return ob.store.Iterate(store.BucketTxResults, func(entry store.Entry) error {
+		result, err := store.DecodeTxResult(entry.Value)
+		if err != nil {
+			return errors.Wrapf(err, "BuildIncludedTxMaps: error decoding result of outTx %s", entry.Key)
+		}
+		nonce, err := outTxNonce(entry.Key)
+		if err != nil {
+			return err
+		}
+		ob.includedTxResults[entry.Key] = result
+		ob.includedTxHashes[result.TxID] = nonce
+		return nil
+	})
+}
+
+// saveTxResult saves the result of an included outTx in the store
+func (ob *BitcoinChainClient) saveTxResult(outTxID string, result btcjson.GetTransactionResult) error {
+	value, err := store.EncodeTxResult(result)
+	if err != nil {
+		return err
+	}
+	return ob.store.Put(store.BucketTxResults, outTxID, value)
+}
+
+// PruneStore deletes the results and the hashes of the outTxs finalized on zetacore before the retention window
+func (ob *BitcoinChainClient) PruneStore() error {
+	prunable, err := outTxPrunable(ob.zetaClient, ob.chain.ChainId, ob.GetTxID)
+	if err != nil {
 		return err
 	}
-	for _, entry := range broadcastedTransactions {
-		ob.broadcastedTx[entry.Key] = entry.Hash
+	pruned, err := pruneOutTxData(ob.store, ob.storeRetention, prunable, store.BucketTxResults, store.BucketBroadcastedTxs)
+	ob.Mu.Lock()
+	for _, outTxID := range pruned[store.BucketTxResults] {
+		if res, found := ob.includedTxResults[outTxID]; found {
+			delete(ob.includedTxHashes, res.TxID)
+			delete(ob.includedTxResults, outTxID)
+		}
+	}
+	for _, outTxID := range pruned[store.BucketBroadcastedTxs] {
+		delete(ob.broadcastedTx, outTxID)
 	}
+	ob.Mu.Unlock()
+	if err != nil {
+		return err
+	}
+	ob.logger.ChainLogger.Info().Msgf("PruneStore: pruned %d tx results and %d broadcasted txs",
+		len(pruned[store.BucketTxResults]), len(pruned[store.BucketBroadcastedTxs]))
 	return nil
 }
 
@@ -1253,18 +1464,18 @@ func (ob *BitcoinChainClient) LoadLastBlock() error {
 	}
 
 	//Load persisted block number
-	var lastBlockNum clienttypes.LastBlockSQLType
-	if err := ob.db.First(&lastBlockNum, clienttypes.LastBlockNumID).Error; err != nil {
-		ob.logger.ChainLogger.Info().Msg("LastBlockNum not found in DB, scan from latest")
+	lastBlockNum, err := loadLastBlock(ob.store)
+	if err != nil {
+		ob.logger.ChainLogger.Info().Err(err).Msg("LastBlockNum not found in store, scan from latest")
 		ob.SetLastBlockHeightScanned(bn)
 	} else {
 		// #nosec G701 always in range
-		lastBN := int64(lastBlockNum.Num)
+		lastBN := int64(lastBlockNum)
 		ob.SetLastBlockHeightScanned(lastBN)
 
 		//If persisted block number is too low, use the latest height
 		if (bn - lastBN) > maxHeightDiff {
-			ob.logger.ChainLogger.Info().Msgf("LastBlockNum too low: %d, scan from latest", lastBlockNum.Num)
+			ob.logger.ChainLogger.Info().Msgf("LastBlockNum too low: %d, scan from latest", lastBlockNum)
 			ob.SetLastBlockHeightScanned(bn)
 		}
 	}
@@ -1277,26 +1488,13 @@ func (ob *BitcoinChainClient) LoadLastBlock() error {
 	return nil
 }
 
-func (ob *BitcoinChainClient) loadDB(dbpath string) error {
-	if _, err := os.Stat(dbpath); os.IsNotExist(err) {
-		err := os.MkdirAll(dbpath, os.ModePerm)
-		if err != nil {
-			return err
-		}
-	}
-	path := fmt.Sprintf("%s/btc_chain_client", dbpath)
-	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
-	if err != nil {
-		panic("failed to connect database")
-	}
-	ob.db = db
-
-	err = db.AutoMigrate(&clienttypes.TransactionResultSQLType{},
-		&clienttypes.OutTxHashSQLType{},
-		&clienttypes.LastBlockSQLType{})
+func (ob *BitcoinChainClient) loadDB(opts store.Options) error {
+	s, err := store.Open(opts.Backend, opts.Dir, btcStoreName)
 	if err != nil {
 		return err
 	}
+	ob.store = s
+	ob.storeRetention = opts.Retention
 
 	//Load last block
 	err = ob.LoadLastBlock()
@@ -1304,10 +1502,12 @@ func (ob *BitcoinChainClient) loadDB(dbpath string) error {
 		return err
 	}
 
-	//Load broadcasted transactions
+	//Load broadcasted and included transactions
 	err = ob.BuildBroadcastedTxMap()
-
-	return err
+	if err != nil {
+		return err
+	}
+	return ob.BuildIncludedTxMaps()
 }
 
 func (ob *BitcoinChainClient) GetTxID(nonce uint64) string {
@@ -1,6 +1,7 @@
 package zetaclient
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -14,10 +15,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	flag "github.com/spf13/pflag"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -125,6 +130,79 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 	return commit.TxHash, nil
 }
 
+// voteSigner returns the key of the authz signer of the vote
+func (b *ZetaCoreBridge) voteSigner(msg sdktypes.Msg) string {
+	return GetSigner(sdktypes.MsgTypeURL(msg)).String()
+}
+
+// votesSigner returns the authz signer of the votes, the votes must have the same signer to be wrapped in a single
+// authz exec
+func votesSigner(msgs []sdktypes.Msg) (AuthZSigner, error) {
+	if len(msgs) == 0 {
+		return AuthZSigner{}, fmt.Errorf("no votes to sign")
+	}
+	authzSigner := GetSigner(sdktypes.MsgTypeURL(msgs[0]))
+	for _, msg := range msgs[1:] {
+		signer := GetSigner(sdktypes.MsgTypeURL(msg))
+		if signer.String() != authzSigner.String() {
+			return AuthZSigner{}, fmt.Errorf("vote %s signed by %s, expected %s", sdktypes.MsgTypeURL(msg), signer, authzSigner)
+		}
+	}
+	return authzSigner, nil
+}
+
+// simulateVotes simulates the votes wrapped in a single authz exec and returns the gas used
+// The error wraps ErrVoteRejected if the simulation fails because of a vote
+func (b *ZetaCoreBridge) simulateVotes(msgs []sdktypes.Msg) (uint64, error) {
+	authzSigner, err := votesSigner(msgs)
+	if err != nil {
+		return 0, err
+	}
+	authzMsg := authz.NewMsgExec(authzSigner.GranteeAddress, msgs)
+
+	ctx, err := b.GetContext()
+	if err != nil {
+		return 0, err
+	}
+	b.broadcastLock.RLock()
+	factory := clienttx.NewFactoryCLI(ctx, flag.NewFlagSet("zetacore", 0))
+	factory = factory.WithAccountNumber(b.accountNumber[authzSigner.KeyType])
+	factory = factory.WithSequence(b.seqNumber[authzSigner.KeyType])
+	factory = factory.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	b.broadcastLock.RUnlock()
+
+	simRes, _, err := clienttx.CalculateGas(b.grpcConn, factory, &authzMsg)
+	if err != nil {
+		// the simulation fails with an unknown code when a message fails, a sequence mismatch is fixed by the broadcast
+		st, ok := status.FromError(err)
+		if ok && st.Code() == codes.Unknown && !strings.Contains(st.Message(), "account sequence mismatch") {
+			return 0, fmt.Errorf("%w: %s", ErrVoteRejected, st.Message())
+		}
+		return 0, err
+	}
+	return simRes.GasInfo.GasUsed, nil
+}
+
+// broadcastVotes broadcasts the votes wrapped in a single authz exec and returns the tx hash
+func (b *ZetaCoreBridge) broadcastVotes(gasLimit uint64, msgs []sdktypes.Msg) (string, error) {
+	authzSigner, err := votesSigner(msgs)
+	if err != nil {
+		return "", err
+	}
+	authzMsg := authz.NewMsgExec(authzSigner.GranteeAddress, msgs)
+	return b.Broadcast(gasLimit, &authzMsg, authzSigner)
+}
+
+// getTxResult returns the result of a tx included in a block
+func (b *ZetaCoreBridge) getTxResult(txHash string) (*sdktypes.TxResponse, error) {
+	client := txtypes.NewServiceClient(b.grpcConn)
+	resp, err := client.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: txHash})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TxResponse, nil
+}
+
 // GetContext return a valid context with all relevant values set
 func (b *ZetaCoreBridge) GetContext() (client.Context, error) {
 	ctx := client.Context{}
@@ -180,5 +258,8 @@ func (b *ZetaCoreBridge) SignTx(
 	if b.cfg.HsmMode {
 		return hsm.SignWithHSM(txf, name, txBuilder, overwriteSig, txConfig)
 	}
+	if b.remoteSigner != nil {
+		return b.remoteSigner.SignTx(txf, txBuilder, txConfig)
+	}
 	return clienttx.Sign(txf, name, txBuilder, overwriteSig)
 }
@@ -2,6 +2,7 @@ package zetaclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
@@ -16,9 +18,12 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/rs/zerolog"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -43,7 +48,7 @@ type BTCSigner struct {
 	ts        *TelemetryServer
 }
 
-var _ ChainSigner = &BTCSigner{}
+var _ BatchChainSigner = &BTCSigner{}
 
 func NewBTCSigner(cfg config.BTCConfig, tssSigner TSSSigner, logger zerolog.Logger, ts *TelemetryServer) (*BTCSigner, error) {
 	connCfg := &rpcclient.ConnConfig{
@@ -59,14 +64,32 @@ func NewBTCSigner(cfg config.BTCConfig, tssSigner TSSSigner, logger zerolog.Logg
 		return nil, fmt.Errorf("error creating bitcoin rpc client: %s", err)
 	}
 
+	return NewBTCSignerWithRPCClient(tssSigner, client, logger, ts), nil
+}
+
+// NewBTCSignerWithRPCClient returns a new bitcoin signer broadcasting the outbound transactions through the given rpc client
+func NewBTCSignerWithRPCClient(tssSigner TSSSigner, rpcClient BTCRPCClient, logger zerolog.Logger, ts *TelemetryServer) *BTCSigner {
 	return &BTCSigner{
 		tssSigner: tssSigner,
-		rpcClient: client,
+		rpcClient: rpcClient,
 		logger: logger.With().
 			Str("chain", "BTC").
 			Str("module", "BTCSigner").Logger(),
 		ts: ts,
-	}, nil
+	}
+}
+
+// btcOutTx is the outbound tx of a cctx to be signed
+type btcOutTx struct {
+	cctx      *types.CrossChainTx
+	outTxID   string
+	to        *btcutil.AddressWitnessPubKeyHash
+	amount    float64
+	gasPrice  *big.Int
+	sizeLimit uint64
+	nonce     uint64
+	ctx       context.Context
+	logger    zerolog.Logger
 }
 
 // SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
@@ -80,9 +103,6 @@ func (signer *BTCSigner) SignWithdrawTx(
 	nonce uint64,
 	chain *common.Chain,
 ) (*wire.MsgTx, error) {
-	estimateFee := float64(gasPrice.Uint64()*outTxBytesMax) / 1e8
-	nonceMark := common.NonceMarkAmount(nonce)
-
 	// refresh unspent UTXOs and continue with keysign regardless of error
 	err := btcClient.FetchUTXOS()
 	if err != nil {
@@ -90,11 +110,189 @@ func (signer *BTCSigner) SignWithdrawTx(
 	}
 
 	// select N UTXOs to cover the total expense
-	prevOuts, total, consolidatedUtxo, consolidatedValue, err := btcClient.SelectUTXOs(amount+estimateFee+float64(nonceMark)*1e-8, maxNoOfInputsPerTx, nonce, consolidationRank, false)
+	prevOuts, total, consolidatedUtxo, consolidatedValue, err := btcClient.SelectUTXOs(withdrawTxCost(amount, gasPrice, nonce), maxNoOfInputsPerTx, nonce, consolidationRank, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := signer.buildWithdrawTx(to, amount, gasPrice, sizeLimit, nonce, prevOuts, total, consolidatedUtxo, consolidatedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign the tx
+	witnessHashes, err := withdrawTxWitnessHashes(tx, prevOuts)
+	if err != nil {
+		return nil, err
+	}
+	sig65Bs, err := signer.signWitnessHashes(witnessHashes, height, nonce, chain)
+	if err != nil {
+		return nil, err
+	}
+	signer.addWitnesses(tx, sig65Bs)
+	return tx, nil
+}
+
+// SignWithdrawTxBatch builds the withdraw txs of consecutive nonces and signs the witness hashes of all their inputs in a single keysign.
+// The tx of a nonce spends the nonce-mark output of the tx of the previous nonce, which is known before signing as segwit txids
+// don't commit to the witnesses, so the txs of a batch are chained to each other the same way as the txs signed one by one.
+// The batch stops at the first tx that can't be built; only the 1st tx is signed, input by input, if the batched keysign fails.
+// It returns the signed txs in nonce order, which can be fewer than the outbound txs
+func (signer *BTCSigner) SignWithdrawTxBatch(
+	outTxs []*btcOutTx,
+	btcClient *BitcoinChainClient,
+	height uint64,
+	chain *common.Chain,
+) ([]*wire.MsgTx, error) {
+	firstNonce := outTxs[0].nonce
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := btcClient.FetchUTXOS()
+	if err != nil {
+		signer.logger.Error().Err(err).Msgf("SignWithdrawTxBatch: FetchUTXOS error: nonce %d chain %d", firstNonce, chain.ChainId)
+	}
+	utxos, idx, err := btcClient.nonceMarkedUTXOs(firstNonce, false)
 	if err != nil {
 		return nil, err
 	}
 
+	tssAddress := signer.tssSigner.BTCAddressWitnessPubkeyHash().EncodeAddress()
+	txs := make([]*wire.MsgTx, 0, len(outTxs))
+	witnessHashes := make([][][]byte, 0, len(outTxs))
+	for i, outTx := range outTxs {
+		tx, hashes, selected, err := signer.buildWithdrawTxFrom(outTx, utxos, idx)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			outTx.logger.Warn().Err(err).Msgf("SignWithdrawTxBatch: cannot build tx of nonce %d; signing nonces %d to %d", outTx.nonce, firstNonce, outTx.nonce-1)
+			break
+		}
+		txs = append(txs, tx)
+		witnessHashes = append(witnessHashes, hashes)
+
+		// the tx of the next nonce spends the nonce-mark and the change of this tx
+		utxos, idx = spendWithdrawTx(utxos, selected, tx, tssAddress)
+	}
+
+	// sign the inputs of all the txs in a single keysign
+	digests := make([][]byte, 0, len(txs)*maxNoOfInputsPerTx)
+	for _, hashes := range witnessHashes {
+		digests = append(digests, hashes...)
+	}
+	sig65Bs, err := signer.tssSigner.SignBatch(digests, height, firstNonce, chain)
+	if err == nil && len(sig65Bs) != len(digests) {
+		err = fmt.Errorf("got %d signatures for %d digests", len(sig65Bs), len(digests))
+	}
+	if err != nil {
+		// fallback to the 1st tx only, the later txs spend the outputs of a tx the other signers might not have signed
+		signer.logger.Warn().Err(err).Msgf("SignWithdrawTxBatch: SignBatch error: nonces %d to %d chain %d; signing nonce %d alone",
+			firstNonce, firstNonce+uint64(len(txs))-1, chain.ChainId, firstNonce)
+		sig65Bs, err = signer.signWitnessHashes(witnessHashes[0], height, firstNonce, chain)
+		if err != nil {
+			return nil, err
+		}
+		txs = txs[:1]
+	}
+
+	start := 0
+	for i, tx := range txs {
+		signer.addWitnesses(tx, sig65Bs[start:start+len(witnessHashes[i])])
+		start += len(witnessHashes[i])
+	}
+	return txs, nil
+}
+
+// buildWithdrawTxFrom builds the unsigned withdraw tx of an outbound from the sorted utxos, the nonce-mark utxo is at index idx.
+// It returns the tx, the witness hashes of its inputs and the indexes of the spent utxos
+func (signer *BTCSigner) buildWithdrawTxFrom(outTx *btcOutTx, utxos []btcjson.ListUnspentResult, idx int) (*wire.MsgTx, [][]byte, []int, error) {
+	selected, total, consolidatedUtxo, consolidatedValue, err := selectUTXOs(
+		utxos,
+		idx,
+		withdrawTxCost(outTx.amount, outTx.gasPrice, outTx.nonce),
+		maxNoOfInputsPerTx,
+		consolidationRank,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	prevOuts := make([]btcjson.ListUnspentResult, len(selected))
+	for i, j := range selected {
+		prevOuts[i] = utxos[j]
+	}
+	tx, err := signer.buildWithdrawTx(outTx.to, outTx.amount, outTx.gasPrice, outTx.sizeLimit, outTx.nonce, prevOuts, total, consolidatedUtxo, consolidatedValue)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	witnessHashes, err := withdrawTxWitnessHashes(tx, prevOuts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return tx, witnessHashes, selected, nil
+}
+
+// spendWithdrawTx returns the sorted utxos left once the withdraw tx spends the selected utxos, with the nonce-mark
+// and the change outputs of the tx added, and the index of the nonce-mark, the 1st input of the tx of the next nonce
+func spendWithdrawTx(utxos []btcjson.ListUnspentResult, selected []int, tx *wire.MsgTx, tssAddress string) ([]btcjson.ListUnspentResult, int) {
+	spent := make(map[int]bool, len(selected))
+	for _, i := range selected {
+		spent[i] = true
+	}
+	left := make([]btcjson.ListUnspentResult, 0, len(utxos)-len(selected)+2)
+	for i, utxo := range utxos {
+		if !spent[i] {
+			left = append(left, utxo)
+		}
+	}
+
+	// the 1st output is the nonce-mark, the 3rd one (if any) is the remaining btc to TSS self
+	txid := tx.TxHash().String()
+	for i, txOut := range tx.TxOut {
+		amount := float64(txOut.Value) / 1e8
+		if i == 1 || (i == 2 && amount < BtcDepositorFeeMin) {
+			continue
+		}
+		utxo := btcjson.ListUnspentResult{
+			TxID:         txid,
+			Address:      tssAddress,
+			ScriptPubKey: hex.EncodeToString(txOut.PkScript),
+			Amount:       amount,
+		}
+		// #nosec G701 always in range
+		utxo.Vout = uint32(i)
+		left = append(left, utxo)
+	}
+	sortUTXOs(left)
+
+	for i, utxo := range left {
+		if utxo.TxID == txid && utxo.Vout == 0 {
+			return left, i
+		}
+	}
+	return left, -1 // never happen
+}
+
+// withdrawTxCost returns the btc to spend for a withdraw tx, the amount, the max fee and the nonce-mark
+func withdrawTxCost(amount float64, gasPrice *big.Int, nonce uint64) float64 {
+	estimateFee := float64(gasPrice.Uint64()*outTxBytesMax) / 1e8
+	nonceMark := common.NonceMarkAmount(nonce)
+	return amount + estimateFee + float64(nonceMark)*1e-8
+}
+
+// buildWithdrawTx builds the unsigned withdraw tx spending the selected utxos
+func (signer *BTCSigner) buildWithdrawTx(
+	to *btcutil.AddressWitnessPubKeyHash,
+	amount float64,
+	gasPrice *big.Int,
+	sizeLimit uint64,
+	nonce uint64,
+	prevOuts []btcjson.ListUnspentResult,
+	total float64,
+	consolidatedUtxo uint16,
+	consolidatedValue float64,
+) (*wire.MsgTx, error) {
+	nonceMark := common.NonceMarkAmount(nonce)
+
 	// build tx with selected unspents
 	tx := wire.NewMsgTx(wire.TxVersion)
 	for _, prevOut := range prevOuts {
@@ -170,8 +368,11 @@ func (signer *BTCSigner) SignWithdrawTx(
 		txOut3 := wire.NewTxOut(remainingSats, payToSelf)
 		tx.AddTxOut(txOut3)
 	}
+	return tx, nil
+}
 
-	// sign the tx
+// withdrawTxWitnessHashes returns the witness hashes to sign for the inputs of a withdraw tx
+func withdrawTxWitnessHashes(tx *wire.MsgTx, prevOuts []btcjson.ListUnspentResult) ([][]byte, error) {
 	sigHashes := txscript.NewTxSigHashes(tx)
 	witnessHashes := make([][]byte, len(tx.TxIn))
 	for ix := range tx.TxIn {
@@ -188,15 +389,11 @@ func (signer *BTCSigner) SignWithdrawTx(
 			return nil, err
 		}
 	}
-	tss, ok := signer.tssSigner.(*TSS)
-	if !ok {
-		return nil, fmt.Errorf("tssSigner is not a TSS")
-	}
-	sig65Bs, err := tss.SignBatch(witnessHashes, height, nonce, chain)
-	if err != nil {
-		return nil, fmt.Errorf("SignBatch error: %v", err)
-	}
+	return witnessHashes, nil
+}
 
+// addWitnesses adds the witnesses made of the signatures of the inputs to the tx
+func (signer *BTCSigner) addWitnesses(tx *wire.MsgTx, sig65Bs [][65]byte) {
 	for ix := range tx.TxIn {
 		sig65B := sig65Bs[ix]
 		R := big.NewInt(0).SetBytes(sig65B[:32])
@@ -211,7 +408,29 @@ func (signer *BTCSigner) SignWithdrawTx(
 		txWitness := wire.TxWitness{append(sig.Serialize(), byte(hashType)), pkCompressed}
 		tx.TxIn[ix].Witness = txWitness
 	}
-	return tx, nil
+}
+
+// signWitnessHashes signs the witness hashes of the inputs of a tx in a single keysign
+// The hashes are signed one by one if the batched keysign fails
+func (signer *BTCSigner) signWitnessHashes(witnessHashes [][]byte, height uint64, nonce uint64, chain *common.Chain) ([][65]byte, error) {
+	sig65Bs, err := signer.tssSigner.SignBatch(witnessHashes, height, nonce, chain)
+	if err == nil && len(sig65Bs) == len(witnessHashes) {
+		return sig65Bs, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("got %d signatures for %d digests", len(sig65Bs), len(witnessHashes))
+	}
+
+	// fallback to single signing
+	signer.logger.Warn().Err(err).Msgf("SignBatch error: nonce %d chain %d; signing the %d inputs one by one", nonce, chain.ChainId, len(witnessHashes))
+	sig65Bs = make([][65]byte, len(witnessHashes))
+	for ix, witnessHash := range witnessHashes {
+		sig65Bs[ix], err = signer.tssSigner.Sign(witnessHash, height, nonce, chain, "")
+		if err != nil {
+			return nil, fmt.Errorf("Sign error: input %d: %v", ix, err)
+		}
+	}
+	return sig65Bs, nil
 }
 
 func (signer *BTCSigner) Broadcast(signedTx *wire.MsgTx) error {
@@ -241,6 +460,9 @@ func (signer *BTCSigner) TryProcessOutTx(
 	zetaBridge ZetaCoreBridger,
 	height uint64,
 ) {
+	// #nosec G701 always in range
+	ctx, span := startOutboundSpan(context.Background(), "TryProcessOutTx", cctx, attribute.Int64("zeta.height", int64(height)))
+	defer span.End()
 	defer func() {
 		outTxMan.EndTryProcess(outTxID)
 		if err := recover(); err != nil {
@@ -248,64 +470,193 @@ func (signer *BTCSigner) TryProcessOutTx(
 		}
 	}()
 
-	logger := signer.logger.With().
-		Str("OutTxID", outTxID).
-		Str("SendHash", cctx.Index).
-		Logger()
+	logger := signer.outTxLogger(cctx, outTxID)
+	btcClient, ok := chainclient.(*BitcoinChainClient)
+	if !ok {
+		logger.Error().Msgf("chain client is not a bitcoin client")
+		return
+	}
+	satPerByte, ok := signer.relayFeeRate(zetaBridge, logger)
+	if !ok {
+		return
+	}
+	outTx := signer.newOutTx(ctx, cctx, outTxID, btcClient, satPerByte, logger)
+	if outTx == nil {
+		return
+	}
+	logger.Info().Msgf("using utxos: %v", btcClient.utxos)
 
-	params := cctx.GetCurrentOutTxParam()
-	if params.CoinType == common.CoinType_Zeta || params.CoinType == common.CoinType_ERC20 {
-		logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
+	_, keysignSpan := tracing.StartCctxSpan(ctx, tracer, "Keysign", cctx.Index)
+	tx, err := signer.SignWithdrawTx(
+		outTx.to,
+		outTx.amount,
+		outTx.gasPrice,
+		outTx.sizeLimit,
+		btcClient,
+		height,
+		outTx.nonce,
+		&btcClient.chain,
+	)
+	tracing.EndSpan(keysignSpan, err)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outTx.nonce, btcClient.chain.ChainId)
 		return
 	}
+	signer.broadcastOutTx(outTx, tx, btcClient, zetaBridge)
+}
+
+// TryProcessOutTxBatch signs the outbound txs of the cctxs of consecutive nonces in a single keysign and broadcasts them in nonce order
+// Only the tx of the 1st nonce is signed if the batched keysign fails
+func (signer *BTCSigner) TryProcessOutTxBatch(
+	cctxs []*types.CrossChainTx,
+	outTxMan *OutTxProcessorManager,
+	outTxIDs []string,
+	chainclient ChainClient,
+	zetaBridge ZetaCoreBridger,
+	height uint64,
+) {
+	spans := make([]trace.Span, len(cctxs))
+	defer func() {
+		for i, outTxID := range outTxIDs {
+			if spans[i] != nil {
+				spans[i].End()
+			}
+			outTxMan.EndTryProcess(outTxID)
+		}
+		if err := recover(); err != nil {
+			signer.logger.Error().Msgf("BTC TryProcessOutTxBatch: %s, caught panic error: %v", outTxIDs[0], err)
+		}
+	}()
 
-	logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
 	btcClient, ok := chainclient.(*BitcoinChainClient)
 	if !ok {
-		logger.Error().Msgf("chain client is not a bitcoin client")
+		signer.logger.Error().Msgf("chain client is not a bitcoin client")
+		return
+	}
+	satPerByte, ok := signer.relayFeeRate(zetaBridge, signer.logger)
+	if !ok {
 		return
 	}
+
+	// the batch stops at the first cctx without a tx to sign, the txs of the later nonces would spend its nonce-mark
+	outTxs := make([]*btcOutTx, 0, len(cctxs))
+	for i, cctx := range cctxs {
+		var ctx context.Context
+		// #nosec G701 always in range
+		ctx, spans[i] = startOutboundSpan(context.Background(), "TryProcessOutTx", cctx,
+			attribute.Int64("zeta.height", int64(height)),
+			attribute.Int("keysign.batch_size", len(cctxs)),
+		)
+		if len(outTxs) < i {
+			continue
+		}
+		outTx := signer.newOutTx(ctx, cctx, outTxIDs[i], btcClient, satPerByte, signer.outTxLogger(cctx, outTxIDs[i]))
+		// #nosec G701 always in range
+		if outTx != nil && (i == 0 || outTx.nonce == outTxs[0].nonce+uint64(i)) {
+			outTxs = append(outTxs, outTx)
+		}
+	}
+	if len(outTxs) == 0 {
+		return
+	}
+
+	keysignSpans := make([]trace.Span, len(outTxs))
+	for i, outTx := range outTxs {
+		_, keysignSpans[i] = tracing.StartCctxSpan(outTx.ctx, tracer, "Keysign", outTx.cctx.Index, attribute.Int("keysign.batch_size", len(outTxs)))
+	}
+	signer.logger.Info().Msgf("TryProcessOutTxBatch: signing %d txs, nonces %d to %d", len(outTxs), outTxs[0].nonce, outTxs[len(outTxs)-1].nonce)
+	txs, err := signer.SignWithdrawTxBatch(outTxs, btcClient, height, &btcClient.chain)
+	for i, outTx := range outTxs {
+		if i < len(txs) {
+			tracing.EndSpan(keysignSpans[i], nil)
+			continue
+		}
+		if err == nil {
+			err = fmt.Errorf("nonce %d is left out of the batch", outTx.nonce)
+		}
+		tracing.EndSpan(keysignSpans[i], err)
+		outTx.logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outTx.nonce, btcClient.chain.ChainId)
+	}
+
+	// broadcast in nonce order, a tx is only accepted by the chain after the tx whose nonce-mark it spends
+	for i, tx := range txs {
+		signer.broadcastOutTx(outTxs[i], tx, btcClient, zetaBridge)
+	}
+}
+
+// outTxLogger returns the logger of the processing of a cctx
+func (signer *BTCSigner) outTxLogger(cctx *types.CrossChainTx, outTxID string) zerolog.Logger {
+	return signer.logger.With().
+		Str("OutTxID", outTxID).
+		Str("SendHash", cctx.Index).
+		Logger()
+}
+
+// relayFeeRate returns the min relay fee rate in satoshi/byte if the outbound is enabled
+func (signer *BTCSigner) relayFeeRate(zetaBridge ZetaCoreBridger, logger zerolog.Logger) (*big.Int, bool) {
 	flags, err := zetaBridge.GetCrosschainFlags()
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot get crosschain flags")
-		return
+		return nil, false
 	}
 	if !flags.IsOutboundEnabled {
 		logger.Info().Msgf("outbound is disabled")
-		return
+		return nil, false
 	}
-	myid := zetaBridge.GetKeys().GetAddress()
+	networkInfo, err := signer.rpcClient.GetNetworkInfo()
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get bitcoin network info")
+		return nil, false
+	}
+	return FeeRateToSatPerByte(networkInfo.RelayFee), true
+}
+
+// newOutTx checks the cctx and returns its outbound tx to sign, returns nil if there is no tx to sign
+func (signer *BTCSigner) newOutTx(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	outTxID string,
+	btcClient *BitcoinChainClient,
+	satPerByte *big.Int,
+	logger zerolog.Logger,
+) *btcOutTx {
+	params := cctx.GetCurrentOutTxParam()
+	if params.CoinType == common.CoinType_Zeta || params.CoinType == common.CoinType_ERC20 {
+		logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
+		return nil
+	}
+	logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
+
 	// Early return if the send is already processed
 	// FIXME: handle revert case
 	outboundTxTssNonce := params.OutboundTxTssNonce
 	included, confirmed, err := btcClient.IsSendOutTxProcessed(cctx.Index, outboundTxTssNonce, common.CoinType_Gas, logger)
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot check if send %s is processed", cctx.Index)
-		return
+		return nil
 	}
 	if included || confirmed {
 		logger.Info().Msgf("CCTX %s already processed; exit signer", outTxID)
-		return
+		return nil
 	}
 
-	sizelimit := params.OutboundTxGasLimit
 	gasprice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
 	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
 		logger.Error().Msgf("cannot convert gas price  %s ", params.OutboundTxGasPrice)
-		return
+		return nil
 	}
 
 	// Check receiver P2WPKH address
 	bitcoinNetParams, err := common.BitcoinNetParamsFromChainID(params.ReceiverChainId)
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot get bitcoin net params%v", err)
-		return
+		return nil
 	}
 
 	addr, err := btcutil.DecodeAddress(params.Receiver, bitcoinNetParams)
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
-		return
+		return nil
 	}
 	if !addr.IsForNet(bitcoinNetParams) {
 		logger.Error().Msgf(
@@ -313,72 +664,70 @@ func (signer *BTCSigner) TryProcessOutTx(
 			params.Receiver,
 			bitcoinNetParams.Name,
 		)
-		return
+		return nil
 	}
 	to, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
-	if err != nil || !ok {
-		logger.Error().Err(err).Msgf("cannot convert address %s to P2WPKH address", params.Receiver)
-		return
+	if !ok {
+		logger.Error().Msgf("cannot convert address %s to P2WPKH address", params.Receiver)
+		return nil
 	}
 
 	// Add 1 satoshi/byte to gasPrice to avoid minRelayTxFee issue
-	networkInfo, err := signer.rpcClient.GetNetworkInfo()
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot get bitcoin network info")
-		return
-	}
-	satPerByte := FeeRateToSatPerByte(networkInfo.RelayFee)
 	gasprice.Add(gasprice, satPerByte)
 
 	logger.Info().Msgf("SignWithdrawTx: to %s, value %d sats", addr.EncodeAddress(), params.Amount.Uint64())
-	logger.Info().Msgf("using utxos: %v", btcClient.utxos)
-
-	tx, err := signer.SignWithdrawTx(
-		to,
-		float64(params.Amount.Uint64())/1e8,
-		gasprice,
-		sizelimit,
-		btcClient,
-		height,
-		outboundTxTssNonce,
-		&btcClient.chain,
-	)
-	if err != nil {
-		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outboundTxTssNonce, params.ReceiverChainId)
-		return
+	return &btcOutTx{
+		cctx:      cctx,
+		outTxID:   outTxID,
+		to:        to,
+		amount:    float64(params.Amount.Uint64()) / 1e8,
+		gasPrice:  gasprice,
+		sizeLimit: params.OutboundTxGasLimit,
+		nonce:     outboundTxTssNonce,
+		ctx:       ctx,
+		logger:    logger,
 	}
-	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", cctx.InboundTxParams.SenderChainId, btcClient.chain.ChainName, outboundTxTssNonce)
+}
+
+// broadcastOutTx broadcasts the signed outbound tx, adds it to the outtx tracker and saves it once broadcasted
+func (signer *BTCSigner) broadcastOutTx(outTx *btcOutTx, tx *wire.MsgTx, btcClient *BitcoinChainClient, zetaBridge ZetaCoreBridger) {
+	logger := outTx.logger
+	outboundTxTssNonce := outTx.nonce
+	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", outTx.cctx.InboundTxParams.SenderChainId, btcClient.chain.ChainName, outboundTxTssNonce)
 
 	// FIXME: add prometheus metrics
-	_, err = zetaBridge.GetObserverList(btcClient.chain)
+	_, err := zetaBridge.GetObserverList(btcClient.chain)
 	if err != nil {
 		logger.Warn().Err(err).Msgf("unable to get observer list: chain %d observation %s", outboundTxTssNonce, observertypes.ObservationType_OutBoundTx.String())
 	}
-	if tx != nil {
-		outTxHash := tx.TxHash().String()
-		logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", btcClient.chain.ChainName, outboundTxTssNonce, outTxHash, myid)
-		// TODO: pick a few broadcasters.
-		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
-		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
-		for i := 0; i < 5; i++ {
-			// #nosec G404 randomness is not a security issue here
-			time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) //random delay to avoid sychronized broadcast
-			err := signer.Broadcast(tx)
-			if err != nil {
-				logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, btcClient.chain.ChainName, outboundTxTssNonce, i)
-				continue
-			}
-			logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.String(), outTxHash)
-			zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, outboundTxTssNonce, outTxHash, nil, "", -1)
-			if err != nil {
-				logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.ChainName, outTxHash)
-			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+	myid := zetaBridge.GetKeys().GetAddress()
+	outTxHash := tx.TxHash().String()
+	logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", btcClient.chain.ChainName, outboundTxTssNonce, outTxHash, myid)
+	// TODO: pick a few broadcasters.
+	//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
+	_, broadcastSpan := tracing.StartCctxSpan(outTx.ctx, tracer, "BroadcastOutbound", outTx.cctx.Index, tracing.AttrOutboundHash.String(outTxHash))
+	defer broadcastSpan.End()
+	// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
+	for i := 0; i < 5; i++ {
+		// #nosec G404 randomness is not a security issue here
+		time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) //random delay to avoid sychronized broadcast
+		err := signer.Broadcast(tx)
+		if err != nil {
+			broadcastSpan.RecordError(err)
+			logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, btcClient.chain.ChainName, outboundTxTssNonce, i)
+			continue
+		}
+		logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.String(), outTxHash)
+		broadcastSpan.SetAttributes(attribute.Bool("broadcast.success", true))
+		zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, outboundTxTssNonce, outTxHash, nil, "", -1)
+		if err != nil {
+			logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.ChainName, outTxHash)
+		}
+		logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
 
-			// Save successfully broadcasted transaction to btc chain client
-			btcClient.SaveBroadcastedTx(outTxHash, outboundTxTssNonce)
+		// Save successfully broadcasted transaction to btc chain client
+		btcClient.SaveBroadcastedTx(outTxHash, outboundTxTssNonce)
 
-			break // successful broadcast; no need to retry
-		}
+		break // successful broadcast; no need to retry
 	}
 }
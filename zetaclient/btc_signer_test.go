@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand"
 	"sort"
 	"sync"
@@ -21,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	. "gopkg.in/check.v1"
 )
@@ -375,6 +377,7 @@ func TestP2WPHSizeBreakdown(t *testing.T) {
 
 	txSizeDepositor := SegWitTxSizeDepositor()
 	require.Equal(t, uint64(149), txSizeDepositor)
+	require.EqualValues(t, common.BtcOutTxBytesDepositor, txSizeDepositor)
 
 	txSizeWithdrawer := SegWitTxSizeWithdrawer()
 	require.Equal(t, uint64(254), txSizeWithdrawer)
@@ -382,6 +385,7 @@ func TestP2WPHSizeBreakdown(t *testing.T) {
 
 	depositFee := DepositorFee(5)
 	require.Equal(t, depositFee, 0.00000745)
+	require.Equal(t, BtcDepositorFeeMin, float64(common.BtcDepositorFeeSat)/1e8)
 }
 
 // helper function to create a new BitcoinChainClient
@@ -625,3 +629,155 @@ func TestUTXOConsolidation(t *testing.T) {
 		require.Equal(t, 22.31, clsdtValue)
 	})
 }
+
+func TestSignWitnessHashes(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA("7b8507ba117e069f4a3f456f505276084f8c92aee86ac78ae37b4d1801d35fa8")
+	require.NoError(t, err)
+	chain := common.BtcRegtestChain()
+	witnessHashes := [][]byte{crypto.Keccak256([]byte{1}), crypto.Keccak256([]byte{2})}
+
+	for _, blamed := range []bool{false, true} {
+		tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}, blamed: blamed}
+		signer := NewBTCSignerWithRPCClient(tss, nil, zerolog.Nop(), nil)
+		sigs, err := signer.signWitnessHashes(witnessHashes, 10, 1, &chain)
+		require.NoError(t, err)
+		require.Len(t, sigs, 2)
+		require.Equal(t, 1, tss.batchSigns)
+		if blamed {
+			require.Equal(t, 2, tss.signs)
+		} else {
+			require.Zero(t, tss.signs)
+		}
+		for i, sig := range sigs {
+			pubKey, err := crypto.SigToPub(witnessHashes[i], sig[:])
+			require.NoError(t, err)
+			require.Equal(t, privateKey.PublicKey, *pubKey)
+		}
+	}
+}
+
+// batchTestBTCBridge is a zetacore bridge without the cctxs of the bitcoin outbound txs
+type batchTestBTCBridge struct {
+	ZetaCoreBridger
+}
+
+func (b *batchTestBTCBridge) GetCctxByNonce(_ int64, nonce uint64) (*types.CrossChainTx, error) {
+	return nil, fmt.Errorf("cctx of nonce %d not found", nonce)
+}
+
+func TestSignWithdrawTxBatch(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA("7b8507ba117e069f4a3f456f505276084f8c92aee86ac78ae37b4d1801d35fa8")
+	require.NoError(t, err)
+	chain := common.BtcRegtestChain()
+	dummyTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+	payee, _ := generateKeyPair(t, &chaincfg.RegressionNetParams)
+	to, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(payee.PubKey().SerializeCompressed()), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	// newClient returns a test client whose utxos of distinct txids are spendable by the TSS, nonce 0 is mined
+	newClient := func(t *testing.T) *BitcoinChainClient {
+		ob := createTestClient(t)
+		ob.chain = chain
+		ob.zetaClient = &batchTestBTCBridge{}
+		mineTxNSetNonceMark(ob, 0, dummyTxID, -1)
+		payToSelf, err := payToWitnessPubKeyHashScript(ob.Tss.BTCAddressWitnessPubkeyHash().WitnessProgram())
+		require.NoError(t, err)
+		for i := range ob.utxos {
+			if ob.utxos[i].TxID == "" {
+				ob.utxos[i].TxID = hex.EncodeToString(crypto.Keccak256([]byte{byte(i)}))
+			}
+			ob.utxos[i].ScriptPubKey = hex.EncodeToString(payToSelf)
+		}
+		return ob
+	}
+	newOutTxs := func(nonces ...uint64) []*btcOutTx {
+		outTxs := make([]*btcOutTx, len(nonces))
+		for i, nonce := range nonces {
+			outTxs[i] = &btcOutTx{to: to, amount: 0.1, gasPrice: big.NewInt(10), sizeLimit: 1000, nonce: nonce}
+		}
+		return outTxs
+	}
+	// verify checks the witnesses of the inputs of the chained txs
+	verify := func(t *testing.T, ob *BitcoinChainClient, txs []*wire.MsgTx) {
+		prevOuts := make(map[wire.OutPoint]*wire.TxOut)
+		for _, utxo := range ob.utxos {
+			hash, err := chainhash.NewHashFromStr(utxo.TxID)
+			require.NoError(t, err)
+			amount, err := GetSatoshis(utxo.Amount)
+			require.NoError(t, err)
+			pkScript, err := hex.DecodeString(utxo.ScriptPubKey)
+			require.NoError(t, err)
+			prevOuts[*wire.NewOutPoint(hash, utxo.Vout)] = wire.NewTxOut(amount, pkScript)
+		}
+		for _, tx := range txs {
+			sigHashes := txscript.NewTxSigHashes(tx)
+			for ix, txIn := range tx.TxIn {
+				prevOut, found := prevOuts[txIn.PreviousOutPoint]
+				require.True(t, found)
+				delete(prevOuts, txIn.PreviousOutPoint)
+				vm, err := txscript.NewEngine(prevOut.PkScript, tx, ix, txscript.StandardVerifyFlags, nil, sigHashes, prevOut.Value)
+				require.NoError(t, err)
+				require.NoError(t, vm.Execute())
+			}
+			txHash := tx.TxHash()
+			for vout, txOut := range tx.TxOut {
+				prevOuts[*wire.NewOutPoint(&txHash, uint32(vout))] = txOut
+			}
+		}
+	}
+
+	t.Run("should chain the txs to the nonce-marks of the previous nonces", func(t *testing.T) {
+		ob := newClient(t)
+		tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}}
+		signer := NewBTCSignerWithRPCClient(tss, nil, zerolog.Nop(), nil)
+
+		txs, err := signer.SignWithdrawTxBatch(newOutTxs(1, 2, 3), ob, 10, &chain)
+		require.NoError(t, err)
+		require.Len(t, txs, 3)
+		require.Equal(t, 1, tss.batchSigns)
+		require.Zero(t, tss.signs)
+		require.Equal(t, dummyTxID, txs[0].TxIn[0].PreviousOutPoint.Hash.String())
+		for i := 1; i < len(txs); i++ {
+			preTxHash := txs[i-1].TxHash()
+			require.Equal(t, *wire.NewOutPoint(&preTxHash, 0), txs[i].TxIn[0].PreviousOutPoint)
+			require.Equal(t, common.NonceMarkAmount(uint64(i+1)), txs[i].TxOut[0].Value)
+		}
+		verify(t, ob, txs)
+	})
+
+	t.Run("should sign the 1st tx alone if the batched keysign fails", func(t *testing.T) {
+		ob := newClient(t)
+		tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}, blamed: true}
+		signer := NewBTCSignerWithRPCClient(tss, nil, zerolog.Nop(), nil)
+
+		txs, err := signer.SignWithdrawTxBatch(newOutTxs(1, 2, 3), ob, 10, &chain)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, 2, tss.batchSigns)
+		require.Equal(t, len(txs[0].TxIn), tss.signs)
+		verify(t, ob, txs)
+	})
+
+	t.Run("should stop the batch at the first tx short of funds", func(t *testing.T) {
+		ob := newClient(t)
+		tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}}
+		signer := NewBTCSignerWithRPCClient(tss, nil, zerolog.Nop(), nil)
+
+		outTxs := newOutTxs(1, 2, 3)
+		outTxs[1].amount = 22.4
+		txs, err := signer.SignWithdrawTxBatch(outTxs, ob, 10, &chain)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		verify(t, ob, txs)
+	})
+
+	t.Run("should fail without the nonce-mark of the 1st nonce", func(t *testing.T) {
+		ob := newClient(t)
+		tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}}
+		signer := NewBTCSignerWithRPCClient(tss, nil, zerolog.Nop(), nil)
+
+		_, err := signer.SignWithdrawTxBatch(newOutTxs(2, 3), ob, 10, &chain)
+		require.Error(t, err)
+		require.Zero(t, tss.batchSigns)
+	})
+}
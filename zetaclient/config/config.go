@@ -10,6 +10,7 @@ import (
 
 const filename string = "zetaclient_config.json"
 const folder string = "config"
+const adminTokenFilename string = "zetaclient_admin_token"
 
 // Save saves ZetaClient config
 func Save(config *Config, path string) error {
@@ -70,6 +71,11 @@ func Load(path string) (*Config, error) {
 	return cfg, nil
 }
 
+// AdminTokenPath returns the path of the file of the token authenticating the requests to the admin API
+func AdminTokenPath(path string) string {
+	return filepath.Join(path, folder, adminTokenFilename)
+}
+
 func GetPath(inputPath string) string {
 	path := strings.Split(inputPath, "/")
 	if len(path) > 0 {
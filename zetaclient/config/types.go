@@ -47,6 +47,13 @@ type BTCConfig struct {
 	RPCParams   string // "regtest", "mainnet", "testnet3"
 }
 
+// InTxRescan is a block range of a chain rescanned for missed inbound txs
+type InTxRescan struct {
+	ChainID    int64  `json:"ChainID"`
+	StartBlock uint64 `json:"StartBlock"`
+	EndBlock   uint64 `json:"EndBlock"`
+}
+
 // Config is the config for ZetaClient
 // TODO: use snake case for json fields
 // https://github.com/zeta-chain/node/issues/1020
@@ -72,12 +79,52 @@ type Config struct {
 	HsmMode             bool           `json:"HsmMode"`
 	HsmHotKey           string         `json:"HsmHotKey"`
 
+	// ZRC20SupplyCheck enables the ZRC20 supply checker
+	// ZRC20SupplyCheckPause pauses the outbound of a chain locally when its ZRC20 supply is not backed by the chain holdings
+	ZRC20SupplyCheck      bool `json:"ZRC20SupplyCheck"`
+	ZRC20SupplyCheckPause bool `json:"ZRC20SupplyCheckPause"`
+
+	// AdminAPIAddr is the loopback address the admin API of the client listens on, the admin API is disabled if empty
+	// The requests are authenticated with the token of the admin token file
+	AdminAPIAddr string `json:"AdminAPIAddr"`
+
+	// RemoteSignerAddr is the address of the remote signer of the txs of the hot key, either host:port or unix:///path
+	// The txs are signed with the keyring if empty
+	RemoteSignerAddr string `json:"RemoteSignerAddr"`
+
+	// P2PKeyPath is the file of the hex encoded secp256k1 private key of the TSS p2p identity, required with the remote
+	// signer as the private key of the hot key is then not on the host
+	P2PKeyPath string `json:"P2PKeyPath"`
+
+	// VoteBatchWindow is the window in milliseconds within which the inbound, outbound, gas price and block header votes
+	// are collected and broadcast in a single tx, the votes are broadcast one by one if zero
+	VoteBatchWindow uint64 `json:"VoteBatchWindow"`
+
+	// TracingEndpoint is the OTLP gRPC endpoint (host:port) the spans of the cctxs are exported to, disabled if empty
+	TracingEndpoint string `json:"TracingEndpoint"`
+
+	// TracingInsecure disables the TLS of the connection to the OTLP endpoint
+	TracingInsecure bool `json:"TracingInsecure"`
+
+	// StoreBackend is the backend of the stores of the chain clients, either "sqlite" or "leveldb", sqlite if empty
+	StoreBackend string `json:"StoreBackend"`
+
+	// StoreRetention is the retention in hours of the data of the outbound txs finalized on zetacore in the stores of
+	// the chain clients, the data is never pruned if zero
+	StoreRetention uint64 `json:"StoreRetention"`
+
+	// InTxRescans are the block ranges rescanned when the client starts, the missed inbound txs are voted
+	InTxRescans []InTxRescan `json:"InTxRescans"`
+
 	// chain specific fields are updatable at runtime and shared across threads
 	cfgLock         *sync.RWMutex        `json:"-"`
 	Keygen          observertypes.Keygen `json:"Keygen"`
 	ChainsEnabled   []common.Chain       `json:"ChainsEnabled"`
 	EVMChainConfigs map[int64]*EVMConfig `json:"EVMChainConfigs"`
 	BitcoinConfig   *BTCConfig           `json:"BitcoinConfig"`
+
+	// pausedChains are the chains for which this client stops scheduling outbound transactions, with the reason
+	pausedChains map[int64]string `json:"-"`
 }
 
 func NewConfig() *Config {
@@ -151,6 +198,31 @@ func (c *Config) GetBTCConfig() (common.Chain, BTCConfig, bool) {
 	return *chain, *c.BitcoinConfig, true
 }
 
+// PauseChain stops the scheduling of outbound transactions for the chain by this client
+func (c *Config) PauseChain(chainID int64, reason string) {
+	c.cfgLock.Lock()
+	defer c.cfgLock.Unlock()
+	if c.pausedChains == nil {
+		c.pausedChains = make(map[int64]string)
+	}
+	c.pausedChains[chainID] = reason
+}
+
+// UnpauseChain resumes the scheduling of outbound transactions for the chain by this client
+func (c *Config) UnpauseChain(chainID int64) {
+	c.cfgLock.Lock()
+	defer c.cfgLock.Unlock()
+	delete(c.pausedChains, chainID)
+}
+
+// IsChainPaused returns true and the reason if the chain is paused by this client
+func (c *Config) IsChainPaused(chainID int64) (string, bool) {
+	c.cfgLock.RLock()
+	defer c.cfgLock.RUnlock()
+	reason, paused := c.pausedChains[chainID]
+	return reason, paused
+}
+
 func (c *Config) GetKeyringBackend() KeyringBackend {
 	c.cfgLock.RLock()
 	defer c.cfgLock.RUnlock()
@@ -226,6 +298,9 @@ func (c *Config) Clone() *Config {
 		TestTssKeysign:      c.TestTssKeysign,
 		KeyringBackend:      c.KeyringBackend,
 
+		ZRC20SupplyCheck:      c.ZRC20SupplyCheck,
+		ZRC20SupplyCheckPause: c.ZRC20SupplyCheckPause,
+
 		cfgLock:         &sync.RWMutex{},
 		Keygen:          c.GetKeygen(),
 		ChainsEnabled:   c.GetEnabledChains(),
@@ -241,6 +316,12 @@ func (c *Config) Clone() *Config {
 		copied.BitcoinConfig = &BTCConfig{}
 		*copied.BitcoinConfig = *c.BitcoinConfig
 	}
+	if c.pausedChains != nil {
+		copied.pausedChains = make(map[int64]string, len(c.pausedChains))
+		for chainID, reason := range c.pausedChains {
+			copied.pausedChains[chainID] = reason
+		}
+	}
 
 	return copied
 }
@@ -0,0 +1,223 @@
+package zetaclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// zetaDecimals is the number of decimals of the ZETA token
+const zetaDecimals = 18
+
+// AssetDecimals caches the decimals of the ZRC20 of the assets of a chain, used to normalize the amount thresholds of
+// the confirmation tiers
+type AssetDecimals struct {
+	mu       sync.Mutex
+	bridge   ZetaCoreBridger
+	chainID  int64
+	decimals map[string]uint32
+}
+
+func NewAssetDecimals(bridge ZetaCoreBridger, chainID int64) *AssetDecimals {
+	return &AssetDecimals{
+		bridge:   bridge,
+		chainID:  chainID,
+		decimals: make(map[string]uint32),
+	}
+}
+
+// Get returns the decimals of the ZRC20 of the asset, false if the foreign coin of the asset is not found
+func (d *AssetDecimals) Get(coinType common.CoinType, asset string) (uint32, bool) {
+	if coinType == common.CoinType_Zeta {
+		return zetaDecimals, true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if decimals, found := d.decimals[assetKey(coinType, asset)]; found {
+		return decimals, true
+	}
+
+	// the decimals of the foreign coins never change, they are queried again only for an unknown asset
+	foreignCoins, err := d.bridge.GetForeignCoins()
+	if err != nil {
+		return 0, false
+	}
+	for _, fCoin := range foreignCoins {
+		if fCoin.ForeignChainId == d.chainID {
+			d.decimals[assetKey(fCoin.CoinType, fCoin.Asset)] = fCoin.Decimals
+		}
+	}
+	decimals, found := d.decimals[assetKey(coinType, asset)]
+	return decimals, found
+}
+
+func assetKey(coinType common.CoinType, asset string) string {
+	return fmt.Sprintf("%s/%s", coinType, strings.ToLower(asset))
+}
+
+// requiredConfirmations returns the confirmation count required for the amount of the asset from the confirmation tiers
+// of the core params, the highest confirmation count of the asset is returned if the decimals of its ZRC20 are unknown
+func requiredConfirmations(
+	params observertypes.CoreParams,
+	decimals *AssetDecimals,
+	coinType common.CoinType,
+	asset string,
+	amount sdkmath.Uint,
+) uint64 {
+	if len(params.ConfirmationTiers) == 0 {
+		return params.ConfirmationCount
+	}
+	if coinType != common.CoinType_ERC20 {
+		asset = ""
+	}
+	assetDecimals, found := decimals.Get(coinType, asset)
+	if !found {
+		return params.MaxConfirmationCountForAsset(coinType, asset)
+	}
+	return params.ConfirmationCountForAmount(coinType, asset, amount, assetDecimals)
+}
+
+// PendingInbound is an inbound tx observed in a block without enough confirmations for its amount
+type PendingInbound struct {
+	Msg       *types.MsgVoteOnObservedInboundTx
+	GasLimit  uint64
+	BlockHash string
+
+	// ConfirmedHeight is the height of the chain from which the inbound tx is confirmed
+	ConfirmedHeight uint64
+}
+
+// PendingInbounds are the inbound txs waiting for the confirmations required by their amount, indexed by ballot
+type PendingInbounds struct {
+	mu       sync.Mutex
+	inbounds map[string]PendingInbound
+}
+
+func NewPendingInbounds() *PendingInbounds {
+	return &PendingInbounds{
+		inbounds: make(map[string]PendingInbound),
+	}
+}
+
+// Add adds the inbound tx, the inbound tx of the same ballot is replaced
+func (p *PendingInbounds) Add(inbound PendingInbound) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inbounds[inbound.Msg.Digest()] = inbound
+}
+
+// PopConfirmed removes and returns the inbound txs confirmed at the height, ordered by block
+func (p *PendingInbounds) PopConfirmed(height uint64) []PendingInbound {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var confirmed []PendingInbound
+	for ballot, inbound := range p.inbounds {
+		if inbound.ConfirmedHeight <= height {
+			confirmed = append(confirmed, inbound)
+			delete(p.inbounds, ballot)
+		}
+	}
+	sort.SliceStable(confirmed, func(i, j int) bool {
+		if confirmed[i].Msg.InBlockHeight != confirmed[j].Msg.InBlockHeight {
+			return confirmed[i].Msg.InBlockHeight < confirmed[j].Msg.InBlockHeight
+		}
+		return confirmed[i].Msg.EventIndex < confirmed[j].Msg.EventIndex
+	})
+	return confirmed
+}
+
+// Len returns the number of pending inbound txs
+func (p *PendingInbounds) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.inbounds)
+}
+
+// LastScannedToSave returns the last scanned block to persist, the block before the first pending inbound tx if any
+// so that the pending inbound txs are scanned again after a restart
+func (p *PendingInbounds) LastScannedToSave(lastScanned uint64) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, inbound := range p.inbounds {
+		if inbound.Msg.InBlockHeight > 0 && inbound.Msg.InBlockHeight <= lastScanned {
+			lastScanned = inbound.Msg.InBlockHeight - 1
+		}
+	}
+	return lastScanned
+}
+
+// deferInbound adds the inbound tx to the pending inbound txs if it doesn't have the confirmations required by its
+// amount at the height of the chain, returns true if the inbound tx is deferred
+func deferInbound(
+	pending *PendingInbounds,
+	params observertypes.CoreParams,
+	decimals *AssetDecimals,
+	msg *types.MsgVoteOnObservedInboundTx,
+	gasLimit uint64,
+	blockHash string,
+	height uint64,
+	logger zerolog.Logger,
+) bool {
+	confirmations := requiredConfirmations(params, decimals, msg.CoinType, msg.Asset, msg.Amount)
+	confirmedHeight := msg.InBlockHeight + confirmations
+	if confirmedHeight <= height {
+		return false
+	}
+	pending.Add(PendingInbound{
+		Msg:             msg,
+		GasLimit:        gasLimit,
+		BlockHash:       blockHash,
+		ConfirmedHeight: confirmedHeight,
+	})
+	logger.Info().Msgf(
+		"inbound tx %s of amount %s requires %d confirmations, vote deferred to block %d",
+		msg.InTxHash,
+		msg.Amount,
+		confirmations,
+		confirmedHeight,
+	)
+	return true
+}
+
+// postConfirmedInbounds posts the votes of the pending inbound txs confirmed at the height of the chain
+// The inbound txs whose block is no longer in the chain are dropped, they are observed again in their new block
+func postConfirmedInbounds(
+	bridge ZetaCoreBridger,
+	pending *PendingInbounds,
+	height uint64,
+	blockHashAt func(uint64) (string, error),
+	logger zerolog.Logger,
+) {
+	for _, inbound := range pending.PopConfirmed(height) {
+		msg := inbound.Msg
+		blockHash, err := blockHashAt(msg.InBlockHeight)
+		if err != nil {
+			logger.Error().Err(err).Msgf("error getting block %d of inbound tx %s", msg.InBlockHeight, msg.InTxHash)
+			pending.Add(inbound)
+			continue
+		}
+		if blockHash != inbound.BlockHash {
+			logger.Warn().Msgf(
+				"block %d %s of inbound tx %s replaced by block %s, vote dropped",
+				msg.InBlockHeight,
+				inbound.BlockHash,
+				msg.InTxHash,
+				blockHash,
+			)
+			continue
+		}
+		zetaHash, err := bridge.PostSend(inbound.GasLimit, msg)
+		if err != nil {
+			logger.Error().Err(err).Msgf("error posting deferred vote of inbound tx %s", msg.InTxHash)
+			continue
+		}
+		logger.Info().Msgf("deferred inbound tx %s confirmed and reported: PostSend zeta tx: %s", msg.InTxHash, zetaHash)
+	}
+}
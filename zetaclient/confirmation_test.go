@@ -0,0 +1,159 @@
+package zetaclient
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const testERC20Asset = "0xD28D6A0b8189305551a0A8bd247a6ECa9CE781Ca"
+
+// confirmationTestBridge is a zetacore bridge with the foreign coins and recording the votes
+type confirmationTestBridge struct {
+	ZetaCoreBridger
+	foreignCoins []fungibletypes.ForeignCoins
+	queries      int
+	posted       []*types.MsgVoteOnObservedInboundTx
+}
+
+func (b *confirmationTestBridge) GetForeignCoins() ([]fungibletypes.ForeignCoins, error) {
+	b.queries++
+	if b.foreignCoins == nil {
+		return nil, errors.New("foreign coins not found")
+	}
+	return b.foreignCoins, nil
+}
+
+func (b *confirmationTestBridge) PostSend(_ uint64, msg *types.MsgVoteOnObservedInboundTx) (string, error) {
+	b.posted = append(b.posted, msg)
+	return "zetaHash", nil
+}
+
+func newConfirmationTestBridge() *confirmationTestBridge {
+	chainID := common.GoerliLocalnetChain().ChainId
+	return &confirmationTestBridge{
+		foreignCoins: []fungibletypes.ForeignCoins{
+			{ForeignChainId: chainID, CoinType: common.CoinType_Gas, Decimals: 18},
+			{ForeignChainId: chainID, CoinType: common.CoinType_ERC20, Asset: testERC20Asset, Decimals: 6},
+			{ForeignChainId: common.BtcRegtestChain().ChainId, CoinType: common.CoinType_Gas, Decimals: 8},
+		},
+	}
+}
+
+func confirmationTestParams() observertypes.CoreParams {
+	return observertypes.CoreParams{
+		ConfirmationCount: 2,
+		ConfirmationTiers: []observertypes.ConfirmationTier{
+			observertypes.NewConfirmationTier(common.CoinType_Gas, "", sdk.NewDec(1), 6),
+			observertypes.NewConfirmationTier(common.CoinType_ERC20, testERC20Asset, sdk.NewDec(1000), 12),
+		},
+	}
+}
+
+func confirmationTestMsg(inTxHash string, coinType common.CoinType, asset string, amount math.Uint, height uint64) *types.MsgVoteOnObservedInboundTx {
+	return &types.MsgVoteOnObservedInboundTx{
+		Creator:       "creator",
+		SenderChainId: common.GoerliLocalnetChain().ChainId,
+		InTxHash:      inTxHash,
+		InBlockHeight: height,
+		CoinType:      coinType,
+		Asset:         asset,
+		Amount:        amount,
+	}
+}
+
+func TestAssetDecimals(t *testing.T) {
+	bridge := newConfirmationTestBridge()
+	decimals := NewAssetDecimals(bridge, common.GoerliLocalnetChain().ChainId)
+
+	d, found := decimals.Get(common.CoinType_Zeta, "")
+	require.True(t, found)
+	require.EqualValues(t, 18, d)
+	require.Equal(t, 0, bridge.queries)
+
+	d, found = decimals.Get(common.CoinType_ERC20, "0xd28d6a0b8189305551a0a8bd247a6eca9ce781ca")
+	require.True(t, found)
+	require.EqualValues(t, 6, d)
+	d, found = decimals.Get(common.CoinType_Gas, "")
+	require.True(t, found)
+	require.EqualValues(t, 18, d)
+	require.Equal(t, 1, bridge.queries)
+
+	// the foreign coins of the other chains are not used
+	_, found = decimals.Get(common.CoinType_ERC20, "0xA8D5060feb6B456e886F023709A2795373691E63")
+	require.False(t, found)
+	require.Equal(t, 2, bridge.queries)
+}
+
+func TestRequiredConfirmations(t *testing.T) {
+	params := confirmationTestParams()
+	decimals := NewAssetDecimals(newConfirmationTestBridge(), common.GoerliLocalnetChain().ChainId)
+	eth := func(amount string) math.Uint { return math.NewUintFromString(amount) }
+
+	require.EqualValues(t, 2, requiredConfirmations(params, decimals, common.CoinType_Gas, "", eth("999999999999999999")))
+	require.EqualValues(t, 6, requiredConfirmations(params, decimals, common.CoinType_Gas, "", eth("1000000000000000000")))
+	require.EqualValues(t, 2, requiredConfirmations(params, decimals, common.CoinType_ERC20, testERC20Asset, eth("999999999")))
+	require.EqualValues(t, 12, requiredConfirmations(params, decimals, common.CoinType_ERC20, testERC20Asset, eth("1000000000")))
+	require.EqualValues(t, 2, requiredConfirmations(params, decimals, common.CoinType_Zeta, "", eth("1000000000000000000000")))
+
+	// the highest confirmation count of the asset is used if the decimals are unknown
+	decimals = NewAssetDecimals(&confirmationTestBridge{}, common.GoerliLocalnetChain().ChainId)
+	require.EqualValues(t, 6, requiredConfirmations(params, decimals, common.CoinType_Gas, "", eth("1")))
+
+	// the confirmation count is used without tiers
+	params.ConfirmationTiers = nil
+	require.EqualValues(t, 2, requiredConfirmations(params, decimals, common.CoinType_Gas, "", eth("1000000000000000000")))
+}
+
+func TestPendingInbounds(t *testing.T) {
+	params := confirmationTestParams()
+	bridge := newConfirmationTestBridge()
+	decimals := NewAssetDecimals(bridge, common.GoerliLocalnetChain().ChainId)
+	pending := NewPendingInbounds()
+	oneEth := math.NewUintFromString("1000000000000000000")
+
+	// a small transfer is not deferred
+	small := confirmationTestMsg("0x1", common.CoinType_Gas, "", math.NewUint(1), 100)
+	require.False(t, deferInbound(pending, params, decimals, small, PostSendEVMGasLimit, "0xaa", 102, zerolog.Nop()))
+
+	// the large transfers are deferred until their confirmation height
+	large1 := confirmationTestMsg("0x2", common.CoinType_Gas, "", oneEth, 100)
+	large2 := confirmationTestMsg("0x3", common.CoinType_Gas, "", oneEth, 101)
+	reorged := confirmationTestMsg("0x4", common.CoinType_Gas, "", oneEth, 101)
+	require.True(t, deferInbound(pending, params, decimals, large1, PostSendEVMGasLimit, "0xaa", 102, zerolog.Nop()))
+	require.True(t, deferInbound(pending, params, decimals, large2, PostSendEVMGasLimit, "0xbb", 102, zerolog.Nop()))
+	require.True(t, deferInbound(pending, params, decimals, reorged, PostSendEVMGasLimit, "0xcc", 102, zerolog.Nop()))
+	require.Equal(t, 3, pending.Len())
+	require.EqualValues(t, 99, pending.LastScannedToSave(102))
+	require.EqualValues(t, 50, pending.LastScannedToSave(50))
+
+	blockHashAt := func(height uint64) (string, error) {
+		return map[uint64]string{100: "0xaa", 101: "0xbb"}[height], nil
+	}
+	postConfirmedInbounds(bridge, pending, 105, blockHashAt, zerolog.Nop())
+	require.Empty(t, bridge.posted)
+
+	postConfirmedInbounds(bridge, pending, 106, blockHashAt, zerolog.Nop())
+	require.Equal(t, []*types.MsgVoteOnObservedInboundTx{large1}, bridge.posted)
+	require.EqualValues(t, 100, pending.LastScannedToSave(102))
+
+	// the inbound tx of a replaced block is dropped
+	postConfirmedInbounds(bridge, pending, 107, blockHashAt, zerolog.Nop())
+	require.Equal(t, []*types.MsgVoteOnObservedInboundTx{large1, large2}, bridge.posted)
+	require.Equal(t, 0, pending.Len())
+
+	// the inbound tx is kept if its block can't be queried
+	require.True(t, deferInbound(pending, params, decimals, large1, PostSendEVMGasLimit, "0xaa", 102, zerolog.Nop()))
+	postConfirmedInbounds(bridge, pending, 106, func(uint64) (string, error) {
+		return "", errors.New("rpc error")
+	}, zerolog.Nop())
+	require.Equal(t, 1, pending.Len())
+}
@@ -32,9 +32,8 @@ import (
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
 	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 )
 
 type TxHashEnvelope struct {
@@ -55,10 +54,6 @@ type EVMLog struct {
 
 }
 
-const (
-	DonationMessage = "I am rich!"
-)
-
 // EVMChainClient represents the chain configuration for an EVM chain
 // Filled with above constants depending on chain
 type EVMChainClient struct {
@@ -73,7 +68,8 @@ type EVMChainClient struct {
 	BlockTimeExternalChain    uint64 // block time in seconds
 	txWatchList               map[ethcommon.Hash]string
 	Mu                        *sync.Mutex
-	db                        *gorm.DB
+	store                     store.Store
+	storeRetention            time.Duration
 	outTXConfirmedReceipts    map[string]*ethtypes.Receipt
 	outTXConfirmedTransaction map[string]*ethtypes.Transaction
 	MinNonce                  int64
@@ -85,17 +81,23 @@ type EVMChainClient struct {
 	cfg                       *config.Config
 	params                    observertypes.CoreParams
 	ts                        *TelemetryServer
+	inboundPauseReason        *string // the inbound observation is paused by the operator if set
+	rescanFrom                uint64  // the block from which the operator requested a rescan, 0 if none
+	blockHashes               *BlockHashTracker
+	maxReorgDepth             uint64
+	assetDecimals             *AssetDecimals
+	pendingInbounds           *PendingInbounds
 
 	BlockCache *lru.Cache
 }
 
-var _ ChainClient = (*EVMChainClient)(nil)
+var _ TssNonceChainClient = (*EVMChainClient)(nil)
 
 // NewEVMChainClient returns a new configuration based on supplied target chain
 func NewEVMChainClient(
 	bridge ZetaCoreBridger,
 	tss TSSSigner,
-	dbpath string,
+	storeOpts store.Options,
 	metrics *metricsPkg.Metrics,
 	logger zerolog.Logger,
 	cfg *config.Config,
@@ -124,6 +126,9 @@ func NewEVMChainClient(
 	ob.outTXConfirmedReceipts = make(map[string]*ethtypes.Receipt)
 	ob.outTXConfirmedTransaction = make(map[string]*ethtypes.Transaction)
 	ob.OutTxChan = make(chan OutTx, 100)
+	ob.blockHashes = NewBlockHashTracker(reorgTrackedBlocks)
+	ob.assetDecimals = NewAssetDecimals(bridge, ob.chain.ChainId)
+	ob.pendingInbounds = NewPendingInbounds()
 
 	logFile, err := os.OpenFile(ob.chain.ChainName.String()+"_debug.log", os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
 	if err != nil {
@@ -168,8 +173,20 @@ func NewEVMChainClient(
 	if err != nil {
 		return nil, err
 	}
+	err = ob.RegisterPromCounter(metricsPkg.ReorgCount, "Number of reorgs of scanned blocks")
+	if err != nil {
+		return nil, err
+	}
+	err = ob.RegisterPromGauge(metricsPkg.LastReorgDepth, "Number of scanned blocks replaced by the last reorg")
+	if err != nil {
+		return nil, err
+	}
+	err = ob.RegisterPromGauge(metricsPkg.MaxReorgDepth, "Highest number of scanned blocks replaced by a reorg")
+	if err != nil {
+		return nil, err
+	}
 
-	err = ob.LoadDB(dbpath, ob.chain)
+	err = ob.LoadDB(storeOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -271,20 +288,16 @@ func (ob *EVMChainClient) Start() {
 	go ob.ExternalChainWatcher() // Observes external Chains for incoming trasnactions
 	go ob.WatchGasPrice()        // Observes external Chains for Gas prices and posts to core
 	go ob.observeOutTx()         // Populates receipts and confirmed outbound transactions
+	go runStorePruner(ob.PruneStore, ob.stop, ob.logger.ChainLogger)
 }
 
 func (ob *EVMChainClient) Stop() {
 	ob.logger.ChainLogger.Info().Msgf("ob %s is stopping", ob.chain.String())
 	close(ob.stop) // this notifies all goroutines to stop
 
-	ob.logger.ChainLogger.Info().Msg("closing ob.db")
-	dbInst, err := ob.db.DB()
-	if err != nil {
-		ob.logger.ChainLogger.Info().Msg("error getting database instance")
-	}
-	err = dbInst.Close()
-	if err != nil {
-		ob.logger.ChainLogger.Error().Err(err).Msg("error closing database")
+	ob.logger.ChainLogger.Info().Msg("closing ob.store")
+	if err := ob.store.Close(); err != nil {
+		ob.logger.ChainLogger.Error().Err(err).Msg("error closing store")
 	}
 
 	ob.logger.ChainLogger.Info().Msgf("%s observer stopped", ob.chain.String())
@@ -294,7 +307,6 @@ func (ob *EVMChainClient) Stop() {
 // If isConfirmed, it also post to ZetaCore
 func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, cointype common.CoinType, logger zerolog.Logger) (bool, bool, error) {
 	ob.Mu.Lock()
-	params := ob.params
 	receipt, found1 := ob.outTXConfirmedReceipts[ob.GetTxID(nonce)]
 	transaction, found2 := ob.outTXConfirmedTransaction[ob.GetTxID(nonce)]
 	ob.Mu.Unlock()
@@ -302,9 +314,39 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 	if !found {
 		return false, false, nil
 	}
+	confirmationCount := ob.outboundConfirmationCount(nonce)
 
 	sendID := fmt.Sprintf("%s-%d", ob.chain.String(), nonce)
 	logger = logger.With().Str("sendID", sendID).Logger()
+
+	// the outbound was replaced by a cancel tx to unblock the later nonces; the cctx is reverted or aborted
+	if ob.isCancelTx(transaction) {
+		if confHeight := receipt.BlockNumber.Uint64() + confirmationCount; confHeight > ob.GetLastBlockHeight() {
+			logger.Info().Msgf("Included cancel tx; %d blocks before confirmed! chain %s nonce %d", confHeight-ob.GetLastBlockHeight(), ob.chain.String(), nonce)
+			return true, false, nil
+		}
+		logger.Warn().Msgf("Found (cancel tx) sendHash %s on chain %s txhash %s", sendHash, ob.chain.String(), receipt.TxHash.Hex())
+		zetaTxHash, ballot, err := ob.zetaClient.PostReceiveConfirmation(
+			sendHash,
+			receipt.TxHash.Hex(),
+			receipt.BlockNumber.Uint64(),
+			receipt.GasUsed,
+			transaction.GasPrice(),
+			transaction.Gas(),
+			big.NewInt(0),
+			common.ReceiveStatus_Failed,
+			ob.chain,
+			nonce,
+			cointype,
+		)
+		if err != nil {
+			logger.Error().Err(err).Msgf("error posting confirmation to meta core for cctx %s nonce %d", sendHash, nonce)
+		} else if zetaTxHash != "" {
+			logger.Info().Msgf("Zeta tx hash: %s cctx %s nonce %d ballot %s", zetaTxHash, sendHash, nonce, ballot)
+		}
+		return true, true, nil
+	}
+
 	if cointype == common.CoinType_Cmd {
 		recvStatus := common.ReceiveStatus_Failed
 		if receipt.Status == 1 {
@@ -377,7 +419,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 		if receipt.Status == 1 {
 			logs := receipt.Logs
 			for _, vLog := range logs {
-				confHeight := vLog.BlockNumber + params.ConfirmationCount
+				confHeight := vLog.BlockNumber + confirmationCount
 				// TODO rewrite this to return early if not confirmed
 				connector, err := ob.GetConnectorContract()
 				if err != nil {
@@ -487,7 +529,7 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 			}
 			for _, vLog := range logs {
 				event, err := ERC20Custody.ParseWithdrawn(*vLog)
-				confHeight := vLog.BlockNumber + params.ConfirmationCount
+				confHeight := vLog.BlockNumber + confirmationCount
 				if err == nil {
 					logger.Info().Msgf("Found (ERC20Custody.Withdrawn Event) sendHash %s on chain %s txhash %s", sendHash, ob.chain.String(), vLog.TxHash.Hex())
 					if confHeight <= ob.GetLastBlockHeight() {
@@ -631,7 +673,10 @@ func (ob *EVMChainClient) observeOutTx() {
 // receipt non-nil, err nil: txHash confirmed
 func (ob *EVMChainClient) queryTxByHash(txHash string, nonce uint64) (*ethtypes.Receipt, *ethtypes.Transaction, error) {
 	logger := ob.logger.ObserveOutTx.With().Str("txHash", txHash).Uint64("nonce", nonce).Logger()
-	if ob.outTXConfirmedReceipts[ob.GetTxID(nonce)] != nil && ob.outTXConfirmedTransaction[ob.GetTxID(nonce)] != nil {
+	ob.Mu.Lock()
+	recorded := ob.outTXConfirmedReceipts[ob.GetTxID(nonce)] != nil && ob.outTXConfirmedTransaction[ob.GetTxID(nonce)] != nil
+	ob.Mu.Unlock()
+	if recorded {
 		return nil, nil, fmt.Errorf("queryTxByHash: txHash %s receipts already recorded", txHash)
 	}
 	ctxt, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -651,7 +696,7 @@ func (ob *EVMChainClient) queryTxByHash(txHash string, nonce uint64) (*ethtypes.
 	if transaction.Nonce() != nonce {
 		return nil, nil, fmt.Errorf("queryTxByHash: txHash %s nonce mismatch: wanted %d, got tx nonce %d", txHash, nonce, transaction.Nonce())
 	}
-	confHeight := receipt.BlockNumber.Uint64() + ob.GetCoreParams().ConfirmationCount
+	confHeight := receipt.BlockNumber.Uint64() + ob.outboundConfirmationCount(nonce)
 	if confHeight >= math.MaxInt64 {
 		return nil, nil, fmt.Errorf("queryTxByHash: confHeight is out of range")
 	}
@@ -697,6 +742,40 @@ func (ob *EVMChainClient) GetLastBlockHeight() uint64 {
 	return height
 }
 
+// PauseInbound stops the observation of inbound transactions of the chain by this client
+func (ob *EVMChainClient) PauseInbound(reason string) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.inboundPauseReason = &reason
+}
+
+// UnpauseInbound resumes the observation of inbound transactions of the chain by this client
+func (ob *EVMChainClient) UnpauseInbound() {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.inboundPauseReason = nil
+}
+
+// IsInboundPaused returns true and the reason if the observation of inbound transactions is paused
+func (ob *EVMChainClient) IsInboundPaused() (string, bool) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	if ob.inboundPauseReason == nil {
+		return "", false
+	}
+	return *ob.inboundPauseReason, true
+}
+
+// RescanFrom makes the inbound observation scan again the blocks from the height on its next tick
+func (ob *EVMChainClient) RescanFrom(height uint64) error {
+	lastScanned := ob.GetLastBlockHeightScanned()
+	if height == 0 || height > lastScanned+1 {
+		return fmt.Errorf("invalid rescan height %d, last scanned block is %d", height, lastScanned)
+	}
+	atomic.StoreUint64(&ob.rescanFrom, height)
+	return nil
+}
+
 func (ob *EVMChainClient) ExternalChainWatcher() {
 	// At each tick, query the Connector contract
 	ticker, err := NewDynamicTicker(fmt.Sprintf("EVM_ExternalChainWatcher_%d", ob.chain.ChainId), ob.GetCoreParams().InTxTicker)
@@ -760,13 +839,26 @@ func (ob *EVMChainClient) postBlockHeader(tip uint64) error {
 }
 
 func (ob *EVMChainClient) observeInTX() error {
+	if reason, paused := ob.IsInboundPaused(); paused {
+		ob.logger.ExternalChainWatcher.Warn().Msgf("observeInTX: inbound observation is paused: %s", reason)
+		return nil
+	}
+	if height := atomic.SwapUint64(&ob.rescanFrom, 0); height > 0 {
+		ob.logger.ExternalChainWatcher.Warn().Msgf("observeInTX: rescanning from block %d", height)
+		ob.SetLastBlockHeightScanned(height - 1)
+	}
+	if err := ob.detectReorg(); err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("observeInTX: error detecting reorg")
+	}
+
 	header, err := ob.evmClient.HeaderByNumber(context.Background(), nil)
 	if err != nil {
 		return err
 	}
 	// update last block height
 	ob.SetLastBlockHeight(header.Number.Uint64())
-	confirmedBlockNum := header.Number.Uint64() - ob.GetCoreParams().ConfirmationCount
+	// confirmed block number for the transfers requiring the fewest confirmations
+	confirmedBlockNum := header.Number.Uint64() - ob.GetCoreParams().MinConfirmationCount()
 
 	crosschainFlags, err := ob.zetaClient.GetCrosschainFlags()
 	if err != nil {
@@ -781,6 +873,9 @@ func (ob *EVMChainClient) observeInTX() error {
 	}
 	counter.Inc()
 
+	// post the votes of the deferred inbound txs confirmed for their amount
+	postConfirmedInbounds(ob.zetaClient, ob.pendingInbounds, header.Number.Uint64(), ob.getBlockHash, ob.logger.ExternalChainWatcher)
+
 	// skip if no new block is produced.
 	sampledLogger := ob.logger.ExternalChainWatcher.Sample(&zerolog.BasicSampler{N: 10})
 	if confirmedBlockNum <= ob.GetLastBlockHeightScanned() {
@@ -824,6 +919,10 @@ func (ob *EVMChainClient) observeInTX() error {
 				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error getting inbound vote msg")
 				continue
 			}
+			traceInboundObserved(&msg, InboundSourceObserver)
+			if ob.deferInbound(&msg, PostSendNonEVMGasLimit, logs.Event.Raw.BlockHash.Hex(), header.Number.Uint64()) {
+				continue
+			}
 
 			zetaHash, err := ob.zetaClient.PostSend(PostSendNonEVMGasLimit, &msg)
 			if err != nil {
@@ -865,6 +964,10 @@ func (ob *EVMChainClient) observeInTX() error {
 			if err != nil {
 				continue
 			}
+			traceInboundObserved(&msg, InboundSourceObserver)
+			if ob.deferInbound(&msg, PostSendEVMGasLimit, depositedLogs.Event.Raw.BlockHash.Hex(), header.Number.Uint64()) {
+				continue
+			}
 			zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, &msg)
 			if err != nil {
 				ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
@@ -893,6 +996,7 @@ func (ob *EVMChainClient) observeInTX() error {
 				ob.logger.ExternalChainWatcher.Error().Err(err).Msgf("error getting block: %d", bn)
 				continue
 			}
+			ob.blockHashes.Add(bn, block.Hash())
 			headerRLP, err := rlp.EncodeToBytes(block.Header())
 			if err != nil {
 				ob.logger.ExternalChainWatcher.Error().Err(err).Msgf("error encoding block header: %d", bn)
@@ -914,34 +1018,22 @@ func (ob *EVMChainClient) observeInTX() error {
 				if tx.To() == nil {
 					continue
 				}
-				if bytes.Equal(tx.Data(), []byte(DonationMessage)) {
+				if bytes.Equal(tx.Data(), []byte(common.DonationMessage)) {
 					ob.logger.ExternalChainWatcher.Info().Msgf("thank you rich folk for your donation!: %s", tx.Hash().Hex())
 					continue
 				}
 
 				if *tx.To() == tssAddress {
-					receipt, err := ob.evmClient.TransactionReceipt(context.Background(), tx.Hash())
+					msg, err := ob.getInboundVoteMsgForTssTx(tx, block.Hash())
 					if err != nil {
-						ob.logger.ExternalChainWatcher.Err(err).Msg("TransactionReceipt error")
+						ob.logger.ExternalChainWatcher.Err(err).Msgf("error observing tx %s", tx.Hash())
 						continue
 					}
-					if receipt.Status != 1 { // 1: successful, 0: failed
-						ob.logger.ExternalChainWatcher.Info().Msgf("tx %s failed; don't act", tx.Hash())
+					if msg == nil {
 						continue
 					}
-
-					from, err := ob.evmClient.TransactionSender(context.Background(), tx, block.Hash(), receipt.TransactionIndex)
-					if err != nil {
-						ob.logger.ExternalChainWatcher.Err(err).Msg("TransactionSender error; trying local recovery (assuming LondonSigner dynamic fee tx type) of sender address")
-						signer := ethtypes.NewLondonSigner(big.NewInt(ob.chain.ChainId))
-						from, err = signer.Sender(tx)
-						if err != nil {
-							ob.logger.ExternalChainWatcher.Err(err).Msg("local recovery of sender address failed")
-							continue
-						}
-					}
-					msg := ob.GetInboundVoteMsgForTokenSentToTSS(tx.Hash(), tx.Value(), receipt, from, tx.Data())
-					if msg == nil {
+					traceInboundObserved(msg, InboundSourceObserver)
+					if ob.deferInbound(msg, PostSendEVMGasLimit, block.Hash().Hex(), header.Number.Uint64()) {
 						continue
 					}
 					zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
@@ -955,13 +1047,70 @@ func (ob *EVMChainClient) observeInTX() error {
 		}
 	}()
 	// ============= end of query the incoming tx to TSS address ==============
+	// the blocks of the deferred inbound txs are scanned again after a restart
 	ob.SetLastBlockHeightScanned(toBlock)
-	if err := ob.db.Save(clienttypes.ToLastBlockSQLType(ob.GetLastBlockHeightScanned())).Error; err != nil {
-		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error writing toBlock to db")
+	lastScanned := ob.pendingInbounds.LastScannedToSave(ob.GetLastBlockHeightScanned())
+	if err := saveLastBlock(ob.store, lastScanned); err != nil {
+		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error writing toBlock to store")
 	}
 	return nil
 }
 
+// deferInbound defers the vote of the inbound tx if it doesn't have the confirmations required by its amount at the
+// height of the chain, returns true if deferred
+func (ob *EVMChainClient) deferInbound(msg *types.MsgVoteOnObservedInboundTx, gasLimit uint64, blockHash string, height uint64) bool {
+	return deferInbound(ob.pendingInbounds, ob.GetCoreParams(), ob.assetDecimals, msg, gasLimit, blockHash, height, ob.logger.ExternalChainWatcher)
+}
+
+// getBlockHash returns the hash of the block at the height
+func (ob *EVMChainClient) getBlockHash(height uint64) (string, error) {
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(height))
+	if err != nil {
+		return "", err
+	}
+	return header.Hash().Hex(), nil
+}
+
+// outboundConfirmationCount returns the confirmation count required for the outbound tx of the nonce from the amount of
+// its cctx, the highest confirmation count is returned if the cctx can't be queried
+func (ob *EVMChainClient) outboundConfirmationCount(nonce uint64) uint64 {
+	params := ob.GetCoreParams()
+	if len(params.ConfirmationTiers) == 0 {
+		return params.ConfirmationCount
+	}
+	cctx, err := ob.zetaClient.GetCctxByNonce(ob.chain.ChainId, nonce)
+	if err != nil {
+		ob.logger.ObserveOutTx.Warn().Err(err).Msgf("outboundConfirmationCount: error getting cctx of nonce %d", nonce)
+		return params.MaxConfirmationCount()
+	}
+	outTxParams := cctx.GetCurrentOutTxParam()
+	return requiredConfirmations(params, ob.assetDecimals, outTxParams.CoinType, cctx.InboundTxParams.Asset, outTxParams.Amount)
+}
+
+// getInboundVoteMsgForTssTx returns the inbound vote of the gas deposit of the tx sent to the TSS address
+// The vote is nil if the tx failed
+func (ob *EVMChainClient) getInboundVoteMsgForTssTx(tx *ethtypes.Transaction, blockHash ethcommon.Hash) (*types.MsgVoteOnObservedInboundTx, error) {
+	receipt, err := ob.evmClient.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return nil, errors.Wrap(err, "TransactionReceipt error")
+	}
+	if receipt.Status != 1 { // 1: successful, 0: failed
+		ob.logger.ExternalChainWatcher.Info().Msgf("tx %s failed; don't act", tx.Hash())
+		return nil, nil
+	}
+
+	from, err := ob.evmClient.TransactionSender(context.Background(), tx, blockHash, receipt.TransactionIndex)
+	if err != nil {
+		ob.logger.ExternalChainWatcher.Err(err).Msg("TransactionSender error; trying local recovery (assuming LondonSigner dynamic fee tx type) of sender address")
+		signer := ethtypes.NewLondonSigner(big.NewInt(ob.chain.ChainId))
+		from, err = signer.Sender(tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "local recovery of sender address failed")
+		}
+	}
+	return ob.GetInboundVoteMsgForTokenSentToTSS(tx.Hash(), tx.Value(), receipt, from, tx.Data()), nil
+}
+
 func (ob *EVMChainClient) WatchGasPrice() {
 
 	err := ob.PostGasPrice()
@@ -1002,10 +1151,10 @@ func (ob *EVMChainClient) WatchGasPrice() {
 }
 
 func (ob *EVMChainClient) PostGasPrice() error {
-	// GAS PRICE
-	gasPrice, err := ob.evmClient.SuggestGasPrice(context.TODO())
+	// GAS PRICE AND PRIORITY FEE
+	gasPrice, priorityFee, err := ob.suggestGasFees()
 	if err != nil {
-		ob.logger.WatchGasPrice.Err(err).Msg("Err SuggestGasPrice:")
+		ob.logger.WatchGasPrice.Err(err).Msg("Err suggestGasFees:")
 		return err
 	}
 	blockNum, err := ob.evmClient.BlockNumber(context.TODO())
@@ -1017,7 +1166,7 @@ func (ob *EVMChainClient) PostGasPrice() error {
 	// SUPPLY
 	supply := "100" // lockedAmount on ETH, totalSupply on other chains
 
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), supply, blockNum)
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), priorityFee, supply, blockNum)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice to zetacore failed")
 		return err
@@ -1028,6 +1177,34 @@ func (ob *EVMChainClient) PostGasPrice() error {
 	return nil
 }
 
+// suggestGasFees returns the gas price and the priority fee to vote for the chain
+// If the latest block has a base fee (EIP-1559), the gas price is the base fee of the block plus the priority fee
+// suggested by the chain, the signer derives the base fee from it to compute the fee cap of the outbound txs.
+// Otherwise the gas price is the one suggested by the chain and the priority fee is 0. The headers of Klaytn are not
+// compatible with the Ethereum headers, Klaytn outbound txs are legacy txs
+func (ob *EVMChainClient) suggestGasFees() (*big.Int, uint64, error) {
+	var header *ethtypes.Header
+	if !ob.chain.IsKlaytnChain() {
+		var err error
+		header, err = ob.evmClient.HeaderByNumber(context.TODO(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	if header == nil || header.BaseFee == nil {
+		gasPrice, err := ob.evmClient.SuggestGasPrice(context.TODO())
+		if err != nil {
+			return nil, 0, err
+		}
+		return gasPrice, 0, nil
+	}
+	priorityFee, err := ob.evmClient.SuggestGasTipCap(context.TODO())
+	if err != nil {
+		return nil, 0, err
+	}
+	return new(big.Int).Add(header.BaseFee, priorityFee), priorityFee.Uint64(), nil
+}
+
 // query ZetaCore about the last block that it has heard from a specific chain.
 // return 0 if not existent.
 func (ob *EVMChainClient) getLastHeight() (uint64, error) {
@@ -1058,9 +1235,9 @@ func (ob *EVMChainClient) BuildBlockIndex() error {
 			ob.SetLastBlockHeightScanned(scanFromBlockInt)
 		}
 	} else { // last observed block
-		var lastBlockNum clienttypes.LastBlockSQLType
-		if err := ob.db.First(&lastBlockNum, clienttypes.LastBlockNumID).Error; err != nil {
-			logger.Info().Msg("db PosKey does not exist; read from ZetaCore")
+		lastBlockNum, err := loadLastBlock(ob.store)
+		if err != nil {
+			logger.Info().Err(err).Msg("last block not in store; read from ZetaCore")
 			lastheight, err := ob.getLastHeight()
 			if err != nil {
 				logger.Warn().Err(err).Msg("getLastHeight error")
@@ -1074,92 +1251,59 @@ func (ob *EVMChainClient) BuildBlockIndex() error {
 				}
 				ob.SetLastBlockHeightScanned(header.Number.Uint64())
 			}
-			if dbc := ob.db.Save(clienttypes.ToLastBlockSQLType(ob.GetLastBlockHeightScanned())); dbc.Error != nil {
-				logger.Error().Err(dbc.Error).Msg("error writing ob.LastBlock to db: ")
+			if err := saveLastBlock(ob.store, ob.GetLastBlockHeightScanned()); err != nil {
+				logger.Error().Err(err).Msg("error writing ob.LastBlock to store: ")
 			}
 		} else {
-			ob.SetLastBlockHeightScanned(lastBlockNum.Num)
+			ob.SetLastBlockHeightScanned(lastBlockNum)
 		}
 	}
 	return nil
 }
 
-func (ob *EVMChainClient) BuildReceiptsMap() error {
-	logger := ob.logger
-	var receipts []clienttypes.ReceiptSQLType
-	if err := ob.db.Find(&receipts).Error; err != nil {
-		logger.ChainLogger.Error().Err(err).Msg("error iterating over db")
+// LoadDB opens the store of the chain and loads its data into EVMChainClient
+func (ob *EVMChainClient) LoadDB(opts store.Options) error {
+	s, err := store.Open(opts.Backend, opts.Dir, ob.chain.ChainName.String())
+	if err != nil {
+		ob.logger.ChainLogger.Error().Err(err).Msg("error opening store")
 		return err
 	}
-	for _, receipt := range receipts {
-		r, err := clienttypes.FromReceiptDBType(receipt.Receipt)
-		if err != nil {
-			return err
-		}
-		ob.outTXConfirmedReceipts[receipt.Identifier] = r
-	}
+	ob.store = s
+	ob.storeRetention = opts.Retention
 
-	return nil
+	return ob.BuildBlockIndex()
 }
 
-func (ob *EVMChainClient) BuildTransactionsMap() error {
-	logger := ob.logger
-	var transactions []clienttypes.TransactionSQLType
-	if err := ob.db.Find(&transactions).Error; err != nil {
-		logger.ChainLogger.Error().Err(err).Msg("error iterating over db")
+// PruneStore deletes the receipts and the txs of the outbound txs finalized on zetacore before the retention window,
+// the store is not pruned if the retention is zero. The confirmed receipts and txs kept in memory are deleted as soon
+// as their outbound txs are finalized
+func (ob *EVMChainClient) PruneStore() error {
+	prunable, err := outTxPrunable(ob.zetaClient, ob.chain.ChainId, ob.GetTxID)
+	if err != nil {
 		return err
 	}
-	for _, transaction := range transactions {
-		trans, err := clienttypes.FromTransactionDBType(transaction.Transaction)
-		if err != nil {
-			return err
+	ob.Mu.Lock()
+	for outTxID := range ob.outTXConfirmedReceipts {
+		if prunable(outTxID) {
+			delete(ob.outTXConfirmedReceipts, outTxID)
 		}
-		ob.outTXConfirmedTransaction[transaction.Identifier] = trans
 	}
-	return nil
-}
-
-// LoadDB open sql database and load data into EVMChainClient
-func (ob *EVMChainClient) LoadDB(dbPath string, chain common.Chain) error {
-	if dbPath != "" {
-		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-			err := os.MkdirAll(dbPath, os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
-		path := fmt.Sprintf("%s/%s", dbPath, chain.ChainName.String()) //Use "file::memory:?cache=shared" for temp db
-		db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
-		if err != nil {
-			panic("failed to connect database")
+	for outTxID := range ob.outTXConfirmedTransaction {
+		if prunable(outTxID) {
+			delete(ob.outTXConfirmedTransaction, outTxID)
 		}
+	}
+	ob.Mu.Unlock()
 
-		err = db.AutoMigrate(&clienttypes.ReceiptSQLType{},
-			&clienttypes.TransactionSQLType{},
-			&clienttypes.LastBlockSQLType{})
-		if err != nil {
-			ob.logger.ChainLogger.Error().Err(err).Msg("error migrating db")
-			return err
-		}
-
-		ob.db = db
-		err = ob.BuildBlockIndex()
-		if err != nil {
-			return err
-		}
-
-		//DISABLING RECEIPT AND TRANSACTION PERSISTENCE
-		//err = ob.BuildReceiptsMap()
-		//if err != nil {
-		//	return err
-		//}
-		//
-		//err = ob.BuildTransactionsMap()
-		//if err != nil {
-		//	return err
-		//}
-
+	if ob.storeRetention <= 0 {
+		return nil
+	}
+	pruned, err := pruneOutTxData(ob.store, ob.storeRetention, prunable, store.BucketReceipts, store.BucketTransactions)
+	if err != nil {
+		return err
 	}
+	ob.logger.ChainLogger.Info().Msgf("PruneStore: pruned %d receipts and %d transactions",
+		len(pruned[store.BucketReceipts]), len(pruned[store.BucketTransactions]))
 	return nil
 }
 
@@ -1193,6 +1337,19 @@ func (ob *EVMChainClient) GetTxID(nonce uint64) string {
 	return fmt.Sprintf("%d-%s-%d", ob.chain.ChainId, tssAddr, nonce)
 }
 
+// isCancelTx returns true if the tx is a cancel tx signed by SignCancelTx: an empty transfer from the TSS address to itself
+func (ob *EVMChainClient) isCancelTx(tx *ethtypes.Transaction) bool {
+	return tx.To() != nil &&
+		*tx.To() == ob.Tss.EVMAddress() &&
+		tx.Value().Sign() == 0 &&
+		len(tx.Data()) == 0
+}
+
+// GetTssNonce returns the nonce of the TSS address in the latest block, i.e. the next nonce the chain accepts
+func (ob *EVMChainClient) GetTssNonce() (uint64, error) {
+	return ob.evmClient.NonceAt(context.Background(), ob.Tss.EVMAddress(), nil)
+}
+
 func (ob *EVMChainClient) GetBlockByNumberCached(blockNumber uint64) (*ethtypes.Block, error) {
 	if block, ok := ob.BlockCache.Get(blockNumber); ok {
 		return block.(*ethtypes.Block), nil
@@ -0,0 +1,66 @@
+package zetaclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+// gasTestEVMClient is an EVM rpc client with a fixed base fee, gas price and priority fee
+type gasTestEVMClient struct {
+	EVMRPCClient
+	baseFee     *big.Int
+	gasPrice    *big.Int
+	priorityFee *big.Int
+}
+
+func (c *gasTestEVMClient) HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(1), BaseFee: c.baseFee}, nil
+}
+
+func (c *gasTestEVMClient) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return c.gasPrice, nil
+}
+
+func (c *gasTestEVMClient) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return c.priorityFee, nil
+}
+
+func TestEVMChainClient_SuggestGasFees(t *testing.T) {
+	t.Run("should vote the base fee plus the priority fee on an EIP-1559 chain", func(t *testing.T) {
+		ob := &EVMChainClient{
+			chain:     common.GoerliChain(),
+			evmClient: &gasTestEVMClient{baseFee: big.NewInt(90), gasPrice: big.NewInt(120), priorityFee: big.NewInt(10)},
+		}
+		gasPrice, priorityFee, err := ob.suggestGasFees()
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100), gasPrice)
+		require.EqualValues(t, 10, priorityFee)
+	})
+
+	t.Run("should vote the suggested gas price on a legacy chain", func(t *testing.T) {
+		ob := &EVMChainClient{
+			chain:     common.GoerliChain(),
+			evmClient: &gasTestEVMClient{gasPrice: big.NewInt(120), priorityFee: big.NewInt(10)},
+		}
+		gasPrice, priorityFee, err := ob.suggestGasFees()
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(120), gasPrice)
+		require.Zero(t, priorityFee)
+	})
+
+	t.Run("should vote the suggested gas price on Klaytn", func(t *testing.T) {
+		ob := &EVMChainClient{
+			chain:     common.Chain{ChainName: common.ChainName_baobab_testnet, ChainId: 1001},
+			evmClient: &gasTestEVMClient{baseFee: big.NewInt(90), gasPrice: big.NewInt(120), priorityFee: big.NewInt(10)},
+		}
+		gasPrice, priorityFee, err := ob.suggestGasFees()
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(120), gasPrice)
+		require.Zero(t, priorityFee)
+	})
+}
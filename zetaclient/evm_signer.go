@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -20,8 +22,11 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type EVMSigner struct {
@@ -36,9 +41,15 @@ type EVMSigner struct {
 	erc20CustodyContractAddress ethcommon.Address
 	logger                      zerolog.Logger
 	ts                          *TelemetryServer
+
+	// reportedCancelTxs is the hash of the cancel tx reported on zetacore for each cancelled nonce
+	reportedCancelTxs sync.Map
 }
 
-var _ ChainSigner = &EVMSigner{}
+var (
+	_ BatchChainSigner  = &EVMSigner{}
+	_ CancelChainSigner = &EVMSigner{}
+)
 
 func NewEVMSigner(
 	chain common.Chain,
@@ -89,34 +100,86 @@ func NewEVMSigner(
 
 // Sign given data, and metadata (gas, nonce, etc)
 // returns a signed transaction, sig bytes, hash bytes, and error
+// A dynamic fee tx is signed if priorityFee is not nil, its fee cap is twice the base fee plus the priority fee
 func (signer *EVMSigner) Sign(
 	data []byte,
 	to ethcommon.Address,
 	gasLimit uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	nonce uint64,
 	height uint64,
 ) (*ethtypes.Transaction, []byte, []byte, error) {
 	log.Debug().Msgf("TSS SIGNER: %s", signer.tssSigner.Pubkey())
-	tx := ethtypes.NewTransaction(nonce, to, big.NewInt(0), gasLimit, gasPrice, data)
-	hashBytes := signer.ethSigner.Hash(tx).Bytes()
+	tx := newOutboundTx(signer.chainID, nonce, to, big.NewInt(0), gasLimit, gasPrice, priorityFee, data)
+	return signer.signTx(tx, height)
+}
 
-	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
+// signTx signs the tx with the TSS
+// returns the signed transaction, sig bytes, hash bytes, and error
+func (signer *EVMSigner) signTx(tx *ethtypes.Transaction, height uint64) (*ethtypes.Transaction, []byte, []byte, error) {
+	hashBytes := signer.ethSigner.Hash(tx).Bytes()
+	sig, err := signer.tssSigner.Sign(hashBytes, height, tx.Nonce(), signer.chain, "")
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	log.Debug().Msgf("Sign: Signature: %s", hex.EncodeToString(sig[:]))
+	signedTX, err := signer.withSignature(tx, hashBytes, sig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return signedTX, sig[:], hashBytes, nil
+}
+
+// withSignature returns the tx with the signature of its hash
+func (signer *EVMSigner) withSignature(tx *ethtypes.Transaction, hashBytes []byte, sig [65]byte) (*ethtypes.Transaction, error) {
 	pubk, err := crypto.SigToPub(hashBytes, sig[:])
 	if err != nil {
 		signer.logger.Error().Err(err).Msgf("SigToPub error")
+	} else {
+		signer.logger.Info().Msgf("Sign: Ecrecovery of signature: %s", crypto.PubkeyToAddress(*pubk).Hex())
 	}
-	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
-	if err != nil {
-		return nil, nil, nil, err
+	return tx.WithSignature(signer.ethSigner, sig[:])
+}
+
+// signTxBatch signs the txs in a single keysign, the txs are signed one by one if the batched keysign fails
+func (signer *EVMSigner) signTxBatch(txs []*ethtypes.Transaction, height uint64) ([]*ethtypes.Transaction, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	digests := make([][]byte, len(txs))
+	for i, tx := range txs {
+		digests[i] = signer.ethSigner.Hash(tx).Bytes()
+	}
+
+	signedTxs := make([]*ethtypes.Transaction, len(txs))
+	sigs, err := signer.tssSigner.SignBatch(digests, height, txs[0].Nonce(), signer.chain)
+	if err == nil && len(sigs) != len(txs) {
+		err = fmt.Errorf("got %d signatures for %d digests", len(sigs), len(txs))
 	}
-	return signedTX, sig[:], hashBytes[:], nil
+	if err == nil {
+		for i, tx := range txs {
+			signedTxs[i], err = signer.withSignature(tx, digests[i], sigs[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return signedTxs, nil
+	}
+
+	// fallback to single signing, a blamed batch does not block the other txs
+	signer.logger.Warn().Err(err).Msgf("batched keysign of %d txs failed; signing them one by one", len(txs))
+	var errs []string
+	for i, tx := range txs {
+		signedTxs[i], _, _, err = signer.signTx(tx, height)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("nonce %d: %s", tx.Nonce(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return signedTxs, fmt.Errorf("keysign failed: %s", strings.Join(errs, "; "))
+	}
+	return signedTxs, nil
 }
 
 // Broadcast takes in signed tx, broadcast to external chain node
@@ -146,20 +209,14 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 	sendHash [32]byte,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64) (*ethtypes.Transaction, error) {
 
-	if len(sendHash) < 32 {
-		return nil, fmt.Errorf("sendHash len %d must be 32", len(sendHash))
-	}
-	var data []byte
-	var err error
-
-	data, err = signer.abi.Pack("onReceive", sender.Bytes(), srcChainID, to, amount, message, sendHash)
+	tx, err := signer.newOnReceiveTx(sender, srcChainID, to, amount, gasLimit, message, sendHash, nonce, gasPrice, priorityFee)
 	if err != nil {
-		return nil, fmt.Errorf("pack error: %w", err)
+		return nil, err
 	}
-
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err = signer.signTx(tx, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -167,6 +224,28 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 	return tx, nil
 }
 
+// newOnReceiveTx returns the unsigned tx of SignOutboundTx
+func (signer *EVMSigner) newOnReceiveTx(sender ethcommon.Address,
+	srcChainID *big.Int,
+	to ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	message []byte,
+	sendHash [32]byte,
+	nonce uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int) (*ethtypes.Transaction, error) {
+
+	if len(sendHash) < 32 {
+		return nil, fmt.Errorf("sendHash len %d must be 32", len(sendHash))
+	}
+	data, err := signer.abi.Pack("onReceive", sender.Bytes(), srcChainID, to, amount, message, sendHash)
+	if err != nil {
+		return nil, fmt.Errorf("pack error: %w", err)
+	}
+	return newOutboundTx(signer.chainID, nonce, signer.metaContractAddress, big.NewInt(0), gasLimit, gasPrice, priorityFee, data), nil
+}
+
 // SignRevertTx
 // function onRevert(
 // address originSenderAddress,
@@ -188,17 +267,14 @@ func (signer *EVMSigner) SignRevertTx(
 	sendHash [32]byte,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
-	var data []byte
-	var err error
-
-	data, err = signer.abi.Pack("onRevert", sender, srcChainID, to, toChainID, amount, message, sendHash)
+	tx, err := signer.newOnRevertTx(sender, srcChainID, to, toChainID, amount, gasLimit, message, sendHash, nonce, gasPrice, priorityFee)
 	if err != nil {
-		return nil, fmt.Errorf("pack error: %w", err)
+		return nil, err
 	}
-
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err = signer.signTx(tx, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -206,20 +282,30 @@ func (signer *EVMSigner) SignRevertTx(
 	return tx, nil
 }
 
-func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, nil)
-	hashBytes := signer.ethSigner.Hash(tx).Bytes()
-	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
-	if err != nil {
-		return nil, err
-	}
-	pubk, err := crypto.SigToPub(hashBytes, sig[:])
+// newOnRevertTx returns the unsigned tx of SignRevertTx
+func (signer *EVMSigner) newOnRevertTx(
+	sender ethcommon.Address,
+	srcChainID *big.Int,
+	to []byte,
+	toChainID *big.Int,
+	amount *big.Int,
+	gasLimit uint64,
+	message []byte,
+	sendHash [32]byte,
+	nonce uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int,
+) (*ethtypes.Transaction, error) {
+	data, err := signer.abi.Pack("onRevert", sender, srcChainID, to, toChainID, amount, message, sendHash)
 	if err != nil {
-		signer.logger.Error().Err(err).Msgf("SigToPub error")
+		return nil, fmt.Errorf("pack error: %w", err)
 	}
-	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
+	return newOutboundTx(signer.chainID, nonce, signer.metaContractAddress, big.NewInt(0), gasLimit, gasPrice, priorityFee, data), nil
+}
+
+func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tx := newOutboundTx(signer.chainID, nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, priorityFee, nil)
+	signedTX, _, _, err := signer.signTx(tx, height)
 	if err != nil {
 		return nil, err
 	}
@@ -232,36 +318,48 @@ func (signer *EVMSigner) SignWithdrawTx(
 	amount *big.Int,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, to, amount, 21000, gasPrice, nil)
-	hashBytes := signer.ethSigner.Hash(tx).Bytes()
-	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
+	tx := newOutboundTx(signer.chainID, nonce, to, amount, 21000, gasPrice, priorityFee, nil)
+	signedTX, _, _, err := signer.signTx(tx, height)
 	if err != nil {
 		return nil, err
 	}
-	pubk, err := crypto.SigToPub(hashBytes, sig[:])
+
+	return signedTX, nil
+}
+
+func (signer *EVMSigner) SignCommandTx(
+	cmd string,
+	params string,
+	to ethcommon.Address,
+	outboundParams *types.OutboundTxParams,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int,
+	height uint64,
+) (*ethtypes.Transaction, error) {
+	tx, err := signer.newCommandTx(cmd, params, to, outboundParams, gasLimit, gasPrice, priorityFee)
 	if err != nil {
-		signer.logger.Error().Err(err).Msgf("SigToPub error")
+		return nil, err
 	}
-	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
+	signedTX, _, _, err := signer.signTx(tx, height)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("sign error: %w", err)
 	}
-
 	return signedTX, nil
 }
 
-func (signer *EVMSigner) SignCommandTx(
+// newCommandTx returns the unsigned tx of SignCommandTx
+func (signer *EVMSigner) newCommandTx(
 	cmd string,
 	params string,
 	to ethcommon.Address,
 	outboundParams *types.OutboundTxParams,
 	gasLimit uint64,
 	gasPrice *big.Int,
-	height uint64,
+	priorityFee *big.Int,
 ) (*ethtypes.Transaction, error) {
 	if cmd == common.CmdWhitelistERC20 {
 		erc20 := ethcommon.HexToAddress(params)
@@ -276,36 +374,25 @@ func (signer *EVMSigner) SignCommandTx(
 		if err != nil {
 			return nil, err
 		}
-		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, outboundParams.OutboundTxTssNonce, height)
-		if err != nil {
-			return nil, fmt.Errorf("sign error: %w", err)
-		}
-		return tx, nil
+		return newOutboundTx(signer.chainID, outboundParams.OutboundTxTssNonce, to, big.NewInt(0), gasLimit, gasPrice, priorityFee, data), nil
 	}
 	if cmd == common.CmdMigrateTssFunds {
-		tx := ethtypes.NewTransaction(outboundParams.OutboundTxTssNonce, to, outboundParams.Amount.BigInt(), 21000, gasPrice, nil)
-		hashBytes := signer.ethSigner.Hash(tx).Bytes()
-		sig, err := signer.tssSigner.Sign(hashBytes, height, outboundParams.OutboundTxTssNonce, signer.chain, "")
-		if err != nil {
-			return nil, err
-		}
-		pubk, err := crypto.SigToPub(hashBytes, sig[:])
-		if err != nil {
-			signer.logger.Error().Err(err).Msgf("SigToPub error")
-		}
-		addr := crypto.PubkeyToAddress(*pubk)
-		signer.logger.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-		signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
-		if err != nil {
-			return nil, err
-		}
-
-		return signedTX, nil
+		return newOutboundTx(signer.chainID, outboundParams.OutboundTxTssNonce, to, outboundParams.Amount.BigInt(), 21000, gasPrice, priorityFee, nil), nil
 	}
 
 	return nil, fmt.Errorf("SignCommandTx: unknown command %s", cmd)
 }
 
+// evmOutTx is the unsigned outbound tx of a cctx
+type evmOutTx struct {
+	cctx    *types.CrossChainTx
+	outTxID string
+	toChain *common.Chain
+	tx      *ethtypes.Transaction
+	ctx     context.Context
+	logger  zerolog.Logger
+}
+
 func (signer *EVMSigner) TryProcessOutTx(
 	send *types.CrossChainTx,
 	outTxMan *OutTxProcessorManager,
@@ -314,18 +401,199 @@ func (signer *EVMSigner) TryProcessOutTx(
 	zetaBridge ZetaCoreBridger,
 	height uint64,
 ) {
-	logger := signer.logger.With().
+	logger := signer.outTxLogger(send, outTxID)
+
+	// #nosec G701 always in range
+	ctx, span := startOutboundSpan(context.Background(), "TryProcessOutTx", send, attribute.Int64("zeta.height", int64(height)))
+	defer span.End()
+	defer func() {
+		outTxMan.EndTryProcess(outTxID)
+	}()
+
+	outTx := signer.newOutTx(ctx, send, outTxID, evmClient, zetaBridge, logger)
+	if outTx == nil {
+		return
+	}
+
+	_, keysignSpan := tracing.StartCctxSpan(ctx, tracer, "Keysign", send.Index)
+	tx, _, _, err := signer.signTx(outTx.tx, height)
+	tracing.EndSpan(keysignSpan, err)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("signer SignOutbound error: nonce %d chain %d", send.GetCurrentOutTxParam().OutboundTxTssNonce, send.GetCurrentOutTxParam().ReceiverChainId)
+		return
+	}
+	signer.broadcastOutTx(outTx, tx, zetaBridge)
+}
+
+// TryProcessOutTxBatch signs the outbound txs of the cctxs in a single keysign and broadcasts them in nonce order
+// The txs are signed one by one if the batched keysign fails
+func (signer *EVMSigner) TryProcessOutTxBatch(
+	cctxs []*types.CrossChainTx,
+	outTxMan *OutTxProcessorManager,
+	outTxIDs []string,
+	evmClient ChainClient,
+	zetaBridge ZetaCoreBridger,
+	height uint64,
+) {
+	spans := make([]trace.Span, len(cctxs))
+	defer func() {
+		for i, outTxID := range outTxIDs {
+			if spans[i] != nil {
+				spans[i].End()
+			}
+			outTxMan.EndTryProcess(outTxID)
+		}
+	}()
+
+	outTxs := make([]*evmOutTx, 0, len(cctxs))
+	for i, send := range cctxs {
+		var ctx context.Context
+		// #nosec G701 always in range
+		ctx, spans[i] = startOutboundSpan(context.Background(), "TryProcessOutTx", send,
+			attribute.Int64("zeta.height", int64(height)),
+			attribute.Int("keysign.batch_size", len(cctxs)),
+		)
+		outTx := signer.newOutTx(ctx, send, outTxIDs[i], evmClient, zetaBridge, signer.outTxLogger(send, outTxIDs[i]))
+		if outTx != nil {
+			outTxs = append(outTxs, outTx)
+		}
+	}
+	if len(outTxs) == 0 {
+		return
+	}
+	sort.SliceStable(outTxs, func(i, j int) bool {
+		return outTxs[i].tx.Nonce() < outTxs[j].tx.Nonce()
+	})
+
+	txs := make([]*ethtypes.Transaction, len(outTxs))
+	keysignSpans := make([]trace.Span, len(outTxs))
+	for i, outTx := range outTxs {
+		txs[i] = outTx.tx
+		_, keysignSpans[i] = tracing.StartCctxSpan(outTx.ctx, tracer, "Keysign", outTx.cctx.Index, attribute.Int("keysign.batch_size", len(outTxs)))
+	}
+	signer.logger.Info().Msgf("TryProcessOutTxBatch: signing %d txs, nonces %d to %d", len(txs), txs[0].Nonce(), txs[len(txs)-1].Nonce())
+	signedTxs, err := signer.signTxBatch(txs, height)
+	for i, outTx := range outTxs {
+		if signedTxs == nil || signedTxs[i] == nil {
+			tracing.EndSpan(keysignSpans[i], err)
+			outTx.logger.Warn().Err(err).Msgf("signer SignOutbound error: nonce %d chain %d", outTx.tx.Nonce(), outTx.toChain.ChainId)
+			continue
+		}
+		tracing.EndSpan(keysignSpans[i], nil)
+	}
+
+	// broadcast in nonce order, a tx is only accepted by the chain after the txs of the lower nonces
+	for i, outTx := range outTxs {
+		if signedTxs == nil || signedTxs[i] == nil {
+			continue
+		}
+		signer.broadcastOutTx(outTx, signedTxs[i], zetaBridge)
+	}
+}
+
+// TryCancelOutTx replaces the outbound tx of the cctx of a blocking nonce by a cancel tx so that the later nonces can be mined
+// The cancel tx outbids the outbound tx that might be in the mempool and is reported on zetacore once broadcast
+func (signer *EVMSigner) TryCancelOutTx(
+	send *types.CrossChainTx,
+	outTxMan *OutTxProcessorManager,
+	outTxID string,
+	gap NonceGap,
+	zetaBridge ZetaCoreBridger,
+	height uint64,
+) {
+	logger := signer.outTxLogger(send, outTxID)
+
+	// #nosec G701 always in range
+	ctx, span := startOutboundSpan(context.Background(), "TryCancelOutTx", send, attribute.Int64("zeta.height", int64(height)))
+	defer span.End()
+	defer func() {
+		outTxMan.EndTryProcess(outTxID)
+	}()
+
+	params := send.GetCurrentOutTxParam()
+	gasPrice, priorityFee, err := cancelTxGasPrice(params)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryCancelOutTx: cannot get the gas price of nonce %d", params.OutboundTxTssNonce)
+		return
+	}
+
+	_, keysignSpan := tracing.StartCctxSpan(ctx, tracer, "Keysign", send.Index)
+	tx, err := signer.SignCancelTx(params.OutboundTxTssNonce, gasPrice, priorityFee, height)
+	tracing.EndSpan(keysignSpan, err)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("TryCancelOutTx: SignCancelTx error: nonce %d chain %d", params.OutboundTxTssNonce, signer.chain.ChainId)
+		return
+	}
+	signer.broadcastOutTx(&evmOutTx{
+		cctx:    send,
+		outTxID: outTxID,
+		toChain: signer.chain,
+		tx:      tx,
+		ctx:     ctx,
+		logger:  logger,
+	}, tx, zetaBridge)
+
+	cancelTxHash := tx.Hash().Hex()
+	if reported, found := signer.reportedCancelTxs.Load(tx.Nonce()); found && reported == cancelTxHash {
+		return
+	}
+	signer.reportedCancelTxs.Store(tx.Nonce(), cancelTxHash)
+	zetaHash, err := zetaBridge.PostNonceGapReport(signer.chain.ChainId, gap.Nonce, gap.TssNonce, gap.Cause, types.OutTxNonceGapAction_Cancelled, cancelTxHash)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryCancelOutTx: PostNonceGapReport failed for nonce %d", gap.Nonce)
+		signer.reportedCancelTxs.Delete(tx.Nonce())
+		return
+	}
+	logger.Info().Msgf("TryCancelOutTx: reported cancel tx %s of nonce %d, zeta tx %s", cancelTxHash, gap.Nonce, zetaHash)
+}
+
+// cancelTxGasPrice returns the gas price and priority fee of the cancel tx of an outbound
+// They are bumped by 10% above the outbound ones, the minimum for the chain to accept the replacement of a pending tx
+func cancelTxGasPrice(params *types.OutboundTxParams) (*big.Int, *big.Int, error) {
+	gasPrice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot convert gas price %s", params.OutboundTxGasPrice)
+	}
+	priorityFee, err := outboundPriorityFee(params, gasPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+	if priorityFee == nil {
+		return bumpGasPrice(gasPrice), nil, nil
+	}
+	// the base fee and the priority fee are bumped separately so that both the fee cap and the tip cap are bumped
+	baseFee := bumpGasPrice(new(big.Int).Sub(gasPrice, priorityFee))
+	priorityFee = bumpGasPrice(priorityFee)
+	return baseFee.Add(baseFee, priorityFee), priorityFee, nil
+}
+
+// bumpGasPrice returns the gas price increased by 10%, plus one to round up
+func bumpGasPrice(gasPrice *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(gasPrice, big.NewInt(11))
+	bumped.Div(bumped, big.NewInt(10))
+	return bumped.Add(bumped, big.NewInt(1))
+}
+
+// outTxLogger returns the logger of the processing of a cctx
+func (signer *EVMSigner) outTxLogger(send *types.CrossChainTx, outTxID string) zerolog.Logger {
+	return signer.logger.With().
 		Str("outTxID", outTxID).
 		Str("SendHash", send.Index).
 		Logger()
+}
+
+// newOutTx builds the unsigned outbound tx of a cctx, returns nil if there is no tx to sign
+func (signer *EVMSigner) newOutTx(
+	ctx context.Context,
+	send *types.CrossChainTx,
+	outTxID string,
+	evmClient ChainClient,
+	zetaBridge ZetaCoreBridger,
+	logger zerolog.Logger,
+) *evmOutTx {
 	logger.Info().Msgf("start processing outTxID %s", outTxID)
 	logger.Info().Msgf("EVM Chain TryProcessOutTx: %s, value %d to %s", send.Index, send.GetCurrentOutTxParam().Amount.BigInt(), send.GetCurrentOutTxParam().Receiver)
 
-	defer func() {
-		outTxMan.EndTryProcess(outTxID)
-	}()
-	myID := zetaBridge.GetKeys().GetOperatorAddress()
-
 	var to ethcommon.Address
 	var err error
 	var toChain *common.Chain
@@ -334,7 +602,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 		toChain = common.GetChainFromChainID(send.InboundTxParams.SenderChainId)
 		if toChain == nil {
 			logger.Error().Msgf("Unknown chain: %d", send.InboundTxParams.SenderChainId)
-			return
+			return nil
 		}
 		logger.Info().Msgf("Abort: reverting inbound")
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingOutbound {
@@ -342,15 +610,11 @@ func (signer *EVMSigner) TryProcessOutTx(
 		toChain = common.GetChainFromChainID(send.GetCurrentOutTxParam().ReceiverChainId)
 		if toChain == nil {
 			logger.Error().Msgf("Unknown chain: %d", send.GetCurrentOutTxParam().ReceiverChainId)
-			return
+			return nil
 		}
 	} else {
 		logger.Info().Msgf("Transaction doesn't need to be processed status: %d", send.CctxStatus.Status)
-		return
-	}
-	if err != nil {
-		logger.Error().Err(err).Msg("ParseChain fail; skip")
-		return
+		return nil
 	}
 
 	// Early return if the cctx is already processed
@@ -360,7 +624,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 	}
 	if included || confirmed {
 		logger.Info().Msgf("CCTX already processed; exit signer")
-		return
+		return nil
 	}
 
 	var message []byte
@@ -385,7 +649,7 @@ func (signer *EVMSigner) TryProcessOutTx(
 	sendHash, err := hex.DecodeString(send.Index[2:]) // remove the leading 0x
 	if err != nil || len(sendHash) != 32 {
 		logger.Error().Err(err).Msgf("decode CCTX %s error", send.Index)
-		return
+		return nil
 	}
 	var sendhash [32]byte
 	copy(sendhash[:32], sendHash[:32])
@@ -402,12 +666,12 @@ func (signer *EVMSigner) TryProcessOutTx(
 			suggested, err := signer.client.SuggestGasPrice(context.Background())
 			if err != nil {
 				logger.Error().Err(err).Msgf("cannot get gas price from chain %s ", toChain)
-				return
+				return nil
 			}
 			gasprice = roundUpToNearestGwei(suggested)
 		} else {
 			logger.Error().Err(err).Msgf("cannot convert gas price  %s ", send.GetCurrentOutTxParam().OutboundTxGasPrice)
-			return
+			return nil
 		}
 	} else {
 		gasprice = specified
@@ -428,54 +692,47 @@ func (signer *EVMSigner) TryProcessOutTx(
 	//	gasprice = specified
 	//}
 
+	// sign a dynamic fee tx if the outbound tx has a priority fee
+	priorityFee, err := outboundPriorityFee(send.GetCurrentOutTxParam(), gasprice)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot convert priority fee %s ", send.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+		return nil
+	}
+
 	flags, err := zetaBridge.GetCrosschainFlags()
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot get crosschain flags")
-		return
+		return nil
 	}
 
 	var tx *ethtypes.Transaction
-
+	nonce := send.GetCurrentOutTxParam().OutboundTxTssNonce
 	if send.GetCurrentOutTxParam().CoinType == common.CoinType_Cmd { // admin command
 		to := ethcommon.HexToAddress(send.GetCurrentOutTxParam().Receiver)
 		if to == (ethcommon.Address{}) {
 			logger.Error().Msgf("invalid receiver %s", send.GetCurrentOutTxParam().Receiver)
-			return
+			return nil
 		}
 
 		msg := strings.Split(send.RelayedMessage, ":")
 		if len(msg) != 2 {
 			logger.Error().Msgf("invalid message %s", msg)
-			return
+			return nil
 		}
-		tx, err = signer.SignCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam(), gasLimit, gasprice, height)
+		tx, err = signer.newCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam(), gasLimit, gasprice, priorityFee)
 	} else if send.InboundTxParams.SenderChainId == zetaBridge.ZetaChain().ChainId && send.CctxStatus.Status == types.CctxStatus_PendingOutbound && flags.IsOutboundEnabled {
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_Gas {
-			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-			tx, err = signer.SignWithdrawTx(
-				to,
-				send.GetCurrentOutTxParam().Amount.BigInt(),
-				send.GetCurrentOutTxParam().OutboundTxTssNonce,
-				gasprice,
-				height,
-			)
+			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+			tx = newOutboundTx(signer.chainID, nonce, to, send.GetCurrentOutTxParam().Amount.BigInt(), 21000, gasprice, priorityFee, nil)
 		}
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_ERC20 {
 			asset := ethcommon.HexToAddress(send.InboundTxParams.Asset)
-			logger.Info().Msgf("SignERC20WithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-			tx, err = signer.SignERC20WithdrawTx(
-				to,
-				asset,
-				send.GetCurrentOutTxParam().Amount.BigInt(),
-				gasLimit,
-				send.GetCurrentOutTxParam().OutboundTxTssNonce,
-				gasprice,
-				height,
-			)
+			logger.Info().Msgf("SignERC20WithdrawTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+			tx, err = signer.newERC20WithdrawTx(to, asset, send.GetCurrentOutTxParam().Amount.BigInt(), gasLimit, nonce, gasprice, priorityFee)
 		}
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_Zeta {
-			logger.Info().Msgf("SignOutboundTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-			tx, err = signer.SignOutboundTx(
+			logger.Info().Msgf("SignOutboundTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+			tx, err = signer.newOnReceiveTx(
 				ethcommon.HexToAddress(send.InboundTxParams.Sender),
 				big.NewInt(send.InboundTxParams.SenderChainId),
 				to,
@@ -483,38 +740,24 @@ func (signer *EVMSigner) TryProcessOutTx(
 				gasLimit,
 				message,
 				sendhash,
-				send.GetCurrentOutTxParam().OutboundTxTssNonce,
+				nonce,
 				gasprice,
-				height,
+				priorityFee,
 			)
 		}
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingRevert && send.OutboundTxParams[0].ReceiverChainId == zetaBridge.ZetaChain().ChainId {
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_Gas {
-			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-			tx, err = signer.SignWithdrawTx(
-				to,
-				send.GetCurrentOutTxParam().Amount.BigInt(),
-				send.GetCurrentOutTxParam().OutboundTxTssNonce,
-				gasprice,
-				height,
-			)
+			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+			tx = newOutboundTx(signer.chainID, nonce, to, send.GetCurrentOutTxParam().Amount.BigInt(), 21000, gasprice, priorityFee, nil)
 		}
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_ERC20 {
 			asset := ethcommon.HexToAddress(send.InboundTxParams.Asset)
-			logger.Info().Msgf("SignERC20WithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-			tx, err = signer.SignERC20WithdrawTx(
-				to,
-				asset,
-				send.GetCurrentOutTxParam().Amount.BigInt(),
-				gasLimit,
-				send.GetCurrentOutTxParam().OutboundTxTssNonce,
-				gasprice,
-				height,
-			)
+			logger.Info().Msgf("SignERC20WithdrawTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+			tx, err = signer.newERC20WithdrawTx(to, asset, send.GetCurrentOutTxParam().Amount.BigInt(), gasLimit, nonce, gasprice, priorityFee)
 		}
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingRevert {
-		logger.Info().Msgf("SignRevertTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-		tx, err = signer.SignRevertTx(
+		logger.Info().Msgf("SignRevertTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+		tx, err = signer.newOnRevertTx(
 			ethcommon.HexToAddress(send.InboundTxParams.Sender),
 			big.NewInt(send.OutboundTxParams[0].ReceiverChainId),
 			to.Bytes(),
@@ -523,13 +766,13 @@ func (signer *EVMSigner) TryProcessOutTx(
 			gasLimit,
 			message,
 			sendhash,
-			send.GetCurrentOutTxParam().OutboundTxTssNonce,
+			nonce,
 			gasprice,
-			height,
+			priorityFee,
 		)
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingOutbound {
-		logger.Info().Msgf("SignOutboundTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
-		tx, err = signer.SignOutboundTx(
+		logger.Info().Msgf("SignOutboundTx: %d => %s, nonce %d, gasprice %d, priority fee %d", send.InboundTxParams.SenderChainId, toChain, nonce, gasprice, priorityFee)
+		tx, err = signer.newOnReceiveTx(
 			ethcommon.HexToAddress(send.InboundTxParams.Sender),
 			big.NewInt(send.InboundTxParams.SenderChainId),
 			to,
@@ -537,59 +780,76 @@ func (signer *EVMSigner) TryProcessOutTx(
 			gasLimit,
 			message,
 			sendhash,
-			send.GetCurrentOutTxParam().OutboundTxTssNonce,
+			nonce,
 			gasprice,
-			height,
+			priorityFee,
 		)
 	}
-
 	if err != nil {
-		logger.Warn().Err(err).Msgf("signer SignOutbound error: nonce %d chain %d", send.GetCurrentOutTxParam().OutboundTxTssNonce, send.GetCurrentOutTxParam().ReceiverChainId)
-		return
+		logger.Warn().Err(err).Msgf("signer SignOutbound error: nonce %d chain %d", nonce, send.GetCurrentOutTxParam().ReceiverChainId)
+		return nil
 	}
+	if tx == nil {
+		return nil
+	}
+	return &evmOutTx{
+		cctx:    send,
+		outTxID: outTxID,
+		toChain: toChain,
+		tx:      tx,
+		ctx:     ctx,
+		logger:  logger,
+	}
+}
+
+// broadcastOutTx broadcasts the signed outbound tx of a cctx and adds its hash to the outTx tracker
+func (signer *EVMSigner) broadcastOutTx(outTx *evmOutTx, tx *ethtypes.Transaction, zetaBridge ZetaCoreBridger) {
+	send, toChain, logger := outTx.cctx, outTx.toChain, outTx.logger
 	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce)
+	myID := zetaBridge.GetKeys().GetOperatorAddress()
 
-	_, err = zetaBridge.GetObserverList(*toChain)
+	_, err := zetaBridge.GetObserverList(*toChain)
 	if err != nil {
 		logger.Warn().Err(err).Msgf("unable to get observer list: chain %d observation %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, observertypes.ObservationType_OutBoundTx.String())
 
 	}
-	if tx != nil {
-		outTxHash := tx.Hash().Hex()
-		logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", signer.chain, send.GetCurrentOutTxParam().OutboundTxTssNonce, outTxHash, myID)
-		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
-		backOff := 1000 * time.Millisecond
-		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
-		for i := 0; i < 5; i++ {
-			logger.Info().Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, i)
-			// #nosec G404 randomness is not a security issue here
-			time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) // FIXME: use backoff
-			err := signer.Broadcast(tx)
-			if err != nil {
-				log.Warn().Err(err).Msgf("OutTx Broadcast error")
-				retry, report := HandleBroadcastError(err, strconv.FormatUint(send.GetCurrentOutTxParam().OutboundTxTssNonce, 10), toChain.String(), outTxHash)
-				if report {
-					zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(toChain.ChainId, tx.Nonce(), outTxHash, nil, "", -1)
-					if err != nil {
-						logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
-					}
-					logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
-				}
-				if !retry {
-					break
+	outTxHash := tx.Hash().Hex()
+	logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", signer.chain, send.GetCurrentOutTxParam().OutboundTxTssNonce, outTxHash, myID)
+	//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
+	_, broadcastSpan := tracing.StartCctxSpan(outTx.ctx, tracer, "BroadcastOutbound", send.Index, tracing.AttrOutboundHash.String(outTxHash))
+	defer broadcastSpan.End()
+	backOff := 1000 * time.Millisecond
+	// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
+	for i := 0; i < 5; i++ {
+		logger.Info().Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, i)
+		// #nosec G404 randomness is not a security issue here
+		time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) // FIXME: use backoff
+		err := signer.Broadcast(tx)
+		if err != nil {
+			log.Warn().Err(err).Msgf("OutTx Broadcast error")
+			broadcastSpan.RecordError(err)
+			retry, report := HandleBroadcastError(err, strconv.FormatUint(send.GetCurrentOutTxParam().OutboundTxTssNonce, 10), toChain.String(), outTxHash)
+			if report {
+				zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(toChain.ChainId, tx.Nonce(), outTxHash, nil, "", -1)
+				if err != nil {
+					logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
 				}
-				backOff *= 2
-				continue
+				logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
 			}
-			logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
-			zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(toChain.ChainId, tx.Nonce(), outTxHash, nil, "", -1)
-			if err != nil {
-				logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
+			if !retry {
+				break
 			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
-			break // successful broadcast; no need to retry
+			backOff *= 2
+			continue
 		}
-
+		logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
+		broadcastSpan.SetAttributes(attribute.Bool("broadcast.success", true))
+		zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(toChain.ChainId, tx.Nonce(), outTxHash, nil, "", -1)
+		if err != nil {
+			logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", send.GetCurrentOutTxParam().OutboundTxTssNonce, toChain, outTxHash)
+		}
+		logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+		break // successful broadcast; no need to retry
 	}
 }
 
@@ -606,16 +866,14 @@ func (signer *EVMSigner) SignERC20WithdrawTx(
 	gasLimit uint64,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
-	var data []byte
-	var err error
-	data, err = signer.erc20CustodyABI.Pack("withdraw", recipient, asset, amount)
+	tx, err := signer.newERC20WithdrawTx(recipient, asset, amount, gasLimit, nonce, gasPrice, priorityFee)
 	if err != nil {
-		return nil, fmt.Errorf("pack error: %w", err)
+		return nil, err
 	}
-
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err = signer.signTx(tx, height)
 	if err != nil {
 		return nil, fmt.Errorf("sign error: %w", err)
 	}
@@ -623,6 +881,23 @@ func (signer *EVMSigner) SignERC20WithdrawTx(
 	return tx, nil
 }
 
+// newERC20WithdrawTx returns the unsigned tx of SignERC20WithdrawTx
+func (signer *EVMSigner) newERC20WithdrawTx(
+	recipient ethcommon.Address,
+	asset ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	nonce uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int,
+) (*ethtypes.Transaction, error) {
+	data, err := signer.erc20CustodyABI.Pack("withdraw", recipient, asset, amount)
+	if err != nil {
+		return nil, fmt.Errorf("pack error: %w", err)
+	}
+	return newOutboundTx(signer.chainID, nonce, signer.erc20CustodyContractAddress, big.NewInt(0), gasLimit, gasPrice, priorityFee, data), nil
+}
+
 // SignWhitelistTx
 // function whitelist(
 // address asset,
@@ -637,6 +912,7 @@ func (signer *EVMSigner) SignWhitelistTx(
 	gasLimit uint64,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	var data []byte
@@ -648,7 +924,7 @@ func (signer *EVMSigner) SignWhitelistTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, priorityFee, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -665,3 +941,52 @@ func roundUpToNearestGwei(gasPrice *big.Int) *big.Int {
 	}
 	return new(big.Int).Add(gasPrice, new(big.Int).Sub(oneGwei, mod))
 }
+
+// newOutboundTx returns a dynamic fee tx with the gas price as fee cap if the priority fee is not nil, a legacy tx otherwise
+func newOutboundTx(
+	chainID *big.Int,
+	nonce uint64,
+	to ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int,
+	data []byte,
+) *ethtypes.Transaction {
+	if priorityFee == nil {
+		return ethtypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	}
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: priorityFee,
+		GasFeeCap: dynamicFeeCap(gasPrice, priorityFee),
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     amount,
+		Data:      data,
+	})
+}
+
+// dynamicFeeCap returns the fee cap of a dynamic fee tx, the gas price of an EIP-1559 chain is the base fee plus the
+// priority fee. The cap is twice the base fee plus the priority fee so that the tx stays minable for 6 full blocks
+func dynamicFeeCap(gasPrice *big.Int, priorityFee *big.Int) *big.Int {
+	baseFee := new(big.Int).Sub(gasPrice, priorityFee)
+	return baseFee.Mul(baseFee, big.NewInt(2)).Add(baseFee, priorityFee)
+}
+
+// outboundPriorityFee returns the priority fee of the outbound tx capped to the gas price, nil for a legacy outbound tx
+func outboundPriorityFee(params *types.OutboundTxParams, gasPrice *big.Int) (*big.Int, error) {
+	priorityFee, err := params.GetPriorityFee()
+	if err != nil {
+		return nil, err
+	}
+	if priorityFee == 0 {
+		return nil, nil
+	}
+	tip := new(big.Int).SetUint64(priorityFee)
+	if tip.Cmp(gasPrice) > 0 {
+		tip.Set(gasPrice)
+	}
+	return tip, nil
+}
@@ -0,0 +1,309 @@
+package zetaclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestOutboundPriorityFee(t *testing.T) {
+	gasPrice := big.NewInt(100)
+
+	t.Run("legacy outbound tx", func(t *testing.T) {
+		priorityFee, err := outboundPriorityFee(&types.OutboundTxParams{}, gasPrice)
+		require.NoError(t, err)
+		require.Nil(t, priorityFee)
+	})
+	t.Run("EIP-1559 outbound tx", func(t *testing.T) {
+		priorityFee, err := outboundPriorityFee(&types.OutboundTxParams{OutboundTxGasPriorityFee: "10"}, gasPrice)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(10), priorityFee)
+	})
+	t.Run("priority fee capped to the gas price", func(t *testing.T) {
+		priorityFee, err := outboundPriorityFee(&types.OutboundTxParams{OutboundTxGasPriorityFee: "200"}, gasPrice)
+		require.NoError(t, err)
+		require.Equal(t, gasPrice, priorityFee)
+	})
+	t.Run("invalid priority fee", func(t *testing.T) {
+		_, err := outboundPriorityFee(&types.OutboundTxParams{OutboundTxGasPriorityFee: "invalid"}, gasPrice)
+		require.Error(t, err)
+	})
+}
+
+func TestDynamicFeeCap(t *testing.T) {
+	require.Equal(t, big.NewInt(190), dynamicFeeCap(big.NewInt(100), big.NewInt(10)))
+	require.Equal(t, big.NewInt(10), dynamicFeeCap(big.NewInt(10), big.NewInt(10)))
+	require.Equal(t, big.NewInt(200), dynamicFeeCap(big.NewInt(100), big.NewInt(0)))
+}
+
+func TestEVMSigner_Sign(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tss := TestSigner{PrivKey: privateKey}
+	chain := common.GoerliChain()
+	chainID := big.NewInt(chain.ChainId)
+	signer := &EVMSigner{
+		chain:     &chain,
+		chainID:   chainID,
+		tssSigner: tss,
+		ethSigner: ethtypes.LatestSignerForChainID(chainID),
+		logger:    zerolog.Nop(),
+	}
+	to := ethcommon.HexToAddress("0x236C7f53a90493Bb423411fe4117Cb4c2De71DfB")
+
+	t.Run("sign legacy tx", func(t *testing.T) {
+		tx, _, _, err := signer.Sign([]byte{1}, to, 100_000, big.NewInt(100), nil, 1, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, ethtypes.LegacyTxType, tx.Type())
+		require.Equal(t, big.NewInt(100), tx.GasPrice())
+
+		sender, err := ethtypes.Sender(signer.ethSigner, tx)
+		require.NoError(t, err)
+		require.Equal(t, tss.EVMAddress(), sender)
+	})
+
+	t.Run("sign dynamic fee tx", func(t *testing.T) {
+		tx, _, _, err := signer.Sign([]byte{1}, to, 100_000, big.NewInt(100), big.NewInt(10), 1, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, ethtypes.DynamicFeeTxType, tx.Type())
+		require.Equal(t, big.NewInt(190), tx.GasFeeCap()) // 2 * base fee + priority fee
+		require.Equal(t, big.NewInt(10), tx.GasTipCap())
+		require.Equal(t, chainID, tx.ChainId())
+		require.EqualValues(t, 1, tx.Nonce())
+
+		sender, err := ethtypes.Sender(signer.ethSigner, tx)
+		require.NoError(t, err)
+		require.Equal(t, tss.EVMAddress(), sender)
+	})
+}
+
+// batchTestSigner is a TSS counting the keysigns, failing the batched ones if blamed
+type batchTestSigner struct {
+	TestSigner
+	blamed      bool
+	signs       int
+	batchSigns  int
+	batchDigest int
+}
+
+func (s *batchTestSigner) Sign(digest []byte, height uint64, nonce uint64, chain *common.Chain, pubkey string) ([65]byte, error) {
+	s.signs++
+	return s.TestSigner.Sign(digest, height, nonce, chain, pubkey)
+}
+
+func (s *batchTestSigner) SignBatch(digests [][]byte, height uint64, nonce uint64, chain *common.Chain) ([][65]byte, error) {
+	s.batchSigns++
+	s.batchDigest += len(digests)
+	if s.blamed {
+		return nil, errors.New("keysign fail: blame")
+	}
+	return s.TestSigner.SignBatch(digests, height, nonce, chain)
+}
+
+// batchTestClient is an EVM chain client and rpc client recording the broadcasted txs
+type batchTestClient struct {
+	ChainClient
+	EVMRPCClient
+	broadcasted []*ethtypes.Transaction
+}
+
+func (c *batchTestClient) IsSendOutTxProcessed(string, uint64, common.CoinType, zerolog.Logger) (bool, bool, error) {
+	return false, false, nil
+}
+
+func (c *batchTestClient) SendTransaction(_ context.Context, tx *ethtypes.Transaction) error {
+	c.broadcasted = append(c.broadcasted, tx)
+	return nil
+}
+
+// batchTestBridge is a zetacore bridge recording the outTx trackers
+type batchTestBridge struct {
+	ZetaCoreBridger
+	trackers   map[uint64]string
+	gapReports []*types.MsgReportOutTxNonceGap
+}
+
+func (b *batchTestBridge) GetKeys() *Keys { return &Keys{} }
+
+func (b *batchTestBridge) ZetaChain() common.Chain { return common.ZetaPrivnetChain() }
+
+func (b *batchTestBridge) GetCrosschainFlags() (observertypes.CrosschainFlags, error) {
+	return observertypes.CrosschainFlags{IsInboundEnabled: true, IsOutboundEnabled: true}, nil
+}
+
+func (b *batchTestBridge) GetObserverList(common.Chain) ([]string, error) { return nil, nil }
+
+func (b *batchTestBridge) AddTxHashToOutTxTracker(_ int64, nonce uint64, txHash string, _ *common.Proof, _ string, _ int64) (string, error) {
+	b.trackers[nonce] = txHash
+	return "zetaHash", nil
+}
+
+func (b *batchTestBridge) PostNonceGapReport(
+	chainID int64,
+	nonce uint64,
+	tssNonce uint64,
+	cause types.OutTxNonceGapCause,
+	action types.OutTxNonceGapAction,
+	cancelTxHash string,
+) (string, error) {
+	b.gapReports = append(b.gapReports, types.NewMsgReportOutTxNonceGap("", chainID, nonce, tssNonce, cause, action, cancelTxHash))
+	return "zetaHash", nil
+}
+
+func batchTestCctx(nonce uint64) *types.CrossChainTx {
+	return &types.CrossChainTx{
+		Index:      fmt.Sprintf("0x%064x", nonce),
+		CctxStatus: &types.Status{Status: types.CctxStatus_PendingOutbound},
+		InboundTxParams: &types.InboundTxParams{
+			SenderChainId: common.ZetaPrivnetChain().ChainId,
+			Amount:        sdkmath.NewUint(0),
+		},
+		OutboundTxParams: []*types.OutboundTxParams{{
+			Receiver:           "0x236C7f53a90493Bb423411fe4117Cb4c2De71DfB",
+			ReceiverChainId:    common.GoerliLocalnetChain().ChainId,
+			CoinType:           common.CoinType_Gas,
+			Amount:             sdkmath.NewUint(1000),
+			OutboundTxTssNonce: nonce,
+			OutboundTxGasLimit: 21000,
+			OutboundTxGasPrice: "100",
+		}},
+	}
+}
+
+func TestEVMSigner_TryProcessOutTxBatch(t *testing.T) {
+	for _, blamed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("blamed %t", blamed), func(t *testing.T) {
+			privateKey, err := crypto.GenerateKey()
+			require.NoError(t, err)
+			tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}, blamed: blamed}
+			chain := common.GoerliLocalnetChain()
+			chainID := big.NewInt(chain.ChainId)
+			client := &batchTestClient{}
+			signer := &EVMSigner{
+				client:    client,
+				chain:     &chain,
+				chainID:   chainID,
+				tssSigner: tss,
+				ethSigner: ethtypes.LatestSignerForChainID(chainID),
+				logger:    zerolog.Nop(),
+			}
+			bridge := &batchTestBridge{trackers: make(map[uint64]string)}
+
+			outTxMan := NewOutTxProcessorManager(zerolog.Nop())
+			var cctxs []*types.CrossChainTx
+			var outTxIDs []string
+			for _, nonce := range []uint64{7, 5, 6} {
+				cctx := batchTestCctx(nonce)
+				outTxID := ToOutTxID(cctx.Index, chain.ChainId, nonce)
+				outTxMan.StartTryProcess(outTxID)
+				cctxs = append(cctxs, cctx)
+				outTxIDs = append(outTxIDs, outTxID)
+			}
+			signer.TryProcessOutTxBatch(cctxs, outTxMan, outTxIDs, client, bridge, 10)
+
+			// a single batched keysign, the txs are signed one by one if it fails
+			require.Equal(t, 1, tss.batchSigns)
+			require.Equal(t, 3, tss.batchDigest)
+			if blamed {
+				require.Equal(t, 3, tss.signs)
+			} else {
+				require.Zero(t, tss.signs)
+			}
+
+			// the txs are broadcasted in nonce order and added to the trackers
+			require.Len(t, client.broadcasted, 3)
+			for i, tx := range client.broadcasted {
+				require.EqualValues(t, 5+i, tx.Nonce())
+				sender, err := ethtypes.Sender(signer.ethSigner, tx)
+				require.NoError(t, err)
+				require.Equal(t, tss.EVMAddress(), sender)
+				require.Equal(t, tx.Hash().Hex(), bridge.trackers[tx.Nonce()])
+			}
+			for _, outTxID := range outTxIDs {
+				require.False(t, outTxMan.IsOutTxActive(outTxID))
+			}
+		})
+	}
+}
+
+func TestCancelTxGasPrice(t *testing.T) {
+	t.Run("legacy outbound tx", func(t *testing.T) {
+		gasPrice, priorityFee, err := cancelTxGasPrice(&types.OutboundTxParams{OutboundTxGasPrice: "100"})
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(111), gasPrice)
+		require.Nil(t, priorityFee)
+	})
+	t.Run("EIP-1559 outbound tx", func(t *testing.T) {
+		gasPrice, priorityFee, err := cancelTxGasPrice(&types.OutboundTxParams{OutboundTxGasPrice: "100", OutboundTxGasPriorityFee: "10"})
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(112), gasPrice) // base fee 90 bumped to 100
+		require.Equal(t, big.NewInt(12), priorityFee)
+
+		// the fee cap of the cancel tx is at least 10% above the fee cap of the outbound tx
+		feeCap := dynamicFeeCap(big.NewInt(100), big.NewInt(10))
+		cancelFeeCap := dynamicFeeCap(gasPrice, priorityFee)
+		require.True(t, cancelFeeCap.Cmp(bumpGasPrice(feeCap)) >= 0, "fee cap %s, cancel fee cap %s", feeCap, cancelFeeCap)
+	})
+	t.Run("invalid gas price", func(t *testing.T) {
+		_, _, err := cancelTxGasPrice(&types.OutboundTxParams{OutboundTxGasPrice: ""})
+		require.Error(t, err)
+	})
+}
+
+func TestEVMSigner_TryCancelOutTx(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tss := &batchTestSigner{TestSigner: TestSigner{PrivKey: privateKey}}
+	chain := common.GoerliLocalnetChain()
+	chainID := big.NewInt(chain.ChainId)
+	client := &batchTestClient{}
+	signer := &EVMSigner{
+		client:    client,
+		chain:     &chain,
+		chainID:   chainID,
+		tssSigner: tss,
+		ethSigner: ethtypes.LatestSignerForChainID(chainID),
+		logger:    zerolog.Nop(),
+	}
+	bridge := &batchTestBridge{trackers: make(map[uint64]string)}
+	outTxMan := NewOutTxProcessorManager(zerolog.Nop())
+	cctx := batchTestCctx(5)
+	outTxID := ToOutTxID(cctx.Index, chain.ChainId, 5)
+	gap := NonceGap{Nonce: 5, TssNonce: 5, Cause: types.OutTxNonceGapCause_NotMined}
+
+	for i := 0; i < 2; i++ {
+		outTxMan.StartTryProcess(outTxID)
+		signer.TryCancelOutTx(cctx, outTxMan, outTxID, gap, bridge, 10)
+		require.False(t, outTxMan.IsOutTxActive(outTxID))
+	}
+
+	// the cancel tx is an empty transfer to the TSS address outbidding the outbound tx
+	require.Len(t, client.broadcasted, 2)
+	tx := client.broadcasted[0]
+	require.EqualValues(t, 5, tx.Nonce())
+	require.Equal(t, tss.EVMAddress(), *tx.To())
+	require.Zero(t, tx.Value().Sign())
+	require.Equal(t, big.NewInt(111), tx.GasPrice())
+	sender, err := ethtypes.Sender(signer.ethSigner, tx)
+	require.NoError(t, err)
+	require.Equal(t, tss.EVMAddress(), sender)
+	require.Equal(t, tx.Hash().Hex(), bridge.trackers[5])
+
+	// the cancellation is reported once
+	require.Len(t, bridge.gapReports, 1)
+	require.Equal(t, types.OutTxNonceGapAction_Cancelled, bridge.gapReports[0].Action)
+	require.Equal(t, types.OutTxNonceGapCause_NotMined, bridge.gapReports[0].Cause)
+	require.Equal(t, tx.Hash().Hex(), bridge.gapReports[0].CancelTxHash)
+}
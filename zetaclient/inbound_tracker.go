@@ -63,6 +63,9 @@ func (ob *BitcoinChainClient) ExternalChainWatcherForNewInboundTrackerSuggestion
 }
 
 func (ob *BitcoinChainClient) ObserveTrackerSuggestions() error {
+	if _, paused := ob.IsInboundPaused(); paused {
+		return nil
+	}
 	trackers, err := ob.zetaClient.GetInboundTrackersForChain(ob.chain.ChainId)
 	if err != nil {
 		return err
@@ -111,6 +114,7 @@ func (ob *BitcoinChainClient) CheckReceiptForBtcTxHash(txHash string, vote bool)
 	if !vote {
 		return msg.Digest(), nil
 	}
+	traceInboundObserved(msg, InboundSourceTracker)
 	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
 	if err != nil {
 		ob.logger.WatchInTx.Error().Err(err).Msg("error posting to zeta core")
@@ -121,6 +125,9 @@ func (ob *BitcoinChainClient) CheckReceiptForBtcTxHash(txHash string, vote bool)
 }
 
 func (ob *EVMChainClient) ObserveTrackerSuggestions() error {
+	if _, paused := ob.IsInboundPaused(); paused {
+		return nil
+	}
 	trackers, err := ob.zetaClient.GetInboundTrackersForChain(ob.chain.ChainId)
 	if err != nil {
 		return err
@@ -163,19 +170,25 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeZeta(txHash string, vote bool)
 	}
 
 	var msg types.MsgVoteOnObservedInboundTx
+	found := false
 	for _, log := range receipt.Logs {
 		event, err := connector.ParseZetaSent(*log)
 		if err == nil && event != nil {
 			msg, err = ob.GetInboundVoteMsgForZetaSentEvent(event)
 			if err == nil {
+				found = true
 				break
 			}
 		}
 	}
+	if !found {
+		return "", errors.New("no ZetaSent event found")
+	}
 	if !vote {
 		return msg.Digest(), nil
 	}
 
+	traceInboundObserved(&msg, InboundSourceTracker)
 	zetaHash, err := ob.zetaClient.PostSend(PostSendNonEVMGasLimit, &msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
@@ -197,19 +210,25 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeERC20(txHash string, vote bool)
 		return "", err
 	}
 	var msg types.MsgVoteOnObservedInboundTx
+	found := false
 	for _, log := range receipt.Logs {
 		zetaDeposited, err := custody.ParseDeposited(*log)
 		if err == nil && zetaDeposited != nil {
 			msg, err = ob.GetInboundVoteMsgForDepositedEvent(zetaDeposited)
 			if err == nil {
+				found = true
 				break
 			}
 		}
 	}
+	if !found {
+		return "", errors.New("no Deposited event found")
+	}
 	if !vote {
 		return msg.Digest(), nil
 	}
 
+	traceInboundObserved(&msg, InboundSourceTracker)
 	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, &msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
@@ -255,10 +274,14 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeGas(txHash string, vote bool) (
 		}
 	}
 	msg := ob.GetInboundVoteMsgForTokenSentToTSS(tx.Hash(), tx.Value(), receipt, from, tx.Data())
+	if msg == nil {
+		return "", errors.New("no gas deposit to the TSS address found")
+	}
 	if !vote {
 		return msg.Digest(), nil
 	}
 
+	traceInboundObserved(msg, InboundSourceTracker)
 	zetaHash, err := ob.zetaClient.PostSend(PostSendEVMGasLimit, msg)
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("error posting to zeta core")
@@ -268,3 +291,25 @@ func (ob *EVMChainClient) CheckReceiptForCoinTypeGas(txHash string, vote bool) (
 
 	return msg.Digest(), nil
 }
+
+// VoteInboundTx observes the inbound tx with the hash and coin type and posts its vote, it returns the ballot identifier
+func (ob *EVMChainClient) VoteInboundTx(txHash string, coinType common.CoinType) (string, error) {
+	switch coinType {
+	case common.CoinType_Zeta:
+		return ob.CheckReceiptForCoinTypeZeta(txHash, true)
+	case common.CoinType_ERC20:
+		return ob.CheckReceiptForCoinTypeERC20(txHash, true)
+	case common.CoinType_Gas:
+		return ob.CheckReceiptForCoinTypeGas(txHash, true)
+	default:
+		return "", fmt.Errorf("unsupported coin type %s", coinType)
+	}
+}
+
+// VoteInboundTx observes the inbound tx with the hash and posts its vote, it returns the ballot identifier
+func (ob *BitcoinChainClient) VoteInboundTx(txHash string, coinType common.CoinType) (string, error) {
+	if coinType != common.CoinType_Gas {
+		return "", fmt.Errorf("unsupported coin type %s", coinType)
+	}
+	return ob.CheckReceiptForBtcTxHash(txHash, true)
+}
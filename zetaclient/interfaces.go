@@ -18,6 +18,7 @@ import (
 	"github.com/zeta-chain/go-tss/blame"
 	"github.com/zeta-chain/zetacore/common"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -32,6 +33,12 @@ type ChainClient interface {
 	GetPromCounter(name string) (prometheus.Counter, error)
 	GetTxID(nonce uint64) string
 	ExternalChainWatcherForNewInboundTrackerSuggestions()
+	PauseInbound(reason string)
+	UnpauseInbound()
+	IsInboundPaused() (string, bool)
+	RescanFrom(height uint64) error
+	RescanInTx(startBlock, endBlock uint64, vote bool) (RescanResult, error)
+	VoteInboundTx(txHash string, coinType common.CoinType) (string, error)
 }
 
 // ChainSigner is the interface to sign transactions for a chain
@@ -46,6 +53,38 @@ type ChainSigner interface {
 	)
 }
 
+// BatchChainSigner is the interface to sign the transactions of several cctxs of a chain in a single keysign
+type BatchChainSigner interface {
+	ChainSigner
+	TryProcessOutTxBatch(
+		cctxs []*crosschaintypes.CrossChainTx,
+		outTxMan *OutTxProcessorManager,
+		outTxIDs []string,
+		chainClient ChainClient,
+		zetaBridge ZetaCoreBridger,
+		height uint64,
+	)
+}
+
+// CancelChainSigner is the interface to cancel the outbound tx of a cctx blocking the later nonces of a chain
+type CancelChainSigner interface {
+	ChainSigner
+	TryCancelOutTx(
+		send *crosschaintypes.CrossChainTx,
+		outTxMan *OutTxProcessorManager,
+		outTxID string,
+		gap NonceGap,
+		zetaBridge ZetaCoreBridger,
+		height uint64,
+	)
+}
+
+// TssNonceChainClient is the interface for chain clients able to query the nonce of the TSS account
+type TssNonceChainClient interface {
+	ChainClient
+	GetTssNonce() (uint64, error)
+}
+
 // ZetaCoreBridger is the interface to interact with ZetaCore
 type ZetaCoreBridger interface {
 	PostSend(zetaGasLimit uint64, msg *crosschaintypes.MsgVoteOnObservedInboundTx) (string, error)
@@ -62,9 +101,10 @@ type ZetaCoreBridger interface {
 		nonce uint64,
 		coinType common.CoinType,
 	) (string, string, error)
-	PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error)
+	PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error)
 	PostAddBlockHeader(chainID int64, txhash []byte, height int64, header common.HeaderData) (string, error)
 	GetBlockHeaderStateByChain(chainID int64) (observertypes.QueryGetBlockHeaderStateResponse, error)
+	GetBlockHeaderByHash(blockHash []byte) (*common.BlockHeader, error)
 
 	PostBlameData(blame *blame.Blame, chainID int64, index string) (string, error)
 	AddTxHashToOutTxTracker(
@@ -75,6 +115,14 @@ type ZetaCoreBridger interface {
 		blockHash string,
 		txIndex int64,
 	) (string, error)
+	PostNonceGapReport(
+		chainID int64,
+		nonce uint64,
+		tssNonce uint64,
+		cause crosschaintypes.OutTxNonceGapCause,
+		action crosschaintypes.OutTxNonceGapAction,
+		cancelTxHash string,
+	) (string, error)
 	GetKeys() *Keys
 	GetBlockHeight() (int64, error)
 	GetZetaBlockHeight() (int64, error)
@@ -88,6 +136,9 @@ type ZetaCoreBridger interface {
 	GetKeyGen() (*observertypes.Keygen, error)
 	GetBtcTssAddress() (string, error)
 	GetInboundTrackersForChain(chainID int64) ([]crosschaintypes.InTxTracker, error)
+	GetBallotByID(id string) (*observertypes.QueryBallotByIdentifierResponse, error)
+	GetInTxHashToCctx(inTxHash string) (crosschaintypes.InTxHashToCctx, error)
+	GetForeignCoins() ([]fungibletypes.ForeignCoins, error)
 	GetLogger() *zerolog.Logger
 	ZetaChain() common.Chain
 	Pause()
@@ -121,6 +172,7 @@ type EVMRPCClient interface {
 	TransactionByHash(ctx context.Context, hash ethcommon.Hash) (tx *ethtypes.Transaction, isPending bool, err error)
 	TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error)
 	TransactionSender(ctx context.Context, tx *ethtypes.Transaction, block ethcommon.Hash, index uint) (ethcommon.Address, error)
+	NonceAt(ctx context.Context, account ethcommon.Address, blockNumber *big.Int) (uint64, error)
 }
 
 // KlaytnRPCClient is the interface for Klaytn RPC client
@@ -2,16 +2,20 @@ package zetaclient
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
@@ -155,6 +159,27 @@ func (k *Keys) GetPrivateKey() (cryptotypes.PrivKey, error) {
 	return priKey, nil
 }
 
+// HasPrivateKey returns true if the private key of the hot key is stored in the local keyring
+func (k *Keys) HasPrivateKey() bool {
+	return k.GetSignerInfo().GetLocal() != nil
+}
+
+// LoadP2PKey loads the hex encoded secp256k1 private key of the TSS p2p identity from the file
+func LoadP2PKey(path string) (cryptotypes.PrivKey, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("fail to read the p2p key file: %w", err)
+	}
+	bz, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode the p2p key: %w", err)
+	}
+	if len(bz) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("p2p key bytes len %d != %d", len(bz), secp256k1.PrivKeySize)
+	}
+	return &secp256k1.PrivKey{Key: bz}, nil
+}
+
 // GetKeybase return the keybase
 func (k *Keys) GetKeybase() ckeys.Keyring {
 	return k.kb
@@ -170,6 +195,15 @@ func (k *Keys) GetPubKeySet() (common.PubKeySet, error) {
 	if err != nil {
 		return pubkeySet, err
 	}
+	return GetPubKeySetFromPrivKey(pK)
+}
+
+// GetPubKeySetFromPrivKey returns the pub key set of the secp256k1 private key
+func GetPubKeySetFromPrivKey(pK cryptotypes.PrivKey) (common.PubKeySet, error) {
+	pubkeySet := common.PubKeySet{
+		Secp256k1: "",
+		Ed25519:   "",
+	}
 
 	s, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pK.PubKey())
 	if err != nil {
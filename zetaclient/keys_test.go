@@ -2,6 +2,7 @@ package zetaclient
 
 import (
 	"bytes"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
@@ -123,3 +125,42 @@ func (ks *KeysSuite) TestNewKeys(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(pubKey.VerifySignature([]byte(msg), signedMsg), Equals, true)
 }
+
+func (ks *KeysSuite) TestHasPrivateKey(c *C) {
+	folder := ks.setupKeysForTest(c)
+	defer func() {
+		err := os.RemoveAll(folder)
+		c.Assert(err, IsNil)
+	}()
+
+	k, _, err := GetKeyringKeybase(&config.Config{
+		AuthzHotkey:  signerNameForTest,
+		ZetaCoreHome: folder,
+	})
+	c.Assert(err, IsNil)
+	granter := cosmos.AccAddress(crypto.AddressHash([]byte("granter")))
+	c.Assert(NewKeysWithKeybase(k, granter, signerNameForTest).HasPrivateKey(), Equals, true)
+
+	// only the public key of the hot key is in the keyring of a remote signer setup
+	_, err = k.SaveOfflineKey(GetGranteeKeyName("remote"), secp256k1.GenPrivKey().PubKey())
+	c.Assert(err, IsNil)
+	c.Assert(NewKeysWithKeybase(k, granter, "remote").HasPrivateKey(), Equals, false)
+}
+
+func (ks *KeysSuite) TestLoadP2PKey(c *C) {
+	folder := c.MkDir()
+	priKey := secp256k1.GenPrivKey()
+
+	path := filepath.Join(folder, "p2p_key")
+	c.Assert(os.WriteFile(path, []byte(hex.EncodeToString(priKey.Bytes())+"\n"), 0600), IsNil)
+	p2pKey, err := LoadP2PKey(path)
+	c.Assert(err, IsNil)
+	c.Assert(p2pKey.Equals(priKey), Equals, true)
+
+	c.Assert(os.WriteFile(path, []byte(hex.EncodeToString(priKey.Bytes()[:16])), 0600), IsNil)
+	_, err = LoadP2PKey(path)
+	c.Assert(err, NotNil)
+
+	_, err = LoadP2PKey(filepath.Join(folder, "missing"))
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,101 @@
+package zetaclient
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// moduleField is the prefix of the module field in the JSON encoded log events
+var moduleField = []byte(`"module":"`)
+
+// ModuleLevelWriter is a zerolog writer filtering the log events with the level of their module
+// The level of a module can be changed at runtime, the modules without a level use the default level
+// The logger writing to it must be at the lowest level so that the level of a module can be lowered
+type ModuleLevelWriter struct {
+	out          io.Writer
+	mu           sync.RWMutex
+	defaultLevel zerolog.Level
+	moduleLevels map[string]zerolog.Level
+}
+
+var _ zerolog.LevelWriter = (*ModuleLevelWriter)(nil)
+
+// NewModuleLevelWriter returns a writer to out filtering the log events below the default level
+func NewModuleLevelWriter(out io.Writer, defaultLevel zerolog.Level) *ModuleLevelWriter {
+	return &ModuleLevelWriter{
+		out:          out,
+		defaultLevel: defaultLevel,
+		moduleLevels: make(map[string]zerolog.Level),
+	}
+}
+
+// Write writes the events without level
+func (w *ModuleLevelWriter) Write(p []byte) (int, error) {
+	return w.out.Write(p)
+}
+
+// WriteLevel writes the event if its level is not below the level of its module
+func (w *ModuleLevelWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if level < w.levelOf(p) {
+		return len(p), nil
+	}
+	return w.out.Write(p)
+}
+
+// SetDefaultLevel sets the level of the modules without level
+func (w *ModuleLevelWriter) SetDefaultLevel(level zerolog.Level) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.defaultLevel = level
+}
+
+// SetModuleLevel sets the level of the module
+func (w *ModuleLevelWriter) SetModuleLevel(module string, level zerolog.Level) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.moduleLevels[module] = level
+}
+
+// ResetModuleLevel sets the level of the module back to the default level
+func (w *ModuleLevelWriter) ResetModuleLevel(module string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.moduleLevels, module)
+}
+
+// Levels returns the default level and the levels of the modules
+func (w *ModuleLevelWriter) Levels() (zerolog.Level, map[string]zerolog.Level) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	moduleLevels := make(map[string]zerolog.Level, len(w.moduleLevels))
+	for module, level := range w.moduleLevels {
+		moduleLevels[module] = level
+	}
+	return w.defaultLevel, moduleLevels
+}
+
+// levelOf returns the level of the module of the event
+// The last module field is used if the module is set more than once, as when the event is decoded
+func (w *ModuleLevelWriter) levelOf(p []byte) zerolog.Level {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if len(w.moduleLevels) == 0 {
+		return w.defaultLevel
+	}
+	i := bytes.LastIndex(p, moduleField)
+	if i < 0 {
+		return w.defaultLevel
+	}
+	module := p[i+len(moduleField):]
+	end := bytes.IndexByte(module, '"')
+	if end < 0 {
+		return w.defaultLevel
+	}
+	if level, found := w.moduleLevels[string(module[:end])]; found {
+		return level
+	}
+	return w.defaultLevel
+}
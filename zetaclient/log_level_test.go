@@ -0,0 +1,66 @@
+package zetaclient_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+func TestModuleLevelWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zetaclient.NewModuleLevelWriter(&buf, zerolog.InfoLevel)
+	logger := zerolog.New(writer).Level(zerolog.TraceLevel)
+	outTxLogger := logger.With().Str("module", "ObserveOutTx").Logger()
+	gasLogger := logger.With().Str("module", "WatchGasPrice").Logger()
+
+	logLines := func() []string {
+		defer buf.Reset()
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) == 1 && lines[0] == "" {
+			return nil
+		}
+		return lines
+	}
+
+	// the default level is used without module level
+	logger.Debug().Msg("debug")
+	outTxLogger.Debug().Msg("debug")
+	outTxLogger.Info().Msg("info")
+	require.Len(t, logLines(), 1)
+
+	// the level of a module can be lowered or raised
+	writer.SetModuleLevel("ObserveOutTx", zerolog.DebugLevel)
+	writer.SetModuleLevel("WatchGasPrice", zerolog.ErrorLevel)
+	outTxLogger.Debug().Msg("debug")
+	gasLogger.Info().Msg("info")
+	gasLogger.Error().Msg("error")
+	logger.Debug().Msg("debug")
+	lines := logLines()
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"module":"ObserveOutTx"`)
+	require.Contains(t, lines[1], `"level":"error"`)
+
+	// the last module of the event is used
+	outTxLogger.Debug().Str("module", "WatchGasPrice").Msg("debug")
+	require.Empty(t, logLines())
+
+	// the module level is not affected by the default level
+	writer.SetDefaultLevel(zerolog.WarnLevel)
+	outTxLogger.Debug().Msg("debug")
+	logger.Info().Msg("info")
+	require.Len(t, logLines(), 1)
+
+	// the module level can be reset
+	writer.ResetModuleLevel("ObserveOutTx")
+	outTxLogger.Debug().Msg("debug")
+	outTxLogger.Warn().Msg("warn")
+	require.Len(t, logLines(), 1)
+
+	defaultLevel, moduleLevels := writer.Levels()
+	require.Equal(t, zerolog.WarnLevel, defaultLevel)
+	require.Equal(t, map[string]zerolog.Level{"WatchGasPrice": zerolog.ErrorLevel}, moduleLevels)
+}
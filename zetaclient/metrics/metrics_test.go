@@ -34,3 +34,11 @@ func (ms *MetricsSuite) TestMetrics(c *C) {
 	//out, err := ioutil.ReadAll(res.Body)
 	//fmt.Println(string(out))
 }
+
+func (ms *MetricsSuite) TestGaugeVec(c *C) {
+	err := ms.m.RegisterGaugeVec("gaugevec1", "help to gaugevec1", []string{"chain_id"})
+	c.Assert(err, IsNil)
+	err = ms.m.RegisterGaugeVec("gaugevec1", "help to gaugevec1", []string{"chain_id"})
+	c.Assert(err, NotNil)
+	GaugeVecs["gaugevec1"].WithLabelValues("1").Set(42)
+}
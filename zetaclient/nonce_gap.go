@@ -0,0 +1,106 @@
+package zetaclient
+
+import (
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+const (
+	// NonceGapInterval is the keysign interval, in zeta blocks, of the nonce blocking an EVM chain
+	NonceGapInterval = 3
+
+	// NonceGapLookahead is the number of nonces above the blocking nonce looked up in the trackers
+	NonceGapLookahead = 100
+)
+
+// NonceGap is an outbound nonce of an EVM chain that blocks the later nonces of the TSS account
+type NonceGap struct {
+	// Nonce is the blocking nonce, the lowest nonce not mined on the chain
+	Nonce uint64
+
+	// TssNonce is the nonce of the TSS account on the chain
+	TssNonce uint64
+
+	// Cause tells whether the outbound tx of the blocking nonce was broadcast
+	Cause types.OutTxNonceGapCause
+}
+
+// DetectNonceGap compares the pending nonces of a chain, the nonce of the TSS account on the chain and the outTx trackers
+// It returns the blocking nonce if an outbound tx of a later nonce is waiting for it, nil otherwise
+func DetectNonceGap(pendingNonces observertypes.PendingNonces, tssNonce uint64, trackers []types.OutTxTracker) *NonceGap {
+	if pendingNonces.NonceLow < 0 || pendingNonces.NonceHigh <= pendingNonces.NonceLow {
+		return nil
+	}
+	// #nosec G701 always positive
+	nonceLow, nonceHigh := uint64(pendingNonces.NonceLow), uint64(pendingNonces.NonceHigh)
+
+	// the nonces below the TSS nonce are mined and wait for the observation
+	// a TSS nonce below the pending nonces is a lagging rpc, there is nothing to heal
+	if tssNonce < nonceLow || tssNonce >= nonceHigh {
+		return nil
+	}
+
+	blockingTracked, laterTracked := false, false
+	for _, tracker := range trackers {
+		switch {
+		case tracker.Nonce == tssNonce:
+			blockingTracked = len(tracker.HashList) > 0
+		case tracker.Nonce > tssNonce && tracker.Nonce <= tssNonce+NonceGapLookahead && tracker.Nonce < nonceHigh:
+			laterTracked = laterTracked || len(tracker.HashList) > 0
+		}
+	}
+	if !laterTracked {
+		return nil
+	}
+
+	cause := types.OutTxNonceGapCause_NotBroadcast
+	if blockingTracked {
+		cause = types.OutTxNonceGapCause_NotMined
+	}
+	return &NonceGap{
+		Nonce:    tssNonce,
+		TssNonce: tssNonce,
+		Cause:    cause,
+	}
+}
+
+// checkNonceGap returns the nonce blocking an EVM chain, nil if there is none or if it cannot be determined
+func (co *CoreObserver) checkNonceGap(chainID int64, trackers []types.OutTxTracker, ob ChainClient) *NonceGap {
+	client, ok := ob.(TssNonceChainClient)
+	if !ok {
+		return nil
+	}
+	pendingNonces, err := co.bridge.GetPendingNoncesByChain(chainID)
+	if err != nil {
+		co.logger.ZetaChainWatcher.Warn().Err(err).Msgf("checkNonceGap: GetPendingNoncesByChain failed for chain %d", chainID)
+		return nil
+	}
+	tssNonce, err := client.GetTssNonce()
+	if err != nil {
+		co.logger.ZetaChainWatcher.Warn().Err(err).Msgf("checkNonceGap: GetTssNonce failed for chain %d", chainID)
+		return nil
+	}
+
+	gap := DetectNonceGap(pendingNonces, tssNonce, trackers)
+	if gap == nil {
+		delete(co.nonceGaps, chainID)
+		return nil
+	}
+	if reported, found := co.nonceGaps[chainID]; !found || reported != *gap {
+		co.logger.ZetaChainWatcher.Warn().Msgf("checkNonceGap: nonce %d blocks chain %d, cause %s; pending nonces [%d, %d)",
+			gap.Nonce, chainID, gap.Cause, pendingNonces.NonceLow, pendingNonces.NonceHigh)
+		co.nonceGaps[chainID] = *gap
+		go co.reportNonceGap(chainID, *gap)
+	}
+	return gap
+}
+
+// reportNonceGap reports on zetacore that the blocking nonce of a chain is re-prioritized
+func (co *CoreObserver) reportNonceGap(chainID int64, gap NonceGap) {
+	zetaHash, err := co.bridge.PostNonceGapReport(chainID, gap.Nonce, gap.TssNonce, gap.Cause, types.OutTxNonceGapAction_Reprioritized, "")
+	if err != nil {
+		co.logger.ZetaChainWatcher.Error().Err(err).Msgf("reportNonceGap: PostNonceGapReport failed for chain %d nonce %d", chainID, gap.Nonce)
+		return
+	}
+	co.logger.ZetaChainWatcher.Info().Msgf("reportNonceGap: reported nonce %d of chain %d, zeta tx %s", gap.Nonce, chainID, zetaHash)
+}
@@ -0,0 +1,89 @@
+package zetaclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func nonceGapTestTrackers(nonces ...uint64) []types.OutTxTracker {
+	trackers := make([]types.OutTxTracker, len(nonces))
+	for i, nonce := range nonces {
+		trackers[i] = types.OutTxTracker{
+			Nonce:    nonce,
+			HashList: []*types.TxHashList{{TxHash: "0x1234"}},
+		}
+	}
+	return trackers
+}
+
+func TestDetectNonceGap(t *testing.T) {
+	pendingNonces := observertypes.PendingNonces{NonceLow: 10, NonceHigh: 20}
+
+	tests := []struct {
+		name          string
+		pendingNonces observertypes.PendingNonces
+		tssNonce      uint64
+		trackers      []types.OutTxTracker
+		gap           *NonceGap
+	}{
+		{
+			name:          "no pending nonce",
+			pendingNonces: observertypes.PendingNonces{NonceLow: 10, NonceHigh: 10},
+			tssNonce:      10,
+			trackers:      nonceGapTestTrackers(11),
+		},
+		{
+			name:          "no later nonce broadcast",
+			pendingNonces: pendingNonces,
+			tssNonce:      10,
+			trackers:      nonceGapTestTrackers(10),
+		},
+		{
+			name:          "blocking nonce not broadcast",
+			pendingNonces: pendingNonces,
+			tssNonce:      10,
+			trackers:      nonceGapTestTrackers(11, 12),
+			gap:           &NonceGap{Nonce: 10, TssNonce: 10, Cause: types.OutTxNonceGapCause_NotBroadcast},
+		},
+		{
+			name:          "blocking nonce not mined",
+			pendingNonces: pendingNonces,
+			tssNonce:      10,
+			trackers:      nonceGapTestTrackers(10, 11, 12),
+			gap:           &NonceGap{Nonce: 10, TssNonce: 10, Cause: types.OutTxNonceGapCause_NotMined},
+		},
+		{
+			name:          "lower nonces mined but not observed",
+			pendingNonces: pendingNonces,
+			tssNonce:      13,
+			trackers:      nonceGapTestTrackers(10, 11, 12, 15),
+			gap:           &NonceGap{Nonce: 13, TssNonce: 13, Cause: types.OutTxNonceGapCause_NotBroadcast},
+		},
+		{
+			name:          "lagging rpc",
+			pendingNonces: pendingNonces,
+			tssNonce:      8,
+			trackers:      nonceGapTestTrackers(10, 11),
+		},
+		{
+			name:          "all pending nonces mined",
+			pendingNonces: pendingNonces,
+			tssNonce:      20,
+			trackers:      nonceGapTestTrackers(18, 19),
+		},
+		{
+			name:          "tracker without hash",
+			pendingNonces: pendingNonces,
+			tssNonce:      10,
+			trackers:      []types.OutTxTracker{{Nonce: 11}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.gap, DetectNonceGap(tt.pendingNonces, tt.tssNonce, tt.trackers))
+		})
+	}
+}
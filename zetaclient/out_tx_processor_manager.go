@@ -2,6 +2,7 @@ package zetaclient
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -62,6 +63,32 @@ func (outTxMan *OutTxProcessorManager) TimeInTryProcess(outTxID string) time.Dur
 	return 0
 }
 
+// OutTxProcess is an outtx being processed by the OutTxProcessorManager
+type OutTxProcess struct {
+	OutTxID   string    `json:"outtx_id"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// ActiveOutTxs returns the outtxs being processed, the oldest first
+func (outTxMan *OutTxProcessorManager) ActiveOutTxs() []OutTxProcess {
+	outTxMan.mu.Lock()
+	defer outTxMan.mu.Unlock()
+	active := make([]OutTxProcess, 0, len(outTxMan.outTxActive))
+	for outTxID := range outTxMan.outTxActive {
+		active = append(active, OutTxProcess{
+			OutTxID:   outTxID,
+			StartTime: outTxMan.outTxStartTime[outTxID],
+		})
+	}
+	sort.Slice(active, func(i, j int) bool {
+		if active[i].StartTime.Equal(active[j].StartTime) {
+			return active[i].OutTxID < active[j].OutTxID
+		}
+		return active[i].StartTime.Before(active[j].StartTime)
+	})
+	return active
+}
+
 // ToOutTxID returns the outTxID for OutTxProcessorManager to track
 func ToOutTxID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
@@ -18,6 +18,7 @@ import (
 	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"google.golang.org/grpc"
 )
@@ -100,6 +101,16 @@ func (b *ZetaCoreBridge) GetCctxByHash(sendHash string) (*types.CrossChainTx, er
 	return resp.CrossChainTx, nil
 }
 
+// GetInTxHashToCctx returns the indexes of the cctxs created by the in tx hash
+func (b *ZetaCoreBridge) GetInTxHashToCctx(inTxHash string) (types.InTxHashToCctx, error) {
+	client := types.NewQueryClient(b.grpcConn)
+	resp, err := client.InTxHashToCctx(context.Background(), &types.QueryGetInTxHashToCctxRequest{InTxHash: inTxHash})
+	if err != nil {
+		return types.InTxHashToCctx{}, err
+	}
+	return resp.InTxHashToCctx, nil
+}
+
 func (b *ZetaCoreBridge) GetCctxByNonce(chainID int64, nonce uint64) (*types.CrossChainTx, error) {
 	client := types.NewQueryClient(b.grpcConn)
 	resp, err := client.CctxByNonce(context.Background(), &types.QueryGetCctxByNonceRequest{
@@ -175,6 +186,30 @@ func (b *ZetaCoreBridge) GetZetaTokenSupplyOnNode() (sdkmath.Int, error) {
 	return resp.GetAmount().Amount, nil
 }
 
+// GetForeignCoins returns the foreign coins registered on ZetaChain
+func (b *ZetaCoreBridge) GetForeignCoins() ([]fungibletypes.ForeignCoins, error) {
+	client := fungibletypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ForeignCoinsAll(context.Background(), &fungibletypes.QueryAllForeignCoinsRequest{
+		Pagination: &query.PageRequest{
+			Limit: 2000,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ForeignCoins, nil
+}
+
+// GetZRC20Supplies returns the foreign coins registered on ZetaChain with the total supply of their ZRC20
+func (b *ZetaCoreBridge) GetZRC20Supplies() ([]fungibletypes.QueryAllZRC20SupplyResponse_Supply, error) {
+	client := fungibletypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ZRC20SupplyAll(context.Background(), &fungibletypes.QueryAllZRC20SupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Supplies, nil
+}
+
 func (b *ZetaCoreBridge) GetLastBlockHeight() ([]*types.LastBlockHeight, error) {
 	client := types.NewQueryClient(b.grpcConn)
 	resp, err := client.LastBlockHeightAll(context.Background(), &types.QueryAllLastBlockHeightRequest{})
@@ -402,6 +437,15 @@ func (b *ZetaCoreBridge) GetBlockHeaderStateByChain(chainID int64) (observertype
 	return *resp, nil
 }
 
+func (b *ZetaCoreBridge) GetBlockHeaderByHash(blockHash []byte) (*common.BlockHeader, error) {
+	client := observertypes.NewQueryClient(b.grpcConn)
+	resp, err := client.GetBlockHeaderByHash(context.Background(), &observertypes.QueryGetBlockHeaderByHashRequest{BlockHash: blockHash})
+	if err != nil {
+		return nil, err
+	}
+	return resp.BlockHeader, nil
+}
+
 func (b *ZetaCoreBridge) GetSupportedChains() ([]*common.Chain, error) {
 	client := observertypes.NewQueryClient(b.grpcConn)
 	resp, err := client.SupportedChains(context.Background(), &observertypes.QuerySupportedChains{})
@@ -0,0 +1,106 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc"
+)
+
+// requestTimeout is the timeout of the requests to the remote signer
+const requestTimeout = 10 * time.Second
+
+// Client requests the signatures of the txs of the hot key to a remote signer
+type Client struct {
+	conn   *grpc.ClientConn
+	client RemoteSignerClient
+	pubKey cryptotypes.PubKey
+}
+
+// NewClient connects to the remote signer at the address, either host:port or unix:///path, and retrieves the public
+// key of the hot key
+func NewClient(addr string) (*Client, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		conn:   conn,
+		client: NewRemoteSignerClient(conn),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := c.client.PubKey(ctx, &PubKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c.pubKey = &secp256k1.PubKey{Key: res.PubKey}
+	return c, nil
+}
+
+// PubKey returns the public key of the hot key of the remote signer
+func (c *Client) PubKey() cryptotypes.PubKey {
+	return c.pubKey
+}
+
+// Close closes the connection to the remote signer
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// SignTx signs a tx in direct mode with the remote signer, the hot key is the single signer of the tx
+// This is adapted from github.com/cosmos/cosmos-sdk/client/tx Sign() function; Modified to use a remote signer.
+func (c *Client) SignTx(txf clienttx.Factory, txBuilder client.TxBuilder, txConfig client.TxConfig) error {
+	if txf.SignMode() != signing.SignMode_SIGN_MODE_DIRECT {
+		return errors.New("only the direct sign mode is supported by the remote signer")
+	}
+
+	// set the signer info with an empty signature to generate the auth info bytes
+	sig := signing.SignatureV2{
+		PubKey: c.pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: nil,
+		},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	// the remote signer signs the sign doc built from the body and auth info bytes of the encoded tx
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(txBytes); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := c.client.SignTx(ctx, &SignTxRequest{
+		ChainId:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	sig.Data = &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+		Signature: res.Signature,
+	}
+	return txBuilder.SetSignatures(sig)
+}
@@ -0,0 +1,196 @@
+package remotesigner
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// RateLimitWindow is the window of the rate limit of the signatures
+const RateLimitWindow = time.Minute
+
+var (
+	// ErrMsgTypeNotAllowed is returned when a tx contains a message whose type is not allowed by the policy
+	ErrMsgTypeNotAllowed = errors.New("message type not allowed")
+
+	// ErrRateLimited is returned when the rate limit of the signatures is reached
+	ErrRateLimited = errors.New("rate limit reached")
+
+	// ErrDoubleVote is returned when a tx contains a vote different from a vote already signed for the same ballot
+	ErrDoubleVote = errors.New("double vote")
+)
+
+// ballotVote is a vote for the ballot of its digest
+type ballotVote interface {
+	proto.Message
+	Digest() string
+}
+
+// signedVote is the record of the state file of a signed vote
+type signedVote struct {
+	Ballot string `json:"ballot"`
+	Vote   string `json:"vote"`
+}
+
+// Policy is the signing policy of the remote signer
+// Only the messages of the allowed types are signed, at most rateLimit txs are signed within RateLimitWindow, and a
+// vote different from a vote already signed for the same ballot is never signed. The signed votes are recorded in the
+// state file to keep the double vote protection across restarts.
+type Policy struct {
+	allowedMsgTypes map[string]bool
+	rateLimit       int
+	stateFile       string
+
+	mu          sync.Mutex
+	votes       map[string]string
+	windowStart time.Time
+	signed      int
+	now         func() time.Time
+}
+
+// DefaultAllowedMsgTypes returns the types of the messages granted to the zetaclient hot key
+func DefaultAllowedMsgTypes() []string {
+	return crosschaintypes.GetAllAuthzZetaclientTxTypes()
+}
+
+// NewPolicy creates a signing policy, the rate limit is disabled if zero and the votes are only kept in memory if the
+// state file is empty
+func NewPolicy(allowedMsgTypes []string, rateLimit int, stateFile string) (*Policy, error) {
+	p := &Policy{
+		allowedMsgTypes: make(map[string]bool),
+		rateLimit:       rateLimit,
+		stateFile:       stateFile,
+		votes:           make(map[string]string),
+		now:             time.Now,
+	}
+	for _, msgType := range allowedMsgTypes {
+		p.allowedMsgTypes[msgType] = true
+	}
+	if stateFile != "" {
+		if err := p.loadState(); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Authorize returns an error if the messages of a tx can't be signed, otherwise the tx is counted in the rate limit and
+// its votes are recorded
+func (p *Policy) Authorize(msgs []sdk.Msg) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	votes := make(map[string]string)
+	for _, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		if !p.allowedMsgTypes[msgType] {
+			return fmt.Errorf("%w: %s", ErrMsgTypeNotAllowed, msgType)
+		}
+		vote, ok := msg.(ballotVote)
+		if !ok {
+			continue
+		}
+		ballot := vote.Digest()
+		voteHash, err := hashVote(vote)
+		if err != nil {
+			return err
+		}
+		if signed, found := p.votes[ballot]; found && signed != voteHash {
+			return fmt.Errorf("%w: ballot %s", ErrDoubleVote, ballot)
+		}
+		if pending, found := votes[ballot]; found && pending != voteHash {
+			return fmt.Errorf("%w: ballot %s", ErrDoubleVote, ballot)
+		}
+		votes[ballot] = voteHash
+	}
+
+	now := p.now()
+	if now.Sub(p.windowStart) >= RateLimitWindow {
+		p.windowStart = now
+		p.signed = 0
+	}
+	if p.rateLimit > 0 && p.signed >= p.rateLimit {
+		return fmt.Errorf("%w: %d txs signed since %s", ErrRateLimited, p.signed, p.windowStart)
+	}
+
+	var newVotes []signedVote
+	for ballot, voteHash := range votes {
+		if _, found := p.votes[ballot]; !found {
+			newVotes = append(newVotes, signedVote{Ballot: ballot, Vote: voteHash})
+		}
+	}
+	if err := p.saveVotes(newVotes); err != nil {
+		return err
+	}
+	for _, vote := range newVotes {
+		p.votes[vote.Ballot] = vote.Vote
+	}
+	p.signed++
+	return nil
+}
+
+// loadState loads the signed votes of the state file
+func (p *Policy) loadState() error {
+	file, err := os.Open(filepath.Clean(p.stateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var vote signedVote
+		if err := json.Unmarshal(scanner.Bytes(), &vote); err != nil {
+			return fmt.Errorf("invalid state file %s: %w", p.stateFile, err)
+		}
+		p.votes[vote.Ballot] = vote.Vote
+	}
+	return scanner.Err()
+}
+
+// saveVotes appends the signed votes to the state file before the signature
+func (p *Policy) saveVotes(votes []signedVote) error {
+	if p.stateFile == "" || len(votes) == 0 {
+		return nil
+	}
+	file, err := os.OpenFile(filepath.Clean(p.stateFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	for _, vote := range votes {
+		line, err := json.Marshal(vote)
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return file.Sync()
+}
+
+// hashVote returns the hash of a vote
+func hashVote(vote ballotVote) (string, error) {
+	bz, err := proto.Marshal(vote)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:]), nil
+}
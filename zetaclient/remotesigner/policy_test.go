@@ -0,0 +1,113 @@
+package remotesigner
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func outboundVote(creator string, status common.ReceiveStatus) *crosschaintypes.MsgVoteOnObservedOutboundTx {
+	return crosschaintypes.NewMsgVoteOnObservedOutboundTx(
+		creator,
+		sample.Hash().Hex(),
+		"0x1",
+		10,
+		21000,
+		math.NewInt(100),
+		21000,
+		math.NewUint(1000),
+		status,
+		common.GoerliLocalnetChain().ChainId,
+		1,
+		common.CoinType_Gas,
+	)
+}
+
+func gasPriceVote(creator string, blockNumber uint64) *crosschaintypes.MsgGasPriceVoter {
+	return crosschaintypes.NewMsgGasPriceVoter(creator, common.GoerliLocalnetChain().ChainId, 1000, 0, "100", blockNumber)
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	creator := sample.AccAddress()
+
+	t.Run("should authorize the allowed messages", func(t *testing.T) {
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, "")
+		require.NoError(t, err)
+
+		require.NoError(t, policy.Authorize([]sdk.Msg{
+			gasPriceVote(creator, 1),
+			outboundVote(creator, common.ReceiveStatus_Success),
+		}))
+	})
+
+	t.Run("should refuse the messages not allowed", func(t *testing.T) {
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, "")
+		require.NoError(t, err)
+
+		err = policy.Authorize([]sdk.Msg{
+			gasPriceVote(creator, 1),
+			banktypes.NewMsgSend(sample.Bech32AccAddress(), sample.Bech32AccAddress(), sdk.NewCoins()),
+		})
+		require.ErrorIs(t, err, ErrMsgTypeNotAllowed)
+	})
+
+	t.Run("should refuse a different vote for the same ballot", func(t *testing.T) {
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, "")
+		require.NoError(t, err)
+		success := outboundVote(creator, common.ReceiveStatus_Success)
+		failed := *success
+		failed.Status = common.ReceiveStatus_Failed
+
+		require.NoError(t, policy.Authorize([]sdk.Msg{success}))
+		require.NoError(t, policy.Authorize([]sdk.Msg{success}))
+		require.ErrorIs(t, policy.Authorize([]sdk.Msg{&failed}), ErrDoubleVote)
+	})
+
+	t.Run("should refuse different votes for the same ballot in a tx", func(t *testing.T) {
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, "")
+		require.NoError(t, err)
+		success := outboundVote(creator, common.ReceiveStatus_Success)
+		failed := *success
+		failed.Status = common.ReceiveStatus_Failed
+
+		require.ErrorIs(t, policy.Authorize([]sdk.Msg{success, &failed}), ErrDoubleVote)
+		require.NoError(t, policy.Authorize([]sdk.Msg{&failed}))
+	})
+
+	t.Run("should keep the signed votes across restarts", func(t *testing.T) {
+		stateFile := filepath.Join(t.TempDir(), "votes.jsonl")
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, stateFile)
+		require.NoError(t, err)
+		success := outboundVote(creator, common.ReceiveStatus_Success)
+		failed := *success
+		failed.Status = common.ReceiveStatus_Failed
+		require.NoError(t, policy.Authorize([]sdk.Msg{success}))
+
+		policy, err = NewPolicy(DefaultAllowedMsgTypes(), 0, stateFile)
+		require.NoError(t, err)
+		require.NoError(t, policy.Authorize([]sdk.Msg{success}))
+		require.ErrorIs(t, policy.Authorize([]sdk.Msg{&failed}), ErrDoubleVote)
+	})
+
+	t.Run("should limit the number of txs signed within the window", func(t *testing.T) {
+		policy, err := NewPolicy(DefaultAllowedMsgTypes(), 2, "")
+		require.NoError(t, err)
+		now := time.Now()
+		policy.now = func() time.Time { return now }
+
+		require.NoError(t, policy.Authorize([]sdk.Msg{gasPriceVote(creator, 1)}))
+		require.NoError(t, policy.Authorize([]sdk.Msg{gasPriceVote(creator, 2)}))
+		require.ErrorIs(t, policy.Authorize([]sdk.Msg{gasPriceVote(creator, 3)}), ErrRateLimited)
+
+		now = now.Add(RateLimitWindow)
+		require.NoError(t, policy.Authorize([]sdk.Msg{gasPriceVote(creator, 3)}))
+	})
+}
@@ -0,0 +1,140 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ RemoteSignerServer = &Server{}
+
+// Server signs the txs of the hot key requested by zetaclient if their messages satisfy the signing policy
+// The messages of the txs must be authz exec messages of the grantee, the policy is checked against the messages
+// executed on behalf of the granter.
+type Server struct {
+	privKey cryptotypes.PrivKey
+	chainID string
+	policy  *Policy
+	cdc     codec.Codec
+	logger  zerolog.Logger
+}
+
+// NewServer creates a remote signer server signing the txs of the chain with the private key
+func NewServer(privKey cryptotypes.PrivKey, chainID string, policy *Policy, cdc codec.Codec, logger zerolog.Logger) *Server {
+	return &Server{
+		privKey: privKey,
+		chainID: chainID,
+		policy:  policy,
+		cdc:     cdc,
+		logger:  logger.With().Str("module", "RemoteSigner").Logger(),
+	}
+}
+
+// PubKey returns the public key of the hot key
+func (s *Server) PubKey(_ context.Context, _ *PubKeyRequest) (*PubKeyResponse, error) {
+	return &PubKeyResponse{PubKey: s.privKey.PubKey().Bytes()}, nil
+}
+
+// SignTx signs the sign doc of a tx in direct mode
+func (s *Server) SignTx(_ context.Context, req *SignTxRequest) (*SignTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ChainId != s.chainID {
+		return nil, status.Errorf(codes.PermissionDenied, "chain id %s is not %s", req.ChainId, s.chainID)
+	}
+	if err := s.checkAuthInfo(req.AuthInfoBytes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	msgs, err := s.execMsgs(req.BodyBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.policy.Authorize(msgs); err != nil {
+		s.logger.Warn().Err(err).Msgf("refused to sign a tx of %d messages", len(msgs))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     req.BodyBytes,
+		AuthInfoBytes: req.AuthInfoBytes,
+		ChainId:       req.ChainId,
+		AccountNumber: req.AccountNumber,
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	signature, err := s.privKey.Sign(signBytes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.logger.Info().Msgf("signed a tx of %d messages", len(msgs))
+	return &SignTxResponse{Signature: signature}, nil
+}
+
+// checkAuthInfo checks the tx is signed only by the hot key in direct mode
+func (s *Server) checkAuthInfo(authInfoBytes []byte) error {
+	var authInfo txtypes.AuthInfo
+	if err := s.cdc.Unmarshal(authInfoBytes, &authInfo); err != nil {
+		return err
+	}
+	if len(authInfo.SignerInfos) != 1 {
+		return fmt.Errorf("want 1 signer, got %d", len(authInfo.SignerInfos))
+	}
+	signerInfo := authInfo.SignerInfos[0]
+	pubKey, ok := signerInfo.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok || !bytes.Equal(pubKey.Bytes(), s.privKey.PubKey().Bytes()) {
+		return errors.New("the signer is not the hot key")
+	}
+	single, ok := signerInfo.ModeInfo.GetSum().(*txtypes.ModeInfo_Single_)
+	if !ok || single.Single.Mode != signing.SignMode_SIGN_MODE_DIRECT {
+		return errors.New("only the direct sign mode is supported")
+	}
+	return nil
+}
+
+// execMsgs returns the messages executed by the authz exec messages of a tx body
+func (s *Server) execMsgs(bodyBytes []byte) ([]sdk.Msg, error) {
+	var body txtypes.TxBody
+	if err := s.cdc.Unmarshal(bodyBytes, &body); err != nil {
+		return nil, err
+	}
+	if len(body.Messages) == 0 {
+		return nil, errors.New("empty tx")
+	}
+	var msgs []sdk.Msg
+	for _, any := range body.Messages {
+		exec, ok := any.GetCachedValue().(*authz.MsgExec)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMsgTypeNotAllowed, any.TypeUrl)
+		}
+		execMsgs, err := exec.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, execMsgs...)
+	}
+	return msgs, nil
+}
+
+// Listen listens on the address of the remote signer, either host:port or unix:///path
+func Listen(addr string) (net.Listener, error) {
+	if path := strings.TrimPrefix(addr, "unix://"); path != addr {
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", addr)
+}
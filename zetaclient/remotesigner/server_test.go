@@ -0,0 +1,131 @@
+package remotesigner
+
+import (
+	"testing"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testChainID = "athens_101-1"
+
+// startTestServer starts a remote signer with a new hot key and returns a client connected to it
+func startTestServer(t *testing.T, encodingCfg params.EncodingConfig) (*Client, *secp256k1.PrivKey) {
+	privKey := secp256k1.GenPrivKey()
+	policy, err := NewPolicy(DefaultAllowedMsgTypes(), 0, "")
+	require.NoError(t, err)
+
+	listener, err := Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewServer(privKey, testChainID, policy, encodingCfg.Codec, zerolog.Nop()))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewClient(listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client, privKey
+}
+
+// signTestTx signs with the client a tx executing the messages on behalf of the granter
+func signTestTx(t *testing.T, encodingCfg params.EncodingConfig, client *Client, chainID string, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	exec := authz.NewMsgExec(sdk.AccAddress(client.PubKey().Address()), msgs)
+	txBuilder := encodingCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&exec))
+	txBuilder.SetGasLimit(200_000)
+
+	txf := clienttx.Factory{}.
+		WithChainID(chainID).
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		WithTxConfig(encodingCfg.TxConfig)
+	err := client.SignTx(txf, txBuilder, encodingCfg.TxConfig)
+	return txBuilder.GetTx(), err
+}
+
+func TestServer_SignTx(t *testing.T) {
+	encodingCfg := app.MakeEncodingConfig()
+	creator := sample.AccAddress()
+
+	t.Run("should sign the tx with the hot key", func(t *testing.T) {
+		client, privKey := startTestServer(t, encodingCfg)
+		require.Equal(t, privKey.PubKey().Bytes(), client.PubKey().Bytes())
+
+		tx, err := signTestTx(t, encodingCfg, client, testChainID, gasPriceVote(creator, 1), outboundVote(creator, common.ReceiveStatus_Success))
+		require.NoError(t, err)
+
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.EqualValues(t, 7, sigs[0].Sequence)
+		signBytes, err := encodingCfg.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+			ChainID:       testChainID,
+			AccountNumber: 3,
+			Sequence:      7,
+			PubKey:        privKey.PubKey(),
+		}, tx)
+		require.NoError(t, err)
+		signature := sigs[0].Data.(*signing.SingleSignatureData).Signature
+		require.True(t, privKey.PubKey().VerifySignature(signBytes, signature))
+	})
+
+	t.Run("should refuse the messages not allowed", func(t *testing.T) {
+		client, _ := startTestServer(t, encodingCfg)
+
+		send := banktypes.NewMsgSend(sample.Bech32AccAddress(), sample.Bech32AccAddress(), sdk.NewCoins())
+		_, err := signTestTx(t, encodingCfg, client, testChainID, gasPriceVote(creator, 1), send)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("should refuse a double vote", func(t *testing.T) {
+		client, _ := startTestServer(t, encodingCfg)
+		success := outboundVote(creator, common.ReceiveStatus_Success)
+		failed := *success
+		failed.Status = common.ReceiveStatus_Failed
+
+		_, err := signTestTx(t, encodingCfg, client, testChainID, success)
+		require.NoError(t, err)
+		_, err = signTestTx(t, encodingCfg, client, testChainID, &failed)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("should refuse the txs of another chain", func(t *testing.T) {
+		client, _ := startTestServer(t, encodingCfg)
+
+		_, err := signTestTx(t, encodingCfg, client, "zetachain_7000-1", gasPriceVote(creator, 1))
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("should refuse the messages not executed through authz", func(t *testing.T) {
+		client, _ := startTestServer(t, encodingCfg)
+		txBuilder := encodingCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(gasPriceVote(creator, 1)))
+		txf := clienttx.Factory{}.
+			WithChainID(testChainID).
+			WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+			WithTxConfig(encodingCfg.TxConfig)
+
+		err := client.SignTx(txf, txBuilder, encodingCfg.TxConfig)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,158 @@
+package zetaclient
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	metricsPkg "github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+// reorgTrackedBlocks is the number of last scanned blocks whose hash is tracked to detect the reorgs
+const reorgTrackedBlocks = 1000
+
+// ScannedBlock is the number and the hash of a scanned block
+type ScannedBlock struct {
+	Number uint64
+	Hash   ethcommon.Hash
+}
+
+// BlockHashTracker tracks the hashes of the last scanned blocks of a chain, ordered by block number
+type BlockHashTracker struct {
+	mu     sync.Mutex
+	size   int
+	blocks []ScannedBlock
+}
+
+func NewBlockHashTracker(size int) *BlockHashTracker {
+	return &BlockHashTracker{
+		size:   size,
+		blocks: make([]ScannedBlock, 0, size),
+	}
+}
+
+// Add tracks the hash of a scanned block, the blocks tracked from this block number are replaced
+// The oldest blocks are dropped when more than size blocks are tracked
+func (t *BlockHashTracker) Add(number uint64, hash ethcommon.Hash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.truncateFrom(number)
+	t.blocks = append(t.blocks, ScannedBlock{Number: number, Hash: hash})
+	if len(t.blocks) > t.size {
+		t.blocks = append(t.blocks[:0], t.blocks[len(t.blocks)-t.size:]...)
+	}
+}
+
+// TruncateFrom drops the blocks tracked from this block number
+func (t *BlockHashTracker) TruncateFrom(number uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.truncateFrom(number)
+}
+
+// Blocks returns a copy of the tracked blocks ordered by block number
+func (t *BlockHashTracker) Blocks() []ScannedBlock {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]ScannedBlock(nil), t.blocks...)
+}
+
+func (t *BlockHashTracker) truncateFrom(number uint64) {
+	for len(t.blocks) > 0 && t.blocks[len(t.blocks)-1].Number >= number {
+		t.blocks = t.blocks[:len(t.blocks)-1]
+	}
+}
+
+// findReorgAncestor compares the tracked blocks with the hashes of the chain returned by hashAt, and returns the
+// last tracked block still in the chain if the last tracked block has been replaced
+// The block before the first tracked block is returned if all the tracked blocks have been replaced
+func findReorgAncestor(blocks []ScannedBlock, hashAt func(uint64) (ethcommon.Hash, error)) (uint64, bool, error) {
+	if len(blocks) == 0 {
+		return 0, false, nil
+	}
+	last := blocks[len(blocks)-1]
+	hash, err := hashAt(last.Number)
+	if err != nil {
+		return 0, false, err
+	}
+	if hash == last.Hash {
+		return 0, false, nil
+	}
+	for i := len(blocks) - 2; i >= 0; i-- {
+		hash, err := hashAt(blocks[i].Number)
+		if err != nil {
+			return 0, false, err
+		}
+		if hash == blocks[i].Hash {
+			return blocks[i].Number, true, nil
+		}
+	}
+	if blocks[0].Number == 0 {
+		return 0, true, nil
+	}
+	return blocks[0].Number - 1, true, nil
+}
+
+// detectReorg checks that the last scanned blocks are still in the chain, the observation scans again the blocks
+// replaced by a reorg from the last block still in the chain
+func (ob *EVMChainClient) detectReorg() error {
+	blocks := ob.blockHashes.Blocks()
+	ancestor, reorged, err := findReorgAncestor(blocks, func(number uint64) (ethcommon.Hash, error) {
+		header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+		if err != nil {
+			return ethcommon.Hash{}, errors.Wrapf(err, "error getting header %d", number)
+		}
+		return header.Hash(), nil
+	})
+	if err != nil || !reorged {
+		return err
+	}
+
+	last := blocks[len(blocks)-1]
+	depth := last.Number - ancestor
+	ob.logger.ExternalChainWatcher.Error().Msgf(
+		"detectReorg: reorg of depth %d detected, scanned block %d %s replaced, rescanning from block %d (confirmation count %d)",
+		depth,
+		last.Number,
+		last.Hash.Hex(),
+		ancestor+1,
+		ob.GetCoreParams().ConfirmationCount,
+	)
+	if ancestor < blocks[0].Number {
+		ob.logger.ExternalChainWatcher.Error().Msgf("detectReorg: no tracked block left in the chain, the reorg may be deeper than %d blocks", depth)
+	}
+	ob.recordReorg(depth)
+
+	// drop the replaced blocks from the cache to get the blocks of the new chain
+	for _, block := range blocks {
+		if block.Number > ancestor {
+			ob.BlockCache.Remove(block.Number)
+			ob.BlockCache.Remove(block.Hash)
+		}
+	}
+	ob.blockHashes.TruncateFrom(ancestor + 1)
+	if ob.GetLastBlockHeightScanned() > ancestor {
+		ob.SetLastBlockHeightScanned(ancestor)
+	}
+	return nil
+}
+
+// recordReorg updates the reorg metrics of the chain
+func (ob *EVMChainClient) recordReorg(depth uint64) {
+	if counter, err := ob.GetPromCounter(metricsPkg.ReorgCount); err == nil {
+		counter.Inc()
+	}
+	if gauge, err := ob.GetPromGauge(metricsPkg.LastReorgDepth); err == nil {
+		gauge.Set(float64(depth))
+	}
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	if depth > ob.maxReorgDepth {
+		ob.maxReorgDepth = depth
+		if gauge, err := ob.GetPromGauge(metricsPkg.MaxReorgDepth); err == nil {
+			gauge.Set(float64(depth))
+		}
+	}
+}
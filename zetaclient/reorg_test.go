@@ -0,0 +1,79 @@
+package zetaclient
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockHashTracker(t *testing.T) {
+	tracker := NewBlockHashTracker(3)
+	for i := uint64(1); i <= 5; i++ {
+		tracker.Add(i, ethcommon.BigToHash(new(big.Int).SetUint64(i)))
+	}
+	blocks := tracker.Blocks()
+	require.Len(t, blocks, 3)
+	require.EqualValues(t, 3, blocks[0].Number)
+	require.EqualValues(t, 5, blocks[2].Number)
+
+	// the blocks from a rescanned block are replaced
+	tracker.Add(4, ethcommon.HexToHash("0x44"))
+	blocks = tracker.Blocks()
+	require.Len(t, blocks, 2)
+	require.Equal(t, ScannedBlock{Number: 4, Hash: ethcommon.HexToHash("0x44")}, blocks[1])
+
+	tracker.TruncateFrom(4)
+	blocks = tracker.Blocks()
+	require.Len(t, blocks, 1)
+	require.EqualValues(t, 3, blocks[0].Number)
+}
+
+func TestFindReorgAncestor(t *testing.T) {
+	blocks := []ScannedBlock{
+		{Number: 10, Hash: ethcommon.HexToHash("0x10")},
+		{Number: 11, Hash: ethcommon.HexToHash("0x11")},
+		{Number: 12, Hash: ethcommon.HexToHash("0x12")},
+		{Number: 13, Hash: ethcommon.HexToHash("0x13")},
+	}
+	chainWith := func(hashes map[uint64]string) func(uint64) (ethcommon.Hash, error) {
+		return func(number uint64) (ethcommon.Hash, error) {
+			return ethcommon.HexToHash(hashes[number]), nil
+		}
+	}
+
+	t.Run("no reorg", func(t *testing.T) {
+		_, reorged, err := findReorgAncestor(blocks, chainWith(map[uint64]string{13: "0x13"}))
+		require.NoError(t, err)
+		require.False(t, reorged)
+
+		_, reorged, err = findReorgAncestor(nil, nil)
+		require.NoError(t, err)
+		require.False(t, reorged)
+	})
+
+	t.Run("reorg of the last blocks", func(t *testing.T) {
+		ancestor, reorged, err := findReorgAncestor(blocks, chainWith(map[uint64]string{
+			10: "0x10", 11: "0x11", 12: "0xaa", 13: "0xbb",
+		}))
+		require.NoError(t, err)
+		require.True(t, reorged)
+		require.EqualValues(t, 11, ancestor)
+	})
+
+	t.Run("reorg deeper than the tracked blocks", func(t *testing.T) {
+		ancestor, reorged, err := findReorgAncestor(blocks, chainWith(map[uint64]string{}))
+		require.NoError(t, err)
+		require.True(t, reorged)
+		require.EqualValues(t, 9, ancestor)
+	})
+
+	t.Run("rpc error", func(t *testing.T) {
+		_, _, err := findReorgAncestor(blocks, func(uint64) (ethcommon.Hash, error) {
+			return ethcommon.Hash{}, errors.New("rpc error")
+		})
+		require.Error(t, err)
+	})
+}
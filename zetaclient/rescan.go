@@ -0,0 +1,267 @@
+package zetaclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RescanResult is the result of the rescan of a block range for missed inbound txs
+type RescanResult struct {
+	ChainID    int64  `json:"chain_id"`
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+
+	// Found is the number of inbound txs found in the block range
+	Found int `json:"found"`
+
+	// Observed is the number of inbound txs found with a ballot or a cctx on zetacore
+	Observed int `json:"observed"`
+
+	// Missed are the ballot identifiers of the inbound txs found without ballot and cctx on zetacore
+	Missed []string `json:"missed"`
+
+	// Voted are the ballot identifiers of the missed inbound txs voted by the rescan
+	Voted []string `json:"voted"`
+}
+
+// RescanInTx scans again the confirmed blocks from startBlock to endBlock for ZetaSent events, Deposited events and
+// gas deposits to the TSS address, and votes the inbound txs without ballot and cctx on zetacore if vote is true
+func (ob *EVMChainClient) RescanInTx(startBlock, endBlock uint64, vote bool) (RescanResult, error) {
+	result := RescanResult{ChainID: ob.chain.ChainId, StartBlock: startBlock, EndBlock: endBlock}
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return result, err
+	}
+	// the block range must be confirmed for any amount
+	blockNum, maxConfirmations := header.Number.Uint64(), ob.GetCoreParams().MaxConfirmationCount()
+	if blockNum < maxConfirmations {
+		return result, fmt.Errorf("current block number %d is too small", blockNum)
+	}
+	if err := validateRescanRange(startBlock, endBlock, blockNum-maxConfirmations); err != nil {
+		return result, err
+	}
+	ob.logger.ExternalChainWatcher.Info().Msgf("RescanInTx: rescanning blocks %d to %d", startBlock, endBlock)
+
+	// scan at most MaxBlocksPerPeriod blocks per query as the observation
+	for start := startBlock; start <= endBlock; start += config.MaxBlocksPerPeriod {
+		end := start + config.MaxBlocksPerPeriod - 1
+		if end > endBlock {
+			end = endBlock
+		}
+		msgs, err := ob.getInboundVoteMsgs(start, end)
+		if err != nil {
+			return result, errors.Wrapf(err, "error scanning blocks %d to %d", start, end)
+		}
+		if err := processRescannedInbounds(ob.zetaClient, ob.logger.ExternalChainWatcher, &result, msgs, vote); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// getInboundVoteMsgs returns the inbound votes of the inbound txs of the blocks from startBlock to endBlock
+// Contrary to the observation, any error getting the events or the blocks is returned to not miss any inbound tx
+func (ob *EVMChainClient) getInboundVoteMsgs(startBlock, endBlock uint64) ([]*types.MsgVoteOnObservedInboundTx, error) {
+	var msgs []*types.MsgVoteOnObservedInboundTx
+	filterOpts := &bind.FilterOpts{
+		Start:   startBlock,
+		End:     &endBlock,
+		Context: context.TODO(),
+	}
+
+	connector, err := ob.GetConnectorContract()
+	if err != nil {
+		return nil, err
+	}
+	zetaSentLogs, err := connector.FilterZetaSent(filterOpts, []ethcommon.Address{}, []*big.Int{})
+	if err != nil {
+		return nil, errors.Wrap(err, "FilterZetaSent error")
+	}
+	for zetaSentLogs.Next() {
+		msg, err := ob.GetInboundVoteMsgForZetaSentEvent(zetaSentLogs.Event)
+		if err != nil {
+			ob.logger.ExternalChainWatcher.Error().Err(err).Msgf("error getting inbound vote msg of tx %s", zetaSentLogs.Event.Raw.TxHash)
+			continue
+		}
+		msgs = append(msgs, &msg)
+	}
+	if err := zetaSentLogs.Error(); err != nil {
+		return nil, errors.Wrap(err, "ZetaSent logs error")
+	}
+
+	custody, err := ob.GetERC20CustodyContract()
+	if err != nil {
+		return nil, err
+	}
+	depositedLogs, err := custody.FilterDeposited(filterOpts, []ethcommon.Address{})
+	if err != nil {
+		return nil, errors.Wrap(err, "FilterDeposited error")
+	}
+	for depositedLogs.Next() {
+		msg, err := ob.GetInboundVoteMsgForDepositedEvent(depositedLogs.Event)
+		if err != nil {
+			ob.logger.ExternalChainWatcher.Error().Err(err).Msgf("error getting inbound vote msg of tx %s", depositedLogs.Event.Raw.TxHash)
+			continue
+		}
+		msgs = append(msgs, &msg)
+	}
+	if err := depositedLogs.Error(); err != nil {
+		return nil, errors.Wrap(err, "Deposited logs error")
+	}
+
+	tssAddress := ob.Tss.EVMAddress()
+	if tssAddress == (ethcommon.Address{}) {
+		return nil, errors.New("TSS address not set")
+	}
+	for bn := startBlock; bn <= endBlock; bn++ {
+		// the blocks are not cached to not evict the recent blocks of the observation
+		block, err := ob.evmClient.BlockByNumber(context.Background(), new(big.Int).SetUint64(bn))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting block %d", bn)
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != tssAddress || bytes.Equal(tx.Data(), []byte(common.DonationMessage)) {
+				continue
+			}
+			msg, err := ob.getInboundVoteMsgForTssTx(tx, block.Hash())
+			if err != nil {
+				return nil, errors.Wrapf(err, "error observing tx %s", tx.Hash())
+			}
+			if msg != nil {
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+	return msgs, nil
+}
+
+// RescanInTx scans again the confirmed blocks from startBlock to endBlock for deposits to the TSS address, and votes
+// the inbound txs without ballot and cctx on zetacore if vote is true
+func (ob *BitcoinChainClient) RescanInTx(startBlock, endBlock uint64, vote bool) (RescanResult, error) {
+	result := RescanResult{ChainID: ob.chain.ChainId, StartBlock: startBlock, EndBlock: endBlock}
+	cnt, err := ob.rpcClient.GetBlockCount()
+	if err != nil {
+		return result, fmt.Errorf("error getting block count: %s", err)
+	}
+	// #nosec G701 always in range
+	// the block range must be confirmed for any amount
+	confirmedBlockNum := cnt - int64(ob.GetCoreParams().MaxConfirmationCount())
+	if confirmedBlockNum < 0 {
+		return result, fmt.Errorf("current block number %d is too small", cnt)
+	}
+	// #nosec G701 checked as positive
+	if err := validateRescanRange(startBlock, endBlock, uint64(confirmedBlockNum)); err != nil {
+		return result, err
+	}
+	ob.logger.WatchInTx.Info().Msgf("RescanInTx: rescanning blocks %d to %d", startBlock, endBlock)
+
+	tssAddress := ob.Tss.BTCAddress()
+	for bn := startBlock; bn <= endBlock; bn++ {
+		// #nosec G701 checked as not above the confirmed block
+		res, err := ob.GetBlockByNumberCached(int64(bn))
+		if err != nil {
+			return result, errors.Wrapf(err, "error getting bitcoin block %d", bn)
+		}
+		// #nosec G701 always positive
+		inTxs := FilterAndParseIncomingTx(res.Block.Tx, uint64(res.Block.Height), tssAddress, &ob.logger.WatchInTx, ob.chain.ChainId)
+		msgs := make([]*types.MsgVoteOnObservedInboundTx, 0, len(inTxs))
+		for _, inTx := range inTxs {
+			msgs = append(msgs, ob.GetInboundVoteMessageFromBtcEvent(inTx))
+		}
+		if err := processRescannedInbounds(ob.zetaClient, ob.logger.WatchInTx, &result, msgs, vote); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// validateRescanRange returns an error if the block range is empty or not confirmed
+func validateRescanRange(startBlock, endBlock, confirmedBlockNum uint64) error {
+	if startBlock == 0 || startBlock > endBlock {
+		return fmt.Errorf("invalid block range %d to %d", startBlock, endBlock)
+	}
+	if endBlock > confirmedBlockNum {
+		return fmt.Errorf("block %d is not confirmed, last confirmed block is %d", endBlock, confirmedBlockNum)
+	}
+	return nil
+}
+
+// processRescannedInbounds adds the rescanned inbound votes to the result and posts the votes of the missed inbound
+// txs if vote is true, the votes that fail to be posted are logged and not added to the voted ballots
+func processRescannedInbounds(
+	bridge ZetaCoreBridger,
+	logger zerolog.Logger,
+	result *RescanResult,
+	msgs []*types.MsgVoteOnObservedInboundTx,
+	vote bool,
+) error {
+	for _, msg := range msgs {
+		result.Found++
+		observed, err := isInboundObserved(bridge, msg)
+		if err != nil {
+			return errors.Wrapf(err, "error checking inbound tx %s", msg.InTxHash)
+		}
+		if observed {
+			result.Observed++
+			continue
+		}
+		ballot := msg.Digest()
+		result.Missed = append(result.Missed, ballot)
+		logger.Warn().Msgf("RescanInTx: missed inbound tx %s, ballot %s", msg.InTxHash, ballot)
+		if !vote {
+			continue
+		}
+
+		gasLimit := uint64(PostSendEVMGasLimit)
+		if msg.CoinType == common.CoinType_Zeta {
+			gasLimit = PostSendNonEVMGasLimit
+		}
+		traceInboundObserved(msg, InboundSourceRescan)
+		zetaHash, err := bridge.PostSend(gasLimit, msg)
+		if err != nil {
+			logger.Error().Err(err).Msgf("RescanInTx: error posting vote of inbound tx %s", msg.InTxHash)
+			continue
+		}
+		result.Voted = append(result.Voted, ballot)
+		logger.Info().Msgf("RescanInTx: missed inbound tx %s reported: PostSend zeta tx: %s", msg.InTxHash, zetaHash)
+	}
+	return nil
+}
+
+// isInboundObserved returns true if zetacore has a ballot for the inbound vote or a cctx created by it
+func isInboundObserved(bridge ZetaCoreBridger, msg *types.MsgVoteOnObservedInboundTx) (bool, error) {
+	index := msg.Digest()
+	_, err := bridge.GetBallotByID(index)
+	if err == nil {
+		return true, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return false, err
+	}
+
+	inTxHashToCctx, err := bridge.GetInTxHashToCctx(msg.InTxHash)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, cctxIndex := range inTxHashToCctx.CctxIndex {
+		if cctxIndex == index {
+			return true, nil
+		}
+	}
+	return false, nil
+}
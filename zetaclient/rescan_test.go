@@ -0,0 +1,164 @@
+package zetaclient
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rescanTestBridge is a zetacore bridge with the ballots and cctxs of the inbound txs
+type rescanTestBridge struct {
+	ZetaCoreBridger
+	ballots        map[string]bool
+	inTxHashToCctx map[string][]string
+	failPostSend   bool
+	posted         []*types.MsgVoteOnObservedInboundTx
+}
+
+func (b *rescanTestBridge) GetBallotByID(id string) (*observertypes.QueryBallotByIdentifierResponse, error) {
+	if !b.ballots[id] {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &observertypes.QueryBallotByIdentifierResponse{}, nil
+}
+
+func (b *rescanTestBridge) GetInTxHashToCctx(inTxHash string) (types.InTxHashToCctx, error) {
+	cctxIndex, found := b.inTxHashToCctx[inTxHash]
+	if !found {
+		return types.InTxHashToCctx{}, status.Error(codes.NotFound, "not found")
+	}
+	return types.InTxHashToCctx{InTxHash: inTxHash, CctxIndex: cctxIndex}, nil
+}
+
+func (b *rescanTestBridge) PostSend(_ uint64, msg *types.MsgVoteOnObservedInboundTx) (string, error) {
+	if b.failPostSend {
+		return "", errors.New("post send error")
+	}
+	b.posted = append(b.posted, msg)
+	return "zetaHash", nil
+}
+
+func rescanTestMsg(inTxHash string) *types.MsgVoteOnObservedInboundTx {
+	return &types.MsgVoteOnObservedInboundTx{
+		Creator:       "creator",
+		SenderChainId: common.GoerliLocalnetChain().ChainId,
+		InTxHash:      inTxHash,
+		CoinType:      common.CoinType_Gas,
+		Amount:        math.NewUint(1000),
+	}
+}
+
+func TestValidateRescanRange(t *testing.T) {
+	require.NoError(t, validateRescanRange(1, 1, 1))
+	require.NoError(t, validateRescanRange(10, 20, 30))
+	require.Error(t, validateRescanRange(0, 20, 30))
+	require.Error(t, validateRescanRange(21, 20, 30))
+	require.ErrorContains(t, validateRescanRange(10, 31, 30), "not confirmed")
+}
+
+func TestProcessRescannedInbounds(t *testing.T) {
+	withBallot := rescanTestMsg("0x1")
+	withCctx := rescanTestMsg("0x2")
+	missed := rescanTestMsg("0x3")
+	// the cctx of another inbound of the same tx does not make the inbound observed
+	otherCctx := rescanTestMsg("0x4")
+	newBridge := func() *rescanTestBridge {
+		return &rescanTestBridge{
+			ballots: map[string]bool{withBallot.Digest(): true},
+			inTxHashToCctx: map[string][]string{
+				withCctx.InTxHash:  {withCctx.Digest()},
+				otherCctx.InTxHash: {"other"},
+			},
+		}
+	}
+	msgs := []*types.MsgVoteOnObservedInboundTx{withBallot, withCctx, missed, otherCctx}
+
+	t.Run("dry run", func(t *testing.T) {
+		bridge := newBridge()
+		var result RescanResult
+		require.NoError(t, processRescannedInbounds(bridge, zerolog.Nop(), &result, msgs, false))
+		require.Equal(t, 4, result.Found)
+		require.Equal(t, 2, result.Observed)
+		require.Equal(t, []string{missed.Digest(), otherCctx.Digest()}, result.Missed)
+		require.Empty(t, result.Voted)
+		require.Empty(t, bridge.posted)
+	})
+
+	t.Run("vote the missed inbounds", func(t *testing.T) {
+		bridge := newBridge()
+		var result RescanResult
+		require.NoError(t, processRescannedInbounds(bridge, zerolog.Nop(), &result, msgs, true))
+		require.Equal(t, []string{missed.Digest(), otherCctx.Digest()}, result.Voted)
+		require.Equal(t, []*types.MsgVoteOnObservedInboundTx{missed, otherCctx}, bridge.posted)
+	})
+
+	t.Run("failed votes are not reported as voted", func(t *testing.T) {
+		bridge := newBridge()
+		bridge.failPostSend = true
+		var result RescanResult
+		require.NoError(t, processRescannedInbounds(bridge, zerolog.Nop(), &result, msgs, true))
+		require.Len(t, result.Missed, 2)
+		require.Empty(t, result.Voted)
+	})
+}
+
+func TestIsInboundObserved(t *testing.T) {
+	msg := rescanTestMsg("0x1")
+	bridge := &rescanTestBridge{}
+	observed, err := isInboundObserved(bridge, msg)
+	require.NoError(t, err)
+	require.False(t, observed)
+
+	bridge.inTxHashToCctx = map[string][]string{msg.InTxHash: {msg.Digest()}}
+	observed, err = isInboundObserved(bridge, msg)
+	require.NoError(t, err)
+	require.True(t, observed)
+
+	bridge = &rescanTestBridge{ballots: map[string]bool{msg.Digest(): true}}
+	observed, err = isInboundObserved(bridge, msg)
+	require.NoError(t, err)
+	require.True(t, observed)
+}
+
+// rescanTestEVMClient is an EVM rpc client at a fixed block number
+type rescanTestEVMClient struct {
+	EVMRPCClient
+	blockNumber int64
+}
+
+func (c *rescanTestEVMClient) HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(c.blockNumber)}, nil
+}
+
+func TestEVMChainClient_RescanInTx(t *testing.T) {
+	ob := &EVMChainClient{
+		chain:     common.GoerliLocalnetChain(),
+		Mu:        &sync.Mutex{},
+		params:    observertypes.CoreParams{ConfirmationCount: 12},
+		evmClient: &rescanTestEVMClient{blockNumber: 5},
+	}
+
+	t.Run("should fail if the current block number is below the confirmation count", func(t *testing.T) {
+		_, err := ob.RescanInTx(1, 2, false)
+		require.ErrorContains(t, err, "too small")
+	})
+
+	t.Run("should fail if the block range is not confirmed", func(t *testing.T) {
+		ob.evmClient = &rescanTestEVMClient{blockNumber: 20}
+		_, err := ob.RescanInTx(1, 10, false)
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "too small")
+	})
+}
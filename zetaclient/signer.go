@@ -18,6 +18,8 @@ type TSSSigner interface {
 	Pubkey() []byte
 	// Sign: Specify optionalPubkey to use a different pubkey than the current pubkey set during keygen
 	Sign(data []byte, height uint64, nonce uint64, chain *common.Chain, optionalPubkey string) ([65]byte, error)
+	// SignBatch: Sign the digests in a single keysign, the nonce is the one of the first digest and identifies the blame
+	SignBatch(digests [][]byte, height uint64, nonce uint64, chain *common.Chain) ([][65]byte, error)
 	EVMAddress() ethcommon.Address
 	BTCAddress() string
 	BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash
@@ -41,6 +43,18 @@ func (s TestSigner) Sign(digest []byte, _ uint64, _ uint64, _ *common.Chain, _ s
 	return sigbyte, nil
 }
 
+func (s TestSigner) SignBatch(digests [][]byte, height uint64, nonce uint64, chain *common.Chain) ([][65]byte, error) {
+	sigs := make([][65]byte, len(digests))
+	for i, digest := range digests {
+		sig, err := s.Sign(digest, height, nonce, chain, "")
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
+}
+
 func (s TestSigner) Pubkey() []byte {
 	publicKeyBytes := crypto.FromECDSAPub(&s.PrivKey.PublicKey)
 	return publicKeyBytes
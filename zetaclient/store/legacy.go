@@ -0,0 +1,115 @@
+package store
+
+import (
+	"fmt"
+
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+	"gorm.io/gorm"
+)
+
+// importLegacyTables copies the rows of the tables of the previous versions of the client into the entries, the
+// timestamp of an entry is the update time of its row. The tables are kept so that the database can still be opened by
+// a previous version of the client, with the data as of the upgrade
+func importLegacyTables(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+
+	if migrator.HasTable(&clienttypes.LastBlockSQLType{}) {
+		var lastBlock clienttypes.LastBlockSQLType
+		err := tx.Limit(1).Find(&lastBlock, clienttypes.LastBlockNumID).Error
+		if err != nil {
+			return err
+		}
+		if lastBlock.ID == clienttypes.LastBlockNumID {
+			value, err := EncodeLastBlock(lastBlock.Num)
+			if err != nil {
+				return err
+			}
+			err = putSQLEntry(tx, BucketLastBlock, Entry{Key: KeyLastBlock, Value: value, Timestamp: lastBlock.UpdatedAt})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if migrator.HasTable(&clienttypes.ReceiptSQLType{}) {
+		var rows []clienttypes.ReceiptSQLType
+		if err := tx.Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			receipt, err := clienttypes.FromReceiptDBType(row.Receipt)
+			if err != nil {
+				return fmt.Errorf("legacy receipt %s: %w", row.Identifier, err)
+			}
+			value, err := EncodeReceipt(receipt)
+			if err != nil {
+				return err
+			}
+			err = putSQLEntry(tx, BucketReceipts, Entry{Key: row.Identifier, Value: value, Timestamp: row.UpdatedAt})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if migrator.HasTable(&clienttypes.TransactionSQLType{}) {
+		var rows []clienttypes.TransactionSQLType
+		if err := tx.Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			transaction, err := clienttypes.FromTransactionDBType(row.Transaction)
+			if err != nil {
+				return fmt.Errorf("legacy transaction %s: %w", row.Identifier, err)
+			}
+			value, err := EncodeTransaction(transaction)
+			if err != nil {
+				return err
+			}
+			err = putSQLEntry(tx, BucketTransactions, Entry{Key: row.Identifier, Value: value, Timestamp: row.UpdatedAt})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if migrator.HasTable(&clienttypes.OutTxHashSQLType{}) {
+		var rows []clienttypes.OutTxHashSQLType
+		if err := tx.Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			value, err := EncodeTxHash(row.Hash)
+			if err != nil {
+				return err
+			}
+			err = putSQLEntry(tx, BucketBroadcastedTxs, Entry{Key: row.Key, Value: value, Timestamp: row.UpdatedAt})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if migrator.HasTable(&clienttypes.TransactionResultSQLType{}) {
+		var rows []clienttypes.TransactionResultSQLType
+		if err := tx.Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			result, err := clienttypes.FromTransactionResultSQLType(row)
+			if err != nil {
+				return fmt.Errorf("legacy tx result %s: %w", row.Key, err)
+			}
+			value, err := EncodeTxResult(result)
+			if err != nil {
+				return err
+			}
+			err = putSQLEntry(tx, BucketTxResults, Entry{Key: row.Key, Value: value, Timestamp: row.UpdatedAt})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
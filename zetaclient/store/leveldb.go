@@ -0,0 +1,183 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// The keys of the entries of a LevelDB store are prefixed with their bucket and the values with the timestamp of the
+// entry (big endian unix nanoseconds).
+const (
+	leveldbEntryPrefix = "e/"
+	leveldbVersionKey  = "m/version"
+	timestampLen       = 8
+)
+
+// leveldbMigrations are the migrations of the LevelDB store, the migration at index i migrates the store from version
+// i to version i+1
+var leveldbMigrations = []func(s *leveldbStore, sqlitePath string) error{
+	// v1: the entries of the SQLite store in the same directory, if any, are imported
+	func(s *leveldbStore, sqlitePath string) error {
+		if _, err := os.Stat(sqlitePath); err != nil {
+			return nil
+		}
+		return s.importStore(sqlitePath)
+	},
+}
+
+// leveldbStore is a store in a LevelDB database
+type leveldbStore struct {
+	db *leveldb.DB
+}
+
+var _ Store = &leveldbStore{}
+
+func openLevelDB(path string, sqlitePath string) (*leveldbStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &leveldbStore{db: db}
+	if err := s.migrate(sqlitePath); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// migrate migrates the store to SchemaVersion, the version is updated after each migration
+func (s *leveldbStore) migrate(sqlitePath string) error {
+	version, err := s.Version()
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(version); err != nil {
+		return err
+	}
+	for ; version < SchemaVersion; version++ {
+		if err := leveldbMigrations[version](s, sqlitePath); err != nil {
+			return err
+		}
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, version+1)
+		if err := s.db.Put([]byte(leveldbVersionKey), value, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importStore imports the entries of the SQLite store at the path, keeping their timestamps
+func (s *leveldbStore) importStore(sqlitePath string) error {
+	source, err := openSQLite(sqlitePath)
+	if err != nil {
+		return fmt.Errorf("error opening the SQLite store to import: %w", err)
+	}
+	defer source.Close()
+
+	batch := new(leveldb.Batch)
+	for _, bucket := range Buckets {
+		err := source.Iterate(bucket, func(entry Entry) error {
+			batch.Put(leveldbKey(bucket, entry.Key), leveldbValue(entry))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.db.Write(batch, nil)
+}
+
+func (s *leveldbStore) Get(bucket, key string) (Entry, error) {
+	value, err := s.db.Get(leveldbKey(bucket, key), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return Entry{}, ErrNotFound
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+	return leveldbEntry(key, value)
+}
+
+func (s *leveldbStore) Put(bucket, key string, value []byte) error {
+	return s.db.Put(leveldbKey(bucket, key), leveldbValue(Entry{Value: value, Timestamp: time.Now()}), nil)
+}
+
+func (s *leveldbStore) Delete(bucket string, keys ...string) error {
+	batch := new(leveldb.Batch)
+	for _, key := range keys {
+		batch.Delete(leveldbKey(bucket, key))
+	}
+	return s.db.Write(batch, nil)
+}
+
+func (s *leveldbStore) Iterate(bucket string, fn func(Entry) error) error {
+	prefix := leveldbKey(bucket, "")
+	iter := s.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		entry, err := leveldbEntry(string(iter.Key()[len(prefix):]), iter.Value())
+		if err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+func (s *leveldbStore) Version() (uint32, error) {
+	value, err := s.db.Get([]byte(leveldbVersionKey), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 4 {
+		return 0, fmt.Errorf("invalid store schema version %x", value)
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+func (s *leveldbStore) Compact() error {
+	return s.db.CompactRange(util.Range{})
+}
+
+func (s *leveldbStore) Close() error {
+	return s.db.Close()
+}
+
+func leveldbKey(bucket, key string) []byte {
+	return []byte(leveldbEntryPrefix + bucket + "/" + key)
+}
+
+func leveldbValue(entry Entry) []byte {
+	value := make([]byte, timestampLen+len(entry.Value))
+	// #nosec G701 the timestamps are after 1970
+	binary.BigEndian.PutUint64(value, uint64(entry.Timestamp.UnixNano()))
+	copy(value[timestampLen:], entry.Value)
+	return value
+}
+
+func leveldbEntry(key string, value []byte) (Entry, error) {
+	if len(value) < timestampLen {
+		return Entry{}, fmt.Errorf("invalid value of entry %s", key)
+	}
+	// the value returned by the iterator is only valid until the next iteration
+	entryValue := make([]byte, len(value)-timestampLen)
+	copy(entryValue, value[timestampLen:])
+	return Entry{
+		Key:   key,
+		Value: entryValue,
+		// #nosec G701 always in range
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(value))),
+	}, nil
+}
@@ -0,0 +1,178 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+// sqliteDeleteBatch is the number of keys deleted per statement, below the limit of variables of a SQLite statement
+const sqliteDeleteBatch = 500
+
+// entrySQLType is an entry of the SQLite store
+type entrySQLType struct {
+	Bucket    string `gorm:"primaryKey"`
+	Key       string `gorm:"primaryKey"`
+	Value     []byte
+	Timestamp int64 // unix nanoseconds
+}
+
+func (entrySQLType) TableName() string {
+	return "entries"
+}
+
+// versionSQLType is the schema version of the SQLite store, in a single row
+type versionSQLType struct {
+	ID      uint `gorm:"primaryKey"`
+	Version uint32
+}
+
+func (versionSQLType) TableName() string {
+	return "schema_version"
+}
+
+// sqliteMigrations are the migrations of the SQLite store, the migration at index i migrates the store from version i
+// to version i+1
+var sqliteMigrations = []func(tx *gorm.DB) error{
+	// v1: the entries replace the tables of the previous versions of the client
+	func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&entrySQLType{}); err != nil {
+			return err
+		}
+		return importLegacyTables(tx)
+	},
+}
+
+// sqliteStore is a store in a SQLite database
+type sqliteStore struct {
+	db *gorm.DB
+}
+
+var _ Store = &sqliteStore{}
+
+func openSQLite(path string) (*sqliteStore, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, err
+	}
+	s := &sqliteStore{db: db}
+	if err := s.migrate(); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// migrate migrates the store to SchemaVersion, each migration is applied in a transaction with the version update
+func (s *sqliteStore) migrate() error {
+	if err := s.db.AutoMigrate(&versionSQLType{}); err != nil {
+		return err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(version); err != nil {
+		return err
+	}
+	for ; version < SchemaVersion; version++ {
+		migration := sqliteMigrations[version]
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			if err := migration(tx); err != nil {
+				return err
+			}
+			return tx.Save(&versionSQLType{ID: 1, Version: version + 1}).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqliteStore) Get(bucket, key string) (Entry, error) {
+	var entry entrySQLType
+	err := s.db.Where("bucket = ? AND key = ?", bucket, key).Take(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Entry{}, ErrNotFound
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+	return entry.toEntry(), nil
+}
+
+func (s *sqliteStore) Put(bucket, key string, value []byte) error {
+	return putSQLEntry(s.db, bucket, Entry{Key: key, Value: value, Timestamp: time.Now()})
+}
+
+func (s *sqliteStore) Delete(bucket string, keys ...string) error {
+	for start := 0; start < len(keys); start += sqliteDeleteBatch {
+		end := start + sqliteDeleteBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+		err := s.db.Where("bucket = ? AND key IN ?", bucket, keys[start:end]).Delete(&entrySQLType{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqliteStore) Iterate(bucket string, fn func(Entry) error) error {
+	rows, err := s.db.Model(&entrySQLType{}).Where("bucket = ?", bucket).Order("key").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entry entrySQLType
+		if err := s.db.ScanRows(rows, &entry); err != nil {
+			return err
+		}
+		if err := fn(entry.toEntry()); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s *sqliteStore) Version() (uint32, error) {
+	var version versionSQLType
+	err := s.db.Take(&version, 1).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	return version.Version, err
+}
+
+func (s *sqliteStore) Compact() error {
+	return s.db.Exec("VACUUM").Error
+}
+
+func (s *sqliteStore) Close() error {
+	db, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+func (e entrySQLType) toEntry() Entry {
+	return Entry{Key: e.Key, Value: e.Value, Timestamp: time.Unix(0, e.Timestamp)}
+}
+
+// putSQLEntry writes an entry, replacing the entry of the key if any
+func putSQLEntry(db *gorm.DB, bucket string, entry Entry) error {
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&entrySQLType{
+		Bucket:    bucket,
+		Key:       entry.Key,
+		Value:     entry.Value,
+		Timestamp: entry.Timestamp.UnixNano(),
+	}).Error
+}
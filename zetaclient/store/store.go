@@ -0,0 +1,194 @@
+// Package store implements the persistence of the chain clients: the last scanned block and the data of the outbound
+// txs of a chain are stored as timestamped entries in buckets, either in SQLite or in LevelDB.
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// the backends of the store
+const (
+	BackendSQLite  = "sqlite"
+	BackendLevelDB = "leveldb"
+)
+
+// the buckets of the store
+const (
+	// BucketLastBlock holds the last scanned block under KeyLastBlock
+	BucketLastBlock = "last_block"
+
+	// BucketReceipts holds the receipts of the confirmed evm outbound txs, keyed by outTxID
+	BucketReceipts = "receipts"
+
+	// BucketTransactions holds the confirmed evm outbound txs, keyed by outTxID
+	BucketTransactions = "transactions"
+
+	// BucketBroadcastedTxs holds the hashes of the bitcoin outbound txs broadcasted by the client, keyed by outTxID
+	BucketBroadcastedTxs = "broadcasted_txs"
+
+	// BucketTxResults holds the results of the included bitcoin outbound txs, keyed by outTxID
+	BucketTxResults = "tx_results"
+)
+
+// KeyLastBlock is the key of the last scanned block
+const KeyLastBlock = "last_block"
+
+// SchemaVersion is the version of the layout of the stores, the stores are migrated to this version when opened
+const SchemaVersion = 1
+
+// leveldbSuffix is the suffix of the directory of a LevelDB store
+const leveldbSuffix = ".leveldb"
+
+// Buckets are all the buckets of the store
+var Buckets = []string{BucketLastBlock, BucketReceipts, BucketTransactions, BucketBroadcastedTxs, BucketTxResults}
+
+// ErrNotFound is returned when an entry is not in the store
+var ErrNotFound = errors.New("entry not found")
+
+// Entry is an entry of a bucket
+type Entry struct {
+	Key   string
+	Value []byte
+
+	// Timestamp is the time of the last write of the entry
+	Timestamp time.Time
+}
+
+// Store is the store of a chain client
+type Store interface {
+	// Get returns the entry of a key, ErrNotFound if missing
+	Get(bucket, key string) (Entry, error)
+
+	// Put writes the value of a key
+	Put(bucket, key string, value []byte) error
+
+	// Delete deletes the entries of keys, the missing keys are ignored
+	Delete(bucket string, keys ...string) error
+
+	// Iterate calls fn on the entries of a bucket in key order, the iteration stops at the first error
+	Iterate(bucket string, fn func(Entry) error) error
+
+	// Version returns the schema version of the store
+	Version() (uint32, error)
+
+	// Compact reclaims the space of the deleted entries
+	Compact() error
+
+	// Close closes the store
+	Close() error
+}
+
+// Options are the options of the store of a chain client
+type Options struct {
+	// Backend is the backend of the store, BackendSQLite if empty
+	Backend string
+
+	// Dir is the directory of the stores
+	Dir string
+
+	// Retention is the time the data of the outbound txs finalized on zetacore is kept, never pruned if zero
+	Retention time.Duration
+}
+
+// Open opens the store with the name in the directory, the store is created if missing and migrated to SchemaVersion
+func Open(backend, dir, name string) (Store, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	switch backend {
+	case BackendSQLite, "":
+		return openSQLite(Path(BackendSQLite, dir, name))
+	case BackendLevelDB:
+		return openLevelDB(Path(BackendLevelDB, dir, name), Path(BackendSQLite, dir, name))
+	default:
+		return nil, fmt.Errorf("unknown store backend %s", backend)
+	}
+}
+
+// Path returns the path of the store with the name in the directory, the path of the SQLite store is the path of the
+// database of the previous versions of the client
+func Path(backend, dir, name string) string {
+	if backend == BackendLevelDB {
+		return filepath.Join(dir, name+leveldbSuffix)
+	}
+	return filepath.Join(dir, name)
+}
+
+// Detect returns the backend of the existing store with the name in the directory, LevelDB takes precedence as the
+// SQLite database is kept after being imported into a LevelDB store
+func Detect(dir, name string) (string, error) {
+	if _, err := os.Stat(Path(BackendLevelDB, dir, name)); err == nil {
+		return BackendLevelDB, nil
+	}
+	if _, err := os.Stat(Path(BackendSQLite, dir, name)); err == nil {
+		return BackendSQLite, nil
+	}
+	return "", fmt.Errorf("no store %s in %s", name, dir)
+}
+
+// List returns the names of the stores in the directory
+func List(dir string) ([]string, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	seen := make(map[string]bool)
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() {
+			if filepath.Ext(name) != leveldbSuffix {
+				continue
+			}
+			name = name[:len(name)-len(leveldbSuffix)]
+		} else if isSQLiteTempFile(name) {
+			continue
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Prune deletes the entries of a bucket written before the time whose key is prunable, it returns the deleted keys
+func Prune(s Store, bucket string, before time.Time, prunable func(key string) bool) ([]string, error) {
+	var keys []string
+	err := s.Iterate(bucket, func(entry Entry) error {
+		if entry.Timestamp.Before(before) && prunable(entry.Key) {
+			keys = append(keys, entry.Key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return keys, s.Delete(bucket, keys...)
+}
+
+// isSQLiteTempFile returns true if the file is a journal of a SQLite database
+func isSQLiteTempFile(name string) bool {
+	for _, suffix := range []string{"-journal", "-wal", "-shm"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVersion returns an error if the store was written by a newer version of the client
+func checkVersion(version uint32) error {
+	if version > SchemaVersion {
+		return fmt.Errorf("store schema version %d is newer than the supported version %d", version, SchemaVersion)
+	}
+	return nil
+}
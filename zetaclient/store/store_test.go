@@ -0,0 +1,236 @@
+package store
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestStore(t *testing.T) {
+	for _, backend := range []string{BackendSQLite, BackendLevelDB} {
+		t.Run(backend, func(t *testing.T) {
+			dir := t.TempDir()
+			s, err := Open(backend, dir, "goerli_testnet")
+			require.NoError(t, err)
+
+			version, err := s.Version()
+			require.NoError(t, err)
+			require.EqualValues(t, SchemaVersion, version)
+
+			_, err = s.Get(BucketReceipts, "5-tss-1")
+			require.ErrorIs(t, err, ErrNotFound)
+
+			before := time.Now()
+			require.NoError(t, s.Put(BucketReceipts, "5-tss-2", []byte(`"b"`)))
+			require.NoError(t, s.Put(BucketReceipts, "5-tss-1", []byte(`"a"`)))
+			require.NoError(t, s.Put(BucketReceipts, "5-tss-1", []byte(`"c"`)))
+			require.NoError(t, s.Put(BucketTransactions, "5-tss-1", []byte(`"tx"`)))
+
+			entry, err := s.Get(BucketReceipts, "5-tss-1")
+			require.NoError(t, err)
+			require.Equal(t, []byte(`"c"`), entry.Value)
+			require.False(t, entry.Timestamp.Before(before.Truncate(time.Microsecond)))
+
+			// the entries are iterated in key order, within their bucket
+			var keys []string
+			require.NoError(t, s.Iterate(BucketReceipts, func(entry Entry) error {
+				keys = append(keys, entry.Key)
+				return nil
+			}))
+			require.Equal(t, []string{"5-tss-1", "5-tss-2"}, keys)
+
+			errStop := errors.New("stop")
+			require.ErrorIs(t, s.Iterate(BucketReceipts, func(Entry) error { return errStop }), errStop)
+
+			require.NoError(t, s.Delete(BucketReceipts, "5-tss-2", "5-tss-3"))
+			_, err = s.Get(BucketReceipts, "5-tss-2")
+			require.ErrorIs(t, err, ErrNotFound)
+			require.NoError(t, s.Compact())
+
+			// the entries are persisted
+			require.NoError(t, s.Close())
+			s, err = Open(backend, dir, "goerli_testnet")
+			require.NoError(t, err)
+			defer s.Close()
+			entry, err = s.Get(BucketTransactions, "5-tss-1")
+			require.NoError(t, err)
+			require.Equal(t, []byte(`"tx"`), entry.Value)
+
+			names, err := List(dir)
+			require.NoError(t, err)
+			require.Equal(t, []string{"goerli_testnet"}, names)
+			detected, err := Detect(dir, "goerli_testnet")
+			require.NoError(t, err)
+			require.Equal(t, backend, detected)
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	for _, backend := range []string{BackendSQLite, BackendLevelDB} {
+		t.Run(backend, func(t *testing.T) {
+			s, err := Open(backend, t.TempDir(), "btc_chain_client")
+			require.NoError(t, err)
+			defer s.Close()
+
+			for _, key := range []string{"18332-tss-1", "18332-tss-2", "18332-tss-3"} {
+				require.NoError(t, s.Put(BucketTxResults, key, []byte("{}")))
+			}
+
+			// the entries written after the time are kept
+			pruned, err := Prune(s, BucketTxResults, time.Now().Add(-time.Hour), func(string) bool { return true })
+			require.NoError(t, err)
+			require.Empty(t, pruned)
+
+			// the entries whose key is not prunable are kept
+			pruned, err = Prune(s, BucketTxResults, time.Now().Add(time.Second), func(key string) bool {
+				return key != "18332-tss-3"
+			})
+			require.NoError(t, err)
+			require.Equal(t, []string{"18332-tss-1", "18332-tss-2"}, pruned)
+
+			var keys []string
+			require.NoError(t, s.Iterate(BucketTxResults, func(entry Entry) error {
+				keys = append(keys, entry.Key)
+				return nil
+			}))
+			require.Equal(t, []string{"18332-tss-3"}, keys)
+		})
+	}
+}
+
+// openLegacyDB creates a database of the previous versions of the client
+func openLegacyDB(t *testing.T, path string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(
+		&clienttypes.ReceiptSQLType{},
+		&clienttypes.TransactionSQLType{},
+		&clienttypes.LastBlockSQLType{},
+		&clienttypes.TransactionResultSQLType{},
+		&clienttypes.OutTxHashSQLType{},
+	))
+
+	require.NoError(t, db.Save(clienttypes.ToLastBlockSQLType(1234)).Error)
+	receipt, err := clienttypes.ToReceiptSQLType(&ethtypes.Receipt{
+		Status: ethtypes.ReceiptStatusSuccessful,
+		TxHash: ethcommon.HexToHash("0x01"),
+	}, "5-tss-1")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(receipt).Error)
+	transaction, err := clienttypes.ToTransactionSQLType(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		Value:    big.NewInt(2),
+	}), "5-tss-1")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(transaction).Error)
+	hash := clienttypes.ToOutTxHashSQLType("abcd", "18332-tss-1")
+	require.NoError(t, db.Create(&hash).Error)
+	result, err := clienttypes.ToTransactionResultSQLType(btcjson.GetTransactionResult{TxID: "abcd", Confirmations: 3}, "18332-tss-1")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&result).Error)
+	return db
+}
+
+func TestLegacyMigration(t *testing.T) {
+	dir := t.TempDir()
+	legacy := openLegacyDB(t, Path(BackendSQLite, dir, "goerli_testnet"))
+	sqlDB, err := legacy.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	checkEntries := func(t *testing.T, s Store) {
+		entry, err := s.Get(BucketLastBlock, KeyLastBlock)
+		require.NoError(t, err)
+		lastBlock, err := DecodeLastBlock(entry.Value)
+		require.NoError(t, err)
+		require.EqualValues(t, 1234, lastBlock)
+
+		entry, err = s.Get(BucketReceipts, "5-tss-1")
+		require.NoError(t, err)
+		receipt, err := DecodeReceipt(entry.Value)
+		require.NoError(t, err)
+		require.Equal(t, ethcommon.HexToHash("0x01"), receipt.TxHash)
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+		entry, err = s.Get(BucketTransactions, "5-tss-1")
+		require.NoError(t, err)
+		transaction, err := DecodeTransaction(entry.Value)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, transaction.Nonce())
+
+		entry, err = s.Get(BucketBroadcastedTxs, "18332-tss-1")
+		require.NoError(t, err)
+		hash, err := DecodeTxHash(entry.Value)
+		require.NoError(t, err)
+		require.Equal(t, "abcd", hash)
+
+		entry, err = s.Get(BucketTxResults, "18332-tss-1")
+		require.NoError(t, err)
+		result, err := DecodeTxResult(entry.Value)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, result.Confirmations)
+	}
+
+	// the legacy tables are migrated in place and kept for the previous versions of the client
+	s, err := Open(BackendSQLite, dir, "goerli_testnet")
+	require.NoError(t, err)
+	checkEntries(t, s)
+	require.True(t, s.(*sqliteStore).db.Migrator().HasTable(&clienttypes.ReceiptSQLType{}))
+	require.NoError(t, s.Close())
+
+	// the SQLite store is imported into a new LevelDB store
+	s, err = Open(BackendLevelDB, dir, "goerli_testnet")
+	require.NoError(t, err)
+	defer s.Close()
+	checkEntries(t, s)
+	detected, err := Detect(dir, "goerli_testnet")
+	require.NoError(t, err)
+	require.Equal(t, BackendLevelDB, detected)
+}
+
+func TestNewerVersion(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(BackendSQLite, dir, "goerli_testnet")
+	require.NoError(t, err)
+	require.NoError(t, s.(*sqliteStore).db.Save(&versionSQLType{ID: 1, Version: SchemaVersion + 1}).Error)
+	require.NoError(t, s.Close())
+
+	_, err = Open(BackendSQLite, dir, "goerli_testnet")
+	require.ErrorContains(t, err, "newer than the supported version")
+}
+
+func TestValues(t *testing.T) {
+	receipt := &ethtypes.Receipt{
+		Status:      ethtypes.ReceiptStatusFailed,
+		TxHash:      ethcommon.HexToHash("0x02"),
+		GasUsed:     21000,
+		BlockNumber: big.NewInt(10),
+	}
+	value, err := EncodeReceipt(receipt)
+	require.NoError(t, err)
+	decoded, err := DecodeReceipt(value)
+	require.NoError(t, err)
+	require.Equal(t, receipt.TxHash, decoded.TxHash)
+	require.Equal(t, receipt.GasUsed, decoded.GasUsed)
+	require.Equal(t, receipt.BlockNumber, decoded.BlockNumber)
+	require.Nil(t, receipt.Logs)
+
+	result := btcjson.GetTransactionResult{TxID: "abcd", Confirmations: 6, Details: []btcjson.GetTransactionDetailsResult{{Amount: 0.1}}}
+	value, err = EncodeTxResult(result)
+	require.NoError(t, err)
+	decodedResult, err := DecodeTxResult(value)
+	require.NoError(t, err)
+	require.Equal(t, result, decodedResult)
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/btcsuite/btcd/btcjson"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// The values of the entries are JSON documents so that the stores can be exported and inspected without the client.
+
+// EncodeLastBlock encodes the last scanned block
+func EncodeLastBlock(block uint64) ([]byte, error) {
+	return json.Marshal(block)
+}
+
+// DecodeLastBlock decodes the last scanned block
+func DecodeLastBlock(value []byte) (uint64, error) {
+	var block uint64
+	err := json.Unmarshal(value, &block)
+	return block, err
+}
+
+// EncodeReceipt encodes the receipt of an evm outbound tx
+func EncodeReceipt(receipt *ethtypes.Receipt) ([]byte, error) {
+	// the logs are required when decoding
+	if receipt.Logs == nil {
+		withLogs := *receipt
+		withLogs.Logs = []*ethtypes.Log{}
+		receipt = &withLogs
+	}
+	return json.Marshal(receipt)
+}
+
+// DecodeReceipt decodes the receipt of an evm outbound tx
+func DecodeReceipt(value []byte) (*ethtypes.Receipt, error) {
+	receipt := &ethtypes.Receipt{}
+	if err := json.Unmarshal(value, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// EncodeTransaction encodes an evm outbound tx
+func EncodeTransaction(transaction *ethtypes.Transaction) ([]byte, error) {
+	return json.Marshal(transaction)
+}
+
+// DecodeTransaction decodes an evm outbound tx
+func DecodeTransaction(value []byte) (*ethtypes.Transaction, error) {
+	transaction := &ethtypes.Transaction{}
+	if err := json.Unmarshal(value, transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// EncodeTxHash encodes the hash of a bitcoin outbound tx
+func EncodeTxHash(hash string) ([]byte, error) {
+	return json.Marshal(hash)
+}
+
+// DecodeTxHash decodes the hash of a bitcoin outbound tx
+func DecodeTxHash(value []byte) (string, error) {
+	var hash string
+	err := json.Unmarshal(value, &hash)
+	return hash, err
+}
+
+// EncodeTxResult encodes the result of a bitcoin outbound tx
+func EncodeTxResult(result btcjson.GetTransactionResult) ([]byte, error) {
+	return json.Marshal(result)
+}
+
+// DecodeTxResult decodes the result of a bitcoin outbound tx
+func DecodeTxResult(value []byte) (btcjson.GetTransactionResult, error) {
+	var result btcjson.GetTransactionResult
+	err := json.Unmarshal(value, &result)
+	return result, err
+}
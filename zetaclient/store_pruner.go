@@ -0,0 +1,95 @@
+package zetaclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
+)
+
+// storePruneInterval is the interval between two prunings of the store of a chain client
+const storePruneInterval = time.Hour
+
+// outTxNonce returns the nonce of an outTxID (chainID-tss-nonce)
+func outTxNonce(outTxID string) (uint64, error) {
+	i := strings.LastIndex(outTxID, "-")
+	if i < 0 {
+		return 0, fmt.Errorf("invalid outTxID %s", outTxID)
+	}
+	return strconv.ParseUint(outTxID[i+1:], 10, 64)
+}
+
+// outTxPrunable returns whether the data of an outbound tx can be pruned: the outbound tx is either finalized on
+// zetacore (nonce below the lowest pending nonce) or signed by a previous TSS
+func outTxPrunable(bridge ZetaCoreBridger, chainID int64, getTxID func(nonce uint64) string) (func(outTxID string) bool, error) {
+	pendingNonces, err := bridge.GetPendingNoncesByChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return func(outTxID string) bool {
+		nonce, err := outTxNonce(outTxID)
+		if err != nil {
+			return false
+		}
+		// #nosec G701 always positive
+		return nonce < uint64(pendingNonces.NonceLow) || getTxID(nonce) != outTxID
+	}, nil
+}
+
+// pruneOutTxData deletes from the buckets the prunable data of the outbound txs written before the retention window,
+// it returns the deleted outTxIDs by bucket
+func pruneOutTxData(
+	s store.Store,
+	retention time.Duration,
+	prunable func(outTxID string) bool,
+	buckets ...string,
+) (map[string][]string, error) {
+	before := time.Now().Add(-retention)
+	pruned := make(map[string][]string)
+	for _, bucket := range buckets {
+		keys, err := store.Prune(s, bucket, before, prunable)
+		if err != nil {
+			return pruned, err
+		}
+		pruned[bucket] = keys
+	}
+	return pruned, nil
+}
+
+// runStorePruner runs the pruning of the store of a chain client every storePruneInterval until stop is closed
+func runStorePruner(prune func() error, stop <-chan struct{}, logger zerolog.Logger) {
+	ticker := time.NewTicker(storePruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := prune(); err != nil {
+				logger.Error().Err(err).Msg("error pruning the store")
+			}
+		case <-stop:
+			logger.Info().Msg("store pruner stopped")
+			return
+		}
+	}
+}
+
+// saveLastBlock saves the last scanned block in the store
+func saveLastBlock(s store.Store, block uint64) error {
+	value, err := store.EncodeLastBlock(block)
+	if err != nil {
+		return err
+	}
+	return s.Put(store.BucketLastBlock, store.KeyLastBlock, value)
+}
+
+// loadLastBlock loads the last scanned block from the store, store.ErrNotFound if it was never saved
+func loadLastBlock(s store.Store) (uint64, error) {
+	entry, err := s.Get(store.BucketLastBlock, store.KeyLastBlock)
+	if err != nil {
+		return 0, err
+	}
+	return store.DecodeLastBlock(entry.Value)
+}
@@ -0,0 +1,132 @@
+package zetaclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/store"
+)
+
+// pruneTestBridge is a zetacore bridge with the pending nonces of a chain
+type pruneTestBridge struct {
+	ZetaCoreBridger
+	nonceLow int64
+}
+
+func (b *pruneTestBridge) GetPendingNoncesByChain(chainID int64) (observertypes.PendingNonces, error) {
+	return observertypes.PendingNonces{ChainId: chainID, NonceLow: b.nonceLow, NonceHigh: b.nonceLow + 2}, nil
+}
+
+func TestOutTxNonce(t *testing.T) {
+	nonce, err := outTxNonce("18332-tb1qy9pqmk2pd9sv63g27jt8r657wy0d9ueeh0nqur-42")
+	require.NoError(t, err)
+	require.EqualValues(t, 42, nonce)
+
+	_, err = outTxNonce("42")
+	require.Error(t, err)
+	_, err = outTxNonce("5-tss-")
+	require.Error(t, err)
+}
+
+func TestPruneOutTxData(t *testing.T) {
+	s, err := store.Open(store.BackendLevelDB, t.TempDir(), "goerli_testnet")
+	require.NoError(t, err)
+	defer s.Close()
+
+	getTxID := func(nonce uint64) string {
+		return (&EVMChainClient{chain: common.GoerliChain(), Tss: newPruneTestSigner(t)}).GetTxID(nonce)
+	}
+	finalized, pending := getTxID(3), getTxID(7)
+	previousTss := "5-0x0000000000000000000000000000000000000000-9"
+	for _, outTxID := range []string{finalized, pending, previousTss} {
+		require.NoError(t, s.Put(store.BucketReceipts, outTxID, []byte("{}")))
+	}
+	prunable, err := outTxPrunable(&pruneTestBridge{nonceLow: 5}, common.GoerliChain().ChainId, getTxID)
+	require.NoError(t, err)
+
+	// the entries within the retention window are kept
+	pruned, err := pruneOutTxData(s, time.Hour, prunable, store.BucketReceipts)
+	require.NoError(t, err)
+	require.Empty(t, pruned[store.BucketReceipts])
+
+	// the entries of the finalized outTxs and of the previous TSS are pruned
+	pruned, err = pruneOutTxData(s, -time.Second, prunable, store.BucketReceipts)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{finalized, previousTss}, pruned[store.BucketReceipts])
+	_, err = s.Get(store.BucketReceipts, pending)
+	require.NoError(t, err)
+}
+
+func TestBitcoinPruneStore(t *testing.T) {
+	s, err := store.Open(store.BackendSQLite, t.TempDir(), btcStoreName)
+	require.NoError(t, err)
+	ob := &BitcoinChainClient{
+		chain:             common.BtcRegtestChain(),
+		zetaClient:        &pruneTestBridge{nonceLow: 2},
+		Tss:               newPruneTestSigner(t),
+		Mu:                &sync.Mutex{},
+		includedTxHashes:  make(map[string]uint64),
+		includedTxResults: make(map[string]btcjson.GetTransactionResult),
+		broadcastedTx:     make(map[string]string),
+		store:             s,
+		storeRetention:    -time.Second,
+	}
+	defer ob.store.Close()
+
+	for nonce, hash := range []string{"aa", "bb", "cc"} {
+		// #nosec G701 test - always in range
+		outTxID := ob.GetTxID(uint64(nonce))
+		require.NoError(t, ob.saveTxResult(outTxID, btcjson.GetTransactionResult{TxID: hash}))
+		ob.SaveBroadcastedTx(hash, uint64(nonce))
+	}
+
+	// the included and broadcasted txs are loaded from the store
+	require.NoError(t, ob.BuildIncludedTxMaps())
+	require.Len(t, ob.includedTxResults, 3)
+	require.EqualValues(t, 2, ob.includedTxHashes["cc"])
+
+	// the txs of the nonces below the lowest pending nonce are pruned from the store and the maps
+	require.NoError(t, ob.PruneStore())
+	require.Len(t, ob.includedTxResults, 1)
+	require.Equal(t, map[string]uint64{"cc": 2}, ob.includedTxHashes)
+	require.Equal(t, map[string]string{ob.GetTxID(2): "cc"}, ob.broadcastedTx)
+
+	ob.broadcastedTx = make(map[string]string)
+	require.NoError(t, ob.BuildBroadcastedTxMap())
+	require.Equal(t, map[string]string{ob.GetTxID(2): "cc"}, ob.broadcastedTx)
+}
+
+func TestEVMPruneStore(t *testing.T) {
+	ob := &EVMChainClient{
+		chain:                     common.GoerliChain(),
+		zetaClient:                &pruneTestBridge{nonceLow: 2},
+		Tss:                       newPruneTestSigner(t),
+		Mu:                        &sync.Mutex{},
+		outTXConfirmedReceipts:    make(map[string]*ethtypes.Receipt),
+		outTXConfirmedTransaction: make(map[string]*ethtypes.Transaction),
+	}
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		ob.outTXConfirmedReceipts[ob.GetTxID(nonce)] = &ethtypes.Receipt{}
+		ob.outTXConfirmedTransaction[ob.GetTxID(nonce)] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce})
+	}
+
+	// the receipts and txs of the nonces below the lowest pending nonce are pruned from memory without a store retention
+	require.NoError(t, ob.PruneStore())
+	require.Len(t, ob.outTXConfirmedReceipts, 1)
+	require.Contains(t, ob.outTXConfirmedReceipts, ob.GetTxID(2))
+	require.Len(t, ob.outTXConfirmedTransaction, 1)
+	require.Contains(t, ob.outTXConfirmedTransaction, ob.GetTxID(2))
+}
+
+func newPruneTestSigner(t *testing.T) TestSigner {
+	privateKey, err := crypto.HexToECDSA("7b8507ba117e069f4a3f456f505276084f8c92aee86ac78ae37b4d1801d35fa8")
+	require.NoError(t, err)
+	return TestSigner{PrivKey: privateKey}
+}
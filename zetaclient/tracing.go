@@ -0,0 +1,70 @@
+package zetaclient
+
+import (
+	"context"
+
+	"github.com/zeta-chain/zetacore/common/tracing"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// the sources of the inbound txs observed
+const (
+	InboundSourceObserver = "observer"
+	InboundSourceTracker  = "tracker"
+	InboundSourceRescan   = "rescan"
+)
+
+var tracer = tracing.Tracer("zetaclient")
+
+// inboundAttributes returns the span attributes of an inbound vote
+func inboundAttributes(msg *types.MsgVoteOnObservedInboundTx) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		tracing.AttrInboundHash.String(msg.InTxHash),
+		tracing.AttrSenderChainID.Int64(msg.SenderChainId),
+		tracing.AttrReceiverChainID.Int64(msg.ReceiverChain),
+	}
+}
+
+// outboundAttributes returns the span attributes of the current outbound of a cctx
+func outboundAttributes(cctx *types.CrossChainTx) []attribute.KeyValue {
+	params := cctx.GetCurrentOutTxParam()
+	return []attribute.KeyValue{
+		tracing.AttrInboundHash.String(cctx.InboundTxParams.InboundTxObservedHash),
+		tracing.AttrSenderChainID.Int64(cctx.InboundTxParams.SenderChainId),
+		tracing.AttrReceiverChainID.Int64(params.ReceiverChainId),
+		// #nosec G701 always in range
+		tracing.AttrOutboundNonce.Int64(int64(params.OutboundTxTssNonce)),
+		attribute.String("cctx.status", cctx.CctxStatus.Status.String()),
+	}
+}
+
+// startOutboundSpan starts a span of the current outbound of a cctx
+func startOutboundSpan(ctx context.Context, name string, cctx *types.CrossChainTx, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.StartCctxSpan(ctx, tracer, name, cctx.Index, append(outboundAttributes(cctx), attrs...)...)
+}
+
+// traceInboundObserved records the observation of an inbound tx in the trace of its cctx
+func traceInboundObserved(msg *types.MsgVoteOnObservedInboundTx, source string) {
+	_, span := tracing.StartCctxSpan(
+		context.Background(),
+		tracer,
+		"ObserveInbound",
+		msg.Digest(),
+		append(inboundAttributes(msg),
+			// #nosec G701 always in range
+			attribute.Int64("inbound.block_height", int64(msg.InBlockHeight)),
+			attribute.String("inbound.source", source),
+		)...,
+	)
+	span.End()
+}
+
+// traceOutboundScheduled records the scheduling of the keysign of the current outbound of a cctx in the trace of the
+// cctx
+func traceOutboundScheduled(cctx *types.CrossChainTx, zetaHeight uint64) {
+	// #nosec G701 always in range
+	_, span := startOutboundSpan(context.Background(), "ScheduleOutbound", cctx, attribute.Int64("zeta.height", int64(zetaHeight)))
+	span.End()
+}
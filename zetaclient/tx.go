@@ -1,6 +1,7 @@
 package zetaclient
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -12,9 +13,11 @@ import (
 
 	"github.com/zeta-chain/go-tss/blame"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/tracing"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -27,6 +30,7 @@ const (
 	PostBlameDataGasLimit           = 200_000
 	DefaultGasLimit                 = 200_000
 	PostProveOutboundTxGasLimit     = 400_000
+	PostNonceGapReportGasLimit      = 200_000
 	DefaultRetryCount               = 5
 	ExtendedRetryCount              = 15
 	DefaultRetryInterval            = 5
@@ -81,9 +85,25 @@ func (b *ZetaCoreBridge) WrapMessageWithAuthz(msg sdk.Msg) (sdk.Msg, AuthZSigner
 	return &authzMessage, authzSigner, nil
 }
 
-func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error) {
+// submitVote adds the vote to the next batch of the vote aggregator without waiting for its broadcast, the result of
+// the vote is logged once its batch is confirmed in a block
+func (b *ZetaCoreBridge) submitVote(msg sdk.Msg, gasLimit uint64) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	return b.voteAggregator.Submit(msg, gasLimit, func(zetaTxHash string, err error) {
+		if err != nil {
+			b.logger.Error().Err(err).Msgf("vote %s failed", msgURL)
+			return
+		}
+		b.logger.Info().Msgf("vote %s confirmed in zeta tx %s", msgURL, zetaTxHash)
+	})
+}
+
+func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error) {
 	signerAddress := b.keys.GetOperatorAddress().String()
-	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, supply, blockNum)
+	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, priorityFee, supply, blockNum)
+	if b.voteAggregator != nil {
+		return "", b.submitVote(msg, PostGasPriceGasLimit)
+	}
 
 	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
 	if err != nil {
@@ -125,7 +145,43 @@ func (b *ZetaCoreBridge) AddTxHashToOutTxTracker(
 	return zetaTxHash, nil
 }
 
-func (b *ZetaCoreBridge) PostSend(zetaGasLimit uint64, msg *types.MsgVoteOnObservedInboundTx) (string, error) {
+// PostNonceGapReport reports on zetacore the action taken on an outbound nonce blocking the later nonces of a chain
+func (b *ZetaCoreBridge) PostNonceGapReport(
+	chainID int64,
+	nonce uint64,
+	tssNonce uint64,
+	cause types.OutTxNonceGapCause,
+	action types.OutTxNonceGapAction,
+	cancelTxHash string,
+) (string, error) {
+	signerAddress := b.keys.GetOperatorAddress().String()
+	msg := types.NewMsgReportOutTxNonceGap(signerAddress, chainID, nonce, tssNonce, cause, action, cancelTxHash)
+
+	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
+	if err != nil {
+		return "", err
+	}
+
+	zetaTxHash, err := b.Broadcast(PostNonceGapReportGasLimit, authzMsg, authzSigner)
+	if err != nil {
+		return "", err
+	}
+	return zetaTxHash, nil
+}
+
+// PostSend votes the inbound tx, the vote is only added to the next batch when the vote aggregator is enabled and the
+// returned zeta tx hash is empty
+func (b *ZetaCoreBridge) PostSend(zetaGasLimit uint64, msg *types.MsgVoteOnObservedInboundTx) (zetaTxHash string, err error) {
+	_, span := tracing.StartCctxSpan(context.Background(), tracer, "PostSend", msg.Digest(), inboundAttributes(msg)...)
+	defer func() {
+		span.SetAttributes(attribute.String("zeta.tx_hash", zetaTxHash))
+		tracing.EndSpan(span, err)
+	}()
+
+	if b.voteAggregator != nil {
+		return "", b.submitVote(msg, zetaGasLimit)
+	}
+
 	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
 	if err != nil {
 		return "", err
@@ -154,7 +210,19 @@ func (b *ZetaCoreBridge) PostReceiveConfirmation(
 	chain common.Chain,
 	nonce uint64,
 	coinType common.CoinType,
-) (string, string, error) {
+) (zetaTxHash string, ballotIndex string, err error) {
+	_, span := tracing.StartCctxSpan(context.Background(), tracer, "PostReceiveConfirmation", sendHash,
+		tracing.AttrOutboundHash.String(outTxHash),
+		tracing.AttrReceiverChainID.Int64(chain.ChainId),
+		// #nosec G701 always in range
+		tracing.AttrOutboundNonce.Int64(int64(nonce)),
+		attribute.String("vote.status", status.String()),
+	)
+	defer func() {
+		span.SetAttributes(attribute.String("zeta.tx_hash", zetaTxHash))
+		tracing.EndSpan(span, err)
+	}()
+
 	signerAddress := b.keys.GetOperatorAddress().String()
 	msg := types.NewMsgVoteOnObservedOutboundTx(
 		signerAddress,
@@ -177,11 +245,12 @@ func (b *ZetaCoreBridge) PostReceiveConfirmation(
 	}
 
 	// don't post confirmation if has already voted before
-	ballotIndex := msg.Digest()
+	ballotIndex = msg.Digest()
 	hasVoted, err := b.HasVoted(ballotIndex, msg.Creator)
 	if err != nil {
 		return "", ballotIndex, errors.Wrapf(err, "PostReceiveConfirmation: unable to check if already voted for ballot %s voter %s", ballotIndex, msg.Creator)
 	}
+	span.SetAttributes(attribute.Bool("vote.already_voted", hasVoted))
 	if hasVoted {
 		return "", ballotIndex, nil
 	}
@@ -192,6 +261,9 @@ func (b *ZetaCoreBridge) PostReceiveConfirmation(
 	if status == common.ReceiveStatus_Failed {
 		gasLimit = PostSendEVMGasLimit
 	}
+	if b.voteAggregator != nil {
+		return "", ballotIndex, b.submitVote(msg, gasLimit)
+	}
 	for i := 0; i < DefaultRetryCount; i++ {
 		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
 		if err == nil {
@@ -274,6 +346,9 @@ func (b *ZetaCoreBridge) PostAddBlockHeader(chainID int64, blockHash []byte, hei
 	signerAddress := b.keys.GetOperatorAddress().String()
 
 	msg := observerTypes.NewMsgAddBlockHeader(signerAddress, chainID, blockHash, height, header)
+	if b.voteAggregator != nil {
+		return "", b.submitVote(msg, DefaultGasLimit)
+	}
 
 	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
 	if err != nil {
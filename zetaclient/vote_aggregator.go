@@ -0,0 +1,386 @@
+package zetaclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// VoteBatchMaxMsgs is the maximum number of votes broadcast in a single tx
+	VoteBatchMaxMsgs = 50
+
+	// VoteBatchMaxGasLimit is the maximum gas limit of a batch, the larger batches are split
+	VoteBatchMaxGasLimit = 10_000_000
+
+	// VoteBatchGasAdjustment is the percentage of the simulated gas used set as the gas limit of a batch
+	VoteBatchGasAdjustment = 150
+
+	// VoteBatchConfirmInterval is the interval of the queries of the result of a broadcast batch
+	VoteBatchConfirmInterval = 2 * time.Second
+
+	// VoteBatchConfirmTimeout is the time after which the votes of a batch not included in a block are submitted again
+	VoteBatchConfirmTimeout = 60 * time.Second
+)
+
+var (
+	// ErrVoteRejected is returned when a vote is rejected by the simulation of its batch
+	ErrVoteRejected = errors.New("vote rejected by zetacore")
+
+	// ErrVoteAggregatorStopped is returned for the votes submitted to a stopped aggregator
+	ErrVoteAggregatorStopped = errors.New("vote aggregator stopped")
+)
+
+// voteBroadcaster simulates and broadcasts the batches of votes of the aggregator
+type voteBroadcaster interface {
+	// voteSigner returns the key of the signer of a vote, only the votes of the same signer are batched together
+	voteSigner(msg sdk.Msg) string
+
+	// simulateVotes returns the gas used by the batch, the error wraps ErrVoteRejected if a vote of the batch fails
+	simulateVotes(msgs []sdk.Msg) (uint64, error)
+
+	// broadcastVotes broadcasts the batch in a single tx and returns the tx hash
+	broadcastVotes(gasLimit uint64, msgs []sdk.Msg) (string, error)
+
+	// getTxResult returns the result of a tx included in a block
+	getTxResult(txHash string) (*sdk.TxResponse, error)
+}
+
+// VoteCallback receives the hash of the tx of the batch of a vote once the tx is confirmed in a block, or the error
+// of the vote once it is dropped
+type VoteCallback func(txHash string, err error)
+
+// pendingVote is a vote waiting to be broadcast
+type pendingVote struct {
+	msg       sdk.Msg
+	key       string
+	signer    string
+	gasLimit  uint64
+	retries   int
+	callbacks []VoteCallback
+}
+
+// done reports the result of the vote to its submitters
+func (v *pendingVote) done(txHash string, err error) {
+	for _, callback := range v.callbacks {
+		if callback != nil {
+			callback(txHash, err)
+		}
+	}
+	v.callbacks = nil
+}
+
+// voteBatch is a batch of votes broadcast in a single tx with its gas limit
+type voteBatch struct {
+	votes    []*pendingVote
+	gasLimit uint64
+}
+
+// VoteAggregator collects the votes submitted within a window and broadcasts them as a single authz exec tx
+// The gas limit of a batch is computed from its simulation, the votes rejected by the simulation are isolated by
+// splitting the batch and are reported to their submitters. The result of the other votes is reported once their batch
+// is confirmed in a block, the votes of a batch failing once included in a block are submitted again.
+type VoteAggregator struct {
+	broadcaster voteBroadcaster
+	window      time.Duration
+	logger      zerolog.Logger
+
+	mu           sync.Mutex
+	pending      []*pendingVote
+	pendingByKey map[string]*pendingVote
+	stopped      bool
+
+	notify chan struct{}
+	full   chan struct{}
+	stop   chan struct{}
+
+	retryInterval   time.Duration
+	confirmInterval time.Duration
+	confirmTimeout  time.Duration
+}
+
+// NewVoteAggregator creates a new vote aggregator broadcasting the votes submitted within the window
+func NewVoteAggregator(broadcaster voteBroadcaster, window time.Duration, logger zerolog.Logger) *VoteAggregator {
+	return &VoteAggregator{
+		broadcaster:     broadcaster,
+		window:          window,
+		logger:          logger.With().Str("module", "VoteAggregator").Logger(),
+		pendingByKey:    make(map[string]*pendingVote),
+		notify:          make(chan struct{}, 1),
+		full:            make(chan struct{}, 1),
+		stop:            make(chan struct{}),
+		retryInterval:   DefaultRetryInterval * time.Second,
+		confirmInterval: VoteBatchConfirmInterval,
+		confirmTimeout:  VoteBatchConfirmTimeout,
+	}
+}
+
+// Start broadcasts the pending votes at the end of each window until the aggregator is stopped
+func (a *VoteAggregator) Start() {
+	a.logger.Info().Msgf("VoteAggregator started with a window of %s", a.window)
+	defer a.logger.Info().Msg("VoteAggregator stopped")
+	defer a.dropPending()
+	for {
+		select {
+		case <-a.notify:
+		case <-a.stop:
+			return
+		}
+
+		// collect the votes submitted within the window, a full batch is broadcast right away
+		timer := time.NewTimer(a.window)
+		select {
+		case <-timer.C:
+		case <-a.full:
+			timer.Stop()
+		case <-a.stop:
+			timer.Stop()
+			return
+		}
+		a.flush()
+	}
+}
+
+// Stop stops the aggregator, the pending votes are not broadcast and fail with ErrVoteAggregatorStopped
+func (a *VoteAggregator) Stop() {
+	close(a.stop)
+}
+
+// Submit adds a vote to the next batch and returns without waiting for its broadcast
+// The callback is called once the batch of the vote is confirmed in a block or once the vote is dropped, it may be nil.
+func (a *VoteAggregator) Submit(msg sdk.Msg, gasLimit uint64, callback VoteCallback) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	key, err := voteKey(msg)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-a.stop:
+		return ErrVoteAggregatorStopped
+	default:
+	}
+
+	return a.enqueue(&pendingVote{
+		msg:       msg,
+		key:       key,
+		signer:    a.broadcaster.voteSigner(msg),
+		gasLimit:  gasLimit,
+		callbacks: []VoteCallback{callback},
+	})
+}
+
+// enqueue adds a vote to the next batch, the submitters of a vote already pending are added to the pending vote
+func (a *VoteAggregator) enqueue(vote *pendingVote) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.stopped {
+		return ErrVoteAggregatorStopped
+	}
+	if pending, found := a.pendingByKey[vote.key]; found {
+		pending.callbacks = append(pending.callbacks, vote.callbacks...)
+		return nil
+	}
+	a.enqueueLocked(vote)
+	return nil
+}
+
+func (a *VoteAggregator) enqueueLocked(vote *pendingVote) {
+	a.pending = append(a.pending, vote)
+	a.pendingByKey[vote.key] = vote
+	signal(a.notify)
+	if len(a.pending) >= VoteBatchMaxMsgs {
+		signal(a.full)
+	}
+}
+
+// takePending removes the pending votes from the aggregator and returns them
+func (a *VoteAggregator) takePending() []*pendingVote {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	votes := a.pending
+	a.pending = nil
+	a.pendingByKey = make(map[string]*pendingVote)
+	return votes
+}
+
+// dropPending fails the pending votes of a stopped aggregator and the votes submitted again afterwards
+func (a *VoteAggregator) dropPending() {
+	a.mu.Lock()
+	a.stopped = true
+	a.mu.Unlock()
+	for _, vote := range a.takePending() {
+		vote.done("", ErrVoteAggregatorStopped)
+	}
+}
+
+// flush broadcasts the pending votes, the votes of each signer are broadcast in their own batches
+func (a *VoteAggregator) flush() {
+	var signers []string
+	votesBySigner := make(map[string][]*pendingVote)
+	for _, vote := range a.takePending() {
+		if _, found := votesBySigner[vote.signer]; !found {
+			signers = append(signers, vote.signer)
+		}
+		votesBySigner[vote.signer] = append(votesBySigner[vote.signer], vote)
+	}
+
+	for _, signer := range signers {
+		votes := votesBySigner[signer]
+		for len(votes) > 0 {
+			n := len(votes)
+			if n > VoteBatchMaxMsgs {
+				n = VoteBatchMaxMsgs
+			}
+			for _, batch := range a.splitBatch(votes[:n]) {
+				a.broadcastBatch(batch)
+			}
+			votes = votes[n:]
+		}
+	}
+}
+
+// splitBatch simulates the votes and returns the batches to broadcast
+// The batch is split in halves until the votes rejected by the simulation are isolated and returned to their
+// submitters, or until the gas limit of each batch is within VoteBatchMaxGasLimit. If the batch can't be simulated, the
+// gas limit is the sum of the gas limits of the votes.
+func (a *VoteAggregator) splitBatch(votes []*pendingVote) []voteBatch {
+	gasUsed, err := a.broadcaster.simulateVotes(voteMsgs(votes))
+	switch {
+	case err == nil:
+		gasLimit := gasUsed * VoteBatchGasAdjustment / 100
+		if gasLimit <= VoteBatchMaxGasLimit || len(votes) == 1 {
+			return []voteBatch{{votes: votes, gasLimit: gasLimit}}
+		}
+	case errors.Is(err, ErrVoteRejected):
+		if len(votes) == 1 {
+			a.logger.Warn().Err(err).Msgf("vote %s rejected", sdk.MsgTypeURL(votes[0].msg))
+			votes[0].done("", err)
+			return nil
+		}
+	default:
+		a.logger.Warn().Err(err).Msgf("unable to simulate a batch of %d votes", len(votes))
+		return splitByGasLimit(votes)
+	}
+	mid := len(votes) / 2
+	return append(a.splitBatch(votes[:mid]), a.splitBatch(votes[mid:])...)
+}
+
+// broadcastBatch broadcasts a batch of votes with retries and waits for its inclusion in a block in the background, the
+// votes of a batch that can't be broadcast are dropped
+func (a *VoteAggregator) broadcastBatch(batch voteBatch) {
+	msgs := voteMsgs(batch.votes)
+	var (
+		txHash string
+		err    error
+	)
+	for i := 0; i < DefaultRetryCount; i++ {
+		txHash, err = a.broadcaster.broadcastVotes(batch.gasLimit, msgs)
+		if err == nil {
+			break
+		}
+		a.logger.Debug().Err(err).Msgf("broadcast of a batch of %d votes fail | Retry count : %d", len(msgs), i+1)
+		time.Sleep(a.retryInterval)
+	}
+	if err != nil {
+		a.logger.Error().Err(err).Msgf("broadcast of a batch of %d votes failed after %d retries", len(msgs), DefaultRetryCount)
+		for _, vote := range batch.votes {
+			vote.done("", err)
+		}
+		return
+	}
+	a.logger.Info().Msgf("broadcast a batch of %d votes with gas limit %d in tx %s", len(msgs), batch.gasLimit, txHash)
+	go a.confirmBatch(txHash, batch.votes)
+}
+
+// confirmBatch waits for the inclusion of a batch in a block and reports the result of its votes, the votes of a batch
+// failing or not included before the timeout are submitted again
+func (a *VoteAggregator) confirmBatch(txHash string, votes []*pendingVote) {
+	deadline := time.Now().Add(a.confirmTimeout)
+	var failure error
+	for failure == nil {
+		res, err := a.broadcaster.getTxResult(txHash)
+		switch {
+		case err == nil && res != nil && res.Code == 0:
+			for _, vote := range votes {
+				vote.done(txHash, nil)
+			}
+			return
+		case err == nil && res != nil:
+			failure = fmt.Errorf("batch tx %s failed with code %d: %s", txHash, res.Code, res.RawLog)
+		case time.Now().After(deadline):
+			failure = fmt.Errorf("batch tx %s not included in a block after %s", txHash, a.confirmTimeout)
+		default:
+			select {
+			case <-time.After(a.confirmInterval):
+			case <-a.stop:
+				for _, vote := range votes {
+					vote.done("", ErrVoteAggregatorStopped)
+				}
+				return
+			}
+		}
+	}
+	a.logger.Warn().Err(failure).Msgf("submitting again the %d votes of the batch", len(votes))
+
+	for _, vote := range votes {
+		vote.retries++
+		if vote.retries > DefaultRetryCount {
+			a.logger.Error().Msgf("vote %s dropped after %d retries", sdk.MsgTypeURL(vote.msg), DefaultRetryCount)
+			vote.done("", failure)
+			continue
+		}
+		if err := a.enqueue(vote); err != nil {
+			vote.done("", err)
+		}
+	}
+}
+
+// splitByGasLimit splits the votes in batches whose sum of the gas limits of the votes is within VoteBatchMaxGasLimit
+func splitByGasLimit(votes []*pendingVote) []voteBatch {
+	var batches []voteBatch
+	var batch voteBatch
+	for _, vote := range votes {
+		if len(batch.votes) > 0 && batch.gasLimit+vote.gasLimit > VoteBatchMaxGasLimit {
+			batches = append(batches, batch)
+			batch = voteBatch{}
+		}
+		batch.votes = append(batch.votes, vote)
+		batch.gasLimit += vote.gasLimit
+	}
+	if len(batch.votes) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// voteKey returns the key identifying the same votes
+func voteKey(msg sdk.Msg) (string, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return sdk.MsgTypeURL(msg) + string(bz), nil
+}
+
+func voteMsgs(votes []*pendingVote) []sdk.Msg {
+	msgs := make([]sdk.Msg, len(votes))
+	for i, vote := range votes {
+		msgs[i] = vote.msg
+	}
+	return msgs
+}
+
+// signal notifies a channel without blocking
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,281 @@
+package zetaclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const testVoteGasUsed = 100_000
+
+// testVoteBroadcaster records the broadcast batches, the votes of the rejected blocks fail the simulation
+type testVoteBroadcaster struct {
+	mu              sync.Mutex
+	rejectedBlocks  map[uint64]bool
+	simulationError error
+	failedTxs       map[string]bool
+	pendingTxs      map[string]bool
+	batches         [][]sdk.Msg
+	gasLimits       []uint64
+}
+
+// voteSigner returns the creator of the vote as its signer
+func (b *testVoteBroadcaster) voteSigner(msg sdk.Msg) string {
+	return msg.GetSigners()[0].String()
+}
+
+func (b *testVoteBroadcaster) simulateVotes(msgs []sdk.Msg) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.simulationError != nil {
+		return 0, b.simulationError
+	}
+	for _, msg := range msgs[1:] {
+		if b.voteSigner(msg) != b.voteSigner(msgs[0]) {
+			return 0, errors.New("votes of different signers")
+		}
+	}
+	for _, msg := range msgs {
+		if b.rejectedBlocks[msg.(*types.MsgGasPriceVoter).BlockNumber] {
+			return 0, fmt.Errorf("%w: failed to execute message", ErrVoteRejected)
+		}
+	}
+	return uint64(len(msgs)) * testVoteGasUsed, nil
+}
+
+func (b *testVoteBroadcaster) broadcastVotes(gasLimit uint64, msgs []sdk.Msg) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.batches = append(b.batches, msgs)
+	b.gasLimits = append(b.gasLimits, gasLimit)
+	return fmt.Sprintf("hash%d", len(b.batches)), nil
+}
+
+func (b *testVoteBroadcaster) getTxResult(txHash string) (*sdk.TxResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.pendingTxs[txHash] {
+		return nil, errors.New("tx not found")
+	}
+	if b.failedTxs[txHash] {
+		return &sdk.TxResponse{TxHash: txHash, Code: 1, RawLog: "out of gas"}, nil
+	}
+	return &sdk.TxResponse{TxHash: txHash}, nil
+}
+
+func (b *testVoteBroadcaster) broadcastBlocks() [][]uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	blocks := make([][]uint64, len(b.batches))
+	for i, batch := range b.batches {
+		for _, msg := range batch {
+			blocks[i] = append(blocks[i], msg.(*types.MsgGasPriceVoter).BlockNumber)
+		}
+	}
+	return blocks
+}
+
+func newTestVoteAggregator(t *testing.T, broadcaster *testVoteBroadcaster) *VoteAggregator {
+	aggregator := NewVoteAggregator(broadcaster, 50*time.Millisecond, zerolog.Nop())
+	aggregator.retryInterval = time.Millisecond
+	aggregator.confirmInterval = time.Millisecond
+	aggregator.confirmTimeout = time.Second
+	go aggregator.Start()
+	t.Cleanup(aggregator.Stop)
+	return aggregator
+}
+
+// voteResult is the result of a vote reported by the aggregator
+type voteResult struct {
+	txHash string
+	err    error
+}
+
+// submitVotes submits the gas price votes of the blocks and returns the channels receiving the results of the votes
+func submitVotes(t *testing.T, aggregator *VoteAggregator, creator string, blocks ...uint64) []chan voteResult {
+	results := make([]chan voteResult, len(blocks))
+	for i, block := range blocks {
+		result := make(chan voteResult, 1)
+		msg := types.NewMsgGasPriceVoter(creator, common.GoerliLocalnetChain().ChainId, 1000, 0, "100", block)
+		err := aggregator.Submit(msg, PostGasPriceGasLimit, func(txHash string, err error) {
+			result <- voteResult{txHash: txHash, err: err}
+		})
+		require.NoError(t, err)
+		results[i] = result
+	}
+	return results
+}
+
+// waitVotes waits for the results of the votes
+func waitVotes(t *testing.T, results []chan voteResult) []voteResult {
+	votes := make([]voteResult, len(results))
+	for i, result := range results {
+		select {
+		case votes[i] = <-result:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no result for the vote", "vote %d", i)
+		}
+	}
+	return votes
+}
+
+func TestVoteAggregator_Submit(t *testing.T) {
+	creator := sample.AccAddress()
+
+	t.Run("should broadcast the votes submitted within the window in a single tx", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1, 2, 3))
+		for _, res := range results {
+			require.NoError(t, res.err)
+			require.Equal(t, "hash1", res.txHash)
+		}
+		require.ElementsMatch(t, []uint64{1, 2, 3}, broadcaster.broadcastBlocks()[0])
+		require.Equal(t, []uint64{3 * testVoteGasUsed * VoteBatchGasAdjustment / 100}, broadcaster.gasLimits)
+	})
+
+	t.Run("should broadcast the same vote once", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1, 1))
+		for _, res := range results {
+			require.NoError(t, res.err)
+		}
+		require.Equal(t, [][]uint64{{1}}, broadcaster.broadcastBlocks())
+	})
+
+	t.Run("should isolate the votes rejected by the simulation", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{rejectedBlocks: map[uint64]bool{2: true}}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1, 2, 3, 4))
+		for i, res := range results {
+			if i == 1 {
+				require.ErrorIs(t, res.err, ErrVoteRejected)
+			} else {
+				require.NoError(t, res.err)
+			}
+		}
+		var broadcast []uint64
+		for _, blocks := range broadcaster.broadcastBlocks() {
+			broadcast = append(broadcast, blocks...)
+		}
+		require.ElementsMatch(t, []uint64{1, 3, 4}, broadcast)
+	})
+
+	t.Run("should use the gas limits of the votes if the simulation is unavailable", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{simulationError: errors.New("connection refused")}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		// the sum of the gas limits of the votes is within the maximum gas limit of a batch
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1, 2, 3, 4, 5, 6, 7))
+		for _, res := range results {
+			require.NoError(t, res.err)
+		}
+		blocks := broadcaster.broadcastBlocks()
+		require.Len(t, blocks, 2)
+		require.Len(t, blocks[0], 6)
+		require.Len(t, blocks[1], 1)
+		require.Equal(t, []uint64{6 * PostGasPriceGasLimit, PostGasPriceGasLimit}, broadcaster.gasLimits)
+	})
+
+	t.Run("should submit again the votes of a failed batch", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{failedTxs: map[string]bool{"hash1": true}}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		// the votes are reported once the batch submitted again is confirmed
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1, 2))
+		for _, res := range results {
+			require.NoError(t, res.err)
+			require.Equal(t, "hash2", res.txHash)
+		}
+		blocks := broadcaster.broadcastBlocks()
+		require.Len(t, blocks, 2)
+		require.ElementsMatch(t, []uint64{1, 2}, blocks[1])
+	})
+
+	t.Run("should fail the votes of a batch failing after the retries", func(t *testing.T) {
+		failedTxs := make(map[string]bool)
+		for i := 1; i <= DefaultRetryCount+1; i++ {
+			failedTxs[fmt.Sprintf("hash%d", i)] = true
+		}
+		broadcaster := &testVoteBroadcaster{failedTxs: failedTxs}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := waitVotes(t, submitVotes(t, aggregator, creator, 1))
+		require.ErrorContains(t, results[0].err, "failed with code 1")
+		require.Len(t, broadcaster.broadcastBlocks(), DefaultRetryCount+1)
+	})
+
+	t.Run("should not report the votes before the confirmation of their batch", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{pendingTxs: map[string]bool{"hash1": true}}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := submitVotes(t, aggregator, creator, 1)
+		require.Eventually(t, func() bool {
+			return len(broadcaster.broadcastBlocks()) == 1
+		}, time.Second, 10*time.Millisecond)
+		select {
+		case res := <-results[0]:
+			require.FailNow(t, "vote reported before the confirmation", "result %v", res)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		broadcaster.mu.Lock()
+		broadcaster.pendingTxs = nil
+		broadcaster.mu.Unlock()
+		res := waitVotes(t, results)[0]
+		require.NoError(t, res.err)
+		require.Equal(t, "hash1", res.txHash)
+	})
+
+	t.Run("should batch the votes of each signer separately", func(t *testing.T) {
+		broadcaster := &testVoteBroadcaster{}
+		aggregator := newTestVoteAggregator(t, broadcaster)
+
+		results := submitVotes(t, aggregator, creator, 1, 2)
+		results = append(results, submitVotes(t, aggregator, sample.AccAddress(), 3)...)
+		for _, res := range waitVotes(t, results) {
+			require.NoError(t, res.err)
+		}
+		require.ElementsMatch(t, [][]uint64{{1, 2}, {3}}, broadcaster.broadcastBlocks())
+	})
+
+	t.Run("should fail the invalid votes", func(t *testing.T) {
+		aggregator := newTestVoteAggregator(t, &testVoteBroadcaster{})
+		err := aggregator.Submit(types.NewMsgGasPriceVoter("invalid", 1, 1000, 0, "100", 1), PostGasPriceGasLimit, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail the votes submitted to a stopped aggregator", func(t *testing.T) {
+		aggregator := NewVoteAggregator(&testVoteBroadcaster{}, time.Millisecond, zerolog.Nop())
+		aggregator.Stop()
+		err := aggregator.Submit(types.NewMsgGasPriceVoter(creator, common.GoerliLocalnetChain().ChainId, 1000, 0, "100", 1), PostGasPriceGasLimit, nil)
+		require.ErrorIs(t, err, ErrVoteAggregatorStopped)
+	})
+
+	t.Run("should fail the pending votes when the aggregator is stopped", func(t *testing.T) {
+		aggregator := NewVoteAggregator(&testVoteBroadcaster{}, time.Hour, zerolog.Nop())
+		done := make(chan struct{})
+		go func() {
+			aggregator.Start()
+			close(done)
+		}()
+
+		results := submitVotes(t, aggregator, creator, 1)
+		aggregator.Stop()
+		<-done
+		require.ErrorIs(t, waitVotes(t, results)[0].err, ErrVoteAggregatorStopped)
+	})
+}
@@ -1,12 +1,14 @@
 package zetaclient
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/hashicorp/go-retryablehttp"
@@ -18,6 +20,7 @@ import (
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
 	"google.golang.org/grpc"
 )
 
@@ -40,6 +43,12 @@ type ZetaCoreBridge struct {
 	stop          chan struct{}
 	pause         chan struct{}
 	Telemetry     *TelemetryServer
+
+	// voteAggregator broadcasts the votes in batches when enabled
+	voteAggregator *VoteAggregator
+
+	// remoteSigner signs the txs of the hot key when enabled
+	remoteSigner *remotesigner.Client
 }
 
 // NewZetaCoreBridge create a new instance of ZetaCoreBridge
@@ -136,6 +145,39 @@ func (b *ZetaCoreBridge) ZetaChain() common.Chain {
 func (b *ZetaCoreBridge) Stop() {
 	b.logger.Info().Msgf("ZetaBridge is stopping")
 	close(b.stop) // this notifies all configupdater to stop
+	if b.voteAggregator != nil {
+		b.voteAggregator.Stop()
+	}
+	if b.remoteSigner != nil {
+		if err := b.remoteSigner.Close(); err != nil {
+			b.logger.Error().Err(err).Msg("fail to close the connection to the remote signer")
+		}
+	}
+}
+
+// EnableRemoteSigner signs the txs with the remote signer, the key of the remote signer must be the hot key
+// The private key of the hot key must not be in the local keyring, only its public key
+func (b *ZetaCoreBridge) EnableRemoteSigner(signer *remotesigner.Client) error {
+	if b.cfg.HsmMode {
+		return errors.New("the remote signer can't be enabled in hsm mode")
+	}
+	if b.keys.HasPrivateKey() {
+		return fmt.Errorf("the private key of the hot key %s must not be in the local keyring with the remote signer", b.keys.GetAddress())
+	}
+	hotkeyAddress := b.keys.GetAddress()
+	signerAddress := sdk.AccAddress(signer.PubKey().Address())
+	if !signerAddress.Equals(hotkeyAddress) {
+		return fmt.Errorf("the address %s of the remote signer is not the hot key address %s", signerAddress, hotkeyAddress)
+	}
+	b.remoteSigner = signer
+	return nil
+}
+
+// EnableVoteAggregator broadcasts the inbound, outbound, gas price and block header votes submitted within the window
+// in a single tx
+func (b *ZetaCoreBridge) EnableVoteAggregator(window time.Duration) {
+	b.voteAggregator = NewVoteAggregator(b, window, b.logger)
+	go b.voteAggregator.Start()
 }
 
 // GetAccountNumberAndSequenceNumber We do not use multiple KeyType for now , but this can be optionally used in the future to seprate TSS signer from Zetaclient GRantee
@@ -13,12 +13,14 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 )
 
 const (
 	MaxLookaheadNonce   = 120
+	MaxKeysignBatchSize = 20
 	OutboundTxSignCount = "zetaclient_Outbound_tx_sign_count"
 	HotKeyBurnRate      = "zetaclient_hotkey_burn_rate"
 )
@@ -39,6 +41,8 @@ type CoreObserver struct {
 	ts                  *TelemetryServer
 	stop                chan struct{}
 	lastOperatorBalance sdkmath.Int
+	outTxMan            *OutTxProcessorManager
+	nonceGaps           map[int64]NonceGap
 }
 
 // NewCoreObserver creates a new CoreObserver
@@ -52,8 +56,9 @@ func NewCoreObserver(
 	ts *TelemetryServer,
 ) *CoreObserver {
 	co := CoreObserver{
-		ts:   ts,
-		stop: make(chan struct{}),
+		ts:        ts,
+		stop:      make(chan struct{}),
+		nonceGaps: make(map[int64]NonceGap),
 	}
 	co.cfg = cfg
 	chainLogger := logger.With().
@@ -69,6 +74,7 @@ func NewCoreObserver(
 
 	co.clientMap = clientMap
 	co.metrics = metrics
+	co.outTxMan = NewOutTxProcessorManager(co.logger.ChainLogger)
 	co.logger.ChainLogger.Info().Msg("starting core observer")
 	err := metrics.RegisterCounter(OutboundTxSignCount, "number of Outbound tx signed")
 	if err != nil {
@@ -91,6 +97,16 @@ func (co *CoreObserver) Config() *config.Config {
 	return co.cfg
 }
 
+// OutTxProcessorManager returns the manager of the outtxs being processed by the signers
+func (co *CoreObserver) OutTxProcessorManager() *OutTxProcessorManager {
+	return co.outTxMan
+}
+
+// ChainClient returns the chain client of the chain
+func (co *CoreObserver) ChainClient(chainID int64) (ChainClient, error) {
+	return co.getTargetChainOb(chainID)
+}
+
 func (co *CoreObserver) GetPromCounter(name string) (prom.Counter, error) {
 	cnt, found := metrics.Counters[name]
 	if !found {
@@ -125,7 +141,7 @@ func (co *CoreObserver) MonitorCore() {
 
 // startCctxScheduler schedules keysigns for cctxs on each ZetaChain block (the ticker)
 func (co *CoreObserver) startCctxScheduler() {
-	outTxMan := NewOutTxProcessorManager(co.logger.ChainLogger)
+	outTxMan := co.outTxMan
 	observeTicker := time.NewTicker(3 * time.Second)
 	var lastBlockNum int64
 	for {
@@ -172,12 +188,23 @@ func (co *CoreObserver) startCctxScheduler() {
 					} // Gauge only takes float values
 					gauge.Set(float64(co.ts.hotKeyBurnRate.GetBurnRate().Int64()))
 
+					// the outbound of the chains paused on zetacore is not scheduled
+					flags, err := co.bridge.GetCrosschainFlags()
+					if err != nil {
+						co.logger.ZetaChainWatcher.Error().Err(err).Msg("startCctxScheduler: GetCrosschainFlags fail")
+						continue
+					}
+
 					// schedule keysign for pending cctxs on each chain
 					supportedChains := co.Config().GetEnabledChains()
 					for _, c := range supportedChains {
 						if c.ChainId == co.bridge.ZetaChain().ChainId {
 							continue
 						}
+						if reason, paused := co.outboundPauseReason(flags, c.ChainId); paused {
+							co.logger.ZetaChainWatcher.Warn().Msgf("startCctxScheduler: chain %d is paused: %s", c.ChainId, reason)
+							continue
+						}
 						signer := co.signerMap[c]
 
 						cctxList, totalPending, err := co.bridge.ListPendingCctx(c.ChainId)
@@ -218,6 +245,15 @@ func (co *CoreObserver) startCctxScheduler() {
 	}
 }
 
+// outboundPauseReason returns true and the reason if the outbound of the chain is paused on zetacore or by the operator
+// of this client
+func (co *CoreObserver) outboundPauseReason(flags observertypes.CrosschainFlags, chainID int64) (string, bool) {
+	if pause, paused := flags.GetOutboundPause(chainID); paused {
+		return fmt.Sprintf("paused on zetacore by %s: %s", pause.Creator, pause.Reason), true
+	}
+	return co.Config().IsChainPaused(chainID)
+}
+
 // scheduleCctxEVM schedules evm outtx keysign on each ZetaChain block (the ticker)
 func (co *CoreObserver) scheduleCctxEVM(
 	outTxMan *OutTxProcessorManager,
@@ -236,6 +272,12 @@ func (co *CoreObserver) scheduleCctxEVM(
 		trackerMap[v.Nonce] = true
 	}
 
+	// the nonce blocking the chain, if any, is re-prioritized
+	gap := co.checkNonceGap(chainID, res, ob)
+
+	// the cctxs scheduled at this height are signed together
+	var scheduled []*types.CrossChainTx
+	var scheduledIDs []string
 	for idx, cctx := range cctxList {
 		params := cctx.GetCurrentOutTxParam()
 		nonce := params.OutboundTxTssNonce
@@ -286,11 +328,17 @@ func (co *CoreObserver) scheduleCctxEVM(
 			interval = nonCriticalInterval
 		}
 
-		// otherwise, the normal interval is used
-		if nonce%interval == zetaHeight%interval && !outTxMan.IsOutTxActive(outTxID) {
+		if gap != nil && nonce == gap.Nonce {
+			// the blocking nonce is signed on its own with a short interval
+			if nonce%NonceGapInterval == zetaHeight%NonceGapInterval && !outTxMan.IsOutTxActive(outTxID) {
+				co.processNonceGap(outTxMan, zetaHeight, cctx, outTxID, *gap, ob, signer)
+			}
+		} else if nonce%interval == zetaHeight%interval && !outTxMan.IsOutTxActive(outTxID) { // otherwise, the normal interval is used
 			outTxMan.StartTryProcess(outTxID)
+			traceOutboundScheduled(cctx, zetaHeight)
 			co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxEVM: sign outtx %s with value %d\n", outTxID, cctx.GetCurrentOutTxParam().Amount)
-			go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
+			scheduled = append(scheduled, cctx)
+			scheduledIDs = append(scheduledIDs, outTxID)
 		}
 
 		// #nosec G701 always in range
@@ -298,11 +346,66 @@ func (co *CoreObserver) scheduleCctxEVM(
 			break
 		}
 	}
+	co.processOutTxs(outTxMan, zetaHeight, scheduled, scheduledIDs, ob, signer)
+}
+
+// processOutTxs starts the keysign of the scheduled cctxs of a chain
+// The cctxs are signed in batches of up to MaxKeysignBatchSize consecutive cctxs if the signer supports batched keysign,
+// one by one otherwise
+func (co *CoreObserver) processOutTxs(
+	outTxMan *OutTxProcessorManager,
+	zetaHeight uint64,
+	cctxs []*types.CrossChainTx,
+	outTxIDs []string,
+	ob ChainClient,
+	signer ChainSigner) {
+	batchSigner, ok := signer.(BatchChainSigner)
+	if !ok || len(cctxs) < 2 {
+		for i, cctx := range cctxs {
+			go signer.TryProcessOutTx(cctx, outTxMan, outTxIDs[i], ob, co.bridge, zetaHeight)
+		}
+		return
+	}
+	for start := 0; start < len(cctxs); start += MaxKeysignBatchSize {
+		end := start + MaxKeysignBatchSize
+		if end > len(cctxs) {
+			end = len(cctxs)
+		}
+		if end-start == 1 {
+			go signer.TryProcessOutTx(cctxs[start], outTxMan, outTxIDs[start], ob, co.bridge, zetaHeight)
+			continue
+		}
+		co.logger.ZetaChainWatcher.Debug().Msgf("processOutTxs: sign %d outtxs in a batch, from %s", end-start, outTxIDs[start])
+		go batchSigner.TryProcessOutTxBatch(cctxs[start:end], outTxMan, outTxIDs[start:end], ob, co.bridge, zetaHeight)
+	}
+}
+
+// processNonceGap starts the keysign of the cctx of the nonce blocking a chain
+// The outbound tx is replaced by a cancel tx once the cctx has been pending longer than types.NonceGapCancelTimeout
+func (co *CoreObserver) processNonceGap(
+	outTxMan *OutTxProcessorManager,
+	zetaHeight uint64,
+	cctx *types.CrossChainTx,
+	outTxID string,
+	gap NonceGap,
+	ob ChainClient,
+	signer ChainSigner) {
+	outTxMan.StartTryProcess(outTxID)
+	traceOutboundScheduled(cctx, zetaHeight)
+
+	cancelSigner, ok := signer.(CancelChainSigner)
+	if ok && cctx.IsNonceGapCancelDue(time.Now()) {
+		co.logger.ZetaChainWatcher.Warn().Msgf("processNonceGap: cancel outtx %s blocking the chain since %d", outTxID, cctx.CctxStatus.LastUpdateTimestamp)
+		go cancelSigner.TryCancelOutTx(cctx, outTxMan, outTxID, gap, co.bridge, zetaHeight)
+		return
+	}
+	co.logger.ZetaChainWatcher.Info().Msgf("processNonceGap: sign blocking outtx %s", outTxID)
+	go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
 }
 
 // scheduleCctxBTC schedules bitcoin outtx keysign on each ZetaChain block (the ticker)
-// 1. schedule at most one keysign per ticker
-// 2. schedule keysign only when nonce-mark UTXO is available
+// 1. schedule the consecutive cctxs from the pending nonce in a single batched keysign per ticker
+// 2. schedule keysign only when nonce-mark UTXO is available, the later txs of a batch are chained to the nonce-marks of the earlier ones
 // 3. stop keysign when lookahead is reached
 func (co *CoreObserver) scheduleCctxBTC(
 	outTxMan *OutTxProcessorManager,
@@ -317,8 +420,10 @@ func (co *CoreObserver) scheduleCctxBTC(
 		return
 	}
 	lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
+	pendingNonce := btcClient.GetPendingNonce()
 
-	// schedule at most one keysign per ticker
+	scheduled := make([]*types.CrossChainTx, 0, MaxKeysignBatchSize)
+	scheduledIDs := make([]string, 0, MaxKeysignBatchSize)
 	for idx, cctx := range cctxList {
 		params := cctx.GetCurrentOutTxParam()
 		nonce := params.OutboundTxTssNonce
@@ -328,8 +433,8 @@ func (co *CoreObserver) scheduleCctxBTC(
 			co.logger.ZetaChainWatcher.Error().Msgf("scheduleCctxBTC: outtx %s chainid mismatch: want %d, got %d", outTxID, chainID, params.ReceiverChainId)
 			continue
 		}
-		// stop if the nonce being processed is higher than the pending nonce
-		if nonce > btcClient.GetPendingNonce() {
+		// stop if the nonce being processed is beyond the batch signed from the pending nonce
+		if nonce >= pendingNonce+MaxKeysignBatchSize {
 			break
 		}
 		// stop if lookahead is reached
@@ -337,13 +442,26 @@ func (co *CoreObserver) scheduleCctxBTC(
 			co.logger.ZetaChainWatcher.Warn().Msgf("scheduleCctxBTC: lookahead reached, signing %d, earliest pending %d", nonce, cctxList[0].GetCurrentOutTxParam().OutboundTxTssNonce)
 			break
 		}
-		// try confirming the outtx or scheduling a keysign
-		if !outTxMan.IsOutTxActive(outTxID) {
-			outTxMan.StartTryProcess(outTxID)
-			co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxBTC: sign outtx %s with value %d\n", outTxID, params.Amount)
-			go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
+		// the txs of the nonces below the pending nonce are included, only try confirming them
+		if nonce < pendingNonce {
+			if !outTxMan.IsOutTxActive(outTxID) {
+				outTxMan.StartTryProcess(outTxID)
+				traceOutboundScheduled(cctx, zetaHeight)
+				go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, zetaHeight)
+			}
+			continue
+		}
+		// stop at the first active or missing nonce, the later txs spend the nonce-mark of the tx of that nonce
+		if outTxMan.IsOutTxActive(outTxID) || nonce != pendingNonce+uint64(len(scheduled)) {
+			break
 		}
+		outTxMan.StartTryProcess(outTxID)
+		traceOutboundScheduled(cctx, zetaHeight)
+		co.logger.ZetaChainWatcher.Debug().Msgf("scheduleCctxBTC: sign outtx %s with value %d\n", outTxID, params.Amount)
+		scheduled = append(scheduled, cctx)
+		scheduledIDs = append(scheduledIDs, outTxID)
 	}
+	co.processOutTxs(outTxMan, zetaHeight, scheduled, scheduledIDs, ob, signer)
 }
 
 func (co *CoreObserver) getUpdatedChainOb(chainID int64) (ChainClient, error) {
@@ -355,13 +473,13 @@ func (co *CoreObserver) getUpdatedChainOb(chainID int64) (ChainClient, error) {
 	curParams := chainOb.GetCoreParams()
 	if common.IsEVMChain(chainID) {
 		evmCfg, found := co.cfg.GetEVMConfig(chainID)
-		if found && curParams != evmCfg.CoreParams {
+		if found && !observertypes.CoreParamsEqual(curParams, evmCfg.CoreParams) {
 			chainOb.SetCoreParams(evmCfg.CoreParams)
 			co.logger.ZetaChainWatcher.Info().Msgf("updated core params for chainID %d, new params: %v", chainID, evmCfg.CoreParams)
 		}
 	} else if common.IsBitcoinChain(chainID) {
 		_, btcCfg, found := co.cfg.GetBTCConfig()
-		if found && curParams != btcCfg.CoreParams {
+		if found && !observertypes.CoreParamsEqual(curParams, btcCfg.CoreParams) {
 			chainOb.SetCoreParams(btcCfg.CoreParams)
 			co.logger.ZetaChainWatcher.Info().Msgf("updated core params for Bitcoin, new params: %v", btcCfg.CoreParams)
 		}
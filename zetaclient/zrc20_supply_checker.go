@@ -0,0 +1,335 @@
+package zetaclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/protocol-contracts/pkg/openzeppelin/contracts/token/erc20/ierc20.sol"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+const (
+	// ZRC20SupplyDiscrepancy is the gauge of the holdings backing a ZRC20 on its chain minus the in-flight amount and the ZRC20 supply
+	// a negative value means the ZRC20 supply is not fully backed
+	ZRC20SupplyDiscrepancy = "zetaclient_zrc20_supply_discrepancy"
+
+	// zrc20SupplyPauseReason is the reason of the pause of the outbound of a chain by the supply checker
+	// the supply checker only resumes the outbound of the chains it paused
+	zrc20SupplyPauseReason = "zrc20 supply not backed"
+)
+
+// ZRC20SupplyChecker checks that the supply of each ZRC20 is backed by the holdings of the TSS and the ERC20 custody on its chain
+type ZRC20SupplyChecker struct {
+	cfg        *config.Config
+	evmClient  map[int64]*ethclient.Client
+	btcClient  *rpcclient.Client
+	zetaClient *ZetaCoreBridge
+	ticker     *DynamicTicker
+	stop       chan struct{}
+	logger     zerolog.Logger
+}
+
+func NewZRC20SupplyChecker(cfg *config.Config, zetaClient *ZetaCoreBridge, m *metrics.Metrics, logger zerolog.Logger) (ZRC20SupplyChecker, error) {
+	dynamicTicker, err := NewDynamicTicker("ZRC20SupplyTicker", 60)
+	if err != nil {
+		return ZRC20SupplyChecker{}, err
+	}
+
+	zrc20SupplyChecker := ZRC20SupplyChecker{
+		stop:      make(chan struct{}),
+		ticker:    dynamicTicker,
+		evmClient: make(map[int64]*ethclient.Client),
+		logger: logger.With().
+			Str("module", "ZRC20SupplyChecker").
+			Logger(),
+		cfg:        cfg,
+		zetaClient: zetaClient,
+	}
+	for _, evmConfig := range cfg.GetAllEVMConfigs() {
+		if evmConfig.Chain.IsZetaChain() {
+			continue
+		}
+		client, err := ethclient.Dial(evmConfig.Endpoint)
+		if err != nil {
+			return zrc20SupplyChecker, err
+		}
+		zrc20SupplyChecker.evmClient[evmConfig.Chain.ChainId] = client
+	}
+	if _, btcConfig, enabled := cfg.GetBTCConfig(); enabled {
+		connCfg := &rpcclient.ConnConfig{
+			Host:         btcConfig.RPCHost,
+			User:         btcConfig.RPCUsername,
+			Pass:         btcConfig.RPCPassword,
+			HTTPPostMode: true,
+			DisableTLS:   true,
+			Params:       btcConfig.RPCParams,
+		}
+		client, err := rpcclient.New(connCfg, nil)
+		if err != nil {
+			return zrc20SupplyChecker, fmt.Errorf("error creating rpc client: %s", err)
+		}
+		zrc20SupplyChecker.btcClient = client
+	}
+
+	err = m.RegisterGaugeVec(ZRC20SupplyDiscrepancy, "discrepancy between the holdings backing a ZRC20 and its supply", []string{"chain_id", "zrc20"})
+	if err != nil {
+		return zrc20SupplyChecker, err
+	}
+
+	logger.Info().Msgf("zrc20 supply checker initialized, pause on discrepancy: %t", cfg.ZRC20SupplyCheckPause)
+
+	return zrc20SupplyChecker, nil
+}
+
+func (zs *ZRC20SupplyChecker) Start() {
+	defer zs.ticker.Stop()
+	for {
+		select {
+		case <-zs.ticker.C():
+			err := zs.CheckZRC20Supply()
+			if err != nil {
+				zs.logger.Error().Err(err).Msgf("ZRC20SupplyChecker error")
+			}
+		case <-zs.stop:
+			return
+		}
+	}
+}
+
+func (zs *ZRC20SupplyChecker) Stop() {
+	zs.logger.Info().Msgf("ZRC20SupplyChecker is stopping")
+	close(zs.stop)
+}
+
+// CheckZRC20Supply checks the supply of each ZRC20 against the holdings on its chain
+// the ZETA supply is checked separately by the ZetaSupplyChecker
+func (zs *ZRC20SupplyChecker) CheckZRC20Supply() error {
+	supplies, err := zs.zetaClient.GetZRC20Supplies()
+	if err != nil {
+		return errors.Wrap(err, "error getting zrc20 supplies")
+	}
+
+	// a chain is considered backed if all its ZRC20 are backed, the chain is paused otherwise
+	chainsBacked := make(map[int64]bool)
+	for _, supply := range supplies {
+		foreignCoin := supply.ForeignCoin
+		if foreignCoin.CoinType == common.CoinType_Zeta {
+			continue
+		}
+		chainID := foreignCoin.ForeignChainId
+		if !zs.isChainSupported(chainID) {
+			continue
+		}
+
+		zrc20Supply, ok := sdkmath.NewIntFromString(supply.TotalSupply)
+		if !ok {
+			zs.logger.Error().Msgf("error parsing total supply of zrc20 %s", foreignCoin.Zrc20ContractAddress)
+			continue
+		}
+		holdings, err := zs.GetHoldings(foreignCoin)
+		if err != nil {
+			zs.logger.Error().Err(err).Msgf("error getting holdings of zrc20 %s on chain %d", foreignCoin.Zrc20ContractAddress, chainID)
+			continue
+		}
+		inFlight, err := zs.GetAmountInFlight(foreignCoin)
+		if err != nil {
+			zs.logger.Error().Err(err).Msgf("error getting in-flight amount of zrc20 %s on chain %d", foreignCoin.Zrc20ContractAddress, chainID)
+			continue
+		}
+
+		discrepancy, backed := ValidateZRC20Supply(zs.logger, foreignCoin, zrc20Supply, holdings, inFlight)
+		if gaugeVec, found := metrics.GaugeVecs[ZRC20SupplyDiscrepancy]; found {
+			discrepancyFloat, _ := discrepancy.BigInt().Float64()
+			gaugeVec.WithLabelValues(strconv.FormatInt(chainID, 10), foreignCoin.Zrc20ContractAddress).Set(discrepancyFloat)
+		}
+		chainBacked, found := chainsBacked[chainID]
+		chainsBacked[chainID] = backed && (chainBacked || !found)
+	}
+
+	if !zs.cfg.ZRC20SupplyCheckPause {
+		return nil
+	}
+	// the outbound is only paused by this client, the outbound of a chain is paused for all the observers by the emergency
+	// policy account
+	for chainID, backed := range chainsBacked {
+		reason, paused := zs.cfg.IsChainPaused(chainID)
+		if !backed && !paused {
+			zs.logger.Warn().Msgf("pausing outbound of chain %d: zrc20 supply not backed", chainID)
+			zs.cfg.PauseChain(chainID, zrc20SupplyPauseReason)
+		} else if backed && paused && reason == zrc20SupplyPauseReason {
+			zs.logger.Info().Msgf("resuming outbound of chain %d: zrc20 supply backed", chainID)
+			zs.cfg.UnpauseChain(chainID)
+		}
+	}
+	return nil
+}
+
+// GetHoldings returns the amount of the asset of the foreign coin held by the TSS or the ERC20 custody on its chain
+func (zs *ZRC20SupplyChecker) GetHoldings(foreignCoin fungibletypes.ForeignCoins) (sdkmath.Int, error) {
+	chainID := foreignCoin.ForeignChainId
+	if common.IsBitcoinChain(chainID) {
+		return zs.getBTCHoldings(chainID)
+	}
+
+	client, found := zs.evmClient[chainID]
+	if !found {
+		return sdkmath.ZeroInt(), fmt.Errorf("evm client not found for chain id %d", chainID)
+	}
+	switch foreignCoin.CoinType {
+	case common.CoinType_Gas:
+		tssAddress, err := zs.zetaClient.GetEthTssAddress()
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		balance, err := client.BalanceAt(context.Background(), ethcommon.HexToAddress(tssAddress), nil)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		return sdkmath.NewIntFromBigInt(balance), nil
+	case common.CoinType_ERC20:
+		evmConfig, ok := zs.cfg.GetEVMConfig(chainID)
+		if !ok {
+			return sdkmath.ZeroInt(), fmt.Errorf("evm config not found for chain id %d", chainID)
+		}
+		erc20, err := ierc20.NewIERC20(ethcommon.HexToAddress(foreignCoin.Asset), client)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		balance, err := erc20.BalanceOf(nil, ethcommon.HexToAddress(evmConfig.Erc20CustodyContractAddress))
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		return sdkmath.NewIntFromBigInt(balance), nil
+	default:
+		return sdkmath.ZeroInt(), fmt.Errorf("unsupported coin type %s", foreignCoin.CoinType)
+	}
+}
+
+// getBTCHoldings returns the amount of satoshis in the UTXOs of the TSS
+func (zs *ZRC20SupplyChecker) getBTCHoldings(chainID int64) (sdkmath.Int, error) {
+	if zs.btcClient == nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("btc client not found for chain id %d", chainID)
+	}
+	tssAddress, err := zs.zetaClient.GetBtcTssAddress()
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	bitcoinNetParams, err := common.BitcoinNetParamsFromChainID(chainID)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	address, err := btcutil.DecodeAddress(tssAddress, bitcoinNetParams)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("error decoding wallet address (%s) : %s", tssAddress, err.Error())
+	}
+	utxos, err := zs.btcClient.ListUnspentMinMaxAddresses(0, 9999999, []btcutil.Address{address})
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	total := sdkmath.ZeroInt()
+	for _, utxo := range utxos {
+		amount, err := GetSatoshis(utxo.Amount)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+		total = total.Add(sdkmath.NewInt(amount))
+	}
+	return total, nil
+}
+
+// GetAmountInFlight returns the amount of the pending outbound cctxs of the foreign coin that are not yet broadcasted
+// this amount is already burned on ZetaChain but still held on the foreign chain
+func (zs *ZRC20SupplyChecker) GetAmountInFlight(foreignCoin fungibletypes.ForeignCoins) (sdkmath.Int, error) {
+	chainID := foreignCoin.ForeignChainId
+	cctxs, _, err := zs.zetaClient.ListPendingCctx(chainID)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	trackers, err := zs.zetaClient.GetAllOutTxTrackerByChain(chainID, Ascending)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	trackedNonces := make(map[uint64]bool, len(trackers))
+	for _, tracker := range trackers {
+		trackedNonces[tracker.Nonce] = true
+	}
+	return AmountInFlight(foreignCoin, cctxs, trackedNonces), nil
+}
+
+// AmountInFlight returns the total amount of the cctxs of the foreign coin whose nonce is not tracked
+func AmountInFlight(foreignCoin fungibletypes.ForeignCoins, cctxs []*types.CrossChainTx, trackedNonces map[uint64]bool) sdkmath.Int {
+	amount := sdkmath.ZeroUint()
+	for _, cctx := range cctxs {
+		inbound := cctx.GetInboundTxParams()
+		if inbound.CoinType != foreignCoin.CoinType {
+			continue
+		}
+		if foreignCoin.CoinType == common.CoinType_ERC20 && !strings.EqualFold(inbound.Asset, foreignCoin.Asset) {
+			continue
+		}
+		outbound := cctx.GetCurrentOutTxParam()
+		if outbound.ReceiverChainId != foreignCoin.ForeignChainId || trackedNonces[outbound.OutboundTxTssNonce] {
+			continue
+		}
+		amount = amount.Add(outbound.Amount)
+	}
+	return sdkmath.NewIntFromBigInt(amount.BigInt())
+}
+
+type ZRC20SupplyCheckLogs struct {
+	Logger             zerolog.Logger
+	ChainID            int64       `json:"chain_id"`
+	ZRC20              string      `json:"zrc20"`
+	ZRC20Supply        sdkmath.Int `json:"zrc20_supply"`
+	Holdings           sdkmath.Int `json:"holdings"`
+	InFlight           sdkmath.Int `json:"in_flight"`
+	Discrepancy        sdkmath.Int `json:"discrepancy"`
+	SupplyCheckSuccess bool        `json:"supply_check_success"`
+}
+
+func (z ZRC20SupplyCheckLogs) LogOutput() {
+	output, err := PrettyPrintStruct(z)
+	if err != nil {
+		z.Logger.Error().Err(err).Msgf("error pretty printing struct")
+	}
+	z.Logger.Info().Msgf(output)
+}
+
+// ValidateZRC20Supply returns the discrepancy between the holdings minus the in-flight amount and the ZRC20 supply
+// the supply is backed if the discrepancy is not negative
+func ValidateZRC20Supply(logger zerolog.Logger, foreignCoin fungibletypes.ForeignCoins, zrc20Supply, holdings, inFlight sdkmath.Int) (sdkmath.Int, bool) {
+	discrepancy := holdings.Sub(inFlight).Sub(zrc20Supply)
+	logs := ZRC20SupplyCheckLogs{
+		Logger:             logger,
+		ChainID:            foreignCoin.ForeignChainId,
+		ZRC20:              foreignCoin.Zrc20ContractAddress,
+		ZRC20Supply:        zrc20Supply,
+		Holdings:           holdings,
+		InFlight:           inFlight,
+		Discrepancy:        discrepancy,
+		SupplyCheckSuccess: !discrepancy.IsNegative(),
+	}
+	defer logs.LogOutput()
+	return discrepancy, logs.SupplyCheckSuccess
+}
+
+func (zs *ZRC20SupplyChecker) isChainSupported(chainID int64) bool {
+	if common.IsBitcoinChain(chainID) {
+		return zs.btcClient != nil
+	}
+	_, found := zs.evmClient[chainID]
+	return found
+}
@@ -0,0 +1,129 @@
+package zetaclient_test
+
+import (
+	"os"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	"github.com/zeta-chain/zetacore/zetaclient"
+)
+
+func TestZRC20SupplyChecker_ValidateZRC20Supply(t *testing.T) {
+	tt := []struct {
+		name                string
+		zrc20Supply         sdkmath.Int
+		holdings            sdkmath.Int
+		inFlight            sdkmath.Int
+		expectedDiscrepancy sdkmath.Int
+		validate            assert.BoolAssertionFunc
+	}{
+		{
+			name:                "supply backed by holdings",
+			zrc20Supply:         MustNewIntFromString("1000000000000000000"),
+			holdings:            MustNewIntFromString("1000000000000000000"),
+			inFlight:            MustNewIntFromString("0"),
+			expectedDiscrepancy: MustNewIntFromString("0"),
+			validate:            assert.True,
+		},
+		{
+			name:                "surplus of holdings",
+			zrc20Supply:         MustNewIntFromString("1000000000000000000"),
+			holdings:            MustNewIntFromString("1500000000000000000"),
+			inFlight:            MustNewIntFromString("0"),
+			expectedDiscrepancy: MustNewIntFromString("500000000000000000"),
+			validate:            assert.True,
+		},
+		{
+			name:                "supply backed with cctx in flight",
+			zrc20Supply:         MustNewIntFromString("1000000000000000000"),
+			holdings:            MustNewIntFromString("1200000000000000000"),
+			inFlight:            MustNewIntFromString("200000000000000000"),
+			expectedDiscrepancy: MustNewIntFromString("0"),
+			validate:            assert.True,
+		},
+		{
+			name:                "supply not backed",
+			zrc20Supply:         MustNewIntFromString("1000000000000000000"),
+			holdings:            MustNewIntFromString("1100000000000000000"),
+			inFlight:            MustNewIntFromString("200000000000000000"),
+			expectedDiscrepancy: MustNewIntFromString("-100000000000000000"),
+			validate:            assert.False,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
+			foreignCoin := fungibletypes.ForeignCoins{ForeignChainId: 5, CoinType: common.CoinType_Gas}
+			discrepancy, backed := zetaclient.ValidateZRC20Supply(logger, foreignCoin, tc.zrc20Supply, tc.holdings, tc.inFlight)
+			tc.validate(t, backed)
+			assert.True(t, tc.expectedDiscrepancy.Equal(discrepancy))
+		})
+	}
+}
+
+func TestZRC20SupplyChecker_AmountInFlight(t *testing.T) {
+	newCctx := func(coinType common.CoinType, asset string, receiverChainID int64, nonce uint64, amount uint64) *types.CrossChainTx {
+		return &types.CrossChainTx{
+			InboundTxParams: &types.InboundTxParams{
+				CoinType: coinType,
+				Asset:    asset,
+			},
+			OutboundTxParams: []*types.OutboundTxParams{{
+				ReceiverChainId:    receiverChainID,
+				OutboundTxTssNonce: nonce,
+				Amount:             sdkmath.NewUint(amount),
+			}},
+		}
+	}
+	asset := "0xff3135df4F2775f4091b81f4c7B6359CfA07862a"
+	cctxs := []*types.CrossChainTx{
+		newCctx(common.CoinType_Gas, "", 5, 0, 100),
+		newCctx(common.CoinType_Gas, "", 5, 1, 200),
+		newCctx(common.CoinType_Gas, "", 97, 2, 400),
+		newCctx(common.CoinType_ERC20, asset, 5, 3, 800),
+		newCctx(common.CoinType_ERC20, "0x0000000000000000000000000000000000000001", 5, 4, 1600),
+		newCctx(common.CoinType_Zeta, "", 5, 5, 3200),
+	}
+
+	tt := []struct {
+		name          string
+		foreignCoin   fungibletypes.ForeignCoins
+		trackedNonces map[uint64]bool
+		expected      int64
+	}{
+		{
+			name:        "gas coin",
+			foreignCoin: fungibletypes.ForeignCoins{ForeignChainId: 5, CoinType: common.CoinType_Gas},
+			expected:    300,
+		},
+		{
+			name:          "gas coin with tracked nonce",
+			foreignCoin:   fungibletypes.ForeignCoins{ForeignChainId: 5, CoinType: common.CoinType_Gas},
+			trackedNonces: map[uint64]bool{1: true},
+			expected:      100,
+		},
+		{
+			name:        "erc20 matched by asset",
+			foreignCoin: fungibletypes.ForeignCoins{ForeignChainId: 5, CoinType: common.CoinType_ERC20, Asset: "0xFF3135DF4F2775F4091B81F4C7B6359CFA07862A"},
+			expected:    800,
+		},
+		{
+			name:        "no cctx for chain",
+			foreignCoin: fungibletypes.ForeignCoins{ForeignChainId: 1337, CoinType: common.CoinType_Gas},
+			expected:    0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			amount := zetaclient.AmountInFlight(tc.foreignCoin, cctxs, tc.trackedNonces)
+			assert.True(t, sdkmath.NewInt(tc.expected).Equal(amount), "expected %d, got %s", tc.expected, amount)
+		})
+	}
+}